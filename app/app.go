@@ -19,8 +19,10 @@ import (
 	"github.com/Black-And-White-Club/frolf-bot/app/modules/auth"
 	"github.com/Black-And-White-Club/frolf-bot/app/modules/betting"
 	"github.com/Black-And-White-Club/frolf-bot/app/modules/club"
+	"github.com/Black-And-White-Club/frolf-bot/app/modules/course"
 	"github.com/Black-And-White-Club/frolf-bot/app/modules/guild"
 	"github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard"
+	"github.com/Black-And-White-Club/frolf-bot/app/modules/rating"
 	"github.com/Black-And-White-Club/frolf-bot/app/modules/round"
 	"github.com/Black-And-White-Club/frolf-bot/app/modules/score"
 	"github.com/Black-And-White-Club/frolf-bot/app/modules/user"
@@ -44,6 +46,8 @@ type App struct {
 	GuildModule       *guild.Module
 	ClubModule        *club.Module
 	BettingModule     *betting.Module
+	CourseModule      *course.Module
+	RatingModule      *rating.Module
 	AuthModule        *auth.Module
 	DB                *bundb.DBService
 	EventBus          eventbus.EventBus
@@ -198,22 +202,39 @@ func (app *App) initializeModules(ctx context.Context, routerRunCtx context.Cont
 		app.Observability.Provider.Logger.Error("Failed to initialize user module", attr.Error(err))
 		return fmt.Errorf("failed to initialize user module: %w", err)
 	}
+	if app.CourseModule, err = course.NewModule(ctx, course.ModuleOptions{
+		Observability: app.Observability,
+		EventBus:      app.EventBus,
+		Router:        app.Router,
+		Helpers:       app.Helpers,
+		RouterCtx:     routerRunCtx,
+		DB:            app.DB.GetDB(),
+		UserService:   app.UserModule.UserService,
+	}); err != nil {
+		app.Observability.Provider.Logger.Error("Failed to initialize course module", attr.Error(err))
+		return fmt.Errorf("failed to initialize course module: %w", err)
+	}
 	if app.RoundModule, err = round.NewRoundModule(ctx, app.Config, app.Observability, app.DB.RoundDB, app.DB.GetDB(), app.DB.GuildDB, app.DB.UserDB, app.UserModule.UserService, app.EventBus, app.Router, app.Helpers, routerRunCtx); err != nil {
 		app.Observability.Provider.Logger.Error("Failed to initialize round module", attr.Error(err))
 		return fmt.Errorf("failed to initialize round module: %w", err)
 	}
+	app.RoundModule.SetCourseService(app.CourseModule.CourseService)
 	if app.LeaderboardModule, err = leaderboard.NewLeaderboardModule(ctx, app.Config, app.Observability, app.DB.GetDB(), app.DB.LeaderboardDB, app.RoundModule.RoundService, app.EventBus, app.Router, app.Helpers, routerRunCtx, app.EventBus.GetJetStream(), app.UserModule.UserService); err != nil {
 		app.Observability.Provider.Logger.Error("Failed to initialize leaderboard module", attr.Error(err))
 		return fmt.Errorf("failed to initialize leaderboard module: %w", err)
 	}
+	app.LeaderboardModule.RegisterHTTPRoutes(app.HTTPRouter, app.DB.UserDB)
 	if app.GuildModule, err = guild.NewGuildModule(ctx, app.Config, app.Observability, app.DB.GuildDB, app.EventBus, app.Router, app.Helpers, routerRunCtx, app.DB.GetDB(), app.HTTPRouter); err != nil {
 		app.Observability.Provider.Logger.Error("Failed to initialize guild module", attr.Error(err))
 		return fmt.Errorf("failed to initialize guild module: %w", err)
 	}
+	app.LeaderboardModule.SetGuildService(app.GuildModule.GuildService)
 	if app.ScoreModule, err = score.NewScoreModule(ctx, app.Config, app.Observability, app.DB.ScoreDB, app.DB.GuildDB, app.EventBus, app.Router, app.Helpers, routerRunCtx, app.DB.GetDB()); err != nil {
 		app.Observability.Provider.Logger.Error("Failed to initialize score module", attr.Error(err))
 		return fmt.Errorf("failed to initialize score module: %w", err)
 	}
+	app.ScoreModule.SetRoundRepository(app.DB.RoundDB)
+	app.LeaderboardModule.SetScoreService(app.ScoreModule.ScoreService)
 	if app.ClubModule, err = club.NewClubModule(ctx, club.ClubModuleOptions{
 		Observability:     app.Observability,
 		EventBus:          app.EventBus,
@@ -230,18 +251,35 @@ func (app *App) initializeModules(ctx context.Context, routerRunCtx context.Cont
 		app.Observability.Provider.Logger.Error("Failed to initialize club module", attr.Error(err))
 		return fmt.Errorf("failed to initialize club module: %w", err)
 	}
+	if app.RatingModule, err = rating.NewModule(ctx, rating.ModuleOptions{
+		Observability: app.Observability,
+		EventBus:      app.EventBus,
+		Router:        app.Router,
+		Helpers:       app.Helpers,
+		RouterCtx:     routerRunCtx,
+		DB:            app.DB.GetDB(),
+		HTTPRouter:    app.HTTPRouter,
+		UserRepo:      app.DB.UserDB,
+		RoundRepo:     app.DB.RoundDB,
+	}); err != nil {
+		app.Observability.Provider.Logger.Error("Failed to initialize rating module", attr.Error(err))
+		return fmt.Errorf("failed to initialize rating module: %w", err)
+	}
+	app.RoundModule.SetRatingService(app.RatingModule.RatingService)
 	if app.BettingModule, err = betting.NewModule(ctx, betting.ModuleOptions{
-		Observability:   app.Observability,
-		EventBus:        app.EventBus,
-		Router:          app.Router,
-		Helpers:         app.Helpers,
-		RouterCtx:       routerRunCtx,
-		DB:              app.DB.GetDB(),
-		HTTPRouter:      app.HTTPRouter,
-		UserRepo:        app.DB.UserDB,
-		GuildRepo:       app.DB.GuildDB,
-		LeaderboardRepo: app.DB.LeaderboardDB,
-		RoundRepo:       app.DB.RoundDB,
+		Observability:      app.Observability,
+		EventBus:           app.EventBus,
+		Router:             app.Router,
+		Helpers:            app.Helpers,
+		RouterCtx:          routerRunCtx,
+		DB:                 app.DB.GetDB(),
+		HTTPRouter:         app.HTTPRouter,
+		UserRepo:           app.DB.UserDB,
+		GuildRepo:          app.DB.GuildDB,
+		LeaderboardRepo:    app.DB.LeaderboardDB,
+		RoundRepo:          app.DB.RoundDB,
+		RatingService:      app.RatingModule.RatingService,
+		LeaderboardService: app.LeaderboardModule.LeaderboardService,
 	}); err != nil {
 		app.Observability.Provider.Logger.Error("Failed to initialize betting module", attr.Error(err))
 		return fmt.Errorf("failed to initialize betting module: %w", err)
@@ -392,6 +430,12 @@ func (app *App) close() error {
 	if app.BettingModule != nil {
 		app.BettingModule.Close()
 	}
+	if app.CourseModule != nil {
+		app.CourseModule.Close()
+	}
+	if app.RatingModule != nil {
+		app.RatingModule.Close()
+	}
 	if app.AuthModule != nil {
 		app.AuthModule.Close()
 	}
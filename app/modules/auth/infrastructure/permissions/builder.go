@@ -201,9 +201,24 @@ func publishPatterns(clubUUID, guildID string, includeParticipant bool) []string
 			fmt.Sprintf("leaderboard.tag.list.requested.v1.%s", id),
 			fmt.Sprintf("leaderboard.tag.history.requested.v1.%s", id),
 			fmt.Sprintf("leaderboard.tag.graph.requested.v1.%s", id),
+			fmt.Sprintf("leaderboard.head_to_head.requested.v1.%s", id),
+			fmt.Sprintf("leaderboard.member_stats.requested.v1.%s", id),
+			fmt.Sprintf("leaderboard.chart.points_race.requested.v1.%s", id),
+			fmt.Sprintf("leaderboard.chart.score_distribution.requested.v1.%s", id),
+			fmt.Sprintf("leaderboard.chart.attendance_heatmap.requested.v1.%s", id),
+			fmt.Sprintf("leaderboard.chart.tag_comparison.requested.v1.%s", id),
+			fmt.Sprintf("leaderboard.tag.swap.intents.request.v1.%s", id),
+			fmt.Sprintf("leaderboard.division.list.request.v1.%s", id),
+			fmt.Sprintf("leaderboard.division.leaderboard.request.v1.%s", id),
+			fmt.Sprintf("leaderboard.division.tag_list.request.v1.%s", id),
+			fmt.Sprintf("leaderboard.division.standings.request.v1.%s", id),
 			fmt.Sprintf("season.list.requested.v1.%s", id),
 			fmt.Sprintf("season.standings.requested.v1.%s", id),
 			fmt.Sprintf("betting.snapshot.request.v1.%s", id),
+			fmt.Sprintf("course.list.request.v1.%s", id),
+			fmt.Sprintf("course.layout.get.request.v1.%s", id),
+			fmt.Sprintf("rating.member.request.v1.%s", id),
+			fmt.Sprintf("rating.list.request.v1.%s", id),
 		)
 	}
 
@@ -218,6 +233,9 @@ func publishPatterns(clubUUID, guildID string, includeParticipant bool) []string
 			"round.participant.join.requested.v2",
 			"round.participant.declined.v1",
 			"round.participant.removal.requested.v2",
+			"round.hole.score.submit.requested.v1",
+			"round.check_in.requested.v1",
+			"leaderboard.tag.swap.intent.cancel.requested.v1",
 		)
 	}
 
@@ -280,6 +298,18 @@ func (b *Builder) adminPermissions(clubUUID, userUUID, guildID, userID string) *
 		leaderboardevents.LeaderboardManualPointAdjustmentV2,
 		leaderboardevents.LeaderboardRecalculateRoundV1,
 		leaderboardevents.LeaderboardStartNewSeasonV1,
+		"leaderboard.season.start_with_policy.requested.v1",
+		"leaderboard.replay.requested.v1",
+		"leaderboard.season.schedule.requested.v1",
+		"leaderboard.inactivity.policy.set.requested.v1",
+		"leaderboard.inactivity.preview.requested.v1",
+		"leaderboard.tag.pool.policy.set.requested.v1",
+		"leaderboard.promotion.policy.set.requested.v1",
+		"leaderboard.promotion.preview.requested.v1",
+		"leaderboard.data.export.requested.v1",
+		"leaderboard.tag_history.import.requested.v1",
+		"leaderboard.division.create.requested.v1",
+		"leaderboard.division.member.assign.requested.v1",
 		leaderboardevents.LeaderboardEndSeasonV1,
 		"leaderboard.batch.tag.assignment.requested.v2",
 		"round.scorecard.admin.upload.requested.v2",
@@ -45,12 +45,39 @@ func TestBuilder_ForRole(t *testing.T) {
 					"round.participant.join.requested.v2",
 					"round.participant.declined.v1",
 					"round.participant.removal.requested.v2",
+					"round.hole.score.submit.requested.v1",
+					"round.check_in.requested.v1",
+					"leaderboard.tag.swap.intent.cancel.requested.v1",
 					"user.udisc.identity.update.requested.v1",
 				} {
 					if !contains(p.Publish.Allow, expectedPub) {
 						t.Errorf("expected publish allow for %s, got %v", expectedPub, p.Publish.Allow)
 					}
 				}
+				if !contains(p.Publish.Allow, fmt.Sprintf("leaderboard.tag.swap.intents.request.v1.%s", clubUUID)) {
+					t.Errorf("expected club-scoped swap intent listing, got %v", p.Publish.Allow)
+				}
+				if !contains(p.Publish.Allow, fmt.Sprintf("leaderboard.head_to_head.requested.v1.%s", clubUUID)) {
+					t.Errorf("expected club-scoped head-to-head requests, got %v", p.Publish.Allow)
+				}
+				if !contains(p.Publish.Allow, fmt.Sprintf("leaderboard.member_stats.requested.v1.%s", clubUUID)) {
+					t.Errorf("expected club-scoped member stats requests, got %v", p.Publish.Allow)
+				}
+				if !contains(p.Publish.Allow, fmt.Sprintf("leaderboard.division.leaderboard.request.v1.%s", clubUUID)) {
+					t.Errorf("expected club-scoped division leaderboard requests, got %v", p.Publish.Allow)
+				}
+				if !contains(p.Publish.Allow, fmt.Sprintf("course.list.request.v1.%s", clubUUID)) {
+					t.Errorf("expected club-scoped course list requests, got %v", p.Publish.Allow)
+				}
+				if !contains(p.Publish.Allow, fmt.Sprintf("course.layout.get.request.v1.%s", clubUUID)) {
+					t.Errorf("expected club-scoped course layout requests, got %v", p.Publish.Allow)
+				}
+				if !contains(p.Publish.Allow, fmt.Sprintf("rating.member.request.v1.%s", clubUUID)) {
+					t.Errorf("expected club-scoped member rating requests, got %v", p.Publish.Allow)
+				}
+				if !contains(p.Publish.Allow, fmt.Sprintf("rating.list.request.v1.%s", clubUUID)) {
+					t.Errorf("expected club-scoped rating list requests, got %v", p.Publish.Allow)
+				}
 				if contains(p.Publish.Allow, "round.score.update.requested.v2") {
 					t.Errorf("player permissions must not allow score update writes, got %v", p.Publish.Allow)
 				}
@@ -97,6 +124,18 @@ func TestBuilder_ForRole(t *testing.T) {
 					leaderboardevents.LeaderboardManualPointAdjustmentV2,
 					leaderboardevents.LeaderboardRecalculateRoundV1,
 					leaderboardevents.LeaderboardStartNewSeasonV1,
+					"leaderboard.season.start_with_policy.requested.v1",
+					"leaderboard.replay.requested.v1",
+					"leaderboard.season.schedule.requested.v1",
+					"leaderboard.inactivity.policy.set.requested.v1",
+					"leaderboard.inactivity.preview.requested.v1",
+					"leaderboard.tag.pool.policy.set.requested.v1",
+					"leaderboard.promotion.policy.set.requested.v1",
+					"leaderboard.promotion.preview.requested.v1",
+					"leaderboard.data.export.requested.v1",
+					"leaderboard.tag_history.import.requested.v1",
+					"leaderboard.division.create.requested.v1",
+					"leaderboard.division.member.assign.requested.v1",
 					leaderboardevents.LeaderboardEndSeasonV1,
 					leaderboardevents.LeaderboardGetSeasonStandingsV1,
 					"leaderboard.batch.tag.assignment.requested.v2",
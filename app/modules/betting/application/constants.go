@@ -1,5 +1,7 @@
 package bettingservice
 
+import "time"
+
 const (
 	defaultSeasonID         = "default"
 	defaultSeasonName       = "Default Season"
@@ -23,6 +25,18 @@ const (
 	marketSettlementEntry   = "market_settlement"
 	marketRefundEntry       = "market_refund"
 	marketCorrectionEntry   = "market_correction"
+	tagOfferReservedEntry   = "tag_offer_reserved"
+	tagOfferSettlementEntry = "tag_offer_settlement"
+	tagOfferRefundEntry     = "tag_offer_refund"
+	openTagOfferStatus      = "open"
+	settlingTagOfferStatus  = "settling"
+	acceptedTagOfferStatus  = "accepted"
+	failedTagOfferStatus    = "failed"
+	cancelledTagOfferStatus = "cancelled"
+	declinedTagOfferStatus  = "declined"
+	expiredTagOfferStatus   = "expired"
+	tagOfferTTL             = 7 * 24 * time.Hour
+	tagOfferListSize        = 50
 	marketVigMultiplier     = 1.05
 	minMarketProbability    = 0.01
 	maxMarketProbability    = 0.95
@@ -21,4 +21,11 @@ var (
 	ErrRoundNotFinalized        = errors.New("betting round not finalized")
 	ErrSelfBetProhibited        = errors.New("betting cannot bet on yourself in this market")
 	ErrInvalidMarketType        = errors.New("betting invalid market type")
+	ErrTagOffersUnavailable     = errors.New("betting tag offers unavailable")
+	ErrTagOfferNotFound         = errors.New("betting tag offer not found")
+	ErrTagOfferClosed           = errors.New("betting tag offer is no longer open")
+	ErrTagOfferPriceInvalid     = errors.New("betting tag offer price must be positive")
+	ErrTagOfferTagInvalid       = errors.New("betting tag offer tag is not held by another member")
+	ErrTagOfferNotHolder        = errors.New("betting tag offer can only be accepted by the tag holder")
+	ErrTagOfferForbidden        = errors.New("betting tag offer can only be cancelled by the buyer or the tag holder")
 )
@@ -7,9 +7,12 @@ import (
 	"time"
 
 	guildtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/guild"
+	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
 	bettingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/betting/infrastructure/repositories"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
 	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
 	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
 	"github.com/google/uuid"
@@ -105,6 +108,11 @@ type FakeBettingRepository struct {
 	CreateAuditLogFunc            func(ctx context.Context, db bun.IDB, log *bettingdb.AuditLog) error
 	AcquireWalletBalanceFunc      func(ctx context.Context, db bun.IDB, clubUUID, userUUID uuid.UUID, seasonID string) (*bettingdb.WalletBalance, error)
 	ApplyWalletBalanceDeltaFunc   func(ctx context.Context, db bun.IDB, clubUUID, userUUID uuid.UUID, seasonID string, balanceDelta, reservedDelta int) error
+	CreateTagOfferFunc            func(ctx context.Context, db bun.IDB, offer *bettingdb.TagOffer) error
+	GetTagOfferForUpdateFunc      func(ctx context.Context, db bun.IDB, clubUUID uuid.UUID, offerID int64) (*bettingdb.TagOffer, error)
+	UpdateTagOfferFunc            func(ctx context.Context, db bun.IDB, offer *bettingdb.TagOffer) error
+	ListOpenTagOffersFunc         func(ctx context.Context, db bun.IDB, clubUUID uuid.UUID, now time.Time, limit int) ([]bettingdb.TagOffer, error)
+	ListSettlingTagOffersFunc     func(ctx context.Context, db bun.IDB, limit int) ([]bettingdb.TagOffer, error)
 }
 
 func NewFakeBettingRepository() *FakeBettingRepository { return &FakeBettingRepository{} }
@@ -300,6 +308,46 @@ func (f *FakeBettingRepository) ApplyWalletBalanceDelta(ctx context.Context, db
 	return nil
 }
 
+func (f *FakeBettingRepository) CreateTagOffer(ctx context.Context, db bun.IDB, offer *bettingdb.TagOffer) error {
+	f.record("CreateTagOffer")
+	if f.CreateTagOfferFunc != nil {
+		return f.CreateTagOfferFunc(ctx, db, offer)
+	}
+	return nil
+}
+
+func (f *FakeBettingRepository) GetTagOfferForUpdate(ctx context.Context, db bun.IDB, clubUUID uuid.UUID, offerID int64) (*bettingdb.TagOffer, error) {
+	f.record("GetTagOfferForUpdate")
+	if f.GetTagOfferForUpdateFunc != nil {
+		return f.GetTagOfferForUpdateFunc(ctx, db, clubUUID, offerID)
+	}
+	return nil, nil
+}
+
+func (f *FakeBettingRepository) UpdateTagOffer(ctx context.Context, db bun.IDB, offer *bettingdb.TagOffer) error {
+	f.record("UpdateTagOffer")
+	if f.UpdateTagOfferFunc != nil {
+		return f.UpdateTagOfferFunc(ctx, db, offer)
+	}
+	return nil
+}
+
+func (f *FakeBettingRepository) ListOpenTagOffers(ctx context.Context, db bun.IDB, clubUUID uuid.UUID, now time.Time, limit int) ([]bettingdb.TagOffer, error) {
+	f.record("ListOpenTagOffers")
+	if f.ListOpenTagOffersFunc != nil {
+		return f.ListOpenTagOffersFunc(ctx, db, clubUUID, now, limit)
+	}
+	return nil, nil
+}
+
+func (f *FakeBettingRepository) ListSettlingTagOffers(ctx context.Context, db bun.IDB, limit int) ([]bettingdb.TagOffer, error) {
+	f.record("ListSettlingTagOffers")
+	if f.ListSettlingTagOffersFunc != nil {
+		return f.ListSettlingTagOffersFunc(ctx, db, limit)
+	}
+	return nil, nil
+}
+
 var _ bettingRepository = (*FakeBettingRepository)(nil)
 
 // ---------------------------------------------------------------------------
@@ -445,6 +493,8 @@ type FakeRoundRepository struct {
 	GetUpcomingRoundsFunc            func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) ([]*roundtypes.Round, error)
 	GetFinalizedRoundsAfterFunc      func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, startTime time.Time) ([]*roundtypes.Round, error)
 	GetAllUpcomingRoundsInWindowFunc func(ctx context.Context, db bun.IDB, lookahead time.Duration) ([]*roundtypes.Round, error)
+	GetRoundCourseLayoutIDFunc       func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*uuid.UUID, error)
+	GetFinalizedRoundsOnLayoutFunc   func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error)
 }
 
 func NewFakeRoundRepository() *FakeRoundRepository { return &FakeRoundRepository{} }
@@ -488,8 +538,62 @@ func (f *FakeRoundRepository) GetAllUpcomingRoundsInWindow(ctx context.Context,
 	return nil, nil
 }
 
+func (f *FakeRoundRepository) GetRoundCourseLayoutID(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*uuid.UUID, error) {
+	f.record("GetRoundCourseLayoutID")
+	if f.GetRoundCourseLayoutIDFunc != nil {
+		return f.GetRoundCourseLayoutIDFunc(ctx, db, guildID, roundID)
+	}
+	return nil, nil
+}
+
+func (f *FakeRoundRepository) GetFinalizedRoundsOnLayout(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error) {
+	f.record("GetFinalizedRoundsOnLayout")
+	if f.GetFinalizedRoundsOnLayoutFunc != nil {
+		return f.GetFinalizedRoundsOnLayoutFunc(ctx, db, guildID, layoutID, startTime)
+	}
+	return nil, nil
+}
+
 var _ roundRepository = (*FakeRoundRepository)(nil)
 
+// ---------------------------------------------------------------------------
+// FakeTagService
+// ---------------------------------------------------------------------------
+
+type FakeTagService struct {
+	trace []string
+
+	GetTagListFunc                func(ctx context.Context, guildID sharedtypes.GuildID, clubUUID *string) ([]leaderboardservice.MemberTagView, error)
+	ExecuteBatchTagAssignmentFunc func(ctx context.Context, guildID sharedtypes.GuildID, requests []sharedtypes.TagAssignmentRequest, updateID sharedtypes.RoundID, source sharedtypes.ServiceUpdateSource) (results.OperationResult[leaderboardtypes.LeaderboardData, error], error)
+}
+
+func NewFakeTagService() *FakeTagService { return &FakeTagService{} }
+
+func (f *FakeTagService) record(step string) { f.trace = append(f.trace, step) }
+func (f *FakeTagService) Trace() []string {
+	out := make([]string, len(f.trace))
+	copy(out, f.trace)
+	return out
+}
+
+func (f *FakeTagService) GetTagList(ctx context.Context, guildID sharedtypes.GuildID, clubUUID *string) ([]leaderboardservice.MemberTagView, error) {
+	f.record("GetTagList")
+	if f.GetTagListFunc != nil {
+		return f.GetTagListFunc(ctx, guildID, clubUUID)
+	}
+	return nil, nil
+}
+
+func (f *FakeTagService) ExecuteBatchTagAssignment(ctx context.Context, guildID sharedtypes.GuildID, requests []sharedtypes.TagAssignmentRequest, updateID sharedtypes.RoundID, source sharedtypes.ServiceUpdateSource) (results.OperationResult[leaderboardtypes.LeaderboardData, error], error) {
+	f.record("ExecuteBatchTagAssignment")
+	if f.ExecuteBatchTagAssignmentFunc != nil {
+		return f.ExecuteBatchTagAssignmentFunc(ctx, guildID, requests, updateID, source)
+	}
+	return results.SuccessResult[leaderboardtypes.LeaderboardData, error](nil), nil
+}
+
+var _ tagService = (*FakeTagService)(nil)
+
 // ---------------------------------------------------------------------------
 // newTestService
 // ---------------------------------------------------------------------------
@@ -510,6 +614,7 @@ func newTestService(
 		guildRepo,
 		leaderboardRepo,
 		roundRepo,
+		&fakeOddsRatingSource{},
 		bettingmetrics.NewNoop(),
 		slog.New(slog.NewTextHandler(io.Discard, nil)),
 		nil,
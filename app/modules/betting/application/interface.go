@@ -25,6 +25,9 @@ type Service interface {
 	// LockDueMarkets transitions all open markets whose locks_at has passed to
 	// locked status. Returns results for the caller to emit domain events.
 	LockDueMarkets(ctx context.Context) ([]MarketLockResult, error)
+	// SettlePendingTagOffers retries the tag move of accepted tag offers that are
+	// still settling. Used by the background market worker.
+	SettlePendingTagOffers(ctx context.Context) (int, error)
 	// SuspendOpenMarketsForClub suspends all open markets for a club in response
 	// to a feature access change (freeze or disable). Accepted bets on suspended
 	// markets remain valid and will settle normally.
@@ -33,6 +36,17 @@ type Service interface {
 	// betting wallet for each awarded player. Idempotent: a given roundID is
 	// only journaled once per user per season (enforced by DB unique index).
 	MirrorPointsToWallet(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, points map[sharedtypes.DiscordID]int) error
+	// ListTagOffers returns the club's open tag offers.
+	ListTagOffers(ctx context.Context, clubUUID, userUUID uuid.UUID) (*TagOfferBoard, error)
+	// CreateTagOffer posts an offer to buy another member's tag for wallet points.
+	CreateTagOffer(ctx context.Context, req CreateTagOfferRequest) (*BettingTagOffer, error)
+	// AcceptTagOffer sells the caller's tag to the offer's buyer. The buyer's points
+	// are reserved first; the offer stays settling until the tag has moved, when the
+	// seller is paid, and the reservation is released if the seller loses the tag in
+	// the meantime.
+	AcceptTagOffer(ctx context.Context, req TagOfferActionRequest) (*TagOfferSettlement, error)
+	// CancelTagOffer withdraws an offer (buyer) or declines it (tag holder).
+	CancelTagOffer(ctx context.Context, req TagOfferActionRequest) (*BettingTagOffer, error)
 }
 
 type Overview struct {
@@ -173,6 +187,19 @@ type PlaceBetRequest struct {
 	IdempotencyKey string              `json:"idempotency_key,omitempty"` // optional; empty means no idempotency protection
 }
 
+type CreateTagOfferRequest struct {
+	ClubUUID  uuid.UUID `json:"club_uuid"`
+	UserUUID  uuid.UUID `json:"-"`
+	TagNumber int       `json:"tag_number"`
+	Price     int       `json:"price"`
+}
+
+type TagOfferActionRequest struct {
+	ClubUUID uuid.UUID `json:"club_uuid"`
+	UserUUID uuid.UUID `json:"-"`
+	OfferID  int64     `json:"offer_id"`
+}
+
 type AdminMarketActionRequest struct {
 	ClubUUID  uuid.UUID `json:"club_uuid"`
 	AdminUUID uuid.UUID `json:"-"`
@@ -264,3 +291,35 @@ type MarketSuspendedResult struct {
 	RoundID  sharedtypes.RoundID
 	MarketID int64
 }
+
+// BettingTagOffer is an offer to buy a tag for wallet points. HolderMemberID is
+// the holder when the offer was posted.
+type BettingTagOffer struct {
+	ID             int64      `json:"id"`
+	TagNumber      int        `json:"tag_number"`
+	Price          int        `json:"price"`
+	BuyerMemberID  string     `json:"buyer_member_id"`
+	HolderMemberID string     `json:"holder_member_id"`
+	Status         string     `json:"status"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	ClosedAt       *time.Time `json:"closed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type TagOfferBoard struct {
+	ClubUUID    string            `json:"club_uuid"`
+	GuildID     string            `json:"guild_id"`
+	AccessState string            `json:"access_state"`
+	ReadOnly    bool              `json:"read_only"`
+	Offers      []BettingTagOffer `json:"offers"`
+}
+
+// TagOfferSettlement is the outcome of an accepted offer. The seller takes the
+// buyer's previous tag in exchange; SellerTag is 0 when the buyer held none and
+// the seller is left untagged. Offer.Status is settling while the tag has yet to
+// move and the buyer's points are held in reserve.
+type TagOfferSettlement struct {
+	Offer          BettingTagOffer `json:"offer"`
+	SellerMemberID string          `json:"seller_member_id"`
+	SellerTag      int             `json:"seller_tag"`
+}
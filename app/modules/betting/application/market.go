@@ -277,7 +277,7 @@ func (s *BettingService) getOrBuildOverUnderMarket(
 	participants []targetParticipant,
 ) (*bettingdb.Market, []pricedOption, bool, error) {
 	return s.getOrBuildMarket(ctx, db, clubUUID, seasonID, guildID, round, overUnderMarketType, overUnderMarketTitle(round), func() ([]pricedOption, error) {
-		return s.oddsEngine.priceOverUnderOptions(ctx, db, guildID, participants)
+		return s.oddsEngine.priceOverUnderOptions(ctx, db, guildID, round.ID, participants)
 	})
 }
 
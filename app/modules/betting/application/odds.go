@@ -10,6 +10,8 @@ import (
 
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	ratingdomain "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/domain"
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 )
 
@@ -20,30 +22,33 @@ const (
 	// monteCarloN is the number of simulation iterations for win probability.
 	monteCarloN = 500
 
-	// baselineMu is the assumed mean skill for players with no recorded history.
+	// baselineMu is the normalised skill of a player at the default rating.
 	// Lower scores are better in disc golf, so 0.5 maps to the middle of the
 	// normalised [0,1] difficulty scale we use internally.
 	baselineMu = 0.5
 
-	// baselineSigma is the uncertainty assigned to players with no history.
-	baselineSigma = 0.35
-
 	// fieldSizeCalibrationRate widens variance for larger fields so heavy
 	// favourites aren't priced too short.
 	fieldSizeCalibrationRate = 0.02
 
-	// decayFactor reduces the weight of older rounds exponentially.
+	// decayFactor reduces the weight of older rounds in over/under lines.
 	decayFactor = 0.85
+
+	// ratingScale maps Glicko ratings onto the normalised skill scale: a 1500
+	// rating lands on baselineMu and an unrated player's 350 RD on a sigma of 0.35.
+	ratingScale  = 1000.0
+	ratingCenter = 1500.0
 )
 
 // oddsEngine computes Bayesian win probabilities for a set of participants.
 type oddsEngine struct {
 	roundRepo       roundRepository
 	leaderboardRepo leaderboardRepository
+	ratings         ratingSource
 }
 
-func newOddsEngine(roundRepo roundRepository, leaderboardRepo leaderboardRepository) *oddsEngine {
-	return &oddsEngine{roundRepo: roundRepo, leaderboardRepo: leaderboardRepo}
+func newOddsEngine(roundRepo roundRepository, leaderboardRepo leaderboardRepository, ratings ratingSource) *oddsEngine {
+	return &oddsEngine{roundRepo: roundRepo, leaderboardRepo: leaderboardRepo, ratings: ratings}
 }
 
 // playerRating holds the estimated skill distribution for a single player.
@@ -155,15 +160,11 @@ func (e *oddsEngine) priceWinnerOptions(
 
 	fieldSize := len(participants)
 
-	historySince := time.Now().Add(-historyWindow)
-	history, err := e.roundRepo.GetFinalizedRoundsAfter(ctx, db, guildID, historySince)
+	ratings, err := e.participantRatings(ctx, guildID, participants)
 	if err != nil {
-		history = nil
+		return nil, err
 	}
 
-	observations := buildObservations(history, participants)
-	ratings := buildRatings(observations, participants, fieldSize)
-
 	sim := simulateFull(ratings)
 
 	options := make([]pricedOption, 0, fieldSize)
@@ -204,15 +205,11 @@ func (e *oddsEngine) pricePlacementOptions(
 	fieldSize := len(participants)
 	rankIdx := position - 1 // 0-indexed
 
-	historySince := time.Now().Add(-historyWindow)
-	history, err := e.roundRepo.GetFinalizedRoundsAfter(ctx, db, guildID, historySince)
+	ratings, err := e.participantRatings(ctx, guildID, participants)
 	if err != nil {
-		history = nil
+		return nil, err
 	}
 
-	observations := buildObservations(history, participants)
-	ratings := buildRatings(observations, participants, fieldSize)
-
 	sim := simulateFull(ratings)
 
 	options := make([]pricedOption, 0, fieldSize)
@@ -244,40 +241,51 @@ func (e *oddsEngine) pricePlacementOptions(
 // priceOverUnderOptions prices per-player score over/under markets.
 // Two options are generated per player: "{discordID}_over" and "{discordID}_under".
 // The line is the recency-weighted mean raw score, rounded to the nearest integer.
+// When the round is linked to a course layout, a player's line comes from their
+// rounds on that layout so par-relative scores are compared like for like; players
+// without layout history fall back to their guild-wide line.
 // P(over) = P(sampled normalised score < mu) from simulation (worse than expected = higher stroke count).
 func (e *oddsEngine) priceOverUnderOptions(
 	ctx context.Context,
 	db bun.IDB,
 	guildID sharedtypes.GuildID,
+	roundID sharedtypes.RoundID,
 	participants []targetParticipant,
 ) ([]pricedOption, error) {
 	if len(participants) < 2 {
 		return nil, ErrNoEligibleRound
 	}
 
-	fieldSize := len(participants)
-
 	historySince := time.Now().Add(-historyWindow)
 	history, err := e.roundRepo.GetFinalizedRoundsAfter(ctx, db, guildID, historySince)
 	if err != nil {
 		history = nil
 	}
 
-	observations := buildObservations(history, participants)
+	ratings, err := e.participantRatings(ctx, guildID, participants)
+	if err != nil {
+		return nil, err
+	}
+
 	rawObservations := buildRawObservations(history, participants)
-	ratings := buildRatings(observations, participants, fieldSize)
 
 	sim := simulateFull(ratings)
 
 	// Compute field average raw score as fallback for players with no history.
 	fieldAvgLine := computeFieldAverageLine(rawObservations)
 
+	layoutID, layoutObservations := e.layoutRawObservations(ctx, db, guildID, roundID, historySince, participants)
+
 	options := make([]pricedOption, 0, len(participants)*2)
 	for i, p := range participants {
 		pid := string(p.participant.UserID)
 
 		line := computePlayerLine(rawObservations[pid], fieldAvgLine)
 		lineJSON := fmt.Sprintf(`{"line":%d}`, line)
+		if obs := layoutObservations[pid]; len(obs) > 0 {
+			line = computePlayerLine(obs, fieldAvgLine)
+			lineJSON = fmt.Sprintf(`{"line":%d,"layout_id":%q}`, line, layoutID.String())
+		}
 
 		// P(over) from MC: fraction of iterations where sampled score < player's mu
 		// (lower normalised = worse = more strokes = over the line).
@@ -318,79 +326,41 @@ func (e *oddsEngine) priceOverUnderOptions(
 	return options, nil
 }
 
+// layoutRawObservations returns raw observations from rounds played on the same
+// course layout as roundID. It returns nil when the round has no layout or the
+// lookup fails, so pricing degrades to guild-wide history.
+func (e *oddsEngine) layoutRawObservations(
+	ctx context.Context,
+	db bun.IDB,
+	guildID sharedtypes.GuildID,
+	roundID sharedtypes.RoundID,
+	since time.Time,
+	participants []targetParticipant,
+) (uuid.UUID, map[string][]rawObservation) {
+	if roundID == sharedtypes.RoundID(uuid.Nil) {
+		return uuid.Nil, nil
+	}
+	layoutID, err := e.roundRepo.GetRoundCourseLayoutID(ctx, db, guildID, roundID)
+	if err != nil || layoutID == nil {
+		return uuid.Nil, nil
+	}
+	history, err := e.roundRepo.GetFinalizedRoundsOnLayout(ctx, db, guildID, *layoutID, since)
+	if err != nil || len(history) == 0 {
+		return uuid.Nil, nil
+	}
+	return *layoutID, buildRawObservations(history, participants)
+}
+
 // ---------------------------------------------------------------------------
 // Observation / rating helpers
 // ---------------------------------------------------------------------------
 
-// observation captures one player's score in one historical round.
-type observation struct {
-	normalised float64 // 1.0 = best in field, 0.0 = worst
-	roundAge   int     // index from most recent (0) → oldest
-}
-
 // rawObservation captures a player's un-normalised raw stroke count.
 type rawObservation struct {
 	score    int
 	roundAge int
 }
 
-// buildObservations extracts normalised performance metrics for each participant
-// from the historical rounds slice. Rounds should be ordered oldest→newest.
-func buildObservations(history []*roundtypes.Round, participants []targetParticipant) map[string][]observation {
-	obs := make(map[string][]observation)
-
-	participantSet := make(map[string]struct{}, len(participants))
-	for _, p := range participants {
-		participantSet[string(p.participant.UserID)] = struct{}{}
-	}
-
-	for age, round := range reverseRounds(history) {
-		if round == nil || len(round.Participants) == 0 {
-			continue
-		}
-
-		type playerScore struct {
-			userID string
-			score  int
-		}
-		var scores []playerScore
-		for _, participant := range round.Participants {
-			if _, ok := participantSet[string(participant.UserID)]; !ok {
-				continue
-			}
-			if participant.Score == nil || participant.IsDNF {
-				continue
-			}
-			scores = append(scores, playerScore{
-				userID: string(participant.UserID),
-				score:  int(*participant.Score),
-			})
-		}
-		if len(scores) < 2 {
-			continue
-		}
-
-		sort.Slice(scores, func(i, j int) bool { return scores[i].score < scores[j].score })
-
-		best := float64(scores[0].score)
-		worst := float64(scores[len(scores)-1].score)
-		spread := worst - best
-		if spread == 0 {
-			spread = 1
-		}
-
-		for _, ps := range scores {
-			normalised := 1.0 - (float64(ps.score)-best)/spread
-			obs[ps.userID] = append(obs[ps.userID], observation{
-				normalised: normalised,
-				roundAge:   age,
-			})
-		}
-	}
-
-	return obs
-}
-
 // buildRawObservations collects raw stroke counts (un-normalised) per player.
 func buildRawObservations(history []*roundtypes.Round, participants []targetParticipant) map[string][]rawObservation {
 	obs := make(map[string][]rawObservation)
@@ -457,13 +427,36 @@ func computeFieldAverageLine(rawObs map[string][]rawObservation) int {
 	return int(math.Round(total / float64(count)))
 }
 
-// buildRatings constructs per-player skill ratings from observations and applies
-// field-size calibration.
-func buildRatings(observations map[string][]observation, participants []targetParticipant, fieldSize int) []playerRating {
+// participantRatings maps each participant's persisted guild-wide rating onto the
+// normalised skill scale. Members who have not been rated yet price at the rating
+// a new player starts with.
+func (e *oddsEngine) participantRatings(ctx context.Context, guildID sharedtypes.GuildID, participants []targetParticipant) ([]playerRating, error) {
+	memberIDs := make([]sharedtypes.DiscordID, len(participants))
+	for i, p := range participants {
+		memberIDs[i] = p.participant.UserID
+	}
+	stored, err := e.ratings.GetRatings(ctx, guildID, nil, memberIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load player ratings: %w", err)
+	}
+
+	unrated := ratingdomain.NewRating()
 	ratings := make([]playerRating, len(participants))
 	for i, p := range participants {
-		ratings[i] = computeRating(observations[string(p.participant.UserID)], fieldSize)
+		rating, rd := unrated.Rating, unrated.RD
+		if view, ok := stored[p.participant.UserID]; ok {
+			rating, rd = view.Rating, view.RD
+		}
+		ratings[i] = playerRating{
+			mu:    baselineMu + (rating-ratingCenter)/ratingScale,
+			sigma: rd / ratingScale,
+		}
 	}
+	return calibrateForField(ratings, len(participants)), nil
+}
+
+// calibrateForField widens each rating's sigma for larger fields.
+func calibrateForField(ratings []playerRating, fieldSize int) []playerRating {
 	calibration := 1.0 + fieldSizeCalibrationRate*float64(fieldSize-2)
 	for i := range ratings {
 		ratings[i].sigma *= calibration
@@ -482,42 +475,6 @@ func reverseRounds(rounds []*roundtypes.Round) []*roundtypes.Round {
 	return out
 }
 
-// computeRating estimates a player's Bayesian skill distribution from their
-// observation history. Returns baseline if no observations are available.
-func computeRating(obs []observation, fieldSize int) playerRating {
-	if len(obs) == 0 {
-		return playerRating{mu: baselineMu, sigma: baselineSigma}
-	}
-
-	var weightedSum, totalWeight float64
-	for _, o := range obs {
-		w := math.Pow(decayFactor, float64(o.roundAge))
-		weightedSum += w * o.normalised
-		totalWeight += w
-	}
-	if totalWeight == 0 {
-		return playerRating{mu: baselineMu, sigma: baselineSigma}
-	}
-	mu := weightedSum / totalWeight
-
-	var varianceSum float64
-	for _, o := range obs {
-		w := math.Pow(decayFactor, float64(o.roundAge))
-		diff := o.normalised - mu
-		varianceSum += w * diff * diff
-	}
-	variance := varianceSum / totalWeight
-	sigma := math.Sqrt(variance)
-
-	minSigma := baselineSigma / math.Sqrt(float64(len(obs)+1))
-	if sigma < minSigma {
-		sigma = minSigma
-	}
-
-	_ = fieldSize
-	return playerRating{mu: mu, sigma: sigma}
-}
-
 // sampleNormal samples from N(mu, sigma) using the Box-Muller transform.
 func sampleNormal(mu, sigma float64) float64 {
 	if sigma <= 0 {
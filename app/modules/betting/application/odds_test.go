@@ -2,11 +2,14 @@ package bettingservice
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
+	ratingdomain "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/domain"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 )
@@ -14,7 +17,9 @@ import (
 // ---- fake round repo for odds tests ----
 
 type fakeOddsRoundRepo struct {
-	rounds []*roundtypes.Round
+	rounds       []*roundtypes.Round
+	layoutID     *uuid.UUID
+	layoutRounds []*roundtypes.Round
 }
 
 func (f *fakeOddsRoundRepo) GetRound(_ context.Context, _ bun.IDB, _ sharedtypes.GuildID, _ sharedtypes.RoundID) (*roundtypes.Round, error) {
@@ -33,6 +38,14 @@ func (f *fakeOddsRoundRepo) GetAllUpcomingRoundsInWindow(_ context.Context, _ bu
 	return nil, nil
 }
 
+func (f *fakeOddsRoundRepo) GetRoundCourseLayoutID(_ context.Context, _ bun.IDB, _ sharedtypes.GuildID, _ sharedtypes.RoundID) (*uuid.UUID, error) {
+	return f.layoutID, nil
+}
+
+func (f *fakeOddsRoundRepo) GetFinalizedRoundsOnLayout(_ context.Context, _ bun.IDB, _ sharedtypes.GuildID, _ uuid.UUID, _ time.Time) ([]*roundtypes.Round, error) {
+	return f.layoutRounds, nil
+}
+
 // ---- fake leaderboard repo for odds tests ----
 
 type fakeOddsLeaderboardRepo struct{}
@@ -41,6 +54,17 @@ func (f *fakeOddsLeaderboardRepo) GetLeaderboardByGuild(ctx context.Context, gui
 	return nil, nil
 }
 
+// ---- fake rating source for odds tests ----
+
+type fakeOddsRatingSource struct {
+	ratings map[sharedtypes.DiscordID]ratingservice.RatingView
+	err     error
+}
+
+func (f *fakeOddsRatingSource) GetRatings(_ context.Context, _ sharedtypes.GuildID, _ *uuid.UUID, _ []sharedtypes.DiscordID) (map[sharedtypes.DiscordID]ratingservice.RatingView, error) {
+	return f.ratings, f.err
+}
+
 // buildRound creates a finalized round with the given scores for a fixed set
 // of players. scoresByUser maps DiscordID → raw score (lower is better).
 func buildFinalizedRound(guildID sharedtypes.GuildID, finishedAt time.Time, scoresByUser map[sharedtypes.DiscordID]int) *roundtypes.Round {
@@ -74,10 +98,10 @@ func makeParticipants(ids ...sharedtypes.DiscordID) []targetParticipant {
 	return out
 }
 
-func TestOddsEngine_NoHistory_BaselineFallback(t *testing.T) {
-	// With no history every player gets the same baseline rating, so
+func TestOddsEngine_NoRatings_DefaultRating(t *testing.T) {
+	// With no ratings every player gets the default rating, so
 	// probabilities should be roughly equal — all within [min, max].
-	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil)
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{})
 
 	participants := makeParticipants("playerA", "playerB", "playerC", "playerD")
 	opts, err := engine.priceWinnerOptions(context.Background(), nil, "guild1", participants)
@@ -96,25 +120,19 @@ func TestOddsEngine_NoHistory_BaselineFallback(t *testing.T) {
 	}
 }
 
-func TestOddsEngine_WithHistory_BestPlayerFavoured(t *testing.T) {
-	// playerA consistently scores ~50 (good), playerB ~70, playerC ~90 (bad).
-	// After enough simulations playerA should have the highest win probability.
+func TestOddsEngine_BestRatedPlayerFavoured(t *testing.T) {
+	// playerA is rated well above playerB and playerC. After enough simulations
+	// playerA should have the highest win probability.
 	const guild = sharedtypes.GuildID("guild1")
 	playerA := sharedtypes.DiscordID("playerA")
 	playerB := sharedtypes.DiscordID("playerB")
 	playerC := sharedtypes.DiscordID("playerC")
 
-	now := time.Now()
-	rounds := make([]*roundtypes.Round, 0, 10)
-	for i := 0; i < 10; i++ {
-		rounds = append(rounds, buildFinalizedRound(guild, now.Add(-time.Duration(i)*24*time.Hour), map[sharedtypes.DiscordID]int{
-			playerA: 50,
-			playerB: 70,
-			playerC: 90,
-		}))
-	}
-
-	engine := newOddsEngine(&fakeOddsRoundRepo{rounds: rounds}, nil)
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{ratings: map[sharedtypes.DiscordID]ratingservice.RatingView{
+		playerA: {MemberID: playerA, Rating: 1900, RD: 60},
+		playerB: {MemberID: playerB, Rating: 1500, RD: 60},
+		playerC: {MemberID: playerC, Rating: 1100, RD: 60},
+	}})
 	participants := makeParticipants(playerA, playerB, playerC)
 	opts, err := engine.priceWinnerOptions(context.Background(), nil, guild, participants)
 	if err != nil {
@@ -149,14 +167,10 @@ func TestOddsEngine_ProbabilitiesWithinBounds(t *testing.T) {
 	playerA := sharedtypes.DiscordID("ace")
 	playerB := sharedtypes.DiscordID("novice")
 
-	rounds := []*roundtypes.Round{
-		buildFinalizedRound(guild, time.Now().Add(-24*time.Hour), map[sharedtypes.DiscordID]int{
-			playerA: 40,
-			playerB: 120,
-		}),
-	}
-
-	engine := newOddsEngine(&fakeOddsRoundRepo{rounds: rounds}, nil)
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{ratings: map[sharedtypes.DiscordID]ratingservice.RatingView{
+		playerA: {MemberID: playerA, Rating: 2400, RD: 40},
+		playerB: {MemberID: playerB, Rating: 700, RD: 40},
+	}})
 	opts, err := engine.priceWinnerOptions(context.Background(), nil, guild, makeParticipants(playerA, playerB))
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -176,8 +190,66 @@ func TestOddsEngine_ProbabilitiesWithinBounds(t *testing.T) {
 	}
 }
 
+func TestOddsEngine_RatingsOverrideHistory(t *testing.T) {
+	// History says playerA is far stronger, but the persisted ratings say playerB
+	// is; the engine must price from the ratings.
+	const guild = sharedtypes.GuildID("guild1")
+	playerA := sharedtypes.DiscordID("playerA")
+	playerB := sharedtypes.DiscordID("playerB")
+	playerC := sharedtypes.DiscordID("playerC")
+
+	rounds := []*roundtypes.Round{
+		buildFinalizedRound(guild, time.Now().Add(-24*time.Hour), map[sharedtypes.DiscordID]int{
+			playerA: 45,
+			playerB: 90,
+			playerC: 70,
+		}),
+	}
+
+	engine := newOddsEngine(&fakeOddsRoundRepo{rounds: rounds}, nil, &fakeOddsRatingSource{ratings: map[sharedtypes.DiscordID]ratingservice.RatingView{
+		playerA: {MemberID: playerA, Rating: 1300, RD: 50},
+		playerB: {MemberID: playerB, Rating: 1800, RD: 50},
+	}})
+
+	opts, err := engine.priceWinnerOptions(context.Background(), nil, guild, makeParticipants(playerA, playerB, playerC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts[0].optionKey != string(playerB) {
+		t.Errorf("expected highest-rated playerB to be favourite, got %s", opts[0].optionKey)
+	}
+}
+
+func TestOddsEngine_UnratedPlayerGetsNewRating(t *testing.T) {
+	// An unrated member prices exactly like one holding the default rating.
+	rated := sharedtypes.DiscordID("rated")
+	unrated := sharedtypes.DiscordID("unrated")
+	initial := ratingdomain.NewRating()
+
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{ratings: map[sharedtypes.DiscordID]ratingservice.RatingView{
+		rated: {MemberID: rated, Rating: initial.Rating, RD: initial.RD},
+	}})
+
+	ratings, err := engine.participantRatings(context.Background(), "guild1", makeParticipants(rated, unrated))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ratings[0] != ratings[1] {
+		t.Errorf("expected unrated player to match the default rating, got %+v and %+v", ratings[0], ratings[1])
+	}
+}
+
+func TestOddsEngine_RatingSourceError(t *testing.T) {
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{err: fmt.Errorf("rating store unavailable")})
+
+	_, err := engine.priceWinnerOptions(context.Background(), nil, "guild1", makeParticipants("playerA", "playerB"))
+	if err == nil {
+		t.Fatal("expected rating source error, got nil")
+	}
+}
+
 func TestOddsEngine_TooFewParticipants(t *testing.T) {
-	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil)
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{})
 	_, err := engine.priceWinnerOptions(context.Background(), nil, "guild1", makeParticipants("onlyOne"))
 	if err == nil {
 		t.Fatal("expected error for < 2 participants, got nil")
@@ -188,10 +260,10 @@ func TestOddsEngine_TooFewParticipants(t *testing.T) {
 // Placement pricing tests
 // ---------------------------------------------------------------------------
 
-func TestOddsEngine_PricePlacementOptions_BaselineFallback(t *testing.T) {
-	// With no history all players get baseline ratings, so placement options
+func TestOddsEngine_PricePlacementOptions_DefaultRating(t *testing.T) {
+	// With no ratings all players get the default rating, so placement options
 	// should be priced without error and respect the minimum odds floor.
-	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil)
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{})
 
 	participants := makeParticipants("alice", "bob", "carol")
 	opts, err := engine.pricePlacementOptions(context.Background(), nil, "guild1", participants, 2)
@@ -209,24 +281,18 @@ func TestOddsEngine_PricePlacementOptions_BaselineFallback(t *testing.T) {
 }
 
 func TestOddsEngine_PricePlacementOptions_BestPlayerLeastLikelyLast(t *testing.T) {
-	// playerA consistently scores low (good); playerC scores high (bad).
+	// playerA is rated highest; playerC is rated lowest.
 	// For "last place", playerA should have lower probability than playerC.
 	const guild = sharedtypes.GuildID("guild-placement")
 	playerA := sharedtypes.DiscordID("aces")
 	playerB := sharedtypes.DiscordID("mid")
 	playerC := sharedtypes.DiscordID("champ-reversed") // worst player
 
-	now := time.Now()
-	rounds := make([]*roundtypes.Round, 0, 8)
-	for i := 0; i < 8; i++ {
-		rounds = append(rounds, buildFinalizedRound(guild, now.Add(-time.Duration(i)*24*time.Hour), map[sharedtypes.DiscordID]int{
-			playerA: 45, // best
-			playerB: 65,
-			playerC: 90, // worst
-		}))
-	}
-
-	engine := newOddsEngine(&fakeOddsRoundRepo{rounds: rounds}, nil)
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{ratings: map[sharedtypes.DiscordID]ratingservice.RatingView{
+		playerA: {MemberID: playerA, Rating: 1850, RD: 60}, // best
+		playerB: {MemberID: playerB, Rating: 1500, RD: 60},
+		playerC: {MemberID: playerC, Rating: 1150, RD: 60}, // worst
+	}})
 	participants := makeParticipants(playerA, playerB, playerC)
 
 	// last place = position 3
@@ -247,7 +313,7 @@ func TestOddsEngine_PricePlacementOptions_BestPlayerLeastLikelyLast(t *testing.T
 }
 
 func TestOddsEngine_PricePlacementOptions_TooFewParticipants(t *testing.T) {
-	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil)
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{})
 
 	_, err := engine.pricePlacementOptions(context.Background(), nil, "guild1", makeParticipants("solo"), 2)
 	if err == nil {
@@ -262,14 +328,14 @@ func TestOddsEngine_PricePlacementOptions_TooFewParticipants(t *testing.T) {
 func TestOddsEngine_PriceOverUnderOptions_GeneratesPairs(t *testing.T) {
 	// Each participant should get exactly one _over and one _under option,
 	// both with ParticipantMemberID matching the player's Discord ID.
-	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil)
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{})
 
 	playerA := sharedtypes.DiscordID("alice")
 	playerB := sharedtypes.DiscordID("bob")
 	playerC := sharedtypes.DiscordID("carol")
 	participants := makeParticipants(playerA, playerB, playerC)
 
-	opts, err := engine.priceOverUnderOptions(context.Background(), nil, "guild1", participants)
+	opts, err := engine.priceOverUnderOptions(context.Background(), nil, "guild1", sharedtypes.RoundID(uuid.Nil), participants)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -324,8 +390,8 @@ func TestOddsEngine_PriceOverUnderOptions_LineFromHistory(t *testing.T) {
 		}))
 	}
 
-	engine := newOddsEngine(&fakeOddsRoundRepo{rounds: rounds}, nil)
-	opts, err := engine.priceOverUnderOptions(context.Background(), nil, guild, makeParticipants(playerA, playerB))
+	engine := newOddsEngine(&fakeOddsRoundRepo{rounds: rounds}, nil, &fakeOddsRatingSource{})
+	opts, err := engine.priceOverUnderOptions(context.Background(), nil, guild, sharedtypes.RoundID(uuid.Nil), makeParticipants(playerA, playerB))
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -346,15 +412,58 @@ func TestOddsEngine_PriceOverUnderOptions_LineFromHistory(t *testing.T) {
 	}
 }
 
+func TestOddsEngine_PriceOverUnderOptions_LineFromLayoutHistory(t *testing.T) {
+	// On a linked layout, a player's line comes from their rounds on that layout;
+	// players without layout history keep their guild-wide line.
+	const guild = sharedtypes.GuildID("guild-layout")
+	playerA := sharedtypes.DiscordID("layoutplayer")
+	playerB := sharedtypes.DiscordID("newcomer")
+	layoutID := uuid.New()
+
+	now := time.Now()
+	var rounds, layoutRounds []*roundtypes.Round
+	for i := 0; i < 4; i++ {
+		rounds = append(rounds, buildFinalizedRound(guild, now.Add(-time.Duration(i)*24*time.Hour), map[sharedtypes.DiscordID]int{
+			playerA: 2,
+			playerB: 6,
+		}))
+		layoutRounds = append(layoutRounds, buildFinalizedRound(guild, now.Add(-time.Duration(i)*24*time.Hour), map[sharedtypes.DiscordID]int{
+			playerA: -3,
+		}))
+	}
+
+	engine := newOddsEngine(&fakeOddsRoundRepo{rounds: rounds, layoutID: &layoutID, layoutRounds: layoutRounds}, nil, &fakeOddsRatingSource{})
+	opts, err := engine.priceOverUnderOptions(context.Background(), nil, guild, sharedtypes.RoundID(uuid.New()), makeParticipants(playerA, playerB))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		string(playerA) + "_over": fmt.Sprintf(`{"line":-3,"layout_id":%q}`, layoutID.String()),
+		string(playerB) + "_over": `{"line":6}`,
+	}
+	for _, o := range opts {
+		if meta, ok := want[o.optionKey]; ok {
+			if o.metadata != meta {
+				t.Errorf("%s metadata: want %s, got %s", o.optionKey, meta, o.metadata)
+			}
+			delete(want, o.optionKey)
+		}
+	}
+	if len(want) != 0 {
+		t.Errorf("missing options: %v", want)
+	}
+}
+
 func TestOddsEngine_PriceOverUnderOptions_ComplementaryProbabilities(t *testing.T) {
 	// For each player, over probabilityBps + under probabilityBps should equal
 	// 10000 (100% in basis points) within rounding tolerance of ±1.
-	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil)
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{})
 
 	players := []sharedtypes.DiscordID{"p1", "p2", "p3"}
 	participants := makeParticipants(players...)
 
-	opts, err := engine.priceOverUnderOptions(context.Background(), nil, "guild1", participants)
+	opts, err := engine.priceOverUnderOptions(context.Background(), nil, "guild1", sharedtypes.RoundID(uuid.Nil), participants)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -379,9 +488,9 @@ func TestOddsEngine_PriceOverUnderOptions_ComplementaryProbabilities(t *testing.
 }
 
 func TestOddsEngine_PriceOverUnderOptions_TooFewParticipants(t *testing.T) {
-	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil)
+	engine := newOddsEngine(&fakeOddsRoundRepo{}, nil, &fakeOddsRatingSource{})
 
-	_, err := engine.priceOverUnderOptions(context.Background(), nil, "guild1", makeParticipants("solo"))
+	_, err := engine.priceOverUnderOptions(context.Background(), nil, "guild1", sharedtypes.RoundID(uuid.Nil), makeParticipants("solo"))
 	if err == nil {
 		t.Fatal("expected error for single participant, got nil")
 	}
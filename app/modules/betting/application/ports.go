@@ -5,10 +5,14 @@ import (
 	"time"
 
 	guildtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/guild"
+	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
 	bettingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/betting/infrastructure/repositories"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
 	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
 	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -40,6 +44,11 @@ type bettingRepository interface {
 	ListBetsForMarket(ctx context.Context, db bun.IDB, marketID int64) ([]bettingdb.Bet, error)
 	ListBetsForUserAndMarket(ctx context.Context, db bun.IDB, clubUUID, userUUID uuid.UUID, marketID int64) ([]bettingdb.Bet, error)
 	CreateAuditLog(ctx context.Context, db bun.IDB, log *bettingdb.AuditLog) error
+	CreateTagOffer(ctx context.Context, db bun.IDB, offer *bettingdb.TagOffer) error
+	GetTagOfferForUpdate(ctx context.Context, db bun.IDB, clubUUID uuid.UUID, offerID int64) (*bettingdb.TagOffer, error)
+	UpdateTagOffer(ctx context.Context, db bun.IDB, offer *bettingdb.TagOffer) error
+	ListOpenTagOffers(ctx context.Context, db bun.IDB, clubUUID uuid.UUID, now time.Time, limit int) ([]bettingdb.TagOffer, error)
+	ListSettlingTagOffers(ctx context.Context, db bun.IDB, limit int) ([]bettingdb.TagOffer, error)
 	AcquireWalletBalance(ctx context.Context, db bun.IDB, clubUUID, userUUID uuid.UUID, seasonID string) (*bettingdb.WalletBalance, error)
 	ApplyWalletBalanceDelta(ctx context.Context, db bun.IDB, clubUUID, userUUID uuid.UUID, seasonID string, balanceDelta, reservedDelta int) error
 }
@@ -66,6 +75,19 @@ type roundRepository interface {
 	GetUpcomingRounds(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) ([]*roundtypes.Round, error)
 	GetFinalizedRoundsAfter(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, startTime time.Time) ([]*roundtypes.Round, error)
 	GetAllUpcomingRoundsInWindow(ctx context.Context, db bun.IDB, lookahead time.Duration) ([]*roundtypes.Round, error)
+	GetRoundCourseLayoutID(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*uuid.UUID, error)
+	GetFinalizedRoundsOnLayout(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error)
+}
+
+// tagService reads and moves leaderboard tags. Settled tag offers move tags
+// through the leaderboard's batch assignment funnel so tag history stays whole.
+type tagService interface {
+	GetTagList(ctx context.Context, guildID sharedtypes.GuildID, clubUUID *string) ([]leaderboardservice.MemberTagView, error)
+	ExecuteBatchTagAssignment(ctx context.Context, guildID sharedtypes.GuildID, requests []sharedtypes.TagAssignmentRequest, updateID sharedtypes.RoundID, source sharedtypes.ServiceUpdateSource) (results.OperationResult[leaderboardtypes.LeaderboardData, error], error)
+}
+
+type ratingSource interface {
+	GetRatings(ctx context.Context, guildID sharedtypes.GuildID, layoutID *uuid.UUID, memberIDs []sharedtypes.DiscordID) (map[sharedtypes.DiscordID]ratingservice.RatingView, error)
 }
 
 // ---------------------------------------------------------------------------
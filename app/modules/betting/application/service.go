@@ -22,6 +22,7 @@ type BettingService struct {
 	tracer          trace.Tracer
 	db              *bun.DB
 	oddsEngine      *oddsEngine
+	tags            tagService
 }
 
 func NewService(
@@ -30,6 +31,7 @@ func NewService(
 	guildRepo guildRepository,
 	leaderboardRepo leaderboardRepository,
 	roundRepo roundRepository,
+	ratings ratingSource,
 	metrics bettingmetrics.BettingMetrics,
 	logger *slog.Logger,
 	tracer trace.Tracer,
@@ -45,9 +47,16 @@ func NewService(
 		logger:          logger,
 		tracer:          tracer,
 		db:              db,
-		oddsEngine:      newOddsEngine(roundRepo, leaderboardRepo),
+		oddsEngine:      newOddsEngine(roundRepo, leaderboardRepo, ratings),
 	}
 }
 
+// WithTagService enables tag offers. Accepted offers move tags through the
+// leaderboard service.
+func (s *BettingService) WithTagService(tags tagService) *BettingService {
+	s.tags = tags
+	return s
+}
+
 // compile-time interface check
 var _ Service = (*BettingService)(nil)
@@ -0,0 +1,804 @@
+package bettingservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	guildtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/guild"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	bettingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/betting/infrastructure/repositories"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (s *BettingService) ListTagOffers(ctx context.Context, clubUUID, userUUID uuid.UUID) (*TagOfferBoard, error) {
+	start := time.Now()
+	s.metrics.RecordOperationAttempt(ctx, "ListTagOffers", "betting")
+
+	if s.tracer != nil {
+		var span trace.Span
+		ctx, span = s.tracer.Start(ctx, "betting.ListTagOffers")
+		defer span.End()
+		span.SetAttributes(attribute.String("betting.club_uuid", clubUUID.String()))
+	}
+
+	guildID, access, err := s.resolveAccess(ctx, nil, clubUUID, userUUID)
+	if err != nil {
+		s.metrics.RecordOperationFailure(ctx, "ListTagOffers", "betting")
+		return nil, err
+	}
+	if access.State == guildtypes.FeatureAccessStateDisabled {
+		s.metrics.RecordAccessDenied(ctx, "disabled")
+		s.metrics.RecordOperationFailure(ctx, "ListTagOffers", "betting")
+		return nil, ErrFeatureDisabled
+	}
+
+	now := time.Now().UTC()
+	offers, err := s.repo.ListOpenTagOffers(ctx, nil, clubUUID, now, tagOfferListSize)
+	if err != nil {
+		s.metrics.RecordOperationFailure(ctx, "ListTagOffers", "betting")
+		return nil, fmt.Errorf("load tag offers: %w", err)
+	}
+
+	board := &TagOfferBoard{
+		ClubUUID:    clubUUID.String(),
+		GuildID:     string(guildID),
+		AccessState: string(access.State),
+		ReadOnly:    access.State != guildtypes.FeatureAccessStateEnabled,
+		Offers:      make([]BettingTagOffer, 0, len(offers)),
+	}
+	for _, offer := range offers {
+		board.Offers = append(board.Offers, toBettingTagOffer(offer, now))
+	}
+
+	s.metrics.RecordOperationSuccess(ctx, "ListTagOffers", "betting")
+	s.metrics.RecordOperationDuration(ctx, "ListTagOffers", "betting", time.Since(start))
+
+	return board, nil
+}
+
+func (s *BettingService) CreateTagOffer(ctx context.Context, req CreateTagOfferRequest) (*BettingTagOffer, error) {
+	start := time.Now()
+	s.metrics.RecordOperationAttempt(ctx, "CreateTagOffer", "betting")
+
+	if s.tracer != nil {
+		var span trace.Span
+		ctx, span = s.tracer.Start(ctx, "betting.CreateTagOffer")
+		defer span.End()
+		span.SetAttributes(
+			attribute.String("betting.club_uuid", req.ClubUUID.String()),
+			attribute.Int("betting.tag_number", req.TagNumber),
+			attribute.Int("betting.price", req.Price),
+		)
+	}
+
+	if s.tags == nil {
+		s.metrics.RecordOperationFailure(ctx, "CreateTagOffer", "betting")
+		return nil, ErrTagOffersUnavailable
+	}
+	if req.ClubUUID == uuid.Nil || req.UserUUID == uuid.Nil {
+		s.metrics.RecordOperationFailure(ctx, "CreateTagOffer", "betting")
+		return nil, ErrMembershipRequired
+	}
+	if req.Price <= 0 {
+		s.metrics.RecordOperationFailure(ctx, "CreateTagOffer", "betting")
+		return nil, ErrTagOfferPriceInvalid
+	}
+	if req.TagNumber <= 0 {
+		s.metrics.RecordOperationFailure(ctx, "CreateTagOffer", "betting")
+		return nil, ErrTagOfferTagInvalid
+	}
+
+	run := func(ctx context.Context, db bun.IDB) (*BettingTagOffer, error) {
+		guildID, err := s.requireTagOfferAccess(ctx, db, req.ClubUUID, req.UserUUID, false)
+		if err != nil {
+			return nil, err
+		}
+
+		buyerID, err := s.memberDiscordID(ctx, db, req.UserUUID)
+		if err != nil {
+			return nil, err
+		}
+		if buyerID == "" {
+			return nil, ErrMembershipRequired
+		}
+
+		holders, _, err := s.loadTagHolders(ctx, guildID, buyerID)
+		if err != nil {
+			return nil, err
+		}
+		holderID, held := holders[sharedtypes.TagNumber(req.TagNumber)]
+		if !held || holderID == buyerID {
+			return nil, ErrTagOfferTagInvalid
+		}
+
+		seasonID, err := s.activeSeasonID(ctx, db, guildID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Points are not reserved while the offer is open; acceptance re-checks
+		// the buyer's balance under the wallet lock.
+		wallet, err := s.repo.AcquireWalletBalance(ctx, db, req.ClubUUID, req.UserUUID, seasonID)
+		if err != nil {
+			return nil, fmt.Errorf("acquire betting wallet lock: %w", err)
+		}
+		if req.Price > wallet.Balance-wallet.Reserved {
+			return nil, ErrInsufficientBalance
+		}
+
+		now := time.Now().UTC()
+		offer := &bettingdb.TagOffer{
+			ClubUUID:       req.ClubUUID,
+			SeasonID:       seasonID,
+			TagNumber:      req.TagNumber,
+			Price:          req.Price,
+			BuyerUUID:      req.UserUUID,
+			BuyerMemberID:  string(buyerID),
+			HolderMemberID: string(holderID),
+			Status:         openTagOfferStatus,
+			ExpiresAt:      now.Add(tagOfferTTL),
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		if err := s.repo.CreateTagOffer(ctx, db, offer); err != nil {
+			return nil, fmt.Errorf("create tag offer: %w", err)
+		}
+
+		if err := s.repo.CreateAuditLog(ctx, db, &bettingdb.AuditLog{
+			ClubUUID:      req.ClubUUID,
+			ActorUserUUID: &req.UserUUID,
+			Action:        "tag_offer_created",
+			Metadata:      fmt.Sprintf("offer_id=%d tag=%d price=%d holder=%s", offer.ID, offer.TagNumber, offer.Price, offer.HolderMemberID),
+		}); err != nil {
+			return nil, fmt.Errorf("create betting audit log: %w", err)
+		}
+
+		result := toBettingTagOffer(*offer, now)
+		return &result, nil
+	}
+
+	result, err := runInTx(ctx, s.db, &sql.TxOptions{}, run)
+	if err != nil {
+		s.metrics.RecordOperationFailure(ctx, "CreateTagOffer", "betting")
+		if span := trace.SpanFromContext(ctx); span.IsRecording() {
+			span.RecordError(err)
+		}
+		s.logWarn(ctx, "betting.tag_offer.create.failed", "CreateTagOffer failed",
+			attr.UUIDValue("club_uuid", req.ClubUUID),
+			attr.Int("tag_number", req.TagNumber),
+			attr.Error(err),
+		)
+		return nil, err
+	}
+
+	s.metrics.RecordOperationSuccess(ctx, "CreateTagOffer", "betting")
+	s.metrics.RecordOperationDuration(ctx, "CreateTagOffer", "betting", time.Since(start))
+
+	s.logInfo(ctx, "betting.tag_offer.created", "tag offer created",
+		attr.UUIDValue("club_uuid", req.ClubUUID),
+		attr.Int("tag_number", req.TagNumber),
+		attr.Int("price", req.Price),
+	)
+
+	return result, nil
+}
+
+func (s *BettingService) AcceptTagOffer(ctx context.Context, req TagOfferActionRequest) (*TagOfferSettlement, error) {
+	start := time.Now()
+	s.metrics.RecordOperationAttempt(ctx, "AcceptTagOffer", "betting")
+
+	if s.tracer != nil {
+		var span trace.Span
+		ctx, span = s.tracer.Start(ctx, "betting.AcceptTagOffer")
+		defer span.End()
+		span.SetAttributes(
+			attribute.String("betting.club_uuid", req.ClubUUID.String()),
+			attribute.Int64("betting.tag_offer_id", req.OfferID),
+		)
+	}
+
+	if s.tags == nil {
+		s.metrics.RecordOperationFailure(ctx, "AcceptTagOffer", "betting")
+		return nil, ErrTagOffersUnavailable
+	}
+	if req.ClubUUID == uuid.Nil || req.UserUUID == uuid.Nil {
+		s.metrics.RecordOperationFailure(ctx, "AcceptTagOffer", "betting")
+		return nil, ErrMembershipRequired
+	}
+	if req.OfferID <= 0 {
+		s.metrics.RecordOperationFailure(ctx, "AcceptTagOffer", "betting")
+		return nil, ErrTagOfferNotFound
+	}
+
+	// The buyer's points are reserved and the offer is marked settling in one wallet
+	// transaction; the tag then moves in the leaderboard's own transaction, and only
+	// then are the reserved points paid to the seller. If the move fails, the offer
+	// stays settling with the points in escrow and the market worker retries it.
+	run := func(ctx context.Context, db bun.IDB) (*TagOfferSettlement, error) {
+		guildID, err := s.requireTagOfferAccess(ctx, db, req.ClubUUID, req.UserUUID, false)
+		if err != nil {
+			return nil, err
+		}
+
+		offer, err := s.repo.GetTagOfferForUpdate(ctx, db, req.ClubUUID, req.OfferID)
+		if err != nil {
+			return nil, fmt.Errorf("load tag offer: %w", err)
+		}
+		if offer == nil {
+			return nil, ErrTagOfferNotFound
+		}
+		now := time.Now().UTC()
+		if effectiveTagOfferStatus(offer.Status, offer.ExpiresAt, now) != openTagOfferStatus {
+			return nil, ErrTagOfferClosed
+		}
+		if offer.BuyerUUID == req.UserUUID {
+			return nil, ErrTagOfferNotHolder
+		}
+
+		sellerID, err := s.memberDiscordID(ctx, db, req.UserUUID)
+		if err != nil {
+			return nil, err
+		}
+		holders, _, err := s.loadTagHolders(ctx, guildID, sharedtypes.DiscordID(offer.BuyerMemberID))
+		if err != nil {
+			return nil, err
+		}
+		if sellerID == "" || holders[sharedtypes.TagNumber(offer.TagNumber)] != sellerID {
+			return nil, ErrTagOfferNotHolder
+		}
+
+		buyerWallet, err := s.repo.AcquireWalletBalance(ctx, db, req.ClubUUID, offer.BuyerUUID, offer.SeasonID)
+		if err != nil {
+			return nil, fmt.Errorf("acquire betting wallet lock: %w", err)
+		}
+		if offer.Price > buyerWallet.Balance-buyerWallet.Reserved {
+			return nil, ErrInsufficientBalance
+		}
+
+		if err := s.transferTagOfferPoints(ctx, db, req.ClubUUID, offer.SeasonID, &req.UserUUID, tagOfferReservedEntry, []tagOfferPointsLeg{
+			{userUUID: offer.BuyerUUID, amount: -offer.Price, reserved: offer.Price, reason: fmt.Sprintf("reserved for tag #%d", offer.TagNumber)},
+		}); err != nil {
+			return nil, err
+		}
+
+		offer.Status = settlingTagOfferStatus
+		offer.SellerUUID = &req.UserUUID
+		offer.SellerMemberID = string(sellerID)
+		offer.ClosedAt = &now
+		offer.UpdatedAt = now
+		if err := s.repo.UpdateTagOffer(ctx, db, offer); err != nil {
+			return nil, fmt.Errorf("update tag offer: %w", err)
+		}
+
+		if err := s.repo.CreateAuditLog(ctx, db, &bettingdb.AuditLog{
+			ClubUUID:      req.ClubUUID,
+			ActorUserUUID: &req.UserUUID,
+			Action:        "tag_offer_accepted",
+			Metadata: fmt.Sprintf("offer_id=%d tag=%d price=%d buyer=%s seller=%s season_id=%s",
+				offer.ID, offer.TagNumber, offer.Price, offer.BuyerMemberID, sellerID, offer.SeasonID),
+		}); err != nil {
+			return nil, fmt.Errorf("create betting audit log: %w", err)
+		}
+
+		return toTagOfferSettlement(*offer, now), nil
+	}
+
+	result, err := runInTx(ctx, s.db, &sql.TxOptions{}, run)
+	if err != nil {
+		s.metrics.RecordOperationFailure(ctx, "AcceptTagOffer", "betting")
+		if span := trace.SpanFromContext(ctx); span.IsRecording() {
+			span.RecordError(err)
+		}
+		s.logError(ctx, "betting.tag_offer.accept.failed", "AcceptTagOffer failed", err,
+			attr.UUIDValue("club_uuid", req.ClubUUID),
+			attr.Int("offer_id", int(req.OfferID)),
+		)
+		return nil, err
+	}
+
+	if settled, err := s.settleTagOffer(ctx, req.ClubUUID, req.OfferID); err != nil {
+		s.logWarn(ctx, "betting.tag_offer.settle.deferred", "tag offer accepted; tag move left for retry",
+			attr.UUIDValue("club_uuid", req.ClubUUID),
+			attr.Int("offer_id", int(req.OfferID)),
+			attr.Error(err),
+		)
+	} else {
+		result = settled
+	}
+
+	s.metrics.RecordOperationSuccess(ctx, "AcceptTagOffer", "betting")
+	s.metrics.RecordOperationDuration(ctx, "AcceptTagOffer", "betting", time.Since(start))
+
+	s.logInfo(ctx, "betting.tag_offer.accepted", "tag offer accepted",
+		attr.UUIDValue("club_uuid", req.ClubUUID),
+		attr.Int("offer_id", int(req.OfferID)),
+		attr.Int("tag_number", result.Offer.TagNumber),
+		attr.Int("price", result.Offer.Price),
+		attr.String("status", result.Offer.Status),
+	)
+
+	return result, nil
+}
+
+// SettlePendingTagOffers retries the tag move of accepted offers whose tag has not
+// moved yet. It returns how many offers were closed.
+func (s *BettingService) SettlePendingTagOffers(ctx context.Context) (int, error) {
+	offers, err := s.repo.ListSettlingTagOffers(ctx, nil, tagOfferListSize)
+	if err != nil {
+		return 0, fmt.Errorf("load settling tag offers: %w", err)
+	}
+
+	closed := 0
+	for _, offer := range offers {
+		settled, err := s.settleTagOffer(ctx, offer.ClubUUID, offer.ID)
+		if err != nil {
+			s.logWarn(ctx, "betting.tag_offer.settle.failed", "tag offer settlement retry failed",
+				attr.UUIDValue("club_uuid", offer.ClubUUID),
+				attr.Int("offer_id", int(offer.ID)),
+				attr.Error(err),
+			)
+			continue
+		}
+		if settled.Offer.Status != settlingTagOfferStatus {
+			closed++
+		}
+	}
+	return closed, nil
+}
+
+// settleTagOffer moves the tag of an accepted offer, then pays the buyer's
+// reserved points to the seller and closes the offer in one wallet transaction.
+// It is safe to repeat: the offer row is locked for the attempt, a tag the buyer
+// already holds is not moved again, and if the seller lost the tag before it could
+// move the reservation is released back to the buyer instead. A move the
+// leaderboard rejects is recorded on the offer, which stays settling with the
+// points still reserved.
+func (s *BettingService) settleTagOffer(ctx context.Context, clubUUID uuid.UUID, offerID int64) (*TagOfferSettlement, error) {
+	run := func(ctx context.Context, db bun.IDB) (*TagOfferSettlement, error) {
+		offer, err := s.repo.GetTagOfferForUpdate(ctx, db, clubUUID, offerID)
+		if err != nil {
+			return nil, fmt.Errorf("load tag offer: %w", err)
+		}
+		if offer == nil {
+			return nil, ErrTagOfferNotFound
+		}
+		now := time.Now().UTC()
+		if offer.Status != settlingTagOfferStatus {
+			return toTagOfferSettlement(*offer, now), nil
+		}
+
+		guildID, err := s.userRepo.GetDiscordGuildIDByClubUUID(ctx, db, clubUUID)
+		if err != nil {
+			return nil, fmt.Errorf("resolve club guild id: %w", err)
+		}
+		buyerID := sharedtypes.DiscordID(offer.BuyerMemberID)
+		holders, tags, err := s.loadTagHolders(ctx, guildID, buyerID)
+		if err != nil {
+			return nil, err
+		}
+
+		tag := sharedtypes.TagNumber(offer.TagNumber)
+		sellerID := sharedtypes.DiscordID(offer.SellerMemberID)
+		var sellerTag sharedtypes.TagNumber
+
+		switch holders[tag] {
+		case buyerID:
+			// An earlier attempt moved the tag but did not get to close the offer.
+			sellerTag = tags[sellerID]
+		case sellerID:
+			sellerTag = tags[buyerID]
+			moved, err := s.tags.ExecuteBatchTagAssignment(ctx, guildID, tagOfferAssignments(buyerID, sellerID, tag, sellerTag), sharedtypes.RoundID(uuid.Nil), leaderboardservice.ServiceUpdateSourceTagOffer)
+			if err == nil && moved.IsFailure() {
+				err = *moved.Failure
+			}
+			if err != nil {
+				return s.deferTagOfferSettlement(ctx, db, offer, now, fmt.Errorf("move offered tag: %w", err))
+			}
+		default:
+			return s.refundTagOffer(ctx, db, offer, now)
+		}
+
+		if offer.SellerUUID == nil {
+			return nil, fmt.Errorf("tag offer %d has no seller", offer.ID)
+		}
+		if _, err := s.lockTagOfferWallets(ctx, db, clubUUID, offer.SeasonID, offer.BuyerUUID, *offer.SellerUUID); err != nil {
+			return nil, err
+		}
+		if err := s.transferTagOfferPoints(ctx, db, clubUUID, offer.SeasonID, nil, tagOfferSettlementEntry, []tagOfferPointsLeg{
+			{userUUID: offer.BuyerUUID, reserved: -offer.Price},
+			{userUUID: *offer.SellerUUID, amount: offer.Price, reason: fmt.Sprintf("sold tag #%d", offer.TagNumber)},
+		}); err != nil {
+			return nil, err
+		}
+
+		offer.Status = acceptedTagOfferStatus
+		offer.SellerTag = int(sellerTag)
+		offer.SettleError = ""
+		offer.UpdatedAt = now
+		if err := s.repo.UpdateTagOffer(ctx, db, offer); err != nil {
+			return nil, fmt.Errorf("update tag offer: %w", err)
+		}
+
+		if err := s.repo.CreateAuditLog(ctx, db, &bettingdb.AuditLog{
+			ClubUUID: clubUUID,
+			Action:   "tag_offer_settled",
+			Metadata: fmt.Sprintf("offer_id=%d tag=%d buyer=%s seller=%s seller_tag=%d",
+				offer.ID, offer.TagNumber, buyerID, sellerID, sellerTag),
+		}); err != nil {
+			return nil, fmt.Errorf("create betting audit log: %w", err)
+		}
+
+		return toTagOfferSettlement(*offer, now), nil
+	}
+
+	return runInTx(ctx, s.db, &sql.TxOptions{}, run)
+}
+
+// deferTagOfferSettlement records why a tag move failed and leaves the offer
+// settling for the next attempt.
+func (s *BettingService) deferTagOfferSettlement(
+	ctx context.Context,
+	db bun.IDB,
+	offer *bettingdb.TagOffer,
+	now time.Time,
+	cause error,
+) (*TagOfferSettlement, error) {
+	offer.SettleError = cause.Error()
+	offer.UpdatedAt = now
+	if err := s.repo.UpdateTagOffer(ctx, db, offer); err != nil {
+		return nil, fmt.Errorf("update tag offer: %w", err)
+	}
+
+	s.logWarn(ctx, "betting.tag_offer.settle.deferred", "tag offer tag move failed; will retry",
+		attr.UUIDValue("club_uuid", offer.ClubUUID),
+		attr.Int("offer_id", int(offer.ID)),
+		attr.Error(cause),
+	)
+	return toTagOfferSettlement(*offer, now), nil
+}
+
+// refundTagOffer releases the buyer's reserved points when the seller no longer
+// holds the tag, and closes the offer as failed. The seller was never paid, so the
+// refund cannot be blocked by the seller's balance.
+func (s *BettingService) refundTagOffer(
+	ctx context.Context,
+	db bun.IDB,
+	offer *bettingdb.TagOffer,
+	now time.Time,
+) (*TagOfferSettlement, error) {
+	if _, err := s.repo.AcquireWalletBalance(ctx, db, offer.ClubUUID, offer.BuyerUUID, offer.SeasonID); err != nil {
+		return nil, fmt.Errorf("acquire betting wallet lock: %w", err)
+	}
+	if err := s.transferTagOfferPoints(ctx, db, offer.ClubUUID, offer.SeasonID, nil, tagOfferRefundEntry, []tagOfferPointsLeg{
+		{userUUID: offer.BuyerUUID, amount: offer.Price, reserved: -offer.Price, reason: fmt.Sprintf("refund for tag #%d", offer.TagNumber)},
+	}); err != nil {
+		return nil, err
+	}
+
+	offer.Status = failedTagOfferStatus
+	offer.SettleError = ErrTagOfferNotHolder.Error()
+	offer.UpdatedAt = now
+	if err := s.repo.UpdateTagOffer(ctx, db, offer); err != nil {
+		return nil, fmt.Errorf("update tag offer: %w", err)
+	}
+
+	if err := s.repo.CreateAuditLog(ctx, db, &bettingdb.AuditLog{
+		ClubUUID: offer.ClubUUID,
+		Action:   "tag_offer_refunded",
+		Metadata: fmt.Sprintf("offer_id=%d tag=%d price=%d buyer=%s seller=%s season_id=%s",
+			offer.ID, offer.TagNumber, offer.Price, offer.BuyerMemberID, offer.SellerMemberID, offer.SeasonID),
+	}); err != nil {
+		return nil, fmt.Errorf("create betting audit log: %w", err)
+	}
+
+	return toTagOfferSettlement(*offer, now), nil
+}
+
+// tagRemoval as a batch assignment's tag releases the member's current tag.
+const tagRemoval sharedtypes.TagNumber = 0
+
+// tagOfferAssignments gives the buyer the offered tag and the seller the buyer's
+// previous tag. When the buyer had no tag, the seller's tag is released instead.
+func tagOfferAssignments(buyerID, sellerID sharedtypes.DiscordID, tag, buyerTag sharedtypes.TagNumber) []sharedtypes.TagAssignmentRequest {
+	seller := sharedtypes.TagAssignmentRequest{UserID: sellerID, TagNumber: buyerTag}
+	if buyerTag == 0 {
+		seller.TagNumber = tagRemoval
+	}
+	return []sharedtypes.TagAssignmentRequest{
+		{UserID: buyerID, TagNumber: tag},
+		seller,
+	}
+}
+
+// tagOfferPointsLeg is one wallet's side of a tag offer points transfer. amount
+// changes the wallet's available points and is journaled; reserved moves points
+// into (positive) or out of (negative) escrow.
+type tagOfferPointsLeg struct {
+	userUUID uuid.UUID
+	amount   int
+	reserved int
+	reason   string
+}
+
+// lockTagOfferWallets locks both wallets in a fixed order so crossing settlements
+// cannot deadlock.
+func (s *BettingService) lockTagOfferWallets(
+	ctx context.Context,
+	db bun.IDB,
+	clubUUID uuid.UUID,
+	seasonID string,
+	a, b uuid.UUID,
+) (map[uuid.UUID]*bettingdb.WalletBalance, error) {
+	if b.String() < a.String() {
+		a, b = b, a
+	}
+	locked := make(map[uuid.UUID]*bettingdb.WalletBalance, 2)
+	for _, userUUID := range []uuid.UUID{a, b} {
+		wallet, err := s.repo.AcquireWalletBalance(ctx, db, clubUUID, userUUID, seasonID)
+		if err != nil {
+			return nil, fmt.Errorf("acquire betting wallet lock: %w", err)
+		}
+		locked[userUUID] = wallet
+	}
+	return locked, nil
+}
+
+// transferTagOfferPoints journals and applies each leg of a tag offer transfer.
+// A leg that only releases escrow changes no available points and is not
+// journaled. The wallets must already be locked.
+func (s *BettingService) transferTagOfferPoints(
+	ctx context.Context,
+	db bun.IDB,
+	clubUUID uuid.UUID,
+	seasonID string,
+	actor *uuid.UUID,
+	entryType string,
+	legs []tagOfferPointsLeg,
+) error {
+	for _, leg := range legs {
+		if leg.amount != 0 {
+			if err := s.repo.CreateWalletJournalEntry(ctx, db, &bettingdb.WalletJournalEntry{
+				ClubUUID:  clubUUID,
+				UserUUID:  leg.userUUID,
+				SeasonID:  seasonID,
+				EntryType: entryType,
+				Amount:    leg.amount,
+				Reason:    leg.reason,
+				CreatedBy: createdByValue(actor, "system:tag_offer"),
+			}); err != nil {
+				return fmt.Errorf("create betting wallet journal entry: %w", err)
+			}
+		}
+		// Available points are balance minus reserved, so escrowed points stay in
+		// the balance until they are paid out or released.
+		if err := s.repo.ApplyWalletBalanceDelta(ctx, db, clubUUID, leg.userUUID, seasonID, leg.amount+leg.reserved, leg.reserved); err != nil {
+			return fmt.Errorf("update wallet balance on tag offer: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *BettingService) CancelTagOffer(ctx context.Context, req TagOfferActionRequest) (*BettingTagOffer, error) {
+	start := time.Now()
+	s.metrics.RecordOperationAttempt(ctx, "CancelTagOffer", "betting")
+
+	if s.tracer != nil {
+		var span trace.Span
+		ctx, span = s.tracer.Start(ctx, "betting.CancelTagOffer")
+		defer span.End()
+		span.SetAttributes(
+			attribute.String("betting.club_uuid", req.ClubUUID.String()),
+			attribute.Int64("betting.tag_offer_id", req.OfferID),
+		)
+	}
+
+	if s.tags == nil {
+		s.metrics.RecordOperationFailure(ctx, "CancelTagOffer", "betting")
+		return nil, ErrTagOffersUnavailable
+	}
+	if req.ClubUUID == uuid.Nil || req.UserUUID == uuid.Nil {
+		s.metrics.RecordOperationFailure(ctx, "CancelTagOffer", "betting")
+		return nil, ErrMembershipRequired
+	}
+	if req.OfferID <= 0 {
+		s.metrics.RecordOperationFailure(ctx, "CancelTagOffer", "betting")
+		return nil, ErrTagOfferNotFound
+	}
+
+	run := func(ctx context.Context, db bun.IDB) (*BettingTagOffer, error) {
+		// No points move when an offer is withdrawn, so a frozen club may still do it.
+		guildID, err := s.requireTagOfferAccess(ctx, db, req.ClubUUID, req.UserUUID, true)
+		if err != nil {
+			return nil, err
+		}
+
+		offer, err := s.repo.GetTagOfferForUpdate(ctx, db, req.ClubUUID, req.OfferID)
+		if err != nil {
+			return nil, fmt.Errorf("load tag offer: %w", err)
+		}
+		if offer == nil {
+			return nil, ErrTagOfferNotFound
+		}
+		now := time.Now().UTC()
+		if effectiveTagOfferStatus(offer.Status, offer.ExpiresAt, now) != openTagOfferStatus {
+			return nil, ErrTagOfferClosed
+		}
+
+		status := cancelledTagOfferStatus
+		if offer.BuyerUUID != req.UserUUID {
+			memberID, err := s.memberDiscordID(ctx, db, req.UserUUID)
+			if err != nil {
+				return nil, err
+			}
+			holders, _, err := s.loadTagHolders(ctx, guildID, sharedtypes.DiscordID(offer.BuyerMemberID))
+			if err != nil {
+				return nil, err
+			}
+			if memberID == "" || holders[sharedtypes.TagNumber(offer.TagNumber)] != memberID {
+				return nil, ErrTagOfferForbidden
+			}
+			status = declinedTagOfferStatus
+		}
+
+		offer.Status = status
+		offer.ClosedAt = &now
+		offer.UpdatedAt = now
+		if err := s.repo.UpdateTagOffer(ctx, db, offer); err != nil {
+			return nil, fmt.Errorf("update tag offer: %w", err)
+		}
+
+		if err := s.repo.CreateAuditLog(ctx, db, &bettingdb.AuditLog{
+			ClubUUID:      req.ClubUUID,
+			ActorUserUUID: &req.UserUUID,
+			Action:        "tag_offer_" + status,
+			Metadata:      fmt.Sprintf("offer_id=%d tag=%d price=%d", offer.ID, offer.TagNumber, offer.Price),
+		}); err != nil {
+			return nil, fmt.Errorf("create betting audit log: %w", err)
+		}
+
+		result := toBettingTagOffer(*offer, now)
+		return &result, nil
+	}
+
+	result, err := runInTx(ctx, s.db, &sql.TxOptions{}, run)
+	if err != nil {
+		s.metrics.RecordOperationFailure(ctx, "CancelTagOffer", "betting")
+		if span := trace.SpanFromContext(ctx); span.IsRecording() {
+			span.RecordError(err)
+		}
+		return nil, err
+	}
+
+	s.metrics.RecordOperationSuccess(ctx, "CancelTagOffer", "betting")
+	s.metrics.RecordOperationDuration(ctx, "CancelTagOffer", "betting", time.Since(start))
+
+	s.logInfo(ctx, "betting.tag_offer.closed", "tag offer closed",
+		attr.UUIDValue("club_uuid", req.ClubUUID),
+		attr.Int("offer_id", int(req.OfferID)),
+		attr.String("status", result.Status),
+	)
+
+	return result, nil
+}
+
+// requireTagOfferAccess resolves the caller's guild and rejects callers whose
+// club has betting disabled, or frozen unless allowFrozen is set.
+func (s *BettingService) requireTagOfferAccess(
+	ctx context.Context,
+	db bun.IDB,
+	clubUUID, userUUID uuid.UUID,
+	allowFrozen bool,
+) (sharedtypes.GuildID, error) {
+	guildID, access, err := s.resolveAccess(ctx, db, clubUUID, userUUID)
+	if err != nil {
+		return "", err
+	}
+
+	switch access.State {
+	case guildtypes.FeatureAccessStateDisabled:
+		s.metrics.RecordAccessDenied(ctx, "disabled")
+		return "", ErrFeatureDisabled
+	case guildtypes.FeatureAccessStateFrozen:
+		if !allowFrozen {
+			s.metrics.RecordAccessDenied(ctx, "frozen")
+			return "", ErrFeatureFrozen
+		}
+	}
+	return guildID, nil
+}
+
+// memberDiscordID returns the Discord ID of a user, or "" when the user has none.
+func (s *BettingService) memberDiscordID(ctx context.Context, db bun.IDB, userUUID uuid.UUID) (sharedtypes.DiscordID, error) {
+	user, err := s.userRepo.GetUserByUUID(ctx, db, userUUID)
+	if err != nil {
+		if errors.Is(err, userdb.ErrNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("load betting user: %w", err)
+	}
+	if user == nil || user.UserID == nil {
+		return "", nil
+	}
+	return *user.UserID, nil
+}
+
+// loadTagHolders indexes current tags by tag and by member within member's division.
+// Tag numbers repeat across divisions, so offers only trade tags inside one.
+func (s *BettingService) loadTagHolders(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	member sharedtypes.DiscordID,
+) (map[sharedtypes.TagNumber]sharedtypes.DiscordID, map[sharedtypes.DiscordID]sharedtypes.TagNumber, error) {
+	members, err := s.tags.GetTagList(ctx, guildID, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load leaderboard tags: %w", err)
+	}
+
+	divisionID := ""
+	for _, m := range members {
+		if sharedtypes.DiscordID(m.MemberID) == member {
+			divisionID = m.DivisionID
+			break
+		}
+	}
+
+	holders := make(map[sharedtypes.TagNumber]sharedtypes.DiscordID)
+	tags := make(map[sharedtypes.DiscordID]sharedtypes.TagNumber)
+	for _, m := range members {
+		if m.Tag == nil || m.DivisionID != divisionID {
+			continue
+		}
+		holders[sharedtypes.TagNumber(*m.Tag)] = sharedtypes.DiscordID(m.MemberID)
+		tags[sharedtypes.DiscordID(m.MemberID)] = sharedtypes.TagNumber(*m.Tag)
+	}
+	return holders, tags, nil
+}
+
+func (s *BettingService) activeSeasonID(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (string, error) {
+	activeSeason, err := s.leaderboardRepo.GetActiveSeason(ctx, db, string(guildID))
+	if err != nil {
+		return "", fmt.Errorf("load active season: %w", err)
+	}
+	if activeSeason != nil && activeSeason.ID != "" {
+		return activeSeason.ID, nil
+	}
+	return defaultSeasonID, nil
+}
+
+func effectiveTagOfferStatus(status string, expiresAt, now time.Time) string {
+	if status == openTagOfferStatus && !now.Before(expiresAt) {
+		return expiredTagOfferStatus
+	}
+	return status
+}
+
+func toTagOfferSettlement(offer bettingdb.TagOffer, now time.Time) *TagOfferSettlement {
+	return &TagOfferSettlement{
+		Offer:          toBettingTagOffer(offer, now),
+		SellerMemberID: offer.SellerMemberID,
+		SellerTag:      offer.SellerTag,
+	}
+}
+
+func toBettingTagOffer(offer bettingdb.TagOffer, now time.Time) BettingTagOffer {
+	return BettingTagOffer{
+		ID:             offer.ID,
+		TagNumber:      offer.TagNumber,
+		Price:          offer.Price,
+		BuyerMemberID:  offer.BuyerMemberID,
+		HolderMemberID: offer.HolderMemberID,
+		Status:         effectiveTagOfferStatus(offer.Status, offer.ExpiresAt, now),
+		ExpiresAt:      offer.ExpiresAt,
+		ClosedAt:       offer.ClosedAt,
+		CreatedAt:      offer.CreatedAt,
+	}
+}
@@ -0,0 +1,447 @@
+package bettingservice
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	guildtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/guild"
+	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	bettingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/betting/infrastructure/repositories"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// tagOfferFixture wires a club where the seller holds tag 3 and the buyer tag 9.
+type tagOfferFixture struct {
+	clubUUID   uuid.UUID
+	buyerUUID  uuid.UUID
+	sellerUUID uuid.UUID
+	repo       *FakeBettingRepository
+	tags       *FakeTagService
+	svc        *BettingService
+	offer      *bettingdb.TagOffer
+	holders    []leaderboardservice.MemberTagView
+}
+
+func newTagOfferFixture(entitlements guildtypes.ResolvedClubEntitlements) *tagOfferFixture {
+	f := &tagOfferFixture{
+		clubUUID:   uuid.New(),
+		buyerUUID:  uuid.New(),
+		sellerUUID: uuid.New(),
+		repo:       NewFakeBettingRepository(),
+		tags:       NewFakeTagService(),
+	}
+
+	userRepo := NewFakeUserRepository()
+	userRepo.GetClubMembershipFunc = func(_ context.Context, _ bun.IDB, userUUID, clubUUID uuid.UUID) (*userdb.ClubMembership, error) {
+		return memberMembership(userUUID, clubUUID), nil
+	}
+	userRepo.GetDiscordGuildIDByClubUUIDFunc = func(_ context.Context, _ bun.IDB, _ uuid.UUID) (sharedtypes.GuildID, error) {
+		return "guild-1", nil
+	}
+	userRepo.GetUserByUUIDFunc = func(_ context.Context, _ bun.IDB, userUUID uuid.UUID) (*userdb.User, error) {
+		switch userUUID {
+		case f.buyerUUID:
+			return &userdb.User{UserID: ptr(sharedtypes.DiscordID("buyer"))}, nil
+		case f.sellerUUID:
+			return &userdb.User{UserID: ptr(sharedtypes.DiscordID("seller"))}, nil
+		}
+		return nil, userdb.ErrNotFound
+	}
+	guildRepo := NewFakeGuildRepository()
+	guildRepo.ResolveEntitlementsFunc = func(_ context.Context, _ bun.IDB, _ sharedtypes.GuildID) (guildtypes.ResolvedClubEntitlements, error) {
+		return entitlements, nil
+	}
+	f.holders = []leaderboardservice.MemberTagView{tagged("seller", "", 3), tagged("buyer", "", 9)}
+	f.tags.GetTagListFunc = func(_ context.Context, _ sharedtypes.GuildID, _ *string) ([]leaderboardservice.MemberTagView, error) {
+		return f.holders, nil
+	}
+
+	f.svc = newTestService(f.repo, userRepo, guildRepo, NewFakeLeaderboardRepository(), nil).WithTagService(f.tags)
+	return f
+}
+
+// openOffer stores an open offer for tag 3. Updates are written back to f.offer,
+// so settlement sees what the accept transaction left behind.
+func (f *tagOfferFixture) openOffer(price int) {
+	f.offer = &bettingdb.TagOffer{
+		ID:             7,
+		ClubUUID:       f.clubUUID,
+		SeasonID:       "offer-season",
+		TagNumber:      3,
+		Price:          price,
+		BuyerUUID:      f.buyerUUID,
+		BuyerMemberID:  "buyer",
+		HolderMemberID: "seller",
+		Status:         openTagOfferStatus,
+		ExpiresAt:      time.Now().Add(time.Hour),
+	}
+	f.repo.GetTagOfferForUpdateFunc = func(_ context.Context, _ bun.IDB, _ uuid.UUID, _ int64) (*bettingdb.TagOffer, error) {
+		offer := *f.offer
+		return &offer, nil
+	}
+	f.repo.UpdateTagOfferFunc = func(_ context.Context, _ bun.IDB, offer *bettingdb.TagOffer) error {
+		stored := *offer
+		f.offer = &stored
+		return nil
+	}
+}
+
+// walletDeltas records the balance and reserved changes applied to each wallet.
+type walletDeltas struct {
+	balance  map[uuid.UUID]int
+	reserved map[uuid.UUID]int
+	seasons  []string
+}
+
+func (f *tagOfferFixture) recordWalletDeltas() *walletDeltas {
+	d := &walletDeltas{balance: make(map[uuid.UUID]int), reserved: make(map[uuid.UUID]int)}
+	f.repo.ApplyWalletBalanceDeltaFunc = func(_ context.Context, _ bun.IDB, _, userUUID uuid.UUID, seasonID string, balanceDelta, reservedDelta int) error {
+		d.balance[userUUID] += balanceDelta
+		d.reserved[userUUID] += reservedDelta
+		d.seasons = append(d.seasons, seasonID)
+		return nil
+	}
+	return d
+}
+
+// tagged is a member holding tag in the given division ("" = default).
+func tagged(memberID, divisionID string, tag int) leaderboardservice.MemberTagView {
+	return leaderboardservice.MemberTagView{MemberID: memberID, Tag: &tag, DivisionID: divisionID}
+}
+
+func (f *tagOfferFixture) walletBalances(balances map[uuid.UUID]int) {
+	f.repo.AcquireWalletBalanceFunc = func(_ context.Context, _ bun.IDB, clubUUID, userUUID uuid.UUID, seasonID string) (*bettingdb.WalletBalance, error) {
+		return &bettingdb.WalletBalance{ClubUUID: clubUUID, UserUUID: userUUID, SeasonID: seasonID, Balance: balances[userUUID]}, nil
+	}
+}
+
+func TestAcceptTagOffer_MovesPointsAndTag(t *testing.T) {
+	t.Parallel()
+
+	f := newTagOfferFixture(enabledEntitlements())
+	f.openOffer(40)
+	f.walletBalances(map[uuid.UUID]int{f.buyerUUID: 100})
+
+	var journal []bettingdb.WalletJournalEntry
+	f.repo.CreateWalletJournalEntryFunc = func(_ context.Context, _ bun.IDB, entry *bettingdb.WalletJournalEntry) error {
+		journal = append(journal, *entry)
+		return nil
+	}
+	deltas := f.recordWalletDeltas()
+	var moved []sharedtypes.TagAssignmentRequest
+	var source sharedtypes.ServiceUpdateSource
+	f.tags.ExecuteBatchTagAssignmentFunc = func(_ context.Context, _ sharedtypes.GuildID, requests []sharedtypes.TagAssignmentRequest, _ sharedtypes.RoundID, src sharedtypes.ServiceUpdateSource) (results.OperationResult[leaderboardtypes.LeaderboardData, error], error) {
+		// The buyer's points are held in escrow, and the seller is unpaid, until the tag moves.
+		if deltas.reserved[f.buyerUUID] != 40 || deltas.balance[f.sellerUUID] != 0 {
+			t.Errorf("points moved before the tag: %+v", deltas)
+		}
+		moved = requests
+		source = src
+		return results.SuccessResult[leaderboardtypes.LeaderboardData, error](nil), nil
+	}
+
+	result, err := f.svc.AcceptTagOffer(context.Background(), TagOfferActionRequest{ClubUUID: f.clubUUID, UserUUID: f.sellerUUID, OfferID: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantJournal := []struct {
+		user      uuid.UUID
+		entryType string
+		amount    int
+	}{
+		{f.buyerUUID, tagOfferReservedEntry, -40},
+		{f.sellerUUID, tagOfferSettlementEntry, 40},
+	}
+	if len(journal) != len(wantJournal) {
+		t.Fatalf("expected %d journal entries, got %+v", len(wantJournal), journal)
+	}
+	for i, want := range wantJournal {
+		if journal[i].UserUUID != want.user || journal[i].EntryType != want.entryType || journal[i].Amount != want.amount {
+			t.Errorf("journal[%d]: want %+v, got %+v", i, want, journal[i])
+		}
+	}
+	if deltas.balance[f.buyerUUID] != -40 || deltas.reserved[f.buyerUUID] != 0 || deltas.balance[f.sellerUUID] != 40 {
+		t.Errorf("unexpected wallet deltas: %+v", deltas)
+	}
+	for _, season := range deltas.seasons {
+		if season != "offer-season" {
+			t.Errorf("wallet season: want the offer's season, got %q", season)
+		}
+	}
+
+	wantMoved := []sharedtypes.TagAssignmentRequest{{UserID: "buyer", TagNumber: 3}, {UserID: "seller", TagNumber: 9}}
+	if !slices.Equal(moved, wantMoved) {
+		t.Errorf("tag assignments: want %+v, got %+v", wantMoved, moved)
+	}
+	if source != leaderboardservice.ServiceUpdateSourceTagOffer {
+		t.Errorf("source: want %q, got %q", leaderboardservice.ServiceUpdateSourceTagOffer, source)
+	}
+	if f.offer.Status != acceptedTagOfferStatus || f.offer.SellerUUID == nil || *f.offer.SellerUUID != f.sellerUUID {
+		t.Errorf("offer not marked accepted: %+v", f.offer)
+	}
+	if result.Offer.Status != acceptedTagOfferStatus || result.SellerMemberID != "seller" || result.SellerTag != 9 {
+		t.Errorf("unexpected settlement: %+v", result)
+	}
+}
+
+func TestAcceptTagOffer_UntaggedBuyerReleasesSellerTag(t *testing.T) {
+	t.Parallel()
+
+	f := newTagOfferFixture(enabledEntitlements())
+	f.openOffer(40)
+	f.walletBalances(map[uuid.UUID]int{f.buyerUUID: 100})
+	f.holders = []leaderboardservice.MemberTagView{tagged("seller", "", 3)}
+
+	var moved []sharedtypes.TagAssignmentRequest
+	f.tags.ExecuteBatchTagAssignmentFunc = func(_ context.Context, _ sharedtypes.GuildID, requests []sharedtypes.TagAssignmentRequest, _ sharedtypes.RoundID, _ sharedtypes.ServiceUpdateSource) (results.OperationResult[leaderboardtypes.LeaderboardData, error], error) {
+		moved = requests
+		return results.SuccessResult[leaderboardtypes.LeaderboardData, error](nil), nil
+	}
+
+	result, err := f.svc.AcceptTagOffer(context.Background(), TagOfferActionRequest{ClubUUID: f.clubUUID, UserUUID: f.sellerUUID, OfferID: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantMoved := []sharedtypes.TagAssignmentRequest{{UserID: "buyer", TagNumber: 3}, {UserID: "seller", TagNumber: tagRemoval}}
+	if !slices.Equal(moved, wantMoved) {
+		t.Errorf("tag assignments: want %+v, got %+v", wantMoved, moved)
+	}
+	if result.Offer.Status != acceptedTagOfferStatus || result.SellerTag != 0 {
+		t.Errorf("unexpected settlement: %+v", result)
+	}
+}
+
+func TestAcceptTagOffer_FailedTagMoveStaysSettling(t *testing.T) {
+	t.Parallel()
+
+	f := newTagOfferFixture(enabledEntitlements())
+	f.openOffer(40)
+	f.walletBalances(map[uuid.UUID]int{f.buyerUUID: 100})
+	deltas := f.recordWalletDeltas()
+	f.tags.ExecuteBatchTagAssignmentFunc = func(_ context.Context, _ sharedtypes.GuildID, _ []sharedtypes.TagAssignmentRequest, _ sharedtypes.RoundID, _ sharedtypes.ServiceUpdateSource) (results.OperationResult[leaderboardtypes.LeaderboardData, error], error) {
+		return results.OperationResult[leaderboardtypes.LeaderboardData, error]{}, errors.New("leaderboard unavailable")
+	}
+
+	result, err := f.svc.AcceptTagOffer(context.Background(), TagOfferActionRequest{ClubUUID: f.clubUUID, UserUUID: f.sellerUUID, OfferID: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Offer.Status != settlingTagOfferStatus {
+		t.Errorf("status: want %q, got %q", settlingTagOfferStatus, result.Offer.Status)
+	}
+	if f.offer.Status != settlingTagOfferStatus || f.offer.SettleError == "" {
+		t.Errorf("failed move not recorded on the offer: %+v", f.offer)
+	}
+	if deltas.reserved[f.buyerUUID] != 40 || deltas.balance[f.sellerUUID] != 0 {
+		t.Errorf("points must stay in escrow until the tag moves: %+v", deltas)
+	}
+
+	// The worker retries once the leaderboard recovers.
+	f.tags.ExecuteBatchTagAssignmentFunc = nil
+	f.repo.ListSettlingTagOffersFunc = func(_ context.Context, _ bun.IDB, _ int) ([]bettingdb.TagOffer, error) {
+		return []bettingdb.TagOffer{*f.offer}, nil
+	}
+	closed, err := f.svc.SettlePendingTagOffers(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if closed != 1 || f.offer.Status != acceptedTagOfferStatus || f.offer.SettleError != "" {
+		t.Errorf("retry did not settle the offer: closed=%d offer=%+v", closed, f.offer)
+	}
+	if deltas.reserved[f.buyerUUID] != 0 || deltas.balance[f.buyerUUID] != -40 || deltas.balance[f.sellerUUID] != 40 {
+		t.Errorf("retry did not pay the seller from escrow: %+v", deltas)
+	}
+}
+
+func TestSettlePendingTagOffers_RefundsWhenSellerLostTag(t *testing.T) {
+	t.Parallel()
+
+	f := newTagOfferFixture(enabledEntitlements())
+	f.openOffer(40)
+	f.offer.Status = settlingTagOfferStatus
+	f.offer.SellerUUID = &f.sellerUUID
+	f.offer.SellerMemberID = "seller"
+	// The seller's wallet is empty: the refund only releases the buyer's reservation.
+	f.walletBalances(map[uuid.UUID]int{f.buyerUUID: 100})
+	f.holders = []leaderboardservice.MemberTagView{tagged("someone-else", "", 3)}
+	f.repo.ListSettlingTagOffersFunc = func(_ context.Context, _ bun.IDB, _ int) ([]bettingdb.TagOffer, error) {
+		return []bettingdb.TagOffer{*f.offer}, nil
+	}
+
+	var journal []bettingdb.WalletJournalEntry
+	f.repo.CreateWalletJournalEntryFunc = func(_ context.Context, _ bun.IDB, entry *bettingdb.WalletJournalEntry) error {
+		journal = append(journal, *entry)
+		return nil
+	}
+	deltas := f.recordWalletDeltas()
+
+	if _, err := f.svc.SettlePendingTagOffers(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(journal) != 1 || journal[0].EntryType != tagOfferRefundEntry || journal[0].UserUUID != f.buyerUUID || journal[0].Amount != 40 {
+		t.Errorf("expected one refund journal entry for the buyer, got %+v", journal)
+	}
+	if journal[0].SeasonID != "offer-season" {
+		t.Errorf("refund season: want the offer's season, got %q", journal[0].SeasonID)
+	}
+	if deltas.reserved[f.buyerUUID] != -40 || deltas.balance[f.buyerUUID] != 0 || deltas.balance[f.sellerUUID] != 0 {
+		t.Errorf("unexpected wallet deltas: %+v", deltas)
+	}
+	if f.offer.Status != failedTagOfferStatus {
+		t.Errorf("status: want %q, got %q", failedTagOfferStatus, f.offer.Status)
+	}
+	if slices.Contains(f.tags.Trace(), "ExecuteBatchTagAssignment") {
+		t.Error("the tag should not move")
+	}
+}
+
+func TestAcceptTagOffer_InsufficientBalance(t *testing.T) {
+	t.Parallel()
+
+	f := newTagOfferFixture(enabledEntitlements())
+	f.openOffer(40)
+	f.walletBalances(map[uuid.UUID]int{f.buyerUUID: 10})
+
+	_, err := f.svc.AcceptTagOffer(context.Background(), TagOfferActionRequest{ClubUUID: f.clubUUID, UserUUID: f.sellerUUID, OfferID: 7})
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Fatalf("expected ErrInsufficientBalance, got %v", err)
+	}
+	if slices.Contains(f.repo.Trace(), "CreateWalletJournalEntry") {
+		t.Error("no points should move")
+	}
+	if slices.Contains(f.tags.Trace(), "ExecuteBatchTagAssignment") {
+		t.Error("the tag should not move")
+	}
+}
+
+func TestAcceptTagOffer_RequiresCurrentHolder(t *testing.T) {
+	t.Parallel()
+
+	f := newTagOfferFixture(enabledEntitlements())
+	f.openOffer(40)
+	f.walletBalances(map[uuid.UUID]int{f.buyerUUID: 100})
+	f.holders = []leaderboardservice.MemberTagView{tagged("someone-else", "", 3)}
+
+	_, err := f.svc.AcceptTagOffer(context.Background(), TagOfferActionRequest{ClubUUID: f.clubUUID, UserUUID: f.sellerUUID, OfferID: 7})
+	if !errors.Is(err, ErrTagOfferNotHolder) {
+		t.Fatalf("expected ErrTagOfferNotHolder, got %v", err)
+	}
+}
+
+func TestCreateTagOffer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		entitlements guildtypes.ResolvedClubEntitlements
+		req          func(f *tagOfferFixture) CreateTagOfferRequest
+		wantErr      error
+	}{
+		{
+			name:         "disabled feature rejects offer",
+			entitlements: disabledEntitlements(),
+			req: func(f *tagOfferFixture) CreateTagOfferRequest {
+				return CreateTagOfferRequest{ClubUUID: f.clubUUID, UserUUID: f.buyerUUID, TagNumber: 3, Price: 40}
+			},
+			wantErr: ErrFeatureDisabled,
+		},
+		{
+			name:         "frozen feature rejects offer",
+			entitlements: frozenEntitlements(),
+			req: func(f *tagOfferFixture) CreateTagOfferRequest {
+				return CreateTagOfferRequest{ClubUUID: f.clubUUID, UserUUID: f.buyerUUID, TagNumber: 3, Price: 40}
+			},
+			wantErr: ErrFeatureFrozen,
+		},
+		{
+			name:         "own tag is rejected",
+			entitlements: enabledEntitlements(),
+			req: func(f *tagOfferFixture) CreateTagOfferRequest {
+				return CreateTagOfferRequest{ClubUUID: f.clubUUID, UserUUID: f.buyerUUID, TagNumber: 9, Price: 40}
+			},
+			wantErr: ErrTagOfferTagInvalid,
+		},
+		{
+			name:         "price above available balance is rejected",
+			entitlements: enabledEntitlements(),
+			req: func(f *tagOfferFixture) CreateTagOfferRequest {
+				return CreateTagOfferRequest{ClubUUID: f.clubUUID, UserUUID: f.buyerUUID, TagNumber: 3, Price: 500}
+			},
+			wantErr: ErrInsufficientBalance,
+		},
+		{
+			name:         "offer is recorded against the current holder",
+			entitlements: enabledEntitlements(),
+			req: func(f *tagOfferFixture) CreateTagOfferRequest {
+				return CreateTagOfferRequest{ClubUUID: f.clubUUID, UserUUID: f.buyerUUID, TagNumber: 3, Price: 40}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := newTagOfferFixture(tt.entitlements)
+			f.walletBalances(map[uuid.UUID]int{f.buyerUUID: 100})
+
+			offer, err := f.svc.CreateTagOffer(context.Background(), tt.req(f))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if slices.Contains(f.repo.Trace(), "CreateTagOffer") {
+					t.Error("a rejected offer must not be stored")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if offer.HolderMemberID != "seller" || offer.BuyerMemberID != "buyer" || offer.Status != openTagOfferStatus {
+				t.Errorf("unexpected offer: %+v", offer)
+			}
+		})
+	}
+}
+
+func TestCreateTagOffer_OtherDivisionTagIsRejected(t *testing.T) {
+	t.Parallel()
+
+	f := newTagOfferFixture(enabledEntitlements())
+	f.walletBalances(map[uuid.UUID]int{f.buyerUUID: 100})
+	// The seller holds tag 3 in another division; nobody holds it in the buyer's.
+	f.holders = []leaderboardservice.MemberTagView{tagged("seller", "pro", 3), tagged("buyer", "", 9), tagged("rival", "", 4)}
+
+	_, err := f.svc.CreateTagOffer(context.Background(), CreateTagOfferRequest{ClubUUID: f.clubUUID, UserUUID: f.buyerUUID, TagNumber: 3, Price: 40})
+	if !errors.Is(err, ErrTagOfferTagInvalid) {
+		t.Fatalf("expected ErrTagOfferTagInvalid, got %v", err)
+	}
+}
+
+func TestCancelTagOffer_HolderDeclinesWhileFrozen(t *testing.T) {
+	t.Parallel()
+
+	f := newTagOfferFixture(frozenEntitlements())
+	f.openOffer(40)
+
+	offer, err := f.svc.CancelTagOffer(context.Background(), TagOfferActionRequest{ClubUUID: f.clubUUID, UserUUID: f.sellerUUID, OfferID: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if offer.Status != declinedTagOfferStatus {
+		t.Errorf("status: want %q, got %q", declinedTagOfferStatus, offer.Status)
+	}
+}
@@ -26,9 +26,14 @@ type FakeBettingService struct {
 	VoidRoundMarketsFunc          func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, source string, actorUUID *uuid.UUID, reason string) ([]bettingservice.MarketVoidResult, error)
 	EnsureMarketsForGuildFunc     func(ctx context.Context, guildID sharedtypes.GuildID) ([]bettingservice.MarketGeneratedResult, error)
 	LockDueMarketsFunc            func(ctx context.Context) ([]bettingservice.MarketLockResult, error)
+	SettlePendingTagOffersFunc    func(ctx context.Context) (int, error)
 	SuspendOpenMarketsForClubFunc func(ctx context.Context, guildID sharedtypes.GuildID) ([]bettingservice.MarketSuspendedResult, error)
 	GetMarketSnapshotFunc         func(ctx context.Context, clubUUID uuid.UUID) (*bettingservice.MarketSnapshot, error)
 	MirrorPointsToWalletFunc      func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, points map[sharedtypes.DiscordID]int) error
+	ListTagOffersFunc             func(ctx context.Context, clubUUID, userUUID uuid.UUID) (*bettingservice.TagOfferBoard, error)
+	CreateTagOfferFunc            func(ctx context.Context, req bettingservice.CreateTagOfferRequest) (*bettingservice.BettingTagOffer, error)
+	AcceptTagOfferFunc            func(ctx context.Context, req bettingservice.TagOfferActionRequest) (*bettingservice.TagOfferSettlement, error)
+	CancelTagOfferFunc            func(ctx context.Context, req bettingservice.TagOfferActionRequest) (*bettingservice.BettingTagOffer, error)
 }
 
 // compile-time check
@@ -132,6 +137,14 @@ func (f *FakeBettingService) LockDueMarkets(ctx context.Context) ([]bettingservi
 	return nil, nil
 }
 
+func (f *FakeBettingService) SettlePendingTagOffers(ctx context.Context) (int, error) {
+	f.record("SettlePendingTagOffers")
+	if f.SettlePendingTagOffersFunc != nil {
+		return f.SettlePendingTagOffersFunc(ctx)
+	}
+	return 0, nil
+}
+
 func (f *FakeBettingService) SuspendOpenMarketsForClub(ctx context.Context, guildID sharedtypes.GuildID) ([]bettingservice.MarketSuspendedResult, error) {
 	f.record("SuspendOpenMarketsForClub")
 	if f.SuspendOpenMarketsForClubFunc != nil {
@@ -155,3 +168,35 @@ func (f *FakeBettingService) MirrorPointsToWallet(ctx context.Context, guildID s
 	}
 	return nil
 }
+
+func (f *FakeBettingService) ListTagOffers(ctx context.Context, clubUUID, userUUID uuid.UUID) (*bettingservice.TagOfferBoard, error) {
+	f.record("ListTagOffers")
+	if f.ListTagOffersFunc != nil {
+		return f.ListTagOffersFunc(ctx, clubUUID, userUUID)
+	}
+	return nil, nil
+}
+
+func (f *FakeBettingService) CreateTagOffer(ctx context.Context, req bettingservice.CreateTagOfferRequest) (*bettingservice.BettingTagOffer, error) {
+	f.record("CreateTagOffer")
+	if f.CreateTagOfferFunc != nil {
+		return f.CreateTagOfferFunc(ctx, req)
+	}
+	return nil, nil
+}
+
+func (f *FakeBettingService) AcceptTagOffer(ctx context.Context, req bettingservice.TagOfferActionRequest) (*bettingservice.TagOfferSettlement, error) {
+	f.record("AcceptTagOffer")
+	if f.AcceptTagOfferFunc != nil {
+		return f.AcceptTagOfferFunc(ctx, req)
+	}
+	return nil, nil
+}
+
+func (f *FakeBettingService) CancelTagOffer(ctx context.Context, req bettingservice.TagOfferActionRequest) (*bettingservice.BettingTagOffer, error) {
+	f.record("CancelTagOffer")
+	if f.CancelTagOfferFunc != nil {
+		return f.CancelTagOfferFunc(ctx, req)
+	}
+	return nil, nil
+}
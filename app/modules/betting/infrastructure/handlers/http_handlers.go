@@ -372,6 +372,132 @@ func (h *HTTPHandlers) HandleAdminMarketAction(w http.ResponseWriter, r *http.Re
 	writeJSON(w, http.StatusOK, result)
 }
 
+func (h *HTTPHandlers) HandleListTagOffers(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	h.metrics.RecordHandlerAttempt(r.Context(), "HandleListTagOffers")
+
+	userUUID, err := h.resolveUserUUID(r)
+	if err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleListTagOffers")
+		httpError(w, http.StatusUnauthorized, "unauthorized", "unauthorized")
+		return
+	}
+
+	clubUUID, err := uuid.Parse(r.URL.Query().Get("club_uuid"))
+	if err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleListTagOffers")
+		httpError(w, http.StatusBadRequest, "invalid_club_uuid", "invalid club_uuid")
+		return
+	}
+
+	board, err := h.service.ListTagOffers(r.Context(), clubUUID, userUUID)
+	if err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleListTagOffers")
+		h.writeServiceError(w, r, err)
+		return
+	}
+
+	h.metrics.RecordHandlerSuccess(r.Context(), "HandleListTagOffers")
+	h.metrics.RecordHandlerDuration(r.Context(), "HandleListTagOffers", time.Since(start))
+	writeJSON(w, http.StatusOK, board)
+}
+
+func (h *HTTPHandlers) HandleCreateTagOffer(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	h.metrics.RecordHandlerAttempt(r.Context(), "HandleCreateTagOffer")
+
+	userUUID, err := h.resolveUserUUID(r)
+	if err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleCreateTagOffer")
+		httpError(w, http.StatusUnauthorized, "unauthorized", "unauthorized")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, 4096)
+	var req bettingservice.CreateTagOfferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleCreateTagOffer")
+		httpError(w, http.StatusBadRequest, "invalid_request", "invalid request body")
+		return
+	}
+	req.UserUUID = userUUID
+
+	offer, err := h.service.CreateTagOffer(r.Context(), req)
+	if err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleCreateTagOffer")
+		h.writeServiceError(w, r, err)
+		return
+	}
+
+	h.metrics.RecordHandlerSuccess(r.Context(), "HandleCreateTagOffer")
+	h.metrics.RecordHandlerDuration(r.Context(), "HandleCreateTagOffer", time.Since(start))
+	writeJSON(w, http.StatusCreated, offer)
+}
+
+func (h *HTTPHandlers) HandleAcceptTagOffer(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	h.metrics.RecordHandlerAttempt(r.Context(), "HandleAcceptTagOffer")
+
+	userUUID, err := h.resolveUserUUID(r)
+	if err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleAcceptTagOffer")
+		httpError(w, http.StatusUnauthorized, "unauthorized", "unauthorized")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, 4096)
+	var req bettingservice.TagOfferActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleAcceptTagOffer")
+		httpError(w, http.StatusBadRequest, "invalid_request", "invalid request body")
+		return
+	}
+	req.UserUUID = userUUID
+
+	settlement, err := h.service.AcceptTagOffer(r.Context(), req)
+	if err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleAcceptTagOffer")
+		h.writeServiceError(w, r, err)
+		return
+	}
+
+	h.metrics.RecordHandlerSuccess(r.Context(), "HandleAcceptTagOffer")
+	h.metrics.RecordHandlerDuration(r.Context(), "HandleAcceptTagOffer", time.Since(start))
+	writeJSON(w, http.StatusOK, settlement)
+}
+
+func (h *HTTPHandlers) HandleCancelTagOffer(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	h.metrics.RecordHandlerAttempt(r.Context(), "HandleCancelTagOffer")
+
+	userUUID, err := h.resolveUserUUID(r)
+	if err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleCancelTagOffer")
+		httpError(w, http.StatusUnauthorized, "unauthorized", "unauthorized")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, 4096)
+	var req bettingservice.TagOfferActionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleCancelTagOffer")
+		httpError(w, http.StatusBadRequest, "invalid_request", "invalid request body")
+		return
+	}
+	req.UserUUID = userUUID
+
+	offer, err := h.service.CancelTagOffer(r.Context(), req)
+	if err != nil {
+		h.metrics.RecordHandlerFailure(r.Context(), "HandleCancelTagOffer")
+		h.writeServiceError(w, r, err)
+		return
+	}
+
+	h.metrics.RecordHandlerSuccess(r.Context(), "HandleCancelTagOffer")
+	h.metrics.RecordHandlerDuration(r.Context(), "HandleCancelTagOffer", time.Since(start))
+	writeJSON(w, http.StatusOK, offer)
+}
+
 func (h *HTTPHandlers) writeServiceError(w http.ResponseWriter, r *http.Request, err error) {
 	switch {
 	case errors.Is(err, bettingservice.ErrMembershipRequired):
@@ -408,6 +534,20 @@ func (h *HTTPHandlers) writeServiceError(w http.ResponseWriter, r *http.Request,
 		httpError(w, http.StatusUnprocessableEntity, "self_bet_prohibited", "you cannot bet on yourself in this market")
 	case errors.Is(err, bettingservice.ErrInvalidMarketType):
 		httpError(w, http.StatusBadRequest, "invalid_market_type", "invalid market type")
+	case errors.Is(err, bettingservice.ErrTagOffersUnavailable):
+		httpError(w, http.StatusServiceUnavailable, "tag_offers_unavailable", "tag offers are unavailable")
+	case errors.Is(err, bettingservice.ErrTagOfferNotFound):
+		httpError(w, http.StatusNotFound, "tag_offer_not_found", "tag offer not found")
+	case errors.Is(err, bettingservice.ErrTagOfferClosed):
+		httpError(w, http.StatusConflict, "tag_offer_closed", "tag offer is no longer open")
+	case errors.Is(err, bettingservice.ErrTagOfferPriceInvalid):
+		httpError(w, http.StatusBadRequest, "invalid_price", "price must be greater than zero")
+	case errors.Is(err, bettingservice.ErrTagOfferTagInvalid):
+		httpError(w, http.StatusBadRequest, "invalid_tag", "tag is not held by another member")
+	case errors.Is(err, bettingservice.ErrTagOfferNotHolder):
+		httpError(w, http.StatusForbidden, "tag_holder_required", "only the tag holder can accept this offer")
+	case errors.Is(err, bettingservice.ErrTagOfferForbidden):
+		httpError(w, http.StatusForbidden, "tag_offer_forbidden", "only the buyer or the tag holder can cancel this offer")
 	default:
 		h.logger.ErrorContext(r.Context(), "betting handler failed", slog.String("error", err.Error()))
 		httpError(w, http.StatusInternalServerError, "internal_error", "internal server error")
@@ -511,6 +511,13 @@ func TestWriteServiceError(t *testing.T) {
 		{bettingservice.ErrRoundNotFinalized, http.StatusBadRequest, "round_not_finalized"},
 		{bettingservice.ErrSelfBetProhibited, http.StatusUnprocessableEntity, "self_bet_prohibited"},
 		{bettingservice.ErrInvalidMarketType, http.StatusBadRequest, "invalid_market_type"},
+		{bettingservice.ErrTagOffersUnavailable, http.StatusServiceUnavailable, "tag_offers_unavailable"},
+		{bettingservice.ErrTagOfferNotFound, http.StatusNotFound, "tag_offer_not_found"},
+		{bettingservice.ErrTagOfferClosed, http.StatusConflict, "tag_offer_closed"},
+		{bettingservice.ErrTagOfferPriceInvalid, http.StatusBadRequest, "invalid_price"},
+		{bettingservice.ErrTagOfferTagInvalid, http.StatusBadRequest, "invalid_tag"},
+		{bettingservice.ErrTagOfferNotHolder, http.StatusForbidden, "tag_holder_required"},
+		{bettingservice.ErrTagOfferForbidden, http.StatusForbidden, "tag_offer_forbidden"},
 	}
 
 	h := newHTTPHandlers(&FakeBettingService{}, &userdb.FakeRepository{})
@@ -580,3 +587,61 @@ func TestHandlePlaceBet_RateLimitExceeded(t *testing.T) {
 		t.Errorf("want code=rate_limit_exceeded, got %q", body["code"])
 	}
 }
+
+// ---------------------------------------------------------------------------
+// TestHandleAcceptTagOffer
+// ---------------------------------------------------------------------------
+
+func TestHandleAcceptTagOffer(t *testing.T) {
+	t.Parallel()
+	userUUID := uuid.New()
+	const cookie = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	body := func() *bytes.Buffer {
+		b, _ := json.Marshal(bettingservice.TagOfferActionRequest{ClubUUID: uuid.New(), OfferID: 7})
+		return bytes.NewBuffer(b)
+	}
+
+	t.Run("missing cookie → 401", func(t *testing.T) {
+		t.Parallel()
+		h := newHTTPHandlers(&FakeBettingService{}, &userdb.FakeRepository{})
+		rr := httptest.NewRecorder()
+		h.HandleAcceptTagOffer(rr, httptest.NewRequest(http.MethodPost, "/betting/tag-offers/accept", body()))
+		if rr.Code != http.StatusUnauthorized {
+			t.Errorf("want 401, got %d", rr.Code)
+		}
+	})
+
+	t.Run("caller accepts as themselves", func(t *testing.T) {
+		t.Parallel()
+		svc := &FakeBettingService{}
+		var got bettingservice.TagOfferActionRequest
+		svc.AcceptTagOfferFunc = func(_ context.Context, req bettingservice.TagOfferActionRequest) (*bettingservice.TagOfferSettlement, error) {
+			got = req
+			return &bettingservice.TagOfferSettlement{SellerMemberID: "seller", SellerTag: 9}, nil
+		}
+		h := newHTTPHandlers(svc, validSession(userUUID))
+		rr := httptest.NewRecorder()
+		h.HandleAcceptTagOffer(rr, withRefreshCookie(httptest.NewRequest(http.MethodPost, "/betting/tag-offers/accept", body()), cookie))
+		if rr.Code != http.StatusOK {
+			t.Fatalf("want 200, got %d", rr.Code)
+		}
+		if got.UserUUID != userUUID || got.OfferID != 7 {
+			t.Errorf("unexpected request: %+v", got)
+		}
+	})
+
+	t.Run("service ErrTagOfferClosed → 409", func(t *testing.T) {
+		t.Parallel()
+		svc := &FakeBettingService{}
+		svc.AcceptTagOfferFunc = func(_ context.Context, _ bettingservice.TagOfferActionRequest) (*bettingservice.TagOfferSettlement, error) {
+			return nil, bettingservice.ErrTagOfferClosed
+		}
+		h := newHTTPHandlers(svc, validSession(userUUID))
+		rr := httptest.NewRecorder()
+		h.HandleAcceptTagOffer(rr, withRefreshCookie(httptest.NewRequest(http.MethodPost, "/betting/tag-offers/accept", body()), cookie))
+		if rr.Code != http.StatusConflict {
+			t.Errorf("want 409, got %d", rr.Code)
+		}
+	})
+}
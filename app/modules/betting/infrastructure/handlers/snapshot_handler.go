@@ -8,6 +8,7 @@ import (
 	bettingevents "github.com/Black-And-White-Club/frolf-bot-shared/events/betting"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
 	bettingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/betting/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
 	"github.com/google/uuid"
 )
 
@@ -86,10 +87,7 @@ func (h *EventHandlers) HandleBettingSnapshotRequest(
 	}
 
 	// Honour reply-to inbox for request/reply pattern (same as leaderboard).
-	topic := bettingevents.BettingSnapshotResponseV1
-	if replyTo, ok := ctx.Value(handlerwrapper.CtxKeyReplyTo).(string); ok && replyTo != "" {
-		topic = replyTo
-	}
+	topic := handlerutil.ReplyTopic(ctx, bettingevents.BettingSnapshotResponseV1)
 
 	return []handlerwrapper.Result{{Topic: topic, Payload: resp}}, nil
 }
@@ -32,6 +32,16 @@ type Repository interface {
 	ListBetsForMarket(ctx context.Context, db bun.IDB, marketID int64) ([]Bet, error)
 	ListBetsForUserAndMarket(ctx context.Context, db bun.IDB, clubUUID, userUUID uuid.UUID, marketID int64) ([]Bet, error)
 	CreateAuditLog(ctx context.Context, db bun.IDB, log *AuditLog) error
+	CreateTagOffer(ctx context.Context, db bun.IDB, offer *TagOffer) error
+	// GetTagOfferForUpdate loads an offer under a SELECT FOR UPDATE lock. Returns
+	// nil when the offer does not exist. Must be called within a tx.
+	GetTagOfferForUpdate(ctx context.Context, db bun.IDB, clubUUID uuid.UUID, offerID int64) (*TagOffer, error)
+	UpdateTagOffer(ctx context.Context, db bun.IDB, offer *TagOffer) error
+	// ListOpenTagOffers returns the club's open offers that have not expired by now.
+	ListOpenTagOffers(ctx context.Context, db bun.IDB, clubUUID uuid.UUID, now time.Time, limit int) ([]TagOffer, error)
+	// ListSettlingTagOffers returns accepted offers across clubs whose tag has not
+	// moved yet, oldest first.
+	ListSettlingTagOffers(ctx context.Context, db bun.IDB, limit int) ([]TagOffer, error)
 	// AcquireWalletBalance ensures a balance row exists for (club, user, season)
 	// and returns it under a SELECT FOR UPDATE lock. Must be called within a tx.
 	AcquireWalletBalance(ctx context.Context, db bun.IDB, clubUUID, userUUID uuid.UUID, seasonID string) (*WalletBalance, error)
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding betting tag offers table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				CREATE TABLE IF NOT EXISTS betting_tag_offers (
+					id               BIGSERIAL PRIMARY KEY,
+					club_uuid        UUID NOT NULL,
+					season_id        VARCHAR(64) NOT NULL,
+					tag_number       INTEGER NOT NULL CHECK (tag_number > 0),
+					price            INTEGER NOT NULL CHECK (price > 0),
+					buyer_uuid       UUID NOT NULL,
+					buyer_member_id  VARCHAR(32) NOT NULL,
+					holder_member_id VARCHAR(32) NOT NULL,
+					seller_uuid      UUID,
+					seller_member_id VARCHAR(32),
+					seller_tag       INTEGER CHECK (seller_tag > 0),
+					status           VARCHAR(32) NOT NULL,
+					settle_error     TEXT,
+					expires_at       TIMESTAMPTZ NOT NULL,
+					closed_at        TIMESTAMPTZ,
+					created_at       TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+					updated_at       TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+				);
+			`); err != nil {
+				return fmt.Errorf("create betting_tag_offers: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_betting_tag_offers_open
+				ON betting_tag_offers (club_uuid, tag_number)
+				WHERE status = 'open';
+			`); err != nil {
+				return fmt.Errorf("create idx_betting_tag_offers_open: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_betting_tag_offers_settling
+				ON betting_tag_offers (updated_at)
+				WHERE status = 'settling';
+			`); err != nil {
+				return fmt.Errorf("create idx_betting_tag_offers_settling: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping betting tag offers table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `DROP TABLE IF EXISTS betting_tag_offers;`); err != nil {
+				return fmt.Errorf("drop betting_tag_offers: %w", err)
+			}
+			return nil
+		})
+	})
+}
@@ -88,6 +88,34 @@ type Bet struct {
 	CreatedAt        time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp"`
 }
 
+// TagOffer is a member's standing offer to buy a leaderboard tag for betting
+// wallet points. HolderMemberID is the holder when the offer was posted; whoever
+// holds the tag when it is accepted is the seller. An accepted offer stays
+// "settling", with the price reserved in the buyer's wallet, until the tag has
+// moved and the seller is paid; SettleError keeps the last failed attempt.
+// SellerTag is the tag the seller took in exchange, zero when left untagged.
+type TagOffer struct {
+	bun.BaseModel `bun:"table:betting_tag_offers,alias:bto"`
+
+	ID             int64      `bun:"id,pk,autoincrement"`
+	ClubUUID       uuid.UUID  `bun:"club_uuid,type:uuid,notnull"`
+	SeasonID       string     `bun:"season_id,type:varchar(64),notnull"`
+	TagNumber      int        `bun:"tag_number,notnull"`
+	Price          int        `bun:"price,notnull"`
+	BuyerUUID      uuid.UUID  `bun:"buyer_uuid,type:uuid,notnull"`
+	BuyerMemberID  string     `bun:"buyer_member_id,type:varchar(32),notnull"`
+	HolderMemberID string     `bun:"holder_member_id,type:varchar(32),notnull"`
+	SellerUUID     *uuid.UUID `bun:"seller_uuid,type:uuid,nullzero"`
+	SellerMemberID string     `bun:"seller_member_id,type:varchar(32),nullzero"`
+	SellerTag      int        `bun:"seller_tag,nullzero"`
+	Status         string     `bun:"status,type:varchar(32),notnull"`
+	SettleError    string     `bun:"settle_error,type:text,nullzero"`
+	ExpiresAt      time.Time  `bun:"expires_at,notnull"`
+	ClosedAt       *time.Time `bun:"closed_at,nullzero"`
+	CreatedAt      time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time  `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
 // WalletBalance is a denormalized projection of a user's betting wallet for a
 // given club and season. It tracks the current betting-journal balance and the
 // total stake currently reserved in accepted bets. It is the authoritative
@@ -505,3 +505,97 @@ func (r *Impl) ApplyWalletBalanceDelta(ctx context.Context, db bun.IDB, clubUUID
 
 	return nil
 }
+
+func (r *Impl) CreateTagOffer(ctx context.Context, db bun.IDB, offer *TagOffer) error {
+	if db == nil {
+		db = r.db
+	}
+
+	if _, err := db.NewInsert().Model(offer).Exec(ctx); err != nil {
+		return fmt.Errorf("bettingdb.CreateTagOffer: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Impl) GetTagOfferForUpdate(ctx context.Context, db bun.IDB, clubUUID uuid.UUID, offerID int64) (*TagOffer, error) {
+	if db == nil {
+		db = r.db
+	}
+
+	offer := new(TagOffer)
+	err := db.NewSelect().
+		Model(offer).
+		Where("id = ?", offerID).
+		Where("club_uuid = ?", clubUUID).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("bettingdb.GetTagOfferForUpdate: %w", err)
+	}
+
+	return offer, nil
+}
+
+func (r *Impl) UpdateTagOffer(ctx context.Context, db bun.IDB, offer *TagOffer) error {
+	if db == nil {
+		db = r.db
+	}
+
+	if _, err := db.NewUpdate().
+		Model(offer).
+		Column("status", "seller_uuid", "seller_member_id", "seller_tag", "settle_error", "closed_at", "updated_at").
+		WherePK().
+		Exec(ctx); err != nil {
+		return fmt.Errorf("bettingdb.UpdateTagOffer: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Impl) ListOpenTagOffers(ctx context.Context, db bun.IDB, clubUUID uuid.UUID, now time.Time, limit int) ([]TagOffer, error) {
+	if db == nil {
+		db = r.db
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+
+	offers := make([]TagOffer, 0)
+	if err := db.NewSelect().
+		Model(&offers).
+		Where("club_uuid = ?", clubUUID).
+		Where("status = ?", "open").
+		Where("expires_at > ?", now).
+		OrderExpr("tag_number ASC, price DESC, id ASC").
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, fmt.Errorf("bettingdb.ListOpenTagOffers: %w", err)
+	}
+
+	return offers, nil
+}
+
+func (r *Impl) ListSettlingTagOffers(ctx context.Context, db bun.IDB, limit int) ([]TagOffer, error) {
+	if db == nil {
+		db = r.db
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+
+	offers := make([]TagOffer, 0)
+	if err := db.NewSelect().
+		Model(&offers).
+		Where("status = ?", "settling").
+		OrderExpr("updated_at ASC, id ASC").
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, fmt.Errorf("bettingdb.ListSettlingTagOffers: %w", err)
+	}
+
+	return offers, nil
+}
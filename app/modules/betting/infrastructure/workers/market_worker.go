@@ -28,6 +28,7 @@ type guildDiscoverer interface {
 type marketClient interface {
 	EnsureMarketsForGuild(ctx context.Context, guildID sharedtypes.GuildID) ([]bettingservice.MarketGeneratedResult, error)
 	LockDueMarkets(ctx context.Context) ([]bettingservice.MarketLockResult, error)
+	SettlePendingTagOffers(ctx context.Context) (int, error)
 }
 
 // MarketWorker is a background ticker that ensures winner markets exist for
@@ -130,6 +131,13 @@ func (w *MarketWorker) tick(ctx context.Context) {
 			w.publishMarketLocked(ctx, r)
 		}
 	}
+
+	// Retry tag offers whose points moved but whose tag did not.
+	if _, err := w.service.SettlePendingTagOffers(ctx); err != nil {
+		w.logger.WarnContext(ctx, "betting market worker: settle pending tag offers failed",
+			attr.Error(err),
+		)
+	}
 }
 
 func (w *MarketWorker) publishMarketGenerated(ctx context.Context, r bettingservice.MarketGeneratedResult) {
@@ -27,6 +27,7 @@ type fakeMarketClient struct {
 	results     map[sharedtypes.GuildID][]bettingservice.MarketGeneratedResult
 	lockResults []bettingservice.MarketLockResult
 	lockErr     error
+	settleCalls int
 }
 
 func (s *fakeMarketClient) EnsureMarketsForGuild(_ context.Context, guildID sharedtypes.GuildID) ([]bettingservice.MarketGeneratedResult, error) {
@@ -42,6 +43,13 @@ func (s *fakeMarketClient) LockDueMarkets(_ context.Context) ([]bettingservice.M
 	return s.lockResults, s.lockErr
 }
 
+func (s *fakeMarketClient) SettlePendingTagOffers(_ context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settleCalls++
+	return 0, nil
+}
+
 // ---- tests ----
 
 func TestMarketWorker_Tick_CallsEnsureForDiscoveredGuilds(t *testing.T) {
@@ -135,6 +143,26 @@ func TestMarketWorker_Tick_LocksDueMarkets(t *testing.T) {
 	}
 }
 
+// TestMarketWorker_Tick_SettlesPendingTagOffers verifies that tag offers left
+// settling are retried on every tick.
+func TestMarketWorker_Tick_SettlesPendingTagOffers(t *testing.T) {
+	t.Parallel()
+
+	svc := &fakeMarketClient{}
+	w := &MarketWorker{
+		service:      svc,
+		guilds:       &fakeGuildDiscoverer{},
+		tickInterval: defaultTickInterval,
+		stop:         make(chan struct{}),
+	}
+
+	w.tick(context.Background())
+
+	if svc.settleCalls != 1 {
+		t.Errorf("expected SettlePendingTagOffers called once, got %d", svc.settleCalls)
+	}
+}
+
 // trackingMarketClient wraps fakeMarketClient to intercept LockDueMarkets.
 type trackingMarketClient struct {
 	inner  *fakeMarketClient
@@ -152,3 +180,7 @@ func (t *trackingMarketClient) LockDueMarkets(ctx context.Context) ([]bettingser
 	// Return nil to avoid triggering publishMarketLocked (no eventbus in unit tests).
 	return nil, nil
 }
+
+func (t *trackingMarketClient) SettlePendingTagOffers(ctx context.Context) (int, error) {
+	return t.inner.SettlePendingTagOffers(ctx)
+}
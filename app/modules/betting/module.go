@@ -14,7 +14,9 @@ import (
 	bettingrouter "github.com/Black-And-White-Club/frolf-bot/app/modules/betting/infrastructure/router"
 	bettingworkers "github.com/Black-And-White-Club/frolf-bot/app/modules/betting/infrastructure/workers"
 	guilddb "github.com/Black-And-White-Club/frolf-bot/app/modules/guild/infrastructure/repositories"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
 	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
 	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
 	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -31,25 +33,34 @@ type Module struct {
 }
 
 type ModuleOptions struct {
-	Observability   observability.Observability
-	EventBus        eventbus.EventBus
-	Router          *message.Router
-	Helpers         utils.Helpers
-	RouterCtx       context.Context
-	DB              *bun.DB
-	HTTPRouter      chi.Router
-	UserRepo        userdb.Repository
-	GuildRepo       guilddb.Repository
-	LeaderboardRepo leaderboarddb.Repository
-	RoundRepo       rounddb.Repository
+	Observability      observability.Observability
+	EventBus           eventbus.EventBus
+	Router             *message.Router
+	Helpers            utils.Helpers
+	RouterCtx          context.Context
+	DB                 *bun.DB
+	HTTPRouter         chi.Router
+	UserRepo           userdb.Repository
+	GuildRepo          guilddb.Repository
+	LeaderboardRepo    leaderboarddb.Repository
+	RoundRepo          rounddb.Repository
+	RatingService      ratingservice.Service
+	LeaderboardService leaderboardservice.Service
 }
 
 func NewModule(ctx context.Context, opts ModuleOptions) (*Module, error) {
 	logger := opts.Observability.Provider.Logger
 	tracer := opts.Observability.Registry.Tracer
 
+	if opts.RatingService == nil {
+		return nil, fmt.Errorf("betting module requires a rating service")
+	}
+
 	repo := bettingdb.NewRepository(opts.DB)
-	service := bettingservice.NewService(repo, opts.UserRepo, opts.GuildRepo, opts.LeaderboardRepo, opts.RoundRepo, opts.Observability.Registry.BettingMetrics, logger, tracer, opts.DB)
+	service := bettingservice.NewService(repo, opts.UserRepo, opts.GuildRepo, opts.LeaderboardRepo, opts.RoundRepo, opts.RatingService, opts.Observability.Registry.BettingMetrics, logger, tracer, opts.DB)
+	if opts.LeaderboardService != nil {
+		service.WithTagService(opts.LeaderboardService)
+	}
 
 	var lifecycleRouter *bettingrouter.Router
 	if opts.Router != nil && opts.EventBus != nil {
@@ -70,6 +81,10 @@ func NewModule(ctx context.Context, opts ModuleOptions) (*Module, error) {
 			r.Post("/bets", httpHandlers.HandlePlaceBet)
 			r.Post("/admin/wallet-adjustments", httpHandlers.HandleAdjustWallet)
 			r.Post("/admin/market-actions", httpHandlers.HandleAdminMarketAction)
+			r.Get("/tag-offers", httpHandlers.HandleListTagOffers)
+			r.Post("/tag-offers", httpHandlers.HandleCreateTagOffer)
+			r.Post("/tag-offers/accept", httpHandlers.HandleAcceptTagOffer)
+			r.Post("/tag-offers/cancel", httpHandlers.HandleCancelTagOffer)
 		})
 	}
 
@@ -11,6 +11,7 @@ import (
 	clubtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/club"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
 	clubservice "github.com/Black-And-White-Club/frolf-bot/app/modules/club/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
 	"github.com/google/uuid"
 )
 
@@ -24,7 +25,7 @@ func (h *ClubHandlers) HandleChallengeListRequest(ctx context.Context, payload *
 		return nil, err
 	}
 	return []handlerwrapper.Result{{
-		Topic:   replyTopicFromContext(ctx, clubevents.ChallengeListResponseV1),
+		Topic:   handlerutil.ReplyTopic(ctx, clubevents.ChallengeListResponseV1),
 		Payload: &clubevents.ChallengeListResponsePayloadV1{Challenges: challenges},
 	}}, nil
 }
@@ -39,7 +40,7 @@ func (h *ClubHandlers) HandleChallengeDetailRequest(ctx context.Context, payload
 		return nil, err
 	}
 	return []handlerwrapper.Result{{
-		Topic:   replyTopicFromContext(ctx, clubevents.ChallengeDetailResponseV1),
+		Topic:   handlerutil.ReplyTopic(ctx, clubevents.ChallengeDetailResponseV1),
 		Payload: &clubevents.ChallengeDetailResponsePayloadV1{Challenge: challenge},
 	}}, nil
 }
@@ -227,13 +228,6 @@ func (h *ClubHandlers) challengeResults(baseTopic string, challenge *clubtypes.C
 	return results
 }
 
-func replyTopicFromContext(ctx context.Context, fallback string) string {
-	if replyTo, ok := ctx.Value(handlerwrapper.CtxKeyReplyTo).(string); ok && replyTo != "" {
-		return replyTo
-	}
-	return fallback
-}
-
 func parseChallengeListRequest(payload *clubevents.ChallengeListRequestPayloadV1) (clubservice.ChallengeListRequest, error) {
 	scope, err := parseChallengeScope(payload.ClubUUID, payload.GuildID)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
 	clubservice "github.com/Black-And-White-Club/frolf-bot/app/modules/club/application"
 	clubdb "github.com/Black-And-White-Club/frolf-bot/app/modules/club/infrastructure/repositories"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -83,10 +84,7 @@ func (h *ClubHandlers) HandleClubInfoRequest(ctx context.Context, payload *clube
 	}
 
 	// Determine reply topic (dynamic ReplyTo takes precedence over static constant)
-	replyTopic := clubevents.ClubInfoResponseV2
-	if rt, ok := ctx.Value(handlerwrapper.CtxKeyReplyTo).(string); ok && rt != "" {
-		replyTopic = rt
-	}
+	replyTopic := handlerutil.ReplyTopic(ctx, clubevents.ClubInfoResponseV2)
 
 	return []handlerwrapper.Result{{
 		Topic: replyTopic,
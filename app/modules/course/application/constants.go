@@ -0,0 +1,17 @@
+package courseservice
+
+const (
+	// maxHolePar bounds the par accepted for a single hole.
+	maxHolePar = 10
+
+	// maxLayoutHoles bounds the number of holes accepted for a single layout.
+	maxLayoutHoles = 36
+
+	// defaultLayoutName names layouts registered automatically from imports
+	// that did not say which layout was played.
+	defaultLayoutName = "Main"
+
+	// importedLayoutPrefix names additional auto-registered layouts on a course
+	// whose pars did not match any existing layout.
+	importedLayoutPrefix = "Imported"
+)
@@ -0,0 +1,295 @@
+package courseservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	coursedb "github.com/Black-And-White-Club/frolf-bot/app/modules/course/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// CreateCourse registers a new course in a guild.
+func (s *CourseService) CreateCourse(ctx context.Context, req CreateCourseRequest) (CourseResult, error) {
+	if req.GuildID == "" {
+		return results.FailureResult[*CourseInfo, error](ErrInvalidGuildID), nil
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return results.FailureResult[*CourseInfo, error](fmt.Errorf("%w: name is required", ErrInvalidCourse)), nil
+	}
+
+	return withTelemetry(s, ctx, "CreateCourse", req.GuildID, func(ctx context.Context) (CourseResult, error) {
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (CourseResult, error) {
+			normalized := coursedb.NormalizeName(req.Name)
+			if _, err := s.repo.GetCourseByNormalizedName(ctx, db, req.GuildID, normalized); err == nil {
+				return results.FailureResult[*CourseInfo, error](ErrCourseExists), nil
+			} else if !errors.Is(err, coursedb.ErrNotFound) {
+				return CourseResult{}, err
+			}
+
+			course := &coursedb.Course{
+				GuildID:        req.GuildID,
+				Name:           strings.TrimSpace(req.Name),
+				NormalizedName: normalized,
+				City:           strings.TrimSpace(req.City),
+				UDiscCourseID:  strings.TrimSpace(req.UDiscCourseID),
+			}
+			if err := s.repo.CreateCourse(ctx, db, course); err != nil {
+				return CourseResult{}, err
+			}
+
+			info := toCourseInfo(course)
+			return results.SuccessResult[*CourseInfo, error](&info), nil
+		})
+	})
+}
+
+// GetCourse returns a course and its layouts.
+func (s *CourseService) GetCourse(ctx context.Context, guildID sharedtypes.GuildID, courseID uuid.UUID) (CourseResult, error) {
+	if guildID == "" {
+		return results.FailureResult[*CourseInfo, error](ErrInvalidGuildID), nil
+	}
+
+	return withTelemetry(s, ctx, "GetCourse", guildID, func(ctx context.Context) (CourseResult, error) {
+		course, err := s.repo.GetCourse(ctx, nil, guildID, courseID)
+		if err != nil {
+			if errors.Is(err, coursedb.ErrNotFound) {
+				return results.FailureResult[*CourseInfo, error](ErrCourseNotFound), nil
+			}
+			return CourseResult{}, err
+		}
+
+		info := toCourseInfo(course)
+		return results.SuccessResult[*CourseInfo, error](&info), nil
+	})
+}
+
+// ListCourses returns every course registered in a guild.
+func (s *CourseService) ListCourses(ctx context.Context, guildID sharedtypes.GuildID) (CourseListResult, error) {
+	if guildID == "" {
+		return results.FailureResult[[]CourseInfo, error](ErrInvalidGuildID), nil
+	}
+
+	return withTelemetry(s, ctx, "ListCourses", guildID, func(ctx context.Context) (CourseListResult, error) {
+		courses, err := s.repo.ListCourses(ctx, nil, guildID)
+		if err != nil {
+			return CourseListResult{}, err
+		}
+
+		infos := make([]CourseInfo, 0, len(courses))
+		for _, c := range courses {
+			infos = append(infos, toCourseInfo(c))
+		}
+		return results.SuccessResult[[]CourseInfo, error](infos), nil
+	})
+}
+
+// CreateLayout registers a new layout on an existing course.
+func (s *CourseService) CreateLayout(ctx context.Context, req CreateLayoutRequest) (LayoutResult, error) {
+	if req.GuildID == "" {
+		return results.FailureResult[*LayoutInfo, error](ErrInvalidGuildID), nil
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return results.FailureResult[*LayoutInfo, error](fmt.Errorf("%w: layout name is required", ErrInvalidCourse)), nil
+	}
+	if err := validateHoles(req.Holes); err != nil {
+		return results.FailureResult[*LayoutInfo, error](err), nil
+	}
+
+	return withTelemetry(s, ctx, "CreateLayout", req.GuildID, func(ctx context.Context) (LayoutResult, error) {
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (LayoutResult, error) {
+			course, err := s.repo.GetCourse(ctx, db, req.GuildID, req.CourseID)
+			if err != nil {
+				if errors.Is(err, coursedb.ErrNotFound) {
+					return results.FailureResult[*LayoutInfo, error](ErrCourseNotFound), nil
+				}
+				return LayoutResult{}, err
+			}
+
+			normalized := coursedb.NormalizeName(req.Name)
+			for _, l := range course.Layouts {
+				if l.NormalizedName == normalized {
+					return results.FailureResult[*LayoutInfo, error](ErrLayoutExists), nil
+				}
+			}
+
+			layout, err := s.insertLayout(ctx, db, course, strings.TrimSpace(req.Name), req.Holes)
+			if err != nil {
+				return LayoutResult{}, err
+			}
+
+			info := toLayoutInfo(layout, course.Name)
+			return results.SuccessResult[*LayoutInfo, error](&info), nil
+		})
+	})
+}
+
+// GetLayout returns a layout with its holes.
+func (s *CourseService) GetLayout(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID) (LayoutResult, error) {
+	if guildID == "" {
+		return results.FailureResult[*LayoutInfo, error](ErrInvalidGuildID), nil
+	}
+
+	return withTelemetry(s, ctx, "GetLayout", guildID, func(ctx context.Context) (LayoutResult, error) {
+		layout, err := s.repo.GetLayout(ctx, nil, guildID, layoutID)
+		if err != nil {
+			if errors.Is(err, coursedb.ErrLayoutNotFound) {
+				return results.FailureResult[*LayoutInfo, error](ErrLayoutNotFound), nil
+			}
+			return LayoutResult{}, err
+		}
+
+		info := toLayoutInfo(layout, courseName(layout))
+		return results.SuccessResult[*LayoutInfo, error](&info), nil
+	})
+}
+
+// UpdateLayoutHoles replaces the pars and distances of an existing layout.
+func (s *CourseService) UpdateLayoutHoles(ctx context.Context, req UpdateLayoutHolesRequest) (LayoutResult, error) {
+	if req.GuildID == "" {
+		return results.FailureResult[*LayoutInfo, error](ErrInvalidGuildID), nil
+	}
+	if err := validateHoles(req.Holes); err != nil {
+		return results.FailureResult[*LayoutInfo, error](err), nil
+	}
+
+	return withTelemetry(s, ctx, "UpdateLayoutHoles", req.GuildID, func(ctx context.Context) (LayoutResult, error) {
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (LayoutResult, error) {
+			layout, err := s.repo.GetLayout(ctx, db, req.GuildID, req.LayoutID)
+			if err != nil {
+				if errors.Is(err, coursedb.ErrLayoutNotFound) {
+					return results.FailureResult[*LayoutInfo, error](ErrLayoutNotFound), nil
+				}
+				return LayoutResult{}, err
+			}
+
+			holes := buildHoles(req.Holes)
+			applyHoleTotals(layout, holes)
+			if err := s.repo.ReplaceLayoutHoles(ctx, db, layout, holes); err != nil {
+				if errors.Is(err, coursedb.ErrLayoutNotFound) {
+					return results.FailureResult[*LayoutInfo, error](ErrLayoutNotFound), nil
+				}
+				return LayoutResult{}, err
+			}
+			layout.Holes = holes
+
+			info := toLayoutInfo(layout, courseName(layout))
+			return results.SuccessResult[*LayoutInfo, error](&info), nil
+		})
+	})
+}
+
+// insertLayout builds and persists a layout for course from validated holes.
+func (s *CourseService) insertLayout(
+	ctx context.Context,
+	db bun.IDB,
+	course *coursedb.Course,
+	name string,
+	inputs []HoleInput,
+) (*coursedb.Layout, error) {
+	layout := &coursedb.Layout{
+		CourseID:       course.ID,
+		GuildID:        course.GuildID,
+		Name:           name,
+		NormalizedName: coursedb.NormalizeName(name),
+	}
+	holes := buildHoles(inputs)
+	applyHoleTotals(layout, holes)
+
+	if err := s.repo.CreateLayout(ctx, db, layout, holes); err != nil {
+		return nil, err
+	}
+	layout.Holes = holes
+	layout.Course = course
+	return layout, nil
+}
+
+func validateHoles(holes []HoleInput) error {
+	if len(holes) == 0 {
+		return fmt.Errorf("%w: at least one hole is required", ErrInvalidCourse)
+	}
+	if len(holes) > maxLayoutHoles {
+		return fmt.Errorf("%w: a layout cannot have more than %d holes", ErrInvalidCourse, maxLayoutHoles)
+	}
+	for i, h := range holes {
+		if h.Par < 1 || h.Par > maxHolePar {
+			return fmt.Errorf("%w: hole %d par must be between 1 and %d", ErrInvalidCourse, i+1, maxHolePar)
+		}
+		if h.DistanceFeet != nil && *h.DistanceFeet <= 0 {
+			return fmt.Errorf("%w: hole %d distance must be positive", ErrInvalidCourse, i+1)
+		}
+	}
+	return nil
+}
+
+func buildHoles(inputs []HoleInput) []*coursedb.Hole {
+	holes := make([]*coursedb.Hole, len(inputs))
+	for i, in := range inputs {
+		holes[i] = &coursedb.Hole{
+			HoleNumber:   i + 1,
+			Par:          in.Par,
+			DistanceFeet: in.DistanceFeet,
+		}
+	}
+	return holes
+}
+
+// applyHoleTotals recomputes the derived hole count, total par and par signature.
+func applyHoleTotals(layout *coursedb.Layout, holes []*coursedb.Hole) {
+	pars := make([]int, len(holes))
+	total := 0
+	for i, h := range holes {
+		pars[i] = h.Par
+		total += h.Par
+	}
+	layout.HoleCount = len(holes)
+	layout.TotalPar = total
+	layout.ParSignature = coursedb.ParSignature(pars)
+}
+
+func courseName(layout *coursedb.Layout) string {
+	if layout.Course == nil {
+		return ""
+	}
+	return layout.Course.Name
+}
+
+func toCourseInfo(course *coursedb.Course) CourseInfo {
+	info := CourseInfo{
+		ID:            course.ID,
+		Name:          course.Name,
+		City:          course.City,
+		UDiscCourseID: course.UDiscCourseID,
+		Layouts:       make([]LayoutInfo, 0, len(course.Layouts)),
+	}
+	for _, l := range course.Layouts {
+		info.Layouts = append(info.Layouts, toLayoutInfo(l, course.Name))
+	}
+	return info
+}
+
+func toLayoutInfo(layout *coursedb.Layout, courseName string) LayoutInfo {
+	info := LayoutInfo{
+		ID:         layout.ID,
+		CourseID:   layout.CourseID,
+		CourseName: courseName,
+		Name:       layout.Name,
+		HoleCount:  layout.HoleCount,
+		TotalPar:   layout.TotalPar,
+	}
+	if len(layout.Holes) > 0 {
+		info.Holes = make([]HoleInfo, 0, len(layout.Holes))
+		for _, h := range layout.Holes {
+			info.Holes = append(info.Holes, HoleInfo{
+				Number:       h.HoleNumber,
+				Par:          h.Par,
+				DistanceFeet: h.DistanceFeet,
+			})
+		}
+	}
+	return info
+}
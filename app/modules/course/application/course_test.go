@@ -0,0 +1,257 @@
+package courseservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	coursedb "github.com/Black-And-White-Club/frolf-bot/app/modules/course/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func newTestService(repo coursedb.Repository) *CourseService {
+	return NewCourseService(repo, loggerfrolfbot.NoOpLogger, noop.NewTracerProvider().Tracer("test"), nil)
+}
+
+func TestCourseService_CreateCourse(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		req       CreateCourseRequest
+		setupFake func(*FakeCourseRepository)
+		wantErr   error
+		wantInfra bool
+		verify    func(t *testing.T, res CourseResult, fake *FakeCourseRepository)
+	}{
+		{
+			name: "creates course with normalized name",
+			req:  CreateCourseRequest{GuildID: "guild-1", Name: "  Pier   Park ", City: "Portland"},
+			verify: func(t *testing.T, res CourseResult, fake *FakeCourseRepository) {
+				if res.Success == nil {
+					t.Fatalf("expected success, got %v", res.Failure)
+				}
+				info := *res.Success
+				if info.Name != "Pier   Park" || info.City != "Portland" || info.ID == uuid.Nil {
+					t.Errorf("unexpected course info: %+v", info)
+				}
+			},
+		},
+		{
+			name:    "missing guild",
+			req:     CreateCourseRequest{Name: "Pier Park"},
+			wantErr: ErrInvalidGuildID,
+		},
+		{
+			name:    "missing name",
+			req:     CreateCourseRequest{GuildID: "guild-1", Name: "   "},
+			wantErr: ErrInvalidCourse,
+		},
+		{
+			name: "duplicate name",
+			req:  CreateCourseRequest{GuildID: "guild-1", Name: "pier park"},
+			setupFake: func(f *FakeCourseRepository) {
+				f.GetCourseByNormalizedNameFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, normalizedName string) (*coursedb.Course, error) {
+					if normalizedName != "pier park" {
+						t.Errorf("expected normalized lookup, got %q", normalizedName)
+					}
+					return &coursedb.Course{ID: uuid.New(), Name: "Pier Park"}, nil
+				}
+			},
+			wantErr: ErrCourseExists,
+			verify: func(t *testing.T, res CourseResult, fake *FakeCourseRepository) {
+				for _, step := range fake.Trace() {
+					if step == "CreateCourse" {
+						t.Error("duplicate course should not be inserted")
+					}
+				}
+			},
+		},
+		{
+			name: "repository error",
+			req:  CreateCourseRequest{GuildID: "guild-1", Name: "Pier Park"},
+			setupFake: func(f *FakeCourseRepository) {
+				f.CreateCourseFunc = func(ctx context.Context, db bun.IDB, course *coursedb.Course) error {
+					return errors.New("db down")
+				}
+			},
+			wantInfra: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := NewFakeCourseRepository()
+			if tt.setupFake != nil {
+				tt.setupFake(fake)
+			}
+			res, err := newTestService(fake).CreateCourse(ctx, tt.req)
+			if tt.wantInfra {
+				if err == nil {
+					t.Fatal("expected infra error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil {
+				if res.Failure == nil || !errors.Is(*res.Failure, tt.wantErr) {
+					t.Fatalf("expected failure %v, got %v", tt.wantErr, res.Failure)
+				}
+			}
+			if tt.verify != nil {
+				tt.verify(t, res, fake)
+			}
+		})
+	}
+}
+
+func TestCourseService_CreateLayout(t *testing.T) {
+	ctx := context.Background()
+	courseID := uuid.New()
+	dist := 320
+
+	existing := &coursedb.Course{
+		ID:      courseID,
+		GuildID: "guild-1",
+		Name:    "Pier Park",
+		Layouts: []*coursedb.Layout{{ID: uuid.New(), Name: "Blue", NormalizedName: "blue"}},
+	}
+
+	tests := []struct {
+		name      string
+		req       CreateLayoutRequest
+		setupFake func(*FakeCourseRepository)
+		wantErr   error
+		verify    func(t *testing.T, res LayoutResult)
+	}{
+		{
+			name: "creates layout with derived totals",
+			req: CreateLayoutRequest{
+				GuildID:  "guild-1",
+				CourseID: courseID,
+				Name:     "Red",
+				Holes:    []HoleInput{{Par: 3, DistanceFeet: &dist}, {Par: 4}, {Par: 3}},
+			},
+			setupFake: func(f *FakeCourseRepository) {
+				f.GetCourseFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, id uuid.UUID) (*coursedb.Course, error) {
+					return existing, nil
+				}
+				f.CreateLayoutFunc = func(ctx context.Context, db bun.IDB, layout *coursedb.Layout, holes []*coursedb.Hole) error {
+					if layout.ParSignature != "3,4,3" || layout.TotalPar != 10 || layout.HoleCount != 3 {
+						t.Errorf("unexpected layout totals: %+v", layout)
+					}
+					if holes[0].HoleNumber != 1 || holes[2].HoleNumber != 3 {
+						t.Errorf("holes should be numbered by position")
+					}
+					layout.ID = uuid.New()
+					return nil
+				}
+			},
+			verify: func(t *testing.T, res LayoutResult) {
+				if res.Success == nil {
+					t.Fatalf("expected success, got %v", res.Failure)
+				}
+				info := *res.Success
+				if info.CourseName != "Pier Park" || info.TotalPar != 10 || len(info.Holes) != 3 {
+					t.Errorf("unexpected layout info: %+v", info)
+				}
+				if info.Holes[0].DistanceFeet == nil || *info.Holes[0].DistanceFeet != 320 {
+					t.Errorf("expected hole 1 distance to be kept")
+				}
+			},
+		},
+		{
+			name:    "rejects invalid par",
+			req:     CreateLayoutRequest{GuildID: "guild-1", CourseID: courseID, Name: "Red", Holes: []HoleInput{{Par: 0}}},
+			wantErr: ErrInvalidCourse,
+		},
+		{
+			name:    "rejects empty layout",
+			req:     CreateLayoutRequest{GuildID: "guild-1", CourseID: courseID, Name: "Red"},
+			wantErr: ErrInvalidCourse,
+		},
+		{
+			name:    "course not found",
+			req:     CreateLayoutRequest{GuildID: "guild-1", CourseID: courseID, Name: "Red", Holes: []HoleInput{{Par: 3}}},
+			wantErr: ErrCourseNotFound,
+		},
+		{
+			name: "duplicate layout name",
+			req:  CreateLayoutRequest{GuildID: "guild-1", CourseID: courseID, Name: " BLUE ", Holes: []HoleInput{{Par: 3}}},
+			setupFake: func(f *FakeCourseRepository) {
+				f.GetCourseFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, id uuid.UUID) (*coursedb.Course, error) {
+					return existing, nil
+				}
+			},
+			wantErr: ErrLayoutExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := NewFakeCourseRepository()
+			if tt.setupFake != nil {
+				tt.setupFake(fake)
+			}
+			res, err := newTestService(fake).CreateLayout(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil {
+				if res.Failure == nil || !errors.Is(*res.Failure, tt.wantErr) {
+					t.Fatalf("expected failure %v, got %v", tt.wantErr, res.Failure)
+				}
+			}
+			if tt.verify != nil {
+				tt.verify(t, res)
+			}
+		})
+	}
+}
+
+func TestCourseService_UpdateLayoutHoles(t *testing.T) {
+	ctx := context.Background()
+	layoutID := uuid.New()
+
+	fake := NewFakeCourseRepository()
+	fake.GetLayoutFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, id uuid.UUID) (*coursedb.Layout, error) {
+		return &coursedb.Layout{
+			ID:           layoutID,
+			GuildID:      guildID,
+			Name:         "Main",
+			HoleCount:    2,
+			TotalPar:     6,
+			ParSignature: "3,3",
+			Course:       &coursedb.Course{Name: "Pier Park"},
+		}, nil
+	}
+	var replaced *coursedb.Layout
+	fake.ReplaceLayoutHolesFunc = func(ctx context.Context, db bun.IDB, layout *coursedb.Layout, holes []*coursedb.Hole) error {
+		replaced = layout
+		return nil
+	}
+
+	res, err := newTestService(fake).UpdateLayoutHoles(ctx, UpdateLayoutHolesRequest{
+		GuildID:  "guild-1",
+		LayoutID: layoutID,
+		Holes:    []HoleInput{{Par: 3}, {Par: 5}, {Par: 4}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Success == nil {
+		t.Fatalf("expected success, got %v", res.Failure)
+	}
+	if replaced == nil || replaced.ParSignature != "3,5,4" || replaced.TotalPar != 12 || replaced.HoleCount != 3 {
+		t.Fatalf("expected totals to be recomputed, got %+v", replaced)
+	}
+	if got := (*res.Success).ParScores(); len(got) != 3 || got[1] != 5 {
+		t.Errorf("unexpected pars: %v", got)
+	}
+}
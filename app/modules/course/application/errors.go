@@ -0,0 +1,29 @@
+package courseservice
+
+import "errors"
+
+// Domain errors for the course service.
+// These represent business logic failures that handlers should treat as
+// normal outcomes rather than retrying.
+var (
+	// ErrInvalidGuildID indicates the request did not carry a guild ID.
+	ErrInvalidGuildID = errors.New("invalid guild ID")
+
+	// ErrCourseNotFound indicates the course does not exist in the guild.
+	ErrCourseNotFound = errors.New("course not found")
+
+	// ErrLayoutNotFound indicates the layout does not exist in the guild.
+	ErrLayoutNotFound = errors.New("course layout not found")
+
+	// ErrInvalidCourse indicates a course or layout definition is incomplete.
+	ErrInvalidCourse = errors.New("invalid course definition")
+
+	// ErrCourseExists indicates a course with the same name is already registered.
+	ErrCourseExists = errors.New("course already exists")
+
+	// ErrLayoutExists indicates the course already has a layout with the same name.
+	ErrLayoutExists = errors.New("course layout already exists")
+
+	// ErrAmbiguousLayout indicates several layouts match an import and none can be chosen.
+	ErrAmbiguousLayout = errors.New("multiple course layouts match")
+)
@@ -0,0 +1,129 @@
+package courseservice
+
+import (
+	"context"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	coursedb "github.com/Black-And-White-Club/frolf-bot/app/modules/course/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ------------------------
+// Fake Course Repo
+// ------------------------
+
+// FakeCourseRepository provides a programmable stub for the coursedb.Repository interface.
+type FakeCourseRepository struct {
+	trace []string
+
+	CreateCourseFunc              func(ctx context.Context, db bun.IDB, course *coursedb.Course) error
+	GetCourseFunc                 func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, courseID uuid.UUID) (*coursedb.Course, error)
+	GetCourseByNormalizedNameFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, normalizedName string) (*coursedb.Course, error)
+	ListCoursesFunc               func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) ([]*coursedb.Course, error)
+	CreateLayoutFunc              func(ctx context.Context, db bun.IDB, layout *coursedb.Layout, holes []*coursedb.Hole) error
+	GetLayoutFunc                 func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID) (*coursedb.Layout, error)
+	FindLayoutsByParSignatureFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, signature string) ([]*coursedb.Layout, error)
+	LockGuildRegistryFunc         func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) error
+	ReplaceLayoutHolesFunc        func(ctx context.Context, db bun.IDB, layout *coursedb.Layout, holes []*coursedb.Hole) error
+}
+
+// NewFakeCourseRepository initializes a new FakeCourseRepository with an empty trace.
+func NewFakeCourseRepository() *FakeCourseRepository {
+	return &FakeCourseRepository{
+		trace: []string{},
+	}
+}
+
+// Trace returns the sequence of method calls made to the fake.
+func (f *FakeCourseRepository) Trace() []string {
+	out := make([]string, len(f.trace))
+	copy(out, f.trace)
+	return out
+}
+
+func (f *FakeCourseRepository) record(step string) {
+	f.trace = append(f.trace, step)
+}
+
+// --- Repository Interface Implementation ---
+
+func (f *FakeCourseRepository) CreateCourse(ctx context.Context, db bun.IDB, course *coursedb.Course) error {
+	f.record("CreateCourse")
+	if f.CreateCourseFunc != nil {
+		return f.CreateCourseFunc(ctx, db, course)
+	}
+	if course.ID == uuid.Nil {
+		course.ID = uuid.New()
+	}
+	return nil
+}
+
+func (f *FakeCourseRepository) GetCourse(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, courseID uuid.UUID) (*coursedb.Course, error) {
+	f.record("GetCourse")
+	if f.GetCourseFunc != nil {
+		return f.GetCourseFunc(ctx, db, guildID, courseID)
+	}
+	return nil, coursedb.ErrNotFound
+}
+
+func (f *FakeCourseRepository) GetCourseByNormalizedName(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, normalizedName string) (*coursedb.Course, error) {
+	f.record("GetCourseByNormalizedName")
+	if f.GetCourseByNormalizedNameFunc != nil {
+		return f.GetCourseByNormalizedNameFunc(ctx, db, guildID, normalizedName)
+	}
+	return nil, coursedb.ErrNotFound
+}
+
+func (f *FakeCourseRepository) ListCourses(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) ([]*coursedb.Course, error) {
+	f.record("ListCourses")
+	if f.ListCoursesFunc != nil {
+		return f.ListCoursesFunc(ctx, db, guildID)
+	}
+	return nil, nil
+}
+
+func (f *FakeCourseRepository) CreateLayout(ctx context.Context, db bun.IDB, layout *coursedb.Layout, holes []*coursedb.Hole) error {
+	f.record("CreateLayout")
+	if f.CreateLayoutFunc != nil {
+		return f.CreateLayoutFunc(ctx, db, layout, holes)
+	}
+	if layout.ID == uuid.Nil {
+		layout.ID = uuid.New()
+	}
+	return nil
+}
+
+func (f *FakeCourseRepository) GetLayout(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID) (*coursedb.Layout, error) {
+	f.record("GetLayout")
+	if f.GetLayoutFunc != nil {
+		return f.GetLayoutFunc(ctx, db, guildID, layoutID)
+	}
+	return nil, coursedb.ErrLayoutNotFound
+}
+
+func (f *FakeCourseRepository) FindLayoutsByParSignature(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, signature string) ([]*coursedb.Layout, error) {
+	f.record("FindLayoutsByParSignature")
+	if f.FindLayoutsByParSignatureFunc != nil {
+		return f.FindLayoutsByParSignatureFunc(ctx, db, guildID, signature)
+	}
+	return nil, nil
+}
+
+func (f *FakeCourseRepository) LockGuildRegistry(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) error {
+	f.record("LockGuildRegistry")
+	if f.LockGuildRegistryFunc != nil {
+		return f.LockGuildRegistryFunc(ctx, db, guildID)
+	}
+	return nil
+}
+
+func (f *FakeCourseRepository) ReplaceLayoutHoles(ctx context.Context, db bun.IDB, layout *coursedb.Layout, holes []*coursedb.Hole) error {
+	f.record("ReplaceLayoutHoles")
+	if f.ReplaceLayoutHolesFunc != nil {
+		return f.ReplaceLayoutHolesFunc(ctx, db, layout, holes)
+	}
+	return nil
+}
+
+var _ coursedb.Repository = (*FakeCourseRepository)(nil)
@@ -0,0 +1,109 @@
+package courseservice
+
+import (
+	"context"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	"github.com/google/uuid"
+)
+
+// Result type aliases to reduce generic verbosity.
+type (
+	CourseResult     = results.OperationResult[*CourseInfo, error]
+	CourseListResult = results.OperationResult[[]CourseInfo, error]
+	LayoutResult     = results.OperationResult[*LayoutInfo, error]
+)
+
+// Service defines the interface for course registry operations.
+type Service interface {
+	CreateCourse(ctx context.Context, req CreateCourseRequest) (CourseResult, error)
+	GetCourse(ctx context.Context, guildID sharedtypes.GuildID, courseID uuid.UUID) (CourseResult, error)
+	ListCourses(ctx context.Context, guildID sharedtypes.GuildID) (CourseListResult, error)
+
+	CreateLayout(ctx context.Context, req CreateLayoutRequest) (LayoutResult, error)
+	GetLayout(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID) (LayoutResult, error)
+	UpdateLayoutHoles(ctx context.Context, req UpdateLayoutHolesRequest) (LayoutResult, error)
+
+	// MatchOrCreateLayout resolves the layout an imported scorecard was played on,
+	// registering the course and/or layout when nothing matches. Calls for the same
+	// guild are serialized, so concurrent imports converge on one layout.
+	MatchOrCreateLayout(ctx context.Context, req MatchLayoutRequest) (LayoutResult, error)
+}
+
+// CreateCourseRequest registers a new course in a guild.
+type CreateCourseRequest struct {
+	GuildID       sharedtypes.GuildID `json:"guild_id"`
+	Name          string              `json:"name"`
+	City          string              `json:"city,omitempty"`
+	UDiscCourseID string              `json:"udisc_course_id,omitempty"`
+}
+
+// HoleInput describes one hole when creating or editing a layout.
+// Holes are numbered by their position in the slice.
+type HoleInput struct {
+	Par          int  `json:"par"`
+	DistanceFeet *int `json:"distance_feet,omitempty"`
+}
+
+// CreateLayoutRequest registers a new layout on an existing course.
+type CreateLayoutRequest struct {
+	GuildID  sharedtypes.GuildID `json:"guild_id"`
+	CourseID uuid.UUID           `json:"course_id"`
+	Name     string              `json:"name"`
+	Holes    []HoleInput         `json:"holes"`
+}
+
+// UpdateLayoutHolesRequest replaces the holes of an existing layout.
+type UpdateLayoutHolesRequest struct {
+	GuildID  sharedtypes.GuildID `json:"guild_id"`
+	LayoutID uuid.UUID           `json:"layout_id"`
+	Holes    []HoleInput         `json:"holes"`
+}
+
+// MatchLayoutRequest carries what a scorecard import knows about the course.
+type MatchLayoutRequest struct {
+	GuildID    sharedtypes.GuildID
+	CourseName string
+	LayoutName string
+	ParScores  []int
+}
+
+// CourseInfo is the API view of a course.
+type CourseInfo struct {
+	ID            uuid.UUID    `json:"id"`
+	Name          string       `json:"name"`
+	City          string       `json:"city,omitempty"`
+	UDiscCourseID string       `json:"udisc_course_id,omitempty"`
+	Layouts       []LayoutInfo `json:"layouts"`
+}
+
+// HoleInfo is the API view of one hole.
+type HoleInfo struct {
+	Number       int  `json:"number"`
+	Par          int  `json:"par"`
+	DistanceFeet *int `json:"distance_feet,omitempty"`
+}
+
+// LayoutInfo is the API view of a layout. Holes are omitted when listing courses.
+type LayoutInfo struct {
+	ID         uuid.UUID  `json:"id"`
+	CourseID   uuid.UUID  `json:"course_id"`
+	CourseName string     `json:"course_name,omitempty"`
+	Name       string     `json:"name"`
+	HoleCount  int        `json:"hole_count"`
+	TotalPar   int        `json:"total_par"`
+	Holes      []HoleInfo `json:"holes,omitempty"`
+}
+
+// ParScores returns the layout's pars ordered by hole number.
+func (l *LayoutInfo) ParScores() []int {
+	if l == nil {
+		return nil
+	}
+	pars := make([]int, len(l.Holes))
+	for i, h := range l.Holes {
+		pars[i] = h.Par
+	}
+	return pars
+}
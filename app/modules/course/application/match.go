@@ -0,0 +1,158 @@
+package courseservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	coursedb "github.com/Black-And-White-Club/frolf-bot/app/modules/course/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+// MatchOrCreateLayout resolves the layout an imported scorecard was played on.
+//
+// Matching order:
+//  1. Course by normalized name, then its layout with the same par sequence
+//     (or the same layout name when pars agree).
+//  2. Known course but no matching pars: register a new layout on that course.
+//  3. Unknown course: register the course with a single layout.
+//  4. No course name at all: match by par sequence across the guild, but only
+//     when exactly one layout has those pars.
+func (s *CourseService) MatchOrCreateLayout(ctx context.Context, req MatchLayoutRequest) (LayoutResult, error) {
+	if req.GuildID == "" {
+		return results.FailureResult[*LayoutInfo, error](ErrInvalidGuildID), nil
+	}
+	if len(req.ParScores) == 0 {
+		return results.FailureResult[*LayoutInfo, error](fmt.Errorf("%w: par scores are required", ErrInvalidCourse)), nil
+	}
+	inputs := make([]HoleInput, len(req.ParScores))
+	for i, par := range req.ParScores {
+		inputs[i] = HoleInput{Par: par}
+	}
+	if err := validateHoles(inputs); err != nil {
+		return results.FailureResult[*LayoutInfo, error](err), nil
+	}
+
+	return withTelemetry(s, ctx, "MatchOrCreateLayout", req.GuildID, func(ctx context.Context) (LayoutResult, error) {
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (LayoutResult, error) {
+			return s.executeMatchOrCreateLayout(ctx, db, req, inputs)
+		})
+	})
+}
+
+func (s *CourseService) executeMatchOrCreateLayout(
+	ctx context.Context,
+	db bun.IDB,
+	req MatchLayoutRequest,
+	inputs []HoleInput,
+) (LayoutResult, error) {
+	signature := coursedb.ParSignature(req.ParScores)
+	name := strings.TrimSpace(req.CourseName)
+
+	if name == "" {
+		layouts, err := s.repo.FindLayoutsByParSignature(ctx, db, req.GuildID, signature)
+		if err != nil {
+			return LayoutResult{}, err
+		}
+		switch len(layouts) {
+		case 0:
+			return results.FailureResult[*LayoutInfo, error](ErrLayoutNotFound), nil
+		case 1:
+			info := toLayoutInfo(layouts[0], courseName(layouts[0]))
+			return results.SuccessResult[*LayoutInfo, error](&info), nil
+		default:
+			return results.FailureResult[*LayoutInfo, error](ErrAmbiguousLayout), nil
+		}
+	}
+
+	if err := s.repo.LockGuildRegistry(ctx, db, req.GuildID); err != nil {
+		return LayoutResult{}, err
+	}
+
+	course, err := s.repo.GetCourseByNormalizedName(ctx, db, req.GuildID, coursedb.NormalizeName(name))
+	if err != nil && !errors.Is(err, coursedb.ErrNotFound) {
+		return LayoutResult{}, err
+	}
+
+	if course == nil {
+		course = &coursedb.Course{
+			GuildID: req.GuildID,
+			Name:    name,
+		}
+		if err := s.repo.CreateCourse(ctx, db, course); err != nil {
+			return LayoutResult{}, err
+		}
+	}
+
+	if match := pickLayout(course.Layouts, signature, req.LayoutName); match != nil {
+		layout, err := s.repo.GetLayout(ctx, db, req.GuildID, match.ID)
+		if err != nil {
+			return LayoutResult{}, err
+		}
+		info := toLayoutInfo(layout, course.Name)
+		return results.SuccessResult[*LayoutInfo, error](&info), nil
+	}
+
+	layout, err := s.insertLayout(ctx, db, course, newLayoutName(course.Layouts, req.LayoutName), inputs)
+	if err != nil {
+		return LayoutResult{}, err
+	}
+
+	s.logger.InfoContext(ctx, "Registered course layout from import",
+		"guild_id", string(req.GuildID),
+		"course", course.Name,
+		"layout", layout.Name,
+		"holes", layout.HoleCount,
+	)
+
+	info := toLayoutInfo(layout, course.Name)
+	return results.SuccessResult[*LayoutInfo, error](&info), nil
+}
+
+// pickLayout chooses the existing layout with the given par signature, preferring
+// one whose name also matches. A layout with the requested name but different
+// pars is not a match: the import is treated as a new layout.
+func pickLayout(layouts []*coursedb.Layout, signature, layoutName string) *coursedb.Layout {
+	normalized := coursedb.NormalizeName(layoutName)
+	var first *coursedb.Layout
+	for _, l := range layouts {
+		if l.ParSignature != signature {
+			continue
+		}
+		if normalized != "" && l.NormalizedName == normalized {
+			return l
+		}
+		if first == nil {
+			first = l
+		}
+	}
+	return first
+}
+
+// newLayoutName picks a name for an auto-registered layout that does not collide
+// with the course's existing layouts.
+func newLayoutName(existing []*coursedb.Layout, requested string) string {
+	taken := make(map[string]bool, len(existing))
+	for _, l := range existing {
+		taken[l.NormalizedName] = true
+	}
+
+	base := strings.TrimSpace(requested)
+	if base == "" {
+		if len(existing) == 0 {
+			return defaultLayoutName
+		}
+		base = importedLayoutPrefix
+	}
+	if !taken[coursedb.NormalizeName(base)] {
+		return base
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s %d", base, i)
+		if !taken[coursedb.NormalizeName(candidate)] {
+			return candidate
+		}
+	}
+}
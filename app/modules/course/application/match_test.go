@@ -0,0 +1,181 @@
+package courseservice
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	coursedb "github.com/Black-And-White-Club/frolf-bot/app/modules/course/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+func TestCourseService_MatchOrCreateLayout(t *testing.T) {
+	ctx := context.Background()
+	blueID := uuid.New()
+
+	pier := func() *coursedb.Course {
+		return &coursedb.Course{
+			ID:      uuid.New(),
+			GuildID: "guild-1",
+			Name:    "Pier Park",
+			Layouts: []*coursedb.Layout{
+				{ID: blueID, Name: "Blue", NormalizedName: "blue", ParSignature: "3,3,4"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		req       MatchLayoutRequest
+		setupFake func(*FakeCourseRepository)
+		wantErr   error
+		verify    func(t *testing.T, res LayoutResult, fake *FakeCourseRepository)
+	}{
+		{
+			name: "matches existing layout by course name and pars",
+			req:  MatchLayoutRequest{GuildID: "guild-1", CourseName: "PIER park", ParScores: []int{3, 3, 4}},
+			setupFake: func(f *FakeCourseRepository) {
+				f.GetCourseByNormalizedNameFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, name string) (*coursedb.Course, error) {
+					return pier(), nil
+				}
+				f.GetLayoutFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, id uuid.UUID) (*coursedb.Layout, error) {
+					return &coursedb.Layout{ID: id, Name: "Blue", HoleCount: 3, TotalPar: 10}, nil
+				}
+			},
+			verify: func(t *testing.T, res LayoutResult, fake *FakeCourseRepository) {
+				if res.Success == nil || (*res.Success).ID != blueID {
+					t.Fatalf("expected Blue layout, got %+v / %v", res.Success, res.Failure)
+				}
+				if slices.Contains(fake.Trace(), "CreateLayout") {
+					t.Error("matching layout should not create a new one")
+				}
+			},
+		},
+		{
+			name: "known course with new pars registers imported layout",
+			req:  MatchLayoutRequest{GuildID: "guild-1", CourseName: "Pier Park", ParScores: []int{3, 4, 4}},
+			setupFake: func(f *FakeCourseRepository) {
+				f.GetCourseByNormalizedNameFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, name string) (*coursedb.Course, error) {
+					return pier(), nil
+				}
+			},
+			verify: func(t *testing.T, res LayoutResult, fake *FakeCourseRepository) {
+				if res.Success == nil {
+					t.Fatalf("expected success, got %v", res.Failure)
+				}
+				if (*res.Success).Name != "Imported" || (*res.Success).TotalPar != 11 {
+					t.Errorf("unexpected layout: %+v", *res.Success)
+				}
+				if slices.Contains(fake.Trace(), "CreateCourse") {
+					t.Error("existing course should be reused")
+				}
+			},
+		},
+		{
+			name: "unknown course registers course and main layout",
+			req:  MatchLayoutRequest{GuildID: "guild-1", CourseName: "Blue Lake", ParScores: []int{3, 3}},
+			verify: func(t *testing.T, res LayoutResult, fake *FakeCourseRepository) {
+				if res.Success == nil {
+					t.Fatalf("expected success, got %v", res.Failure)
+				}
+				info := *res.Success
+				if info.Name != "Main" || info.CourseName != "Blue Lake" || info.HoleCount != 2 {
+					t.Errorf("unexpected layout: %+v", info)
+				}
+				want := []string{"LockGuildRegistry", "GetCourseByNormalizedName", "CreateCourse", "CreateLayout"}
+				if !slices.Equal(fake.Trace(), want) {
+					t.Errorf("trace = %v, want %v", fake.Trace(), want)
+				}
+			},
+		},
+		{
+			name: "no course name matches unique par signature",
+			req:  MatchLayoutRequest{GuildID: "guild-1", ParScores: []int{3, 3, 4}},
+			setupFake: func(f *FakeCourseRepository) {
+				f.FindLayoutsByParSignatureFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, signature string) ([]*coursedb.Layout, error) {
+					if signature != "3,3,4" {
+						t.Errorf("unexpected signature %q", signature)
+					}
+					return []*coursedb.Layout{{ID: blueID, Name: "Blue", Course: &coursedb.Course{Name: "Pier Park"}}}, nil
+				}
+			},
+			verify: func(t *testing.T, res LayoutResult, fake *FakeCourseRepository) {
+				if res.Success == nil || (*res.Success).CourseName != "Pier Park" {
+					t.Fatalf("expected Pier Park layout, got %+v / %v", res.Success, res.Failure)
+				}
+			},
+		},
+		{
+			name: "no course name with several matches is ambiguous",
+			req:  MatchLayoutRequest{GuildID: "guild-1", ParScores: []int{3, 3, 4}},
+			setupFake: func(f *FakeCourseRepository) {
+				f.FindLayoutsByParSignatureFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, signature string) ([]*coursedb.Layout, error) {
+					return []*coursedb.Layout{{ID: uuid.New()}, {ID: uuid.New()}}, nil
+				}
+			},
+			wantErr: ErrAmbiguousLayout,
+		},
+		{
+			name:    "no course name and no match",
+			req:     MatchLayoutRequest{GuildID: "guild-1", ParScores: []int{3}},
+			wantErr: ErrLayoutNotFound,
+		},
+		{
+			name:    "missing pars",
+			req:     MatchLayoutRequest{GuildID: "guild-1", CourseName: "Pier Park"},
+			wantErr: ErrInvalidCourse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := NewFakeCourseRepository()
+			if tt.setupFake != nil {
+				tt.setupFake(fake)
+			}
+			res, err := newTestService(fake).MatchOrCreateLayout(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil {
+				if res.Failure == nil || !errors.Is(*res.Failure, tt.wantErr) {
+					t.Fatalf("expected failure %v, got %v", tt.wantErr, res.Failure)
+				}
+			}
+			if tt.verify != nil {
+				tt.verify(t, res, fake)
+			}
+		})
+	}
+}
+
+func TestNewLayoutName(t *testing.T) {
+	existing := []*coursedb.Layout{
+		{NormalizedName: "main"},
+		{NormalizedName: "imported"},
+		{NormalizedName: "imported 2"},
+	}
+
+	tests := []struct {
+		name      string
+		existing  []*coursedb.Layout
+		requested string
+		want      string
+	}{
+		{name: "first layout", want: "Main"},
+		{name: "requested name free", existing: existing, requested: "Long Tees", want: "Long Tees"},
+		{name: "requested name taken", existing: existing, requested: "Main", want: "Main 2"},
+		{name: "imported suffix", existing: existing, want: "Imported 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newLayoutName(tt.existing, tt.requested); got != tt.want {
+				t.Errorf("newLayoutName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
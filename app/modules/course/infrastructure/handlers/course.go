@@ -0,0 +1,220 @@
+package coursehandlers
+
+import (
+	"context"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	courseservice "github.com/Black-And-White-Club/frolf-bot/app/modules/course/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+	"github.com/google/uuid"
+)
+
+// Course topics.
+const (
+	CourseCreateRequestedV1            = "course.create.requested.v1"
+	CourseCreatedV1                    = "course.created.v1"
+	CourseLayoutCreateRequestedV1      = "course.layout.create.requested.v1"
+	CourseLayoutCreatedV1              = "course.layout.created.v1"
+	CourseLayoutHolesUpdateRequestedV1 = "course.layout.holes.update.requested.v1"
+	CourseLayoutUpdatedV1              = "course.layout.updated.v1"
+	CourseFailedV1                     = "course.failed.v1"
+	CourseListRequestV1                = "course.list.request.v1"
+	CourseListResponseV1               = "course.list.response.v1"
+	CourseLayoutGetRequestV1           = "course.layout.get.request.v1"
+	CourseLayoutGetResponseV1          = "course.layout.get.response.v1"
+)
+
+// CourseCreateRequestPayloadV1 is the request to register a course.
+type CourseCreateRequestPayloadV1 struct {
+	GuildID       sharedtypes.GuildID   `json:"guild_id"`
+	UserID        sharedtypes.DiscordID `json:"user_id"`
+	Name          string                `json:"name"`
+	City          string                `json:"city,omitempty"`
+	UDiscCourseID string                `json:"udisc_course_id,omitempty"`
+}
+
+// CourseLayoutCreateRequestPayloadV1 is the request to add a layout to a course.
+type CourseLayoutCreateRequestPayloadV1 struct {
+	GuildID  sharedtypes.GuildID       `json:"guild_id"`
+	UserID   sharedtypes.DiscordID     `json:"user_id"`
+	CourseID uuid.UUID                 `json:"course_id"`
+	Name     string                    `json:"name"`
+	Holes    []courseservice.HoleInput `json:"holes"`
+}
+
+// CourseLayoutHolesUpdateRequestPayloadV1 replaces a layout's par and distances.
+type CourseLayoutHolesUpdateRequestPayloadV1 struct {
+	GuildID  sharedtypes.GuildID       `json:"guild_id"`
+	UserID   sharedtypes.DiscordID     `json:"user_id"`
+	LayoutID uuid.UUID                 `json:"layout_id"`
+	Holes    []courseservice.HoleInput `json:"holes"`
+}
+
+// CourseListRequestPayloadV1 is the request/reply payload for listing a guild's courses.
+type CourseListRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+}
+
+// CourseListResponsePayloadV1 is the reply for CourseListRequestV1.
+type CourseListResponsePayloadV1 struct {
+	GuildID sharedtypes.GuildID        `json:"guild_id"`
+	Courses []courseservice.CourseInfo `json:"courses"`
+}
+
+// CourseLayoutGetRequestPayloadV1 is the request/reply payload for one layout with holes.
+type CourseLayoutGetRequestPayloadV1 struct {
+	GuildID  sharedtypes.GuildID `json:"guild_id"`
+	LayoutID uuid.UUID           `json:"layout_id"`
+}
+
+// CourseLayoutGetResponsePayloadV1 is the reply for CourseLayoutGetRequestV1.
+type CourseLayoutGetResponsePayloadV1 struct {
+	GuildID sharedtypes.GuildID       `json:"guild_id"`
+	Layout  *courseservice.LayoutInfo `json:"layout,omitempty"`
+	Error   string                    `json:"error,omitempty"`
+}
+
+// CourseResultPayloadV1 is published after a course is created.
+type CourseResultPayloadV1 struct {
+	GuildID sharedtypes.GuildID       `json:"guild_id"`
+	Course  *courseservice.CourseInfo `json:"course"`
+}
+
+// CourseLayoutResultPayloadV1 is published after a layout is created or edited.
+type CourseLayoutResultPayloadV1 struct {
+	GuildID sharedtypes.GuildID       `json:"guild_id"`
+	Layout  *courseservice.LayoutInfo `json:"layout"`
+}
+
+// CourseFailedPayloadV1 reports a rejected course operation.
+type CourseFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	Reason  string                `json:"reason"`
+}
+
+// HandleCourseCreateRequest registers a course (admin only).
+func (h *CourseHandlers) HandleCourseCreateRequest(ctx context.Context, payload *CourseCreateRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.requireAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.courseFailure(ctx, payload.GuildID, payload.UserID, err), nil
+	}
+
+	result, err := h.service.CreateCourse(ctx, courseservice.CreateCourseRequest{
+		GuildID:       payload.GuildID,
+		Name:          payload.Name,
+		City:          payload.City,
+		UDiscCourseID: payload.UDiscCourseID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.courseFailure(ctx, payload.GuildID, payload.UserID, *result.Failure), nil
+	}
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic:   CourseCreatedV1,
+		Payload: &CourseResultPayloadV1{GuildID: payload.GuildID, Course: *result.Success},
+	}}), nil
+}
+
+// HandleCourseLayoutCreateRequest adds a layout with its holes to a course (admin only).
+func (h *CourseHandlers) HandleCourseLayoutCreateRequest(ctx context.Context, payload *CourseLayoutCreateRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.requireAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.courseFailure(ctx, payload.GuildID, payload.UserID, err), nil
+	}
+
+	result, err := h.service.CreateLayout(ctx, courseservice.CreateLayoutRequest{
+		GuildID:  payload.GuildID,
+		CourseID: payload.CourseID,
+		Name:     payload.Name,
+		Holes:    payload.Holes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.courseFailure(ctx, payload.GuildID, payload.UserID, *result.Failure), nil
+	}
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic:   CourseLayoutCreatedV1,
+		Payload: &CourseLayoutResultPayloadV1{GuildID: payload.GuildID, Layout: *result.Success},
+	}}), nil
+}
+
+// HandleCourseLayoutHolesUpdateRequest replaces a layout's holes (admin only).
+func (h *CourseHandlers) HandleCourseLayoutHolesUpdateRequest(ctx context.Context, payload *CourseLayoutHolesUpdateRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.requireAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.courseFailure(ctx, payload.GuildID, payload.UserID, err), nil
+	}
+
+	result, err := h.service.UpdateLayoutHoles(ctx, courseservice.UpdateLayoutHolesRequest{
+		GuildID:  payload.GuildID,
+		LayoutID: payload.LayoutID,
+		Holes:    payload.Holes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.courseFailure(ctx, payload.GuildID, payload.UserID, *result.Failure), nil
+	}
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic:   CourseLayoutUpdatedV1,
+		Payload: &CourseLayoutResultPayloadV1{GuildID: payload.GuildID, Layout: *result.Success},
+	}}), nil
+}
+
+// HandleCourseListRequest replies with the guild's courses and their layouts.
+func (h *CourseHandlers) HandleCourseListRequest(ctx context.Context, payload *CourseListRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	result, err := h.service.ListCourses(ctx, payload.GuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CourseListResponsePayloadV1{GuildID: payload.GuildID, Courses: []courseservice.CourseInfo{}}
+	if result.Success != nil {
+		response.Courses = *result.Success
+	}
+
+	return []handlerwrapper.Result{{Topic: handlerutil.ReplyTopic(ctx, CourseListResponseV1), Payload: response}}, nil
+}
+
+// HandleCourseLayoutGetRequest replies with one layout including per-hole par and distance.
+func (h *CourseHandlers) HandleCourseLayoutGetRequest(ctx context.Context, payload *CourseLayoutGetRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	result, err := h.service.GetLayout(ctx, payload.GuildID, payload.LayoutID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CourseLayoutGetResponsePayloadV1{GuildID: payload.GuildID}
+	switch {
+	case result.Failure != nil:
+		response.Error = (*result.Failure).Error()
+	case result.Success != nil:
+		response.Layout = *result.Success
+	}
+
+	return []handlerwrapper.Result{{Topic: handlerutil.ReplyTopic(ctx, CourseLayoutGetResponseV1), Payload: response}}, nil
+}
+
+func (h *CourseHandlers) courseFailure(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID, err error) []handlerwrapper.Result {
+	h.logger.WarnContext(ctx, "Course request rejected",
+		attr.String("guild_id", string(guildID)),
+		attr.String("user_id", string(userID)),
+		attr.Error(err),
+	)
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: CourseFailedV1,
+		Payload: &CourseFailedPayloadV1{
+			GuildID: guildID,
+			UserID:  userID,
+			Reason:  err.Error(),
+		},
+	}})
+}
@@ -0,0 +1,156 @@
+package coursehandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	courseservice "github.com/Black-And-White-Club/frolf-bot/app/modules/course/application"
+	"github.com/google/uuid"
+)
+
+func TestCourseHandlers_HandleCourseCreateRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-1")
+	courseID := uuid.New()
+
+	tests := []struct {
+		name       string
+		role       *FakeRoleLookup
+		setupFake  func(*FakeService)
+		replyTo    string
+		wantTopics []string
+	}{
+		{
+			name: "admin creates course",
+			role: &FakeRoleLookup{Role: sharedtypes.UserRoleAdmin},
+			setupFake: func(f *FakeService) {
+				f.CreateCourseFunc = func(ctx context.Context, req courseservice.CreateCourseRequest) (courseservice.CourseResult, error) {
+					return results.SuccessResult[*courseservice.CourseInfo, error](&courseservice.CourseInfo{ID: courseID, Name: req.Name}), nil
+				}
+			},
+			wantTopics: []string{CourseCreatedV1},
+		},
+		{
+			name: "admin request mirrors result to reply inbox",
+			role: &FakeRoleLookup{Role: sharedtypes.UserRoleAdmin},
+			setupFake: func(f *FakeService) {
+				f.CreateCourseFunc = func(ctx context.Context, req courseservice.CreateCourseRequest) (courseservice.CourseResult, error) {
+					return results.SuccessResult[*courseservice.CourseInfo, error](&courseservice.CourseInfo{ID: courseID}), nil
+				}
+			},
+			replyTo:    "_INBOX.course",
+			wantTopics: []string{CourseCreatedV1, "_INBOX.course"},
+		},
+		{
+			name:       "non-admin is rejected",
+			role:       &FakeRoleLookup{Role: sharedtypes.UserRoleUser},
+			wantTopics: []string{CourseFailedV1},
+		},
+		{
+			name:       "role lookup error is rejected",
+			role:       &FakeRoleLookup{Err: errors.New("db down")},
+			wantTopics: []string{CourseFailedV1},
+		},
+		{
+			name: "service failure publishes failed topic",
+			role: &FakeRoleLookup{Role: sharedtypes.UserRoleAdmin},
+			setupFake: func(f *FakeService) {
+				f.CreateCourseFunc = func(ctx context.Context, req courseservice.CreateCourseRequest) (courseservice.CourseResult, error) {
+					return results.FailureResult[*courseservice.CourseInfo, error](courseservice.ErrCourseExists), nil
+				}
+			},
+			wantTopics: []string{CourseFailedV1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &FakeService{}
+			if tt.setupFake != nil {
+				tt.setupFake(svc)
+			}
+			h := NewCourseHandlers(svc, tt.role, slog.Default())
+
+			ctx := context.Background()
+			if tt.replyTo != "" {
+				ctx = context.WithValue(ctx, handlerwrapper.CtxKeyReplyTo, tt.replyTo)
+			}
+
+			got, err := h.HandleCourseCreateRequest(ctx, &CourseCreateRequestPayloadV1{
+				GuildID: guildID,
+				UserID:  "user-1",
+				Name:    "Maple Hill",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.wantTopics) {
+				t.Fatalf("expected %d results, got %d", len(tt.wantTopics), len(got))
+			}
+			for i, topic := range tt.wantTopics {
+				if got[i].Topic != topic {
+					t.Errorf("result %d topic = %s, want %s", i, got[i].Topic, topic)
+				}
+			}
+		})
+	}
+}
+
+func TestCourseHandlers_HandleCourseLayoutGetRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-1")
+	layoutID := uuid.New()
+
+	tests := []struct {
+		name       string
+		setupFake  func(*FakeService)
+		wantLayout bool
+		wantError  string
+	}{
+		{
+			name: "found",
+			setupFake: func(f *FakeService) {
+				f.GetLayoutFunc = func(ctx context.Context, g sharedtypes.GuildID, id uuid.UUID) (courseservice.LayoutResult, error) {
+					return results.SuccessResult[*courseservice.LayoutInfo, error](&courseservice.LayoutInfo{ID: id}), nil
+				}
+			},
+			wantLayout: true,
+		},
+		{
+			name: "not found is reported in payload",
+			setupFake: func(f *FakeService) {
+				f.GetLayoutFunc = func(ctx context.Context, g sharedtypes.GuildID, id uuid.UUID) (courseservice.LayoutResult, error) {
+					return results.FailureResult[*courseservice.LayoutInfo, error](courseservice.ErrLayoutNotFound), nil
+				}
+			},
+			wantError: courseservice.ErrLayoutNotFound.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &FakeService{}
+			tt.setupFake(svc)
+			h := NewCourseHandlers(svc, &FakeRoleLookup{}, slog.Default())
+
+			ctx := context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.layout")
+			got, err := h.HandleCourseLayoutGetRequest(ctx, &CourseLayoutGetRequestPayloadV1{GuildID: guildID, LayoutID: layoutID})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 1 || got[0].Topic != "_INBOX.layout" {
+				t.Fatalf("expected single reply to inbox, got %+v", got)
+			}
+			payload := got[0].Payload.(*CourseLayoutGetResponsePayloadV1)
+			if (payload.Layout != nil) != tt.wantLayout {
+				t.Errorf("layout present = %v, want %v", payload.Layout != nil, tt.wantLayout)
+			}
+			if payload.Error != tt.wantError {
+				t.Errorf("error = %q, want %q", payload.Error, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package coursehandlers
+
+import (
+	"context"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	courseservice "github.com/Black-And-White-Club/frolf-bot/app/modules/course/application"
+	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
+	"github.com/google/uuid"
+)
+
+// FakeService implements courseservice.Service for handler testing.
+type FakeService struct {
+	CreateCourseFunc        func(ctx context.Context, req courseservice.CreateCourseRequest) (courseservice.CourseResult, error)
+	GetCourseFunc           func(ctx context.Context, guildID sharedtypes.GuildID, courseID uuid.UUID) (courseservice.CourseResult, error)
+	ListCoursesFunc         func(ctx context.Context, guildID sharedtypes.GuildID) (courseservice.CourseListResult, error)
+	CreateLayoutFunc        func(ctx context.Context, req courseservice.CreateLayoutRequest) (courseservice.LayoutResult, error)
+	GetLayoutFunc           func(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID) (courseservice.LayoutResult, error)
+	UpdateLayoutHolesFunc   func(ctx context.Context, req courseservice.UpdateLayoutHolesRequest) (courseservice.LayoutResult, error)
+	MatchOrCreateLayoutFunc func(ctx context.Context, req courseservice.MatchLayoutRequest) (courseservice.LayoutResult, error)
+}
+
+func (f *FakeService) CreateCourse(ctx context.Context, req courseservice.CreateCourseRequest) (courseservice.CourseResult, error) {
+	if f.CreateCourseFunc != nil {
+		return f.CreateCourseFunc(ctx, req)
+	}
+	return courseservice.CourseResult{}, nil
+}
+
+func (f *FakeService) GetCourse(ctx context.Context, guildID sharedtypes.GuildID, courseID uuid.UUID) (courseservice.CourseResult, error) {
+	if f.GetCourseFunc != nil {
+		return f.GetCourseFunc(ctx, guildID, courseID)
+	}
+	return courseservice.CourseResult{}, nil
+}
+
+func (f *FakeService) ListCourses(ctx context.Context, guildID sharedtypes.GuildID) (courseservice.CourseListResult, error) {
+	if f.ListCoursesFunc != nil {
+		return f.ListCoursesFunc(ctx, guildID)
+	}
+	return courseservice.CourseListResult{}, nil
+}
+
+func (f *FakeService) CreateLayout(ctx context.Context, req courseservice.CreateLayoutRequest) (courseservice.LayoutResult, error) {
+	if f.CreateLayoutFunc != nil {
+		return f.CreateLayoutFunc(ctx, req)
+	}
+	return courseservice.LayoutResult{}, nil
+}
+
+func (f *FakeService) GetLayout(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID) (courseservice.LayoutResult, error) {
+	if f.GetLayoutFunc != nil {
+		return f.GetLayoutFunc(ctx, guildID, layoutID)
+	}
+	return courseservice.LayoutResult{}, nil
+}
+
+func (f *FakeService) UpdateLayoutHoles(ctx context.Context, req courseservice.UpdateLayoutHolesRequest) (courseservice.LayoutResult, error) {
+	if f.UpdateLayoutHolesFunc != nil {
+		return f.UpdateLayoutHolesFunc(ctx, req)
+	}
+	return courseservice.LayoutResult{}, nil
+}
+
+func (f *FakeService) MatchOrCreateLayout(ctx context.Context, req courseservice.MatchLayoutRequest) (courseservice.LayoutResult, error) {
+	if f.MatchOrCreateLayoutFunc != nil {
+		return f.MatchOrCreateLayoutFunc(ctx, req)
+	}
+	return courseservice.LayoutResult{}, nil
+}
+
+var _ courseservice.Service = (*FakeService)(nil)
+
+// FakeRoleLookup implements RoleLookup with a fixed role.
+type FakeRoleLookup struct {
+	Role sharedtypes.UserRoleEnum
+	Err  error
+}
+
+func (f *FakeRoleLookup) GetUserRole(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+	if f.Err != nil {
+		return userservice.UserRoleResult{}, f.Err
+	}
+	return results.SuccessResult[sharedtypes.UserRoleEnum, error](f.Role), nil
+}
@@ -0,0 +1,51 @@
+package coursehandlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	courseservice "github.com/Black-And-White-Club/frolf-bot/app/modules/course/application"
+	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
+)
+
+// RoleLookup resolves a member's role so course edits can be restricted to admins.
+type RoleLookup interface {
+	GetUserRole(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error)
+}
+
+// CourseHandlers implements the Handlers interface for course events.
+type CourseHandlers struct {
+	service    courseservice.Service
+	roleLookup RoleLookup
+	logger     *slog.Logger
+}
+
+// NewCourseHandlers creates a new CourseHandlers instance.
+func NewCourseHandlers(service courseservice.Service, roleLookup RoleLookup, logger *slog.Logger) Handlers {
+	return &CourseHandlers{
+		service:    service,
+		roleLookup: roleLookup,
+		logger:     logger,
+	}
+}
+
+// requireAdminRole rejects course edits from members who are not guild admins.
+func (h *CourseHandlers) requireAdminRole(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) error {
+	if userID == "" {
+		return fmt.Errorf("course changes require a valid user_id")
+	}
+	roleResult, err := h.roleLookup.GetUserRole(ctx, guildID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to verify admin role: %w", err)
+	}
+	if roleResult.Failure != nil {
+		return fmt.Errorf("failed to verify admin role: %w", *roleResult.Failure)
+	}
+	if roleResult.Success == nil || *roleResult.Success != sharedtypes.UserRoleAdmin {
+		return fmt.Errorf("admin role required to edit courses")
+	}
+	return nil
+}
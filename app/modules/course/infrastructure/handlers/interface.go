@@ -0,0 +1,19 @@
+package coursehandlers
+
+import (
+	"context"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+)
+
+// Handlers defines the contract for course registry message handlers.
+type Handlers interface {
+	// Mutations (admin only)
+	HandleCourseCreateRequest(ctx context.Context, payload *CourseCreateRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleCourseLayoutCreateRequest(ctx context.Context, payload *CourseLayoutCreateRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleCourseLayoutHolesUpdateRequest(ctx context.Context, payload *CourseLayoutHolesUpdateRequestPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Request/reply reads
+	HandleCourseListRequest(ctx context.Context, payload *CourseListRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleCourseLayoutGetRequest(ctx context.Context, payload *CourseLayoutGetRequestPayloadV1) ([]handlerwrapper.Result, error)
+}
@@ -0,0 +1,230 @@
+package coursedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// Impl implements the Repository interface using Bun ORM.
+type Impl struct {
+	db bun.IDB
+}
+
+// NewRepository creates a new course repository.
+func NewRepository(db bun.IDB) Repository {
+	return &Impl{db: db}
+}
+
+// CreateCourse inserts a new course.
+func (r *Impl) CreateCourse(ctx context.Context, db bun.IDB, course *Course) error {
+	if db == nil {
+		db = r.db
+	}
+	if course.ID == uuid.Nil {
+		course.ID = uuid.New()
+	}
+	if course.NormalizedName == "" {
+		course.NormalizedName = NormalizeName(course.Name)
+	}
+	if _, err := db.NewInsert().Model(course).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create course: %w", err)
+	}
+	return nil
+}
+
+// GetCourse retrieves a course and its layouts (without holes).
+func (r *Impl) GetCourse(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, courseID uuid.UUID) (*Course, error) {
+	if db == nil {
+		db = r.db
+	}
+	course := new(Course)
+	err := db.NewSelect().
+		Model(course).
+		Relation("Layouts", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("cl.name ASC")
+		}).
+		Where("c.id = ? AND c.guild_id = ?", courseID, guildID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get course: %w", err)
+	}
+	return course, nil
+}
+
+// GetCourseByNormalizedName retrieves a course by its normalized name.
+func (r *Impl) GetCourseByNormalizedName(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, normalizedName string) (*Course, error) {
+	if db == nil {
+		db = r.db
+	}
+	course := new(Course)
+	err := db.NewSelect().
+		Model(course).
+		Relation("Layouts").
+		Where("c.guild_id = ? AND c.normalized_name = ?", guildID, normalizedName).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get course by name: %w", err)
+	}
+	return course, nil
+}
+
+// ListCourses returns every course in a guild with its layouts, ordered by name.
+func (r *Impl) ListCourses(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) ([]*Course, error) {
+	if db == nil {
+		db = r.db
+	}
+	var courses []*Course
+	err := db.NewSelect().
+		Model(&courses).
+		Relation("Layouts", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("cl.name ASC")
+		}).
+		Where("c.guild_id = ?", guildID).
+		Order("c.name ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list courses: %w", err)
+	}
+	return courses, nil
+}
+
+// CreateLayout inserts a layout together with its holes.
+func (r *Impl) CreateLayout(ctx context.Context, db bun.IDB, layout *Layout, holes []*Hole) error {
+	if db == nil {
+		db = r.db
+	}
+	if layout.ID == uuid.Nil {
+		layout.ID = uuid.New()
+	}
+	if layout.NormalizedName == "" {
+		layout.NormalizedName = NormalizeName(layout.Name)
+	}
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().Model(layout).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create course layout: %w", err)
+		}
+		for _, h := range holes {
+			h.LayoutID = layout.ID
+		}
+		if len(holes) > 0 {
+			if _, err := tx.NewInsert().Model(&holes).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to create course holes: %w", err)
+			}
+		}
+		layout.Holes = holes
+		return nil
+	})
+}
+
+// GetLayout retrieves a layout with its course and holes.
+func (r *Impl) GetLayout(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID) (*Layout, error) {
+	if db == nil {
+		db = r.db
+	}
+	layout := new(Layout)
+	err := db.NewSelect().
+		Model(layout).
+		Relation("Course").
+		Relation("Holes", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("ch.hole_number ASC")
+		}).
+		Where("cl.id = ? AND cl.guild_id = ?", layoutID, guildID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrLayoutNotFound
+		}
+		return nil, fmt.Errorf("failed to get course layout: %w", err)
+	}
+	return layout, nil
+}
+
+// FindLayoutsByParSignature returns the guild's layouts with exactly this par sequence.
+func (r *Impl) FindLayoutsByParSignature(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, signature string) ([]*Layout, error) {
+	if db == nil {
+		db = r.db
+	}
+	var layouts []*Layout
+	err := db.NewSelect().
+		Model(&layouts).
+		Relation("Course").
+		Relation("Holes", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("ch.hole_number ASC")
+		}).
+		Where("cl.guild_id = ? AND cl.par_signature = ?", guildID, signature).
+		Order("cl.created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find course layouts by par: %w", err)
+	}
+	return layouts, nil
+}
+
+// ReplaceLayoutHoles rewrites the holes of a layout and its derived totals.
+func (r *Impl) ReplaceLayoutHoles(ctx context.Context, db bun.IDB, layout *Layout, holes []*Hole) error {
+	if db == nil {
+		db = r.db
+	}
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		res, err := tx.NewUpdate().
+			Model(layout).
+			Column("hole_count", "total_par", "par_signature").
+			Set("updated_at = now()").
+			Where("id = ? AND guild_id = ?", layout.ID, layout.GuildID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update course layout: %w", err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrLayoutNotFound
+		}
+
+		if _, err := tx.NewDelete().
+			Model((*Hole)(nil)).
+			Where("layout_id = ?", layout.ID).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to clear course holes: %w", err)
+		}
+		for _, h := range holes {
+			h.LayoutID = layout.ID
+		}
+		if len(holes) > 0 {
+			if _, err := tx.NewInsert().Model(&holes).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to create course holes: %w", err)
+			}
+		}
+		layout.Holes = holes
+		return nil
+	})
+}
+
+// LockGuildRegistry takes a transaction-scoped advisory lock on the guild's registry so
+// concurrent match-or-create calls see each other's courses and layouts instead of
+// registering duplicates.
+func (r *Impl) LockGuildRegistry(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) error {
+	if db == nil {
+		db = r.db
+	}
+	key := fmt.Sprintf("course-registry:%s", guildID)
+	if _, err := db.NewRaw("SELECT pg_advisory_xact_lock(('x' || substr(md5(?), 1, 16))::bit(64)::bigint)", key).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to lock course registry: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+package coursedb
+
+import "errors"
+
+// Sentinel errors for the course repository layer.
+var (
+	// ErrNotFound indicates the requested course does not exist.
+	ErrNotFound = errors.New("course not found")
+
+	// ErrLayoutNotFound indicates the requested layout does not exist.
+	ErrLayoutNotFound = errors.New("course layout not found")
+)
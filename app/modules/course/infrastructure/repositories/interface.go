@@ -0,0 +1,44 @@
+package coursedb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// Repository defines the contract for course persistence.
+//
+// Error semantics:
+//   - ErrNotFound: course does not exist in the guild
+//   - ErrLayoutNotFound: layout does not exist in the guild
+type Repository interface {
+	// CreateCourse inserts a new course.
+	CreateCourse(ctx context.Context, db bun.IDB, course *Course) error
+
+	// GetCourse retrieves a course and its layouts (without holes).
+	GetCourse(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, courseID uuid.UUID) (*Course, error)
+
+	// GetCourseByNormalizedName retrieves a course by its normalized name.
+	GetCourseByNormalizedName(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, normalizedName string) (*Course, error)
+
+	// ListCourses returns every course in a guild with its layouts, ordered by name.
+	ListCourses(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) ([]*Course, error)
+
+	// CreateLayout inserts a layout together with its holes.
+	CreateLayout(ctx context.Context, db bun.IDB, layout *Layout, holes []*Hole) error
+
+	// GetLayout retrieves a layout with its course and holes.
+	GetLayout(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID) (*Layout, error)
+
+	// FindLayoutsByParSignature returns the guild's layouts with exactly this par sequence.
+	FindLayoutsByParSignature(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, signature string) ([]*Layout, error)
+
+	// LockGuildRegistry serializes registry writes for a guild until the transaction ends.
+	LockGuildRegistry(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) error
+
+	// ReplaceLayoutHoles rewrites the holes of a layout and its derived totals.
+	ReplaceLayoutHoles(ctx context.Context, db bun.IDB, layout *Layout, holes []*Hole) error
+}
@@ -0,0 +1,82 @@
+package coursemigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Creating course registry tables...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				CREATE TABLE IF NOT EXISTS courses (
+					id UUID PRIMARY KEY,
+					guild_id VARCHAR NOT NULL,
+					name VARCHAR NOT NULL,
+					normalized_name VARCHAR NOT NULL,
+					city VARCHAR,
+					udisc_course_id VARCHAR,
+					created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					UNIQUE (guild_id, normalized_name)
+				);
+			`); err != nil {
+				return fmt.Errorf("failed to create courses table: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE TABLE IF NOT EXISTS course_layouts (
+					id UUID PRIMARY KEY,
+					course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+					guild_id VARCHAR NOT NULL,
+					name VARCHAR NOT NULL,
+					normalized_name VARCHAR NOT NULL,
+					hole_count INTEGER NOT NULL CHECK (hole_count > 0),
+					total_par INTEGER NOT NULL,
+					par_signature VARCHAR NOT NULL,
+					created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					UNIQUE (course_id, normalized_name)
+				);
+			`); err != nil {
+				return fmt.Errorf("failed to create course_layouts table: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_course_layouts_guild_signature
+					ON course_layouts (guild_id, par_signature);
+			`); err != nil {
+				return fmt.Errorf("failed to create course_layouts signature index: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE TABLE IF NOT EXISTS course_holes (
+					layout_id UUID NOT NULL REFERENCES course_layouts(id) ON DELETE CASCADE,
+					hole_number INTEGER NOT NULL CHECK (hole_number >= 1),
+					par INTEGER NOT NULL CHECK (par >= 1),
+					distance_feet INTEGER,
+					PRIMARY KEY (layout_id, hole_number)
+				);
+			`); err != nil {
+				return fmt.Errorf("failed to create course_holes table: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping course registry tables...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			for _, table := range []string{"course_holes", "course_layouts", "courses"} {
+				if _, err := tx.ExecContext(ctx, fmt.Sprintf("DROP TABLE IF EXISTS %s;", table)); err != nil {
+					return fmt.Errorf("failed to drop %s table: %w", table, err)
+				}
+			}
+			return nil
+		})
+	})
+}
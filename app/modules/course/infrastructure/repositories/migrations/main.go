@@ -0,0 +1,5 @@
+package coursemigrations
+
+import "github.com/uptrace/bun/migrate"
+
+var Migrations = migrate.NewMigrations()
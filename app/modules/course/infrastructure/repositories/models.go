@@ -0,0 +1,87 @@
+package coursedb
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// Course is a disc golf course known to a guild.
+type Course struct {
+	bun.BaseModel `bun:"table:courses,alias:c"`
+
+	ID             uuid.UUID           `bun:"id,pk,type:uuid"`
+	GuildID        sharedtypes.GuildID `bun:"guild_id,notnull"`
+	Name           string              `bun:"name,notnull"`
+	NormalizedName string              `bun:"normalized_name,notnull"`
+	City           string              `bun:"city,nullzero"`
+	UDiscCourseID  string              `bun:"udisc_course_id,nullzero"`
+	CreatedAt      time.Time           `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time           `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	Layouts []*Layout `bun:"rel:has-many,join:id=course_id"`
+}
+
+// Layout is a specific set of tees and baskets on a course.
+type Layout struct {
+	bun.BaseModel `bun:"table:course_layouts,alias:cl"`
+
+	ID             uuid.UUID           `bun:"id,pk,type:uuid"`
+	CourseID       uuid.UUID           `bun:"course_id,notnull,type:uuid"`
+	GuildID        sharedtypes.GuildID `bun:"guild_id,notnull"`
+	Name           string              `bun:"name,notnull"`
+	NormalizedName string              `bun:"normalized_name,notnull"`
+	HoleCount      int                 `bun:"hole_count,notnull"`
+	TotalPar       int                 `bun:"total_par,notnull"`
+	ParSignature   string              `bun:"par_signature,notnull"`
+	CreatedAt      time.Time           `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time           `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+
+	Course *Course `bun:"rel:belongs-to,join:course_id=id"`
+	Holes  []*Hole `bun:"rel:has-many,join:id=layout_id"`
+}
+
+// Hole is the par and optional distance of one hole on a layout.
+type Hole struct {
+	bun.BaseModel `bun:"table:course_holes,alias:ch"`
+
+	LayoutID     uuid.UUID `bun:"layout_id,pk,type:uuid"`
+	HoleNumber   int       `bun:"hole_number,pk"`
+	Par          int       `bun:"par,notnull"`
+	DistanceFeet *int      `bun:"distance_feet"`
+}
+
+// ParScores returns the layout's pars ordered by hole number.
+func (l *Layout) ParScores() []int {
+	if l == nil {
+		return nil
+	}
+	pars := make([]int, l.HoleCount)
+	for _, h := range l.Holes {
+		if h != nil && h.HoleNumber >= 1 && h.HoleNumber <= len(pars) {
+			pars[h.HoleNumber-1] = h.Par
+		}
+	}
+	return pars
+}
+
+// ParSignature encodes a par sequence so layouts can be matched by an exact index
+// lookup (e.g. "3,3,4,3").
+func ParSignature(pars []int) string {
+	parts := make([]string, len(pars))
+	for i, p := range pars {
+		parts[i] = strconv.Itoa(p)
+	}
+	return strings.Join(parts, ",")
+}
+
+// NormalizeName lowercases and collapses whitespace so "Pier  Park " and
+// "pier park" refer to the same course.
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
@@ -0,0 +1,97 @@
+package courserouter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	coursehandlers "github.com/Black-And-White-Club/frolf-bot/app/modules/course/infrastructure/handlers"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Router struct {
+	logger     *slog.Logger
+	router     *message.Router
+	subscriber eventbus.EventBus
+	publisher  eventbus.EventBus
+	helper     utils.Helpers
+	tracer     trace.Tracer
+}
+
+func NewRouter(
+	logger *slog.Logger,
+	router *message.Router,
+	subscriber eventbus.EventBus,
+	publisher eventbus.EventBus,
+	helper utils.Helpers,
+	tracer trace.Tracer,
+) *Router {
+	return &Router{
+		logger:     logger,
+		router:     router,
+		subscriber: subscriber,
+		publisher:  publisher,
+		helper:     helper,
+		tracer:     tracer,
+	}
+}
+
+func (r *Router) Configure(_ context.Context, handlers coursehandlers.Handlers) error {
+	deps := handlerDeps{
+		router:     r.router,
+		subscriber: r.subscriber,
+		publisher:  r.publisher,
+		logger:     r.logger,
+		tracer:     r.tracer,
+		helper:     r.helper,
+	}
+
+	registerHandler(deps, coursehandlers.CourseCreateRequestedV1, handlers.HandleCourseCreateRequest)
+	registerHandler(deps, coursehandlers.CourseLayoutCreateRequestedV1, handlers.HandleCourseLayoutCreateRequest)
+	registerHandler(deps, coursehandlers.CourseLayoutHolesUpdateRequestedV1, handlers.HandleCourseLayoutHolesUpdateRequest)
+	// NATS request/reply: course.*.request.v1.> captures per-club subjects
+	registerHandler(deps, coursehandlers.CourseListRequestV1+".>", handlers.HandleCourseListRequest)
+	registerHandler(deps, coursehandlers.CourseLayoutGetRequestV1+".>", handlers.HandleCourseLayoutGetRequest)
+
+	return nil
+}
+
+type handlerDeps struct {
+	router     *message.Router
+	subscriber eventbus.EventBus
+	publisher  eventbus.EventBus
+	logger     *slog.Logger
+	tracer     trace.Tracer
+	helper     utils.Helpers
+}
+
+func registerHandler[T any](
+	deps handlerDeps,
+	topic string,
+	handler func(context.Context, *T) ([]handlerwrapper.Result, error),
+) {
+	handlerName := "course." + topic
+
+	deps.router.AddHandler(
+		handlerName,
+		topic,
+		deps.subscriber,
+		"",
+		deps.publisher,
+		handlerwrapper.WrapTransformingTyped(
+			handlerName,
+			deps.logger,
+			deps.tracer,
+			deps.helper,
+			nil,
+			handler,
+		),
+	)
+}
+
+func (r *Router) Close() error {
+	return r.router.Close()
+}
@@ -0,0 +1,64 @@
+package course
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	courseservice "github.com/Black-And-White-Club/frolf-bot/app/modules/course/application"
+	coursehandlers "github.com/Black-And-White-Club/frolf-bot/app/modules/course/infrastructure/handlers"
+	coursedb "github.com/Black-And-White-Club/frolf-bot/app/modules/course/infrastructure/repositories"
+	courserouter "github.com/Black-And-White-Club/frolf-bot/app/modules/course/infrastructure/router"
+	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/uptrace/bun"
+)
+
+type Module struct {
+	CourseService courseservice.Service
+	Router        *courserouter.Router
+	observability observability.Observability
+}
+
+type ModuleOptions struct {
+	Observability observability.Observability
+	EventBus      eventbus.EventBus
+	Router        *message.Router
+	Helpers       utils.Helpers
+	RouterCtx     context.Context
+	DB            *bun.DB
+	UserService   userservice.Service
+}
+
+func NewModule(ctx context.Context, opts ModuleOptions) (*Module, error) {
+	logger := opts.Observability.Provider.Logger
+	tracer := opts.Observability.Registry.Tracer
+
+	repo := coursedb.NewRepository(opts.DB)
+	service := courseservice.NewCourseService(repo, logger, tracer, opts.DB)
+
+	var courseRouter *courserouter.Router
+	if opts.Router != nil && opts.EventBus != nil {
+		handlers := coursehandlers.NewCourseHandlers(service, opts.UserService, logger)
+		courseRouter = courserouter.NewRouter(logger, opts.Router, opts.EventBus, opts.EventBus, opts.Helpers, tracer)
+		if err := courseRouter.Configure(opts.RouterCtx, handlers); err != nil {
+			return nil, fmt.Errorf("failed to configure course router: %w", err)
+		}
+	}
+
+	return &Module{
+		CourseService: service,
+		Router:        courseRouter,
+		observability: opts.Observability,
+	}, nil
+}
+
+func (m *Module) Close() error {
+	if m.Router != nil {
+		return m.Router.Close()
+	}
+
+	return nil
+}
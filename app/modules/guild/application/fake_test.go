@@ -20,6 +20,7 @@ type FakeGuildRepository struct {
 
 	GetConfigFunc                 func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*guildtypes.GuildConfig, error)
 	GetConfigIncludeDeletedFunc   func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*guildtypes.GuildConfig, error)
+	GetConfigModelFunc            func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*guilddb.GuildConfig, error)
 	SaveConfigFunc                func(ctx context.Context, db bun.IDB, config *guildtypes.GuildConfig) error
 	UpdateConfigFunc              func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, updates *guilddb.UpdateFields) error
 	DeleteConfigFunc              func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) error
@@ -70,6 +71,14 @@ func (f *FakeGuildRepository) GetConfigIncludeDeleted(ctx context.Context, db bu
 	return nil, guilddb.ErrNotFound
 }
 
+func (f *FakeGuildRepository) GetConfigModel(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*guilddb.GuildConfig, error) {
+	f.record("GetConfigModel")
+	if f.GetConfigModelFunc != nil {
+		return f.GetConfigModelFunc(ctx, db, guildID)
+	}
+	return nil, guilddb.ErrNotFound
+}
+
 func (f *FakeGuildRepository) SaveConfig(ctx context.Context, db bun.IDB, config *guildtypes.GuildConfig) error {
 	f.record("SaveConfig")
 	if f.SaveConfigFunc != nil {
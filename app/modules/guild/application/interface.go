@@ -14,6 +14,18 @@ import (
 // Defined here so it's available to both the interface and the implementation.
 type GuildConfigResult = results.OperationResult[*guildtypes.GuildConfig, error]
 
+// TagPoolConfigResult is the result of reading or updating a guild's tag pool settings.
+type TagPoolConfigResult = results.OperationResult[*TagPoolConfig, error]
+
+// TagPoolConfig is the tag pool selection stored on a guild's config, with the
+// custom leaderboards switch that gates divisions. Model is closed, open or capped;
+// Cap is the number of contested positions for the capped model.
+type TagPoolConfig struct {
+	Model                     string
+	Cap                       int
+	CustomLeaderboardsEnabled bool
+}
+
 type GrantAccessRequest struct {
 	ClubUUID   uuid.UUID
 	FeatureKey guildtypes.ClubFeatureKey
@@ -49,6 +61,9 @@ type Service interface {
 	UpdateGuildConfig(ctx context.Context, config *guildtypes.GuildConfig) (GuildConfigResult, error)
 	DeleteGuildConfig(ctx context.Context, guildID sharedtypes.GuildID) (GuildConfigResult, error)
 
+	GetTagPoolConfig(ctx context.Context, guildID sharedtypes.GuildID) (TagPoolConfigResult, error)
+	UpdateTagPoolConfig(ctx context.Context, guildID sharedtypes.GuildID, model string, capacity int) (TagPoolConfigResult, error)
+
 	ResolveClubEntitlements(ctx context.Context, clubUUID uuid.UUID) (guildtypes.ResolvedClubEntitlements, error)
 	ResolveClubFeature(ctx context.Context, clubUUID uuid.UUID, featureKey guildtypes.ClubFeatureKey) (guildtypes.ClubFeatureAccess, error)
 	ResolveClubFeatureByGuildID(ctx context.Context, guildID sharedtypes.GuildID, featureKey guildtypes.ClubFeatureKey) (guildtypes.ClubFeatureAccess, error)
@@ -0,0 +1,93 @@
+package guildservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	guilddb "github.com/Black-And-White-Club/frolf-bot/app/modules/guild/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+// GetTagPoolConfig retrieves the tag pool settings of a guild configuration.
+func (s *GuildService) GetTagPoolConfig(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+) (TagPoolConfigResult, error) {
+	if guildID == "" {
+		return results.FailureResult[*TagPoolConfig, error](ErrInvalidGuildID), nil
+	}
+
+	getTx := func(ctx context.Context, db bun.IDB) (TagPoolConfigResult, error) {
+		return s.executeGetTagPoolConfig(ctx, db, guildID)
+	}
+
+	result, err := withTelemetry(s, ctx, "GetTagPoolConfig", guildID, func(ctx context.Context) (TagPoolConfigResult, error) {
+		return runInTx(s, ctx, getTx)
+	})
+
+	if err != nil {
+		return TagPoolConfigResult{}, fmt.Errorf("GetTagPoolConfig failed for %s: %w", guildID, err)
+	}
+
+	return result, nil
+}
+
+// UpdateTagPoolConfig stores the tag pool model and cap on a guild configuration.
+// The custom leaderboards switch is not changed.
+func (s *GuildService) UpdateTagPoolConfig(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	model string,
+	capacity int,
+) (TagPoolConfigResult, error) {
+	if guildID == "" {
+		return results.FailureResult[*TagPoolConfig, error](ErrInvalidGuildID), nil
+	}
+
+	updateTx := func(ctx context.Context, db bun.IDB) (TagPoolConfigResult, error) {
+		updates := &guilddb.UpdateFields{
+			TagPoolModel: &model,
+			TagPoolCap:   &capacity,
+		}
+		if err := s.repo.UpdateConfig(ctx, db, guildID, updates); err != nil {
+			if errors.Is(err, guilddb.ErrNoRowsAffected) {
+				return results.FailureResult[*TagPoolConfig, error](ErrGuildConfigNotFound), nil
+			}
+			return TagPoolConfigResult{}, fmt.Errorf("failed to update tag pool config in DB: %w", err)
+		}
+		return s.executeGetTagPoolConfig(ctx, db, guildID)
+	}
+
+	result, err := withTelemetry(s, ctx, "UpdateTagPoolConfig", guildID, func(ctx context.Context) (TagPoolConfigResult, error) {
+		return runInTx(s, ctx, updateTx)
+	})
+
+	if err != nil {
+		return TagPoolConfigResult{}, fmt.Errorf("UpdateTagPoolConfig failed for %s: %w", guildID, err)
+	}
+
+	return result, nil
+}
+
+func (s *GuildService) executeGetTagPoolConfig(
+	ctx context.Context,
+	db bun.IDB,
+	guildID sharedtypes.GuildID,
+) (TagPoolConfigResult, error) {
+	model, err := s.repo.GetConfigModel(ctx, db, guildID)
+	if err != nil {
+		if errors.Is(err, guilddb.ErrNotFound) {
+			return results.FailureResult[*TagPoolConfig, error](ErrGuildConfigNotFound), nil
+		}
+		return TagPoolConfigResult{}, err
+	}
+
+	return results.SuccessResult[*TagPoolConfig, error](&TagPoolConfig{
+		Model:                     model.TagPoolModel,
+		Cap:                       model.TagPoolCap,
+		CustomLeaderboardsEnabled: model.CustomLeaderboardsEnabled,
+	}), nil
+}
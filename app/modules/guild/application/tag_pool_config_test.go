@@ -0,0 +1,97 @@
+package guildservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	guildmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/guild"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	guilddb "github.com/Black-And-White-Club/frolf-bot/app/modules/guild/infrastructure/repositories"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func newTagPoolTestService(repo *FakeGuildRepository) *GuildService {
+	return &GuildService{
+		repo:    repo,
+		logger:  loggerfrolfbot.NoOpLogger,
+		metrics: &guildmetrics.NoOpMetrics{},
+		tracer:  noop.NewTracerProvider().Tracer("test"),
+	}
+}
+
+func TestGuildService_GetTagPoolConfig(t *testing.T) {
+	t.Run("reads the stored settings", func(t *testing.T) {
+		repo := NewFakeGuildRepository()
+		repo.GetConfigModelFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*guilddb.GuildConfig, error) {
+			return &guilddb.GuildConfig{GuildID: guildID, TagPoolModel: "capped", TagPoolCap: 3, CustomLeaderboardsEnabled: true}, nil
+		}
+
+		res, err := newTagPoolTestService(repo).GetTagPoolConfig(context.Background(), "guild-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Success == nil {
+			t.Fatalf("expected success, got %+v", res)
+		}
+		got := *res.Success
+		if got.Model != "capped" || got.Cap != 3 || !got.CustomLeaderboardsEnabled {
+			t.Fatalf("unexpected tag pool config: %+v", got)
+		}
+	})
+
+	t.Run("missing config is a domain failure", func(t *testing.T) {
+		res, err := newTagPoolTestService(NewFakeGuildRepository()).GetTagPoolConfig(context.Background(), "guild-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrGuildConfigNotFound) {
+			t.Fatalf("expected ErrGuildConfigNotFound, got %+v", res)
+		}
+	})
+}
+
+func TestGuildService_UpdateTagPoolConfig(t *testing.T) {
+	t.Run("updates only the tag pool fields", func(t *testing.T) {
+		repo := NewFakeGuildRepository()
+		var got *guilddb.UpdateFields
+		repo.UpdateConfigFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, updates *guilddb.UpdateFields) error {
+			got = updates
+			return nil
+		}
+		repo.GetConfigModelFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*guilddb.GuildConfig, error) {
+			return &guilddb.GuildConfig{GuildID: guildID, TagPoolModel: "open"}, nil
+		}
+
+		res, err := newTagPoolTestService(repo).UpdateTagPoolConfig(context.Background(), "guild-1", "open", 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Success == nil || (*res.Success).Model != "open" {
+			t.Fatalf("expected updated config, got %+v", res)
+		}
+		if got == nil || got.TagPoolModel == nil || *got.TagPoolModel != "open" || got.TagPoolCap == nil || *got.TagPoolCap != 0 {
+			t.Fatalf("expected tag pool fields to be set, got %+v", got)
+		}
+		if got.SignupChannelID != nil || got.AutoSetupCompleted != nil {
+			t.Fatalf("unrelated fields must not be updated, got %+v", got)
+		}
+	})
+
+	t.Run("missing config is a domain failure", func(t *testing.T) {
+		repo := NewFakeGuildRepository()
+		repo.UpdateConfigFunc = func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, updates *guilddb.UpdateFields) error {
+			return guilddb.ErrNoRowsAffected
+		}
+
+		res, err := newTagPoolTestService(repo).UpdateTagPoolConfig(context.Background(), "guild-1", "open", 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrGuildConfigNotFound) {
+			t.Fatalf("expected ErrGuildConfigNotFound, got %+v", res)
+		}
+	})
+}
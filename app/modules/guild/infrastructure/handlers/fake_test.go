@@ -23,6 +23,9 @@ type FakeGuildService struct {
 	UpdateGuildConfigFunc func(ctx context.Context, config *guildtypes.GuildConfig) (guildservice.GuildConfigResult, error)
 	DeleteGuildConfigFunc func(ctx context.Context, guildID sharedtypes.GuildID) (guildservice.GuildConfigResult, error)
 
+	GetTagPoolConfigFunc    func(ctx context.Context, guildID sharedtypes.GuildID) (guildservice.TagPoolConfigResult, error)
+	UpdateTagPoolConfigFunc func(ctx context.Context, guildID sharedtypes.GuildID, model string, capacity int) (guildservice.TagPoolConfigResult, error)
+
 	ResolveClubEntitlementsFunc     func(ctx context.Context, clubUUID uuid.UUID) (guildtypes.ResolvedClubEntitlements, error)
 	ResolveClubFeatureFunc          func(ctx context.Context, clubUUID uuid.UUID, featureKey guildtypes.ClubFeatureKey) (guildtypes.ClubFeatureAccess, error)
 	ResolveClubFeatureByGuildIDFunc func(ctx context.Context, guildID sharedtypes.GuildID, featureKey guildtypes.ClubFeatureKey) (guildtypes.ClubFeatureAccess, error)
@@ -83,6 +86,22 @@ func (f *FakeGuildService) DeleteGuildConfig(ctx context.Context, guildID shared
 	return guildservice.GuildConfigResult{}, nil
 }
 
+func (f *FakeGuildService) GetTagPoolConfig(ctx context.Context, guildID sharedtypes.GuildID) (guildservice.TagPoolConfigResult, error) {
+	f.record("GetTagPoolConfig")
+	if f.GetTagPoolConfigFunc != nil {
+		return f.GetTagPoolConfigFunc(ctx, guildID)
+	}
+	return guildservice.TagPoolConfigResult{}, nil
+}
+
+func (f *FakeGuildService) UpdateTagPoolConfig(ctx context.Context, guildID sharedtypes.GuildID, model string, capacity int) (guildservice.TagPoolConfigResult, error) {
+	f.record("UpdateTagPoolConfig")
+	if f.UpdateTagPoolConfigFunc != nil {
+		return f.UpdateTagPoolConfigFunc(ctx, guildID, model, capacity)
+	}
+	return guildservice.TagPoolConfigResult{}, nil
+}
+
 func (f *FakeGuildService) ResolveClubEntitlements(ctx context.Context, clubUUID uuid.UUID) (guildtypes.ResolvedClubEntitlements, error) {
 	f.record("ResolveClubEntitlements")
 	if f.ResolveClubEntitlementsFunc != nil {
@@ -38,6 +38,8 @@ type UpdateFields struct {
 	SignupEmoji          *string
 	AutoSetupCompleted   *bool
 	SetupCompletedAt     *int64 // Unix nano timestamp
+	TagPoolModel         *string
+	TagPoolCap           *int
 }
 
 // IsEmpty reports whether any fields are set for update.
@@ -54,7 +56,9 @@ func (u *UpdateFields) IsEmpty() bool {
 		u.AdminRoleID == nil &&
 		u.SignupEmoji == nil &&
 		u.AutoSetupCompleted == nil &&
-		u.SetupCompletedAt == nil
+		u.SetupCompletedAt == nil &&
+		u.TagPoolModel == nil &&
+		u.TagPoolCap == nil
 }
 
 // upsertSetColumns defines fields to overwrite on a conflict (SaveConfig).
@@ -117,6 +121,23 @@ func (r *Impl) GetConfigIncludeDeleted(ctx context.Context, db bun.IDB, guildID
 	return config, nil
 }
 
+// GetConfigModel retrieves the stored row of an active guild configuration,
+// including the settings the shared config type does not carry.
+func (r *Impl) GetConfigModel(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*GuildConfig, error) {
+	if db == nil {
+		db = r.db
+	}
+
+	model, err := r.selectConfigModel(ctx, db, guildID, false)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("guilddb.GetConfigModel: %w", err)
+	}
+	return model, nil
+}
+
 // ResolveEntitlements resolves club-level entitlements from subscription/trial state plus manual overrides.
 func (r *Impl) ResolveEntitlements(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (guildtypes.ResolvedClubEntitlements, error) {
 	if db == nil {
@@ -231,6 +252,12 @@ func (r *Impl) UpdateConfig(ctx context.Context, db bun.IDB, guildID sharedtypes
 	if updates.SetupCompletedAt != nil {
 		q = q.Set("setup_completed_at = ?", unixNanoToTime(*updates.SetupCompletedAt))
 	}
+	if updates.TagPoolModel != nil {
+		q = q.Set("tag_pool_model = ?", *updates.TagPoolModel)
+	}
+	if updates.TagPoolCap != nil {
+		q = q.Set("tag_pool_cap = ?", *updates.TagPoolCap)
+	}
 
 	q = q.Set("updated_at = ?", time.Now().UTC())
 
@@ -24,6 +24,10 @@ type Repository interface {
 	GetConfig(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*guildtypes.GuildConfig, error)
 	GetConfigIncludeDeleted(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*guildtypes.GuildConfig, error)
 
+	// GetConfigModel retrieves the stored row of an active guild configuration.
+	// Returns ErrNotFound if no active config exists for the guild.
+	GetConfigModel(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*GuildConfig, error)
+
 	// SaveConfig creates or re-activates a guild configuration.
 	// Uses UPSERT semantics: inserts if not exists, updates if exists.
 	// Re-activation: sets is_active=true, deletion_status='none'.
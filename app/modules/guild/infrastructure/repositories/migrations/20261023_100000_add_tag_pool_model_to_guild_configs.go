@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding tag pool model columns to guild_configs...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE guild_configs
+					ADD COLUMN IF NOT EXISTS tag_pool_model VARCHAR(20) NOT NULL DEFAULT 'closed',
+					ADD COLUMN IF NOT EXISTS tag_pool_cap INTEGER NOT NULL DEFAULT 0;
+			`); err != nil {
+				return fmt.Errorf("failed to add tag pool columns to guild_configs: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE guild_configs DROP CONSTRAINT IF EXISTS guild_configs_tag_pool_check;
+				ALTER TABLE guild_configs ADD CONSTRAINT guild_configs_tag_pool_check
+					CHECK (tag_pool_model IN ('closed', 'open', 'capped') AND tag_pool_cap >= 0);
+			`); err != nil {
+				return fmt.Errorf("failed to add tag pool constraint: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Rolling back tag pool model columns from guild_configs...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE guild_configs
+					DROP CONSTRAINT IF EXISTS guild_configs_tag_pool_check,
+					DROP COLUMN IF EXISTS tag_pool_cap,
+					DROP COLUMN IF EXISTS tag_pool_model;
+			`); err != nil {
+				return fmt.Errorf("failed to drop tag pool columns from guild_configs: %w", err)
+			}
+			return nil
+		})
+	})
+}
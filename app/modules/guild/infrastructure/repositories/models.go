@@ -73,6 +73,11 @@ type GuildConfig struct {
 	CommandsPerMinute         int        `bun:"commands_per_minute"`
 	RoundsPerDay              int        `bun:"rounds_per_day"`
 	CustomLeaderboardsEnabled bool       `bun:"custom_leaderboards_enabled"`
+
+	// Tag pool model used to reallocate tags after a round (closed|open|capped), and
+	// the number of contested positions for the capped model.
+	TagPoolModel string `bun:"tag_pool_model,type:varchar(20),nullzero,notnull,default:'closed'"`
+	TagPoolCap   int    `bun:"tag_pool_cap,notnull,default:0"`
 }
 
 type ClubFeatureOverride struct {
@@ -7,6 +7,7 @@ import (
 
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
 	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -109,23 +110,76 @@ func (s *LeaderboardService) AdjustPoints(
 }
 
 // StartNewSeason creates a new season, deactivating the old one. Existing data is preserved under the old season_id.
+// The season is scored under the default points policy.
 func (s *LeaderboardService) StartNewSeason(
 	ctx context.Context,
 	guildID sharedtypes.GuildID,
 	seasonID string,
 	seasonName string,
+) (results.OperationResult[bool, error], error) {
+	return s.StartNewSeasonWithPolicy(ctx, guildID, seasonID, seasonName, leaderboarddomain.DefaultPointsPolicy())
+}
+
+// StartNewSeasonWithPolicy creates a new season scored under policy, deactivating the old one.
+func (s *LeaderboardService) StartNewSeasonWithPolicy(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	seasonID string,
+	seasonName string,
+	policy leaderboarddomain.PointsPolicy,
 ) (results.OperationResult[bool, error], error) {
 	return withTelemetry(s, ctx, "StartNewSeason", guildID, func(ctx context.Context) (results.OperationResult[bool, error], error) {
+		if err := policy.Validate(); err != nil {
+			return results.FailureResult[bool](err), nil
+		}
 		if s.commandPipeline == nil {
 			return results.OperationResult[bool, error]{}, ErrCommandPipelineUnavailable
 		}
-		if err := s.commandPipeline.StartSeason(ctx, string(guildID), seasonID, seasonName); err != nil {
+		previous, err := s.repo.GetActiveSeason(ctx, nil, string(guildID))
+		if err != nil {
+			return results.OperationResult[bool, error]{}, fmt.Errorf("get active season: %w", err)
+		}
+		if err := s.commandPipeline.StartSeason(ctx, string(guildID), seasonID, seasonName, policy); err != nil {
 			return results.OperationResult[bool, error]{}, err
 		}
+		// Starting a season closes the active one; members its promotion policy moved
+		// start the new season with their seed tags, as on EndSeason.
+		if previous != nil {
+			if err := s.seedSeasonPromotions(ctx, guildID, previous.ID); err != nil {
+				s.logSeedFailure(ctx, guildID, previous.ID, err)
+			}
+		}
 		return results.SuccessResult[bool, error](true), nil
 	})
 }
 
+// GetSeasonPointsPolicy returns the points policy a season is scored under. An empty
+// seasonID selects the active season.
+func (s *LeaderboardService) GetSeasonPointsPolicy(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	seasonID string,
+) (results.OperationResult[leaderboarddomain.PointsPolicy, error], error) {
+	return withTelemetry(s, ctx, "GetSeasonPointsPolicy", guildID, func(ctx context.Context) (results.OperationResult[leaderboarddomain.PointsPolicy, error], error) {
+		var (
+			season *leaderboarddb.Season
+			err    error
+		)
+		if seasonID == "" {
+			season, err = s.repo.GetActiveSeason(ctx, nil, string(guildID))
+		} else {
+			season, err = s.repo.GetSeasonByID(ctx, nil, string(guildID), seasonID)
+		}
+		if err != nil {
+			return results.OperationResult[leaderboarddomain.PointsPolicy, error]{}, fmt.Errorf("failed to get season: %w", err)
+		}
+		if season == nil {
+			return results.FailureResult[leaderboarddomain.PointsPolicy](ErrSeasonNotFound), nil
+		}
+		return results.SuccessResult[leaderboarddomain.PointsPolicy, error](seasonPolicy(season)), nil
+	})
+}
+
 // GetSeasonStandingsForSeason retrieves standings for a specific season.
 func (s *LeaderboardService) GetSeasonStandingsForSeason(
 	ctx context.Context,
@@ -138,21 +192,26 @@ func (s *LeaderboardService) GetSeasonStandingsForSeason(
 			return results.OperationResult[[]SeasonStandingEntry, error]{}, fmt.Errorf("failed to get season standings: %w", err)
 		}
 
-		entries := make([]SeasonStandingEntry, len(standings))
-		for i, s := range standings {
-			entries[i] = SeasonStandingEntry{
-				MemberID:      s.MemberID,
-				SeasonID:      s.SeasonID,
-				TotalPoints:   s.TotalPoints,
-				CurrentTier:   s.CurrentTier,
-				SeasonBestTag: s.SeasonBestTag,
-				RoundsPlayed:  s.RoundsPlayed,
-			}
-		}
-		return results.SuccessResult[[]SeasonStandingEntry, error](entries), nil
+		return results.SuccessResult[[]SeasonStandingEntry, error](toSeasonStandingEntries(standings)), nil
 	})
 }
 
+// toSeasonStandingEntries converts season standing rows to their read model.
+func toSeasonStandingEntries(standings []leaderboarddb.SeasonStanding) []SeasonStandingEntry {
+	entries := make([]SeasonStandingEntry, len(standings))
+	for i, s := range standings {
+		entries[i] = SeasonStandingEntry{
+			MemberID:      s.MemberID,
+			SeasonID:      s.SeasonID,
+			TotalPoints:   s.TotalPoints,
+			CurrentTier:   s.CurrentTier,
+			SeasonBestTag: s.SeasonBestTag,
+			RoundsPlayed:  s.RoundsPlayed,
+		}
+	}
+	return entries
+}
+
 // ListSeasons returns all seasons for a guild, ordered by active first then start_date descending.
 func (s *LeaderboardService) ListSeasons(
 	ctx context.Context,
@@ -166,22 +225,31 @@ func (s *LeaderboardService) ListSeasons(
 
 		entries := make([]SeasonInfo, len(seasons))
 		for i, season := range seasons {
-			entry := SeasonInfo{
-				ID:        season.ID,
-				Name:      season.Name,
-				IsActive:  season.IsActive,
-				StartDate: season.StartDate.Format(time.RFC3339),
-			}
-			if !season.EndDate.IsZero() {
-				endStr := season.EndDate.Format(time.RFC3339)
-				entry.EndDate = &endStr
-			}
-			entries[i] = entry
+			entries[i] = toSeasonInfo(season)
 		}
 		return results.SuccessResult[[]SeasonInfo, error](entries), nil
 	})
 }
 
+// toSeasonInfo converts a season row to its read model.
+func toSeasonInfo(season leaderboarddb.Season) SeasonInfo {
+	entry := SeasonInfo{
+		ID:        season.ID,
+		Name:      season.Name,
+		IsActive:  season.IsActive,
+		Scheduled: season.Scheduled,
+		StartDate: season.StartDate.Format(time.RFC3339),
+		Timezone:  season.Timezone,
+
+		PointsPolicy: seasonPolicy(&season),
+	}
+	if !season.EndDate.IsZero() {
+		endStr := season.EndDate.Format(time.RFC3339)
+		entry.EndDate = &endStr
+	}
+	return entry
+}
+
 // GetSeasonName retrieves the display name for a specific season.
 func (s *LeaderboardService) GetSeasonName(
 	ctx context.Context,
@@ -197,3 +265,12 @@ func (s *LeaderboardService) GetSeasonName(
 	}
 	return season.Name, nil
 }
+
+// seasonPolicy returns a season's points policy, defaulting for seasons created before
+// policies were persisted.
+func seasonPolicy(season *leaderboarddb.Season) leaderboarddomain.PointsPolicy {
+	if season == nil || season.PointsPolicy == nil {
+		return leaderboarddomain.DefaultPointsPolicy()
+	}
+	return *season.PointsPolicy
+}
@@ -11,6 +11,10 @@ import (
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
 )
 
+// ServiceUpdateSourceTagOffer marks tag assignments made by settling a betting tag
+// offer. Their tag history is recorded with the "tag_offer" reason.
+const ServiceUpdateSourceTagOffer sharedtypes.ServiceUpdateSource = "tag_offer"
+
 // ExecuteBatchTagAssignment is the public entry point for batch changes.
 // It opens its own transaction.
 func (s *LeaderboardService) ExecuteBatchTagAssignment(
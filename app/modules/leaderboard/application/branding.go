@@ -4,11 +4,22 @@ import "image/color"
 
 // ChartPalette defines the color palette for chart generation.
 type ChartPalette struct {
-	Background  color.RGBA // Background color
-	GridLines   color.RGBA // Grid line color
-	PrimaryLine color.RGBA // Primary data line color
-	TextColor   color.RGBA // Text/label color
-	AccentLine  color.RGBA // Accent/secondary line color
+	Background  color.RGBA   // Background color
+	GridLines   color.RGBA   // Grid line color
+	PrimaryLine color.RGBA   // Primary data line color
+	TextColor   color.RGBA   // Text/label color
+	AccentLine  color.RGBA   // Accent/secondary line color
+	Series      []color.RGBA // Line colors for multi-member charts, in order
+}
+
+// SeriesColor returns the color of the i-th line on a multi-member chart, cycling
+// through Series (or the primary and accent lines when Series is empty).
+func (p ChartPalette) SeriesColor(i int) color.RGBA {
+	colors := p.Series
+	if len(colors) == 0 {
+		colors = []color.RGBA{p.PrimaryLine, p.AccentLine}
+	}
+	return colors[i%len(colors)]
 }
 
 // ObsidianForestPalette is the default chart palette matching the PWA Obsidian Forest theme.
@@ -18,4 +29,12 @@ var ObsidianForestPalette = ChartPalette{
 	PrimaryLine: color.RGBA{197, 160, 78, 255},  // #c5a04e → Gold Accent
 	TextColor:   color.RGBA{226, 232, 240, 255}, // #e2e8f0 → Off-white
 	AccentLine:  color.RGBA{139, 92, 246, 255},  // #8b5cf6 → Amethyst Aura
+	Series: []color.RGBA{
+		{197, 160, 78, 255},  // #c5a04e → Gold Accent
+		{139, 92, 246, 255},  // #8b5cf6 → Amethyst Aura
+		{45, 212, 191, 255},  // #2dd4bf → Teal
+		{244, 114, 182, 255}, // #f472b6 → Rose
+		{56, 189, 248, 255},  // #38bdf8 → Sky
+		{163, 230, 53, 255},  // #a3e635 → Lime
+	},
 }
@@ -2,12 +2,44 @@ package leaderboardservice
 
 import (
 	"bytes"
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
 	"time"
 
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
 	"github.com/wcharczuk/go-chart/v2"
 	"github.com/wcharczuk/go-chart/v2/drawing"
 )
 
+// ChartFormat is the image encoding of a rendered chart.
+type ChartFormat string
+
+const (
+	ChartFormatPNG ChartFormat = "png"
+	ChartFormatSVG ChartFormat = "svg"
+)
+
+// ContentType returns the MIME type of the format.
+func (f ChartFormat) ContentType() string {
+	if f == ChartFormatSVG {
+		return "image/svg+xml"
+	}
+	return "image/png"
+}
+
+func (f ChartFormat) renderer() (chart.RendererProvider, error) {
+	switch f {
+	case ChartFormatPNG, "":
+		return chart.PNG, nil
+	case ChartFormatSVG:
+		return chart.SVG, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidChartRequest, f)
+	}
+}
+
 // GenerateTagHistoryChart produces a PNG line chart of a member's tag history.
 func GenerateTagHistoryChart(history []TagHistoryView, palette ChartPalette) ([]byte, error) {
 	if len(history) == 0 {
@@ -84,37 +116,343 @@ func GenerateTagHistoryChart(history []TagHistoryView, palette ChartPalette) ([]
 }
 
 func renderNoDataPlaceholder(palette ChartPalette) ([]byte, error) {
-	const (
-		width  = 400
-		height = 200
-		msg    = "No tag history found"
+	return renderMessage("No tag history found", palette, ChartFormatPNG)
+}
+
+// RenderPointsRaceChart draws each member's running season points total.
+func RenderPointsRaceChart(series []leaderboarddomain.MemberSeries, labels map[string]string, palette ChartPalette, format ChartFormat) ([]byte, error) {
+	return renderMemberSeriesChart(series, labels, palette, format, memberSeriesChartOptions{
+		empty: "No season points yet",
+		yName: "Season Points",
+	})
+}
+
+// RenderTagComparisonChart draws several members' tag numbers over time, #1 at the top.
+func RenderTagComparisonChart(series []leaderboarddomain.MemberSeries, labels map[string]string, palette ChartPalette, format ChartFormat) ([]byte, error) {
+	return renderMemberSeriesChart(series, labels, palette, format, memberSeriesChartOptions{
+		empty:      "No tag history found",
+		yName:      "Tag Number",
+		descending: true,
+	})
+}
+
+type memberSeriesChartOptions struct {
+	empty      string
+	yName      string
+	descending bool
+}
+
+func renderMemberSeriesChart(
+	series []leaderboarddomain.MemberSeries,
+	labels map[string]string,
+	palette ChartPalette,
+	format ChartFormat,
+	opts memberSeriesChartOptions,
+) ([]byte, error) {
+	provider, err := format.renderer()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		lines      []chart.Series
+		first      time.Time
+		last       time.Time
+		minY, maxY = math.MaxFloat64, -math.MaxFloat64
 	)
+	for i, s := range series {
+		if len(s.Points) == 0 {
+			continue
+		}
+		xValues := make([]time.Time, len(s.Points))
+		yValues := make([]float64, len(s.Points))
+		for j, p := range s.Points {
+			xValues[j] = p.At
+			yValues[j] = p.Value
+			if first.IsZero() || p.At.Before(first) {
+				first = p.At
+			}
+			if p.At.After(last) {
+				last = p.At
+			}
+			minY = math.Min(minY, p.Value)
+			maxY = math.Max(maxY, p.Value)
+		}
+		name := s.MemberID
+		if label, ok := labels[s.MemberID]; ok && label != "" {
+			name = label
+		}
+		c := drawing.Color(palette.SeriesColor(i))
+		lines = append(lines, chart.TimeSeries{
+			Name:    name,
+			XValues: xValues,
+			YValues: yValues,
+			Style: chart.Style{
+				StrokeColor: c,
+				StrokeWidth: 2,
+				DotWidth:    3,
+				DotColor:    c,
+			},
+		})
+	}
+	// go-chart needs two distinct timestamps to lay out the x-axis.
+	if len(lines) == 0 || !last.After(first) {
+		return renderMessage(opts.empty, palette, format)
+	}
+	if minY == maxY {
+		minY, maxY = minY-1, maxY+1
+	}
 
 	graph := chart.Chart{
-		Width:  width,
-		Height: height,
-		Background: chart.Style{
-			FillColor: drawing.Color(palette.Background),
+		Width:        800,
+		Height:       400,
+		ColorPalette: chartColors{palette},
+		Background:   chart.Style{Padding: chart.Box{Top: 20, Left: 20, Right: 20, Bottom: 20}},
+		XAxis: chart.XAxis{
+			Name:           "Date",
+			ValueFormatter: chart.TimeValueFormatterWithFormat("2006-01-02"),
 		},
-		Canvas: chart.Style{
-			FillColor: drawing.Color(palette.Background),
+		YAxis: chart.YAxis{
+			Name:           opts.yName,
+			Range:          &chart.ContinuousRange{Min: minY, Max: maxY, Descending: opts.descending},
+			ValueFormatter: wholeNumberFormatter,
 		},
-		Elements: []chart.Renderable{
-			func(r chart.Renderer, cb chart.Box, chartDefaults chart.Style) {
-				r.SetFontColor(drawing.Color(palette.TextColor))
-				r.SetFontSize(12.0)
-				tb := r.MeasureText(msg)
-				x := (cb.Width() - tb.Width()) / 2
-				y := (cb.Height() + tb.Height()) / 2
-				r.Text(msg, x, y)
+		Series: lines,
+	}
+	graph.Elements = []chart.Renderable{chart.LegendLeft(&graph, chart.Style{
+		FillColor:   drawing.Color(palette.Background),
+		FontColor:   drawing.Color(palette.TextColor),
+		StrokeColor: drawing.Color(palette.GridLines),
+	})}
+
+	buffer := bytes.NewBuffer([]byte{})
+	if err := graph.Render(provider, buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// RenderScoreDistributionChart draws how often a member finished on each stroke total.
+func RenderScoreDistributionChart(bins []leaderboarddomain.ScoreBin, palette ChartPalette, format ChartFormat) ([]byte, error) {
+	provider, err := format.renderer()
+	if err != nil {
+		return nil, err
+	}
+	if len(bins) == 0 {
+		return renderMessage("No scored rounds found", palette, format)
+	}
+
+	maxCount := 1
+	bars := make([]chart.Value, len(bins))
+	for i, b := range bins {
+		maxCount = max(maxCount, b.Count)
+		bars[i] = chart.Value{
+			Label: strconv.Itoa(b.Score),
+			Value: float64(b.Count),
+			Style: chart.Style{
+				FillColor:   drawing.Color(palette.PrimaryLine),
+				StrokeColor: drawing.Color(palette.PrimaryLine),
 			},
+		}
+	}
+
+	// Whole-round ticks; go-chart's defaults would label fractional counts.
+	step := (maxCount + 7) / 8
+	var ticks []chart.Tick
+	for v := 0; ; v += step {
+		ticks = append(ticks, chart.Tick{Value: float64(v), Label: strconv.Itoa(v)})
+		if v >= maxCount {
+			break
+		}
+	}
+
+	const plotWidth = 680
+	spacing := max(2, 40/len(bins))
+	graph := chart.BarChart{
+		Title:        "Score Distribution",
+		Width:        800,
+		Height:       400,
+		ColorPalette: chartColors{palette},
+		Background:   chart.Style{Padding: chart.Box{Top: 40, Left: 20, Right: 20, Bottom: 20}},
+		BarWidth:     max(4, plotWidth/len(bins)-spacing),
+		BarSpacing:   spacing,
+		UseBaseValue: true,
+		BaseValue:    0,
+		YAxis: chart.YAxis{
+			Name:  "Rounds",
+			Range: &chart.ContinuousRange{Min: 0, Max: ticks[len(ticks)-1].Value},
+			Ticks: ticks,
 		},
+		Bars: bars,
 	}
+
 	buffer := bytes.NewBuffer([]byte{})
-	err := graph.Render(chart.PNG, buffer)
+	if err := graph.Render(provider, buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// RenderAttendanceHeatmap draws a weekday-by-week grid of round attendance, shading
+// each cell from the grid color (no rounds) to the primary color (busiest day).
+func RenderAttendanceHeatmap(weeks []leaderboarddomain.AttendanceWeek, palette ChartPalette, format ChartFormat) ([]byte, error) {
+	provider, err := format.renderer()
 	if err != nil {
 		return nil, err
 	}
+	if len(weeks) == 0 {
+		return renderMessage("No rounds found", palette, format)
+	}
+
+	const (
+		cell    = 22
+		gap     = 3
+		left    = 50
+		top     = 50
+		bottom  = 40
+		right   = 20
+		maxDays = 7
+	)
+	width := left + len(weeks)*(cell+gap) + right
+	height := top + maxDays*(cell+gap) + bottom
+
+	busiest := 0
+	for _, w := range weeks {
+		for _, v := range w.Days {
+			busiest = max(busiest, v)
+		}
+	}
+
+	r, title, err := newCanvas(provider, width, height, palette)
+	if err != nil {
+		return nil, err
+	}
+	text := title
+	text.FontSize = 9
+	chart.Draw.Text(r, "Round Attendance by Week", left, top/2, title)
+
+	for d, name := range []string{"Mon", "", "Wed", "", "Fri", "", "Sun"} {
+		if name != "" {
+			chart.Draw.Text(r, name, 12, top+d*(cell+gap)+cell-6, text)
+		}
+	}
+	for i, w := range weeks {
+		x := left + i*(cell+gap)
+		for d, v := range w.Days {
+			y := top + d*(cell+gap)
+			shade := heatColor(palette, v, busiest)
+			chart.Draw.Box(r, chart.Box{Top: y, Left: x, Right: x + cell, Bottom: y + cell}, chart.Style{
+				FillColor:   drawing.Color(shade),
+				StrokeColor: drawing.Color(shade),
+				StrokeWidth: 1,
+			})
+		}
+		if i%4 == 0 {
+			chart.Draw.Text(r, w.WeekStart.Format("Jan 2"), x, top+maxDays*(cell+gap)+14, text)
+		}
+	}
+
+	buffer := bytes.NewBuffer([]byte{})
+	if err := r.Save(buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func wholeNumberFormatter(v interface{}) string {
+	if f, ok := v.(float64); ok {
+		return strconv.FormatFloat(math.Round(f), 'f', 0, 64)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// heatColor blends from the grid color to the primary line color by v/busiest.
+func heatColor(palette ChartPalette, v, busiest int) color.RGBA {
+	if v <= 0 || busiest <= 0 {
+		return palette.GridLines
+	}
+	// Keep the faintest non-empty day visibly different from an empty one.
+	t := 0.25 + 0.75*float64(v)/float64(busiest)
+	blend := func(a, b uint8) uint8 { return uint8(float64(a) + (float64(b)-float64(a))*t) }
+	from, to := palette.GridLines, palette.PrimaryLine
+	return color.RGBA{blend(from.R, to.R), blend(from.G, to.G), blend(from.B, to.B), 255}
+}
+
+// renderMessage renders a small chart-sized image that only carries a message, for
+// requests without enough data to plot.
+func renderMessage(msg string, palette ChartPalette, format ChartFormat) ([]byte, error) {
+	const (
+		width  = 400
+		height = 200
+	)
+	provider, err := format.renderer()
+	if err != nil {
+		return nil, err
+	}
+	r, style, err := newCanvas(provider, width, height, palette)
+	if err != nil {
+		return nil, err
+	}
+
+	tb := chart.Draw.MeasureText(r, msg, style)
+	chart.Draw.Text(r, msg, (width-tb.Width())/2, (height+tb.Height())/2, style)
 
+	buffer := bytes.NewBuffer([]byte{})
+	if err := r.Save(buffer); err != nil {
+		return nil, err
+	}
 	return buffer.Bytes(), nil
 }
+
+// newCanvas returns a renderer for free-form drawing, filled with the background
+// color, and the palette's text style.
+func newCanvas(provider chart.RendererProvider, width, height int, palette ChartPalette) (chart.Renderer, chart.Style, error) {
+	r, err := provider(width, height)
+	if err != nil {
+		return nil, chart.Style{}, err
+	}
+	font, err := chart.GetDefaultFont()
+	if err != nil {
+		return nil, chart.Style{}, err
+	}
+	r.SetDPI(chart.DefaultDPI)
+	chart.Draw.Box(r, chart.Box{Top: 0, Left: 0, Right: width, Bottom: height}, chart.Style{
+		FillColor:   drawing.Color(palette.Background),
+		StrokeColor: drawing.Color(palette.Background),
+		StrokeWidth: 1,
+	})
+	return r, chart.Style{Font: font, FontColor: drawing.Color(palette.TextColor), FontSize: 12}, nil
+}
+
+// chartColors themes go-chart's axes, text and default series colors with a palette.
+type chartColors struct {
+	palette ChartPalette
+}
+
+func (c chartColors) BackgroundColor() drawing.Color {
+	return drawing.Color(c.palette.Background)
+}
+
+func (c chartColors) BackgroundStrokeColor() drawing.Color {
+	return drawing.Color(c.palette.Background)
+}
+
+func (c chartColors) CanvasColor() drawing.Color {
+	return drawing.Color(c.palette.Background)
+}
+
+func (c chartColors) CanvasStrokeColor() drawing.Color {
+	return drawing.Color(c.palette.GridLines)
+}
+
+func (c chartColors) AxisStrokeColor() drawing.Color {
+	return drawing.Color(c.palette.GridLines)
+}
+
+func (c chartColors) TextColor() drawing.Color {
+	return drawing.Color(c.palette.TextColor)
+}
+
+func (c chartColors) GetSeriesColor(index int) drawing.Color {
+	return drawing.Color(c.palette.SeriesColor(index))
+}
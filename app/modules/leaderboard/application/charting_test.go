@@ -0,0 +1,77 @@
+package leaderboardservice
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+)
+
+var pngMagic = []byte("\x89PNG")
+
+func TestChartRenderers(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 18, 0, 0, 0, time.UTC) }
+	series := []leaderboarddomain.MemberSeries{
+		{MemberID: "alice", Points: []leaderboarddomain.SeriesPoint{{At: day(1), Value: 3}, {At: day(8), Value: 1}}},
+		{MemberID: "bob", Points: []leaderboarddomain.SeriesPoint{{At: day(2), Value: 5}}},
+	}
+	labels := map[string]string{"alice": "Alice"}
+	weeks := leaderboarddomain.BuildAttendanceHeatmap([]leaderboarddomain.AttendanceRound{
+		{At: day(4), Participants: 6}, {At: day(11), Participants: 9},
+	}, 6, day(13))
+	bins := leaderboarddomain.BuildScoreHistogram([]int{52, 54, 54, 57})
+
+	renderers := map[string]func(ChartFormat) ([]byte, error){
+		"points race": func(f ChartFormat) ([]byte, error) {
+			return RenderPointsRaceChart(series, labels, ObsidianForestPalette, f)
+		},
+		"tag comparison": func(f ChartFormat) ([]byte, error) {
+			return RenderTagComparisonChart(series, labels, ObsidianForestPalette, f)
+		},
+		"score distribution": func(f ChartFormat) ([]byte, error) {
+			return RenderScoreDistributionChart(bins, ObsidianForestPalette, f)
+		},
+		"attendance heatmap": func(f ChartFormat) ([]byte, error) {
+			return RenderAttendanceHeatmap(weeks, ObsidianForestPalette, f)
+		},
+		"not enough data": func(f ChartFormat) ([]byte, error) {
+			return RenderPointsRaceChart(series[1:], nil, ObsidianForestPalette, f)
+		},
+	}
+
+	for name, render := range renderers {
+		t.Run(name, func(t *testing.T) {
+			png, err := render(ChartFormatPNG)
+			if err != nil {
+				t.Fatalf("png: %v", err)
+			}
+			if !bytes.HasPrefix(png, pngMagic) {
+				t.Fatalf("png output does not start with the PNG signature")
+			}
+
+			svg, err := render(ChartFormatSVG)
+			if err != nil {
+				t.Fatalf("svg: %v", err)
+			}
+			if !bytes.Contains(svg, []byte("<svg")) {
+				t.Fatalf("svg output has no <svg> element")
+			}
+		})
+	}
+
+	if _, err := RenderScoreDistributionChart(bins, ObsidianForestPalette, "gif"); !errors.Is(err, ErrInvalidChartRequest) {
+		t.Fatalf("expected ErrInvalidChartRequest for an unknown format, got %v", err)
+	}
+}
+
+func TestRenderNoDataPlaceholder(t *testing.T) {
+	png, err := GenerateTagHistoryChart(nil, ObsidianForestPalette)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.HasPrefix(png, pngMagic) {
+		t.Fatal("placeholder is not a PNG")
+	}
+}
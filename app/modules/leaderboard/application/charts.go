@@ -0,0 +1,275 @@
+package leaderboardservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+)
+
+// ChartKind selects a statistics chart.
+type ChartKind string
+
+const (
+	// ChartPointsRace plots the running season points of the top members.
+	ChartPointsRace ChartKind = "points_race"
+	// ChartScoreDistribution is a histogram of one member's stroke totals.
+	ChartScoreDistribution ChartKind = "score_distribution"
+	// ChartAttendanceHeatmap shades round attendance per weekday over recent weeks.
+	ChartAttendanceHeatmap ChartKind = "attendance_heatmap"
+	// ChartTagComparison plots several members' tag numbers over time.
+	ChartTagComparison ChartKind = "tag_comparison"
+)
+
+const (
+	// DefaultPointsRaceSize is how many members a points race shows by default.
+	DefaultPointsRaceSize = 5
+	// MaxPointsRaceSize caps the members on a points race.
+	MaxPointsRaceSize = 10
+	// MaxComparedMembers caps the members on a tag comparison.
+	MaxComparedMembers = 6
+	// DefaultAttendanceWeeks is how many weeks an attendance heatmap covers by default.
+	DefaultAttendanceWeeks = 12
+	// MaxAttendanceWeeks caps the weeks on an attendance heatmap.
+	MaxAttendanceWeeks = 52
+	// ScoreDistributionRoundLimit is how many of a member's latest rounds a score
+	// distribution counts.
+	ScoreDistributionRoundLimit = 50
+)
+
+// ChartRequest describes a statistics chart to render.
+type ChartRequest struct {
+	Kind   ChartKind
+	Format ChartFormat // png when empty
+
+	// SeasonID limits points races and score distributions to one season. Points races
+	// default to the active season; score distributions to every round.
+	SeasonID string
+	// MemberIDs names the member of a score distribution (exactly one) or the members
+	// of a tag comparison.
+	MemberIDs []string
+	// Labels optionally maps member IDs to the names shown in legends.
+	Labels map[string]string
+	// TopN is the number of members on a points race; Weeks the span of a heatmap.
+	TopN  int
+	Weeks int
+}
+
+// RenderedChart is an encoded chart image.
+type RenderedChart struct {
+	Kind        ChartKind
+	Format      ChartFormat
+	ContentType string
+	Data        []byte
+}
+
+// RenderChart renders a statistics chart themed with the default palette.
+func (s *LeaderboardService) RenderChart(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	req ChartRequest,
+) (results.OperationResult[RenderedChart, error], error) {
+	return withTelemetry(s, ctx, "RenderChart", guildID, func(ctx context.Context) (results.OperationResult[RenderedChart, error], error) {
+		if req.Format == "" {
+			req.Format = ChartFormatPNG
+		}
+		if err := req.validate(); err != nil {
+			return results.FailureResult[RenderedChart](err), nil
+		}
+		resolvedGuildID := s.resolveGuildID(ctx, string(guildID))
+		palette := ObsidianForestPalette
+
+		var (
+			data []byte
+			err  error
+		)
+		switch req.Kind {
+		case ChartPointsRace:
+			var season *leaderboarddb.Season
+			season, err = s.chartSeason(ctx, resolvedGuildID, req.SeasonID)
+			if err != nil {
+				return results.OperationResult[RenderedChart, error]{}, err
+			}
+			if season == nil {
+				return results.FailureResult[RenderedChart](ErrSeasonNotFound), nil
+			}
+			var series []leaderboarddomain.MemberSeries
+			series, err = s.pointsRaceSeries(ctx, resolvedGuildID, season.ID, clampChartSize(req.TopN, DefaultPointsRaceSize, MaxPointsRaceSize))
+			if err != nil {
+				return results.OperationResult[RenderedChart, error]{}, err
+			}
+			data, err = RenderPointsRaceChart(series, req.Labels, palette, req.Format)
+
+		case ChartScoreDistribution:
+			if s.scoreSource == nil {
+				return results.FailureResult[RenderedChart](ErrScoreDataUnavailable), nil
+			}
+			var scores []int
+			scores, err = s.memberRoundScores(ctx, guildID, resolvedGuildID, req.MemberIDs[0], req.SeasonID)
+			if err != nil {
+				return results.OperationResult[RenderedChart, error]{}, err
+			}
+			data, err = RenderScoreDistributionChart(leaderboarddomain.BuildScoreHistogram(scores), palette, req.Format)
+
+		case ChartAttendanceHeatmap:
+			var outcomes []leaderboarddb.RoundOutcome
+			outcomes, err = s.outcomeRepo.ListRoundOutcomes(ctx, s.db, resolvedGuildID)
+			if err != nil {
+				return results.OperationResult[RenderedChart, error]{}, fmt.Errorf("failed to list rounds: %w", err)
+			}
+			rounds := make([]leaderboarddomain.AttendanceRound, len(outcomes))
+			for i, o := range outcomes {
+				rounds[i] = leaderboarddomain.AttendanceRound{At: o.CreatedAt, Participants: len(o.Participants)}
+			}
+			weeks := leaderboarddomain.BuildAttendanceHeatmap(rounds, clampChartSize(req.Weeks, DefaultAttendanceWeeks, MaxAttendanceWeeks), time.Now().UTC())
+			data, err = RenderAttendanceHeatmap(weeks, palette, req.Format)
+
+		case ChartTagComparison:
+			var series []leaderboarddomain.MemberSeries
+			series, err = s.tagComparisonSeries(ctx, resolvedGuildID, req.MemberIDs)
+			if err != nil {
+				return results.OperationResult[RenderedChart, error]{}, err
+			}
+			data, err = RenderTagComparisonChart(series, req.Labels, palette, req.Format)
+		}
+		if err != nil {
+			return results.OperationResult[RenderedChart, error]{}, fmt.Errorf("failed to render %s chart: %w", req.Kind, err)
+		}
+
+		return results.SuccessResult[RenderedChart, error](RenderedChart{
+			Kind:        req.Kind,
+			Format:      req.Format,
+			ContentType: req.Format.ContentType(),
+			Data:        data,
+		}), nil
+	})
+}
+
+func (r ChartRequest) validate() error {
+	if _, err := r.Format.renderer(); err != nil {
+		return err
+	}
+	switch r.Kind {
+	case ChartPointsRace, ChartAttendanceHeatmap:
+		return nil
+	case ChartScoreDistribution:
+		if len(r.MemberIDs) != 1 || r.MemberIDs[0] == "" {
+			return fmt.Errorf("%w: score distribution needs exactly one member", ErrInvalidChartRequest)
+		}
+	case ChartTagComparison:
+		if len(r.MemberIDs) == 0 || len(r.MemberIDs) > MaxComparedMembers || slices.Contains(r.MemberIDs, "") {
+			return fmt.Errorf("%w: tag comparison needs 1 to %d members", ErrInvalidChartRequest, MaxComparedMembers)
+		}
+	default:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidChartRequest, r.Kind)
+	}
+	return nil
+}
+
+// clampChartSize returns n, or def when n is not positive, capped at limit.
+func clampChartSize(n, def, limit int) int {
+	if n <= 0 {
+		return def
+	}
+	return min(n, limit)
+}
+
+// chartSeason returns the named season, or the active one when seasonID is empty.
+func (s *LeaderboardService) chartSeason(ctx context.Context, guildID, seasonID string) (*leaderboarddb.Season, error) {
+	if seasonID == "" {
+		season, err := s.repo.GetActiveSeason(ctx, nil, guildID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get active season: %w", err)
+		}
+		return season, nil
+	}
+	season, err := s.repo.GetSeasonByID(ctx, nil, guildID, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season: %w", err)
+	}
+	return season, nil
+}
+
+// pointsRaceSeries builds running season totals for the topN members.
+func (s *LeaderboardService) pointsRaceSeries(ctx context.Context, guildID, seasonID string, topN int) ([]leaderboarddomain.MemberSeries, error) {
+	history, err := s.repo.ListPointHistoryForGuild(ctx, nil, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load point history: %w", err)
+	}
+	var entries []leaderboarddomain.PointsEntry
+	for _, h := range history {
+		if h.SeasonID != seasonID {
+			continue
+		}
+		entries = append(entries, leaderboarddomain.PointsEntry{
+			MemberID: string(h.MemberID),
+			Points:   h.Points,
+			At:       h.CreatedAt,
+		})
+	}
+	return leaderboarddomain.BuildPointsRace(entries, topN), nil
+}
+
+// memberRoundScores returns a member's stroke totals over their latest scored rounds.
+// Rounds whose scores cannot be looked up are logged and skipped.
+func (s *LeaderboardService) memberRoundScores(ctx context.Context, guildID sharedtypes.GuildID, resolvedGuildID, memberID, seasonID string) ([]int, error) {
+	outcomes, err := s.outcomeRepo.ListRoundOutcomes(ctx, s.db, resolvedGuildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rounds: %w", err)
+	}
+
+	var scores []int
+	for i := len(outcomes) - 1; i >= 0 && len(scores) < ScoreDistributionRoundLimit; i-- {
+		outcome := outcomes[i]
+		if seasonID != "" && (outcome.SeasonID == nil || *outcome.SeasonID != seasonID) {
+			continue
+		}
+		if !slices.ContainsFunc(outcome.Participants, func(p leaderboarddomain.RoundInput) bool { return p.MemberID == memberID }) {
+			continue
+		}
+		roundScores, err := s.scoreSource.GetRoundScores(ctx, guildID, sharedtypes.RoundID(outcome.RoundID))
+		if err != nil {
+			s.logger.WarnContext(ctx, "chart score lookup failed",
+				slog.String("guild_id", string(guildID)),
+				slog.String("round_id", outcome.RoundID.String()),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		if score, ok := roundScores[sharedtypes.DiscordID(memberID)]; ok {
+			scores = append(scores, score)
+		}
+	}
+	return scores, nil
+}
+
+// tagComparisonSeries returns each member's tag numbers over time, one series per
+// member in request order.
+func (s *LeaderboardService) tagComparisonSeries(ctx context.Context, guildID string, memberIDs []string) ([]leaderboarddomain.MemberSeries, error) {
+	series := make([]leaderboarddomain.MemberSeries, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		entries, err := s.tagHistRepo.GetTagHistoryForMember(ctx, s.db, guildID, memberID, DefaultTagHistoryLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tag history: %w", err)
+		}
+		line := leaderboarddomain.MemberSeries{MemberID: memberID}
+		// Entries are newest first; only tags the member took are plotted.
+		for i := len(entries) - 1; i >= 0; i-- {
+			if entries[i].NewMemberID != memberID || entries[i].TagNumber <= 0 {
+				continue
+			}
+			line.Points = append(line.Points, leaderboarddomain.SeriesPoint{
+				At:    entries[i].CreatedAt,
+				Value: float64(entries[i].TagNumber),
+			})
+		}
+		series = append(series, line)
+	}
+	return series, nil
+}
@@ -0,0 +1,146 @@
+package leaderboardservice
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+func TestRenderChart_RejectsInvalidRequests(t *testing.T) {
+	tests := []struct {
+		name string
+		req  ChartRequest
+	}{
+		{"unknown kind", ChartRequest{Kind: "pie"}},
+		{"unknown format", ChartRequest{Kind: ChartAttendanceHeatmap, Format: "gif"}},
+		{"score distribution without member", ChartRequest{Kind: ChartScoreDistribution}},
+		{"tag comparison with too many members", ChartRequest{Kind: ChartTagComparison, MemberIDs: []string{"a", "b", "c", "d", "e", "f", "g"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+			res, err := svc.RenderChart(context.Background(), "guild-1", tt.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.Failure == nil || !errors.Is(*res.Failure, ErrInvalidChartRequest) {
+				t.Fatalf("expected invalid chart request, got %+v", res)
+			}
+		})
+	}
+}
+
+func TestRenderChart_PointsRaceUsesActiveSeason(t *testing.T) {
+	t0 := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	repo := NewFakeLeaderboardRepo()
+	repo.GetActiveSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error) {
+		return &leaderboarddb.Season{GuildID: guildID, ID: "2026-spring", IsActive: true}, nil
+	}
+	repo.ListPointHistoryForGuildFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.PointHistory, error) {
+		return []leaderboarddb.PointHistory{
+			{MemberID: "alice", SeasonID: "2025-fall", Points: 900, CreatedAt: t0.AddDate(0, -6, 0)},
+			{MemberID: "alice", SeasonID: "2026-spring", Points: 100, CreatedAt: t0},
+			{MemberID: "alice", SeasonID: "2026-spring", Points: 50, CreatedAt: t0.AddDate(0, 0, 7)},
+		}, nil
+	}
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	res, err := svc.RenderChart(context.Background(), "guild-1", ChartRequest{Kind: ChartPointsRace, Format: ChartFormatSVG})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Success == nil {
+		t.Fatalf("expected success, got %+v", res)
+	}
+	if res.Success.ContentType != "image/svg+xml" || !bytes.Contains(res.Success.Data, []byte("<svg")) {
+		t.Fatalf("expected an SVG chart, got %s", res.Success.ContentType)
+	}
+	if !slices.Contains(repo.Trace(), "GetActiveSeason") {
+		t.Fatal("expected the active season to be used")
+	}
+}
+
+func TestRenderChart_PointsRaceWithoutSeason(t *testing.T) {
+	svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	res, err := svc.RenderChart(context.Background(), "guild-1", ChartRequest{Kind: ChartPointsRace})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Failure == nil || !errors.Is(*res.Failure, ErrSeasonNotFound) {
+		t.Fatalf("expected season not found, got %+v", res)
+	}
+}
+
+func TestRenderChart_ScoreDistribution(t *testing.T) {
+	t.Run("needs a score source", func(t *testing.T) {
+		svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+		res, err := svc.RenderChart(context.Background(), "guild-1", ChartRequest{Kind: ChartScoreDistribution, MemberIDs: []string{"alice"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrScoreDataUnavailable) {
+			t.Fatalf("expected score data unavailable, got %+v", res)
+		}
+	})
+
+	t.Run("renders the member's scored rounds", func(t *testing.T) {
+		r1, r2 := uuid.New(), uuid.New()
+		outcomes := &fakeRoundOutcomeRepo{
+			listOutcomesFunc: func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.RoundOutcome, error) {
+				return []leaderboarddb.RoundOutcome{
+					{RoundID: r1, Participants: []leaderboarddomain.RoundInput{{MemberID: "alice", FinishRank: 1}}},
+					{RoundID: r2, Participants: []leaderboarddomain.RoundInput{{MemberID: "bob", FinishRank: 1}}},
+				}, nil
+			},
+		}
+		svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, outcomes)
+		svc.SetScoreSource(fakeRoundScoreSource{
+			r1: {"alice": 54},
+			r2: {"bob": 50},
+		})
+
+		res, err := svc.RenderChart(context.Background(), "guild-1", ChartRequest{Kind: ChartScoreDistribution, MemberIDs: []string{"alice"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Success == nil || !bytes.HasPrefix(res.Success.Data, pngMagic) {
+			t.Fatalf("expected a PNG chart, got %+v", res)
+		}
+	})
+}
+
+func TestTagComparisonSeries_PlotsTagsTakenOldestFirst(t *testing.T) {
+	t0 := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	alice := "alice"
+	tags := &fakeTagHistoryRepo{memberHistory: map[string][]leaderboarddb.TagHistoryEntry{
+		"alice": {
+			{TagNumber: 2, OldMemberID: &alice, NewMemberID: "bob", CreatedAt: t0.AddDate(0, 0, 14)},
+			{TagNumber: 2, NewMemberID: "alice", CreatedAt: t0.AddDate(0, 0, 7)},
+			{TagNumber: 5, NewMemberID: "alice", CreatedAt: t0},
+		},
+	}}
+	svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, tags, &fakeRoundOutcomeRepo{})
+
+	series, err := svc.tagComparisonSeries(context.Background(), "guild-1", []string{"alice", "bob"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(series) != 2 || series[1].MemberID != "bob" || len(series[1].Points) != 0 {
+		t.Fatalf("expected an empty series for bob, got %+v", series)
+	}
+	want := []leaderboarddomain.SeriesPoint{{At: t0, Value: 5}, {At: t0.AddDate(0, 0, 7), Value: 2}}
+	if !slices.Equal(series[0].Points, want) {
+		t.Fatalf("alice's series %+v, want %+v", series[0].Points, want)
+	}
+}
@@ -2,6 +2,7 @@ package leaderboardservice
 
 import (
 	"context"
+	"fmt"
 
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
@@ -37,15 +38,28 @@ func (s *LeaderboardService) ResetTagsFromQualifyingRound(
 	return s.commandPipeline.ResetTags(ctx, string(guildID), order)
 }
 
-// EndSeason ends the active season for a guild through normalized command orchestration.
+// EndSeason archives and ends the active season for a guild through normalized command
+// orchestration, then seeds the tags of members promoted or relegated at season end.
 func (s *LeaderboardService) EndSeason(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[bool, error], error) {
 	return withTelemetry(s, ctx, "EndSeason", guildID, func(ctx context.Context) (results.OperationResult[bool, error], error) {
 		if s.commandPipeline == nil {
 			return results.OperationResult[bool, error]{}, ErrCommandPipelineUnavailable
 		}
+		// Ending a guild without an active season is a no-op, reported as false.
+		active, err := s.repo.GetActiveSeason(ctx, nil, string(guildID))
+		if err != nil {
+			return results.OperationResult[bool, error]{}, fmt.Errorf("get active season: %w", err)
+		}
+		if active == nil {
+			return results.SuccessResult[bool, error](false), nil
+		}
 		if err := s.commandPipeline.EndSeason(ctx, string(guildID)); err != nil {
 			return results.OperationResult[bool, error]{}, err
 		}
+		// Members moved by the promotion policy start the next season with their seed tags.
+		if err := s.seedSeasonPromotions(ctx, guildID, active.ID); err != nil {
+			s.logSeedFailure(ctx, guildID, active.ID, err)
+		}
 		return results.SuccessResult[bool, error](true), nil
 	})
 }
@@ -0,0 +1,246 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+// ListDivisions returns a guild's divisions in creation order. The default division
+// is implicit and not listed.
+func (s *LeaderboardService) ListDivisions(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+) (results.OperationResult[[]leaderboarddomain.Division, error], error) {
+	return withTelemetry(s, ctx, "ListDivisions", guildID, func(ctx context.Context) (results.OperationResult[[]leaderboarddomain.Division, error], error) {
+		rows, err := s.repo.ListDivisions(ctx, nil, string(guildID))
+		if err != nil {
+			return results.OperationResult[[]leaderboarddomain.Division, error]{}, fmt.Errorf("failed to list divisions: %w", err)
+		}
+		divisions := make([]leaderboarddomain.Division, len(rows))
+		for i, row := range rows {
+			divisions[i] = leaderboarddomain.Division{ID: row.ID, Name: row.Name}
+		}
+		return results.SuccessResult[[]leaderboarddomain.Division, error](divisions), nil
+	})
+}
+
+// CreateDivision adds a division to a guild. Guilds must have custom leaderboards
+// enabled on their config. New divisions start empty; members join through
+// AssignMemberDivision.
+func (s *LeaderboardService) CreateDivision(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	division leaderboarddomain.Division,
+) (results.OperationResult[leaderboarddomain.Division, error], error) {
+	return withTelemetry(s, ctx, "CreateDivision", guildID, func(ctx context.Context) (results.OperationResult[leaderboarddomain.Division, error], error) {
+		division.Name = strings.TrimSpace(division.Name)
+		if err := division.Validate(); err != nil {
+			return results.FailureResult[leaderboarddomain.Division](err), nil
+		}
+
+		settings, err := s.loadGuildSettings(ctx, string(guildID))
+		if err != nil {
+			return results.OperationResult[leaderboarddomain.Division, error]{}, err
+		}
+		if settings == nil || !settings.CustomLeaderboardsEnabled {
+			return results.FailureResult[leaderboarddomain.Division](ErrCustomLeaderboardsDisabled), nil
+		}
+
+		row := &leaderboarddb.Division{GuildID: string(guildID), ID: division.ID, Name: division.Name}
+		if err := s.repo.CreateDivision(ctx, nil, row); err != nil {
+			if errors.Is(err, leaderboarddb.ErrAlreadyExists) {
+				return results.FailureResult[leaderboarddomain.Division](ErrDivisionExists), nil
+			}
+			return results.OperationResult[leaderboarddomain.Division, error]{}, fmt.Errorf("failed to create division: %w", err)
+		}
+		return results.SuccessResult[leaderboarddomain.Division, error](division), nil
+	})
+}
+
+// AssignMemberDivision moves a member to a division; an empty division ID moves them
+// back to the default one. A member changing division gives up their tag, which is
+// recorded as released, and starts untagged in the new division.
+func (s *LeaderboardService) AssignMemberDivision(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	memberID sharedtypes.DiscordID,
+	divisionID string,
+) (results.OperationResult[leaderboarddomain.Division, error], error) {
+	assignTx := func(ctx context.Context, db bun.IDB) (results.OperationResult[leaderboarddomain.Division, error], error) {
+		if memberID == "" {
+			return results.FailureResult[leaderboarddomain.Division](ErrInvalidUserID), nil
+		}
+		if err := s.memberRepo.AcquireGuildLock(ctx, db, string(guildID)); err != nil {
+			return results.OperationResult[leaderboarddomain.Division, error]{}, fmt.Errorf("acquire guild lock: %w", err)
+		}
+
+		division, err := s.lookupDivision(ctx, db, string(guildID), divisionID)
+		if err != nil {
+			return results.OperationResult[leaderboarddomain.Division, error]{}, err
+		}
+		if division == nil {
+			return results.FailureResult[leaderboarddomain.Division](ErrDivisionNotFound), nil
+		}
+
+		member, err := s.memberRepo.GetMemberByID(ctx, db, string(guildID), string(memberID))
+		if err != nil {
+			return results.OperationResult[leaderboarddomain.Division, error]{}, fmt.Errorf("failed to load member: %w", err)
+		}
+		if member != nil && member.DivisionID == divisionID {
+			return results.SuccessResult[leaderboarddomain.Division, error](*division), nil
+		}
+
+		tag, fromDivisionID := 0, leaderboarddomain.DefaultDivisionID
+		if member != nil {
+			fromDivisionID = member.DivisionID
+			if member.CurrentTag != nil {
+				tag = *member.CurrentTag
+			}
+		}
+		if err := s.moveMemberDivisionInTx(ctx, db, string(guildID), string(memberID), tag, fromDivisionID, divisionID); err != nil {
+			return results.OperationResult[leaderboarddomain.Division, error]{}, err
+		}
+		return results.SuccessResult[leaderboarddomain.Division, error](*division), nil
+	}
+
+	return withTelemetry(s, ctx, "AssignMemberDivision", guildID, func(ctx context.Context) (results.OperationResult[leaderboarddomain.Division, error], error) {
+		result, err := runInTx(s, ctx, assignTx)
+		if err == nil && result.IsSuccess() {
+			// The member's current tag is gone from their stats profile.
+			s.forgetMemberStats(string(guildID), memberID)
+		}
+		return result, err
+	})
+}
+
+// GetDivisionLeaderboard is GetLeaderboard limited to one division's tag holders.
+func (s *LeaderboardService) GetDivisionLeaderboard(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	divisionID string,
+	seasonID string,
+) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
+	return withTelemetry(s, ctx, "GetDivisionLeaderboard", guildID, func(ctx context.Context) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
+		division, err := s.lookupDivision(ctx, nil, string(guildID), divisionID)
+		if err != nil {
+			return results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error]{}, err
+		}
+		if division == nil {
+			return results.FailureResult[[]leaderboardtypes.LeaderboardEntry](ErrDivisionNotFound), nil
+		}
+		return s.leaderboardEntries(ctx, guildID, &divisionID, seasonID)
+	})
+}
+
+// GetDivisionTagList is GetTagList limited to one division's members. It returns
+// ErrDivisionNotFound for unknown divisions.
+func (s *LeaderboardService) GetDivisionTagList(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, clubUUID *string) ([]MemberTagView, error) {
+	division, err := s.lookupDivision(ctx, nil, string(guildID), divisionID)
+	if err != nil {
+		return nil, err
+	}
+	if division == nil {
+		return nil, ErrDivisionNotFound
+	}
+	members, err := s.GetTagList(ctx, guildID, clubUUID)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]MemberTagView, 0, len(members))
+	for _, m := range members {
+		if m.DivisionID == divisionID {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered, nil
+}
+
+// GetDivisionSeasonStandings is GetSeasonStandingsForSeason limited to the members
+// currently in one division. Members with standings but no league row are in the
+// default division.
+func (s *LeaderboardService) GetDivisionSeasonStandings(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	divisionID string,
+	seasonID string,
+) (results.OperationResult[[]SeasonStandingEntry, error], error) {
+	return withTelemetry(s, ctx, "GetDivisionSeasonStandings", guildID, func(ctx context.Context) (results.OperationResult[[]SeasonStandingEntry, error], error) {
+		division, err := s.lookupDivision(ctx, nil, string(guildID), divisionID)
+		if err != nil {
+			return results.OperationResult[[]SeasonStandingEntry, error]{}, err
+		}
+		if division == nil {
+			return results.FailureResult[[]SeasonStandingEntry](ErrDivisionNotFound), nil
+		}
+
+		standings, err := s.repo.GetSeasonStandingsBySeasonID(ctx, nil, string(guildID), seasonID)
+		if err != nil {
+			return results.OperationResult[[]SeasonStandingEntry, error]{}, fmt.Errorf("failed to get season standings: %w", err)
+		}
+		members, err := s.memberRepo.GetMembersByGuild(ctx, s.db, string(guildID))
+		if err != nil {
+			return results.OperationResult[[]SeasonStandingEntry, error]{}, fmt.Errorf("failed to load members: %w", err)
+		}
+		divisionByMember := make(map[sharedtypes.DiscordID]string, len(members))
+		for _, m := range members {
+			divisionByMember[sharedtypes.DiscordID(m.MemberID)] = m.DivisionID
+		}
+
+		var inDivision []leaderboarddb.SeasonStanding
+		for _, st := range standings {
+			if divisionByMember[st.MemberID] == divisionID {
+				inDivision = append(inDivision, st)
+			}
+		}
+		return results.SuccessResult[[]SeasonStandingEntry, error](toSeasonStandingEntries(inDivision)), nil
+	})
+}
+
+// moveMemberDivisionInTx moves a member from fromDivisionID to toDivisionID and records
+// the release of the tag they held in fromDivisionID; tag is 0 if they held none.
+func (s *LeaderboardService) moveMemberDivisionInTx(ctx context.Context, db bun.IDB, guildID, memberID string, tag int, fromDivisionID, toDivisionID string) error {
+	if err := s.memberRepo.SetMemberDivision(ctx, db, guildID, memberID, toDivisionID); err != nil {
+		return fmt.Errorf("failed to assign division: %w", err)
+	}
+	if tag <= 0 {
+		return nil
+	}
+	oldMemberID := memberID
+	if err := s.tagHistRepo.BulkInsertTagHistory(ctx, db, []leaderboarddb.TagHistoryEntry{{
+		GuildID:     guildID,
+		TagNumber:   tag,
+		DivisionID:  fromDivisionID,
+		OldMemberID: &oldMemberID,
+		NewMemberID: "",
+		Reason:      leaderboarddomain.DivisionChangeReason,
+		Metadata:    "{}",
+	}}); err != nil {
+		return fmt.Errorf("failed to record tag release: %w", err)
+	}
+	return nil
+}
+
+// lookupDivision returns the named division, or the default one for an empty ID.
+// Returns nil if the guild has no such division.
+func (s *LeaderboardService) lookupDivision(ctx context.Context, db bun.IDB, guildID, divisionID string) (*leaderboarddomain.Division, error) {
+	if divisionID == leaderboarddomain.DefaultDivisionID {
+		return &leaderboarddomain.Division{}, nil
+	}
+	row, err := s.repo.GetDivision(ctx, db, guildID, divisionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get division: %w", err)
+	}
+	if row == nil {
+		return nil, nil
+	}
+	return &leaderboarddomain.Division{ID: row.ID, Name: row.Name}, nil
+}
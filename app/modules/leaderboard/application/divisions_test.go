@@ -0,0 +1,234 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+func TestCreateDivision_RequiresCustomLeaderboards(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *GuildSettings
+		wantErr error
+	}{
+		{name: "no config", config: nil, wantErr: ErrCustomLeaderboardsDisabled},
+		{name: "disabled", config: &GuildSettings{}, wantErr: ErrCustomLeaderboardsDisabled},
+		{name: "enabled", config: &GuildSettings{CustomLeaderboardsEnabled: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewFakeLeaderboardRepo()
+			var created *leaderboarddb.Division
+			repo.CreateDivisionFunc = func(ctx context.Context, db bun.IDB, division *leaderboarddb.Division) error {
+				created = division
+				return nil
+			}
+			svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+			svc.SetGuildSettingsSource(&fakeGuildSettingsSource{settings: tt.config})
+
+			result, err := svc.CreateDivision(context.Background(), "guild-1", leaderboarddomain.Division{ID: "pro", Name: " Pro "})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil {
+				if !result.IsFailure() || !errors.Is(*result.Failure, tt.wantErr) {
+					t.Fatalf("expected failure %v, got %+v", tt.wantErr, result)
+				}
+				if created != nil {
+					t.Fatalf("division should not be created")
+				}
+				return
+			}
+			if !result.IsSuccess() || result.Success.Name != "Pro" {
+				t.Fatalf("expected trimmed division, got %+v", result)
+			}
+			if created == nil || created.GuildID != "guild-1" || created.ID != "pro" {
+				t.Fatalf("unexpected row %+v", created)
+			}
+		})
+	}
+}
+
+func TestCreateDivision_DuplicateFails(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	repo.CreateDivisionFunc = func(ctx context.Context, db bun.IDB, division *leaderboarddb.Division) error {
+		return leaderboarddb.ErrAlreadyExists
+	}
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+	svc.SetGuildSettingsSource(&fakeGuildSettingsSource{settings: &GuildSettings{CustomLeaderboardsEnabled: true}})
+
+	result, err := svc.CreateDivision(context.Background(), "guild-1", leaderboarddomain.Division{ID: "pro", Name: "Pro"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.IsFailure() || !errors.Is(*result.Failure, ErrDivisionExists) {
+		t.Fatalf("expected ErrDivisionExists, got %+v", result)
+	}
+}
+
+func TestAssignMemberDivision_ReleasesTag(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	repo.GetDivisionFunc = func(ctx context.Context, db bun.IDB, guildID, divisionID string) (*leaderboarddb.Division, error) {
+		return &leaderboarddb.Division{GuildID: guildID, ID: divisionID, Name: "Pro"}, nil
+	}
+	var movedTo string
+	members := &fakeLeagueMemberRepo{
+		getMemberByIDFunc: func(ctx context.Context, db bun.IDB, guildID, memberID string) (*leaderboarddb.LeagueMember, error) {
+			tag := 4
+			return &leaderboarddb.LeagueMember{GuildID: guildID, MemberID: memberID, CurrentTag: &tag}, nil
+		},
+		setMemberDivisionFunc: func(ctx context.Context, db bun.IDB, guildID, memberID, divisionID string) error {
+			movedTo = divisionID
+			return nil
+		},
+	}
+	tags := &fakeTagHistoryRepo{}
+	svc := newWriteFlowTestService(repo, members, tags, &fakeRoundOutcomeRepo{})
+
+	result, err := svc.AssignMemberDivision(context.Background(), "guild-1", "user-1", "pro")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.IsSuccess() || result.Success.Name != "Pro" {
+		t.Fatalf("expected success, got %+v", result)
+	}
+	if members.acquireGuildLockCalls != 1 {
+		t.Fatalf("expected guild lock, got %d calls", members.acquireGuildLockCalls)
+	}
+	if movedTo != "pro" {
+		t.Fatalf("expected member moved to pro, got %q", movedTo)
+	}
+	if len(tags.lastBulkInserted) != 1 {
+		t.Fatalf("expected one release entry, got %+v", tags.lastBulkInserted)
+	}
+	entry := tags.lastBulkInserted[0]
+	if entry.TagNumber != 4 || entry.NewMemberID != "" || entry.Reason != leaderboarddomain.DivisionChangeReason {
+		t.Fatalf("unexpected release entry %+v", entry)
+	}
+}
+
+func TestAssignMemberDivision_UnknownDivision(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	members := &fakeLeagueMemberRepo{
+		setMemberDivisionFunc: func(ctx context.Context, db bun.IDB, guildID, memberID, divisionID string) error {
+			t.Fatal("member should not be moved")
+			return nil
+		},
+	}
+	svc := newWriteFlowTestService(repo, members, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	result, err := svc.AssignMemberDivision(context.Background(), "guild-1", "user-1", "missing")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.IsFailure() || !errors.Is(*result.Failure, ErrDivisionNotFound) {
+		t.Fatalf("expected ErrDivisionNotFound, got %+v", result)
+	}
+}
+
+func TestAssignMemberDivision_SameDivisionIsNoop(t *testing.T) {
+	members := &fakeLeagueMemberRepo{
+		getMemberByIDFunc: func(ctx context.Context, db bun.IDB, guildID, memberID string) (*leaderboarddb.LeagueMember, error) {
+			tag := 2
+			return &leaderboarddb.LeagueMember{GuildID: guildID, MemberID: memberID, CurrentTag: &tag}, nil
+		},
+		setMemberDivisionFunc: func(ctx context.Context, db bun.IDB, guildID, memberID, divisionID string) error {
+			t.Fatal("member should not be moved")
+			return nil
+		},
+	}
+	tags := &fakeTagHistoryRepo{}
+	svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), members, tags, &fakeRoundOutcomeRepo{})
+
+	result, err := svc.AssignMemberDivision(context.Background(), "guild-1", "user-1", leaderboarddomain.DefaultDivisionID)
+	if err != nil || !result.IsSuccess() {
+		t.Fatalf("expected success, got %+v, %v", result, err)
+	}
+	if tags.bulkInsertCalls != 0 {
+		t.Fatalf("no tag should be released")
+	}
+}
+
+func TestProcessRoundInTx_AllocatesWithinDivisions(t *testing.T) {
+	members := &fakeLeagueMemberRepo{
+		getMembersByIDsFunc: func(ctx context.Context, db bun.IDB, guildID string, memberIDs []string) ([]leaderboarddb.LeagueMember, error) {
+			one, two, other := 1, 2, 1
+			return []leaderboarddb.LeagueMember{
+				{GuildID: guildID, MemberID: "pro-a", DivisionID: "pro", CurrentTag: &one},
+				{GuildID: guildID, MemberID: "pro-b", DivisionID: "pro", CurrentTag: &two},
+				{GuildID: guildID, MemberID: "am", CurrentTag: &other},
+			}, nil
+		},
+	}
+	svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), members, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	out, err := svc.processRoundInTx(context.Background(), bun.Tx{}, ProcessRoundCommand{
+		GuildID: "guild-1",
+		RoundID: uuid.New(),
+		Participants: []RoundParticipantInput{
+			{MemberID: "am", FinishRank: 1},
+			{MemberID: "pro-b", FinishRank: 2},
+			{MemberID: "pro-a", FinishRank: 3},
+		},
+	})
+	if err != nil {
+		t.Fatalf("processRoundInTx returned error: %v", err)
+	}
+
+	want := map[string]int{"pro-b": 1, "pro-a": 2, "am": 1}
+	for memberID, tag := range want {
+		if out.FinalParticipantTags[memberID] != tag {
+			t.Fatalf("expected %s to hold tag %d, got %+v", memberID, tag, out.FinalParticipantTags)
+		}
+	}
+}
+
+func TestGetDivisionSeasonStandings_FiltersByMemberDivision(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	repo.GetDivisionFunc = func(ctx context.Context, db bun.IDB, guildID, divisionID string) (*leaderboarddb.Division, error) {
+		return &leaderboarddb.Division{GuildID: guildID, ID: divisionID, Name: "Pro"}, nil
+	}
+	repo.GetSeasonStandingsBySeasonIDFunc = func(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]leaderboarddb.SeasonStanding, error) {
+		return []leaderboarddb.SeasonStanding{
+			{MemberID: "pro-a", SeasonID: seasonID, TotalPoints: 30},
+			{MemberID: "am", SeasonID: seasonID, TotalPoints: 20},
+			{MemberID: "departed", SeasonID: seasonID, TotalPoints: 10},
+		}, nil
+	}
+	members := &fakeLeagueMemberRepo{
+		getMembersByGuildFunc: func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.LeagueMember, error) {
+			return []leaderboarddb.LeagueMember{
+				{GuildID: guildID, MemberID: "pro-a", DivisionID: "pro"},
+				{GuildID: guildID, MemberID: "am"},
+			}, nil
+		},
+	}
+	svc := newWriteFlowTestService(repo, members, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	pro, err := svc.GetDivisionSeasonStandings(context.Background(), "guild-1", "pro", "s1")
+	if err != nil || !pro.IsSuccess() {
+		t.Fatalf("expected success, got %+v, %v", pro, err)
+	}
+	if len(*pro.Success) != 1 || (*pro.Success)[0].MemberID != "pro-a" {
+		t.Fatalf("unexpected pro standings %+v", *pro.Success)
+	}
+
+	def, err := svc.GetDivisionSeasonStandings(context.Background(), "guild-1", leaderboarddomain.DefaultDivisionID, "s1")
+	if err != nil || !def.IsSuccess() {
+		t.Fatalf("expected success, got %+v, %v", def, err)
+	}
+	got := make([]sharedtypes.DiscordID, 0, len(*def.Success))
+	for _, st := range *def.Success {
+		got = append(got, st.MemberID)
+	}
+	if len(got) != 2 || got[0] != "am" || got[1] != "departed" {
+		t.Fatalf("expected am and departed in the default division, got %v", got)
+	}
+}
@@ -23,6 +23,48 @@ var (
 	// ErrTagNotAvailable indicates the requested tag is not available.
 	ErrTagNotAvailable = errors.New("tag not available")
 
+	// ErrSeasonNotFound indicates the requested season does not exist.
+	ErrSeasonNotFound = errors.New("season not found")
+
+	// ErrNoActiveSeason indicates the guild has no active season to end.
+	ErrNoActiveSeason = errors.New("no active season")
+
+	// ErrSeasonArchiveNotFound indicates the season has not ended or was never archived.
+	ErrSeasonArchiveNotFound = errors.New("season archive not found")
+
+	// ErrGuildConfigNotFound indicates the guild has no active config to store settings on.
+	ErrGuildConfigNotFound = errors.New("guild config not found")
+
+	// ErrInvalidChartRequest indicates a chart request names an unknown kind or format,
+	// or the wrong number of members for its kind.
+	ErrInvalidChartRequest = errors.New("invalid chart request")
+
+	// ErrScoreDataUnavailable indicates stroke scores are needed but no score source is wired.
+	ErrScoreDataUnavailable = errors.New("score data unavailable")
+
+	// ErrMemberNotFound indicates the member has no tag, standing or processed rounds.
+	ErrMemberNotFound = errors.New("member not found")
+
+	// ErrDivisionNotFound indicates the guild has no division with the requested ID.
+	ErrDivisionNotFound = errors.New("division not found")
+
+	// ErrDivisionExists indicates the guild already has a division with the requested ID.
+	ErrDivisionExists = errors.New("division already exists")
+
+	// ErrCustomLeaderboardsDisabled indicates the guild has not enabled custom
+	// leaderboards, which divisions require.
+	ErrCustomLeaderboardsDisabled = errors.New("custom leaderboards are not enabled")
+
+	// ErrInvalidSnapshotRequest indicates a point-in-time leaderboard request names
+	// neither or both of a time and a round.
+	ErrInvalidSnapshotRequest = errors.New("snapshot request needs either an as-of time or a round ID")
+
+	// ErrRoundNotProcessed indicates the leaderboard has no record of the round.
+	ErrRoundNotProcessed = errors.New("round has not been processed")
+
+	// ErrInvalidExportRequest indicates an export names an unknown dataset or format.
+	ErrInvalidExportRequest = errors.New("invalid export request")
+
 	// ErrCommandPipelineUnavailable indicates command-optimized orchestration is not configured.
 	ErrCommandPipelineUnavailable = errors.New("command pipeline unavailable")
 )
@@ -0,0 +1,118 @@
+package leaderboardservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+)
+
+// ExportDataset selects the data an export contains.
+type ExportDataset string
+
+const (
+	// ExportTagHistory is the guild's whole tag ledger, oldest first. Its columns can
+	// be imported again with ImportTagHistory.
+	ExportTagHistory ExportDataset = "tag_history"
+	// ExportMembers is the guild's league members and their current tags.
+	ExportMembers ExportDataset = "members"
+	// ExportSeasonStandings is one season's standings.
+	ExportSeasonStandings ExportDataset = "season_standings"
+)
+
+// ExportFile is an encoded export.
+type ExportFile struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// ExportLeaderboardData encodes one of a guild's datasets as CSV or XLSX. seasonID
+// selects the standings exported, defaulting to the active season; other datasets
+// ignore it.
+func (s *LeaderboardService) ExportLeaderboardData(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	dataset ExportDataset,
+	format ExportFormat,
+	seasonID string,
+) (results.OperationResult[ExportFile, error], error) {
+	return withTelemetry(s, ctx, "ExportLeaderboardData", guildID, func(ctx context.Context) (results.OperationResult[ExportFile, error], error) {
+		if guildID == "" {
+			return results.FailureResult[ExportFile](ErrInvalidGuildID), nil
+		}
+		if format != ExportFormatCSV && format != ExportFormatXLSX {
+			return results.FailureResult[ExportFile](fmt.Errorf("%w: unknown format %q", ErrInvalidExportRequest, format)), nil
+		}
+		resolvedGuildID := s.resolveGuildID(ctx, string(guildID))
+
+		var rows [][]any
+		switch dataset {
+		case ExportTagHistory:
+			history, err := s.tagHistRepo.GetTagHistoryForGuild(ctx, nil, resolvedGuildID, time.Time{})
+			if err != nil {
+				return results.OperationResult[ExportFile, error]{}, fmt.Errorf("failed to load tag history: %w", err)
+			}
+			rows = append(rows, []any{"date", "tag_number", "old_member_id", "new_member_id", "reason", "round_id", "division_id"})
+			for _, h := range history {
+				oldMemberID, roundID := "", ""
+				if h.OldMemberID != nil {
+					oldMemberID = *h.OldMemberID
+				}
+				if h.RoundID != nil {
+					roundID = h.RoundID.String()
+				}
+				rows = append(rows, []any{h.CreatedAt.UTC().Format(time.RFC3339), h.TagNumber, oldMemberID, h.NewMemberID, h.Reason, roundID, h.DivisionID})
+			}
+
+		case ExportMembers:
+			members, err := s.memberRepo.GetMembersByGuild(ctx, nil, resolvedGuildID)
+			if err != nil {
+				return results.OperationResult[ExportFile, error]{}, fmt.Errorf("failed to load members: %w", err)
+			}
+			rows = append(rows, []any{"member_id", "tag_number", "division_id", "last_active_at"})
+			for _, m := range members {
+				var tag any = ""
+				if m.CurrentTag != nil {
+					tag = *m.CurrentTag
+				}
+				rows = append(rows, []any{m.MemberID, tag, m.DivisionID, m.LastActiveAt.UTC().Format(time.RFC3339)})
+			}
+
+		case ExportSeasonStandings:
+			if seasonID == "" {
+				season, err := s.repo.GetActiveSeason(ctx, nil, resolvedGuildID)
+				if err != nil {
+					return results.OperationResult[ExportFile, error]{}, fmt.Errorf("failed to get active season: %w", err)
+				}
+				if season == nil {
+					return results.FailureResult[ExportFile](ErrNoActiveSeason), nil
+				}
+				seasonID = season.ID
+			}
+			standings, err := s.repo.GetSeasonStandingsBySeasonID(ctx, nil, resolvedGuildID, seasonID)
+			if err != nil {
+				return results.OperationResult[ExportFile, error]{}, fmt.Errorf("failed to get season standings: %w", err)
+			}
+			rows = append(rows, []any{"season_id", "member_id", "total_points", "rounds_played", "current_tier", "season_best_tag"})
+			for _, st := range standings {
+				rows = append(rows, []any{st.SeasonID, string(st.MemberID), st.TotalPoints, st.RoundsPlayed, st.CurrentTier, st.SeasonBestTag})
+			}
+
+		default:
+			return results.FailureResult[ExportFile](fmt.Errorf("%w: unknown dataset %q", ErrInvalidExportRequest, dataset)), nil
+		}
+
+		data, err := encodeTable(format, string(dataset), rows)
+		if err != nil {
+			return results.OperationResult[ExportFile, error]{}, fmt.Errorf("failed to encode %s export: %w", dataset, err)
+		}
+		return results.SuccessResult[ExportFile, error](ExportFile{
+			FileName:    fmt.Sprintf("%s.%s", dataset, format),
+			ContentType: format.ContentType(),
+			Data:        data,
+		}), nil
+	})
+}
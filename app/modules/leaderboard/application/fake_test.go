@@ -2,6 +2,7 @@ package leaderboardservice
 
 import (
 	"context"
+	"time"
 
 	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
@@ -34,6 +35,43 @@ type FakeLeaderboardRepo struct {
 	DeletePointHistoryForRoundFunc    func(ctx context.Context, db bun.IDB, guildID string, roundID sharedtypes.RoundID) error
 	DecrementSeasonStandingFunc       func(ctx context.Context, db bun.IDB, guildID string, memberID sharedtypes.DiscordID, seasonID string, pointsToRemove int) error
 	DecrementSeasonStandingsBatchFunc func(ctx context.Context, db bun.IDB, guildID string, deltas []leaderboarddb.SeasonStandingDecrement) error
+
+	// Replay Stubs
+	ListPointHistoryForGuildFunc       func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.PointHistory, error)
+	ListPointHistoryForSeasonUntilFunc func(ctx context.Context, db bun.IDB, guildID string, seasonID string, until time.Time) ([]leaderboarddb.PointHistory, error)
+	DeleteSeasonStandingsForGuildFunc  func(ctx context.Context, db bun.IDB, guildID string) error
+	GetSeasonStandingsBySeasonIDFunc   func(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]leaderboarddb.SeasonStanding, error)
+	ListSeasonsFunc                    func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.Season, error)
+
+	// Season Stubs
+	GetActiveSeasonFunc func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error)
+	GetSeasonByIDFunc   func(ctx context.Context, db bun.IDB, guildID string, seasonID string) (*leaderboarddb.Season, error)
+	CreateSeasonFunc    func(ctx context.Context, db bun.IDB, guildID string, season *leaderboarddb.Season) error
+	CloseSeasonFunc     func(ctx context.Context, db bun.IDB, guildID string, seasonID string, endedAt time.Time) error
+	ActivateSeasonFunc  func(ctx context.Context, db bun.IDB, guildID string, seasonID string) error
+
+	ListGuildsWithDueSeasonTransitionsFunc func(ctx context.Context, db bun.IDB, now time.Time) ([]string, error)
+
+	// Inactivity Stubs
+	GetInactivityPolicyFunc           func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.InactivityPolicy, error)
+	UpsertInactivityPolicyFunc        func(ctx context.Context, db bun.IDB, policy *leaderboarddb.InactivityPolicy) error
+	ListEnabledInactivityPoliciesFunc func(ctx context.Context, db bun.IDB) ([]leaderboarddb.InactivityPolicy, error)
+
+	// Promotion Stubs
+	GetPromotionPolicyFunc     func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.PromotionPolicy, error)
+	UpsertPromotionPolicyFunc  func(ctx context.Context, db bun.IDB, policy *leaderboarddb.PromotionPolicy) error
+	InsertSeasonPromotionsFunc func(ctx context.Context, db bun.IDB, promotions []leaderboarddb.SeasonPromotion) error
+	ListSeasonPromotionsFunc   func(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]leaderboarddb.SeasonPromotion, error)
+
+	// Division Stubs
+	CreateDivisionFunc func(ctx context.Context, db bun.IDB, division *leaderboarddb.Division) error
+	GetDivisionFunc    func(ctx context.Context, db bun.IDB, guildID, divisionID string) (*leaderboarddb.Division, error)
+	ListDivisionsFunc  func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.Division, error)
+
+	// Archive Stubs
+	ArchiveSeasonFunc        func(ctx context.Context, db bun.IDB, guildID string, standings []leaderboarddb.SeasonArchiveStanding, awards []leaderboarddb.SeasonAward) error
+	GetArchivedStandingsFunc func(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]leaderboarddb.SeasonArchiveStanding, error)
+	GetSeasonAwardsFunc      func(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]leaderboarddb.SeasonAward, error)
 }
 
 func NewFakeLeaderboardRepo() *FakeLeaderboardRepo {
@@ -144,15 +182,45 @@ func (f *FakeLeaderboardRepo) DecrementSeasonStandingsBatch(ctx context.Context,
 	return nil
 }
 
+func (f *FakeLeaderboardRepo) ListPointHistoryForGuild(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.PointHistory, error) {
+	f.record("ListPointHistoryForGuild")
+	if f.ListPointHistoryForGuildFunc != nil {
+		return f.ListPointHistoryForGuildFunc(ctx, db, guildID)
+	}
+	return nil, nil
+}
+
+func (f *FakeLeaderboardRepo) ListPointHistoryForSeasonUntil(ctx context.Context, db bun.IDB, guildID string, seasonID string, until time.Time) ([]leaderboarddb.PointHistory, error) {
+	f.record("ListPointHistoryForSeasonUntil")
+	if f.ListPointHistoryForSeasonUntilFunc != nil {
+		return f.ListPointHistoryForSeasonUntilFunc(ctx, db, guildID, seasonID, until)
+	}
+	return nil, nil
+}
+
+func (f *FakeLeaderboardRepo) DeleteSeasonStandingsForGuild(ctx context.Context, db bun.IDB, guildID string) error {
+	f.record("DeleteSeasonStandingsForGuild")
+	if f.DeleteSeasonStandingsForGuildFunc != nil {
+		return f.DeleteSeasonStandingsForGuildFunc(ctx, db, guildID)
+	}
+	return nil
+}
+
 // --- Season Management ---
 
 func (f *FakeLeaderboardRepo) GetActiveSeason(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error) {
 	f.record("GetActiveSeason")
+	if f.GetActiveSeasonFunc != nil {
+		return f.GetActiveSeasonFunc(ctx, db, guildID)
+	}
 	return &leaderboarddb.Season{GuildID: guildID, ID: "default", Name: "Default Season", IsActive: true}, nil
 }
 
 func (f *FakeLeaderboardRepo) CreateSeason(ctx context.Context, db bun.IDB, guildID string, season *leaderboarddb.Season) error {
 	f.record("CreateSeason")
+	if f.CreateSeasonFunc != nil {
+		return f.CreateSeasonFunc(ctx, db, guildID, season)
+	}
 	return nil
 }
 
@@ -168,16 +236,153 @@ func (f *FakeLeaderboardRepo) GetPointHistoryForMember(ctx context.Context, db b
 
 func (f *FakeLeaderboardRepo) GetSeasonStandingsBySeasonID(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]leaderboarddb.SeasonStanding, error) {
 	f.record("GetSeasonStandingsBySeasonID")
+	if f.GetSeasonStandingsBySeasonIDFunc != nil {
+		return f.GetSeasonStandingsBySeasonIDFunc(ctx, db, guildID, seasonID)
+	}
 	return nil, nil
 }
 
 func (f *FakeLeaderboardRepo) ListSeasons(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.Season, error) {
 	f.record("ListSeasons")
+	if f.ListSeasonsFunc != nil {
+		return f.ListSeasonsFunc(ctx, db, guildID)
+	}
 	return nil, nil
 }
 
 func (f *FakeLeaderboardRepo) GetSeasonByID(ctx context.Context, db bun.IDB, guildID string, seasonID string) (*leaderboarddb.Season, error) {
 	f.record("GetSeasonByID")
+	if f.GetSeasonByIDFunc != nil {
+		return f.GetSeasonByIDFunc(ctx, db, guildID, seasonID)
+	}
+	return nil, nil
+}
+
+func (f *FakeLeaderboardRepo) CloseSeason(ctx context.Context, db bun.IDB, guildID string, seasonID string, endedAt time.Time) error {
+	f.record("CloseSeason")
+	if f.CloseSeasonFunc != nil {
+		return f.CloseSeasonFunc(ctx, db, guildID, seasonID, endedAt)
+	}
+	return nil
+}
+
+func (f *FakeLeaderboardRepo) ActivateSeason(ctx context.Context, db bun.IDB, guildID string, seasonID string) error {
+	f.record("ActivateSeason")
+	if f.ActivateSeasonFunc != nil {
+		return f.ActivateSeasonFunc(ctx, db, guildID, seasonID)
+	}
+	return nil
+}
+
+func (f *FakeLeaderboardRepo) ListGuildsWithDueSeasonTransitions(ctx context.Context, db bun.IDB, now time.Time) ([]string, error) {
+	f.record("ListGuildsWithDueSeasonTransitions")
+	if f.ListGuildsWithDueSeasonTransitionsFunc != nil {
+		return f.ListGuildsWithDueSeasonTransitionsFunc(ctx, db, now)
+	}
+	return nil, nil
+}
+
+func (f *FakeLeaderboardRepo) GetInactivityPolicy(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.InactivityPolicy, error) {
+	f.record("GetInactivityPolicy")
+	if f.GetInactivityPolicyFunc != nil {
+		return f.GetInactivityPolicyFunc(ctx, db, guildID)
+	}
+	return nil, nil
+}
+
+func (f *FakeLeaderboardRepo) UpsertInactivityPolicy(ctx context.Context, db bun.IDB, policy *leaderboarddb.InactivityPolicy) error {
+	f.record("UpsertInactivityPolicy")
+	if f.UpsertInactivityPolicyFunc != nil {
+		return f.UpsertInactivityPolicyFunc(ctx, db, policy)
+	}
+	return nil
+}
+
+func (f *FakeLeaderboardRepo) GetPromotionPolicy(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.PromotionPolicy, error) {
+	f.record("GetPromotionPolicy")
+	if f.GetPromotionPolicyFunc != nil {
+		return f.GetPromotionPolicyFunc(ctx, db, guildID)
+	}
+	return nil, nil
+}
+
+func (f *FakeLeaderboardRepo) UpsertPromotionPolicy(ctx context.Context, db bun.IDB, policy *leaderboarddb.PromotionPolicy) error {
+	f.record("UpsertPromotionPolicy")
+	if f.UpsertPromotionPolicyFunc != nil {
+		return f.UpsertPromotionPolicyFunc(ctx, db, policy)
+	}
+	return nil
+}
+
+func (f *FakeLeaderboardRepo) InsertSeasonPromotions(ctx context.Context, db bun.IDB, promotions []leaderboarddb.SeasonPromotion) error {
+	f.record("InsertSeasonPromotions")
+	if f.InsertSeasonPromotionsFunc != nil {
+		return f.InsertSeasonPromotionsFunc(ctx, db, promotions)
+	}
+	return nil
+}
+
+func (f *FakeLeaderboardRepo) ListSeasonPromotions(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]leaderboarddb.SeasonPromotion, error) {
+	f.record("ListSeasonPromotions")
+	if f.ListSeasonPromotionsFunc != nil {
+		return f.ListSeasonPromotionsFunc(ctx, db, guildID, seasonID)
+	}
+	return nil, nil
+}
+
+func (f *FakeLeaderboardRepo) ListEnabledInactivityPolicies(ctx context.Context, db bun.IDB) ([]leaderboarddb.InactivityPolicy, error) {
+	f.record("ListEnabledInactivityPolicies")
+	if f.ListEnabledInactivityPoliciesFunc != nil {
+		return f.ListEnabledInactivityPoliciesFunc(ctx, db)
+	}
+	return nil, nil
+}
+
+func (f *FakeLeaderboardRepo) CreateDivision(ctx context.Context, db bun.IDB, division *leaderboarddb.Division) error {
+	f.record("CreateDivision")
+	if f.CreateDivisionFunc != nil {
+		return f.CreateDivisionFunc(ctx, db, division)
+	}
+	return nil
+}
+
+func (f *FakeLeaderboardRepo) GetDivision(ctx context.Context, db bun.IDB, guildID, divisionID string) (*leaderboarddb.Division, error) {
+	f.record("GetDivision")
+	if f.GetDivisionFunc != nil {
+		return f.GetDivisionFunc(ctx, db, guildID, divisionID)
+	}
+	return nil, nil
+}
+
+func (f *FakeLeaderboardRepo) ListDivisions(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.Division, error) {
+	f.record("ListDivisions")
+	if f.ListDivisionsFunc != nil {
+		return f.ListDivisionsFunc(ctx, db, guildID)
+	}
+	return nil, nil
+}
+
+func (f *FakeLeaderboardRepo) ArchiveSeason(ctx context.Context, db bun.IDB, guildID string, standings []leaderboarddb.SeasonArchiveStanding, awards []leaderboarddb.SeasonAward) error {
+	f.record("ArchiveSeason")
+	if f.ArchiveSeasonFunc != nil {
+		return f.ArchiveSeasonFunc(ctx, db, guildID, standings, awards)
+	}
+	return nil
+}
+
+func (f *FakeLeaderboardRepo) GetArchivedStandings(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]leaderboarddb.SeasonArchiveStanding, error) {
+	f.record("GetArchivedStandings")
+	if f.GetArchivedStandingsFunc != nil {
+		return f.GetArchivedStandingsFunc(ctx, db, guildID, seasonID)
+	}
+	return nil, nil
+}
+
+func (f *FakeLeaderboardRepo) GetSeasonAwards(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]leaderboarddb.SeasonAward, error) {
+	f.record("GetSeasonAwards")
+	if f.GetSeasonAwardsFunc != nil {
+		return f.GetSeasonAwardsFunc(ctx, db, guildID, seasonID)
+	}
 	return nil, nil
 }
 
@@ -205,7 +410,7 @@ type FakeCommandPipeline struct {
 		source sharedtypes.ServiceUpdateSource,
 		updateID sharedtypes.RoundID,
 	) (leaderboardtypes.LeaderboardData, error)
-	StartSeasonFunc         func(ctx context.Context, guildID, seasonID, seasonName string) error
+	StartSeasonFunc         func(ctx context.Context, guildID, seasonID, seasonName string, policy leaderboarddomain.PointsPolicy) error
 	EndSeasonFunc           func(ctx context.Context, guildID string) error
 	ResetTagsFunc           func(ctx context.Context, guildID string, finishOrder []string) ([]leaderboarddomain.TagChange, error)
 	GetTaggedMembersFunc    func(ctx context.Context, guildID string, clubUUID *string) ([]TaggedMemberView, error)
@@ -236,9 +441,9 @@ func (f *FakeCommandPipeline) ApplyTagAssignments(
 	return leaderboardtypes.LeaderboardData{}, nil
 }
 
-func (f *FakeCommandPipeline) StartSeason(ctx context.Context, guildID, seasonID, seasonName string) error {
+func (f *FakeCommandPipeline) StartSeason(ctx context.Context, guildID, seasonID, seasonName string, policy leaderboarddomain.PointsPolicy) error {
 	if f.StartSeasonFunc != nil {
-		return f.StartSeasonFunc(ctx, guildID, seasonID, seasonName)
+		return f.StartSeasonFunc(ctx, guildID, seasonID, seasonName, policy)
 	}
 	return nil
 }
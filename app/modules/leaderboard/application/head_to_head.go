@@ -0,0 +1,152 @@
+package leaderboardservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+)
+
+// RoundScoreSource supplies members' gross stroke totals for a processed round.
+// Rounds without stored scores return an empty map.
+type RoundScoreSource interface {
+	GetRoundScores(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (map[sharedtypes.DiscordID]int, error)
+}
+
+// SetScoreSource wires the score lookup used for stroke differentials. Without it,
+// head-to-head records carry finish results and tag exchanges only.
+func (s *LeaderboardService) SetScoreSource(source RoundScoreSource) {
+	s.scoreSource = source
+}
+
+// GetHeadToHead returns memberA's record against memberB across the processed rounds
+// they both finished, optionally limited to one season.
+func (s *LeaderboardService) GetHeadToHead(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	memberA, memberB sharedtypes.DiscordID,
+	seasonID string,
+) (results.OperationResult[leaderboarddomain.HeadToHeadRecord, error], error) {
+	return withTelemetry(s, ctx, "GetHeadToHead", guildID, func(ctx context.Context) (results.OperationResult[leaderboarddomain.HeadToHeadRecord, error], error) {
+		if memberA == "" || memberB == "" || memberA == memberB {
+			return results.FailureResult[leaderboarddomain.HeadToHeadRecord](ErrInvalidUserID), nil
+		}
+		resolvedGuildID := s.resolveGuildID(ctx, string(guildID))
+
+		var season *leaderboarddb.Season
+		if seasonID != "" {
+			var err error
+			season, err = s.repo.GetSeasonByID(ctx, nil, resolvedGuildID, seasonID)
+			if err != nil {
+				return results.OperationResult[leaderboarddomain.HeadToHeadRecord, error]{}, fmt.Errorf("failed to get season: %w", err)
+			}
+			if season == nil {
+				return results.FailureResult[leaderboarddomain.HeadToHeadRecord](ErrSeasonNotFound), nil
+			}
+		}
+
+		outcomes, err := s.outcomeRepo.ListSharedRoundOutcomes(ctx, s.db, resolvedGuildID, string(memberA), string(memberB))
+		if err != nil {
+			return results.OperationResult[leaderboarddomain.HeadToHeadRecord, error]{}, fmt.Errorf("failed to list shared rounds: %w", err)
+		}
+		history, err := s.tagHistRepo.GetTagHistoryBetweenMembers(ctx, s.db, resolvedGuildID, string(memberA), string(memberB))
+		if err != nil {
+			return results.OperationResult[leaderboarddomain.HeadToHeadRecord, error]{}, fmt.Errorf("failed to get tag exchanges: %w", err)
+		}
+
+		var rounds []leaderboarddomain.HeadToHeadRound
+		for _, outcome := range outcomes {
+			if season != nil && (outcome.SeasonID == nil || *outcome.SeasonID != season.ID) {
+				continue
+			}
+			round, ok := sharedRound(outcome, string(memberA), string(memberB))
+			if !ok {
+				continue
+			}
+			s.attachRoundScores(ctx, guildID, outcome, &round, memberA, memberB)
+			rounds = append(rounds, round)
+		}
+
+		var exchanges []leaderboarddomain.TagExchange
+		for _, entry := range history {
+			if entry.OldMemberID == nil || entry.TagNumber <= 0 {
+				continue
+			}
+			if season != nil && !inSeasonWindow(season, entry.CreatedAt) {
+				continue
+			}
+			exchange := leaderboarddomain.TagExchange{
+				TagNumber: entry.TagNumber,
+				From:      *entry.OldMemberID,
+				To:        entry.NewMemberID,
+				At:        entry.CreatedAt,
+			}
+			if entry.RoundID != nil {
+				exchange.RoundID = entry.RoundID.String()
+			}
+			exchanges = append(exchanges, exchange)
+		}
+
+		record := leaderboarddomain.BuildHeadToHead(string(memberA), string(memberB), rounds, exchanges)
+		return results.SuccessResult[leaderboarddomain.HeadToHeadRecord, error](record), nil
+	})
+}
+
+// sharedRound extracts both members' finishes from a round outcome.
+func sharedRound(outcome leaderboarddb.RoundOutcome, memberA, memberB string) (leaderboarddomain.HeadToHeadRound, bool) {
+	round := leaderboarddomain.HeadToHeadRound{
+		RoundID:  outcome.RoundID.String(),
+		PlayedAt: outcome.CreatedAt,
+	}
+	for _, p := range outcome.Participants {
+		switch p.MemberID {
+		case memberA:
+			round.RankA = p.FinishRank
+		case memberB:
+			round.RankB = p.FinishRank
+		}
+	}
+	return round, round.RankA > 0 && round.RankB > 0
+}
+
+// attachRoundScores adds both members' stroke totals when the round has stored scores.
+// Lookup failures are logged and leave the round without a stroke differential.
+func (s *LeaderboardService) attachRoundScores(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	outcome leaderboarddb.RoundOutcome,
+	round *leaderboarddomain.HeadToHeadRound,
+	memberA, memberB sharedtypes.DiscordID,
+) {
+	if s.scoreSource == nil {
+		return
+	}
+	scores, err := s.scoreSource.GetRoundScores(ctx, guildID, sharedtypes.RoundID(outcome.RoundID))
+	if err != nil {
+		s.logger.WarnContext(ctx, "head-to-head score lookup failed",
+			slog.String("guild_id", string(guildID)),
+			slog.String("round_id", outcome.RoundID.String()),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	scoreA, okA := scores[memberA]
+	scoreB, okB := scores[memberB]
+	if okA && okB {
+		round.ScoreA, round.ScoreB = &scoreA, &scoreB
+	}
+}
+
+// inSeasonWindow reports whether t falls between a season's start and end; seasons
+// still running have no end.
+func inSeasonWindow(season *leaderboarddb.Season, t time.Time) bool {
+	if !season.StartDate.IsZero() && t.Before(season.StartDate) {
+		return false
+	}
+	return season.EndDate.IsZero() || !t.After(season.EndDate)
+}
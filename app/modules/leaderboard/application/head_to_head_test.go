@@ -0,0 +1,124 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type fakeRoundScoreSource map[uuid.UUID]map[sharedtypes.DiscordID]int
+
+func (f fakeRoundScoreSource) GetRoundScores(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (map[sharedtypes.DiscordID]int, error) {
+	scores, ok := f[uuid.UUID(roundID)]
+	if !ok {
+		return nil, errors.New("no scores")
+	}
+	return scores, nil
+}
+
+func TestGetHeadToHead(t *testing.T) {
+	t0 := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	spring, summer := "2026-spring", "2026-summer"
+	r1, r2, r3 := uuid.New(), uuid.New(), uuid.New()
+	outcomes := &fakeRoundOutcomeRepo{
+		listSharedFunc: func(ctx context.Context, db bun.IDB, guildID, memberA, memberB string) ([]leaderboarddb.RoundOutcome, error) {
+			return []leaderboarddb.RoundOutcome{
+				{RoundID: r1, SeasonID: &spring, CreatedAt: t0, Participants: []leaderboarddomain.RoundInput{
+					{MemberID: "alice", FinishRank: 1}, {MemberID: "bob", FinishRank: 2},
+				}},
+				{RoundID: r2, SeasonID: &spring, CreatedAt: t0.Add(24 * time.Hour), Participants: []leaderboarddomain.RoundInput{
+					{MemberID: "alice", FinishRank: 3}, {MemberID: "bob", FinishRank: 1},
+				}},
+				{RoundID: r3, SeasonID: &summer, CreatedAt: t0.AddDate(0, 3, 0), Participants: []leaderboarddomain.RoundInput{
+					{MemberID: "alice", FinishRank: 1}, {MemberID: "bob", FinishRank: 4},
+				}},
+			}, nil
+		},
+	}
+	alice, bob := "alice", "bob"
+	tags := &fakeTagHistoryRepo{betweenHistory: []leaderboarddb.TagHistoryEntry{
+		{TagNumber: 2, OldMemberID: &bob, NewMemberID: "alice", RoundID: &r1, CreatedAt: t0},
+		{TagNumber: 2, OldMemberID: &alice, NewMemberID: "bob", RoundID: &r2, CreatedAt: t0.Add(24 * time.Hour)},
+		{TagNumber: 2, OldMemberID: &bob, NewMemberID: "alice", RoundID: &r3, CreatedAt: t0.AddDate(0, 3, 0)},
+	}}
+
+	t.Run("all rounds", func(t *testing.T) {
+		svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, tags, outcomes)
+		svc.SetScoreSource(fakeRoundScoreSource{
+			r1: {"alice": 52, "bob": 55},
+			r2: {"alice": 60, "bob": 56},
+		})
+
+		res, err := svc.GetHeadToHead(context.Background(), "guild-1", "alice", "bob", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Success == nil {
+			t.Fatalf("expected success, got %+v", res)
+		}
+		got := *res.Success
+		if got.Wins != 2 || got.Losses != 1 || got.Ties != 0 {
+			t.Fatalf("record %d-%d-%d, want 2-1-0", got.Wins, got.Losses, got.Ties)
+		}
+		if got.StrokeRounds != 2 || got.AverageStrokeDifferential != 0.5 {
+			t.Fatalf("stroke differential %v over %d rounds, want 0.5 over 2", got.AverageStrokeDifferential, got.StrokeRounds)
+		}
+		if got.TagsTaken != 2 || got.TagsLost != 1 {
+			t.Fatalf("tags taken %d lost %d, want 2 and 1", got.TagsTaken, got.TagsLost)
+		}
+	})
+
+	t.Run("within a season", func(t *testing.T) {
+		repo := NewFakeLeaderboardRepo()
+		repo.GetSeasonByIDFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string) (*leaderboarddb.Season, error) {
+			return &leaderboarddb.Season{GuildID: guildID, ID: spring, StartDate: t0, EndDate: t0.AddDate(0, 1, 0)}, nil
+		}
+		svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, tags, outcomes)
+
+		res, err := svc.GetHeadToHead(context.Background(), "guild-1", "alice", "bob", spring)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := *res.Success
+		if got.Rounds != 2 || got.Wins != 1 || got.Losses != 1 {
+			t.Fatalf("expected the two spring rounds split 1-1, got %+v", got)
+		}
+		if got.TagsTaken != 1 || got.TagsLost != 1 {
+			t.Fatalf("expected one spring exchange each way, got taken %d lost %d", got.TagsTaken, got.TagsLost)
+		}
+		if got.StrokeRounds != 0 {
+			t.Fatalf("no score source is wired, got %d stroke rounds", got.StrokeRounds)
+		}
+	})
+
+	t.Run("unknown season", func(t *testing.T) {
+		svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, tags, outcomes)
+
+		res, err := svc.GetHeadToHead(context.Background(), "guild-1", "alice", "bob", "2020-fall")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrSeasonNotFound) {
+			t.Fatalf("expected season not found, got %+v", res)
+		}
+	})
+
+	t.Run("same member twice", func(t *testing.T) {
+		svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, tags, outcomes)
+
+		res, err := svc.GetHeadToHead(context.Background(), "guild-1", "alice", "alice", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrInvalidUserID) {
+			t.Fatalf("expected invalid user failure, got %+v", res)
+		}
+	})
+}
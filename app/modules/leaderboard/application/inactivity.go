@@ -0,0 +1,254 @@
+package leaderboardservice
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ServiceUpdateSourceInactivity marks tag assignments made by inactivity decay. Their
+// tag history is recorded with the "inactivity" reason.
+const ServiceUpdateSourceInactivity sharedtypes.ServiceUpdateSource = "inactivity_decay"
+
+// InactivityPreview lists the tag changes the next decay run would make.
+type InactivityPreview struct {
+	Policy  leaderboarddomain.InactivityPolicy
+	Decayed []leaderboarddomain.InactivityDecay
+	// Changes holds every tag change, including members moving up past a dropped one.
+	Changes []sharedtypes.TagAssignmentRequest
+}
+
+// InactivityDecayRun is the decay applied to one guild by RunInactivityDecay.
+type InactivityDecayRun struct {
+	GuildID sharedtypes.GuildID
+	Decayed []leaderboarddomain.InactivityDecay
+	Changes []sharedtypes.TagAssignmentRequest
+}
+
+// GetInactivityPolicy returns a guild's inactivity policy. Guilds without one get a
+// disabled zero policy.
+func (s *LeaderboardService) GetInactivityPolicy(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error) {
+	return withTelemetry(s, ctx, "GetInactivityPolicy", guildID, func(ctx context.Context) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error) {
+		row, err := s.repo.GetInactivityPolicy(ctx, nil, string(guildID))
+		if err != nil {
+			return results.OperationResult[leaderboarddomain.InactivityPolicy, error]{}, fmt.Errorf("failed to get inactivity policy: %w", err)
+		}
+		return results.SuccessResult[leaderboarddomain.InactivityPolicy, error](toInactivityPolicy(row)), nil
+	})
+}
+
+// SetInactivityPolicy stores a guild's inactivity policy. It takes effect on the next
+// decay run.
+func (s *LeaderboardService) SetInactivityPolicy(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	policy leaderboarddomain.InactivityPolicy,
+) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error) {
+	return withTelemetry(s, ctx, "SetInactivityPolicy", guildID, func(ctx context.Context) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error) {
+		if err := policy.Validate(); err != nil {
+			return results.FailureResult[leaderboarddomain.InactivityPolicy](err), nil
+		}
+		row := &leaderboarddb.InactivityPolicy{
+			GuildID:       string(guildID),
+			Enabled:       policy.Enabled,
+			InactiveWeeks: policy.InactiveWeeks,
+			Action:        string(policy.Action),
+			DropPositions: policy.DropPositions,
+		}
+		if err := s.repo.UpsertInactivityPolicy(ctx, nil, row); err != nil {
+			return results.OperationResult[leaderboarddomain.InactivityPolicy, error]{}, fmt.Errorf("failed to save inactivity policy: %w", err)
+		}
+		return results.SuccessResult[leaderboarddomain.InactivityPolicy, error](policy), nil
+	})
+}
+
+// PreviewInactivityDecay lists who the next decay run would affect. A non-nil policy
+// previews that policy instead of the stored one, so admins can try settings before
+// saving them; the stored policy is previewed even while disabled.
+func (s *LeaderboardService) PreviewInactivityDecay(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	policy *leaderboarddomain.InactivityPolicy,
+) (results.OperationResult[InactivityPreview, error], error) {
+	return withTelemetry(s, ctx, "PreviewInactivityDecay", guildID, func(ctx context.Context) (results.OperationResult[InactivityPreview, error], error) {
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (results.OperationResult[InactivityPreview, error], error) {
+			if policy == nil {
+				row, err := s.repo.GetInactivityPolicy(ctx, db, string(guildID))
+				if err != nil {
+					return results.OperationResult[InactivityPreview, error]{}, fmt.Errorf("failed to get inactivity policy: %w", err)
+				}
+				stored := toInactivityPolicy(row)
+				policy = &stored
+			}
+			if err := policy.Validate(); err != nil {
+				return results.FailureResult[InactivityPreview](err), nil
+			}
+
+			plan, err := s.planInactivityDecay(ctx, db, string(guildID), *policy, time.Now().UTC())
+			if err != nil {
+				return results.OperationResult[InactivityPreview, error]{}, err
+			}
+			return results.SuccessResult[InactivityPreview, error](InactivityPreview{
+				Policy:  *policy,
+				Decayed: plan.Decayed,
+				Changes: inactivityRequests(plan),
+			}), nil
+		})
+	})
+}
+
+// RunInactivityDecay applies every enabled inactivity policy. Changes go through
+// ExecuteBatchTagAssignment so they are recorded in tag history. Guilds are processed
+// independently; one failing guild does not hold back the others.
+func (s *LeaderboardService) RunInactivityDecay(ctx context.Context, now time.Time) ([]InactivityDecayRun, error) {
+	policies, err := s.repo.ListEnabledInactivityPolicies(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("LeaderboardService.RunInactivityDecay: %w", err)
+	}
+
+	var runs []InactivityDecayRun
+	for _, row := range policies {
+		guildID := sharedtypes.GuildID(row.GuildID)
+		planned, err := runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (results.OperationResult[leaderboarddomain.InactivityPlan, error], error) {
+			plan, err := s.planInactivityDecay(ctx, db, row.GuildID, toInactivityPolicy(&row), now)
+			if err != nil {
+				return results.OperationResult[leaderboarddomain.InactivityPlan, error]{}, err
+			}
+			return results.SuccessResult[leaderboarddomain.InactivityPlan, error](plan), nil
+		})
+		if err != nil {
+			s.logger.ErrorContext(ctx, "inactivity decay planning failed", attr.String("guild_id", row.GuildID), attr.Error(err))
+			continue
+		}
+		plan := *planned.Success
+		if len(plan.Assignments) == 0 {
+			continue
+		}
+
+		requests := inactivityRequests(plan)
+		result, err := s.ExecuteBatchTagAssignment(ctx, guildID, requests, sharedtypes.RoundID(uuid.Nil), ServiceUpdateSourceInactivity)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "inactivity decay failed", attr.String("guild_id", row.GuildID), attr.Error(err))
+			continue
+		}
+		if result.IsFailure() {
+			s.logger.WarnContext(ctx, "inactivity decay rejected", attr.String("guild_id", row.GuildID), attr.Error(*result.Failure))
+			continue
+		}
+		runs = append(runs, InactivityDecayRun{GuildID: guildID, Decayed: plan.Decayed, Changes: requests})
+	}
+	return runs, nil
+}
+
+// planInactivityDecay loads when each tag holder was last active and plans the decay.
+// A member is active when they play a finalized round or take a tag, and a decay
+// restarts their inactivity window so each window decays them once.
+func (s *LeaderboardService) planInactivityDecay(
+	ctx context.Context,
+	db bun.IDB,
+	guildID string,
+	policy leaderboarddomain.InactivityPolicy,
+	now time.Time,
+) (leaderboarddomain.InactivityPlan, error) {
+	members, err := s.memberRepo.GetTaggedMembers(ctx, db, guildID, nil)
+	if err != nil {
+		return leaderboarddomain.InactivityPlan{}, fmt.Errorf("load tagged members: %w", err)
+	}
+
+	lastActive := make(map[string]time.Time)
+	history, err := s.repo.ListPointHistoryForGuild(ctx, db, guildID)
+	if err != nil {
+		return leaderboarddomain.InactivityPlan{}, fmt.Errorf("load point history: %w", err)
+	}
+	for _, h := range history {
+		if uuid.UUID(h.RoundID) == uuid.Nil {
+			continue // manual adjustment, not a round
+		}
+		if h.CreatedAt.After(lastActive[string(h.MemberID)]) {
+			lastActive[string(h.MemberID)] = h.CreatedAt
+		}
+	}
+
+	// Only ledger entries inside the window can keep a member active.
+	tagHistory, err := s.tagHistRepo.GetTagHistoryForGuild(ctx, db, guildID, now.Add(-policy.Window()))
+	if err != nil {
+		return leaderboarddomain.InactivityPlan{}, fmt.Errorf("load tag history: %w", err)
+	}
+	ledger := make([]leaderboarddomain.TagLedgerEntry, len(tagHistory))
+	for i, entry := range tagHistory {
+		ledger[i] = leaderboarddomain.TagLedgerEntry{
+			At:          entry.CreatedAt,
+			TagNumber:   entry.TagNumber,
+			NewMemberID: entry.NewMemberID,
+			Reason:      entry.Reason,
+		}
+		if entry.OldMemberID != nil {
+			ledger[i].OldMemberID = *entry.OldMemberID
+		}
+	}
+	for memberID, at := range leaderboarddomain.LedgerActivity(ledger) {
+		if at.After(lastActive[memberID]) {
+			lastActive[memberID] = at
+		}
+	}
+
+	// Members only drop below others in their own division.
+	plan := leaderboarddomain.InactivityPlan{Assignments: make(map[string]int)}
+	for _, division := range leaderboarddomain.GroupByDivision(members, func(m leaderboarddb.LeagueMember) string { return m.DivisionID }) {
+		activity := make([]leaderboarddomain.MemberActivity, 0, len(division))
+		for _, m := range division {
+			if m.CurrentTag == nil {
+				continue
+			}
+			activity = append(activity, leaderboarddomain.MemberActivity{
+				MemberID:     m.MemberID,
+				Tag:          *m.CurrentTag,
+				LastActiveAt: lastActive[m.MemberID],
+			})
+		}
+		divisionPlan := leaderboarddomain.PlanInactivityDecay(policy, activity, now)
+		plan.Decayed = append(plan.Decayed, divisionPlan.Decayed...)
+		maps.Copy(plan.Assignments, divisionPlan.Assignments)
+	}
+	return plan, nil
+}
+
+func inactivityRequests(plan leaderboarddomain.InactivityPlan) []sharedtypes.TagAssignmentRequest {
+	requests := make([]sharedtypes.TagAssignmentRequest, 0, len(plan.Assignments))
+	for memberID, tag := range plan.Assignments {
+		requests = append(requests, sharedtypes.TagAssignmentRequest{
+			UserID:    sharedtypes.DiscordID(memberID),
+			TagNumber: sharedtypes.TagNumber(tag),
+		})
+	}
+	slices.SortFunc(requests, func(a, b sharedtypes.TagAssignmentRequest) int {
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+	return requests
+}
+
+func toInactivityPolicy(row *leaderboarddb.InactivityPolicy) leaderboarddomain.InactivityPolicy {
+	if row == nil {
+		return leaderboarddomain.InactivityPolicy{}
+	}
+	return leaderboarddomain.InactivityPolicy{
+		Enabled:       row.Enabled,
+		InactiveWeeks: row.InactiveWeeks,
+		Action:        leaderboarddomain.InactivityAction(row.Action),
+		DropPositions: row.DropPositions,
+	}
+}
@@ -0,0 +1,155 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+func inactivityTestMembers(tags map[string]int) func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.LeagueMember, error) {
+	return func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.LeagueMember, error) {
+		members := make([]leaderboarddb.LeagueMember, 0, len(tags))
+		for id, tag := range tags {
+			members = append(members, leaderboarddb.LeagueMember{GuildID: guildID, MemberID: id, CurrentTag: &tag})
+		}
+		return members, nil
+	}
+}
+
+func TestRunInactivityDecay_AppliesPolicyThroughBatchAssignment(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	repo := NewFakeLeaderboardRepo()
+	repo.ListEnabledInactivityPoliciesFunc = func(ctx context.Context, db bun.IDB) ([]leaderboarddb.InactivityPolicy, error) {
+		return []leaderboarddb.InactivityPolicy{
+			{GuildID: "guild-1", Enabled: true, InactiveWeeks: 4, Action: "drop", DropPositions: 1},
+		}, nil
+	}
+	repo.ListPointHistoryForGuildFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.PointHistory, error) {
+		return []leaderboarddb.PointHistory{
+			{MemberID: "alice", RoundID: sharedtypes.RoundID(uuid.New()), CreatedAt: now.AddDate(0, 0, -60)},
+			{MemberID: "bob", RoundID: sharedtypes.RoundID(uuid.New()), CreatedAt: now.AddDate(0, 0, -2)},
+			// Manual adjustments are not activity.
+			{MemberID: "alice", CreatedAt: now.AddDate(0, 0, -1)},
+		}, nil
+	}
+	members := &fakeLeagueMemberRepo{getMembersByGuildFunc: inactivityTestMembers(map[string]int{"alice": 1, "bob": 2})}
+	svc := newWriteFlowTestService(repo, members, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	var applied []sharedtypes.TagAssignmentRequest
+	var appliedSource sharedtypes.ServiceUpdateSource
+	svc.SetCommandPipeline(&FakeCommandPipeline{
+		ApplyTagsFunc: func(ctx context.Context, guildID string, requests []sharedtypes.TagAssignmentRequest, source sharedtypes.ServiceUpdateSource, updateID sharedtypes.RoundID) (leaderboardtypes.LeaderboardData, error) {
+			applied = requests
+			appliedSource = source
+			return leaderboardtypes.LeaderboardData{{UserID: "bob", TagNumber: 1}, {UserID: "alice", TagNumber: 2}}, nil
+		},
+	})
+
+	runs, err := svc.RunInactivityDecay(context.Background(), now)
+	if err != nil {
+		t.Fatalf("RunInactivityDecay returned error: %v", err)
+	}
+
+	want := []sharedtypes.TagAssignmentRequest{{UserID: "alice", TagNumber: 2}, {UserID: "bob", TagNumber: 1}}
+	if !slices.Equal(applied, want) {
+		t.Fatalf("applied %+v, want %+v", applied, want)
+	}
+	if appliedSource != ServiceUpdateSourceInactivity {
+		t.Fatalf("expected inactivity source, got %q", appliedSource)
+	}
+	if len(runs) != 1 || runs[0].GuildID != "guild-1" || len(runs[0].Decayed) != 1 || runs[0].Decayed[0].MemberID != "alice" {
+		t.Fatalf("unexpected runs: %+v", runs)
+	}
+}
+
+func TestRunInactivityDecay_RecentDecayRestartsWindow(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	decayedAt := now.AddDate(0, 0, -7)
+	repo := NewFakeLeaderboardRepo()
+	repo.ListEnabledInactivityPoliciesFunc = func(ctx context.Context, db bun.IDB) ([]leaderboarddb.InactivityPolicy, error) {
+		return []leaderboarddb.InactivityPolicy{
+			{GuildID: "guild-1", Enabled: true, InactiveWeeks: 4, Action: "drop", DropPositions: 1},
+		}, nil
+	}
+	members := &fakeLeagueMemberRepo{getMembersByGuildFunc: inactivityTestMembers(map[string]int{"alice": 2, "bob": 1})}
+	alice, bob := "alice", "bob"
+	tags := &fakeTagHistoryRepo{guildHistory: []leaderboarddb.TagHistoryEntry{
+		{TagNumber: 1, OldMemberID: &alice, NewMemberID: "bob", Reason: leaderboarddomain.InactivityReason, CreatedAt: decayedAt},
+		{TagNumber: 2, OldMemberID: &bob, NewMemberID: "alice", Reason: leaderboarddomain.InactivityReason, CreatedAt: decayedAt},
+	}}
+	svc := newWriteFlowTestService(repo, members, tags, &fakeRoundOutcomeRepo{})
+	var applied []sharedtypes.TagAssignmentRequest
+	svc.SetCommandPipeline(&FakeCommandPipeline{
+		ApplyTagsFunc: func(ctx context.Context, guildID string, requests []sharedtypes.TagAssignmentRequest, source sharedtypes.ServiceUpdateSource, updateID sharedtypes.RoundID) (leaderboardtypes.LeaderboardData, error) {
+			applied = requests
+			return leaderboardtypes.LeaderboardData{{UserID: "alice", TagNumber: 1}, {UserID: "bob", TagNumber: 2}}, nil
+		},
+	})
+
+	// bob only moved up past alice, so he is inactive; alice was decayed a week ago.
+	runs, err := svc.RunInactivityDecay(context.Background(), now)
+	if err != nil {
+		t.Fatalf("RunInactivityDecay returned error: %v", err)
+	}
+	if len(runs) != 1 || len(runs[0].Decayed) != 1 || runs[0].Decayed[0].MemberID != "bob" {
+		t.Fatalf("expected a run decaying only bob, got %+v", runs)
+	}
+	want := []sharedtypes.TagAssignmentRequest{{UserID: "alice", TagNumber: 1}, {UserID: "bob", TagNumber: 2}}
+	if !slices.Equal(applied, want) {
+		t.Fatalf("applied %+v, want %+v", applied, want)
+	}
+}
+
+func TestPreviewInactivityDecay_UsesOverridePolicy(t *testing.T) {
+	now := time.Now().UTC()
+	repo := NewFakeLeaderboardRepo()
+	members := &fakeLeagueMemberRepo{getMembersByGuildFunc: inactivityTestMembers(map[string]int{"alice": 1, "bob": 2})}
+	repo.ListPointHistoryForGuildFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.PointHistory, error) {
+		return []leaderboarddb.PointHistory{
+			{MemberID: "bob", RoundID: sharedtypes.RoundID(uuid.New()), CreatedAt: now.AddDate(0, 0, -1)},
+		}, nil
+	}
+	svc := newWriteFlowTestService(repo, members, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	policy := leaderboarddomain.InactivityPolicy{InactiveWeeks: 2, Action: leaderboarddomain.InactivityActionRelease}
+	res, err := svc.PreviewInactivityDecay(context.Background(), "guild-1", &policy)
+	if err != nil || res.Success == nil {
+		t.Fatalf("PreviewInactivityDecay failed: %v %v", err, res.Failure)
+	}
+	preview := *res.Success
+	if len(preview.Decayed) != 1 || preview.Decayed[0].MemberID != "alice" || preview.Decayed[0].NewTag != 0 {
+		t.Fatalf("unexpected decayed members: %+v", preview.Decayed)
+	}
+	if slices.Contains(repo.Trace(), "GetInactivityPolicy") {
+		t.Fatal("override policy should not load the stored one")
+	}
+}
+
+func TestSetInactivityPolicy_RejectsInvalidPolicy(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	res, err := svc.SetInactivityPolicy(context.Background(), "guild-1", leaderboarddomain.InactivityPolicy{
+		Enabled:       true,
+		InactiveWeeks: 4,
+		Action:        leaderboarddomain.InactivityActionDrop,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Failure == nil || !errors.Is(*res.Failure, leaderboarddomain.ErrInvalidInactivityPolicy) {
+		t.Fatalf("expected invalid policy failure, got %+v", res)
+	}
+	if slices.Contains(repo.Trace(), "UpsertInactivityPolicy") {
+		t.Fatal("an invalid policy must not be saved")
+	}
+}
@@ -2,6 +2,7 @@ package leaderboardservice
 
 import (
 	"context"
+	"time"
 
 	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
@@ -57,6 +58,9 @@ type Service interface {
 	// GetLeaderboard returns the active leaderboard entries as domain types.
 	GetLeaderboard(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error)
 
+	// GetLeaderboardAt rebuilds the leaderboard as it stood at asOf, or right after roundID was processed.
+	GetLeaderboardAt(ctx context.Context, guildID sharedtypes.GuildID, divisionID *string, seasonID string, asOf *time.Time, roundID *sharedtypes.RoundID) (results.OperationResult[LeaderboardSnapshot, error], error)
+
 	// GetTagByUserID returns the tag for a user or an error.
 	GetTagByUserID(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[sharedtypes.TagNumber, error], error)
 
@@ -87,6 +91,41 @@ type Service interface {
 	// StartNewSeason creates a new season record, deactivates the old one.
 	StartNewSeason(ctx context.Context, guildID sharedtypes.GuildID, seasonID string, seasonName string) (results.OperationResult[bool, error], error)
 
+	// StartNewSeasonWithPolicy starts a season scored under the given points policy.
+	StartNewSeasonWithPolicy(ctx context.Context, guildID sharedtypes.GuildID, seasonID string, seasonName string, policy leaderboarddomain.PointsPolicy) (results.OperationResult[bool, error], error)
+
+	// GetSeasonPointsPolicy returns the points policy of a season (the active season when seasonID is empty).
+	GetSeasonPointsPolicy(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboarddomain.PointsPolicy, error], error)
+
+	// ScheduleSeason creates a season that starts automatically at its start date.
+	ScheduleSeason(ctx context.Context, guildID sharedtypes.GuildID, schedule SeasonSchedule) (results.OperationResult[SeasonInfo, error], error)
+
+	// RunSeasonSchedule starts and ends seasons whose scheduled dates have passed.
+	RunSeasonSchedule(ctx context.Context, now time.Time) ([]SeasonTransition, error)
+
+	// GetInactivityPolicy returns a guild's tag decay policy for inactive members.
+	GetInactivityPolicy(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error)
+
+	// SetInactivityPolicy stores a guild's tag decay policy for inactive members.
+	SetInactivityPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.InactivityPolicy) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error)
+
+	// PreviewInactivityDecay lists who the next decay run would affect, under the given
+	// policy or, when nil, the stored one.
+	PreviewInactivityDecay(ctx context.Context, guildID sharedtypes.GuildID, policy *leaderboarddomain.InactivityPolicy) (results.OperationResult[InactivityPreview, error], error)
+
+	// RunInactivityDecay applies every enabled inactivity policy.
+	RunInactivityDecay(ctx context.Context, now time.Time) ([]InactivityDecayRun, error)
+
+	// GetTagPoolPolicy returns the tag pool model a guild's rounds reallocate tags with.
+	GetTagPoolPolicy(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error)
+
+	// SetTagPoolPolicy stores the tag pool model on a guild's config.
+	SetTagPoolPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.TagPoolPolicy) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error)
+
+	// ReplayGuild rebuilds tags, point history and standings by re-running the guild's
+	// rounds in order. With dryRun set it only reports what would change.
+	ReplayGuild(ctx context.Context, guildID sharedtypes.GuildID, dryRun bool) (results.OperationResult[ReplayResult, error], error)
+
 	// GetSeasonStandings retrieves standings for a specific season.
 	GetSeasonStandingsForSeason(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[[]SeasonStandingEntry, error], error)
 
@@ -96,6 +135,10 @@ type Service interface {
 	// GetSeasonName retrieves the display name for a specific season.
 	GetSeasonName(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (string, error)
 
+	// GetSeasonArchive returns the archived final standings and awards of an ended season
+	// (the most recently ended season when seasonID is empty).
+	GetSeasonArchive(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[SeasonArchive, error], error)
+
 	// --- COMMAND-STYLE OPERATIONS (transport-agnostic) ---
 
 	// ProcessRoundCommand runs the normalized command flow for round processing.
@@ -104,7 +147,8 @@ type Service interface {
 	// ResetTagsFromQualifyingRound clears and reassigns tags based on finish order.
 	ResetTagsFromQualifyingRound(ctx context.Context, guildID sharedtypes.GuildID, finishOrder []sharedtypes.DiscordID) ([]leaderboarddomain.TagChange, error)
 
-	// EndSeason ends the active season for a guild.
+	// EndSeason archives final standings and awards, then ends the active season for a guild.
+	// It succeeds with false when the guild has no active season.
 	EndSeason(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[bool, error], error)
 
 	// --- TAG HISTORY ---
@@ -118,6 +162,67 @@ type Service interface {
 	// GenerateTagGraphPNG generates a PNG chart of a member's tag history.
 	GenerateTagGraphPNG(ctx context.Context, guildID sharedtypes.GuildID, memberID string) ([]byte, error)
 
+	// --- EXPORT / IMPORT ---
+
+	// ExportLeaderboardData encodes tag history, members or season standings as CSV or XLSX.
+	ExportLeaderboardData(ctx context.Context, guildID sharedtypes.GuildID, dataset ExportDataset, format ExportFormat, seasonID string) (results.OperationResult[ExportFile, error], error)
+
+	// ImportTagHistory validates a tag history file, dry-runs it against current tags and,
+	// unless dryRun is set, applies it through the batch tag funnel.
+	ImportTagHistory(ctx context.Context, guildID sharedtypes.GuildID, fileName string, data []byte, dryRun bool) (results.OperationResult[TagImportResult, error], error)
+
+	// --- CHARTS ---
+
+	// RenderChart renders a statistics chart as PNG or SVG.
+	RenderChart(ctx context.Context, guildID sharedtypes.GuildID, req ChartRequest) (results.OperationResult[RenderedChart, error], error)
+
+	// --- MEMBER STATS ---
+
+	// GetMemberStats returns a member's statistics profile, served from cache when fresh.
+	GetMemberStats(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (results.OperationResult[leaderboarddomain.MemberStats, error], error)
+
+	// InvalidateMemberStats drops cached profiles for the members, or the whole guild when none are given.
+	InvalidateMemberStats(ctx context.Context, guildID sharedtypes.GuildID, memberIDs ...sharedtypes.DiscordID)
+
+	// --- DIVISIONS ---
+
+	// ListDivisions returns a guild's divisions; the default division is implicit.
+	ListDivisions(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[[]leaderboarddomain.Division, error], error)
+
+	// CreateDivision adds a division to a guild with custom leaderboards enabled.
+	CreateDivision(ctx context.Context, guildID sharedtypes.GuildID, division leaderboarddomain.Division) (results.OperationResult[leaderboarddomain.Division, error], error)
+
+	// AssignMemberDivision moves a member to a division ("" = default), releasing their tag.
+	AssignMemberDivision(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, divisionID string) (results.OperationResult[leaderboarddomain.Division, error], error)
+
+	// GetDivisionLeaderboard returns one division's tag leaderboard.
+	GetDivisionLeaderboard(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error)
+
+	// GetDivisionTagList returns one division's members, tagged or not.
+	GetDivisionTagList(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, clubUUID *string) ([]MemberTagView, error)
+
+	// GetDivisionSeasonStandings returns the season standings of one division's members.
+	GetDivisionSeasonStandings(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]SeasonStandingEntry, error], error)
+
+	// --- PROMOTION ---
+
+	// GetPromotionPolicy returns a guild's promotion policy; unset policies are disabled.
+	GetPromotionPolicy(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error)
+
+	// SetPromotionPolicy stores a guild's promotion policy, applied when a season ends.
+	SetPromotionPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.PromotionPolicy) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error)
+
+	// PreviewPromotions lists who would change division if the active season ended now.
+	PreviewPromotions(ctx context.Context, guildID sharedtypes.GuildID, policy *leaderboarddomain.PromotionPolicy) (results.OperationResult[PromotionPreview, error], error)
+
+	// GetSeasonPromotions returns the division moves made when a season ended ("" = latest).
+	GetSeasonPromotions(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[SeasonPromotions, error], error)
+
+	// --- HEAD TO HEAD ---
+
+	// GetHeadToHead returns memberA's record against memberB, optionally within one season.
+	GetHeadToHead(ctx context.Context, guildID sharedtypes.GuildID, memberA, memberB sharedtypes.DiscordID, seasonID string) (results.OperationResult[leaderboarddomain.HeadToHeadRecord, error], error)
+
 	// --- INFRASTRUCTURE ---
 	EnsureGuildLeaderboard(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[bool, error], error)
 }
@@ -149,6 +254,10 @@ type SeasonInfo struct {
 	ID        string
 	Name      string
 	IsActive  bool
+	Scheduled bool // starts automatically at StartDate
 	StartDate string
 	EndDate   *string
+	Timezone  string
+
+	PointsPolicy leaderboarddomain.PointsPolicy
 }
@@ -0,0 +1,279 @@
+package leaderboardservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+)
+
+// RoundParSource supplies the total par of a round's layout. Rounds whose par scores
+// are unknown return 0.
+type RoundParSource interface {
+	GetRoundPar(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (int, error)
+}
+
+// SetParSource wires the round lookup used for par-relative averages. Without it,
+// member stats carry no average relative to par.
+func (s *LeaderboardService) SetParSource(source RoundParSource) {
+	s.parSource = source
+}
+
+// ReliabilitySource supplies a member's check-in record. Members without check-in
+// history return nil.
+type ReliabilitySource interface {
+	GetMemberReliability(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (*leaderboarddomain.Reliability, error)
+}
+
+// SetReliabilitySource wires the round lookup used for check-in reliability.
+// Without it, member stats carry no reliability.
+func (s *LeaderboardService) SetReliabilitySource(source ReliabilitySource) {
+	s.reliability = source
+}
+
+// defaultMemberStatsCacheTTL bounds how long a profile is served from cache. Round
+// processing and score corrections invalidate profiles sooner; the TTL covers tag
+// swaps, point adjustments and check-ins, which do not.
+const defaultMemberStatsCacheTTL = 10 * time.Minute
+
+type memberStatsCacheEntry struct {
+	stats     leaderboarddomain.MemberStats
+	expiresAt time.Time
+}
+
+// GetMemberStats returns a member's statistics profile across every processed round.
+// Profiles are cached per member until the guild's rounds or scores change.
+func (s *LeaderboardService) GetMemberStats(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	memberID sharedtypes.DiscordID,
+) (results.OperationResult[leaderboarddomain.MemberStats, error], error) {
+	return withTelemetry(s, ctx, "GetMemberStats", guildID, func(ctx context.Context) (results.OperationResult[leaderboarddomain.MemberStats, error], error) {
+		if memberID == "" {
+			return results.FailureResult[leaderboarddomain.MemberStats](ErrInvalidUserID), nil
+		}
+		resolvedGuildID := s.resolveGuildID(ctx, string(guildID))
+
+		now := time.Now().UTC()
+		if stats, ok := s.getCachedMemberStats(resolvedGuildID, string(memberID), now); ok {
+			return results.SuccessResult[leaderboarddomain.MemberStats, error](stats), nil
+		}
+
+		stats, found, err := s.buildMemberStats(ctx, guildID, resolvedGuildID, string(memberID))
+		if err != nil {
+			return results.OperationResult[leaderboarddomain.MemberStats, error]{}, err
+		}
+		if !found {
+			return results.FailureResult[leaderboarddomain.MemberStats](ErrMemberNotFound), nil
+		}
+		stats.GeneratedAt = now
+
+		s.cacheMemberStats(resolvedGuildID, stats, now)
+		return results.SuccessResult[leaderboarddomain.MemberStats, error](stats), nil
+	})
+}
+
+// InvalidateMemberStats drops cached profiles so the next request rebuilds them. With
+// no member IDs every profile in the guild is dropped.
+func (s *LeaderboardService) InvalidateMemberStats(ctx context.Context, guildID sharedtypes.GuildID, memberIDs ...sharedtypes.DiscordID) {
+	s.forgetMemberStats(s.resolveGuildID(ctx, string(guildID)), memberIDs...)
+}
+
+// buildMemberStats assembles a profile from round outcomes, scores, tags, season
+// standings and check-ins. found is false when the member has none of them.
+func (s *LeaderboardService) buildMemberStats(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	resolvedGuildID, memberID string,
+) (leaderboarddomain.MemberStats, bool, error) {
+	outcomes, err := s.outcomeRepo.ListRoundOutcomes(ctx, s.db, resolvedGuildID)
+	if err != nil {
+		return leaderboarddomain.MemberStats{}, false, fmt.Errorf("failed to list rounds: %w", err)
+	}
+	rounds := make([]leaderboarddomain.StatsRound, len(outcomes))
+	for i, outcome := range outcomes {
+		rounds[i] = s.statsRound(ctx, guildID, outcome, memberID)
+	}
+	stats := leaderboarddomain.BuildMemberStats(memberID, rounds)
+
+	member, err := s.memberRepo.GetMemberByID(ctx, s.db, resolvedGuildID, memberID)
+	if err != nil {
+		return leaderboarddomain.MemberStats{}, false, fmt.Errorf("failed to get member: %w", err)
+	}
+	tags := make([]int, 0)
+	if member != nil && member.CurrentTag != nil && *member.CurrentTag > 0 {
+		current := *member.CurrentTag
+		stats.CurrentTag = &current
+		tags = append(tags, current)
+	}
+	history, err := s.tagHistRepo.GetTagHistoryForMember(ctx, s.db, resolvedGuildID, memberID, 0)
+	if err != nil {
+		return leaderboarddomain.MemberStats{}, false, fmt.Errorf("failed to get tag history: %w", err)
+	}
+	for _, entry := range history {
+		if entry.NewMemberID == memberID {
+			tags = append(tags, entry.TagNumber)
+		}
+	}
+	if best, ok := leaderboarddomain.BestTag(tags...); ok {
+		stats.BestTag = &best
+	}
+
+	hasStanding, err := s.applyPointsRank(ctx, resolvedGuildID, &stats)
+	if err != nil {
+		return leaderboarddomain.MemberStats{}, false, err
+	}
+
+	if s.reliability != nil {
+		reliability, err := s.reliability.GetMemberReliability(ctx, sharedtypes.GuildID(resolvedGuildID), sharedtypes.DiscordID(memberID))
+		if err != nil {
+			s.logger.WarnContext(ctx, "member stats reliability lookup failed",
+				slog.String("guild_id", resolvedGuildID),
+				slog.String("member_id", memberID),
+				slog.String("error", err.Error()),
+			)
+		} else {
+			stats.Reliability = reliability
+		}
+	}
+
+	found := member != nil || stats.RoundsPlayed > 0 || len(history) > 0 || hasStanding
+	return stats, found, nil
+}
+
+// statsRound describes one processed round from the member's side. Score and par
+// lookups that fail are logged and left out.
+func (s *LeaderboardService) statsRound(ctx context.Context, guildID sharedtypes.GuildID, outcome leaderboarddb.RoundOutcome, memberID string) leaderboarddomain.StatsRound {
+	round := leaderboarddomain.StatsRound{PlayedAt: outcome.CreatedAt}
+	for _, p := range outcome.Participants {
+		if p.MemberID == memberID {
+			round.FinishRank = p.FinishRank
+			break
+		}
+	}
+	if round.FinishRank == 0 || s.scoreSource == nil {
+		return round
+	}
+
+	roundID := sharedtypes.RoundID(outcome.RoundID)
+	scores, err := s.scoreSource.GetRoundScores(ctx, guildID, roundID)
+	if err != nil {
+		s.logger.WarnContext(ctx, "member stats score lookup failed",
+			slog.String("guild_id", string(guildID)),
+			slog.String("round_id", outcome.RoundID.String()),
+			slog.String("error", err.Error()),
+		)
+		return round
+	}
+	score, ok := scores[sharedtypes.DiscordID(memberID)]
+	if !ok {
+		return round
+	}
+	round.Score = &score
+
+	if s.parSource == nil {
+		return round
+	}
+	par, err := s.parSource.GetRoundPar(ctx, guildID, roundID)
+	if err != nil {
+		s.logger.WarnContext(ctx, "member stats par lookup failed",
+			slog.String("guild_id", string(guildID)),
+			slog.String("round_id", outcome.RoundID.String()),
+			slog.String("error", err.Error()),
+		)
+		return round
+	}
+	round.Par = par
+	return round
+}
+
+// applyPointsRank sets the member's points and rank in the active season. Members on
+// equal points share a rank. It reports whether the member has a standing.
+func (s *LeaderboardService) applyPointsRank(ctx context.Context, guildID string, stats *leaderboarddomain.MemberStats) (bool, error) {
+	season, err := s.repo.GetActiveSeason(ctx, nil, guildID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get active season: %w", err)
+	}
+	if season == nil {
+		return false, nil
+	}
+	stats.SeasonID = season.ID
+
+	standings, err := s.repo.GetSeasonStandingsBySeasonID(ctx, nil, guildID, season.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get season standings: %w", err)
+	}
+	var own *leaderboarddb.SeasonStanding
+	for i := range standings {
+		if string(standings[i].MemberID) == stats.MemberID {
+			own = &standings[i]
+			break
+		}
+	}
+	if own == nil {
+		return false, nil
+	}
+
+	rank := 1
+	for _, standing := range standings {
+		if standing.TotalPoints > own.TotalPoints {
+			rank++
+		}
+	}
+	stats.SeasonPoints = own.TotalPoints
+	stats.PointsRank = &rank
+	return true, nil
+}
+
+func (s *LeaderboardService) getCachedMemberStats(guildID, memberID string, now time.Time) (leaderboarddomain.MemberStats, bool) {
+	if s.memberStatsCacheTTL <= 0 {
+		return leaderboarddomain.MemberStats{}, false
+	}
+
+	s.memberStatsCacheMu.RLock()
+	entry, ok := s.memberStatsCache[guildID][memberID]
+	s.memberStatsCacheMu.RUnlock()
+	if !ok || now.After(entry.expiresAt) {
+		return leaderboarddomain.MemberStats{}, false
+	}
+	return entry.stats, true
+}
+
+func (s *LeaderboardService) cacheMemberStats(guildID string, stats leaderboarddomain.MemberStats, now time.Time) {
+	if s.memberStatsCacheTTL <= 0 {
+		return
+	}
+
+	s.memberStatsCacheMu.Lock()
+	if s.memberStatsCache == nil {
+		s.memberStatsCache = make(map[string]map[string]memberStatsCacheEntry)
+	}
+	if s.memberStatsCache[guildID] == nil {
+		s.memberStatsCache[guildID] = make(map[string]memberStatsCacheEntry)
+	}
+	s.memberStatsCache[guildID][stats.MemberID] = memberStatsCacheEntry{
+		stats:     stats,
+		expiresAt: now.Add(s.memberStatsCacheTTL),
+	}
+	s.memberStatsCacheMu.Unlock()
+}
+
+// forgetMemberStats drops the named members' cached profiles, or the whole guild's
+// when none are named.
+func (s *LeaderboardService) forgetMemberStats(guildID string, memberIDs ...sharedtypes.DiscordID) {
+	s.memberStatsCacheMu.Lock()
+	defer s.memberStatsCacheMu.Unlock()
+
+	if len(memberIDs) == 0 {
+		delete(s.memberStatsCache, guildID)
+		return
+	}
+	for _, memberID := range memberIDs {
+		delete(s.memberStatsCache[guildID], string(memberID))
+	}
+}
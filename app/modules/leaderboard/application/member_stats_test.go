@@ -0,0 +1,160 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type fakeRoundParSource map[uuid.UUID]int
+
+func (f fakeRoundParSource) GetRoundPar(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (int, error) {
+	return f[uuid.UUID(roundID)], nil
+}
+
+type fakeReliabilitySource map[sharedtypes.DiscordID]*leaderboarddomain.Reliability
+
+func (f fakeReliabilitySource) GetMemberReliability(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (*leaderboarddomain.Reliability, error) {
+	return f[memberID], nil
+}
+
+func TestGetMemberStats(t *testing.T) {
+	t0 := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	r1, r2, r3 := uuid.New(), uuid.New(), uuid.New()
+	outcomeCalls := 0
+	outcomes := &fakeRoundOutcomeRepo{
+		listOutcomesFunc: func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.RoundOutcome, error) {
+			outcomeCalls++
+			return []leaderboarddb.RoundOutcome{
+				{RoundID: r1, CreatedAt: t0, Participants: []leaderboarddomain.RoundInput{{MemberID: "alice", FinishRank: 1}, {MemberID: "bob", FinishRank: 2}}},
+				{RoundID: r2, CreatedAt: t0.AddDate(0, 0, 7), Participants: []leaderboarddomain.RoundInput{{MemberID: "bob", FinishRank: 1}}},
+				{RoundID: r3, CreatedAt: t0.AddDate(0, 0, 14), Participants: []leaderboarddomain.RoundInput{{MemberID: "bob", FinishRank: 1}, {MemberID: "alice", FinishRank: 2}}},
+			}, nil
+		},
+	}
+	tag := 4
+	members := &fakeLeagueMemberRepo{
+		getMemberByIDFunc: func(ctx context.Context, db bun.IDB, guildID, memberID string) (*leaderboarddb.LeagueMember, error) {
+			if memberID != "alice" {
+				return nil, nil
+			}
+			return &leaderboarddb.LeagueMember{GuildID: guildID, MemberID: memberID, CurrentTag: &tag}, nil
+		},
+	}
+	tags := &fakeTagHistoryRepo{memberHistory: map[string][]leaderboarddb.TagHistoryEntry{
+		"alice": {{TagNumber: 4, NewMemberID: "alice"}, {TagNumber: 2, NewMemberID: "alice"}},
+	}}
+	repo := NewFakeLeaderboardRepo()
+	repo.GetActiveSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error) {
+		return &leaderboarddb.Season{GuildID: guildID, ID: "2026-spring", IsActive: true}, nil
+	}
+	repo.GetSeasonStandingsBySeasonIDFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string) ([]leaderboarddb.SeasonStanding, error) {
+		return []leaderboarddb.SeasonStanding{
+			{MemberID: "bob", TotalPoints: 300},
+			{MemberID: "carol", TotalPoints: 300},
+			{MemberID: "alice", TotalPoints: 150},
+		}, nil
+	}
+	svc := newWriteFlowTestService(repo, members, tags, outcomes)
+	svc.memberStatsCacheTTL = time.Minute
+	svc.SetScoreSource(fakeRoundScoreSource{
+		r1: {"alice": 52, "bob": 55},
+		r3: {"alice": 60, "bob": 56},
+	})
+	svc.SetParSource(fakeRoundParSource{r1: 54})
+	svc.SetReliabilitySource(fakeReliabilitySource{
+		"alice": {CheckedIn: 3, NoShows: 1, Rate: 0.75},
+	})
+
+	res, err := svc.GetMemberStats(context.Background(), "guild-1", "alice")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Success == nil {
+		t.Fatalf("expected success, got %+v", res)
+	}
+	stats := *res.Success
+	if stats.RoundsPlayed != 2 || stats.Wins != 1 || stats.Podiums != 2 {
+		t.Fatalf("played/wins/podiums = %d/%d/%d, want 2/1/2", stats.RoundsPlayed, stats.Wins, stats.Podiums)
+	}
+	if *stats.AverageScore != 56 || *stats.BestScore != 52 || *stats.WorstScore != 60 {
+		t.Fatalf("unexpected scores: %+v", stats)
+	}
+	if stats.ParRounds != 1 || *stats.AverageToPar != -2 {
+		t.Fatalf("par rounds %d, average to par %v; want 1, -2", stats.ParRounds, *stats.AverageToPar)
+	}
+	if *stats.CurrentTag != 4 || *stats.BestTag != 2 {
+		t.Fatalf("current/best tag = %d/%d, want 4/2", *stats.CurrentTag, *stats.BestTag)
+	}
+	if stats.SeasonID != "2026-spring" || stats.SeasonPoints != 150 || *stats.PointsRank != 3 {
+		t.Fatalf("season %s points %d rank %v, want 2026-spring 150 3", stats.SeasonID, stats.SeasonPoints, *stats.PointsRank)
+	}
+	if stats.AttendanceStreak != (leaderboarddomain.Streak{Current: 1, Longest: 1}) {
+		t.Fatalf("attendance streak %+v", stats.AttendanceStreak)
+	}
+	if stats.Reliability == nil || stats.Reliability.NoShows != 1 || stats.Reliability.Rate != 0.75 {
+		t.Fatalf("reliability %+v, want 3 checked in, 1 no-show", stats.Reliability)
+	}
+
+	if _, err := svc.GetMemberStats(context.Background(), "guild-1", "alice"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if outcomeCalls != 1 {
+		t.Fatalf("expected the cached profile to be served, rounds listed %d times", outcomeCalls)
+	}
+
+	svc.InvalidateMemberStats(context.Background(), "guild-1")
+	if _, err := svc.GetMemberStats(context.Background(), "guild-1", "alice"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if outcomeCalls != 2 {
+		t.Fatalf("expected the profile to be rebuilt after invalidation, rounds listed %d times", outcomeCalls)
+	}
+}
+
+func TestGetMemberStats_Failures(t *testing.T) {
+	svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	res, err := svc.GetMemberStats(context.Background(), "guild-1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Failure == nil || !errors.Is(*res.Failure, ErrInvalidUserID) {
+		t.Fatalf("expected invalid user, got %+v", res)
+	}
+
+	res, err = svc.GetMemberStats(context.Background(), "guild-1", "ghost")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Failure == nil || !errors.Is(*res.Failure, ErrMemberNotFound) {
+		t.Fatalf("expected member not found, got %+v", res)
+	}
+}
+
+func TestForgetMemberStats(t *testing.T) {
+	svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+	svc.memberStatsCacheTTL = time.Minute
+	now := time.Now()
+	for _, id := range []string{"alice", "bob"} {
+		svc.cacheMemberStats("guild-1", leaderboarddomain.MemberStats{MemberID: id}, now)
+	}
+
+	svc.forgetMemberStats("guild-1", "alice")
+	if _, ok := svc.getCachedMemberStats("guild-1", "alice", now); ok {
+		t.Fatal("expected alice's profile to be dropped")
+	}
+	if _, ok := svc.getCachedMemberStats("guild-1", "bob", now); !ok {
+		t.Fatal("expected bob's profile to stay cached")
+	}
+	if _, ok := svc.getCachedMemberStats("guild-1", "bob", now.Add(2*time.Minute)); ok {
+		t.Fatal("expected an expired profile to be ignored")
+	}
+}
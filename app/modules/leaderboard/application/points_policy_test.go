@@ -0,0 +1,161 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// TestProcessRoundInTx_RecalculationUsesOriginalSeasonPolicy covers the path taken by
+// LeaderboardRecalculateRoundV1: a changed round is rolled back and rescored under the
+// season it was first processed in, with that season's policy, even though a newer
+// season with a different policy is now active.
+func TestProcessRoundInTx_RecalculationUsesOriginalSeasonPolicy(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	members := &fakeLeagueMemberRepo{}
+	outcomes := &fakeRoundOutcomeRepo{}
+	svc := newWriteFlowTestService(repo, members, &fakeTagHistoryRepo{}, outcomes)
+
+	originalSeason := "2026-spring"
+	linear := leaderboarddomain.DefaultLinearPolicy()
+	matchup := leaderboarddomain.DefaultPointsPolicy()
+
+	repo.GetActiveSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error) {
+		return &leaderboarddb.Season{GuildID: guildID, ID: "2026-summer", IsActive: true, PointsPolicy: &matchup}, nil
+	}
+	var policySeason string
+	repo.GetSeasonByIDFunc = func(ctx context.Context, db bun.IDB, guildID string, seasonID string) (*leaderboarddb.Season, error) {
+		policySeason = seasonID
+		if seasonID == originalSeason {
+			return &leaderboarddb.Season{GuildID: guildID, ID: seasonID, PointsPolicy: &linear}, nil
+		}
+		return &leaderboarddb.Season{GuildID: guildID, ID: seasonID, IsActive: true, PointsPolicy: &matchup}, nil
+	}
+	outcomes.getOutcomeFunc = func(ctx context.Context, db bun.IDB, guildID string, roundID uuid.UUID) (*leaderboarddb.RoundOutcome, error) {
+		return &leaderboarddb.RoundOutcome{
+			GuildID:        guildID,
+			RoundID:        roundID,
+			SeasonID:       &originalSeason,
+			ProcessingHash: "hash-before-correction",
+			ProcessedAt:    time.Now().UTC().Add(-time.Hour),
+		}, nil
+	}
+	members.getMembersByIDsFunc = func(ctx context.Context, db bun.IDB, guildID string, memberIDs []string) ([]leaderboarddb.LeagueMember, error) {
+		tag1, tag2, tag3 := 1, 2, 3
+		return []leaderboarddb.LeagueMember{
+			{GuildID: guildID, MemberID: "u1", CurrentTag: &tag1},
+			{GuildID: guildID, MemberID: "u2", CurrentTag: &tag2},
+			{GuildID: guildID, MemberID: "u3", CurrentTag: &tag3},
+		}, nil
+	}
+	var savedHistories []*leaderboarddb.PointHistory
+	repo.BulkSavePointHistoryFunc = func(ctx context.Context, db bun.IDB, guildID string, histories []*leaderboarddb.PointHistory) error {
+		savedHistories = histories
+		return nil
+	}
+
+	cmd := ProcessRoundCommand{
+		GuildID: "guild-1",
+		RoundID: uuid.New(),
+		Participants: []RoundParticipantInput{
+			{MemberID: "u1", FinishRank: 1},
+			{MemberID: "u2", FinishRank: 2},
+			{MemberID: "u3", FinishRank: 3},
+		},
+	}
+
+	out, err := svc.processRoundInTx(context.Background(), bun.Tx{}, cmd)
+	if err != nil {
+		t.Fatalf("processRoundInTx returned error: %v", err)
+	}
+	if out.SeasonID != originalSeason || policySeason != originalSeason {
+		t.Fatalf("expected recalculation to score under %s, got season %s with policy from %s", originalSeason, out.SeasonID, policySeason)
+	}
+
+	want := map[sharedtypes.DiscordID]int{"u1": 100, "u2": 90, "u3": 80}
+	if len(savedHistories) != len(want) {
+		t.Fatalf("expected %d point histories, got %d", len(want), len(savedHistories))
+	}
+	for _, h := range savedHistories {
+		if h.SeasonID != originalSeason {
+			t.Errorf("%s: expected season %s, got %s", h.MemberID, originalSeason, h.SeasonID)
+		}
+		if h.Points != want[h.MemberID] {
+			t.Errorf("%s: expected linear points %d, got %d", h.MemberID, want[h.MemberID], h.Points)
+		}
+	}
+}
+
+func TestCalculateAndPersistPoints_LegacySeasonUsesDefaultPolicy(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	repo.GetSeasonByIDFunc = func(ctx context.Context, db bun.IDB, guildID string, seasonID string) (*leaderboarddb.Season, error) {
+		return &leaderboarddb.Season{GuildID: guildID, ID: seasonID}, nil
+	}
+
+	cmd := ProcessRoundCommand{
+		GuildID: "guild-1",
+		RoundID: uuid.New(),
+		Participants: []RoundParticipantInput{
+			{MemberID: "u1", FinishRank: 1},
+			{MemberID: "u2", FinishRank: 2},
+		},
+	}
+	awards, err := svc.calculateAndPersistPoints(context.Background(), bun.Tx{}, cmd, map[string]int{"u1": 1, "u2": 2}, nil, nil, "legacy")
+	if err != nil {
+		t.Fatalf("calculateAndPersistPoints returned error: %v", err)
+	}
+	if awards[0].Points != int(leaderboarddomain.BaseWin) {
+		t.Fatalf("expected default matchup points %d, got %d", leaderboarddomain.BaseWin, awards[0].Points)
+	}
+}
+
+func TestStartNewSeasonWithPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      leaderboarddomain.PointsPolicy
+		wantFailure bool
+		wantCalled  bool
+	}{
+		{name: "valid policy is passed to pipeline", policy: leaderboarddomain.DefaultFieldScaledPolicy(), wantCalled: true},
+		{name: "invalid policy is rejected", policy: leaderboarddomain.PointsPolicy{System: "elo"}, wantFailure: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *leaderboarddomain.PointsPolicy
+			svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+			svc.commandPipeline = &FakeCommandPipeline{
+				StartSeasonFunc: func(ctx context.Context, guildID, seasonID, seasonName string, policy leaderboarddomain.PointsPolicy) error {
+					got = &policy
+					return nil
+				},
+			}
+
+			res, err := svc.StartNewSeasonWithPolicy(context.Background(), "guild-1", "2026-fall", "Fall 2026", tt.policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.IsFailure() != tt.wantFailure {
+				t.Fatalf("expected failure=%v, got %+v", tt.wantFailure, res)
+			}
+			if tt.wantFailure && !errors.Is(*res.Failure, leaderboarddomain.ErrInvalidPointsPolicy) {
+				t.Fatalf("expected ErrInvalidPointsPolicy, got %v", *res.Failure)
+			}
+			if (got != nil) != tt.wantCalled {
+				t.Fatalf("expected pipeline called=%v", tt.wantCalled)
+			}
+			if got != nil && got.System != tt.policy.System {
+				t.Fatalf("expected system %s, got %s", tt.policy.System, got.System)
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package leaderboardservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ServiceUpdateSourceSeasonTransition marks tags seeded for members promoted or
+// relegated at season end. Their tag history is recorded with the "season_transition"
+// reason.
+const ServiceUpdateSourceSeasonTransition sharedtypes.ServiceUpdateSource = "season_transition"
+
+// PromotionPreview lists the division moves the active season would end with.
+type PromotionPreview struct {
+	Policy   leaderboarddomain.PromotionPolicy
+	SeasonID string
+	Moves    []leaderboarddomain.PromotionMove
+}
+
+// SeasonPromotions are the division moves made when a season ended.
+type SeasonPromotions struct {
+	SeasonID string
+	Moves    []leaderboarddomain.PromotionMove
+}
+
+// GetPromotionPolicy returns a guild's promotion policy. Guilds without one get a
+// disabled zero policy.
+func (s *LeaderboardService) GetPromotionPolicy(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error) {
+	return withTelemetry(s, ctx, "GetPromotionPolicy", guildID, func(ctx context.Context) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error) {
+		row, err := s.repo.GetPromotionPolicy(ctx, nil, string(guildID))
+		if err != nil {
+			return results.OperationResult[leaderboarddomain.PromotionPolicy, error]{}, fmt.Errorf("failed to get promotion policy: %w", err)
+		}
+		return results.SuccessResult[leaderboarddomain.PromotionPolicy, error](toPromotionPolicy(row)), nil
+	})
+}
+
+// SetPromotionPolicy stores a guild's promotion policy. Every division it names must
+// exist. It takes effect when the active season ends.
+func (s *LeaderboardService) SetPromotionPolicy(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	policy leaderboarddomain.PromotionPolicy,
+) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error) {
+	return withTelemetry(s, ctx, "SetPromotionPolicy", guildID, func(ctx context.Context) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error) {
+		if err := policy.Validate(); err != nil {
+			return results.FailureResult[leaderboarddomain.PromotionPolicy](err), nil
+		}
+		for _, b := range policy.Boundaries {
+			for _, divisionID := range []string{b.UpperDivisionID, b.LowerDivisionID} {
+				division, err := s.lookupDivision(ctx, nil, string(guildID), divisionID)
+				if err != nil {
+					return results.OperationResult[leaderboarddomain.PromotionPolicy, error]{}, err
+				}
+				if division == nil {
+					return results.FailureResult[leaderboarddomain.PromotionPolicy](fmt.Errorf("%w: %s", ErrDivisionNotFound, divisionID)), nil
+				}
+			}
+		}
+
+		row := &leaderboarddb.PromotionPolicy{
+			GuildID:    string(guildID),
+			Enabled:    policy.Enabled,
+			Boundaries: policy.Boundaries,
+		}
+		if row.Boundaries == nil {
+			row.Boundaries = []leaderboarddomain.PromotionBoundary{}
+		}
+		if err := s.repo.UpsertPromotionPolicy(ctx, nil, row); err != nil {
+			return results.OperationResult[leaderboarddomain.PromotionPolicy, error]{}, fmt.Errorf("failed to save promotion policy: %w", err)
+		}
+		return results.SuccessResult[leaderboarddomain.PromotionPolicy, error](policy), nil
+	})
+}
+
+// PreviewPromotions lists who would change division if the active season ended now.
+// A non-nil policy previews that policy instead of the stored one, so admins can try
+// settings before saving them; the stored policy is previewed even while disabled.
+func (s *LeaderboardService) PreviewPromotions(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	policy *leaderboarddomain.PromotionPolicy,
+) (results.OperationResult[PromotionPreview, error], error) {
+	return withTelemetry(s, ctx, "PreviewPromotions", guildID, func(ctx context.Context) (results.OperationResult[PromotionPreview, error], error) {
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (results.OperationResult[PromotionPreview, error], error) {
+			if policy == nil {
+				row, err := s.repo.GetPromotionPolicy(ctx, db, string(guildID))
+				if err != nil {
+					return results.OperationResult[PromotionPreview, error]{}, fmt.Errorf("failed to get promotion policy: %w", err)
+				}
+				stored := toPromotionPolicy(row)
+				policy = &stored
+			}
+			if err := policy.Validate(); err != nil {
+				return results.FailureResult[PromotionPreview](err), nil
+			}
+
+			season, err := s.repo.GetActiveSeason(ctx, db, string(guildID))
+			if err != nil {
+				return results.OperationResult[PromotionPreview, error]{}, fmt.Errorf("failed to get active season: %w", err)
+			}
+			if season == nil {
+				return results.FailureResult[PromotionPreview](ErrNoActiveSeason), nil
+			}
+
+			preview := *policy
+			preview.Enabled = true
+			moves, err := s.planPromotions(ctx, db, string(guildID), preview, season.ID)
+			if err != nil {
+				return results.OperationResult[PromotionPreview, error]{}, err
+			}
+			return results.SuccessResult[PromotionPreview, error](PromotionPreview{
+				Policy:   *policy,
+				SeasonID: season.ID,
+				Moves:    moves,
+			}), nil
+		})
+	})
+}
+
+// GetSeasonPromotions returns the division moves made when a season ended. An empty
+// seasonID selects the most recently ended season.
+func (s *LeaderboardService) GetSeasonPromotions(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	seasonID string,
+) (results.OperationResult[SeasonPromotions, error], error) {
+	return withTelemetry(s, ctx, "GetSeasonPromotions", guildID, func(ctx context.Context) (results.OperationResult[SeasonPromotions, error], error) {
+		season, err := s.findArchivedSeason(ctx, string(guildID), seasonID)
+		if err != nil {
+			return results.OperationResult[SeasonPromotions, error]{}, err
+		}
+		if season == nil {
+			return results.FailureResult[SeasonPromotions](ErrSeasonArchiveNotFound), nil
+		}
+
+		rows, err := s.repo.ListSeasonPromotions(ctx, nil, string(guildID), season.ID)
+		if err != nil {
+			return results.OperationResult[SeasonPromotions, error]{}, fmt.Errorf("failed to list season promotions: %w", err)
+		}
+		moves := make([]leaderboarddomain.PromotionMove, len(rows))
+		for i, row := range rows {
+			moves[i] = leaderboarddomain.PromotionMove{
+				MemberID:       string(row.MemberID),
+				FromDivisionID: row.FromDivisionID,
+				ToDivisionID:   row.ToDivisionID,
+				Promoted:       row.Promoted,
+				Tag:            row.Tag,
+				SeedTag:        row.SeedTag,
+			}
+		}
+		return results.SuccessResult[SeasonPromotions, error](SeasonPromotions{SeasonID: season.ID, Moves: moves}), nil
+	})
+}
+
+// planPromotions ranks every member by their standing in a season and plans the moves
+// the policy makes. Members with standings but no league row are in the default division.
+func (s *LeaderboardService) planPromotions(
+	ctx context.Context,
+	db bun.IDB,
+	guildID string,
+	policy leaderboarddomain.PromotionPolicy,
+	seasonID string,
+) ([]leaderboarddomain.PromotionMove, error) {
+	members, err := s.memberRepo.GetMembersByGuild(ctx, db, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("load members: %w", err)
+	}
+	standings, err := s.repo.GetSeasonStandingsBySeasonID(ctx, db, guildID, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("load season standings: %w", err)
+	}
+
+	candidates := make([]leaderboarddomain.PromotionCandidate, 0, len(members))
+	index := make(map[string]int, len(members))
+	for _, m := range members {
+		c := leaderboarddomain.PromotionCandidate{MemberID: m.MemberID, DivisionID: m.DivisionID}
+		if m.CurrentTag != nil {
+			c.Tag = *m.CurrentTag
+		}
+		index[m.MemberID] = len(candidates)
+		candidates = append(candidates, c)
+	}
+	for _, st := range standings {
+		i, ok := index[string(st.MemberID)]
+		if !ok {
+			i = len(candidates)
+			candidates = append(candidates, leaderboarddomain.PromotionCandidate{MemberID: string(st.MemberID)})
+		}
+		candidates[i].Standing = leaderboarddomain.FinalStanding{
+			MemberID:      string(st.MemberID),
+			TotalPoints:   st.TotalPoints,
+			CurrentTier:   st.CurrentTier,
+			SeasonBestTag: st.SeasonBestTag,
+			RoundsPlayed:  st.RoundsPlayed,
+		}
+	}
+	return leaderboarddomain.PlanPromotions(policy, candidates), nil
+}
+
+// applyPromotionsInTx moves members between divisions by the guild's promotion policy
+// and records the moves against the ending season. It must run inside the end-season
+// transaction, before the season is closed. Tags are seeded afterwards by
+// seedSeasonPromotions.
+func (s *LeaderboardService) applyPromotionsInTx(ctx context.Context, db bun.IDB, guildID, seasonID string) error {
+	row, err := s.repo.GetPromotionPolicy(ctx, db, guildID)
+	if err != nil {
+		return fmt.Errorf("load promotion policy: %w", err)
+	}
+	policy := toPromotionPolicy(row)
+	if !policy.Enabled {
+		return nil
+	}
+
+	moves, err := s.planPromotions(ctx, db, guildID, policy, seasonID)
+	if err != nil {
+		return err
+	}
+	if len(moves) == 0 {
+		return nil
+	}
+
+	records := make([]leaderboarddb.SeasonPromotion, len(moves))
+	for i, move := range moves {
+		if err := s.moveMemberDivisionInTx(ctx, db, guildID, move.MemberID, move.Tag, move.FromDivisionID, move.ToDivisionID); err != nil {
+			return fmt.Errorf("move member %s: %w", move.MemberID, err)
+		}
+		records[i] = leaderboarddb.SeasonPromotion{
+			GuildID:        guildID,
+			SeasonID:       seasonID,
+			MemberID:       sharedtypes.DiscordID(move.MemberID),
+			Position:       i,
+			FromDivisionID: move.FromDivisionID,
+			ToDivisionID:   move.ToDivisionID,
+			Promoted:       move.Promoted,
+			Tag:            move.Tag,
+			SeedTag:        move.SeedTag,
+		}
+	}
+	if err := s.repo.InsertSeasonPromotions(ctx, db, records); err != nil {
+		return fmt.Errorf("record season promotions: %w", err)
+	}
+	return nil
+}
+
+// seedSeasonPromotions gives members moved at the end of a season their starting tags
+// in their new division. It runs after the end-season transaction commits, through
+// ExecuteBatchTagAssignment, so the tags are recorded in tag history. An empty
+// seasonID selects the most recently ended season.
+func (s *LeaderboardService) seedSeasonPromotions(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) error {
+	result, err := s.GetSeasonPromotions(ctx, guildID, seasonID)
+	if err != nil {
+		return err
+	}
+	if result.IsFailure() {
+		return *result.Failure
+	}
+
+	requests := PromotionSeedRequests(result.Success.Moves)
+	if len(requests) == 0 {
+		return nil
+	}
+	seeded, err := s.ExecuteBatchTagAssignment(ctx, guildID, requests, sharedtypes.RoundID(uuid.Nil), ServiceUpdateSourceSeasonTransition)
+	if err != nil {
+		return err
+	}
+	if seeded.IsFailure() {
+		return *seeded.Failure
+	}
+	return nil
+}
+
+// logSeedFailure reports tags that could not be seeded after a season ended. The
+// season and the moves stand; admins can assign the tags by hand.
+func (s *LeaderboardService) logSeedFailure(ctx context.Context, guildID sharedtypes.GuildID, seasonID string, err error) {
+	s.logger.ErrorContext(ctx, "failed to seed tags for promoted and relegated members",
+		attr.String("guild_id", string(guildID)),
+		attr.String("season_id", seasonID),
+		attr.Error(err),
+	)
+}
+
+// PromotionSeedRequests returns the tag assignments that seed moved members in their
+// new divisions. Members without a seed tag are left untagged and have no request.
+func PromotionSeedRequests(moves []leaderboarddomain.PromotionMove) []sharedtypes.TagAssignmentRequest {
+	var requests []sharedtypes.TagAssignmentRequest
+	for _, move := range moves {
+		if move.SeedTag > 0 {
+			requests = append(requests, sharedtypes.TagAssignmentRequest{
+				UserID:    sharedtypes.DiscordID(move.MemberID),
+				TagNumber: sharedtypes.TagNumber(move.SeedTag),
+			})
+		}
+	}
+	return requests
+}
+
+func toPromotionPolicy(row *leaderboarddb.PromotionPolicy) leaderboarddomain.PromotionPolicy {
+	if row == nil {
+		return leaderboarddomain.PromotionPolicy{}
+	}
+	return leaderboarddomain.PromotionPolicy{
+		Enabled:    row.Enabled,
+		Boundaries: row.Boundaries,
+	}
+}
@@ -0,0 +1,184 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+func TestEndSeasonInTx_AppliesPromotionPolicy(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	season := &leaderboarddb.Season{GuildID: "guild-1", ID: "2026-spring", IsActive: true, StartDate: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}
+	repo.GetActiveSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error) {
+		return season, nil
+	}
+	repo.GetPromotionPolicyFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.PromotionPolicy, error) {
+		return &leaderboarddb.PromotionPolicy{
+			GuildID:    guildID,
+			Enabled:    true,
+			Boundaries: []leaderboarddomain.PromotionBoundary{{UpperDivisionID: "pro", LowerDivisionID: "", Count: 1}},
+		}, nil
+	}
+	repo.GetSeasonStandingsBySeasonIDFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string) ([]leaderboarddb.SeasonStanding, error) {
+		return []leaderboarddb.SeasonStanding{
+			{MemberID: "pro-top", SeasonID: seasonID, TotalPoints: 90, RoundsPlayed: 5},
+			{MemberID: "pro-low", SeasonID: seasonID, TotalPoints: 10, RoundsPlayed: 5},
+			{MemberID: "am-top", SeasonID: seasonID, TotalPoints: 70, RoundsPlayed: 5},
+		}, nil
+	}
+	var recorded []leaderboarddb.SeasonPromotion
+	repo.InsertSeasonPromotionsFunc = func(ctx context.Context, db bun.IDB, promotions []leaderboarddb.SeasonPromotion) error {
+		recorded = promotions
+		return nil
+	}
+	var closed bool
+	repo.CloseSeasonFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string, endedAt time.Time) error {
+		if recorded == nil {
+			t.Fatal("season closed before promotions were recorded")
+		}
+		closed = true
+		return nil
+	}
+
+	moved := map[string]string{}
+	members := &fakeLeagueMemberRepo{
+		getMembersByGuildFunc: func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.LeagueMember, error) {
+			one, two, amTag := 1, 2, 1
+			return []leaderboarddb.LeagueMember{
+				{GuildID: guildID, MemberID: "pro-top", DivisionID: "pro", CurrentTag: &one},
+				{GuildID: guildID, MemberID: "pro-low", DivisionID: "pro", CurrentTag: &two},
+				{GuildID: guildID, MemberID: "am-top", CurrentTag: &amTag},
+			}, nil
+		},
+		setMemberDivisionFunc: func(ctx context.Context, db bun.IDB, guildID, memberID, divisionID string) error {
+			moved[memberID] = divisionID
+			return nil
+		},
+	}
+	tags := &fakeTagHistoryRepo{}
+	svc := newWriteFlowTestService(repo, members, tags, &fakeRoundOutcomeRepo{})
+
+	if err := svc.endSeasonInTx(context.Background(), nil, "guild-1"); err != nil {
+		t.Fatalf("endSeasonInTx returned error: %v", err)
+	}
+	if !closed {
+		t.Fatal("expected season to be closed")
+	}
+
+	if len(moved) != 2 || moved["am-top"] != "pro" || moved["pro-low"] != "" {
+		t.Fatalf("unexpected division moves %v", moved)
+	}
+	if tags.bulkInsertCalls != 2 {
+		t.Fatalf("expected both released tags recorded, got %d calls", tags.bulkInsertCalls)
+	}
+
+	want := []leaderboarddb.SeasonPromotion{
+		{GuildID: "guild-1", SeasonID: "2026-spring", MemberID: "am-top", Position: 0, FromDivisionID: "", ToDivisionID: "pro", Promoted: true, Tag: 1, SeedTag: 2},
+		{GuildID: "guild-1", SeasonID: "2026-spring", MemberID: "pro-low", Position: 1, FromDivisionID: "pro", ToDivisionID: "", Tag: 2, SeedTag: 1},
+	}
+	if !slices.Equal(recorded, want) {
+		t.Fatalf("recorded = %+v, want %+v", recorded, want)
+	}
+}
+
+func TestEndSeasonInTx_NoPromotionPolicy(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	repo.GetActiveSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error) {
+		return &leaderboarddb.Season{GuildID: guildID, ID: "s1", IsActive: true}, nil
+	}
+	members := &fakeLeagueMemberRepo{
+		setMemberDivisionFunc: func(ctx context.Context, db bun.IDB, guildID, memberID, divisionID string) error {
+			t.Fatal("no member should move without a policy")
+			return nil
+		},
+	}
+	svc := newWriteFlowTestService(repo, members, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	if err := svc.endSeasonInTx(context.Background(), nil, "guild-1"); err != nil {
+		t.Fatalf("endSeasonInTx returned error: %v", err)
+	}
+	if slices.Contains(repo.Trace(), "InsertSeasonPromotions") {
+		t.Fatal("no promotions should be recorded")
+	}
+}
+
+func TestSetPromotionPolicy_UnknownDivision(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	result, err := svc.SetPromotionPolicy(context.Background(), "guild-1", leaderboarddomain.PromotionPolicy{
+		Enabled:    true,
+		Boundaries: []leaderboarddomain.PromotionBoundary{{UpperDivisionID: "pro", LowerDivisionID: "", Count: 2}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.IsFailure() || !errors.Is(*result.Failure, ErrDivisionNotFound) {
+		t.Fatalf("expected ErrDivisionNotFound, got %+v", result)
+	}
+	if slices.Contains(repo.Trace(), "UpsertPromotionPolicy") {
+		t.Fatal("policy should not be saved")
+	}
+}
+
+func TestPreviewPromotions_PreviewsDisabledStoredPolicy(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	repo.GetPromotionPolicyFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.PromotionPolicy, error) {
+		return &leaderboarddb.PromotionPolicy{
+			GuildID:    guildID,
+			Boundaries: []leaderboarddomain.PromotionBoundary{{UpperDivisionID: "pro", LowerDivisionID: "", Count: 1}},
+		}, nil
+	}
+	repo.GetActiveSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error) {
+		return &leaderboarddb.Season{GuildID: guildID, ID: "s1", IsActive: true}, nil
+	}
+	repo.GetSeasonStandingsBySeasonIDFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string) ([]leaderboarddb.SeasonStanding, error) {
+		return []leaderboarddb.SeasonStanding{{MemberID: "am", SeasonID: seasonID, TotalPoints: 5, RoundsPlayed: 1}}, nil
+	}
+	members := &fakeLeagueMemberRepo{
+		getMembersByGuildFunc: func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.LeagueMember, error) {
+			return []leaderboarddb.LeagueMember{{GuildID: guildID, MemberID: "pro", DivisionID: "pro"}, {GuildID: guildID, MemberID: "am"}}, nil
+		},
+	}
+	svc := newWriteFlowTestService(repo, members, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	result, err := svc.PreviewPromotions(context.Background(), "guild-1", nil)
+	if err != nil || !result.IsSuccess() {
+		t.Fatalf("expected success, got %+v, %v", result, err)
+	}
+	preview := *result.Success
+	if preview.Policy.Enabled || preview.SeasonID != "s1" {
+		t.Fatalf("unexpected preview %+v", preview)
+	}
+	if len(preview.Moves) != 2 || preview.Moves[0].MemberID != "am" || preview.Moves[1].MemberID != "pro" {
+		t.Fatalf("unexpected moves %+v", preview.Moves)
+	}
+}
+
+func TestPreviewPromotions_NoActiveSeason(t *testing.T) {
+	svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	result, err := svc.PreviewPromotions(context.Background(), "guild-1", &leaderboarddomain.PromotionPolicy{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.IsFailure() || !errors.Is(*result.Failure, ErrNoActiveSeason) {
+		t.Fatalf("expected ErrNoActiveSeason, got %+v", result)
+	}
+}
+
+func TestPromotionSeedRequests_SkipsUnseededMembers(t *testing.T) {
+	requests := PromotionSeedRequests([]leaderboarddomain.PromotionMove{
+		{MemberID: "a", SeedTag: 3},
+		{MemberID: "b"},
+	})
+	if len(requests) != 1 || requests[0].UserID != "a" || requests[0].TagNumber != 3 {
+		t.Fatalf("unexpected requests %+v", requests)
+	}
+}
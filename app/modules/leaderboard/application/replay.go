@@ -0,0 +1,442 @@
+package leaderboardservice
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ReplayResult reports how a guild replay changed (or, in dry-run mode, would change)
+// tag ownership and season standings.
+type ReplayResult struct {
+	GuildID string
+	DryRun  bool
+
+	// RoundsReplayed counts rounds re-run from their stored inputs.
+	RoundsReplayed int
+	// RoundsVerbatim counts rounds processed before inputs were stored; their recorded
+	// tag changes and points are carried over unchanged.
+	RoundsVerbatim int
+
+	TagDiffs      []ReplayTagDiff
+	StandingDiffs []ReplayStandingDiff
+}
+
+// ReplayTagDiff is a member whose tag differs between current and replayed state.
+// A tag of 0 means no tag.
+type ReplayTagDiff struct {
+	MemberID    string
+	CurrentTag  int
+	ReplayedTag int
+}
+
+// ReplayStandingDiff is a season standing that differs between current and replayed state.
+type ReplayStandingDiff struct {
+	SeasonID       string
+	MemberID       string
+	CurrentPoints  int
+	ReplayedPoints int
+	CurrentRounds  int
+	ReplayedRounds int
+}
+
+// HasChanges reports whether the replay differs from current state.
+func (r ReplayResult) HasChanges() bool {
+	return len(r.TagDiffs) > 0 || len(r.StandingDiffs) > 0
+}
+
+// Replay event kinds, in the order they apply when timestamps tie: a legacy round's
+// tag changes land before its points so season-best tags see the new tags.
+const (
+	replayKindTags = iota
+	replayKindRound
+	replayKindPoints
+)
+
+type replayEvent struct {
+	at    time.Time
+	kind  int
+	seq   int64
+	apply func(*leaderboarddomain.ReplayState)
+}
+
+// ReplayGuild rebuilds tag ownership, point history, season standings and round tag
+// history for a guild by re-running every processed round in the order it was first
+// processed. Rounds with stored inputs are reallocated and rescored; everything else
+// (claims, admin fixes, resets, manual adjustments, legacy rounds) is applied as recorded.
+// With dryRun set nothing is written and the result only describes the differences.
+// Tags are rebuilt within each division: rounds reallocate among the divisions their
+// participants played in, and rounds stored before divisions were recorded replay in
+// the default division.
+func (s *LeaderboardService) ReplayGuild(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	dryRun bool,
+) (results.OperationResult[ReplayResult, error], error) {
+	replayTx := func(ctx context.Context, db bun.IDB) (results.OperationResult[ReplayResult, error], error) {
+		if guildID == "" {
+			return results.FailureResult[ReplayResult](ErrInvalidGuildID), nil
+		}
+		result, err := s.replayGuildInTx(ctx, db, string(guildID), dryRun)
+		if err != nil {
+			return results.OperationResult[ReplayResult, error]{}, err
+		}
+		return results.SuccessResult[ReplayResult, error](*result), nil
+	}
+
+	return withTelemetry(s, ctx, "ReplayGuild", guildID, func(ctx context.Context) (results.OperationResult[ReplayResult, error], error) {
+		return runInTx(s, ctx, replayTx)
+	})
+}
+
+// tagLedger applies recorded tag history entries to a replay state in ledger order.
+// Entries written in one transaction share a timestamp, which is what groups a reset's
+// clear with its reassignments. Each entry places its member in the entry's division.
+type tagLedger struct {
+	lastReset time.Time
+}
+
+func (l *tagLedger) apply(state *leaderboarddomain.ReplayState, entry leaderboarddb.TagHistoryEntry) {
+	if entry.Reason == "reset" && !entry.CreatedAt.Equal(l.lastReset) {
+		l.lastReset = entry.CreatedAt
+		state.ClearTags()
+	}
+	if entry.NewMemberID == "" {
+		if entry.OldMemberID != nil {
+			state.SetDivision(*entry.OldMemberID, entry.DivisionID)
+			state.ReleaseTag(*entry.OldMemberID, entry.TagNumber)
+		}
+		return
+	}
+	state.SetDivision(entry.NewMemberID, entry.DivisionID)
+	state.AssignTag(entry.NewMemberID, entry.TagNumber)
+}
+
+func (s *LeaderboardService) replayGuildInTx(ctx context.Context, db bun.IDB, guildID string, dryRun bool) (*ReplayResult, error) {
+	if err := s.memberRepo.AcquireGuildLock(ctx, db, guildID); err != nil {
+		return nil, fmt.Errorf("acquire guild lock: %w", err)
+	}
+
+	outcomes, err := s.outcomeRepo.ListRoundOutcomes(ctx, db, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("list round outcomes: %w", err)
+	}
+	tagHistory, err := s.tagHistRepo.GetTagHistoryForGuild(ctx, db, guildID, time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("load tag history: %w", err)
+	}
+	pointHistory, err := s.repo.ListPointHistoryForGuild(ctx, db, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("load point history: %w", err)
+	}
+	seasons, err := s.repo.ListSeasons(ctx, db, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("list seasons: %w", err)
+	}
+	// Rounds are replayed under the tag pool model they were processed with; rounds
+	// processed before the model was stored fall back to the guild's current one.
+	tagPolicy, err := s.loadTagPoolPolicy(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make(map[string]leaderboarddomain.PointsPolicy, len(seasons))
+	for i := range seasons {
+		policies[seasons[i].ID] = seasonPolicy(&seasons[i])
+	}
+	policyFor := func(seasonID string) leaderboarddomain.PointsPolicy {
+		if p, ok := policies[seasonID]; ok {
+			return p
+		}
+		return leaderboarddomain.DefaultPointsPolicy()
+	}
+
+	result := &ReplayResult{GuildID: guildID, DryRun: dryRun}
+	replayed := make(map[uuid.UUID]struct{}, len(outcomes))
+	var replayedRoundIDs []uuid.UUID
+	var events []replayEvent
+
+	// Regenerated ledger rows for replayed rounds, written only when committing.
+	var newTagHistory []leaderboarddb.TagHistoryEntry
+	var newPointHistory []*leaderboarddb.PointHistory
+
+	for _, outcome := range outcomes {
+		if len(outcome.Participants) == 0 {
+			result.RoundsVerbatim++
+			continue
+		}
+		result.RoundsReplayed++
+		replayed[outcome.RoundID] = struct{}{}
+		replayedRoundIDs = append(replayedRoundIDs, outcome.RoundID)
+
+		roundID := outcome.RoundID
+		at := outcome.CreatedAt
+		seasonID := ""
+		if outcome.SeasonID != nil {
+			seasonID = *outcome.SeasonID
+		}
+		inputs := outcome.Participants
+		pool := tagPolicy
+		if outcome.TagPool != nil {
+			pool = *outcome.TagPool
+		}
+		allocate := pool.Allocator()
+		events = append(events, replayEvent{at: at, kind: replayKindRound, apply: func(state *leaderboarddomain.ReplayState) {
+			state.SetTagAllocator(allocate)
+			changes, awards := state.PlayRound(seasonID, policyFor(seasonID), inputs)
+			for _, ch := range changes {
+				var oldMember *string
+				if ch.OldMemberID != "" {
+					oldMember = &ch.OldMemberID
+				}
+				newTagHistory = append(newTagHistory, leaderboarddb.TagHistoryEntry{
+					GuildID:     guildID,
+					RoundID:     &roundID,
+					TagNumber:   ch.TagNumber,
+					DivisionID:  state.Division(ch.NewMemberID),
+					OldMemberID: oldMember,
+					NewMemberID: ch.NewMemberID,
+					Reason:      "round_swap",
+					Metadata:    "{}",
+					CreatedAt:   at,
+				})
+			}
+			for _, award := range awards {
+				newPointHistory = append(newPointHistory, &leaderboarddb.PointHistory{
+					SeasonID:   seasonID,
+					MemberID:   sharedtypes.DiscordID(award.MemberID),
+					RoundID:    sharedtypes.RoundID(roundID),
+					Points:     award.Points,
+					Reason:     "Round Matchups",
+					Tier:       string(award.Tier),
+					Opponents:  award.OpponentsBeaten,
+					GiantSlays: award.GiantSlays,
+					CreatedAt:  at,
+				})
+			}
+		}})
+	}
+
+	// A replayed round's own swaps are regenerated above; every other ledger entry is
+	// applied as recorded.
+	var ledger tagLedger
+	for _, entry := range tagHistory {
+		if entry.RoundID != nil && entry.Reason == "round_swap" {
+			if _, ok := replayed[*entry.RoundID]; ok {
+				continue
+			}
+		}
+		events = append(events, replayEvent{at: entry.CreatedAt, kind: replayKindTags, seq: entry.ID, apply: func(state *leaderboarddomain.ReplayState) {
+			ledger.apply(state, entry)
+		}})
+	}
+
+	for _, ph := range pointHistory {
+		if _, ok := replayed[uuid.UUID(ph.RoundID)]; ok {
+			continue
+		}
+		events = append(events, replayEvent{at: ph.CreatedAt, kind: replayKindPoints, seq: ph.ID, apply: func(state *leaderboarddomain.ReplayState) {
+			if uuid.UUID(ph.RoundID) == uuid.Nil {
+				state.AdjustPoints(ph.SeasonID, string(ph.MemberID), ph.Points)
+				return
+			}
+			state.AddRoundPoints(ph.SeasonID, string(ph.MemberID), ph.Points, ph.Tier)
+		}})
+	}
+
+	slices.SortStableFunc(events, func(a, b replayEvent) int {
+		if c := a.at.Compare(b.at); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.kind, b.kind); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.seq, b.seq)
+	})
+
+	state := leaderboarddomain.NewReplayState()
+	for _, ev := range events {
+		ev.apply(state)
+	}
+
+	// Diff tags against current ownership.
+	members, err := s.memberRepo.GetMembersByGuild(ctx, db, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("load current tags: %w", err)
+	}
+	currentTags := make(map[string]int, len(members))
+	for _, m := range members {
+		if m.CurrentTag != nil && *m.CurrentTag > 0 {
+			currentTags[m.MemberID] = *m.CurrentTag
+		}
+	}
+	replayedTags := state.Tags()
+	for memberID, tag := range currentTags {
+		if replayedTags[memberID] != tag {
+			result.TagDiffs = append(result.TagDiffs, ReplayTagDiff{MemberID: memberID, CurrentTag: tag, ReplayedTag: replayedTags[memberID]})
+		}
+	}
+	for memberID, tag := range replayedTags {
+		if _, ok := currentTags[memberID]; !ok {
+			result.TagDiffs = append(result.TagDiffs, ReplayTagDiff{MemberID: memberID, ReplayedTag: tag})
+		}
+	}
+	slices.SortFunc(result.TagDiffs, func(a, b ReplayTagDiff) int {
+		return cmp.Compare(a.MemberID, b.MemberID)
+	})
+
+	// Diff standings across every season the guild has data for.
+	replayedStandings := state.Standings()
+	seasonIDs := make([]string, 0, len(seasons))
+	for _, season := range seasons {
+		seasonIDs = append(seasonIDs, season.ID)
+	}
+	for _, st := range replayedStandings {
+		seasonIDs = append(seasonIDs, st.SeasonID)
+	}
+	slices.Sort(seasonIDs)
+	seasonIDs = slices.Compact(seasonIDs)
+
+	type standingKey struct{ seasonID, memberID string }
+	currentStandings := make(map[standingKey]leaderboarddb.SeasonStanding)
+	for _, seasonID := range seasonIDs {
+		standings, err := s.repo.GetSeasonStandingsBySeasonID(ctx, db, guildID, seasonID)
+		if err != nil {
+			return nil, fmt.Errorf("load standings for season %s: %w", seasonID, err)
+		}
+		for _, st := range standings {
+			currentStandings[standingKey{st.SeasonID, string(st.MemberID)}] = st
+		}
+	}
+	seen := make(map[standingKey]struct{}, len(replayedStandings))
+	for _, st := range replayedStandings {
+		key := standingKey{st.SeasonID, st.MemberID}
+		seen[key] = struct{}{}
+		cur := currentStandings[key]
+		if cur.TotalPoints != st.TotalPoints || cur.RoundsPlayed != st.RoundsPlayed {
+			result.StandingDiffs = append(result.StandingDiffs, ReplayStandingDiff{
+				SeasonID:       st.SeasonID,
+				MemberID:       st.MemberID,
+				CurrentPoints:  cur.TotalPoints,
+				ReplayedPoints: st.TotalPoints,
+				CurrentRounds:  cur.RoundsPlayed,
+				ReplayedRounds: st.RoundsPlayed,
+			})
+		}
+	}
+	for key, cur := range currentStandings {
+		if _, ok := seen[key]; !ok {
+			result.StandingDiffs = append(result.StandingDiffs, ReplayStandingDiff{
+				SeasonID:      key.seasonID,
+				MemberID:      key.memberID,
+				CurrentPoints: cur.TotalPoints,
+				CurrentRounds: cur.RoundsPlayed,
+			})
+		}
+	}
+	slices.SortFunc(result.StandingDiffs, func(a, b ReplayStandingDiff) int {
+		if c := cmp.Compare(a.SeasonID, b.SeasonID); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.MemberID, b.MemberID)
+	})
+
+	s.logger.InfoContext(ctx, "Guild replay computed",
+		slog.String("guild_id", guildID),
+		slog.Bool("dry_run", dryRun),
+		slog.Int("rounds_replayed", result.RoundsReplayed),
+		slog.Int("rounds_verbatim", result.RoundsVerbatim),
+		slog.Int("tag_diffs", len(result.TagDiffs)),
+		slog.Int("standing_diffs", len(result.StandingDiffs)),
+	)
+
+	if dryRun {
+		return result, nil
+	}
+
+	if err := s.commitReplay(ctx, db, guildID, result, replayedRoundIDs, newTagHistory, newPointHistory, replayedStandings); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// commitReplay swaps the replayed rounds' ledger rows and the guild's standings for the
+// replayed ones, then moves only the tags that differ.
+func (s *LeaderboardService) commitReplay(
+	ctx context.Context,
+	db bun.IDB,
+	guildID string,
+	result *ReplayResult,
+	roundIDs []uuid.UUID,
+	tagHistory []leaderboarddb.TagHistoryEntry,
+	pointHistory []*leaderboarddb.PointHistory,
+	standings []leaderboarddomain.ReplayStanding,
+) error {
+	if err := s.tagHistRepo.DeleteTagHistoryForRounds(ctx, db, guildID, roundIDs, "round_swap"); err != nil {
+		return fmt.Errorf("delete replayed tag history: %w", err)
+	}
+	if err := s.tagHistRepo.BulkInsertTagHistory(ctx, db, tagHistory); err != nil {
+		return fmt.Errorf("write replayed tag history: %w", err)
+	}
+
+	for _, roundID := range roundIDs {
+		if err := s.repo.DeletePointHistoryForRound(ctx, db, guildID, sharedtypes.RoundID(roundID)); err != nil {
+			return fmt.Errorf("delete replayed point history: %w", err)
+		}
+	}
+	if err := s.repo.BulkSavePointHistory(ctx, db, guildID, pointHistory); err != nil {
+		return fmt.Errorf("write replayed point history: %w", err)
+	}
+
+	if err := s.repo.DeleteSeasonStandingsForGuild(ctx, db, guildID); err != nil {
+		return fmt.Errorf("delete season standings: %w", err)
+	}
+	now := time.Now().UTC()
+	rows := make([]*leaderboarddb.SeasonStanding, len(standings))
+	for i, st := range standings {
+		tier := st.CurrentTier
+		if tier == "" {
+			tier = string(leaderboarddomain.TierBronze)
+		}
+		rows[i] = &leaderboarddb.SeasonStanding{
+			SeasonID:      st.SeasonID,
+			MemberID:      sharedtypes.DiscordID(st.MemberID),
+			TotalPoints:   st.TotalPoints,
+			CurrentTier:   tier,
+			SeasonBestTag: st.SeasonBestTag,
+			RoundsPlayed:  st.RoundsPlayed,
+			UpdatedAt:     now,
+		}
+	}
+	if err := s.repo.BulkUpsertSeasonStandings(ctx, db, guildID, rows); err != nil {
+		return fmt.Errorf("write season standings: %w", err)
+	}
+
+	// Clear every member whose tag moves before assigning, so swaps never collide on
+	// the per-division tag uniqueness constraint.
+	var cleared, assigned []leaderboarddb.LeagueMember
+	for _, diff := range result.TagDiffs {
+		cleared = append(cleared, leaderboarddb.LeagueMember{GuildID: guildID, MemberID: diff.MemberID})
+		if diff.ReplayedTag > 0 {
+			tag := diff.ReplayedTag
+			assigned = append(assigned, leaderboarddb.LeagueMember{GuildID: guildID, MemberID: diff.MemberID, CurrentTag: &tag})
+		}
+	}
+	if err := s.memberRepo.BulkUpsertMembers(ctx, db, cleared); err != nil {
+		return fmt.Errorf("clear replayed tags: %w", err)
+	}
+	if err := s.memberRepo.BulkUpsertMembers(ctx, db, assigned); err != nil {
+		return fmt.Errorf("assign replayed tags: %w", err)
+	}
+	return nil
+}
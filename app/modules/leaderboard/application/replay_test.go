@@ -0,0 +1,281 @@
+package leaderboardservice
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// newReplayFixture models a guild where round 1 was corrected after round 2 ran:
+// tags were claimed as alice=1, bob=2, carol=3; round 1 is now stored as alice beating
+// bob, and round 2 as carol beating alice. Live state still reflects the original round 1
+// (bob beat alice), so bob=1, carol=2, alice=3, while replay yields carol=1, bob=2, alice=3.
+func newReplayFixture(t *testing.T) (*LeaderboardService, *FakeLeaderboardRepo, *fakeLeagueMemberRepo, *fakeTagHistoryRepo, [2]uuid.UUID) {
+	t.Helper()
+
+	repo := NewFakeLeaderboardRepo()
+	members := &fakeLeagueMemberRepo{}
+	tags := &fakeTagHistoryRepo{}
+	outcomes := &fakeRoundOutcomeRepo{}
+	svc := newWriteFlowTestService(repo, members, tags, outcomes)
+
+	t0 := time.Date(2026, 4, 1, 18, 0, 0, 0, time.UTC)
+	round1, round2 := uuid.New(), uuid.New()
+	season := "2026-spring"
+
+	tags.guildHistory = []leaderboarddb.TagHistoryEntry{
+		{ID: 1, TagNumber: 1, NewMemberID: "alice", Reason: "claim", CreatedAt: t0},
+		{ID: 2, TagNumber: 2, NewMemberID: "bob", Reason: "claim", CreatedAt: t0},
+		{ID: 3, TagNumber: 3, NewMemberID: "carol", Reason: "claim", CreatedAt: t0},
+		// Stale swaps from the original round 1, superseded by the replay.
+		{ID: 4, RoundID: &round1, TagNumber: 1, NewMemberID: "bob", Reason: "round_swap", CreatedAt: t0.Add(time.Hour)},
+		{ID: 5, RoundID: &round1, TagNumber: 2, NewMemberID: "alice", Reason: "round_swap", CreatedAt: t0.Add(time.Hour)},
+	}
+	outcomes.listOutcomesFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.RoundOutcome, error) {
+		return []leaderboarddb.RoundOutcome{
+			{GuildID: guildID, RoundID: round1, SeasonID: &season, CreatedAt: t0.Add(time.Hour), Participants: []leaderboarddomain.RoundInput{
+				{MemberID: "alice", FinishRank: 1},
+				{MemberID: "bob", FinishRank: 2},
+			}},
+			{GuildID: guildID, RoundID: round2, SeasonID: &season, CreatedAt: t0.Add(2 * time.Hour), Participants: []leaderboarddomain.RoundInput{
+				{MemberID: "carol", FinishRank: 1},
+				{MemberID: "alice", FinishRank: 2},
+			}},
+		}, nil
+	}
+	repo.ListPointHistoryForGuildFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.PointHistory, error) {
+		return []leaderboarddb.PointHistory{
+			{ID: 1, SeasonID: season, MemberID: "bob", RoundID: sharedtypes.RoundID(round1), Points: 100, CreatedAt: t0.Add(time.Hour)},
+			{ID: 9, SeasonID: season, MemberID: "alice", RoundID: sharedtypes.RoundID(uuid.Nil), Points: 50, Reason: "scorecard error", CreatedAt: t0.Add(3 * time.Hour)},
+		}, nil
+	}
+	members.getMembersByGuildFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.LeagueMember, error) {
+		bob, carol, alice := 1, 2, 3
+		return []leaderboarddb.LeagueMember{
+			{GuildID: guildID, MemberID: "bob", CurrentTag: &bob},
+			{GuildID: guildID, MemberID: "carol", CurrentTag: &carol},
+			{GuildID: guildID, MemberID: "alice", CurrentTag: &alice},
+		}, nil
+	}
+
+	return svc, repo, members, tags, [2]uuid.UUID{round1, round2}
+}
+
+func TestReplayGuild_DryRunReportsDiffWithoutWriting(t *testing.T) {
+	svc, repo, members, tags, _ := newReplayFixture(t)
+
+	res, err := svc.ReplayGuild(context.Background(), "guild-1", true)
+	if err != nil {
+		t.Fatalf("ReplayGuild returned error: %v", err)
+	}
+	if res.Success == nil {
+		t.Fatalf("expected success, got failure %v", res.Failure)
+	}
+	got := *res.Success
+
+	if !got.DryRun || got.RoundsReplayed != 2 || got.RoundsVerbatim != 0 {
+		t.Fatalf("unexpected summary: %+v", got)
+	}
+	wantTags := []ReplayTagDiff{
+		{MemberID: "bob", CurrentTag: 1, ReplayedTag: 2},
+		{MemberID: "carol", CurrentTag: 2, ReplayedTag: 1},
+	}
+	if !slices.Equal(got.TagDiffs, wantTags) {
+		t.Fatalf("tag diffs = %+v, want %+v", got.TagDiffs, wantTags)
+	}
+
+	var alice *ReplayStandingDiff
+	for i := range got.StandingDiffs {
+		if got.StandingDiffs[i].MemberID == "alice" {
+			alice = &got.StandingDiffs[i]
+		}
+	}
+	if alice == nil || alice.ReplayedRounds != 2 || alice.ReplayedPoints <= 50 {
+		t.Fatalf("expected alice's replayed standing to keep the manual adjustment, got %+v", alice)
+	}
+
+	if members.acquireGuildLockCalls != 1 {
+		t.Fatalf("expected guild lock, got %d calls", members.acquireGuildLockCalls)
+	}
+	if len(members.bulkUpsertCalls) != 0 || tags.bulkInsertCalls != 0 || len(tags.deletedRounds) != 0 {
+		t.Fatal("dry run must not write tags or tag history")
+	}
+	if slices.Contains(repo.Trace(), "DeleteSeasonStandingsForGuild") {
+		t.Fatal("dry run must not touch standings")
+	}
+}
+
+func TestReplayGuild_CommitRewritesReplayedRounds(t *testing.T) {
+	svc, repo, members, tags, rounds := newReplayFixture(t)
+
+	var saved []*leaderboarddb.PointHistory
+	repo.BulkSavePointHistoryFunc = func(ctx context.Context, db bun.IDB, guildID string, histories []*leaderboarddb.PointHistory) error {
+		saved = histories
+		return nil
+	}
+	var standings []*leaderboarddb.SeasonStanding
+	repo.BulkUpsertSeasonStandingsFunc = func(ctx context.Context, db bun.IDB, guildID string, s []*leaderboarddb.SeasonStanding) error {
+		standings = s
+		return nil
+	}
+
+	res, err := svc.ReplayGuild(context.Background(), "guild-1", false)
+	if err != nil || res.Success == nil {
+		t.Fatalf("ReplayGuild failed: %v %v", err, res.Failure)
+	}
+
+	if !slices.Equal(tags.deletedRounds, rounds[:]) {
+		t.Fatalf("expected stale swaps of both rounds deleted, got %v", tags.deletedRounds)
+	}
+	// Round 1 no longer swaps; round 2 moves carol to 1 and alice to 3.
+	if len(tags.lastBulkInserted) != 2 {
+		t.Fatalf("expected 2 regenerated tag history entries, got %+v", tags.lastBulkInserted)
+	}
+	for _, entry := range tags.lastBulkInserted {
+		if entry.RoundID == nil || *entry.RoundID != rounds[1] || entry.Reason != "round_swap" {
+			t.Fatalf("unexpected regenerated entry: %+v", entry)
+		}
+	}
+
+	for _, ph := range saved {
+		if uuid.UUID(ph.RoundID) == uuid.Nil {
+			t.Fatal("manual adjustments must not be rewritten")
+		}
+	}
+	if len(standings) != 3 {
+		t.Fatalf("expected standings for all three members, got %d", len(standings))
+	}
+
+	trace := repo.Trace()
+	if !slices.Contains(trace, "DeleteSeasonStandingsForGuild") || slices.Index(trace, "DeleteSeasonStandingsForGuild") > slices.Index(trace, "BulkUpsertSeasonStandings") {
+		t.Fatalf("expected standings to be cleared before rewrite, trace: %v", trace)
+	}
+
+	if len(members.bulkUpsertCalls) != 2 {
+		t.Fatalf("expected clear then assign, got %d upserts", len(members.bulkUpsertCalls))
+	}
+	for _, m := range members.bulkUpsertCalls[0] {
+		if m.CurrentTag != nil {
+			t.Fatalf("expected first upsert to clear tags, got %+v", m)
+		}
+	}
+	assigned := make(map[string]int)
+	for _, m := range members.bulkUpsertCalls[1] {
+		assigned[m.MemberID] = *m.CurrentTag
+	}
+	if len(assigned) != 2 || assigned["carol"] != 1 || assigned["bob"] != 2 {
+		t.Fatalf("unexpected tag assignment: %v", assigned)
+	}
+}
+
+func TestReplayGuild_UsesStoredTagPoolPolicy(t *testing.T) {
+	svc, _, _, _, _ := newReplayFixture(t)
+
+	// Round 2 ran under an open pool with an untagged winner; the guild has since
+	// gone back to the default closed pool.
+	outcomes := svc.outcomeRepo.(*fakeRoundOutcomeRepo)
+	list := outcomes.listOutcomesFunc
+	outcomes.listOutcomesFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.RoundOutcome, error) {
+		rows, err := list(ctx, db, guildID)
+		if err != nil {
+			return nil, err
+		}
+		rows[1].Participants = []leaderboarddomain.RoundInput{
+			{MemberID: "dave", FinishRank: 1},
+			{MemberID: "carol", FinishRank: 2},
+			{MemberID: "alice", FinishRank: 3},
+		}
+		rows[1].TagPool = &leaderboarddomain.TagPoolPolicy{Model: leaderboarddomain.TagPoolOpen}
+		return rows, nil
+	}
+
+	res, err := svc.ReplayGuild(context.Background(), "guild-1", true)
+	if err != nil || res.Success == nil {
+		t.Fatalf("ReplayGuild failed: %v %v", err, res.Failure)
+	}
+
+	// Open pool: dave claims 1, carol takes 3 and alice is pushed out.
+	wantTags := []ReplayTagDiff{
+		{MemberID: "alice", CurrentTag: 3, ReplayedTag: 0},
+		{MemberID: "bob", CurrentTag: 1, ReplayedTag: 2},
+		{MemberID: "carol", CurrentTag: 2, ReplayedTag: 3},
+		{MemberID: "dave", ReplayedTag: 1},
+	}
+	if !slices.Equal(res.Success.TagDiffs, wantTags) {
+		t.Fatalf("tag diffs = %+v, want %+v", res.Success.TagDiffs, wantTags)
+	}
+}
+
+func TestReplayGuild_ReplaysWithinDivisions(t *testing.T) {
+	svc, _, members, tags, _ := newReplayFixture(t)
+
+	// dave and erin hold tags 1 and 2 in the pro division, and erin beat dave in a
+	// pro round. Live state missed that round.
+	t0 := tags.guildHistory[0].CreatedAt
+	proRound := uuid.New()
+	tags.guildHistory = append(tags.guildHistory,
+		leaderboarddb.TagHistoryEntry{ID: 6, TagNumber: 1, DivisionID: "pro", NewMemberID: "dave", Reason: "claim", CreatedAt: t0},
+		leaderboarddb.TagHistoryEntry{ID: 7, TagNumber: 2, DivisionID: "pro", NewMemberID: "erin", Reason: "claim", CreatedAt: t0},
+	)
+	outcomes := svc.outcomeRepo.(*fakeRoundOutcomeRepo)
+	list := outcomes.listOutcomesFunc
+	outcomes.listOutcomesFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.RoundOutcome, error) {
+		rows, err := list(ctx, db, guildID)
+		if err != nil {
+			return nil, err
+		}
+		return append(rows, leaderboarddb.RoundOutcome{GuildID: guildID, RoundID: proRound, CreatedAt: t0.Add(4 * time.Hour), Participants: []leaderboarddomain.RoundInput{
+			{MemberID: "erin", FinishRank: 1, DivisionID: "pro"},
+			{MemberID: "dave", FinishRank: 2, DivisionID: "pro"},
+		}}), nil
+	}
+	guildMembers := members.getMembersByGuildFunc
+	members.getMembersByGuildFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.LeagueMember, error) {
+		rows, err := guildMembers(ctx, db, guildID)
+		if err != nil {
+			return nil, err
+		}
+		dave, erin := 1, 2
+		return append(rows,
+			leaderboarddb.LeagueMember{GuildID: guildID, MemberID: "dave", CurrentTag: &dave, DivisionID: "pro"},
+			leaderboarddb.LeagueMember{GuildID: guildID, MemberID: "erin", CurrentTag: &erin, DivisionID: "pro"},
+		), nil
+	}
+
+	res, err := svc.ReplayGuild(context.Background(), "guild-1", false)
+	if err != nil || res.Success == nil {
+		t.Fatalf("ReplayGuild failed: %v %v", err, res.Failure)
+	}
+
+	// The pro round swaps tags only between dave and erin; default holders are as before.
+	wantTags := []ReplayTagDiff{
+		{MemberID: "bob", CurrentTag: 1, ReplayedTag: 2},
+		{MemberID: "carol", CurrentTag: 2, ReplayedTag: 1},
+		{MemberID: "dave", CurrentTag: 1, ReplayedTag: 2},
+		{MemberID: "erin", CurrentTag: 2, ReplayedTag: 1},
+	}
+	if !slices.Equal(res.Success.TagDiffs, wantTags) {
+		t.Fatalf("tag diffs = %+v, want %+v", res.Success.TagDiffs, wantTags)
+	}
+
+	proEntries := 0
+	for _, entry := range tags.lastBulkInserted {
+		if entry.RoundID == nil || *entry.RoundID != proRound {
+			continue
+		}
+		if entry.DivisionID != "pro" {
+			t.Fatalf("expected regenerated pro round history in the pro division, got %+v", entry)
+		}
+		proEntries++
+	}
+	if proEntries != 2 {
+		t.Fatalf("expected 2 regenerated pro round entries, got %d", proEntries)
+	}
+}
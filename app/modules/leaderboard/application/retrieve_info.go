@@ -24,30 +24,45 @@ func (s *LeaderboardService) GetLeaderboard(
 ) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
 
 	return withTelemetry(s, ctx, "GetLeaderboard", guildID, func(ctx context.Context) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
-		if s.commandPipeline == nil {
-			return results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error]{}, ErrCommandPipelineUnavailable
-		}
+		return s.leaderboardEntries(ctx, guildID, nil, seasonID)
+	})
+}
 
-		taggedMembers, err := s.commandPipeline.GetTaggedMembers(ctx, string(guildID), nil)
-		if err != nil {
-			return results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error]{}, err
-		}
+// leaderboardEntries lists the guild's tag holders enriched with season data. A
+// non-nil divisionID keeps only that division's holders; with divisions, tag numbers
+// repeat across the guild-wide list.
+func (s *LeaderboardService) leaderboardEntries(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	divisionID *string,
+	seasonID string,
+) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
+	if s.commandPipeline == nil {
+		return results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error]{}, ErrCommandPipelineUnavailable
+	}
 
-		entries := make([]leaderboardtypes.LeaderboardEntry, len(taggedMembers))
-		for i, member := range taggedMembers {
-			entries[i] = leaderboardtypes.LeaderboardEntry{
-				UserID:    sharedtypes.DiscordID(member.MemberID),
-				TagNumber: sharedtypes.TagNumber(member.Tag),
-			}
-		}
+	taggedMembers, err := s.commandPipeline.GetTaggedMembers(ctx, string(guildID), nil)
+	if err != nil {
+		return results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error]{}, err
+	}
 
-		// Enrich from seasonal standings where available.
-		if err := s.enrichWithSeasonData(ctx, s.db, guildID, seasonID, entries); err != nil {
-			// Already logged in helper, continue with unenriched data
+	entries := make([]leaderboardtypes.LeaderboardEntry, 0, len(taggedMembers))
+	for _, member := range taggedMembers {
+		if divisionID != nil && member.DivisionID != *divisionID {
+			continue
 		}
+		entries = append(entries, leaderboardtypes.LeaderboardEntry{
+			UserID:    sharedtypes.DiscordID(member.MemberID),
+			TagNumber: sharedtypes.TagNumber(member.Tag),
+		})
+	}
 
-		return results.SuccessResult[[]leaderboardtypes.LeaderboardEntry, error](entries), nil
-	})
+	// Enrich from seasonal standings where available.
+	if err := s.enrichWithSeasonData(ctx, s.db, guildID, seasonID, entries); err != nil {
+		// Already logged in helper, continue with unenriched data
+	}
+
+	return results.SuccessResult[[]leaderboardtypes.LeaderboardEntry, error](entries), nil
 }
 
 // GetTaggedMembers returns the current normalized tag state for a guild, sorted by tag (optionally filtered by club).
@@ -72,8 +87,9 @@ func (s *LeaderboardService) GetTaggedMembers(ctx context.Context, guildID share
 			continue // Should be filtered by query but safe to check
 		}
 		members = append(members, TaggedMemberView{
-			MemberID: m.MemberID,
-			Tag:      *m.CurrentTag,
+			MemberID:   m.MemberID,
+			Tag:        *m.CurrentTag,
+			DivisionID: m.DivisionID,
 		})
 	}
 
@@ -98,8 +114,9 @@ func (s *LeaderboardService) getAllMembersCore(ctx context.Context, guildID shar
 	for _, m := range repoMembers {
 		// PWA needs to receive Members with nil tags, so no filter here.
 		members = append(members, MemberTagView{
-			MemberID: m.MemberID,
-			Tag:      m.CurrentTag,
+			MemberID:   m.MemberID,
+			Tag:        m.CurrentTag,
+			DivisionID: m.DivisionID,
 		})
 	}
 
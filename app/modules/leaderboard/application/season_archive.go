@@ -0,0 +1,182 @@
+package leaderboardservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+// SeasonArchive is the frozen record of an ended season.
+type SeasonArchive struct {
+	SeasonID   string
+	SeasonName string
+	EndedAt    time.Time
+	Standings  []leaderboarddomain.FinalStanding // ordered by final rank
+	Awards     []leaderboarddomain.SeasonAward
+}
+
+// GetSeasonArchive returns the archived final standings and awards of an ended season.
+// An empty seasonID selects the most recently ended season.
+func (s *LeaderboardService) GetSeasonArchive(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	seasonID string,
+) (results.OperationResult[SeasonArchive, error], error) {
+	return withTelemetry(s, ctx, "GetSeasonArchive", guildID, func(ctx context.Context) (results.OperationResult[SeasonArchive, error], error) {
+		season, err := s.findArchivedSeason(ctx, string(guildID), seasonID)
+		if err != nil {
+			return results.OperationResult[SeasonArchive, error]{}, err
+		}
+		if season == nil {
+			return results.FailureResult[SeasonArchive](ErrSeasonArchiveNotFound), nil
+		}
+
+		standings, err := s.repo.GetArchivedStandings(ctx, nil, string(guildID), season.ID)
+		if err != nil {
+			return results.OperationResult[SeasonArchive, error]{}, fmt.Errorf("failed to get archived standings: %w", err)
+		}
+		if len(standings) == 0 {
+			return results.FailureResult[SeasonArchive](ErrSeasonArchiveNotFound), nil
+		}
+		awards, err := s.repo.GetSeasonAwards(ctx, nil, string(guildID), season.ID)
+		if err != nil {
+			return results.OperationResult[SeasonArchive, error]{}, fmt.Errorf("failed to get season awards: %w", err)
+		}
+
+		archive := SeasonArchive{
+			SeasonID:   season.ID,
+			SeasonName: season.Name,
+			EndedAt:    season.EndDate,
+			Standings:  make([]leaderboarddomain.FinalStanding, len(standings)),
+			Awards:     make([]leaderboarddomain.SeasonAward, len(awards)),
+		}
+		for i, st := range standings {
+			archive.Standings[i] = leaderboarddomain.FinalStanding{
+				MemberID:      string(st.MemberID),
+				Rank:          st.FinalRank,
+				TotalPoints:   st.TotalPoints,
+				CurrentTier:   st.CurrentTier,
+				SeasonBestTag: st.SeasonBestTag,
+				RoundsPlayed:  st.RoundsPlayed,
+			}
+		}
+		for i, a := range awards {
+			archive.Awards[i] = leaderboarddomain.SeasonAward{
+				Kind:     leaderboarddomain.AwardKind(a.Award),
+				MemberID: string(a.MemberID),
+				Value:    a.Value,
+			}
+		}
+		return results.SuccessResult[SeasonArchive, error](archive), nil
+	})
+}
+
+// findArchivedSeason resolves the season an archive lookup refers to. Active and
+// scheduled seasons have no archive and resolve to nil.
+func (s *LeaderboardService) findArchivedSeason(ctx context.Context, guildID, seasonID string) (*leaderboarddb.Season, error) {
+	if seasonID != "" {
+		season, err := s.repo.GetSeasonByID(ctx, nil, guildID, seasonID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get season: %w", err)
+		}
+		if season == nil || season.IsActive || season.Scheduled {
+			return nil, nil
+		}
+		return season, nil
+	}
+
+	seasons, err := s.repo.ListSeasons(ctx, nil, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seasons: %w", err)
+	}
+	var latest *leaderboarddb.Season
+	for i := range seasons {
+		if seasons[i].IsActive || seasons[i].Scheduled || seasons[i].EndDate.IsZero() {
+			continue
+		}
+		if latest == nil || seasons[i].EndDate.After(latest.EndDate) {
+			latest = &seasons[i]
+		}
+	}
+	return latest, nil
+}
+
+// archiveSeason snapshots a season's standings and awards. It must run inside the
+// end-season transaction, before the season is closed.
+func (s *LeaderboardService) archiveSeason(ctx context.Context, db bun.IDB, guildID string, season *leaderboarddb.Season, endedAt time.Time) error {
+	standings, err := s.repo.GetSeasonStandingsBySeasonID(ctx, db, guildID, season.ID)
+	if err != nil {
+		return fmt.Errorf("load season standings: %w", err)
+	}
+	final := make([]leaderboarddomain.FinalStanding, len(standings))
+	for i, st := range standings {
+		final[i] = leaderboarddomain.FinalStanding{
+			MemberID:      string(st.MemberID),
+			TotalPoints:   st.TotalPoints,
+			CurrentTier:   st.CurrentTier,
+			SeasonBestTag: st.SeasonBestTag,
+			RoundsPlayed:  st.RoundsPlayed,
+		}
+	}
+
+	history, err := s.repo.ListPointHistoryForGuild(ctx, db, guildID)
+	if err != nil {
+		return fmt.Errorf("load point history: %w", err)
+	}
+	giantSlays := make(map[string]int)
+	for _, h := range history {
+		if h.SeasonID == season.ID && h.GiantSlays > 0 {
+			giantSlays[string(h.MemberID)] += h.GiantSlays
+		}
+	}
+
+	tagHistory, err := s.tagHistRepo.GetTagHistoryForGuild(ctx, db, guildID, season.StartDate)
+	if err != nil {
+		return fmt.Errorf("load tag history: %w", err)
+	}
+	ledger := make([]leaderboarddomain.TagLedgerEntry, len(tagHistory))
+	for i, entry := range tagHistory {
+		ledger[i] = leaderboarddomain.TagLedgerEntry{
+			At:          entry.CreatedAt,
+			TagNumber:   entry.TagNumber,
+			NewMemberID: entry.NewMemberID,
+		}
+		if entry.OldMemberID != nil {
+			ledger[i].OldMemberID = *entry.OldMemberID
+		}
+	}
+
+	ranked := leaderboarddomain.RankFinalStandings(final)
+	awards := leaderboarddomain.ComputeSeasonAwards(ranked, leaderboarddomain.SeasonStartTags(ledger), giantSlays)
+
+	archived := make([]leaderboarddb.SeasonArchiveStanding, len(ranked))
+	for i, st := range ranked {
+		archived[i] = leaderboarddb.SeasonArchiveStanding{
+			SeasonID:      season.ID,
+			MemberID:      sharedtypes.DiscordID(st.MemberID),
+			FinalRank:     st.Rank,
+			TotalPoints:   st.TotalPoints,
+			CurrentTier:   st.CurrentTier,
+			SeasonBestTag: st.SeasonBestTag,
+			RoundsPlayed:  st.RoundsPlayed,
+			ArchivedAt:    endedAt,
+		}
+	}
+	awardRows := make([]leaderboarddb.SeasonAward, len(awards))
+	for i, a := range awards {
+		awardRows[i] = leaderboarddb.SeasonAward{
+			SeasonID:   season.ID,
+			Award:      string(a.Kind),
+			MemberID:   sharedtypes.DiscordID(a.MemberID),
+			Value:      a.Value,
+			ArchivedAt: endedAt,
+		}
+	}
+	return s.repo.ArchiveSeason(ctx, db, guildID, archived, awardRows)
+}
@@ -0,0 +1,186 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+func TestEndSeasonInTx_ArchivesStandingsAndAwards(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	members := &fakeLeagueMemberRepo{}
+	bob := "bob"
+	t0 := time.Date(2026, 4, 1, 18, 0, 0, 0, time.UTC)
+	tags := &fakeTagHistoryRepo{guildHistory: []leaderboarddb.TagHistoryEntry{
+		{TagNumber: 1, NewMemberID: "bob", CreatedAt: t0},
+		{TagNumber: 5, NewMemberID: "alice", CreatedAt: t0},
+		{TagNumber: 1, OldMemberID: &bob, NewMemberID: "alice", CreatedAt: t0.Add(time.Hour)},
+		{TagNumber: 5, NewMemberID: "bob", CreatedAt: t0.Add(time.Hour)},
+	}}
+	svc := newWriteFlowTestService(repo, members, tags, &fakeRoundOutcomeRepo{})
+
+	season := &leaderboarddb.Season{GuildID: "guild-1", ID: "2026-spring", Name: "Spring 2026", IsActive: true, StartDate: t0}
+	repo.GetActiveSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error) {
+		return season, nil
+	}
+	repo.GetSeasonStandingsBySeasonIDFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string) ([]leaderboarddb.SeasonStanding, error) {
+		return []leaderboarddb.SeasonStanding{
+			{MemberID: "bob", SeasonID: seasonID, TotalPoints: 300, CurrentTier: "Silver", SeasonBestTag: 1, RoundsPlayed: 4},
+			{MemberID: "alice", SeasonID: seasonID, TotalPoints: 450, CurrentTier: "Gold", SeasonBestTag: 1, RoundsPlayed: 3},
+		}, nil
+	}
+	repo.ListPointHistoryForGuildFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.PointHistory, error) {
+		return []leaderboarddb.PointHistory{
+			{SeasonID: "2026-spring", MemberID: "bob", GiantSlays: 2},
+			{SeasonID: "2025-fall", MemberID: "alice", GiantSlays: 5}, // previous season, ignored
+		}, nil
+	}
+	var archived []leaderboarddb.SeasonArchiveStanding
+	var awards []leaderboarddb.SeasonAward
+	repo.ArchiveSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string, s []leaderboarddb.SeasonArchiveStanding, a []leaderboarddb.SeasonAward) error {
+		archived, awards = s, a
+		return nil
+	}
+	var closedID string
+	repo.CloseSeasonFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string, endedAt time.Time) error {
+		closedID = seasonID
+		return nil
+	}
+
+	if err := svc.endSeasonInTx(context.Background(), nil, "guild-1"); err != nil {
+		t.Fatalf("endSeasonInTx returned error: %v", err)
+	}
+
+	if members.acquireGuildLockCalls != 1 {
+		t.Fatalf("expected guild lock, got %d calls", members.acquireGuildLockCalls)
+	}
+	trace := repo.Trace()
+	if slices.Index(trace, "ArchiveSeason") > slices.Index(trace, "CloseSeason") {
+		t.Fatalf("expected archive before close, trace: %v", trace)
+	}
+	if closedID != "2026-spring" {
+		t.Fatalf("expected the active season to be closed, got %q", closedID)
+	}
+
+	if len(archived) != 2 || archived[0].MemberID != "alice" || archived[0].FinalRank != 1 || archived[1].FinalRank != 2 {
+		t.Fatalf("unexpected archived standings: %+v", archived)
+	}
+
+	got := make(map[string]leaderboarddb.SeasonAward)
+	for _, a := range awards {
+		got[a.Award] = a
+	}
+	want := map[leaderboarddomain.AwardKind]struct {
+		member sharedtypes.DiscordID
+		value  int
+	}{
+		leaderboarddomain.AwardPointsChampion:  {"alice", 450},
+		leaderboarddomain.AwardMostImprovedTag: {"alice", 4},
+		leaderboarddomain.AwardMostRounds:      {"bob", 4},
+		leaderboarddomain.AwardGiantSlayer:     {"bob", 2},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d awards, got %+v", len(want), awards)
+	}
+	for kind, w := range want {
+		a := got[string(kind)]
+		if a.MemberID != w.member || a.Value != w.value || a.SeasonID != "2026-spring" {
+			t.Fatalf("award %s = %+v, want %s/%d", kind, a, w.member, w.value)
+		}
+	}
+}
+
+func TestEndSeasonInTx_NoActiveSeason(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	repo.GetActiveSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error) {
+		return nil, nil
+	}
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	err := svc.endSeasonInTx(context.Background(), nil, "guild-1")
+	if !errors.Is(err, ErrNoActiveSeason) {
+		t.Fatalf("expected ErrNoActiveSeason, got %v", err)
+	}
+	if slices.Contains(repo.Trace(), "ArchiveSeason") || slices.Contains(repo.Trace(), "CloseSeason") {
+		t.Fatal("nothing may be archived or closed without an active season")
+	}
+}
+
+func TestEndSeason_NoActiveSeasonIsNoOp(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	repo.GetActiveSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error) {
+		return nil, nil
+	}
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+	svc.commandPipeline = &FakeCommandPipeline{
+		EndSeasonFunc: func(ctx context.Context, guildID string) error {
+			t.Fatal("the pipeline must not run without an active season")
+			return nil
+		},
+	}
+
+	res, err := svc.EndSeason(context.Background(), "guild-1")
+	if err != nil {
+		t.Fatalf("EndSeason returned error: %v", err)
+	}
+	if res.Success == nil || *res.Success {
+		t.Fatalf("expected success reporting nothing ended, got %+v", res)
+	}
+}
+
+func TestGetSeasonArchive_LatestEndedSeason(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	ended := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	repo.ListSeasonsFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.Season, error) {
+		return []leaderboarddb.Season{
+			{ID: "2026-summer", Name: "Summer 2026", IsActive: true},
+			{ID: "2026-spring", Name: "Spring 2026", EndDate: ended},
+			{ID: "2025-fall", Name: "Fall 2025", EndDate: ended.AddDate(0, -6, 0)},
+		}, nil
+	}
+	var requested string
+	repo.GetArchivedStandingsFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string) ([]leaderboarddb.SeasonArchiveStanding, error) {
+		requested = seasonID
+		return []leaderboarddb.SeasonArchiveStanding{{SeasonID: seasonID, MemberID: "alice", FinalRank: 1, TotalPoints: 450}}, nil
+	}
+	repo.GetSeasonAwardsFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string) ([]leaderboarddb.SeasonAward, error) {
+		return []leaderboarddb.SeasonAward{{SeasonID: seasonID, Award: "points_champion", MemberID: "alice", Value: 450}}, nil
+	}
+
+	res, err := svc.GetSeasonArchive(context.Background(), "guild-1", "")
+	if err != nil || res.Success == nil {
+		t.Fatalf("GetSeasonArchive failed: %v %v", err, res.Failure)
+	}
+	archive := *res.Success
+	if requested != "2026-spring" || archive.SeasonName != "Spring 2026" || !archive.EndedAt.Equal(ended) {
+		t.Fatalf("expected the most recently ended season, got %+v", archive)
+	}
+	if len(archive.Standings) != 1 || len(archive.Awards) != 1 || archive.Awards[0].Kind != leaderboarddomain.AwardPointsChampion {
+		t.Fatalf("unexpected archive contents: %+v", archive)
+	}
+}
+
+func TestGetSeasonArchive_ActiveSeasonNotArchived(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	repo.GetSeasonByIDFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string) (*leaderboarddb.Season, error) {
+		return &leaderboarddb.Season{ID: seasonID, IsActive: true}, nil
+	}
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	res, err := svc.GetSeasonArchive(context.Background(), "guild-1", "2026-summer")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Failure == nil || !errors.Is(*res.Failure, ErrSeasonArchiveNotFound) {
+		t.Fatalf("expected ErrSeasonArchiveNotFound, got %+v", res)
+	}
+}
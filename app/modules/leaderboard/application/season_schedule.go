@@ -0,0 +1,199 @@
+package leaderboardservice
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+// SeasonSchedule describes a season created ahead of time. StartDate and EndDate are
+// instants; Timezone is the zone they were entered in. A nil EndDate lets the season run
+// until the next scheduled season starts or an admin ends it.
+type SeasonSchedule struct {
+	SeasonID   string
+	SeasonName string
+	StartDate  time.Time
+	EndDate    *time.Time
+	Timezone   string
+	Policy     leaderboarddomain.PointsPolicy
+}
+
+// SeasonTransition is a season change made by the scheduler. EndedSeasonID or
+// StartedSeasonID is empty when the transition only ended or only started a season.
+type SeasonTransition struct {
+	GuildID           sharedtypes.GuildID
+	At                time.Time
+	EndedSeasonID     string
+	StartedSeasonID   string
+	StartedSeasonName string
+}
+
+// ScheduleSeason creates a season that starts automatically at its start date.
+func (s *LeaderboardService) ScheduleSeason(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	schedule SeasonSchedule,
+) (results.OperationResult[SeasonInfo, error], error) {
+	return withTelemetry(s, ctx, "ScheduleSeason", guildID, func(ctx context.Context) (results.OperationResult[SeasonInfo, error], error) {
+		if err := schedule.Policy.Validate(); err != nil {
+			return results.FailureResult[SeasonInfo](err), nil
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (results.OperationResult[SeasonInfo, error], error) {
+			if err := s.memberRepo.AcquireGuildLock(ctx, db, string(guildID)); err != nil {
+				return results.OperationResult[SeasonInfo, error]{}, fmt.Errorf("failed to acquire guild lock: %w", err)
+			}
+
+			seasons, err := s.repo.ListSeasons(ctx, db, string(guildID))
+			if err != nil {
+				return results.OperationResult[SeasonInfo, error]{}, fmt.Errorf("failed to list seasons: %w", err)
+			}
+			states := make([]leaderboarddomain.SeasonState, len(seasons))
+			for i, season := range seasons {
+				states[i] = toSeasonState(season)
+			}
+			if msg := leaderboarddomain.ValidateSeasonSchedule(schedule.SeasonID, schedule.SeasonName, schedule.StartDate, schedule.EndDate, time.Now().UTC(), states); msg != "" {
+				return results.FailureResult[SeasonInfo](fmt.Errorf("validation: %s", msg)), nil
+			}
+
+			timezone := schedule.Timezone
+			if timezone == "" {
+				timezone = "UTC"
+			}
+			season := &leaderboarddb.Season{
+				ID:           schedule.SeasonID,
+				Name:         schedule.SeasonName,
+				StartDate:    schedule.StartDate.UTC(),
+				Scheduled:    true,
+				Timezone:     timezone,
+				PointsPolicy: &schedule.Policy,
+			}
+			if schedule.EndDate != nil {
+				season.EndDate = schedule.EndDate.UTC()
+			}
+			if err := s.repo.CreateSeason(ctx, db, string(guildID), season); err != nil {
+				return results.OperationResult[SeasonInfo, error]{}, fmt.Errorf("failed to create season: %w", err)
+			}
+			return results.SuccessResult[SeasonInfo, error](toSeasonInfo(*season)), nil
+		})
+	})
+}
+
+// RunSeasonSchedule starts every scheduled season whose start has passed and ends every
+// active season whose end has passed, archiving the outgoing season each time. A season
+// replaced by a scheduled one ends at the moment its successor starts, and members moved
+// by the promotion policy are seeded with their new tags once it has ended. Guilds are
+// processed independently; one failing guild does not hold back the others.
+func (s *LeaderboardService) RunSeasonSchedule(ctx context.Context, now time.Time) ([]SeasonTransition, error) {
+	guildIDs, err := s.repo.ListGuildsWithDueSeasonTransitions(ctx, nil, now)
+	if err != nil {
+		return nil, fmt.Errorf("LeaderboardService.RunSeasonSchedule: %w", err)
+	}
+
+	var transitions []SeasonTransition
+	for _, guildID := range guildIDs {
+		result, err := withTelemetry(s, ctx, "RunSeasonSchedule", sharedtypes.GuildID(guildID), func(ctx context.Context) (results.OperationResult[[]SeasonTransition, error], error) {
+			return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (results.OperationResult[[]SeasonTransition, error], error) {
+				changes, err := s.transitionSeasonsInTx(ctx, db, guildID, now)
+				if err != nil {
+					return results.OperationResult[[]SeasonTransition, error]{}, err
+				}
+				return results.SuccessResult[[]SeasonTransition, error](changes), nil
+			})
+		})
+		if err != nil {
+			s.logger.ErrorContext(ctx, "scheduled season transition failed",
+				attr.String("guild_id", guildID),
+				attr.Error(err),
+			)
+			continue
+		}
+		if result.Success == nil {
+			continue
+		}
+		for _, t := range *result.Success {
+			if t.EndedSeasonID == "" {
+				continue
+			}
+			if err := s.seedSeasonPromotions(ctx, t.GuildID, t.EndedSeasonID); err != nil {
+				s.logSeedFailure(ctx, t.GuildID, t.EndedSeasonID, err)
+			}
+		}
+		transitions = append(transitions, *result.Success...)
+	}
+	return transitions, nil
+}
+
+func (s *LeaderboardService) transitionSeasonsInTx(ctx context.Context, db bun.IDB, guildID string, now time.Time) ([]SeasonTransition, error) {
+	if err := s.memberRepo.AcquireGuildLock(ctx, db, guildID); err != nil {
+		return nil, fmt.Errorf("acquire guild lock: %w", err)
+	}
+
+	// Re-read under the lock; an admin may have changed seasons since the due scan.
+	seasons, err := s.repo.ListSeasons(ctx, db, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("list seasons: %w", err)
+	}
+	var (
+		active *leaderboarddb.Season
+		due    []*leaderboarddb.Season
+	)
+	for i := range seasons {
+		switch {
+		case seasons[i].IsActive:
+			active = &seasons[i]
+		case seasons[i].Scheduled && !seasons[i].StartDate.After(now):
+			due = append(due, &seasons[i])
+		}
+	}
+	slices.SortFunc(due, func(a, b *leaderboarddb.Season) int {
+		return cmp.Compare(a.StartDate.UnixNano(), b.StartDate.UnixNano())
+	})
+
+	var transitions []SeasonTransition
+	for _, next := range due {
+		transition := SeasonTransition{
+			GuildID:           sharedtypes.GuildID(guildID),
+			At:                next.StartDate,
+			StartedSeasonID:   next.ID,
+			StartedSeasonName: next.Name,
+		}
+		if active != nil {
+			endedAt := next.StartDate
+			if !active.EndDate.IsZero() && active.EndDate.Before(endedAt) {
+				endedAt = active.EndDate
+			}
+			if err := s.closeSeasonInTx(ctx, db, guildID, active, endedAt); err != nil {
+				return nil, fmt.Errorf("close season %s: %w", active.ID, err)
+			}
+			transition.EndedSeasonID = active.ID
+		}
+		if err := s.repo.ActivateSeason(ctx, db, guildID, next.ID); err != nil {
+			return nil, fmt.Errorf("activate season %s: %w", next.ID, err)
+		}
+		next.IsActive, next.Scheduled = true, false
+		active = next
+		transitions = append(transitions, transition)
+	}
+
+	if active != nil && !active.EndDate.IsZero() && !active.EndDate.After(now) {
+		if err := s.closeSeasonInTx(ctx, db, guildID, active, active.EndDate); err != nil {
+			return nil, fmt.Errorf("close season %s: %w", active.ID, err)
+		}
+		transitions = append(transitions, SeasonTransition{
+			GuildID:       sharedtypes.GuildID(guildID),
+			At:            active.EndDate,
+			EndedSeasonID: active.ID,
+		})
+	}
+	return transitions, nil
+}
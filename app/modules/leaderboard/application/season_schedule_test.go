@@ -0,0 +1,145 @@
+package leaderboardservice
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+func TestTransitionSeasonsInTx_EndsActiveAndStartsScheduled(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	members := &fakeLeagueMemberRepo{}
+	svc := newWriteFlowTestService(repo, members, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	springStart := time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)
+	summerStart := time.Date(2026, 6, 1, 5, 0, 0, 0, time.UTC)
+	repo.ListSeasonsFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.Season, error) {
+		return []leaderboarddb.Season{
+			{ID: "2026-spring", Name: "Spring 2026", IsActive: true, StartDate: springStart},
+			{ID: "2026-summer", Name: "Summer 2026", Scheduled: true, StartDate: summerStart},
+			{ID: "2026-fall", Name: "Fall 2026", Scheduled: true, StartDate: summerStart.AddDate(0, 3, 0)},
+		}, nil
+	}
+	var closed []string
+	var closedAt time.Time
+	repo.CloseSeasonFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string, endedAt time.Time) error {
+		closed = append(closed, seasonID)
+		closedAt = endedAt
+		return nil
+	}
+	var activated []string
+	repo.ActivateSeasonFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string) error {
+		activated = append(activated, seasonID)
+		return nil
+	}
+
+	transitions, err := svc.transitionSeasonsInTx(context.Background(), nil, "guild-1", summerStart.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("transitionSeasonsInTx returned error: %v", err)
+	}
+
+	if members.acquireGuildLockCalls != 1 {
+		t.Fatalf("expected guild lock, got %d calls", members.acquireGuildLockCalls)
+	}
+	if !slices.Equal(closed, []string{"2026-spring"}) || !closedAt.Equal(summerStart) {
+		t.Fatalf("expected spring to close at summer's start, got %v at %v", closed, closedAt)
+	}
+	if !slices.Equal(activated, []string{"2026-summer"}) {
+		t.Fatalf("expected only summer to start, got %v", activated)
+	}
+	trace := repo.Trace()
+	if slices.Index(trace, "ArchiveSeason") > slices.Index(trace, "CloseSeason") || slices.Index(trace, "CloseSeason") > slices.Index(trace, "ActivateSeason") {
+		t.Fatalf("expected archive, close, then activate; trace: %v", trace)
+	}
+	if len(transitions) != 1 || transitions[0].EndedSeasonID != "2026-spring" || transitions[0].StartedSeasonID != "2026-summer" {
+		t.Fatalf("unexpected transitions: %+v", transitions)
+	}
+}
+
+func TestTransitionSeasonsInTx_EndsExpiredSeasonWithoutSuccessor(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	end := time.Date(2026, 6, 1, 5, 0, 0, 0, time.UTC)
+	repo.ListSeasonsFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.Season, error) {
+		return []leaderboarddb.Season{
+			{ID: "2026-spring", IsActive: true, StartDate: end.AddDate(0, -3, 0), EndDate: end},
+		}, nil
+	}
+
+	transitions, err := svc.transitionSeasonsInTx(context.Background(), nil, "guild-1", end.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("transitionSeasonsInTx returned error: %v", err)
+	}
+	if len(transitions) != 1 || transitions[0].EndedSeasonID != "2026-spring" || transitions[0].StartedSeasonID != "" || !transitions[0].At.Equal(end) {
+		t.Fatalf("unexpected transitions: %+v", transitions)
+	}
+	if slices.Contains(repo.Trace(), "ActivateSeason") {
+		t.Fatal("no season should start")
+	}
+}
+
+func TestScheduleSeason_RejectsOverlap(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	start := time.Now().UTC().AddDate(0, 1, 0)
+	end := start.AddDate(0, 3, 0)
+	repo.ListSeasonsFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.Season, error) {
+		return []leaderboarddb.Season{
+			{ID: "2026-summer", Scheduled: true, StartDate: start, EndDate: end},
+		}, nil
+	}
+
+	overlapping := start.AddDate(0, 1, 0)
+	res, err := svc.ScheduleSeason(context.Background(), "guild-1", SeasonSchedule{
+		SeasonID:   "2026-late-summer",
+		SeasonName: "Late Summer 2026",
+		StartDate:  overlapping,
+		Policy:     leaderboarddomain.DefaultPointsPolicy(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Failure == nil || !strings.HasPrefix((*res.Failure).Error(), "validation:") {
+		t.Fatalf("expected validation failure, got %+v", res)
+	}
+	if slices.Contains(repo.Trace(), "CreateSeason") {
+		t.Fatal("an overlapping season must not be created")
+	}
+}
+
+func TestScheduleSeason_CreatesScheduledSeason(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	svc := newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+
+	var created *leaderboarddb.Season
+	repo.CreateSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string, season *leaderboarddb.Season) error {
+		created = season
+		return nil
+	}
+
+	start := time.Now().UTC().AddDate(0, 1, 0)
+	res, err := svc.ScheduleSeason(context.Background(), "guild-1", SeasonSchedule{
+		SeasonID:   "2026-summer",
+		SeasonName: "Summer 2026",
+		StartDate:  start,
+		Timezone:   "America/Chicago",
+		Policy:     leaderboarddomain.DefaultPointsPolicy(),
+	})
+	if err != nil || res.Success == nil {
+		t.Fatalf("ScheduleSeason failed: %v %v", err, res.Failure)
+	}
+	if created == nil || !created.Scheduled || created.IsActive || created.Timezone != "America/Chicago" || !created.StartDate.Equal(start) {
+		t.Fatalf("unexpected season created: %+v", created)
+	}
+	if !res.Success.Scheduled {
+		t.Fatalf("expected scheduled season info, got %+v", *res.Success)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
@@ -29,7 +30,7 @@ type CommandPipeline interface {
 		source sharedtypes.ServiceUpdateSource,
 		updateID sharedtypes.RoundID,
 	) (leaderboardtypes.LeaderboardData, error)
-	StartSeason(ctx context.Context, guildID, seasonID, seasonName string) error
+	StartSeason(ctx context.Context, guildID, seasonID, seasonName string, policy leaderboarddomain.PointsPolicy) error
 	EndSeason(ctx context.Context, guildID string) error
 	ResetTags(ctx context.Context, guildID string, finishOrder []string) ([]leaderboarddomain.TagChange, error)
 	GetTaggedMembers(ctx context.Context, guildID string, clubUUID *string) ([]TaggedMemberView, error)
@@ -85,6 +86,14 @@ type LeaderboardService struct {
 	tracer          trace.Tracer
 	db              *bun.DB
 	commandPipeline CommandPipeline
+	scoreSource     RoundScoreSource
+	parSource       RoundParSource
+	reliability     ReliabilitySource
+	guildSettings   GuildSettingsSource
+
+	memberStatsCacheTTL time.Duration
+	memberStatsCacheMu  sync.RWMutex
+	memberStatsCache    map[string]map[string]memberStatsCacheEntry
 }
 
 // NewLeaderboardService creates a new LeaderboardService.
@@ -108,6 +117,9 @@ func NewLeaderboardService(
 		metrics:     metrics,
 		tracer:      tracer,
 		db:          db,
+
+		memberStatsCacheTTL: defaultMemberStatsCacheTTL,
+		memberStatsCache:    make(map[string]map[string]memberStatsCacheEntry),
 	}
 	service.commandPipeline = &serviceCommandPipeline{service: service}
 	return service
@@ -0,0 +1,207 @@
+package leaderboardservice
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// LeaderboardSnapshot is a guild's leaderboard as it stood at a point in time.
+type LeaderboardSnapshot struct {
+	// AsOf is the moment the leaderboard was rebuilt at. For a round it is when the
+	// round's results were last written.
+	AsOf time.Time
+	// SeasonID is the season the points come from; empty if no season was running.
+	SeasonID string
+	Entries  []leaderboardtypes.LeaderboardEntry
+}
+
+// GetLeaderboardAt rebuilds the leaderboard as it stood at asOf, or right after roundID
+// was processed. Exactly one of them must be set. Tags are rebuilt from the tag history
+// ledger and points from the point history of seasonID, or of the season running at
+// that moment when seasonID is empty. Rounds recalculated since are shown with their
+// corrected results. When divisionID is set only the members holding a tag in that
+// division are listed; otherwise every division is, as in GetLeaderboard. It returns
+// ErrDivisionNotFound for unknown divisions.
+func (s *LeaderboardService) GetLeaderboardAt(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	divisionID *string,
+	seasonID string,
+	asOf *time.Time,
+	roundID *sharedtypes.RoundID,
+) (results.OperationResult[LeaderboardSnapshot, error], error) {
+	snapshotTx := func(ctx context.Context, db bun.IDB) (results.OperationResult[LeaderboardSnapshot, error], error) {
+		if guildID == "" {
+			return results.FailureResult[LeaderboardSnapshot](ErrInvalidGuildID), nil
+		}
+		if (asOf == nil) == (roundID == nil) {
+			return results.FailureResult[LeaderboardSnapshot](ErrInvalidSnapshotRequest), nil
+		}
+		resolvedGuildID := s.resolveGuildID(ctx, string(guildID))
+
+		if divisionID != nil {
+			division, err := s.lookupDivision(ctx, db, resolvedGuildID, *divisionID)
+			if err != nil {
+				return results.OperationResult[LeaderboardSnapshot, error]{}, err
+			}
+			if division == nil {
+				return results.FailureResult[LeaderboardSnapshot](ErrDivisionNotFound), nil
+			}
+		}
+
+		var at time.Time
+		var err error
+		if asOf != nil {
+			at = asOf.UTC()
+		} else {
+			var found bool
+			at, found, err = s.roundWrittenAt(ctx, db, resolvedGuildID, uuid.UUID(*roundID))
+			if err != nil {
+				return results.OperationResult[LeaderboardSnapshot, error]{}, err
+			}
+			if !found {
+				return results.FailureResult[LeaderboardSnapshot](ErrRoundNotProcessed), nil
+			}
+		}
+
+		snapshot, err := s.leaderboardAt(ctx, db, resolvedGuildID, divisionID, seasonID, at)
+		if err != nil {
+			return results.OperationResult[LeaderboardSnapshot, error]{}, err
+		}
+		return results.SuccessResult[LeaderboardSnapshot, error](*snapshot), nil
+	}
+
+	return withTelemetry(s, ctx, "GetLeaderboardAt", guildID, func(ctx context.Context) (results.OperationResult[LeaderboardSnapshot, error], error) {
+		return runInTx(s, ctx, snapshotTx)
+	})
+}
+
+// roundWrittenAt returns when a round's results were last written: the latest of its
+// tag and point history, which share their transaction's timestamp, or when it was
+// processed for rounds that changed neither. found is false if the round was never
+// processed.
+func (s *LeaderboardService) roundWrittenAt(ctx context.Context, db bun.IDB, guildID string, roundID uuid.UUID) (at time.Time, found bool, err error) {
+	tagHistory, err := s.tagHistRepo.GetTagHistoryForRound(ctx, db, guildID, roundID)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("load round tag history: %w", err)
+	}
+	for _, entry := range tagHistory {
+		if entry.CreatedAt.After(at) {
+			at = entry.CreatedAt
+		}
+	}
+	pointHistory, err := s.repo.GetPointHistoryForRound(ctx, db, guildID, sharedtypes.RoundID(roundID))
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("load round point history: %w", err)
+	}
+	for _, ph := range pointHistory {
+		if ph.CreatedAt.After(at) {
+			at = ph.CreatedAt
+		}
+	}
+	if !at.IsZero() {
+		return at, true, nil
+	}
+
+	outcome, err := s.outcomeRepo.GetRoundOutcome(ctx, db, guildID, roundID)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("load round outcome: %w", err)
+	}
+	if outcome == nil {
+		return time.Time{}, false, nil
+	}
+	return outcome.ProcessedAt, true, nil
+}
+
+// leaderboardAt replays the tag ledger and a season's points up to at, listing the
+// tag holders of divisionID or, when it is nil, of every division.
+func (s *LeaderboardService) leaderboardAt(ctx context.Context, db bun.IDB, guildID string, divisionID *string, seasonID string, at time.Time) (*LeaderboardSnapshot, error) {
+	tagHistory, err := s.tagHistRepo.GetTagHistoryForGuildUntil(ctx, db, guildID, at)
+	if err != nil {
+		return nil, fmt.Errorf("load tag history: %w", err)
+	}
+	state := leaderboarddomain.NewReplayState()
+	var ledger tagLedger
+	for _, entry := range tagHistory {
+		ledger.apply(state, entry)
+	}
+
+	if seasonID == "" {
+		seasonID, err = s.seasonRunningAt(ctx, db, guildID, at)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if seasonID != "" {
+		pointHistory, err := s.repo.ListPointHistoryForSeasonUntil(ctx, db, guildID, seasonID, at)
+		if err != nil {
+			return nil, fmt.Errorf("load point history: %w", err)
+		}
+		for _, ph := range pointHistory {
+			if uuid.UUID(ph.RoundID) == uuid.Nil {
+				state.AdjustPoints(ph.SeasonID, string(ph.MemberID), ph.Points)
+				continue
+			}
+			state.AddRoundPoints(ph.SeasonID, string(ph.MemberID), ph.Points, ph.Tier)
+		}
+	}
+
+	standings := make(map[string]leaderboarddomain.ReplayStanding)
+	for _, st := range state.Standings() {
+		standings[st.MemberID] = st
+	}
+	tags := state.Tags()
+	entries := make([]leaderboardtypes.LeaderboardEntry, 0, len(tags))
+	for memberID, tag := range tags {
+		if divisionID != nil && state.Division(memberID) != *divisionID {
+			continue
+		}
+		st := standings[memberID]
+		entries = append(entries, leaderboardtypes.LeaderboardEntry{
+			UserID:       sharedtypes.DiscordID(memberID),
+			TagNumber:    sharedtypes.TagNumber(tag),
+			TotalPoints:  st.TotalPoints,
+			RoundsPlayed: st.RoundsPlayed,
+		})
+	}
+	slices.SortFunc(entries, func(a, b leaderboardtypes.LeaderboardEntry) int {
+		if c := cmp.Compare(a.TagNumber, b.TagNumber); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+
+	return &LeaderboardSnapshot{AsOf: at, SeasonID: seasonID, Entries: entries}, nil
+}
+
+// seasonRunningAt returns the season that was running at t, or "" if none was. Seasons
+// without a start date count from when they were created.
+func (s *LeaderboardService) seasonRunningAt(ctx context.Context, db bun.IDB, guildID string, t time.Time) (string, error) {
+	seasons, err := s.repo.ListSeasons(ctx, db, guildID)
+	if err != nil {
+		return "", fmt.Errorf("list seasons: %w", err)
+	}
+	for i := range seasons {
+		season := seasons[i]
+		if season.StartDate.IsZero() {
+			season.StartDate = season.CreatedAt
+		}
+		if season.StartDate.IsZero() || t.Before(season.StartDate) {
+			continue
+		}
+		if season.EndDate.IsZero() || t.Before(season.EndDate) {
+			return season.ID, nil
+		}
+	}
+	return "", nil
+}
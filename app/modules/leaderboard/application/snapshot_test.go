@@ -0,0 +1,206 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+var (
+	snapshotT1 = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	snapshotT2 = time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)
+	snapshotT3 = time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+)
+
+// snapshotHistory: alice and bob claim tags 1 and 2, bob takes tag 1 from alice in
+// snapshotRound, then carol claims tag 3.
+func snapshotHistory(round uuid.UUID) []leaderboarddb.TagHistoryEntry {
+	alice, bob := "alice", "bob"
+	return []leaderboarddb.TagHistoryEntry{
+		{ID: 1, GuildID: "guild-1", TagNumber: 1, NewMemberID: "alice", Reason: "claim", CreatedAt: snapshotT1},
+		{ID: 2, GuildID: "guild-1", TagNumber: 2, NewMemberID: "bob", Reason: "claim", CreatedAt: snapshotT1},
+		{ID: 3, GuildID: "guild-1", RoundID: &round, TagNumber: 1, OldMemberID: &alice, NewMemberID: "bob", Reason: "round_swap", CreatedAt: snapshotT2},
+		{ID: 4, GuildID: "guild-1", RoundID: &round, TagNumber: 2, OldMemberID: &bob, NewMemberID: "alice", Reason: "round_swap", CreatedAt: snapshotT2},
+		{ID: 5, GuildID: "guild-1", TagNumber: 3, NewMemberID: "carol", Reason: "claim", CreatedAt: snapshotT3},
+	}
+}
+
+func snapshotTestService(round uuid.UUID) (*LeaderboardService, *FakeLeaderboardRepo) {
+	repo := NewFakeLeaderboardRepo()
+	repo.ListSeasonsFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.Season, error) {
+		return []leaderboarddb.Season{
+			{GuildID: guildID, ID: "2026-spring", IsActive: true, StartDate: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+			{GuildID: guildID, ID: "2025-fall", StartDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		}, nil
+	}
+	repo.ListPointHistoryForSeasonUntilFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string, until time.Time) ([]leaderboarddb.PointHistory, error) {
+		all := []leaderboarddb.PointHistory{
+			{SeasonID: seasonID, MemberID: "bob", RoundID: sharedtypes.RoundID(round), Points: 100, CreatedAt: snapshotT2},
+			{SeasonID: seasonID, MemberID: "alice", RoundID: sharedtypes.RoundID(round), Points: 50, CreatedAt: snapshotT2},
+			{SeasonID: seasonID, MemberID: "alice", Points: 25, CreatedAt: snapshotT3},
+		}
+		var out []leaderboarddb.PointHistory
+		for _, ph := range all {
+			if !ph.CreatedAt.After(until) {
+				out = append(out, ph)
+			}
+		}
+		return out, nil
+	}
+	tags := &fakeTagHistoryRepo{
+		guildHistory: snapshotHistory(round),
+		roundHistory: map[uuid.UUID][]leaderboarddb.TagHistoryEntry{round: snapshotHistory(round)[2:4]},
+	}
+	return newWriteFlowTestService(repo, &fakeLeagueMemberRepo{}, tags, &fakeRoundOutcomeRepo{}), repo
+}
+
+func TestGetLeaderboardAt_AsOf(t *testing.T) {
+	round := uuid.New()
+	svc, _ := snapshotTestService(round)
+
+	asOf := snapshotT1.Add(time.Hour)
+	result, err := svc.GetLeaderboardAt(context.Background(), "guild-1", nil, "", &asOf, nil)
+	if err != nil || !result.IsSuccess() {
+		t.Fatalf("expected success, got %+v, %v", result, err)
+	}
+	snapshot := *result.Success
+	if snapshot.SeasonID != "2026-spring" || !snapshot.AsOf.Equal(asOf) {
+		t.Fatalf("unexpected snapshot %+v", snapshot)
+	}
+	want := []leaderboardtypes.LeaderboardEntry{
+		{UserID: "alice", TagNumber: 1},
+		{UserID: "bob", TagNumber: 2},
+	}
+	assertSnapshotEntries(t, snapshot.Entries, want)
+}
+
+func TestGetLeaderboardAt_AfterRound(t *testing.T) {
+	round := uuid.New()
+	svc, _ := snapshotTestService(round)
+
+	roundID := sharedtypes.RoundID(round)
+	result, err := svc.GetLeaderboardAt(context.Background(), "guild-1", nil, "", nil, &roundID)
+	if err != nil || !result.IsSuccess() {
+		t.Fatalf("expected success, got %+v, %v", result, err)
+	}
+	snapshot := *result.Success
+	if !snapshot.AsOf.Equal(snapshotT2) {
+		t.Fatalf("expected snapshot at the round's writes, got %v", snapshot.AsOf)
+	}
+	want := []leaderboardtypes.LeaderboardEntry{
+		{UserID: "bob", TagNumber: 1, TotalPoints: 100, RoundsPlayed: 1},
+		{UserID: "alice", TagNumber: 2, TotalPoints: 50, RoundsPlayed: 1},
+	}
+	assertSnapshotEntries(t, snapshot.Entries, want)
+}
+
+func TestGetLeaderboardAt_NoSeasonRunning(t *testing.T) {
+	round := uuid.New()
+	svc, repo := snapshotTestService(round)
+	repo.ListSeasonsFunc = nil
+
+	asOf := snapshotT3
+	result, err := svc.GetLeaderboardAt(context.Background(), "guild-1", nil, "", &asOf, nil)
+	if err != nil || !result.IsSuccess() {
+		t.Fatalf("expected success, got %+v, %v", result, err)
+	}
+	if result.Success.SeasonID != "" {
+		t.Fatalf("expected no season, got %q", result.Success.SeasonID)
+	}
+	for _, e := range result.Success.Entries {
+		if e.TotalPoints != 0 {
+			t.Fatalf("expected no points without a season, got %+v", e)
+		}
+	}
+	if len(result.Success.Entries) != 3 {
+		t.Fatalf("expected three tag holders, got %+v", result.Success.Entries)
+	}
+}
+
+func TestGetLeaderboardAt_Division(t *testing.T) {
+	round := uuid.New()
+	svc, repo := snapshotTestService(round)
+	repo.GetDivisionFunc = func(ctx context.Context, db bun.IDB, guildID, divisionID string) (*leaderboarddb.Division, error) {
+		return &leaderboarddb.Division{GuildID: guildID, ID: divisionID, Name: "Pro"}, nil
+	}
+	history := append(snapshotHistory(round),
+		leaderboarddb.TagHistoryEntry{ID: 6, GuildID: "guild-1", TagNumber: 1, DivisionID: "pro", NewMemberID: "dave", Reason: "claim", CreatedAt: snapshotT3},
+		leaderboarddb.TagHistoryEntry{ID: 7, GuildID: "guild-1", TagNumber: 2, DivisionID: "pro", NewMemberID: "erin", Reason: "claim", CreatedAt: snapshotT3},
+	)
+	svc.tagHistRepo = &fakeTagHistoryRepo{guildHistory: history}
+
+	asOf := snapshotT3
+	pro := "pro"
+	result, err := svc.GetLeaderboardAt(context.Background(), "guild-1", &pro, "", &asOf, nil)
+	if err != nil || !result.IsSuccess() {
+		t.Fatalf("expected success, got %+v, %v", result, err)
+	}
+	assertSnapshotEntries(t, result.Success.Entries, []leaderboardtypes.LeaderboardEntry{
+		{UserID: "dave", TagNumber: 1},
+		{UserID: "erin", TagNumber: 2},
+	})
+
+	// The pro claims of tags 1 and 2 leave the default division's holders alone.
+	defaultDivision := ""
+	result, err = svc.GetLeaderboardAt(context.Background(), "guild-1", &defaultDivision, "", &asOf, nil)
+	if err != nil || !result.IsSuccess() {
+		t.Fatalf("expected success, got %+v, %v", result, err)
+	}
+	assertSnapshotEntries(t, result.Success.Entries, []leaderboardtypes.LeaderboardEntry{
+		{UserID: "bob", TagNumber: 1, TotalPoints: 100, RoundsPlayed: 1},
+		{UserID: "alice", TagNumber: 2, TotalPoints: 75, RoundsPlayed: 1},
+		{UserID: "carol", TagNumber: 3},
+	})
+}
+
+func TestGetLeaderboardAt_Failures(t *testing.T) {
+	asOf := snapshotT1
+	unknown := sharedtypes.RoundID(uuid.New())
+	missing := "missing"
+
+	tests := []struct {
+		name     string
+		asOf     *time.Time
+		roundID  *sharedtypes.RoundID
+		division *string
+		want     error
+	}{
+		{name: "neither time nor round", want: ErrInvalidSnapshotRequest},
+		{name: "both time and round", asOf: &asOf, roundID: &unknown, want: ErrInvalidSnapshotRequest},
+		{name: "unprocessed round", roundID: &unknown, want: ErrRoundNotProcessed},
+		{name: "unknown division", asOf: &asOf, division: &missing, want: ErrDivisionNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _ := snapshotTestService(uuid.New())
+
+			result, err := svc.GetLeaderboardAt(context.Background(), "guild-1", tt.division, "", tt.asOf, tt.roundID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.IsFailure() || !errors.Is(*result.Failure, tt.want) {
+				t.Fatalf("expected %v, got %+v", tt.want, result)
+			}
+		})
+	}
+}
+
+func assertSnapshotEntries(t *testing.T, got, want []leaderboardtypes.LeaderboardEntry) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("entries = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i].UserID != want[i].UserID || got[i].TagNumber != want[i].TagNumber ||
+			got[i].TotalPoints != want[i].TotalPoints || got[i].RoundsPlayed != want[i].RoundsPlayed {
+			t.Fatalf("entries = %+v, want %+v", got, want)
+		}
+	}
+}
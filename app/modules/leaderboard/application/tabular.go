@@ -0,0 +1,112 @@
+package leaderboardservice
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// ExportFormat is the file encoding of an export.
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatXLSX ExportFormat = "xlsx"
+)
+
+// ContentType returns the MIME type of files in this format.
+func (f ExportFormat) ContentType() string {
+	if f == ExportFormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv"
+}
+
+// exportFormatFromFileName picks the format from a file's extension.
+func exportFormatFromFileName(name string) (ExportFormat, bool) {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return ExportFormatCSV, true
+	case ".xlsx":
+		return ExportFormatXLSX, true
+	default:
+		return "", false
+	}
+}
+
+// encodeTable writes rows, header first, as CSV or as a one-sheet workbook.
+func encodeTable(format ExportFormat, sheet string, rows [][]any) ([]byte, error) {
+	switch format {
+	case ExportFormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		for _, row := range rows {
+			record := make([]string, len(row))
+			for i, v := range row {
+				record[i] = fmt.Sprint(v)
+			}
+			if err := w.Write(record); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+
+	case ExportFormatXLSX:
+		f := excelize.NewFile()
+		defer f.Close()
+		if err := f.SetSheetName("Sheet1", sheet); err != nil {
+			return nil, err
+		}
+		for i, row := range rows {
+			cell, err := excelize.CoordinatesToCellName(1, i+1)
+			if err != nil {
+				return nil, err
+			}
+			if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+				return nil, err
+			}
+		}
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidExportRequest, format)
+	}
+}
+
+// decodeTable reads a CSV file or the first sheet of a workbook into rows of cells.
+func decodeTable(format ExportFormat, data []byte) ([][]string, error) {
+	switch format {
+	case ExportFormatCSV:
+		r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+		r.FieldsPerRecord = -1
+		r.TrimLeadingSpace = true
+		return r.ReadAll()
+
+	case ExportFormatXLSX:
+		f, err := excelize.OpenReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, fmt.Errorf("workbook has no sheets")
+		}
+		return f.GetRows(sheets[0])
+
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
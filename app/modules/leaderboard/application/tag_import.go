@@ -0,0 +1,211 @@
+package leaderboardservice
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ServiceUpdateSourceTagImport marks tag changes applied from an imported tag history file.
+const ServiceUpdateSourceTagImport sharedtypes.ServiceUpdateSource = "tag_import"
+
+// TagImportResult reports a validated tag history import.
+type TagImportResult struct {
+	DryRun  bool
+	Applied bool
+	// Rows is how many rows the file holds.
+	Rows int
+	// Issues lists the file's problems; a file with issues is never applied.
+	Issues []leaderboarddomain.TagImportIssue
+	// Changes lists the members whose tags the import changes.
+	Changes []leaderboarddomain.TagImportChange
+	// Assignments are the requests the import applied.
+	Assignments []sharedtypes.TagAssignmentRequest
+}
+
+// ImportTagHistory validates a CSV or XLSX tag history file and plans it against the
+// guild's current tags. With dryRun set, or when the file has issues, nothing is
+// written. Otherwise the planned changes are applied and recorded in one transaction:
+// the rows of a dated file go into tag history at their own dates so the imported
+// history shows in tag graphs and point-in-time leaderboards, while an undated file is
+// recorded by the assignments that applied it. Each row's tag belongs to its member's
+// current division, and each division is planned on its own.
+func (s *LeaderboardService) ImportTagHistory(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	fileName string,
+	data []byte,
+	dryRun bool,
+) (results.OperationResult[TagImportResult, error], error) {
+	return withTelemetry(s, ctx, "ImportTagHistory", guildID, func(ctx context.Context) (results.OperationResult[TagImportResult, error], error) {
+		if guildID == "" {
+			return results.FailureResult[TagImportResult](ErrInvalidGuildID), nil
+		}
+		format, ok := exportFormatFromFileName(fileName)
+		if !ok {
+			return results.FailureResult[TagImportResult](fmt.Errorf("%w: %q is not a .csv or .xlsx file", leaderboarddomain.ErrInvalidTagImport, fileName)), nil
+		}
+		table, err := decodeTable(format, data)
+		if err != nil {
+			return results.FailureResult[TagImportResult](fmt.Errorf("%w: %v", leaderboarddomain.ErrInvalidTagImport, err)), nil
+		}
+		resolvedGuildID := s.resolveGuildID(ctx, string(guildID))
+
+		result := TagImportResult{DryRun: dryRun}
+		rows, issues := leaderboarddomain.ParseTagImportRows(table)
+		result.Rows = len(rows)
+		if len(issues) > 0 {
+			result.Issues = issues
+			return results.SuccessResult[TagImportResult, error](result), nil
+		}
+
+		policy, err := s.loadTagPoolPolicy(ctx, resolvedGuildID)
+		if err != nil {
+			return results.OperationResult[TagImportResult, error]{}, err
+		}
+		tagCap := 0
+		if policy.Model == leaderboarddomain.TagPoolCapped {
+			tagCap = policy.Cap
+		}
+
+		importTx := func(ctx context.Context, db bun.IDB) (results.OperationResult[TagImportResult, error], error) {
+			if err := s.memberRepo.AcquireGuildLock(ctx, db, resolvedGuildID); err != nil {
+				return results.OperationResult[TagImportResult, error]{}, fmt.Errorf("acquire guild lock: %w", err)
+			}
+			members, err := s.memberRepo.GetMembersByGuild(ctx, db, resolvedGuildID)
+			if err != nil {
+				return results.OperationResult[TagImportResult, error]{}, fmt.Errorf("load members: %w", err)
+			}
+			divisionByMember := make(map[string]string, len(members))
+			currentByDivision := make(map[string]map[string]int)
+			for _, m := range members {
+				divisionByMember[m.MemberID] = m.DivisionID
+				if m.CurrentTag == nil {
+					continue
+				}
+				if currentByDivision[m.DivisionID] == nil {
+					currentByDivision[m.DivisionID] = make(map[string]int)
+				}
+				currentByDivision[m.DivisionID][m.MemberID] = *m.CurrentTag
+			}
+
+			// Tag numbers repeat across divisions, so each division's rows are planned
+			// against that division's tags only.
+			for _, group := range leaderboarddomain.GroupByDivision(rows, func(row leaderboarddomain.TagImportRow) string {
+				return divisionByMember[tagImportRowMember(row)]
+			}) {
+				divisionID := divisionByMember[tagImportRowMember(group[0])]
+				changes, issues := leaderboarddomain.PlanTagImport(group, currentByDivision[divisionID], tagCap)
+				result.Changes = append(result.Changes, changes...)
+				result.Issues = append(result.Issues, issues...)
+			}
+			slices.SortFunc(result.Changes, func(a, b leaderboarddomain.TagImportChange) int {
+				return cmp.Compare(a.MemberID, b.MemberID)
+			})
+			slices.SortFunc(result.Issues, func(a, b leaderboarddomain.TagImportIssue) int {
+				return cmp.Compare(a.Line, b.Line)
+			})
+			if len(result.Issues) > 0 || dryRun {
+				return results.SuccessResult[TagImportResult, error](result), nil
+			}
+
+			dated := len(rows) > 0 && !rows[0].At.IsZero()
+			if len(result.Changes) > 0 {
+				requests := make([]sharedtypes.TagAssignmentRequest, len(result.Changes))
+				for i, c := range result.Changes {
+					requests[i] = sharedtypes.TagAssignmentRequest{
+						UserID:    sharedtypes.DiscordID(c.MemberID),
+						TagNumber: sharedtypes.TagNumber(c.ImportedTag),
+					}
+				}
+				history, err := s.assignTagsInTx(ctx, db, resolvedGuildID, requests, ServiceUpdateSourceTagImport, sharedtypes.RoundID(uuid.Nil))
+				if err != nil {
+					var swapErr *TagSwapNeededError
+					if errors.As(err, &swapErr) {
+						return results.FailureResult[TagImportResult, error](swapErr), nil
+					}
+					return results.OperationResult[TagImportResult, error]{}, fmt.Errorf("apply imported tags: %w", err)
+				}
+				// A dated file is recorded at its own dates below; the assignments' rows,
+				// stamped now, would record the same moves twice.
+				if !dated {
+					if err := s.tagHistRepo.BulkInsertTagHistory(ctx, db, history); err != nil {
+						return results.OperationResult[TagImportResult, error]{}, fmt.Errorf("write tag history: %w", err)
+					}
+				}
+				result.Assignments = requests
+			}
+
+			if dated {
+				if err := s.recordImportedHistory(ctx, db, resolvedGuildID, rows, divisionByMember); err != nil {
+					return results.OperationResult[TagImportResult, error]{}, err
+				}
+			}
+			result.Applied = true
+			return results.SuccessResult[TagImportResult, error](result), nil
+		}
+
+		return runInTx(s, ctx, importTx)
+	})
+}
+
+// recordImportedHistory writes a dated file's rows to tag history at their own dates,
+// each in its member's division.
+func (s *LeaderboardService) recordImportedHistory(ctx context.Context, db bun.IDB, guildID string, rows []leaderboarddomain.TagImportRow, divisionByMember map[string]string) error {
+	type divisionTag struct {
+		divisionID string
+		tag        int
+	}
+	holders := make(map[divisionTag]string)
+	tags := make(map[string]int)
+	entries := make([]leaderboarddb.TagHistoryEntry, 0, len(rows))
+	for _, row := range leaderboarddomain.SortTagImportRows(rows) {
+		divisionID := divisionByMember[tagImportRowMember(row)]
+		key := divisionTag{divisionID, row.TagNumber}
+		entry := leaderboarddb.TagHistoryEntry{
+			GuildID:     guildID,
+			TagNumber:   row.TagNumber,
+			DivisionID:  divisionID,
+			NewMemberID: row.MemberID,
+			Reason:      leaderboarddomain.TagImportReason,
+			CreatedAt:   row.At,
+		}
+		if row.MemberID == "" {
+			released := row.ReleasedFrom
+			entry.OldMemberID = &released
+			delete(holders, key)
+		} else {
+			if prev, ok := holders[key]; ok && prev != row.MemberID {
+				entry.OldMemberID = &prev
+				delete(tags, prev)
+			}
+			if old, ok := tags[row.MemberID]; ok && holders[divisionTag{divisionID, old}] == row.MemberID {
+				delete(holders, divisionTag{divisionID, old})
+			}
+			holders[key] = row.MemberID
+			tags[row.MemberID] = row.TagNumber
+		}
+		entries = append(entries, entry)
+	}
+	if err := s.tagHistRepo.BulkInsertTagHistory(ctx, db, entries); err != nil {
+		return fmt.Errorf("record imported tag history: %w", err)
+	}
+	return nil
+}
+
+// tagImportRowMember returns the member a row assigns a tag to or releases it from.
+func tagImportRowMember(row leaderboarddomain.TagImportRow) string {
+	if row.MemberID == "" {
+		return row.ReleasedFrom
+	}
+	return row.MemberID
+}
@@ -0,0 +1,279 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+func tagImportTestService(current map[string]int) (*LeaderboardService, *FakeLeaderboardRepo, *fakeTagHistoryRepo, *fakeLeagueMemberRepo) {
+	repo := NewFakeLeaderboardRepo()
+	memberRows := func(guildID string, include func(string) bool) []leaderboarddb.LeagueMember {
+		var out []leaderboarddb.LeagueMember
+		for memberID, tag := range current {
+			if include(memberID) {
+				out = append(out, leaderboarddb.LeagueMember{GuildID: guildID, MemberID: memberID, CurrentTag: &tag})
+			}
+		}
+		return out
+	}
+	members := &fakeLeagueMemberRepo{
+		getMembersByGuildFunc: func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.LeagueMember, error) {
+			return memberRows(guildID, func(string) bool { return true }), nil
+		},
+		getMembersByIDsFunc: func(ctx context.Context, db bun.IDB, guildID string, memberIDs []string) ([]leaderboarddb.LeagueMember, error) {
+			return memberRows(guildID, func(memberID string) bool { return slices.Contains(memberIDs, memberID) }), nil
+		},
+	}
+	tags := &fakeTagHistoryRepo{}
+	svc := newWriteFlowTestService(repo, members, tags, &fakeRoundOutcomeRepo{})
+	return svc, repo, tags, members
+}
+
+// memberTagWrites returns the tag each member was last written with; 0 means cleared.
+func memberTagWrites(members *fakeLeagueMemberRepo) map[string]int {
+	written := make(map[string]int)
+	for _, call := range members.bulkUpsertCalls {
+		for _, m := range call {
+			written[m.MemberID] = 0
+			if m.CurrentTag != nil {
+				written[m.MemberID] = *m.CurrentTag
+			}
+		}
+	}
+	return written
+}
+
+const datedTagImport = "date,tag_number,member_id\n" +
+	"2025-06-01,1,alice\n" +
+	"2025-06-01,2,bob\n" +
+	"2025-06-08,1,bob\n"
+
+func TestImportTagHistory_DryRun(t *testing.T) {
+	svc, _, tags, members := tagImportTestService(map[string]int{"alice": 1, "carol": 2})
+
+	result, err := svc.ImportTagHistory(context.Background(), "guild-1", "tags.csv", []byte(datedTagImport), true)
+	if err != nil || !result.IsSuccess() {
+		t.Fatalf("expected success, got %+v, %v", result, err)
+	}
+	got := *result.Success
+	if got.Applied || got.Rows != 3 || len(got.Issues) != 0 {
+		t.Fatalf("unexpected result %+v", got)
+	}
+	want := []leaderboarddomain.TagImportChange{
+		{MemberID: "alice", CurrentTag: 1},
+		{MemberID: "bob", ImportedTag: 1},
+	}
+	if len(got.Changes) != len(want) || got.Changes[0] != want[0] || got.Changes[1] != want[1] {
+		t.Fatalf("changes = %+v, want %+v", got.Changes, want)
+	}
+	if len(members.bulkUpsertCalls) != 0 || tags.bulkInsertCalls != 0 {
+		t.Fatal("dry run must not write")
+	}
+}
+
+func TestImportTagHistory_Apply(t *testing.T) {
+	svc, _, tags, members := tagImportTestService(map[string]int{"alice": 1, "carol": 2})
+
+	result, err := svc.ImportTagHistory(context.Background(), "guild-1", "tags.csv", []byte(datedTagImport), false)
+	if err != nil || !result.IsSuccess() || !result.Success.Applied {
+		t.Fatalf("expected applied import, got %+v, %v", result, err)
+	}
+	assignments := result.Success.Assignments
+	if len(assignments) != 2 || assignments[0] != (sharedtypes.TagAssignmentRequest{UserID: "alice"}) ||
+		assignments[1] != (sharedtypes.TagAssignmentRequest{UserID: "bob", TagNumber: 1}) {
+		t.Fatalf("unexpected assignments %+v", assignments)
+	}
+	if written := memberTagWrites(members); len(written) != 2 || written["alice"] != 0 || written["bob"] != 1 {
+		t.Fatalf("unexpected member writes %v", written)
+	}
+
+	// Only the file's dated rows are recorded, not the assignments made now.
+	history := tags.lastBulkInserted
+	if tags.bulkInsertCalls != 1 || len(history) != 3 {
+		t.Fatalf("expected the file's rows in tag history, got %d inserts of %+v", tags.bulkInsertCalls, history)
+	}
+	last := history[2]
+	if last.Reason != leaderboarddomain.TagImportReason || last.NewMemberID != "bob" ||
+		last.OldMemberID == nil || *last.OldMemberID != "alice" ||
+		!last.CreatedAt.Equal(time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected history entry %+v", last)
+	}
+}
+
+func TestImportTagHistory_UndatedRecordsAssignments(t *testing.T) {
+	svc, _, tags, members := tagImportTestService(nil)
+
+	result, err := svc.ImportTagHistory(context.Background(), "guild-1", "tags.csv", []byte("member_id,tag\nalice,4\n"), false)
+	if err != nil || !result.IsSuccess() || !result.Success.Applied {
+		t.Fatalf("expected applied import, got %+v, %v", result, err)
+	}
+	if written := memberTagWrites(members); len(written) != 1 || written["alice"] != 4 {
+		t.Fatalf("unexpected member writes %v", written)
+	}
+	history := tags.lastBulkInserted
+	if tags.bulkInsertCalls != 1 || len(history) != 1 || history[0].Reason != leaderboarddomain.TagImportReason || !history[0].CreatedAt.IsZero() {
+		t.Fatalf("expected only the assignment's own history, got %d inserts of %+v", tags.bulkInsertCalls, history)
+	}
+}
+
+func TestImportTagHistory_Issues(t *testing.T) {
+	svc, _, _, members := tagImportTestService(nil)
+	svc.SetGuildSettingsSource(&fakeGuildSettingsSource{settings: &GuildSettings{TagPool: leaderboarddomain.TagPoolPolicy{Model: leaderboarddomain.TagPoolCapped, Cap: 3}}})
+
+	result, err := svc.ImportTagHistory(context.Background(), "guild-1", "tags.csv", []byte("member_id,tag\nalice,4\n"), false)
+	if err != nil || !result.IsSuccess() {
+		t.Fatalf("expected success with issues, got %+v, %v", result, err)
+	}
+	if result.Success.Applied || len(result.Success.Issues) != 1 || len(members.bulkUpsertCalls) != 0 {
+		t.Fatalf("expected the capped tag to be rejected, got %+v", result.Success)
+	}
+}
+
+func TestImportTagHistory_Divisions(t *testing.T) {
+	svc, _, tags, members := tagImportTestService(map[string]int{"alice": 1})
+	guildMembers := members.getMembersByGuildFunc
+	members.getMembersByGuildFunc = func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.LeagueMember, error) {
+		rows, err := guildMembers(ctx, db, guildID)
+		if err != nil {
+			return nil, err
+		}
+		return append(rows, leaderboarddb.LeagueMember{GuildID: guildID, MemberID: "dave", DivisionID: "pro"}), nil
+	}
+	members.getMembersByIDsFunc = func(ctx context.Context, db bun.IDB, guildID string, memberIDs []string) ([]leaderboarddb.LeagueMember, error) {
+		rows, err := members.getMembersByGuildFunc(ctx, db, guildID)
+		return slices.DeleteFunc(rows, func(m leaderboarddb.LeagueMember) bool { return !slices.Contains(memberIDs, m.MemberID) }), err
+	}
+
+	// dave takes tag 1 in the pro division; alice keeps tag 1 in the default one.
+	data := "date,tag_number,member_id\n2025-06-01,1,alice\n2025-06-02,1,dave\n"
+	result, err := svc.ImportTagHistory(context.Background(), "guild-1", "tags.csv", []byte(data), false)
+	if err != nil || !result.IsSuccess() || !result.Success.Applied {
+		t.Fatalf("expected applied import, got %+v, %v", result, err)
+	}
+	want := []leaderboarddomain.TagImportChange{{MemberID: "dave", ImportedTag: 1}}
+	if len(result.Success.Changes) != 1 || result.Success.Changes[0] != want[0] {
+		t.Fatalf("changes = %+v, want %+v", result.Success.Changes, want)
+	}
+	if written := memberTagWrites(members); len(written) != 1 || written["dave"] != 1 {
+		t.Fatalf("unexpected member writes %v", written)
+	}
+
+	history := tags.lastBulkInserted
+	if len(history) != 2 || history[1].DivisionID != "pro" || history[1].OldMemberID != nil {
+		t.Fatalf("expected dave's row in the pro division without displacing alice, got %+v", history)
+	}
+}
+
+func TestImportTagHistory_Failures(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		data     string
+		want     error
+	}{
+		{name: "unknown extension", fileName: "tags.txt", data: "member_id,tag\n", want: leaderboarddomain.ErrInvalidTagImport},
+		{name: "unreadable workbook", fileName: "tags.xlsx", data: "not a workbook", want: leaderboarddomain.ErrInvalidTagImport},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _, _ := tagImportTestService(nil)
+
+			result, err := svc.ImportTagHistory(context.Background(), "guild-1", tt.fileName, []byte(tt.data), false)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.IsFailure() || !errors.Is(*result.Failure, tt.want) {
+				t.Fatalf("expected %v, got %+v", tt.want, result)
+			}
+		})
+	}
+}
+
+func TestExportLeaderboardData_TagHistoryRoundTrips(t *testing.T) {
+	svc, _, tags, _ := tagImportTestService(map[string]int{"bob": 1})
+	alice := "alice"
+	round := uuid.New()
+	tags.guildHistory = []leaderboarddb.TagHistoryEntry{
+		{ID: 1, TagNumber: 1, NewMemberID: "alice", Reason: "claim", CreatedAt: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, RoundID: &round, TagNumber: 1, OldMemberID: &alice, NewMemberID: "bob", Reason: "round_swap", CreatedAt: time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, format := range []ExportFormat{ExportFormatCSV, ExportFormatXLSX} {
+		t.Run(string(format), func(t *testing.T) {
+			result, err := svc.ExportLeaderboardData(context.Background(), "guild-1", ExportTagHistory, format, "")
+			if err != nil || !result.IsSuccess() {
+				t.Fatalf("expected success, got %+v, %v", result, err)
+			}
+			file := *result.Success
+			if file.FileName != "tag_history."+string(format) || file.ContentType != format.ContentType() {
+				t.Fatalf("unexpected file %q (%s)", file.FileName, file.ContentType)
+			}
+
+			table, err := decodeTable(format, file.Data)
+			if err != nil {
+				t.Fatalf("decode export: %v", err)
+			}
+			// Spreadsheets drop the empty trailing division cell, so only the rest is compared.
+			if len(table) != 3 || len(table[2]) < 6 || strings.Join(table[2][:6], ",") != "2025-06-08T00:00:00Z,1,alice,bob,round_swap,"+round.String() {
+				t.Fatalf("unexpected table %q", table)
+			}
+
+			// Re-importing the ledger matches current tags, so nothing changes.
+			imported, err := svc.ImportTagHistory(context.Background(), "guild-1", file.FileName, file.Data, true)
+			if err != nil || !imported.IsSuccess() || len(imported.Success.Issues) != 0 || len(imported.Success.Changes) != 0 {
+				t.Fatalf("expected a clean re-import, got %+v, %v", imported, err)
+			}
+		})
+	}
+}
+
+func TestExportLeaderboardData_Standings(t *testing.T) {
+	svc, repo, _, _ := tagImportTestService(nil)
+
+	result, err := svc.ExportLeaderboardData(context.Background(), "guild-1", ExportSeasonStandings, ExportFormatCSV, "")
+	if err != nil || !result.IsFailure() || !errors.Is(*result.Failure, ErrNoActiveSeason) {
+		t.Fatalf("expected ErrNoActiveSeason, got %+v, %v", result, err)
+	}
+
+	repo.GetActiveSeasonFunc = func(ctx context.Context, db bun.IDB, guildID string) (*leaderboarddb.Season, error) {
+		return &leaderboarddb.Season{GuildID: guildID, ID: "2026-spring", IsActive: true}, nil
+	}
+	repo.GetSeasonStandingsBySeasonIDFunc = func(ctx context.Context, db bun.IDB, guildID, seasonID string) ([]leaderboarddb.SeasonStanding, error) {
+		return []leaderboarddb.SeasonStanding{{SeasonID: seasonID, MemberID: "alice", TotalPoints: 120, RoundsPlayed: 4, CurrentTier: "Gold", SeasonBestTag: 2}}, nil
+	}
+	result, err = svc.ExportLeaderboardData(context.Background(), "guild-1", ExportSeasonStandings, ExportFormatCSV, "")
+	if err != nil || !result.IsSuccess() {
+		t.Fatalf("expected success, got %+v, %v", result, err)
+	}
+	want := "season_id,member_id,total_points,rounds_played,current_tier,season_best_tag\n2026-spring,alice,120,4,Gold,2\n"
+	if string(result.Success.Data) != want {
+		t.Fatalf("export = %q, want %q", result.Success.Data, want)
+	}
+}
+
+func TestExportLeaderboardData_InvalidRequest(t *testing.T) {
+	svc, _, _, _ := tagImportTestService(nil)
+
+	for _, tc := range []struct {
+		dataset ExportDataset
+		format  ExportFormat
+	}{
+		{ExportMembers, "pdf"},
+		{"rounds", ExportFormatCSV},
+	} {
+		result, err := svc.ExportLeaderboardData(context.Background(), "guild-1", tc.dataset, tc.format, "")
+		if err != nil || !result.IsFailure() || !errors.Is(*result.Failure, ErrInvalidExportRequest) {
+			t.Fatalf("%s/%s: expected ErrInvalidExportRequest, got %+v, %v", tc.dataset, tc.format, result, err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+)
+
+// GuildSettings are the guild config settings the leaderboard depends on. The guild
+// module owns them.
+type GuildSettings struct {
+	TagPool                   leaderboarddomain.TagPoolPolicy
+	CustomLeaderboardsEnabled bool
+}
+
+// GuildSettingsSource reads and updates a guild's settings through the guild module.
+// Guilds without an active config return nil settings, and ErrGuildConfigNotFound
+// when updated.
+type GuildSettingsSource interface {
+	GetGuildSettings(ctx context.Context, guildID sharedtypes.GuildID) (*GuildSettings, error)
+	UpdateTagPoolPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.TagPoolPolicy) error
+}
+
+// SetGuildSettingsSource wires the guild lookup used for the tag pool model and the
+// custom leaderboards switch. Without it, guilds use the closed pool and cannot
+// create divisions.
+func (s *LeaderboardService) SetGuildSettingsSource(source GuildSettingsSource) {
+	s.guildSettings = source
+}
+
+// GetTagPoolPolicy returns the tag pool model stored on a guild's config. Guilds
+// without a config use the closed pool.
+func (s *LeaderboardService) GetTagPoolPolicy(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error) {
+	return withTelemetry(s, ctx, "GetTagPoolPolicy", guildID, func(ctx context.Context) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error) {
+		policy, err := s.loadTagPoolPolicy(ctx, string(guildID))
+		if err != nil {
+			return results.OperationResult[leaderboarddomain.TagPoolPolicy, error]{}, err
+		}
+		return results.SuccessResult[leaderboarddomain.TagPoolPolicy, error](policy), nil
+	})
+}
+
+// SetTagPoolPolicy stores the tag pool model on a guild's config. It applies to rounds
+// processed from then on; earlier tag changes are left as they were.
+func (s *LeaderboardService) SetTagPoolPolicy(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	policy leaderboarddomain.TagPoolPolicy,
+) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error) {
+	return withTelemetry(s, ctx, "SetTagPoolPolicy", guildID, func(ctx context.Context) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error) {
+		if err := policy.Validate(); err != nil {
+			return results.FailureResult[leaderboarddomain.TagPoolPolicy](err), nil
+		}
+		if s.guildSettings == nil {
+			return results.OperationResult[leaderboarddomain.TagPoolPolicy, error]{}, errors.New("failed to save tag pool policy: guild settings source not configured")
+		}
+		if err := s.guildSettings.UpdateTagPoolPolicy(ctx, guildID, policy); err != nil {
+			if errors.Is(err, ErrGuildConfigNotFound) {
+				return results.FailureResult[leaderboarddomain.TagPoolPolicy](ErrGuildConfigNotFound), nil
+			}
+			return results.OperationResult[leaderboarddomain.TagPoolPolicy, error]{}, fmt.Errorf("failed to save tag pool policy: %w", err)
+		}
+		return results.SuccessResult[leaderboarddomain.TagPoolPolicy, error](policy), nil
+	})
+}
+
+// loadGuildSettings reads a guild's settings, returning nil when the guild has no
+// config or no source is wired.
+func (s *LeaderboardService) loadGuildSettings(ctx context.Context, guildID string) (*GuildSettings, error) {
+	if s.guildSettings == nil {
+		return nil, nil
+	}
+	settings, err := s.guildSettings.GetGuildSettings(ctx, sharedtypes.GuildID(guildID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	return settings, nil
+}
+
+// loadTagPoolPolicy reads a guild's tag pool policy, defaulting to the closed pool.
+func (s *LeaderboardService) loadTagPoolPolicy(ctx context.Context, guildID string) (leaderboarddomain.TagPoolPolicy, error) {
+	settings, err := s.loadGuildSettings(ctx, guildID)
+	if err != nil {
+		return leaderboarddomain.TagPoolPolicy{}, err
+	}
+	if settings == nil || settings.TagPool.Model == "" {
+		return leaderboarddomain.DefaultTagPoolPolicy(), nil
+	}
+	return settings.TagPool, nil
+}
@@ -0,0 +1,150 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type fakeGuildSettingsSource struct {
+	settings  *GuildSettings
+	updateErr error
+	reads     int
+	updated   []leaderboarddomain.TagPoolPolicy
+}
+
+func (f *fakeGuildSettingsSource) GetGuildSettings(ctx context.Context, guildID sharedtypes.GuildID) (*GuildSettings, error) {
+	f.reads++
+	return f.settings, nil
+}
+
+func (f *fakeGuildSettingsSource) UpdateTagPoolPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.TagPoolPolicy) error {
+	if f.updateErr != nil {
+		return f.updateErr
+	}
+	f.updated = append(f.updated, policy)
+	return nil
+}
+
+func TestProcessRoundInTx_OpenPoolReleasesDisplacedHolder(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	members := &fakeLeagueMemberRepo{
+		getMembersByIDsFunc: func(ctx context.Context, db bun.IDB, guildID string, memberIDs []string) ([]leaderboarddb.LeagueMember, error) {
+			tag := 1
+			return []leaderboarddb.LeagueMember{{GuildID: guildID, MemberID: "holder", CurrentTag: &tag}}, nil
+		},
+	}
+	tags := &fakeTagHistoryRepo{}
+	svc := newWriteFlowTestService(repo, members, tags, &fakeRoundOutcomeRepo{})
+	svc.SetGuildSettingsSource(&fakeGuildSettingsSource{settings: &GuildSettings{TagPool: leaderboarddomain.TagPoolPolicy{Model: leaderboarddomain.TagPoolOpen}}})
+
+	out, err := svc.processRoundInTx(context.Background(), bun.Tx{}, ProcessRoundCommand{
+		GuildID: "guild-1",
+		RoundID: uuid.New(),
+		Participants: []RoundParticipantInput{
+			{MemberID: "newcomer", FinishRank: 1},
+			{MemberID: "holder", FinishRank: 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("processRoundInTx returned error: %v", err)
+	}
+
+	if len(out.FinalParticipantTags) != 1 || out.FinalParticipantTags["newcomer"] != 1 {
+		t.Fatalf("expected newcomer alone to hold tag 1, got %+v", out.FinalParticipantTags)
+	}
+	for _, call := range members.bulkUpsertCalls {
+		for _, m := range call {
+			if m.MemberID == "holder" && m.CurrentTag != nil {
+				t.Fatalf("displaced holder was written with tag %d", *m.CurrentTag)
+			}
+		}
+	}
+	if len(tags.lastBulkInserted) != 1 || tags.lastBulkInserted[0].OldMemberID == nil || *tags.lastBulkInserted[0].OldMemberID != "holder" {
+		t.Fatalf("expected one history entry taking tag 1 from holder, got %+v", tags.lastBulkInserted)
+	}
+}
+
+func TestProcessRoundInTx_DefaultsToClosedPool(t *testing.T) {
+	repo := NewFakeLeaderboardRepo()
+	members := &fakeLeagueMemberRepo{
+		getMembersByIDsFunc: func(ctx context.Context, db bun.IDB, guildID string, memberIDs []string) ([]leaderboarddb.LeagueMember, error) {
+			tag := 1
+			return []leaderboarddb.LeagueMember{{GuildID: guildID, MemberID: "holder", CurrentTag: &tag}}, nil
+		},
+	}
+	settings := &fakeGuildSettingsSource{}
+	svc := newWriteFlowTestService(repo, members, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+	svc.SetGuildSettingsSource(settings)
+
+	out, err := svc.processRoundInTx(context.Background(), bun.Tx{}, ProcessRoundCommand{
+		GuildID: "guild-1",
+		RoundID: uuid.New(),
+		Participants: []RoundParticipantInput{
+			{MemberID: "newcomer", FinishRank: 1},
+			{MemberID: "holder", FinishRank: 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("processRoundInTx returned error: %v", err)
+	}
+	if len(out.TagChanges) != 0 || out.FinalParticipantTags["holder"] != 1 {
+		t.Fatalf("closed pool should ignore untagged finishers, got %+v", out)
+	}
+	if settings.reads != 1 {
+		t.Fatal("expected the guild's tag pool config to be read")
+	}
+}
+
+func TestSetTagPoolPolicy(t *testing.T) {
+	t.Run("rejects invalid policy", func(t *testing.T) {
+		settings := &fakeGuildSettingsSource{}
+		svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+		svc.SetGuildSettingsSource(settings)
+
+		res, err := svc.SetTagPoolPolicy(context.Background(), "guild-1", leaderboarddomain.TagPoolPolicy{Model: leaderboarddomain.TagPoolCapped})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Failure == nil || !errors.Is(*res.Failure, leaderboarddomain.ErrInvalidTagPoolPolicy) {
+			t.Fatalf("expected invalid policy failure, got %+v", res)
+		}
+		if len(settings.updated) != 0 {
+			t.Fatal("an invalid policy must not be saved")
+		}
+	})
+
+	t.Run("reports missing guild config", func(t *testing.T) {
+		svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+		svc.SetGuildSettingsSource(&fakeGuildSettingsSource{updateErr: ErrGuildConfigNotFound})
+
+		res, err := svc.SetTagPoolPolicy(context.Background(), "guild-1", leaderboarddomain.TagPoolPolicy{Model: leaderboarddomain.TagPoolOpen})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrGuildConfigNotFound) {
+			t.Fatalf("expected guild config not found, got %+v", res)
+		}
+	})
+
+	t.Run("saves through the guild settings source", func(t *testing.T) {
+		settings := &fakeGuildSettingsSource{}
+		svc := newWriteFlowTestService(NewFakeLeaderboardRepo(), &fakeLeagueMemberRepo{}, &fakeTagHistoryRepo{}, &fakeRoundOutcomeRepo{})
+		svc.SetGuildSettingsSource(settings)
+
+		policy := leaderboarddomain.TagPoolPolicy{Model: leaderboarddomain.TagPoolCapped, Cap: 4}
+		res, err := svc.SetTagPoolPolicy(context.Background(), "guild-1", policy)
+		if err != nil || !res.IsSuccess() {
+			t.Fatalf("expected success, got %+v, %v", res, err)
+		}
+		if len(settings.updated) != 1 || settings.updated[0] != policy {
+			t.Fatalf("expected the policy to be saved once, got %+v", settings.updated)
+		}
+	})
+}
@@ -32,19 +32,26 @@ func (s *LeaderboardService) TagSwapRequested(
 		}
 		requestorTag := *requestorTagResult.Success
 
-		leaderboardResult, err := s.GetLeaderboard(ctx, guildID, "")
+		taggedMembers, err := s.commandPipeline.GetTaggedMembers(ctx, string(guildID), nil)
 		if err != nil {
 			return results.OperationResult[leaderboardtypes.LeaderboardData, error]{}, err
 		}
-		if leaderboardResult.IsFailure() {
-			return results.FailureResult[leaderboardtypes.LeaderboardData](fmt.Errorf("leaderboard unavailable")), nil
+
+		// Tag numbers repeat across divisions, so the target is looked up in the
+		// requestor's division only.
+		requestorDivision := ""
+		for _, member := range taggedMembers {
+			if member.MemberID == string(userID) {
+				requestorDivision = member.DivisionID
+				break
+			}
 		}
 
 		var targetUserID sharedtypes.DiscordID
 		targetFound := false
-		for _, entry := range *leaderboardResult.Success {
-			if entry.TagNumber == targetTag {
-				targetUserID = entry.UserID
+		for _, member := range taggedMembers {
+			if member.DivisionID == requestorDivision && sharedtypes.TagNumber(member.Tag) == targetTag {
+				targetUserID = sharedtypes.DiscordID(member.MemberID)
 				targetFound = true
 				break
 			}
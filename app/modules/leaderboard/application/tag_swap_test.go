@@ -71,6 +71,37 @@ func TestLeaderboardService_TagSwapRequested(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Target is looked up in the requestor's division",
+			setupPipeline: func(p *FakeCommandPipeline) {
+				p.GetMemberTagFunc = func(ctx context.Context, guildID, memberID string) (int, bool, error) {
+					return 1, true, nil
+				}
+				p.GetTaggedMembersFunc = func(ctx context.Context, guildID string, clubUUID *string) ([]TaggedMemberView, error) {
+					return []TaggedMemberView{
+						{MemberID: "default-holder", Tag: 2},
+						{MemberID: string(requestorID), Tag: 1, DivisionID: "pro"},
+						{MemberID: string(targetID), Tag: 2, DivisionID: "pro"},
+					}, nil
+				}
+				p.ApplyTagsFunc = func(ctx context.Context, guildID string, requests []sharedtypes.TagAssignmentRequest, source sharedtypes.ServiceUpdateSource, updateID sharedtypes.RoundID) (leaderboardtypes.LeaderboardData, error) {
+					for _, req := range requests {
+						if req.UserID == "default-holder" {
+							t.Fatalf("swap reached into another division: %+v", requests)
+						}
+					}
+					return leaderboardtypes.LeaderboardData{}, nil
+				}
+			},
+			userID:    requestorID,
+			targetTag: 2,
+			expectErr: false,
+			verify: func(t *testing.T, res results.OperationResult[leaderboardtypes.LeaderboardData, error], err error) {
+				if !res.IsSuccess() {
+					t.Fatalf("expected success, got failure: %v", res.Failure)
+				}
+			},
+		},
 		{
 			name: "Cannot swap tag with self",
 			setupPipeline: func(p *FakeCommandPipeline) {
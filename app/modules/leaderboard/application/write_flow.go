@@ -3,6 +3,7 @@ package leaderboardservice
 import (
 	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"maps"
@@ -22,12 +23,25 @@ type ProcessRoundCommand struct {
 	GuildID      string
 	RoundID      uuid.UUID
 	Participants []RoundParticipantInput
+	// StartTime assigns the round to the season it started in; zero means the active season.
+	StartTime time.Time
 }
 
 // RoundParticipantInput represents a single participant's finish data.
 type RoundParticipantInput struct {
 	MemberID   string
 	FinishRank int // 1-based finish position
+	// TagRank overrides FinishRank for closed-pool tag allocation. It is set for net
+	// rounds whose guild reallocates tags by gross order; 0 means use FinishRank.
+	TagRank int
+}
+
+// tagRank returns the rank used for tag allocation.
+func (p RoundParticipantInput) tagRank() int {
+	if p.TagRank > 0 {
+		return p.TagRank
+	}
+	return p.FinishRank
 }
 
 // ProcessRoundOutput represents the result of round processing.
@@ -42,15 +56,17 @@ type ProcessRoundOutput struct {
 
 // TaggedMemberView is a normalized read model for current guild tag state.
 type TaggedMemberView struct {
-	MemberID string
-	Tag      int
+	MemberID   string
+	Tag        int
+	DivisionID string // "" = default division
 }
 
 // MemberTagView is similar to TaggedMemberView, but explicitly supports nullable tags.
 // Used by admin interfaces (like PWA) to identify members who hold no tag.
 type MemberTagView struct {
-	MemberID string
-	Tag      *int
+	MemberID   string
+	Tag        *int
+	DivisionID string // "" = default division
 }
 
 const RecalculationWindow = 24 * time.Hour
@@ -68,7 +84,7 @@ const ChartHistoryLimit = 20
 //  1. Acquire guild advisory lock
 //  2. Compute processing hash + idempotency check
 //  3. Load current tag state from league_members
-//  4. Stream 1: Tag allocation (closed pool)
+//  4. Stream 1: Tag allocation (guild's tag pool model)
 //  5. Persist tag changes to league_members + tag_history
 //  6. Stream 2: Points calculation (if active season)
 //  7. Persist points to season_standings + point_history
@@ -102,6 +118,8 @@ func (s *LeaderboardService) processRoundCommandCore(ctx context.Context, cmd Pr
 	if err != nil {
 		return nil, fmt.Errorf("LeaderboardService.ProcessRound: %w", err)
 	}
+	// Points, ranks and streaks may have moved for anyone in the guild.
+	s.forgetMemberStats(cmd.GuildID)
 	return output, nil
 }
 
@@ -126,6 +144,7 @@ func (s *LeaderboardService) processRoundInTx(ctx context.Context, tx bun.Tx, cm
 		hashInputs[i] = leaderboarddomain.RoundInput{
 			MemberID:   p.MemberID,
 			FinishRank: p.FinishRank,
+			TagRank:    p.TagRank,
 		}
 	}
 	processingHash := leaderboarddomain.ComputeProcessingHash(hashInputs)
@@ -205,25 +224,39 @@ func (s *LeaderboardService) processRoundInTx(ctx context.Context, tx bun.Tx, cm
 	}
 
 	memberTagMap := make(map[string]int, len(members))
+	divisionByMember := make(map[string]string, len(members))
 	for _, m := range members {
 		if m.CurrentTag != nil {
 			memberTagMap[m.MemberID] = *m.CurrentTag
 		}
+		if m.DivisionID != leaderboarddomain.DefaultDivisionID {
+			divisionByMember[m.MemberID] = m.DivisionID
+		}
+	}
+	// The stored inputs carry each participant's division so a replay reallocates
+	// tags within the divisions the round was played in.
+	for i := range hashInputs {
+		hashInputs[i].DivisionID = divisionByMember[hashInputs[i].MemberID]
+	}
+
+	// 4. Stream 1: Tag allocation under the guild's tag pool model, within each division
+	tagPolicy, err := s.loadTagPoolPolicy(ctx, cmd.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("load tag pool policy: %w", err)
 	}
 
-	// 4. Stream 1: Tag allocation (closed pool)
 	tagInputs := make([]leaderboarddomain.TagAllocationInput, len(cmd.Participants))
 	for i, p := range cmd.Participants {
 		tagInputs[i] = leaderboarddomain.TagAllocationInput{
 			MemberID:   p.MemberID,
-			FinishRank: p.FinishRank,
+			FinishRank: p.tagRank(),
 			CurrentTag: memberTagMap[p.MemberID], // 0 if no tag
 		}
 	}
 
 	// SAFETY: We checked RecalculationWindow above, so we allow tag allocation to correct
 	// tags if the finish order changed.
-	tagChanges := leaderboarddomain.AllocateTagsClosedPool(tagInputs)
+	tagChanges := leaderboarddomain.AllocateWithinDivisions(tagPolicy.Allocator(), divisionByMember)(tagInputs)
 
 	// Build lookups from MemberID → FinishRank and pre-round tag for logging.
 	preTagByMember := make(map[string]int, len(tagInputs))
@@ -247,7 +280,7 @@ func (s *LeaderboardService) processRoundInTx(ctx context.Context, tx bun.Tx, cm
 
 	// 5. Persist tag changes
 	if len(tagChanges) > 0 {
-		if err := s.persistTagChanges(ctx, tx, cmd.GuildID, &cmd.RoundID, tagChanges, "round_swap"); err != nil {
+		if err := s.persistTagChanges(ctx, tx, cmd.GuildID, &cmd.RoundID, tagChanges, divisionByMember, "round_swap"); err != nil {
 			return nil, fmt.Errorf("persist tag changes: %w", err)
 		}
 	}
@@ -257,12 +290,12 @@ func (s *LeaderboardService) processRoundInTx(ctx context.Context, tx bun.Tx, cm
 		return nil, fmt.Errorf("ensure participant members: %w", err)
 	}
 
-	// 6. Resolve active season
+	// 6. Resolve season
 	rollbackSeasonID := ""
 	if existing != nil && existing.SeasonID != nil {
 		rollbackSeasonID = *existing.SeasonID
 	}
-	season, err := s.resolveActiveSeason(ctx, tx, cmd.GuildID, rollbackSeasonID)
+	season, err := s.resolveSeason(ctx, tx, cmd.GuildID, rollbackSeasonID, cmd.StartTime)
 	if err != nil {
 		return nil, fmt.Errorf("resolve season: %w", err)
 	}
@@ -287,7 +320,7 @@ func (s *LeaderboardService) processRoundInTx(ctx context.Context, tx bun.Tx, cm
 		)
 	} else {
 		output.SeasonID = season.SeasonID
-		awards, err := s.calculateAndPersistPoints(ctx, tx, cmd, memberTagMap, tagChanges, season.SeasonID)
+		awards, err := s.calculateAndPersistPoints(ctx, tx, cmd, memberTagMap, divisionByMember, tagChanges, season.SeasonID)
 		if err != nil {
 			return nil, fmt.Errorf("calculate and persist points: %w", err)
 		}
@@ -321,6 +354,8 @@ func (s *LeaderboardService) processRoundInTx(ctx context.Context, tx bun.Tx, cm
 		SeasonID:       seasonIDPtr,
 		ProcessingHash: processingHash,
 		ProcessedAt:    time.Now().UTC(),
+		Participants:   hashInputs,
+		TagPool:        &tagPolicy,
 	}); err != nil {
 		return nil, fmt.Errorf("record round outcome: %w", err)
 	}
@@ -329,22 +364,33 @@ func (s *LeaderboardService) processRoundInTx(ctx context.Context, tx bun.Tx, cm
 }
 
 // persistTagChanges updates league_members and writes tag_history entries.
+// divisionOf maps member IDs to divisions; members it does not name are in the
+// default division.
 func (s *LeaderboardService) persistTagChanges(
 	ctx context.Context,
 	tx bun.Tx,
 	guildID string,
 	roundID *uuid.UUID,
 	changes []leaderboarddomain.TagChange,
+	divisionOf map[string]string,
 	reason string,
 ) error {
-	// 1. Clear tags for all members receiving a new tag to prevent unique constraint violations
-	//    during swaps (e.g. A->2, B->1 where A has 1, B has 2 initially).
-	membersToClear := make([]leaderboarddb.LeagueMember, len(changes))
-	for i, ch := range changes {
-		membersToClear[i] = leaderboarddb.LeagueMember{
-			GuildID:    guildID,
-			MemberID:   ch.NewMemberID,
-			CurrentTag: nil,
+	// 1. Clear tags for all members receiving or giving up a tag to prevent unique constraint
+	//    violations during swaps (e.g. A->2, B->1 where A has 1, B has 2 initially).
+	//    Previous holders who receive no new tag stay cleared.
+	membersToClear := make([]leaderboarddb.LeagueMember, 0, len(changes))
+	cleared := make(map[string]struct{}, len(changes))
+	for _, ch := range changes {
+		for _, memberID := range []string{ch.NewMemberID, ch.OldMemberID} {
+			if _, seen := cleared[memberID]; seen || memberID == "" {
+				continue
+			}
+			cleared[memberID] = struct{}{}
+			membersToClear = append(membersToClear, leaderboarddb.LeagueMember{
+				GuildID:    guildID,
+				MemberID:   memberID,
+				CurrentTag: nil,
+			})
 		}
 	}
 	if err := s.memberRepo.BulkUpsertMembers(ctx, tx, membersToClear); err != nil {
@@ -379,6 +425,7 @@ func (s *LeaderboardService) persistTagChanges(
 			GuildID:     guildID,
 			RoundID:     roundID,
 			TagNumber:   ch.TagNumber,
+			DivisionID:  divisionOf[ch.NewMemberID],
 			OldMemberID: oldMember,
 			NewMemberID: ch.NewMemberID,
 			Reason:      reason,
@@ -400,15 +447,18 @@ func (s *LeaderboardService) ensureParticipantMembers(
 	existingTags map[string]int,
 	tagChanges []leaderboarddomain.TagChange,
 ) error {
-	// Build map of new tags from changes
-	changedTags := make(map[string]int, len(tagChanges))
+	// Build set of members whose tags changed, including holders who gave one up
+	changedMembers := make(map[string]struct{}, len(tagChanges))
 	for _, ch := range tagChanges {
-		changedTags[ch.NewMemberID] = ch.TagNumber
+		changedMembers[ch.NewMemberID] = struct{}{}
+		if ch.OldMemberID != "" {
+			changedMembers[ch.OldMemberID] = struct{}{}
+		}
 	}
 
 	var toUpsert []leaderboarddb.LeagueMember
 	for _, p := range participants {
-		if _, changed := changedTags[p.MemberID]; changed {
+		if _, changed := changedMembers[p.MemberID]; changed {
 			continue // already handled in persistTagChanges
 		}
 		// Ensure the member exists even if no tag change
@@ -430,20 +480,50 @@ func (s *LeaderboardService) ensureParticipantMembers(
 	return nil
 }
 
-func (s *LeaderboardService) resolveActiveSeason(ctx context.Context, tx bun.Tx, guildID string, rollbackSeasonID string) (leaderboarddomain.ResolvedSeason, error) {
-	season, err := s.repo.GetActiveSeason(ctx, tx, guildID)
+func (s *LeaderboardService) resolveSeason(ctx context.Context, tx bun.Tx, guildID string, rollbackSeasonID string, roundStart time.Time) (leaderboarddomain.ResolvedSeason, error) {
+	if rollbackSeasonID != "" {
+		return leaderboarddomain.ResolveSeasonForRound(rollbackSeasonID, nil, roundStart), nil
+	}
+	if roundStart.IsZero() {
+		season, err := s.repo.GetActiveSeason(ctx, tx, guildID)
+		if err != nil {
+			return leaderboarddomain.ResolvedSeason{}, err
+		}
+		var states []leaderboarddomain.SeasonState
+		if season != nil {
+			states = append(states, toSeasonState(*season))
+		}
+		return leaderboarddomain.ResolveSeasonForRound("", states, roundStart), nil
+	}
+
+	seasons, err := s.repo.ListSeasons(ctx, tx, guildID)
 	if err != nil {
 		return leaderboarddomain.ResolvedSeason{}, err
 	}
-	var state *leaderboarddomain.SeasonState
-	if season != nil {
-		state = &leaderboarddomain.SeasonState{
-			GuildID:  season.GuildID,
-			SeasonID: season.ID,
-			IsActive: season.IsActive,
-		}
+	states := make([]leaderboarddomain.SeasonState, len(seasons))
+	for i, season := range seasons {
+		states[i] = toSeasonState(season)
 	}
-	return leaderboarddomain.ResolveSeasonForRound(rollbackSeasonID, state), nil
+	return leaderboarddomain.ResolveSeasonForRound("", states, roundStart), nil
+}
+
+// toSeasonState converts a season row to its domain state; zero dates become nil.
+func toSeasonState(season leaderboarddb.Season) leaderboarddomain.SeasonState {
+	state := leaderboarddomain.SeasonState{
+		GuildID:   season.GuildID,
+		SeasonID:  season.ID,
+		IsActive:  season.IsActive,
+		Scheduled: season.Scheduled,
+	}
+	if !season.StartDate.IsZero() {
+		start := season.StartDate
+		state.StartDate = &start
+	}
+	if !season.EndDate.IsZero() {
+		end := season.EndDate
+		state.EndDate = &end
+	}
+	return state
 }
 
 func (s *LeaderboardService) calculateAndPersistPoints(
@@ -451,6 +531,7 @@ func (s *LeaderboardService) calculateAndPersistPoints(
 	tx bun.Tx,
 	cmd ProcessRoundCommand,
 	existingTags map[string]int,
+	divisionByMember map[string]string,
 	tagChanges []leaderboarddomain.TagChange,
 	seasonID string,
 ) ([]leaderboarddomain.PointAward, error) {
@@ -492,6 +573,13 @@ func (s *LeaderboardService) calculateAndPersistPoints(
 		return nil, fmt.Errorf("fetch season standings: %w", err)
 	}
 
+	// Score under the season's own policy so recalculated rounds match how they were
+	// originally scored, even after a newer season changed the rules.
+	policy, err := s.seasonPointsPolicy(ctx, tx, cmd.GuildID, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("load season points policy: %w", err)
+	}
+
 	// Build RoundParticipant slice for domain calculation
 	participants := make([]leaderboarddomain.RoundParticipant, len(eligibleParticipants))
 	for i, p := range eligibleParticipants {
@@ -511,24 +599,31 @@ func (s *LeaderboardService) calculateAndPersistPoints(
 			FinishRank:   p.FinishRank,
 			RoundsPlayed: roundsPlayed,
 			BestTag:      bestTag,
-			CurrentTier:  leaderboarddomain.DetermineTier(bestTag, totalMembers),
+			CurrentTier:  policy.DetermineTier(bestTag, totalMembers),
 		}
 	}
 
-	// Calculate points using domain logic
-	awards := leaderboarddomain.CalculateRoundPoints(participants)
+	// Calculate points using domain logic. Members only earn points against opponents
+	// in their own division, since tag numbers are not comparable across divisions.
+	var awards []leaderboarddomain.PointAward
+	for _, group := range leaderboarddomain.GroupByDivision(participants, func(p leaderboarddomain.RoundParticipant) string {
+		return divisionByMember[p.MemberID]
+	}) {
+		awards = append(awards, policy.CalculateRoundPoints(group)...)
+	}
 
 	// Persist point history
 	histories := make([]*leaderboarddb.PointHistory, len(awards))
 	for i, award := range awards {
 		histories[i] = &leaderboarddb.PointHistory{
-			SeasonID:  seasonID,
-			MemberID:  sharedtypes.DiscordID(award.MemberID),
-			RoundID:   sharedtypes.RoundID(cmd.RoundID),
-			Points:    award.Points,
-			Reason:    "Round Matchups",
-			Tier:      string(award.Tier),
-			Opponents: award.OpponentsBeaten,
+			SeasonID:   seasonID,
+			MemberID:   sharedtypes.DiscordID(award.MemberID),
+			RoundID:    sharedtypes.RoundID(cmd.RoundID),
+			Points:     award.Points,
+			Reason:     "Round Matchups",
+			Tier:       string(award.Tier),
+			Opponents:  award.OpponentsBeaten,
+			GiantSlays: award.GiantSlays,
 		}
 	}
 
@@ -570,6 +665,16 @@ func (s *LeaderboardService) calculateAndPersistPoints(
 	return awards, nil
 }
 
+// seasonPointsPolicy returns the points policy a season is scored under, falling back
+// to the default for seasons created before policies were persisted.
+func (s *LeaderboardService) seasonPointsPolicy(ctx context.Context, db bun.IDB, guildID, seasonID string) (leaderboarddomain.PointsPolicy, error) {
+	season, err := s.repo.GetSeasonByID(ctx, db, guildID, seasonID)
+	if err != nil {
+		return leaderboarddomain.PointsPolicy{}, err
+	}
+	return seasonPolicy(season), nil
+}
+
 func (s *LeaderboardService) rollbackPreviousRound(ctx context.Context, tx bun.Tx, guildID string, roundID uuid.UUID) error {
 	// Fetch previous point history for this round
 	history, err := s.repo.GetPointHistoryForRound(ctx, tx, guildID, sharedtypes.RoundID(roundID))
@@ -627,8 +732,17 @@ func (s *LeaderboardService) resetTagsCore(ctx context.Context, guildID string,
 		// Allocate new tags
 		changes = leaderboarddomain.AllocateTagsFromReset(finishOrder)
 
+		members, err := s.memberRepo.GetMembersByIDs(ctx, tx, guildID, finishOrder)
+		if err != nil {
+			return fmt.Errorf("load reset members: %w", err)
+		}
+		divisionByMember := make(map[string]string, len(members))
+		for _, m := range members {
+			divisionByMember[m.MemberID] = m.DivisionID
+		}
+
 		// Persist
-		if err := s.persistTagChanges(ctx, tx, guildID, nil, changes, "reset"); err != nil {
+		if err := s.persistTagChanges(ctx, tx, guildID, nil, changes, divisionByMember, "reset"); err != nil {
 			return fmt.Errorf("persist reset tag changes: %w", err)
 		}
 
@@ -641,8 +755,8 @@ func (s *LeaderboardService) resetTagsCore(ctx context.Context, guildID string,
 	return changes, nil
 }
 
-// StartSeason creates a new season, deactivating any existing active season.
-func (s *LeaderboardService) startSeasonCore(ctx context.Context, guildID, seasonID, seasonName string) error {
+// StartSeason creates a new season scored under policy, archiving and closing the active season.
+func (s *LeaderboardService) startSeasonCore(ctx context.Context, guildID, seasonID, seasonName string, policy leaderboarddomain.PointsPolicy) error {
 	if msg := leaderboarddomain.ValidateSeasonStart(seasonID, seasonName); msg != "" {
 		return fmt.Errorf("validation: %s", msg)
 	}
@@ -652,16 +766,23 @@ func (s *LeaderboardService) startSeasonCore(ctx context.Context, guildID, seaso
 			return err
 		}
 
-		if err := s.repo.DeactivateAllSeasons(ctx, tx, guildID); err != nil {
-			return fmt.Errorf("deactivate seasons: %w", err)
+		now := time.Now().UTC()
+		active, err := s.repo.GetActiveSeason(ctx, tx, guildID)
+		if err != nil {
+			return fmt.Errorf("get active season: %w", err)
+		}
+		if active != nil {
+			if err := s.closeSeasonInTx(ctx, tx, guildID, active, now); err != nil {
+				return fmt.Errorf("close active season: %w", err)
+			}
 		}
 
-		now := time.Now().UTC()
 		return s.repo.CreateSeason(ctx, tx, guildID, &leaderboarddb.Season{
-			ID:        seasonID,
-			Name:      seasonName,
-			IsActive:  true,
-			StartDate: now,
+			ID:           seasonID,
+			Name:         seasonName,
+			IsActive:     true,
+			StartDate:    now,
+			PointsPolicy: &policy,
 		})
 	})
 
@@ -671,13 +792,14 @@ func (s *LeaderboardService) startSeasonCore(ctx context.Context, guildID, seaso
 	return nil
 }
 
-// EndSeason deactivates the current active season for a guild.
+// EndSeason archives the final standings and awards of the active season, then closes it.
+// It does nothing when the guild has no active season.
 func (s *LeaderboardService) endSeasonCore(ctx context.Context, guildID string) error {
 	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
-		if err := s.memberRepo.AcquireGuildLock(ctx, tx, guildID); err != nil {
+		if err := s.endSeasonInTx(ctx, tx, guildID); err != nil && !errors.Is(err, ErrNoActiveSeason) {
 			return err
 		}
-		return s.repo.DeactivateAllSeasons(ctx, tx, guildID)
+		return nil
 	})
 
 	if err != nil {
@@ -686,6 +808,32 @@ func (s *LeaderboardService) endSeasonCore(ctx context.Context, guildID string)
 	return nil
 }
 
+func (s *LeaderboardService) endSeasonInTx(ctx context.Context, db bun.IDB, guildID string) error {
+	if err := s.memberRepo.AcquireGuildLock(ctx, db, guildID); err != nil {
+		return err
+	}
+	season, err := s.repo.GetActiveSeason(ctx, db, guildID)
+	if err != nil {
+		return err
+	}
+	if season == nil {
+		return ErrNoActiveSeason
+	}
+	return s.closeSeasonInTx(ctx, db, guildID, season, time.Now().UTC())
+}
+
+// closeSeasonInTx archives a season's final standings and awards, applies the guild's
+// promotion policy, then closes the season as of endedAt.
+func (s *LeaderboardService) closeSeasonInTx(ctx context.Context, db bun.IDB, guildID string, season *leaderboarddb.Season, endedAt time.Time) error {
+	if err := s.archiveSeason(ctx, db, guildID, season, endedAt); err != nil {
+		return err
+	}
+	if err := s.applyPromotionsInTx(ctx, db, guildID, season.ID); err != nil {
+		return err
+	}
+	return s.repo.CloseSeason(ctx, db, guildID, season.ID, endedAt)
+}
+
 // GetMemberTag returns a member's current normalized tag for a guild.
 func (s *LeaderboardService) getMemberTagCore(ctx context.Context, guildID, memberID string) (int, bool, error) {
 	member, err := s.memberRepo.GetMemberByID(ctx, s.db, guildID, memberID)
@@ -704,7 +852,17 @@ func (s *LeaderboardService) checkTagAvailabilityCore(ctx context.Context, guild
 		return false, "tag_number_must_be_positive", nil
 	}
 
-	holder, err := s.memberRepo.GetMemberByTag(ctx, s.db, guildID, tagNumber)
+	// Tags are only taken within the member's own division.
+	member, err := s.memberRepo.GetMemberByID(ctx, s.db, guildID, memberID)
+	if err != nil {
+		return false, "", fmt.Errorf("LeaderboardService.CheckTagAvailability: %w", err)
+	}
+	divisionID := leaderboarddomain.DefaultDivisionID
+	if member != nil {
+		divisionID = member.DivisionID
+	}
+
+	holder, err := s.memberRepo.GetMemberByTag(ctx, s.db, guildID, divisionID, tagNumber)
 	if err != nil {
 		return false, "", fmt.Errorf("LeaderboardService.CheckTagAvailability: %w", err)
 	}
@@ -747,14 +905,43 @@ type tagAssignment struct {
 	tag      int
 }
 
+// divisionTagChanges are the member rows and ledger entries produced by one
+// division's tag assignments.
+type divisionTagChanges struct {
+	clearRows []leaderboarddb.LeagueMember
+	upserts   []leaderboarddb.LeagueMember
+	history   []leaderboarddb.TagHistoryEntry
+}
+
 func (s *LeaderboardService) applyTagAssignmentsInTx(
 	ctx context.Context,
-	tx bun.Tx,
+	tx bun.IDB,
 	guildID string,
 	requests []sharedtypes.TagAssignmentRequest,
 	source sharedtypes.ServiceUpdateSource,
 	updateID sharedtypes.RoundID,
 ) (leaderboardtypes.LeaderboardData, error) {
+	historyEntries, err := s.assignTagsInTx(ctx, tx, guildID, requests, source, updateID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tagHistRepo.BulkInsertTagHistory(ctx, tx, historyEntries); err != nil {
+		return nil, fmt.Errorf("write tag history: %w", err)
+	}
+
+	return s.normalizedLeaderboardData(ctx, tx, guildID)
+}
+
+// assignTagsInTx applies tag assignments to league members and returns the tag
+// history entries describing them, ordered by tag, without writing them.
+func (s *LeaderboardService) assignTagsInTx(
+	ctx context.Context,
+	tx bun.IDB,
+	guildID string,
+	requests []sharedtypes.TagAssignmentRequest,
+	source sharedtypes.ServiceUpdateSource,
+	updateID sharedtypes.RoundID,
+) ([]leaderboarddb.TagHistoryEntry, error) {
 	dedup := make(map[string]int, len(requests))
 	for _, req := range requests {
 		if req.TagNumber < 0 {
@@ -775,39 +962,97 @@ func (s *LeaderboardService) applyTagAssignmentsInTx(
 	})
 
 	if len(assignments) == 0 {
-		return s.normalizedLeaderboardData(ctx, tx, guildID)
+		return nil, nil
+	}
+
+	// Fetch current state of requestors
+	uniqueMemberIDs := make([]string, len(assignments))
+	for i, assignment := range assignments {
+		uniqueMemberIDs[i] = assignment.memberID
+	}
+	requestors, err := s.memberRepo.GetMembersByIDs(ctx, tx, guildID, uniqueMemberIDs)
+	if err != nil {
+		return nil, fmt.Errorf("load requestors: %w", err)
+	}
+	divisionByMember := make(map[string]string, len(requestors))
+	for _, m := range requestors {
+		divisionByMember[m.MemberID] = m.DivisionID
+	}
+
+	reason := historyReasonFromSource(source)
+	var roundID *uuid.UUID
+	if rid := uuid.UUID(updateID); rid != uuid.Nil {
+		roundID = &rid
 	}
 
+	// Tags are unique per division, so each division's assignments are resolved
+	// against its own holders.
+	var changes divisionTagChanges
+	for _, group := range leaderboarddomain.GroupByDivision(assignments, func(a tagAssignment) string {
+		return divisionByMember[a.memberID]
+	}) {
+		divisionID := divisionByMember[group[0].memberID]
+		divisionChanges, err := s.planDivisionTagAssignments(ctx, tx, guildID, divisionID, group, requestors, reason, roundID)
+		if err != nil {
+			return nil, err
+		}
+		changes.clearRows = append(changes.clearRows, divisionChanges.clearRows...)
+		changes.upserts = append(changes.upserts, divisionChanges.upserts...)
+		changes.history = append(changes.history, divisionChanges.history...)
+	}
+
+	if err := s.memberRepo.BulkUpsertMembers(ctx, tx, changes.clearRows); err != nil {
+		return nil, fmt.Errorf("clear current tags for reassignment: %w", err)
+	}
+	if err := s.memberRepo.BulkUpsertMembers(ctx, tx, changes.upserts); err != nil {
+		return nil, fmt.Errorf("apply tag assignments: %w", err)
+	}
+
+	historyEntries := changes.history
+	slices.SortFunc(historyEntries, func(a, b leaderboarddb.TagHistoryEntry) int {
+		if c := cmp.Compare(a.TagNumber, b.TagNumber); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.NewMemberID, b.NewMemberID)
+	})
+	return historyEntries, nil
+}
+
+// planDivisionTagAssignments resolves one division's assignments against the tags
+// currently held in that division. requestors may include members of other divisions;
+// only those being assigned here are considered.
+func (s *LeaderboardService) planDivisionTagAssignments(
+	ctx context.Context,
+	tx bun.IDB,
+	guildID string,
+	divisionID string,
+	assignments []tagAssignment,
+	requestors []leaderboarddb.LeagueMember,
+	reason string,
+	roundID *uuid.UUID,
+) (divisionTagChanges, error) {
 	requestedUsers := make(map[string]struct{}, len(assignments))
 	requestedTags := make(map[int]string, len(assignments))
 
-	// Collect IDs and Tags for targeted fetching
-	uniqueMemberIDs := make([]string, 0, len(assignments))
+	// Collect Tags for targeted fetching
 	uniqueTags := make([]int, 0, len(assignments))
 
 	for _, assignment := range assignments {
 		requestedUsers[assignment.memberID] = struct{}{}
 		if assignment.tag != 0 {
 			if otherUser, exists := requestedTags[assignment.tag]; exists && otherUser != assignment.memberID {
-				return nil, fmt.Errorf("duplicate requested tag %d for users %s and %s", assignment.tag, otherUser, assignment.memberID)
+				return divisionTagChanges{}, fmt.Errorf("duplicate requested tag %d for users %s and %s", assignment.tag, otherUser, assignment.memberID)
 			}
 			requestedTags[assignment.tag] = assignment.memberID
 		}
 
-		uniqueMemberIDs = append(uniqueMemberIDs, assignment.memberID)
 		uniqueTags = append(uniqueTags, assignment.tag)
 	}
 
-	// Fetch current state of requestors
-	requestors, err := s.memberRepo.GetMembersByIDs(ctx, tx, guildID, uniqueMemberIDs)
-	if err != nil {
-		return nil, fmt.Errorf("load requestors: %w", err)
-	}
-
 	// Fetch current holders of requested tags
-	holders, err := s.memberRepo.GetMembersByTags(ctx, tx, guildID, uniqueTags)
+	holders, err := s.memberRepo.GetMembersByTags(ctx, tx, guildID, divisionID, uniqueTags)
 	if err != nil {
-		return nil, fmt.Errorf("load tag holders: %w", err)
+		return divisionTagChanges{}, fmt.Errorf("load tag holders: %w", err)
 	}
 
 	currentUserTag := make(map[string]int)
@@ -822,7 +1067,9 @@ func (s *LeaderboardService) applyTagAssignmentsInTx(
 	}
 
 	for _, m := range requestors {
-		addMemberState(m)
+		if _, requested := requestedUsers[m.MemberID]; requested {
+			addMemberState(m)
+		}
 	}
 	for _, m := range holders {
 		addMemberState(m)
@@ -845,7 +1092,7 @@ func (s *LeaderboardService) applyTagAssignmentsInTx(
 			currentTag = sharedtypes.TagNumber(tag)
 		}
 
-		return nil, &TagSwapNeededError{
+		return divisionTagChanges{}, &TagSwapNeededError{
 			RequestorID:  sharedtypes.DiscordID(assignment.memberID),
 			TargetUserID: sharedtypes.DiscordID(holderID),
 			TargetTag:    sharedtypes.TagNumber(assignment.tag),
@@ -853,36 +1100,29 @@ func (s *LeaderboardService) applyTagAssignmentsInTx(
 		}
 	}
 
-	clearRows := make([]leaderboarddb.LeagueMember, 0, len(assignments))
+	var changes divisionTagChanges
 	for _, assignment := range assignments {
 		if _, exists := currentUserTag[assignment.memberID]; !exists {
 			continue
 		}
-		clearRows = append(clearRows, leaderboarddb.LeagueMember{
+		changes.clearRows = append(changes.clearRows, leaderboarddb.LeagueMember{
 			GuildID:    guildID,
 			MemberID:   assignment.memberID,
 			CurrentTag: nil,
 		})
 	}
-	if err := s.memberRepo.BulkUpsertMembers(ctx, tx, clearRows); err != nil {
-		return nil, fmt.Errorf("clear current tags for reassignment: %w", err)
-	}
 
-	upserts := make([]leaderboarddb.LeagueMember, 0, len(assignments))
 	for _, assignment := range assignments {
 		if assignment.tag == 0 {
 			continue // Already cleared in clearRows
 		}
 		tag := assignment.tag
-		upserts = append(upserts, leaderboarddb.LeagueMember{
+		changes.upserts = append(changes.upserts, leaderboarddb.LeagueMember{
 			GuildID:    guildID,
 			MemberID:   assignment.memberID,
 			CurrentTag: &tag,
 		})
 	}
-	if err := s.memberRepo.BulkUpsertMembers(ctx, tx, upserts); err != nil {
-		return nil, fmt.Errorf("apply tag assignments: %w", err)
-	}
 
 	afterUserTag := maps.Clone(currentUserTag)
 	for _, assignment := range assignments {
@@ -898,14 +1138,6 @@ func (s *LeaderboardService) applyTagAssignmentsInTx(
 		afterTagUser[tag] = memberID
 	}
 
-	reason := historyReasonFromSource(source)
-	var roundID *uuid.UUID
-	if rid := uuid.UUID(updateID); rid != uuid.Nil {
-		roundID = &rid
-	}
-
-	historyEntries := make([]leaderboarddb.TagHistoryEntry, 0, len(afterTagUser))
-
 	// Record tag assignments: tags that now have a new positive holder.
 	for tag, newMemberID := range afterTagUser {
 		if tag <= 0 {
@@ -921,10 +1153,11 @@ func (s *LeaderboardService) applyTagAssignmentsInTx(
 			oldPtr = &oldMemberID
 		}
 
-		historyEntries = append(historyEntries, leaderboarddb.TagHistoryEntry{
+		changes.history = append(changes.history, leaderboarddb.TagHistoryEntry{
 			GuildID:     guildID,
 			RoundID:     roundID,
 			TagNumber:   tag,
+			DivisionID:  divisionID,
 			OldMemberID: oldPtr,
 			NewMemberID: newMemberID,
 			Reason:      reason,
@@ -943,27 +1176,18 @@ func (s *LeaderboardService) applyTagAssignmentsInTx(
 			continue // didn't have a tag before, nothing to release
 		}
 		oID := memberID
-		historyEntries = append(historyEntries, leaderboarddb.TagHistoryEntry{
+		changes.history = append(changes.history, leaderboarddb.TagHistoryEntry{
 			GuildID:     guildID,
 			RoundID:     roundID,
 			TagNumber:   oldTag,
+			DivisionID:  divisionID,
 			OldMemberID: &oID,
 			NewMemberID: "", // empty string = released, no new holder
 			Reason:      reason,
 			Metadata:    "{}",
 		})
 	}
-	slices.SortFunc(historyEntries, func(a, b leaderboarddb.TagHistoryEntry) int {
-		if c := cmp.Compare(a.TagNumber, b.TagNumber); c != 0 {
-			return c
-		}
-		return cmp.Compare(a.NewMemberID, b.NewMemberID)
-	})
-	if err := s.tagHistRepo.BulkInsertTagHistory(ctx, tx, historyEntries); err != nil {
-		return nil, fmt.Errorf("write tag history: %w", err)
-	}
-
-	return s.normalizedLeaderboardData(ctx, tx, guildID)
+	return changes, nil
 }
 
 func (s *LeaderboardService) normalizedLeaderboardData(ctx context.Context, db bun.IDB, guildID string) (leaderboardtypes.LeaderboardData, error) {
@@ -997,6 +1221,14 @@ func historyReasonFromSource(source sharedtypes.ServiceUpdateSource) string {
 		return "claim"
 	case sharedtypes.ServiceUpdateSourceTagSwap, sharedtypes.ServiceUpdateSourceProcessScores:
 		return "round_swap"
+	case ServiceUpdateSourceInactivity:
+		return leaderboarddomain.InactivityReason
+	case ServiceUpdateSourceTagOffer:
+		return "tag_offer"
+	case ServiceUpdateSourceSeasonTransition:
+		return leaderboarddomain.SeasonTransitionReason
+	case ServiceUpdateSourceTagImport:
+		return leaderboarddomain.TagImportReason
 	default:
 		return "admin_fix"
 	}
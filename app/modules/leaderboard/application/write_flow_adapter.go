@@ -28,8 +28,8 @@ func (p *serviceCommandPipeline) ApplyTagAssignments(
 	return p.service.applyTagAssignmentsCore(ctx, guildID, requests, source, updateID)
 }
 
-func (p *serviceCommandPipeline) StartSeason(ctx context.Context, guildID, seasonID, seasonName string) error {
-	return p.service.startSeasonCore(ctx, guildID, seasonID, seasonName)
+func (p *serviceCommandPipeline) StartSeason(ctx context.Context, guildID, seasonID, seasonName string, policy leaderboarddomain.PointsPolicy) error {
+	return p.service.startSeasonCore(ctx, guildID, seasonID, seasonName, policy)
 }
 
 func (p *serviceCommandPipeline) EndSeason(ctx context.Context, guildID string) error {
@@ -25,8 +25,9 @@ type fakeLeagueMemberRepo struct {
 	getMembersByGuildFunc func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.LeagueMember, error)
 	getMembersByIDsFunc   func(ctx context.Context, db bun.IDB, guildID string, memberIDs []string) ([]leaderboarddb.LeagueMember, error)
 	getMemberByIDFunc     func(ctx context.Context, db bun.IDB, guildID, memberID string) (*leaderboarddb.LeagueMember, error)
-	getMemberByTagFunc    func(ctx context.Context, db bun.IDB, guildID string, tag int) (*leaderboarddb.LeagueMember, error)
-	getMembersByTagsFunc  func(ctx context.Context, db bun.IDB, guildID string, tags []int) ([]leaderboarddb.LeagueMember, error)
+	getMemberByTagFunc    func(ctx context.Context, db bun.IDB, guildID, divisionID string, tag int) (*leaderboarddb.LeagueMember, error)
+	getMembersByTagsFunc  func(ctx context.Context, db bun.IDB, guildID, divisionID string, tags []int) ([]leaderboarddb.LeagueMember, error)
+	setMemberDivisionFunc func(ctx context.Context, db bun.IDB, guildID, memberID, divisionID string) error
 	upsertMemberErr       error
 	bulkUpsertMembersErr  error
 	bulkUpsertCalls       [][]leaderboarddb.LeagueMember
@@ -60,20 +61,27 @@ func (f *fakeLeagueMemberRepo) GetMemberByID(ctx context.Context, db bun.IDB, gu
 	return nil, nil
 }
 
-func (f *fakeLeagueMemberRepo) GetMemberByTag(ctx context.Context, db bun.IDB, guildID string, tag int) (*leaderboarddb.LeagueMember, error) {
+func (f *fakeLeagueMemberRepo) GetMemberByTag(ctx context.Context, db bun.IDB, guildID, divisionID string, tag int) (*leaderboarddb.LeagueMember, error) {
 	if f.getMemberByTagFunc != nil {
-		return f.getMemberByTagFunc(ctx, db, guildID, tag)
+		return f.getMemberByTagFunc(ctx, db, guildID, divisionID, tag)
 	}
 	return nil, nil
 }
 
-func (f *fakeLeagueMemberRepo) GetMembersByTags(ctx context.Context, db bun.IDB, guildID string, tags []int) ([]leaderboarddb.LeagueMember, error) {
+func (f *fakeLeagueMemberRepo) GetMembersByTags(ctx context.Context, db bun.IDB, guildID, divisionID string, tags []int) ([]leaderboarddb.LeagueMember, error) {
 	if f.getMembersByTagsFunc != nil {
-		return f.getMembersByTagsFunc(ctx, db, guildID, tags)
+		return f.getMembersByTagsFunc(ctx, db, guildID, divisionID, tags)
 	}
 	return nil, nil
 }
 
+func (f *fakeLeagueMemberRepo) SetMemberDivision(ctx context.Context, db bun.IDB, guildID, memberID, divisionID string) error {
+	if f.setMemberDivisionFunc != nil {
+		return f.setMemberDivisionFunc(ctx, db, guildID, memberID, divisionID)
+	}
+	return nil
+}
+
 func (f *fakeLeagueMemberRepo) UpsertMember(ctx context.Context, db bun.IDB, member *leaderboarddb.LeagueMember) error {
 	return f.upsertMemberErr
 }
@@ -98,6 +106,11 @@ type fakeTagHistoryRepo struct {
 	bulkInsertErr    error
 	bulkInsertCalls  int
 	lastBulkInserted []leaderboarddb.TagHistoryEntry
+	guildHistory     []leaderboarddb.TagHistoryEntry
+	roundHistory     map[uuid.UUID][]leaderboarddb.TagHistoryEntry
+	betweenHistory   []leaderboarddb.TagHistoryEntry
+	memberHistory    map[string][]leaderboarddb.TagHistoryEntry
+	deletedRounds    []uuid.UUID
 }
 
 func (f *fakeTagHistoryRepo) BulkInsertTagHistory(ctx context.Context, db bun.IDB, entries []leaderboarddb.TagHistoryEntry) error {
@@ -108,11 +121,11 @@ func (f *fakeTagHistoryRepo) BulkInsertTagHistory(ctx context.Context, db bun.ID
 }
 
 func (f *fakeTagHistoryRepo) GetTagHistoryForRound(ctx context.Context, db bun.IDB, guildID string, roundID uuid.UUID) ([]leaderboarddb.TagHistoryEntry, error) {
-	return nil, nil
+	return f.roundHistory[roundID], nil
 }
 
 func (f *fakeTagHistoryRepo) GetTagHistoryForMember(ctx context.Context, db bun.IDB, guildID, memberID string, limit int) ([]leaderboarddb.TagHistoryEntry, error) {
-	return nil, nil
+	return f.memberHistory[memberID], nil
 }
 
 func (f *fakeTagHistoryRepo) GetLatestTagHistory(ctx context.Context, db bun.IDB, guildID string, limit int) ([]leaderboarddb.TagHistoryEntry, error) {
@@ -120,16 +133,37 @@ func (f *fakeTagHistoryRepo) GetLatestTagHistory(ctx context.Context, db bun.IDB
 }
 
 func (f *fakeTagHistoryRepo) GetTagHistoryForGuild(ctx context.Context, db bun.IDB, guildID string, since time.Time) ([]leaderboarddb.TagHistoryEntry, error) {
-	return nil, nil
+	return f.guildHistory, nil
+}
+
+func (f *fakeTagHistoryRepo) GetTagHistoryForGuildUntil(ctx context.Context, db bun.IDB, guildID string, until time.Time) ([]leaderboarddb.TagHistoryEntry, error) {
+	var entries []leaderboarddb.TagHistoryEntry
+	for _, e := range f.guildHistory {
+		if !e.CreatedAt.After(until) {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+func (f *fakeTagHistoryRepo) DeleteTagHistoryForRounds(ctx context.Context, db bun.IDB, guildID string, roundIDs []uuid.UUID, reason string) error {
+	f.deletedRounds = append(f.deletedRounds, roundIDs...)
+	return nil
 }
 
 func (f *fakeTagHistoryRepo) GetTagHistoryForTag(ctx context.Context, db bun.IDB, guildID string, tag int, limit int) ([]leaderboarddb.TagHistoryEntry, error) {
 	return nil, nil
 }
 
+func (f *fakeTagHistoryRepo) GetTagHistoryBetweenMembers(ctx context.Context, db bun.IDB, guildID, memberA, memberB string) ([]leaderboarddb.TagHistoryEntry, error) {
+	return f.betweenHistory, nil
+}
+
 type fakeRoundOutcomeRepo struct {
 	getOutcomeFunc    func(ctx context.Context, db bun.IDB, guildID string, roundID uuid.UUID) (*leaderboarddb.RoundOutcome, error)
 	upsertOutcomeFunc func(ctx context.Context, db bun.IDB, outcome *leaderboarddb.RoundOutcome) error
+	listOutcomesFunc  func(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.RoundOutcome, error)
+	listSharedFunc    func(ctx context.Context, db bun.IDB, guildID, memberA, memberB string) ([]leaderboarddb.RoundOutcome, error)
 }
 
 func (f *fakeRoundOutcomeRepo) GetRoundOutcome(ctx context.Context, db bun.IDB, guildID string, roundID uuid.UUID) (*leaderboarddb.RoundOutcome, error) {
@@ -146,6 +180,20 @@ func (f *fakeRoundOutcomeRepo) UpsertRoundOutcome(ctx context.Context, db bun.ID
 	return nil
 }
 
+func (f *fakeRoundOutcomeRepo) ListRoundOutcomes(ctx context.Context, db bun.IDB, guildID string) ([]leaderboarddb.RoundOutcome, error) {
+	if f.listOutcomesFunc != nil {
+		return f.listOutcomesFunc(ctx, db, guildID)
+	}
+	return nil, nil
+}
+
+func (f *fakeRoundOutcomeRepo) ListSharedRoundOutcomes(ctx context.Context, db bun.IDB, guildID, memberA, memberB string) ([]leaderboarddb.RoundOutcome, error) {
+	if f.listSharedFunc != nil {
+		return f.listSharedFunc(ctx, db, guildID, memberA, memberB)
+	}
+	return nil, nil
+}
+
 func newWriteFlowTestService(repo *FakeLeaderboardRepo, members *fakeLeagueMemberRepo, tags *fakeTagHistoryRepo, outcomes *fakeRoundOutcomeRepo) *LeaderboardService {
 	return &LeaderboardService{
 		repo:        repo,
@@ -308,7 +356,7 @@ func TestPersistTagChanges_WritesMembersAndHistory(t *testing.T) {
 				{TagNumber: 2, OldMemberID: "u1", NewMemberID: "u2"},
 			}
 
-			err := svc.persistTagChanges(context.Background(), bun.Tx{}, "guild-1", &roundID, changes, "round_swap")
+			err := svc.persistTagChanges(context.Background(), bun.Tx{}, "guild-1", &roundID, changes, nil, "round_swap")
 			if err != nil {
 				t.Fatalf("persistTagChanges returned error: %v", err)
 			}
@@ -373,7 +421,7 @@ func TestCalculateAndPersistPoints_PersistsAwardsAndStandings(t *testing.T) {
 				return nil
 			}
 
-			awards, err := svc.calculateAndPersistPoints(context.Background(), bun.Tx{}, cmd, map[string]int{"u1": 1, "u2": 2}, nil, "season-1")
+			awards, err := svc.calculateAndPersistPoints(context.Background(), bun.Tx{}, cmd, map[string]int{"u1": 1, "u2": 2}, nil, nil, "season-1")
 			if err != nil {
 				t.Fatalf("calculateAndPersistPoints returned error: %v", err)
 			}
@@ -442,7 +490,7 @@ func TestCalculateAndPersistPoints_IncludesUntaggedParticipants(t *testing.T) {
 			}
 
 			// u1 has tag 1, u2 is untagged (tag 0). Both should appear in awards/history.
-			awards, err := svc.calculateAndPersistPoints(context.Background(), bun.Tx{}, cmd, map[string]int{"u1": 1, "u2": 0}, nil, "season-1")
+			awards, err := svc.calculateAndPersistPoints(context.Background(), bun.Tx{}, cmd, map[string]int{"u1": 1, "u2": 0}, nil, nil, "season-1")
 			if err != nil {
 				t.Fatalf("calculateAndPersistPoints returned error: %v", err)
 			}
@@ -545,7 +593,7 @@ func TestApplyTagAssignmentsInTx_SuccessfulSwap(t *testing.T) {
 					{GuildID: guildID, MemberID: "u2", CurrentTag: &tag2},
 				}, nil
 			}
-			members.getMembersByTagsFunc = func(ctx context.Context, db bun.IDB, guildID string, requestedTags []int) ([]leaderboarddb.LeagueMember, error) {
+			members.getMembersByTagsFunc = func(ctx context.Context, db bun.IDB, guildID, divisionID string, requestedTags []int) ([]leaderboarddb.LeagueMember, error) {
 				tag1 := 1
 				tag2 := 2
 				return []leaderboarddb.LeagueMember{
@@ -755,7 +803,7 @@ func TestApplyTagAssignmentsInTx_MixedBatch_AssignmentAndRemoval(t *testing.T) {
 				}, nil
 			}
 			// tag=0 and tag=7 are unoccupied
-			members.getMembersByTagsFunc = func(ctx context.Context, db bun.IDB, guildID string, requestedTags []int) ([]leaderboarddb.LeagueMember, error) {
+			members.getMembersByTagsFunc = func(ctx context.Context, db bun.IDB, guildID, divisionID string, requestedTags []int) ([]leaderboarddb.LeagueMember, error) {
 				return nil, nil
 			}
 			// After batch: u1 has tag=7, u2 has no tag
@@ -0,0 +1,142 @@
+package leaderboarddomain
+
+import (
+	"sort"
+	"time"
+)
+
+// SeriesPoint is one value of a time series.
+type SeriesPoint struct {
+	At    time.Time
+	Value float64
+}
+
+// MemberSeries is one member's line on a multi-member chart, oldest point first.
+type MemberSeries struct {
+	MemberID string
+	Points   []SeriesPoint
+}
+
+// PointsEntry is points awarded to a member at a point in time.
+type PointsEntry struct {
+	MemberID string
+	Points   int
+	At       time.Time
+}
+
+// BuildPointsRace turns point awards into running totals for the topN members with the
+// most points. Members are ordered by final total (highest first), then member ID.
+func BuildPointsRace(entries []PointsEntry, topN int) []MemberSeries {
+	ordered := make([]PointsEntry, len(entries))
+	copy(ordered, entries)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].At.Before(ordered[j].At)
+	})
+
+	totals := make(map[string]int)
+	series := make(map[string]*MemberSeries)
+	for _, e := range ordered {
+		totals[e.MemberID] += e.Points
+		s, ok := series[e.MemberID]
+		if !ok {
+			s = &MemberSeries{MemberID: e.MemberID}
+			series[e.MemberID] = s
+		}
+		s.Points = append(s.Points, SeriesPoint{At: e.At, Value: float64(totals[e.MemberID])})
+	}
+
+	members := make([]string, 0, len(series))
+	for id := range series {
+		members = append(members, id)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if totals[members[i]] != totals[members[j]] {
+			return totals[members[i]] > totals[members[j]]
+		}
+		return members[i] < members[j]
+	})
+	if topN > 0 && len(members) > topN {
+		members = members[:topN]
+	}
+
+	out := make([]MemberSeries, 0, len(members))
+	for _, id := range members {
+		out = append(out, *series[id])
+	}
+	return out
+}
+
+// ScoreBin counts the rounds finished with one stroke total.
+type ScoreBin struct {
+	Score int
+	Count int
+}
+
+// BuildScoreHistogram counts scores per stroke total. Bins run contiguously from the
+// lowest to the highest score so gaps show as empty bars.
+func BuildScoreHistogram(scores []int) []ScoreBin {
+	if len(scores) == 0 {
+		return nil
+	}
+	counts := make(map[int]int, len(scores))
+	lo, hi := scores[0], scores[0]
+	for _, s := range scores {
+		counts[s]++
+		lo = min(lo, s)
+		hi = max(hi, s)
+	}
+	bins := make([]ScoreBin, 0, hi-lo+1)
+	for s := lo; s <= hi; s++ {
+		bins = append(bins, ScoreBin{Score: s, Count: counts[s]})
+	}
+	return bins
+}
+
+// AttendanceRound is a processed round and how many members played it.
+type AttendanceRound struct {
+	At           time.Time
+	Participants int
+}
+
+// AttendanceWeek is one heatmap column: attendance per weekday, Monday first.
+type AttendanceWeek struct {
+	WeekStart time.Time
+	Days      [7]int
+}
+
+// BuildAttendanceHeatmap sums round attendance per weekday over the weeks weeks up to
+// and including the week containing now. Weeks start on Monday in now's location and
+// are returned oldest first; weeks without rounds are kept as zero columns.
+func BuildAttendanceHeatmap(rounds []AttendanceRound, weeks int, now time.Time) []AttendanceWeek {
+	if weeks <= 0 {
+		return nil
+	}
+	current := startOfWeek(now)
+	first := current.AddDate(0, 0, -7*(weeks-1))
+
+	out := make([]AttendanceWeek, weeks)
+	for i := range out {
+		out[i].WeekStart = first.AddDate(0, 0, 7*i)
+	}
+	for _, r := range rounds {
+		at := r.At.In(now.Location())
+		week := startOfWeek(at)
+		if week.Before(first) || week.After(current) {
+			continue
+		}
+		i := int(week.Sub(first).Hours()+12) / (24 * 7)
+		out[i].Days[weekdayIndex(at)] += r.Participants
+	}
+	return out
+}
+
+// startOfWeek returns midnight on the Monday of t's week in t's location.
+func startOfWeek(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return day.AddDate(0, 0, -weekdayIndex(t))
+}
+
+// weekdayIndex numbers weekdays from Monday (0) to Sunday (6).
+func weekdayIndex(t time.Time) int {
+	return (int(t.Weekday()) + 6) % 7
+}
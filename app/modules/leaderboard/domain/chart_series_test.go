@@ -0,0 +1,63 @@
+package leaderboarddomain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuildPointsRace(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 18, 0, 0, 0, time.UTC) }
+	entries := []PointsEntry{
+		{MemberID: "bob", Points: 100, At: day(2)},
+		{MemberID: "alice", Points: 200, At: day(1)},
+		{MemberID: "carol", Points: 50, At: day(1)},
+		{MemberID: "alice", Points: -20, At: day(3)},
+		{MemberID: "bob", Points: 150, At: day(3)},
+	}
+
+	got := BuildPointsRace(entries, 2)
+	want := []MemberSeries{
+		{MemberID: "bob", Points: []SeriesPoint{{At: day(2), Value: 100}, {At: day(3), Value: 250}}},
+		{MemberID: "alice", Points: []SeriesPoint{{At: day(1), Value: 200}, {At: day(3), Value: 180}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("BuildPointsRace = %+v, want %+v", got, want)
+	}
+}
+
+func TestBuildScoreHistogram(t *testing.T) {
+	got := BuildScoreHistogram([]int{54, 52, 54, 55})
+	want := []ScoreBin{{52, 1}, {53, 0}, {54, 2}, {55, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("BuildScoreHistogram = %+v, want %+v", got, want)
+	}
+	if BuildScoreHistogram(nil) != nil {
+		t.Fatal("expected no bins without scores")
+	}
+}
+
+func TestBuildAttendanceHeatmap(t *testing.T) {
+	// Wednesday 2026-05-13.
+	now := time.Date(2026, 5, 13, 12, 0, 0, 0, time.UTC)
+	rounds := []AttendanceRound{
+		{At: time.Date(2026, 5, 11, 18, 0, 0, 0, time.UTC), Participants: 8}, // Monday this week
+		{At: time.Date(2026, 5, 10, 18, 0, 0, 0, time.UTC), Participants: 5}, // Sunday last week
+		{At: time.Date(2026, 5, 10, 9, 0, 0, 0, time.UTC), Participants: 3},  // same Sunday
+		{At: time.Date(2026, 4, 1, 18, 0, 0, 0, time.UTC), Participants: 9},  // outside the window
+	}
+
+	got := BuildAttendanceHeatmap(rounds, 2, now)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 weeks, got %d", len(got))
+	}
+	if !got[0].WeekStart.Equal(time.Date(2026, 5, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("first week starts %v, want Monday 2026-05-04", got[0].WeekStart)
+	}
+	if got[0].Days != [7]int{0, 0, 0, 0, 0, 0, 8} {
+		t.Fatalf("last week %v, want 8 on Sunday", got[0].Days)
+	}
+	if got[1].Days != [7]int{8, 0, 0, 0, 0, 0, 0} {
+		t.Fatalf("this week %v, want 8 on Monday", got[1].Days)
+	}
+}
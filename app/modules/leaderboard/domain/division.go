@@ -0,0 +1,75 @@
+package leaderboarddomain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultDivisionID names the division of members never assigned to one. Guilds
+// without divisions keep every member there.
+const DefaultDivisionID = ""
+
+// MaxDivisionNameLength caps a division's display name.
+const MaxDivisionNameLength = 50
+
+// DivisionChangeReason is the tag history reason recorded when a member's tag is
+// released because they moved to another division.
+const DivisionChangeReason = "division_change"
+
+// ErrInvalidDivision is returned when a division cannot be created.
+var ErrInvalidDivision = errors.New("invalid division")
+
+var divisionIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// Division is a named tag leaderboard within a guild, such as "Pro" or "AM1". Tags
+// are unique within a division and rounds only reallocate them among its members.
+type Division struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Validate reports whether the division can be created. IDs are short lowercase
+// slugs; the default division's empty ID is reserved.
+func (d Division) Validate() error {
+	if !divisionIDPattern.MatchString(d.ID) {
+		return fmt.Errorf("%w: id must be 1-32 lowercase letters, digits, '-' or '_'", ErrInvalidDivision)
+	}
+	name := strings.TrimSpace(d.Name)
+	if name == "" || len(name) > MaxDivisionNameLength {
+		return fmt.Errorf("%w: name must be 1-%d characters", ErrInvalidDivision, MaxDivisionNameLength)
+	}
+	return nil
+}
+
+// GroupByDivision splits items into per-division groups, keeping their order within
+// each group. Groups are ordered by the first item seen in each division.
+func GroupByDivision[T any](items []T, divisionOf func(T) string) [][]T {
+	index := make(map[string]int)
+	var groups [][]T
+	for _, item := range items {
+		division := divisionOf(item)
+		i, ok := index[division]
+		if !ok {
+			i = len(groups)
+			index[division] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], item)
+	}
+	return groups
+}
+
+// AllocateWithinDivisions wraps allocate so it runs separately on each division's
+// participants; tags never move between divisions. divisionOf maps member IDs to
+// divisions, and members it does not name are in the default division.
+func AllocateWithinDivisions(allocate TagAllocator, divisionOf map[string]string) TagAllocator {
+	return func(inputs []TagAllocationInput) []TagChange {
+		var changes []TagChange
+		for _, group := range GroupByDivision(inputs, func(in TagAllocationInput) string { return divisionOf[in.MemberID] }) {
+			changes = append(changes, allocate(group)...)
+		}
+		return changes
+	}
+}
@@ -0,0 +1,84 @@
+package leaderboarddomain
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDivisionValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		division Division
+		valid    bool
+	}{
+		{"slug and name", Division{ID: "am1", Name: "AM1"}, true},
+		{"dashes and underscores", Division{ID: "pro-open_2", Name: "Pro Open"}, true},
+		{"default division id", Division{ID: DefaultDivisionID, Name: "Default"}, false},
+		{"uppercase id", Division{ID: "Pro", Name: "Pro"}, false},
+		{"id starting with dash", Division{ID: "-pro", Name: "Pro"}, false},
+		{"id too long", Division{ID: strings.Repeat("a", 33), Name: "Long"}, false},
+		{"blank name", Division{ID: "pro", Name: "   "}, false},
+		{"name too long", Division{ID: "pro", Name: strings.Repeat("x", MaxDivisionNameLength+1)}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.division.Validate()
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidDivision) {
+				t.Fatalf("expected ErrInvalidDivision, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGroupByDivision(t *testing.T) {
+	division := map[string]string{"alice": "pro", "carol": "pro", "dave": "am"}
+	groups := GroupByDivision([]string{"alice", "bob", "carol", "dave", "erin"}, func(id string) string {
+		return division[id]
+	})
+
+	want := [][]string{{"alice", "carol"}, {"bob", "erin"}, {"dave"}}
+	if !reflect.DeepEqual(groups, want) {
+		t.Fatalf("groups %v, want %v", groups, want)
+	}
+	if got := GroupByDivision([]string(nil), func(string) string { return "" }); got != nil {
+		t.Fatalf("expected no groups, got %v", got)
+	}
+}
+
+func TestAllocateWithinDivisions(t *testing.T) {
+	allocate := AllocateWithinDivisions(AllocateTagsClosedPool, map[string]string{"carol": "am", "dave": "am"})
+
+	// Both divisions hold tags 1 and 2; each pair swaps only with each other.
+	changes := allocate([]TagAllocationInput{
+		{MemberID: "alice", FinishRank: 4, CurrentTag: 1},
+		{MemberID: "bob", FinishRank: 3, CurrentTag: 2},
+		{MemberID: "carol", FinishRank: 1, CurrentTag: 2},
+		{MemberID: "dave", FinishRank: 2, CurrentTag: 1},
+	})
+
+	pro := ComputeFinalTagState(map[string]int{"alice": 1, "bob": 2}, filterChanges(changes, "alice", "bob"))
+	if want := []MemberTagAssignment{{MemberID: "bob", Tag: 1}, {MemberID: "alice", Tag: 2}}; !reflect.DeepEqual(pro, want) {
+		t.Fatalf("default division %+v, want %+v", pro, want)
+	}
+	am := ComputeFinalTagState(map[string]int{"carol": 2, "dave": 1}, filterChanges(changes, "carol", "dave"))
+	if want := []MemberTagAssignment{{MemberID: "carol", Tag: 1}, {MemberID: "dave", Tag: 2}}; !reflect.DeepEqual(am, want) {
+		t.Fatalf("am division %+v, want %+v", am, want)
+	}
+}
+
+func filterChanges(changes []TagChange, memberIDs ...string) []TagChange {
+	var out []TagChange
+	for _, ch := range changes {
+		for _, id := range memberIDs {
+			if ch.NewMemberID == id {
+				out = append(out, ch)
+			}
+		}
+	}
+	return out
+}
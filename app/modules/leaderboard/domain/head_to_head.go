@@ -0,0 +1,132 @@
+package leaderboarddomain
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// HeadToHeadResult is the outcome of one shared round from the first member's side.
+type HeadToHeadResult string
+
+const (
+	HeadToHeadWin  HeadToHeadResult = "win"
+	HeadToHeadLoss HeadToHeadResult = "loss"
+	HeadToHeadTie  HeadToHeadResult = "tie"
+)
+
+// HeadToHeadRound is one processed round both members finished.
+type HeadToHeadRound struct {
+	RoundID  string
+	PlayedAt time.Time
+	RankA    int
+	RankB    int
+	// ScoreA and ScoreB are gross stroke totals; nil when the round has no stored scores.
+	ScoreA *int
+	ScoreB *int
+}
+
+// TagExchange is a tag passing directly between the two members.
+type TagExchange struct {
+	RoundID   string    `json:"round_id,omitempty"`
+	TagNumber int       `json:"tag_number"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	At        time.Time `json:"at"`
+}
+
+// HeadToHeadPoint is one round in a head-to-head series. Wins, Losses and Ties are
+// running totals so the series can be charted without further aggregation.
+type HeadToHeadPoint struct {
+	RoundID            string           `json:"round_id"`
+	PlayedAt           time.Time        `json:"played_at"`
+	Result             HeadToHeadResult `json:"result"`
+	StrokeDifferential *int             `json:"stroke_differential,omitempty"`
+	Wins               int              `json:"wins"`
+	Losses             int              `json:"losses"`
+	Ties               int              `json:"ties"`
+}
+
+// HeadToHeadRecord is MemberA's record against MemberB.
+type HeadToHeadRecord struct {
+	MemberA string
+	MemberB string
+
+	Rounds int
+	Wins   int
+	Losses int
+	Ties   int
+
+	// StrokeRounds counts the shared rounds with both scores stored.
+	// AverageStrokeDifferential is MemberA's strokes minus MemberB's over those rounds,
+	// so a negative value favours MemberA.
+	StrokeRounds              int
+	AverageStrokeDifferential float64
+
+	// TagsTaken counts tags MemberA took from MemberB, TagsLost the reverse.
+	TagsTaken int
+	TagsLost  int
+	Exchanges []TagExchange
+
+	Series []HeadToHeadPoint
+}
+
+// BuildHeadToHead aggregates the rounds two members shared and the tags exchanged
+// between them. The better (lower) finish rank wins a round. Rounds and exchanges are
+// ordered oldest first in the result regardless of input order; exchanges not
+// between the two members are ignored.
+func BuildHeadToHead(memberA, memberB string, rounds []HeadToHeadRound, exchanges []TagExchange) HeadToHeadRecord {
+	record := HeadToHeadRecord{MemberA: memberA, MemberB: memberB}
+
+	ordered := make([]HeadToHeadRound, len(rounds))
+	copy(ordered, rounds)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].PlayedAt.Before(ordered[j].PlayedAt)
+	})
+
+	strokeTotal := 0
+	for _, r := range ordered {
+		point := HeadToHeadPoint{RoundID: r.RoundID, PlayedAt: r.PlayedAt}
+		switch {
+		case r.RankA < r.RankB:
+			record.Wins++
+			point.Result = HeadToHeadWin
+		case r.RankA > r.RankB:
+			record.Losses++
+			point.Result = HeadToHeadLoss
+		default:
+			record.Ties++
+			point.Result = HeadToHeadTie
+		}
+		if r.ScoreA != nil && r.ScoreB != nil {
+			diff := *r.ScoreA - *r.ScoreB
+			point.StrokeDifferential = &diff
+			strokeTotal += diff
+			record.StrokeRounds++
+		}
+		point.Wins, point.Losses, point.Ties = record.Wins, record.Losses, record.Ties
+		record.Series = append(record.Series, point)
+	}
+	record.Rounds = len(ordered)
+	if record.StrokeRounds > 0 {
+		avg := float64(strokeTotal) / float64(record.StrokeRounds)
+		record.AverageStrokeDifferential = math.Round(avg*100) / 100
+	}
+
+	for _, ex := range exchanges {
+		switch {
+		case ex.From == memberB && ex.To == memberA:
+			record.TagsTaken++
+		case ex.From == memberA && ex.To == memberB:
+			record.TagsLost++
+		default:
+			continue
+		}
+		record.Exchanges = append(record.Exchanges, ex)
+	}
+	sort.SliceStable(record.Exchanges, func(i, j int) bool {
+		return record.Exchanges[i].At.Before(record.Exchanges[j].At)
+	})
+
+	return record
+}
@@ -0,0 +1,67 @@
+package leaderboarddomain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildHeadToHead(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 18, 0, 0, 0, time.UTC) }
+	score := func(v int) *int { return &v }
+
+	rounds := []HeadToHeadRound{
+		{RoundID: "r3", PlayedAt: day(3), RankA: 2, RankB: 2},
+		{RoundID: "r1", PlayedAt: day(1), RankA: 1, RankB: 3, ScoreA: score(50), ScoreB: score(54)},
+		{RoundID: "r2", PlayedAt: day(2), RankA: 4, RankB: 2, ScoreA: score(58), ScoreB: score(57)},
+	}
+	exchanges := []TagExchange{
+		{RoundID: "r2", TagNumber: 3, From: "alice", To: "bob", At: day(2)},
+		{RoundID: "r1", TagNumber: 5, From: "bob", To: "alice", At: day(1)},
+		{RoundID: "r1", TagNumber: 7, From: "carol", To: "alice", At: day(1)},
+	}
+
+	got := BuildHeadToHead("alice", "bob", rounds, exchanges)
+
+	if got.Rounds != 3 || got.Wins != 1 || got.Losses != 1 || got.Ties != 1 {
+		t.Fatalf("record %d rounds %d-%d-%d, want 3 rounds 1-1-1", got.Rounds, got.Wins, got.Losses, got.Ties)
+	}
+	if got.StrokeRounds != 2 || got.AverageStrokeDifferential != -1.5 {
+		t.Fatalf("stroke differential %v over %d rounds, want -1.5 over 2", got.AverageStrokeDifferential, got.StrokeRounds)
+	}
+	if got.TagsTaken != 1 || got.TagsLost != 1 || len(got.Exchanges) != 2 {
+		t.Fatalf("tags taken %d lost %d exchanges %+v", got.TagsTaken, got.TagsLost, got.Exchanges)
+	}
+	if got.Exchanges[0].TagNumber != 5 {
+		t.Fatalf("exchanges should be oldest first, got %+v", got.Exchanges)
+	}
+
+	wantSeries := []struct {
+		round           string
+		result          HeadToHeadResult
+		w, l, ties      int
+		hasDifferential bool
+	}{
+		{"r1", HeadToHeadWin, 1, 0, 0, true},
+		{"r2", HeadToHeadLoss, 1, 1, 0, true},
+		{"r3", HeadToHeadTie, 1, 1, 1, false},
+	}
+	if len(got.Series) != len(wantSeries) {
+		t.Fatalf("series has %d points, want %d", len(got.Series), len(wantSeries))
+	}
+	for i, want := range wantSeries {
+		p := got.Series[i]
+		if p.RoundID != want.round || p.Result != want.result || p.Wins != want.w || p.Losses != want.l || p.Ties != want.ties {
+			t.Fatalf("point %d = %+v, want %+v", i, p, want)
+		}
+		if (p.StrokeDifferential != nil) != want.hasDifferential {
+			t.Fatalf("point %d stroke differential %v", i, p.StrokeDifferential)
+		}
+	}
+}
+
+func TestBuildHeadToHead_NoSharedRounds(t *testing.T) {
+	got := BuildHeadToHead("alice", "bob", nil, nil)
+	if got.Rounds != 0 || got.AverageStrokeDifferential != 0 || got.Series != nil {
+		t.Fatalf("expected an empty record, got %+v", got)
+	}
+}
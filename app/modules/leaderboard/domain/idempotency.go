@@ -9,9 +9,14 @@ import (
 )
 
 // RoundInput represents the raw input for a round that needs to be hashed for idempotency.
+// It is also stored with the round outcome so the round can be replayed later.
 type RoundInput struct {
-	MemberID   string
-	FinishRank int
+	MemberID   string `json:"member_id"`
+	FinishRank int    `json:"finish_rank"`
+	TagRank    int    `json:"tag_rank,omitempty"` // 0 when tags follow FinishRank
+	// DivisionID is the division the member played the round in. It is stored so
+	// replays reallocate tags within the same divisions, and is not part of the hash.
+	DivisionID string `json:"division_id,omitempty"`
 }
 
 // ComputeProcessingHash generates a deterministic hash from round input data.
@@ -30,6 +35,12 @@ func ComputeProcessingHash(inputs []RoundInput) string {
 
 	var sb strings.Builder
 	for _, inp := range sorted {
+		// TagRank is only written when set so hashes of rounds without a separate
+		// tag order stay identical to those stored before it existed.
+		if inp.TagRank > 0 {
+			fmt.Fprintf(&sb, "%s:%d:%d;", inp.MemberID, inp.FinishRank, inp.TagRank)
+			continue
+		}
 		fmt.Fprintf(&sb, "%s:%d;", inp.MemberID, inp.FinishRank)
 	}
 
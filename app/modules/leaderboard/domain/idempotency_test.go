@@ -1,6 +1,10 @@
 package leaderboarddomain
 
-import "testing"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
 
 func TestComputeProcessingHashDeterministic(t *testing.T) {
 	__codexTDCases := []struct {
@@ -55,3 +59,22 @@ func TestComputeProcessingHashChangesWhenInputChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeProcessingHashTagRank(t *testing.T) {
+	base := []RoundInput{
+		{MemberID: "alice", FinishRank: 1},
+		{MemberID: "bob", FinishRank: 2},
+	}
+	withTagRank := []RoundInput{
+		{MemberID: "alice", FinishRank: 1, TagRank: 2},
+		{MemberID: "bob", FinishRank: 2, TagRank: 1},
+	}
+
+	legacy := sha256.Sum256([]byte("alice:1;bob:2;"))
+	if got, want := ComputeProcessingHash(base), hex.EncodeToString(legacy[:]); got != want {
+		t.Fatalf("expected unset TagRank to keep the legacy hash, got %s want %s", got, want)
+	}
+	if ComputeProcessingHash(base) == ComputeProcessingHash(withTagRank) {
+		t.Fatal("expected TagRank to change the processing hash")
+	}
+}
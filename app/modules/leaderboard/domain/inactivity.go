@@ -0,0 +1,219 @@
+package leaderboarddomain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// InactivityAction is what happens to the tag of a member who stopped playing.
+type InactivityAction string
+
+const (
+	// InactivityActionDrop moves the member below the next DropPositions active members
+	// in tag order; the members they pass each move up.
+	InactivityActionDrop InactivityAction = "drop"
+	// InactivityActionRelease takes the member's tag away, leaving it unclaimed.
+	InactivityActionRelease InactivityAction = "release"
+)
+
+// InactivityReason is the tag history reason recorded for inactivity decay.
+const InactivityReason = "inactivity"
+
+// ErrInvalidInactivityPolicy is returned when an inactivity policy cannot be applied.
+var ErrInvalidInactivityPolicy = errors.New("invalid inactivity policy")
+
+// InactivityPolicy decays the tags of members who go InactiveWeeks without a finalized
+// round. A member who stays away is decayed again after every further InactiveWeeks.
+type InactivityPolicy struct {
+	Enabled       bool             `json:"enabled"`
+	InactiveWeeks int              `json:"inactive_weeks"`
+	Action        InactivityAction `json:"action"`
+	DropPositions int              `json:"drop_positions,omitempty"`
+}
+
+// Validate reports whether the policy can be applied.
+func (p InactivityPolicy) Validate() error {
+	if p.InactiveWeeks <= 0 {
+		return fmt.Errorf("%w: inactive weeks must be positive", ErrInvalidInactivityPolicy)
+	}
+	switch p.Action {
+	case InactivityActionDrop:
+		if p.DropPositions <= 0 {
+			return fmt.Errorf("%w: drop positions must be positive", ErrInvalidInactivityPolicy)
+		}
+	case InactivityActionRelease:
+	default:
+		return fmt.Errorf("%w: unknown action %q", ErrInvalidInactivityPolicy, p.Action)
+	}
+	return nil
+}
+
+// Window is how long a member may go without activity before their tag decays.
+func (p InactivityPolicy) Window() time.Duration {
+	return time.Duration(p.InactiveWeeks) * 7 * 24 * time.Hour
+}
+
+// MemberActivity is a tag holder and when they were last active. Zero LastActiveAt
+// means no activity on record.
+type MemberActivity struct {
+	MemberID     string
+	Tag          int
+	LastActiveAt time.Time
+}
+
+// InactivityDecay is an inactive member whose tag changes. NewTag is 0 when the tag
+// is released.
+type InactivityDecay struct {
+	MemberID     string
+	Tag          int
+	NewTag       int
+	LastActiveAt time.Time
+}
+
+// InactivityPlan is the outcome of one decay run. Assignments holds the new tag of
+// every member whose tag changes, including members who move up past a dropped one;
+// 0 means the tag is released.
+type InactivityPlan struct {
+	Decayed     []InactivityDecay
+	Assignments map[string]int
+}
+
+// PlanInactivityDecay decides whose tags decay at now. Members with LastActiveAt at or
+// before now minus the policy window are inactive. Dropped members keep their order
+// relative to each other; an inactive member with no active member below them keeps
+// their tag. The policy's Enabled flag is not consulted so disabled policies can be
+// previewed.
+func PlanInactivityDecay(policy InactivityPolicy, members []MemberActivity, now time.Time) InactivityPlan {
+	plan := InactivityPlan{Assignments: make(map[string]int)}
+	if policy.Validate() != nil {
+		return plan
+	}
+
+	held := make([]MemberActivity, 0, len(members))
+	for _, m := range members {
+		if m.Tag > 0 {
+			held = append(held, m)
+		}
+	}
+	slices.SortFunc(held, func(a, b MemberActivity) int {
+		if c := cmp.Compare(a.Tag, b.Tag); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.MemberID, b.MemberID)
+	})
+
+	cutoff := now.Add(-policy.Window())
+	inactive := make([]bool, len(held))
+	for i, m := range held {
+		inactive[i] = !m.LastActiveAt.After(cutoff)
+	}
+
+	if policy.Action == InactivityActionRelease {
+		for i, m := range held {
+			if inactive[i] {
+				plan.Decayed = append(plan.Decayed, InactivityDecay{MemberID: m.MemberID, Tag: m.Tag, LastActiveAt: m.LastActiveAt})
+				plan.Assignments[m.MemberID] = 0
+			}
+		}
+		return plan
+	}
+
+	// Each inactive member is placed just below the DropPositions-th active member
+	// beneath them (or the last one, if fewer remain).
+	type placement struct {
+		after    int // index of the active member this one is placed after
+		inactive bool
+		index    int
+	}
+	placements := make([]placement, len(held))
+	for i := range held {
+		placements[i] = placement{after: i, inactive: inactive[i], index: i}
+		if !inactive[i] {
+			continue
+		}
+		passed := 0
+		for j := i + 1; j < len(held) && passed < policy.DropPositions; j++ {
+			if !inactive[j] {
+				placements[i].after = j
+				passed++
+			}
+		}
+	}
+	slices.SortFunc(placements, func(a, b placement) int {
+		if c := cmp.Compare(a.after, b.after); c != 0 {
+			return c
+		}
+		if a.inactive != b.inactive {
+			if a.inactive {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(a.index, b.index)
+	})
+
+	for slot, p := range placements {
+		m := held[p.index]
+		newTag := held[slot].Tag
+		if newTag == m.Tag {
+			continue
+		}
+		plan.Assignments[m.MemberID] = newTag
+		if p.inactive {
+			plan.Decayed = append(plan.Decayed, InactivityDecay{MemberID: m.MemberID, Tag: m.Tag, NewTag: newTag, LastActiveAt: m.LastActiveAt})
+		}
+	}
+	slices.SortFunc(plan.Decayed, func(a, b InactivityDecay) int { return cmp.Compare(a.Tag, b.Tag) })
+	return plan
+}
+
+// LedgerActivity returns, per member, the latest time the tag ledger shows them taking
+// a tag or being decayed for inactivity. Members who only moved up past a decayed
+// member are not counted as active by that decay. Entries must be in time order.
+func LedgerActivity(entries []TagLedgerEntry) map[string]time.Time {
+	last := make(map[string]time.Time)
+	for i := 0; i < len(entries); {
+		j := i
+		for j < len(entries) && entries[j].At.Equal(entries[i].At) {
+			j++
+		}
+		batch := entries[i:j]
+		at := batch[0].At
+		i = j
+
+		decay := false
+		for _, e := range batch {
+			if e.Reason == InactivityReason {
+				decay = true
+				break
+			}
+		}
+		if !decay {
+			for _, e := range batch {
+				if e.NewMemberID != "" {
+					last[e.NewMemberID] = at
+				}
+			}
+			continue
+		}
+
+		gained := make(map[string]int)
+		for _, e := range batch {
+			if e.NewMemberID != "" {
+				gained[e.NewMemberID] = e.TagNumber
+			}
+		}
+		for _, e := range batch {
+			if e.OldMemberID == "" {
+				continue
+			}
+			if newTag, ok := gained[e.OldMemberID]; !ok || newTag > e.TagNumber {
+				last[e.OldMemberID] = at
+			}
+		}
+	}
+	return last
+}
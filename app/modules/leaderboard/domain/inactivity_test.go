@@ -0,0 +1,95 @@
+package leaderboarddomain
+
+import (
+	"errors"
+	"maps"
+	"testing"
+	"time"
+)
+
+func TestInactivityPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy InactivityPolicy
+		valid  bool
+	}{
+		{"drop", InactivityPolicy{InactiveWeeks: 4, Action: InactivityActionDrop, DropPositions: 3}, true},
+		{"release", InactivityPolicy{InactiveWeeks: 8, Action: InactivityActionRelease}, true},
+		{"no window", InactivityPolicy{Action: InactivityActionRelease}, false},
+		{"drop without positions", InactivityPolicy{InactiveWeeks: 4, Action: InactivityActionDrop}, false},
+		{"unknown action", InactivityPolicy{InactiveWeeks: 4, Action: "shuffle"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidInactivityPolicy) {
+				t.Fatalf("expected ErrInvalidInactivityPolicy, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPlanInactivityDecay_Drop(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	recent := now.AddDate(0, 0, -3)
+	stale := now.AddDate(0, 0, -40)
+	policy := InactivityPolicy{Enabled: true, InactiveWeeks: 4, Action: InactivityActionDrop, DropPositions: 2}
+
+	plan := PlanInactivityDecay(policy, []MemberActivity{
+		{MemberID: "alice", Tag: 1, LastActiveAt: stale},
+		{MemberID: "bob", Tag: 2}, // never active
+		{MemberID: "carol", Tag: 4, LastActiveAt: recent},
+		{MemberID: "dave", Tag: 5, LastActiveAt: recent},
+		{MemberID: "erin", Tag: 7, LastActiveAt: recent},
+		{MemberID: "frank", Tag: 9, LastActiveAt: stale}, // nobody active below
+	}, now)
+
+	// alice and bob both drop below carol and dave, keeping their order.
+	want := map[string]int{"carol": 1, "dave": 2, "alice": 4, "bob": 5}
+	if !maps.Equal(plan.Assignments, want) {
+		t.Fatalf("assignments = %v, want %v", plan.Assignments, want)
+	}
+	if len(plan.Decayed) != 2 || plan.Decayed[0].MemberID != "alice" || plan.Decayed[0].NewTag != 4 || plan.Decayed[1].MemberID != "bob" {
+		t.Fatalf("unexpected decayed members: %+v", plan.Decayed)
+	}
+}
+
+func TestPlanInactivityDecay_Release(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	policy := InactivityPolicy{InactiveWeeks: 2, Action: InactivityActionRelease}
+
+	plan := PlanInactivityDecay(policy, []MemberActivity{
+		{MemberID: "alice", Tag: 1, LastActiveAt: now.AddDate(0, 0, -14)}, // exactly at the window
+		{MemberID: "bob", Tag: 2, LastActiveAt: now.AddDate(0, 0, -13)},
+		{MemberID: "carol", Tag: 0}, // no tag, nothing to release
+	}, now)
+
+	if want := map[string]int{"alice": 0}; !maps.Equal(plan.Assignments, want) {
+		t.Fatalf("assignments = %v, want %v", plan.Assignments, want)
+	}
+	if len(plan.Decayed) != 1 || plan.Decayed[0].Tag != 1 || plan.Decayed[0].NewTag != 0 {
+		t.Fatalf("unexpected decayed members: %+v", plan.Decayed)
+	}
+}
+
+func TestLedgerActivity(t *testing.T) {
+	t0 := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(24 * time.Hour)
+	last := LedgerActivity([]TagLedgerEntry{
+		{At: t0, TagNumber: 3, OldMemberID: "bob", NewMemberID: "alice", Reason: "round_swap"},
+		{At: t0, TagNumber: 5, OldMemberID: "alice", NewMemberID: "bob", Reason: "round_swap"},
+		// Decay: carol drops from 1 to 3, alice moves up from 3 to 1.
+		{At: t1, TagNumber: 1, OldMemberID: "carol", NewMemberID: "alice", Reason: InactivityReason},
+		{At: t1, TagNumber: 3, OldMemberID: "alice", NewMemberID: "carol", Reason: InactivityReason},
+		// Release.
+		{At: t1, TagNumber: 8, OldMemberID: "dave", Reason: InactivityReason},
+	})
+
+	want := map[string]time.Time{"alice": t0, "bob": t0, "carol": t1, "dave": t1}
+	if !maps.EqualFunc(last, want, time.Time.Equal) {
+		t.Fatalf("last activity = %v, want %v", last, want)
+	}
+}
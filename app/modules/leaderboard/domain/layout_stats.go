@@ -0,0 +1,61 @@
+package leaderboarddomain
+
+import (
+	"math"
+	"sort"
+)
+
+// LayoutScore is one member's score relative to par for one round on a course layout.
+// Entries are expected oldest first.
+type LayoutScore struct {
+	MemberID string
+	ToPar    int
+}
+
+// LayoutMemberStats summarizes a member's par-relative results on one layout.
+type LayoutMemberStats struct {
+	MemberID     string
+	RoundsPlayed int
+	AverageToPar float64
+	BestToPar    int
+	LatestToPar  int
+}
+
+// SummarizeLayoutScores aggregates par-relative scores per member. Results are ordered
+// by average (lowest first), then by rounds played so regulars rank above one-offs.
+func SummarizeLayoutScores(scores []LayoutScore) []LayoutMemberStats {
+	byMember := make(map[string]*LayoutMemberStats)
+	totals := make(map[string]int)
+	order := make([]string, 0)
+
+	for _, s := range scores {
+		stats, ok := byMember[s.MemberID]
+		if !ok {
+			stats = &LayoutMemberStats{MemberID: s.MemberID, BestToPar: s.ToPar}
+			byMember[s.MemberID] = stats
+			order = append(order, s.MemberID)
+		}
+		stats.RoundsPlayed++
+		stats.LatestToPar = s.ToPar
+		if s.ToPar < stats.BestToPar {
+			stats.BestToPar = s.ToPar
+		}
+		totals[s.MemberID] += s.ToPar
+	}
+
+	out := make([]LayoutMemberStats, 0, len(order))
+	for _, id := range order {
+		stats := byMember[id]
+		avg := float64(totals[id]) / float64(stats.RoundsPlayed)
+		stats.AverageToPar = math.Round(avg*100) / 100
+		out = append(out, *stats)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].AverageToPar != out[j].AverageToPar {
+			return out[i].AverageToPar < out[j].AverageToPar
+		}
+		return out[i].RoundsPlayed > out[j].RoundsPlayed
+	})
+	return out
+}
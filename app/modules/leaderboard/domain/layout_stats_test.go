@@ -0,0 +1,55 @@
+package leaderboarddomain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSummarizeLayoutScores(t *testing.T) {
+	tests := []struct {
+		name   string
+		scores []LayoutScore
+		want   []LayoutMemberStats
+	}{
+		{
+			name:   "no scores",
+			scores: nil,
+			want:   []LayoutMemberStats{},
+		},
+		{
+			name: "aggregates per member and orders by average",
+			scores: []LayoutScore{
+				{MemberID: "a", ToPar: 2},
+				{MemberID: "b", ToPar: -4},
+				{MemberID: "a", ToPar: -1},
+				{MemberID: "b", ToPar: -2},
+				{MemberID: "a", ToPar: 0},
+			},
+			want: []LayoutMemberStats{
+				{MemberID: "b", RoundsPlayed: 2, AverageToPar: -3, BestToPar: -4, LatestToPar: -2},
+				{MemberID: "a", RoundsPlayed: 3, AverageToPar: 0.33, BestToPar: -1, LatestToPar: 0},
+			},
+		},
+		{
+			name: "ties favour members with more rounds",
+			scores: []LayoutScore{
+				{MemberID: "once", ToPar: 1},
+				{MemberID: "twice", ToPar: 0},
+				{MemberID: "twice", ToPar: 2},
+			},
+			want: []LayoutMemberStats{
+				{MemberID: "twice", RoundsPlayed: 2, AverageToPar: 1, BestToPar: 0, LatestToPar: 2},
+				{MemberID: "once", RoundsPlayed: 1, AverageToPar: 1, BestToPar: 1, LatestToPar: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SummarizeLayoutScores(tt.scores)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SummarizeLayoutScores() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
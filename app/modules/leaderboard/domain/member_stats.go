@@ -0,0 +1,154 @@
+package leaderboarddomain
+
+import (
+	"math"
+	"time"
+)
+
+// podiumRank is the worst finish rank that still counts as a podium.
+const podiumRank = 3
+
+// StatsRound is one processed guild round as seen by a member's stats. Rounds are
+// expected oldest first and include those the member missed, which break their
+// attendance streak.
+type StatsRound struct {
+	PlayedAt time.Time
+	// FinishRank is the member's finish rank, 0 when they did not play the round.
+	FinishRank int
+	// Score is the member's gross stroke total; nil when the round has no stored score.
+	Score *int
+	// Par is the layout's total par; 0 when the round's par scores are unknown.
+	Par int
+}
+
+// Streak is a run of consecutive rounds meeting some condition.
+type Streak struct {
+	Current int `json:"current"`
+	Longest int `json:"longest"`
+}
+
+// Reliability is a member's check-in record across rounds with check-in enabled
+// that started. Rate is the share of those rounds the member turned up for.
+type Reliability struct {
+	CheckedIn int     `json:"checked_in"`
+	NoShows   int     `json:"no_shows"`
+	Rate      float64 `json:"rate"`
+}
+
+// MemberStats is a member's statistics profile.
+type MemberStats struct {
+	MemberID string `json:"member_id"`
+
+	RoundsPlayed int        `json:"rounds_played"`
+	LastPlayedAt *time.Time `json:"last_played_at,omitempty"`
+
+	// ScoredRounds counts the rounds played with a stored score; the score figures
+	// cover only those rounds and are nil when there are none.
+	ScoredRounds int      `json:"scored_rounds"`
+	AverageScore *float64 `json:"average_score,omitempty"`
+	BestScore    *int     `json:"best_score,omitempty"`
+	WorstScore   *int     `json:"worst_score,omitempty"`
+	// ParRounds counts the scored rounds whose layout par is known.
+	ParRounds    int      `json:"par_rounds"`
+	AverageToPar *float64 `json:"average_to_par,omitempty"`
+
+	Wins    int `json:"wins"`
+	Podiums int `json:"podiums"`
+
+	CurrentTag *int `json:"current_tag,omitempty"`
+	BestTag    *int `json:"best_tag,omitempty"`
+
+	// SeasonID names the season PointsRank and SeasonPoints refer to. PointsRank is nil
+	// when there is no active season or the member has no standing in it.
+	SeasonID     string `json:"season_id,omitempty"`
+	SeasonPoints int    `json:"season_points"`
+	PointsRank   *int   `json:"points_rank,omitempty"`
+
+	// WinStreak and PodiumStreak count consecutive rounds played; missed rounds neither
+	// extend nor break them. AttendanceStreak counts consecutive guild rounds played.
+	WinStreak        Streak `json:"win_streak"`
+	PodiumStreak     Streak `json:"podium_streak"`
+	AttendanceStreak Streak `json:"attendance_streak"`
+
+	// Reliability is nil when the member has no check-in history.
+	Reliability *Reliability `json:"reliability,omitempty"`
+
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// BuildMemberStats aggregates the round-derived part of a member's stats. Tags and
+// points are left for the caller to fill in. A finish rank of 1 is a win, shared or not.
+func BuildMemberStats(memberID string, rounds []StatsRound) MemberStats {
+	stats := MemberStats{MemberID: memberID}
+
+	var scoreTotal, toParTotal int
+	for _, r := range rounds {
+		played := r.FinishRank > 0
+		extendStreak(&stats.AttendanceStreak, played)
+		if !played {
+			continue
+		}
+
+		stats.RoundsPlayed++
+		playedAt := r.PlayedAt
+		stats.LastPlayedAt = &playedAt
+
+		won := r.FinishRank == 1
+		podium := r.FinishRank <= podiumRank
+		if won {
+			stats.Wins++
+		}
+		if podium {
+			stats.Podiums++
+		}
+		extendStreak(&stats.WinStreak, won)
+		extendStreak(&stats.PodiumStreak, podium)
+
+		if r.Score == nil {
+			continue
+		}
+		score := *r.Score
+		stats.ScoredRounds++
+		scoreTotal += score
+		if stats.BestScore == nil || score < *stats.BestScore {
+			stats.BestScore = &score
+		}
+		if stats.WorstScore == nil || score > *stats.WorstScore {
+			stats.WorstScore = &score
+		}
+		if r.Par > 0 {
+			stats.ParRounds++
+			toParTotal += score - r.Par
+		}
+	}
+
+	if stats.ScoredRounds > 0 {
+		avg := math.Round(float64(scoreTotal)/float64(stats.ScoredRounds)*100) / 100
+		stats.AverageScore = &avg
+	}
+	if stats.ParRounds > 0 {
+		avg := math.Round(float64(toParTotal)/float64(stats.ParRounds)*100) / 100
+		stats.AverageToPar = &avg
+	}
+	return stats
+}
+
+// BestTag returns the lowest tag number among the given ones, ignoring non-positive
+// numbers. ok is false when there is none.
+func BestTag(tags ...int) (best int, ok bool) {
+	for _, tag := range tags {
+		if tag > 0 && (!ok || tag < best) {
+			best, ok = tag, true
+		}
+	}
+	return best, ok
+}
+
+func extendStreak(s *Streak, hit bool) {
+	if !hit {
+		s.Current = 0
+		return
+	}
+	s.Current++
+	s.Longest = max(s.Longest, s.Current)
+}
@@ -0,0 +1,65 @@
+package leaderboarddomain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildMemberStats(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 5, d, 18, 0, 0, 0, time.UTC) }
+	score := func(v int) *int { return &v }
+	rounds := []StatsRound{
+		{PlayedAt: day(1), FinishRank: 1, Score: score(54), Par: 54},
+		{PlayedAt: day(2), FinishRank: 1, Score: score(50), Par: 54},
+		{PlayedAt: day(3), FinishRank: 2, Score: score(58)},
+		{PlayedAt: day(4)}, // missed
+		{PlayedAt: day(5), FinishRank: 1},
+		{PlayedAt: day(6), FinishRank: 5, Score: score(61), Par: 57},
+		{PlayedAt: day(7), FinishRank: 3, Score: score(55), Par: 57},
+	}
+
+	got := BuildMemberStats("alice", rounds)
+
+	if got.RoundsPlayed != 6 || got.Wins != 3 || got.Podiums != 5 {
+		t.Fatalf("played/wins/podiums = %d/%d/%d, want 6/3/5", got.RoundsPlayed, got.Wins, got.Podiums)
+	}
+	if got.LastPlayedAt == nil || !got.LastPlayedAt.Equal(day(7)) {
+		t.Fatalf("last played %v, want %v", got.LastPlayedAt, day(7))
+	}
+	if got.ScoredRounds != 5 || *got.BestScore != 50 || *got.WorstScore != 61 || *got.AverageScore != 55.6 {
+		t.Fatalf("unexpected score stats: %+v", got)
+	}
+	// (0 - 4 + 4 - 2) / 4 rounds with par
+	if got.ParRounds != 4 || got.AverageToPar == nil || *got.AverageToPar != -0.5 {
+		t.Fatalf("par rounds %d, average to par %v; want 4, -0.5", got.ParRounds, got.AverageToPar)
+	}
+	if got.WinStreak != (Streak{Current: 0, Longest: 2}) {
+		t.Fatalf("win streak %+v", got.WinStreak)
+	}
+	// The missed round does not break the podium streak.
+	if got.PodiumStreak != (Streak{Current: 1, Longest: 4}) {
+		t.Fatalf("podium streak %+v", got.PodiumStreak)
+	}
+	if got.AttendanceStreak != (Streak{Current: 3, Longest: 3}) {
+		t.Fatalf("attendance streak %+v", got.AttendanceStreak)
+	}
+}
+
+func TestBuildMemberStats_NoScores(t *testing.T) {
+	got := BuildMemberStats("bob", []StatsRound{{FinishRank: 2}})
+	if got.RoundsPlayed != 1 || got.ScoredRounds != 0 {
+		t.Fatalf("unexpected counts: %+v", got)
+	}
+	if got.AverageScore != nil || got.BestScore != nil || got.WorstScore != nil || got.AverageToPar != nil {
+		t.Fatalf("expected no score figures, got %+v", got)
+	}
+}
+
+func TestBestTag(t *testing.T) {
+	if best, ok := BestTag(7, 0, 3, -1, 12); !ok || best != 3 {
+		t.Fatalf("BestTag = %d, %v; want 3, true", best, ok)
+	}
+	if _, ok := BestTag(0); ok {
+		t.Fatal("expected no best tag")
+	}
+}
@@ -21,6 +21,12 @@ type PointAward struct {
 	Points          int
 	OpponentsBeaten int
 	Tier            Tier
+	GiantSlays      int // Gold-tier opponents beaten while Bronze
+}
+
+// CalculateRoundPoints computes points for all participants under the default policy.
+func CalculateRoundPoints(participants []RoundParticipant) []PointAward {
+	return DefaultPointsPolicy().CalculateRoundPoints(participants)
 }
 
 // CalculateRoundPoints computes points for all participants using the opponents-defeated matrix.
@@ -28,8 +34,10 @@ type PointAward struct {
 // Participants are sorted by tag number ascending (best tag = rank 1).
 // Each participant earns points for every tagged opponent they outrank (have a lower tag number).
 // Untagged participants (TagNumber == 0) do not count as opponents and award no points when beaten.
-// Tier bonuses apply per matchup according to CalculateMatchup rules.
-func CalculateRoundPoints(participants []RoundParticipant) []PointAward {
+// Under the matchup system, tier bonuses apply per matchup according to Matchup rules; the
+// linear and field-scaled systems instead award points by placement among tagged players,
+// with tied finishers sharing a placement.
+func (p PointsPolicy) CalculateRoundPoints(participants []RoundParticipant) []PointAward {
 	if len(participants) == 0 {
 		return nil
 	}
@@ -53,12 +61,24 @@ func CalculateRoundPoints(participants []RoundParticipant) []PointAward {
 		return cmp.Compare(a.MemberID, b.MemberID)
 	})
 
+	fieldSize := 0
+	for _, participant := range sorted {
+		if participant.TagNumber > 0 {
+			fieldSize++
+		}
+	}
+
 	var finalAwards []PointAward // Build dynamically since we skip untagged
+	place := 0
 
 	for i := 0; i < len(sorted); i++ {
 		if sorted[i].TagNumber <= 0 {
 			continue // entirely skip points & season updates for untagged members
 		}
+		// Tagged players sort first, so i is the count of tagged players ahead.
+		if i == 0 || !tiedFinish(sorted[i-1], sorted[i]) {
+			place = i + 1
+		}
 		winner := PlayerContext{
 			ID:           sorted[i].MemberID,
 			RoundsPlayed: sorted[i].RoundsPlayed,
@@ -68,6 +88,7 @@ func CalculateRoundPoints(participants []RoundParticipant) []PointAward {
 
 		totalPoints := 0
 		opponentsBeaten := 0
+		giantSlays := 0
 
 		// Winner beats every tagged opponent ranked below them (excluding tied players).
 		// Two participants with equal FinishRank share the same finish position and
@@ -82,7 +103,7 @@ func CalculateRoundPoints(participants []RoundParticipant) []PointAward {
 			if sorted[j].TagNumber <= 0 {
 				continue // untagged players are not counted as opponents
 			}
-			if sorted[i].FinishRank > 0 && sorted[j].FinishRank > 0 && sorted[i].FinishRank == sorted[j].FinishRank {
+			if tiedFinish(sorted[i], sorted[j]) {
 				continue // tied players share the finish position — skip each other
 			}
 
@@ -93,22 +114,35 @@ func CalculateRoundPoints(participants []RoundParticipant) []PointAward {
 				CurrentTier:  sorted[j].CurrentTier,
 			}
 
-			matchPoints := CalculateMatchup(winner, loser)
-			totalPoints += int(matchPoints)
+			if p.System == PointsSystemMatchup {
+				totalPoints += int(p.Matchup(winner, loser))
+			}
+			if winner.CurrentTier == TierBronze && loser.CurrentTier == TierGold {
+				giantSlays++
+			}
 			opponentsBeaten++
 		}
+		if p.System != PointsSystemMatchup {
+			totalPoints = p.placementPoints(place, opponentsBeaten, fieldSize)
+		}
 
 		finalAwards = append(finalAwards, PointAward{
 			MemberID:        sorted[i].MemberID,
 			Points:          totalPoints,
 			OpponentsBeaten: opponentsBeaten,
 			Tier:            sorted[i].CurrentTier,
+			GiantSlays:      giantSlays,
 		})
 	}
 
 	return finalAwards
 }
 
+// tiedFinish reports whether two participants share a finish position.
+func tiedFinish(a, b RoundParticipant) bool {
+	return a.FinishRank > 0 && b.FinishRank > 0 && a.FinishRank == b.FinishRank
+}
+
 // UpdateBestTag returns the better (lower) of the current best and the new tag.
 // A value of 0 means "no tag yet", so any positive tag beats it.
 func UpdateBestTag(currentBest, newTag int) int {
@@ -356,3 +356,19 @@ func TestCalculateRoundPoints_SingleParticipant(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateRoundPoints_CountsGiantSlays(t *testing.T) {
+	awards := CalculateRoundPoints([]RoundParticipant{
+		{MemberID: "bronze", TagNumber: 1, FinishRank: 1, RoundsPlayed: 10, CurrentTier: TierBronze},
+		{MemberID: "gold", TagNumber: 2, FinishRank: 2, RoundsPlayed: 10, CurrentTier: TierGold},
+		{MemberID: "silver", TagNumber: 3, FinishRank: 3, RoundsPlayed: 10, CurrentTier: TierSilver},
+	})
+
+	slays := make(map[string]int, len(awards))
+	for _, a := range awards {
+		slays[a.MemberID] = a.GiantSlays
+	}
+	if slays["bronze"] != 1 || slays["gold"] != 0 || slays["silver"] != 0 {
+		t.Fatalf("unexpected giant slay counts: %v", slays)
+	}
+}
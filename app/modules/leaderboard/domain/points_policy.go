@@ -0,0 +1,197 @@
+package leaderboarddomain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// PointsSystem selects how a round's finishing order is converted into season points.
+type PointsSystem string
+
+const (
+	// PointsSystemMatchup awards points per opponent beaten, with tier upset bonuses.
+	PointsSystemMatchup PointsSystem = "matchup"
+	// PointsSystemLinear awards fixed points per placement, decreasing by a constant step.
+	PointsSystemLinear PointsSystem = "linear"
+	// PointsSystemFieldScaled awards a share of MaxPoints by fraction of the field beaten,
+	// scaled down for fields smaller than FullFieldSize.
+	PointsSystemFieldScaled PointsSystem = "field_scaled"
+)
+
+// ErrInvalidPointsPolicy is returned when a points policy cannot be used for a season.
+var ErrInvalidPointsPolicy = errors.New("invalid points policy")
+
+// PointsPolicy is the points configuration a season is scored under. It is chosen when
+// the season starts and stays fixed so recalculated rounds score the same way.
+type PointsPolicy struct {
+	System PointsSystem `json:"system"`
+
+	// Matchup system.
+	BaseWin           int `json:"base_win,omitempty"`
+	BonusStandard     int `json:"bonus_standard,omitempty"`
+	BonusGiantSlayer  int `json:"bonus_giant_slayer,omitempty"`
+	ProvisionalRounds int `json:"provisional_rounds,omitempty"`
+
+	// Tier cut-offs as cumulative percentages of season members (Gold, then Silver).
+	GoldPercent   float64 `json:"gold_percent,omitempty"`
+	SilverPercent float64 `json:"silver_percent,omitempty"`
+
+	// Linear system.
+	FirstPlacePoints int `json:"first_place_points,omitempty"`
+	PlacementStep    int `json:"placement_step,omitempty"`
+	MinimumPoints    int `json:"minimum_points,omitempty"`
+
+	// Field-scaled system.
+	MaxPoints     int `json:"max_points,omitempty"`
+	FullFieldSize int `json:"full_field_size,omitempty"`
+}
+
+// DefaultPointsPolicy returns the original opponents-defeated matchup system.
+func DefaultPointsPolicy() PointsPolicy {
+	return PointsPolicy{
+		System:            PointsSystemMatchup,
+		BaseWin:           int(BaseWin),
+		BonusStandard:     int(BonusStandard),
+		BonusGiantSlayer:  int(BonusGiantSlayer),
+		ProvisionalRounds: 3,
+		GoldPercent:       10,
+		SilverPercent:     40,
+	}
+}
+
+// DefaultLinearPolicy returns placement points of 100 for first, 10 less per place.
+func DefaultLinearPolicy() PointsPolicy {
+	policy := DefaultPointsPolicy()
+	policy.System = PointsSystemLinear
+	policy.FirstPlacePoints = 100
+	policy.PlacementStep = 10
+	policy.MinimumPoints = 10
+	return policy
+}
+
+// DefaultFieldScaledPolicy returns 1000 points for winning a field of 12 or more.
+func DefaultFieldScaledPolicy() PointsPolicy {
+	policy := DefaultPointsPolicy()
+	policy.System = PointsSystemFieldScaled
+	policy.MaxPoints = 1000
+	policy.FullFieldSize = 12
+	return policy
+}
+
+// BuiltInPointsPolicy returns the default configuration of a built-in points system.
+func BuiltInPointsPolicy(system PointsSystem) (PointsPolicy, bool) {
+	switch system {
+	case PointsSystemMatchup, "":
+		return DefaultPointsPolicy(), true
+	case PointsSystemLinear:
+		return DefaultLinearPolicy(), true
+	case PointsSystemFieldScaled:
+		return DefaultFieldScaledPolicy(), true
+	default:
+		return PointsPolicy{}, false
+	}
+}
+
+// Validate reports whether the policy is usable for scoring.
+func (p PointsPolicy) Validate() error {
+	if p.GoldPercent <= 0 || p.GoldPercent > 100 || p.SilverPercent < p.GoldPercent || p.SilverPercent > 100 {
+		return fmt.Errorf("%w: tier percentages must satisfy 0 < gold <= silver <= 100", ErrInvalidPointsPolicy)
+	}
+	if p.ProvisionalRounds < 0 {
+		return fmt.Errorf("%w: provisional rounds cannot be negative", ErrInvalidPointsPolicy)
+	}
+
+	switch p.System {
+	case PointsSystemMatchup:
+		if p.BaseWin <= 0 || p.BonusStandard < 0 || p.BonusGiantSlayer < 0 {
+			return fmt.Errorf("%w: matchup points must be positive", ErrInvalidPointsPolicy)
+		}
+	case PointsSystemLinear:
+		if p.FirstPlacePoints <= 0 || p.PlacementStep < 0 || p.MinimumPoints < 0 || p.MinimumPoints > p.FirstPlacePoints {
+			return fmt.Errorf("%w: linear placement points must be positive and decreasing", ErrInvalidPointsPolicy)
+		}
+	case PointsSystemFieldScaled:
+		if p.MaxPoints <= 0 || p.FullFieldSize < 2 {
+			return fmt.Errorf("%w: field-scaled points need max points and a full field of at least 2", ErrInvalidPointsPolicy)
+		}
+	default:
+		return fmt.Errorf("%w: unknown system %q", ErrInvalidPointsPolicy, p.System)
+	}
+	return nil
+}
+
+// Matchup calculates the points earned by the winner of a single matchup under the
+// matchup system.
+func (p PointsPolicy) Matchup(winner, loser PlayerContext) Points {
+	// Base win points
+	winnerPoints := Points(p.BaseWin)
+
+	// Provisional players never earn bonuses.
+	if winner.RoundsPlayed < p.ProvisionalRounds {
+		return winnerPoints
+	}
+
+	// Gold tier players never earn bonuses.
+	if winner.CurrentTier == TierGold {
+		return winnerPoints
+	}
+
+	// Calculate bonuses based on tier gap if lower rank beats higher rank.
+	// Rank order: Gold > Silver > Bronze.
+
+	// Silver beats Gold -> Standard Bonus
+	if winner.CurrentTier == TierSilver && loser.CurrentTier == TierGold {
+		winnerPoints += Points(p.BonusStandard)
+	}
+
+	// Bronze beats Silver -> Standard Bonus
+	if winner.CurrentTier == TierBronze && loser.CurrentTier == TierSilver {
+		winnerPoints += Points(p.BonusStandard)
+	}
+
+	// Bronze beats Gold -> Giant Slayer Bonus
+	if winner.CurrentTier == TierBronze && loser.CurrentTier == TierGold {
+		winnerPoints += Points(p.BonusGiantSlayer)
+	}
+
+	return winnerPoints
+}
+
+// DetermineTier calculates the tier from the best tag and total members using the
+// policy's cumulative Gold and Silver percentages.
+func (p PointsPolicy) DetermineTier(bestTag int, totalMembers int) Tier {
+	if totalMembers <= 0 || bestTag <= 0 {
+		return TierBronze
+	}
+
+	goldCount := int(math.Ceil(float64(totalMembers) * p.GoldPercent / 100))
+	if bestTag <= goldCount {
+		return TierGold
+	}
+
+	silverCount := int(math.Ceil(float64(totalMembers) * p.SilverPercent / 100))
+	if bestTag <= silverCount {
+		return TierSilver
+	}
+
+	return TierBronze
+}
+
+// placementPoints returns the points for a 1-based placement in a field of fieldSize
+// tagged players under the linear or field-scaled systems.
+func (p PointsPolicy) placementPoints(place, opponentsBeaten, fieldSize int) int {
+	switch p.System {
+	case PointsSystemLinear:
+		return max(p.FirstPlacePoints-(place-1)*p.PlacementStep, p.MinimumPoints)
+	case PointsSystemFieldScaled:
+		if fieldSize < 2 {
+			return 0
+		}
+		share := float64(opponentsBeaten) / float64(fieldSize-1)
+		weight := math.Min(1, float64(fieldSize)/float64(p.FullFieldSize))
+		return int(math.Round(float64(p.MaxPoints) * share * weight))
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,135 @@
+package leaderboarddomain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPointsPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*PointsPolicy)
+		base    PointsPolicy
+		wantErr bool
+	}{
+		{name: "default matchup", base: DefaultPointsPolicy()},
+		{name: "default linear", base: DefaultLinearPolicy()},
+		{name: "default field scaled", base: DefaultFieldScaledPolicy()},
+		{name: "unknown system", base: DefaultPointsPolicy(), mutate: func(p *PointsPolicy) { p.System = "elo" }, wantErr: true},
+		{name: "silver below gold", base: DefaultPointsPolicy(), mutate: func(p *PointsPolicy) { p.SilverPercent = 5 }, wantErr: true},
+		{name: "zero base win", base: DefaultPointsPolicy(), mutate: func(p *PointsPolicy) { p.BaseWin = 0 }, wantErr: true},
+		{name: "linear minimum above first", base: DefaultLinearPolicy(), mutate: func(p *PointsPolicy) { p.MinimumPoints = 500 }, wantErr: true},
+		{name: "field scaled tiny field", base: DefaultFieldScaledPolicy(), mutate: func(p *PointsPolicy) { p.FullFieldSize = 1 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := tt.base
+			if tt.mutate != nil {
+				tt.mutate(&policy)
+			}
+			err := policy.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidPointsPolicy) {
+				t.Fatalf("expected ErrInvalidPointsPolicy, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPointsPolicy_MatchupUsesConfiguredValues(t *testing.T) {
+	policy := DefaultPointsPolicy()
+	policy.BaseWin = 10
+	policy.BonusGiantSlayer = 40
+	policy.ProvisionalRounds = 1
+
+	winner := PlayerContext{ID: "w", RoundsPlayed: 1, CurrentTier: TierBronze}
+	loser := PlayerContext{ID: "l", RoundsPlayed: 10, CurrentTier: TierGold}
+
+	if got := policy.Matchup(winner, loser); got != 50 {
+		t.Fatalf("expected 50 points, got %d", got)
+	}
+	if got := CalculateMatchup(winner, loser); got != BaseWin {
+		t.Fatalf("expected default policy to treat 1 round as provisional, got %d", got)
+	}
+}
+
+func TestPointsPolicy_DetermineTier(t *testing.T) {
+	policy := DefaultPointsPolicy()
+	policy.GoldPercent = 20
+	policy.SilverPercent = 50
+
+	cases := map[int]Tier{2: TierGold, 3: TierSilver, 5: TierSilver, 6: TierBronze}
+	for bestTag, want := range cases {
+		if got := policy.DetermineTier(bestTag, 10); got != want {
+			t.Errorf("DetermineTier(%d, 10) = %s, want %s", bestTag, got, want)
+		}
+	}
+	if got := DetermineTier(2, 10); got != TierSilver {
+		t.Errorf("expected default policy to keep 10%% gold cut-off, got %s", got)
+	}
+}
+
+func TestPointsPolicy_LinearPlacement(t *testing.T) {
+	policy := DefaultLinearPolicy()
+	participants := []RoundParticipant{
+		{MemberID: "a", TagNumber: 1, FinishRank: 1},
+		{MemberID: "b", TagNumber: 2, FinishRank: 2},
+		{MemberID: "c", TagNumber: 3, FinishRank: 2},
+		{MemberID: "d", TagNumber: 4, FinishRank: 4},
+		{MemberID: "untagged", TagNumber: 0, FinishRank: 5},
+	}
+
+	awards := policy.CalculateRoundPoints(participants)
+	want := map[string]int{"a": 100, "b": 90, "c": 90, "d": 70}
+	if len(awards) != len(want) {
+		t.Fatalf("expected %d awards, got %d", len(want), len(awards))
+	}
+	for _, award := range awards {
+		if award.Points != want[award.MemberID] {
+			t.Errorf("%s: expected %d points, got %d", award.MemberID, want[award.MemberID], award.Points)
+		}
+	}
+}
+
+func TestPointsPolicy_LinearMinimumPoints(t *testing.T) {
+	policy := DefaultLinearPolicy()
+	policy.PlacementStep = 60
+	policy.MinimumPoints = 25
+
+	awards := policy.CalculateRoundPoints([]RoundParticipant{
+		{MemberID: "a", TagNumber: 1, FinishRank: 1},
+		{MemberID: "b", TagNumber: 2, FinishRank: 2},
+		{MemberID: "c", TagNumber: 3, FinishRank: 3},
+	})
+	if awards[2].Points != 25 {
+		t.Fatalf("expected last place to receive minimum points, got %d", awards[2].Points)
+	}
+}
+
+func TestPointsPolicy_FieldScaled(t *testing.T) {
+	policy := DefaultFieldScaledPolicy()
+	policy.MaxPoints = 1200
+	policy.FullFieldSize = 6
+
+	small := policy.CalculateRoundPoints([]RoundParticipant{
+		{MemberID: "a", TagNumber: 1, FinishRank: 1},
+		{MemberID: "b", TagNumber: 2, FinishRank: 2},
+		{MemberID: "c", TagNumber: 3, FinishRank: 3},
+	})
+	// Winning a field of 3 out of a full field of 6 earns half of MaxPoints.
+	if small[0].Points != 600 || small[1].Points != 300 || small[2].Points != 0 {
+		t.Fatalf("unexpected small field awards: %+v", small)
+	}
+
+	full := make([]RoundParticipant, 0, 8)
+	for i := 1; i <= 8; i++ {
+		full = append(full, RoundParticipant{MemberID: string(rune('a' + i - 1)), TagNumber: i, FinishRank: i})
+	}
+	awards := policy.CalculateRoundPoints(full)
+	if awards[0].Points != 1200 {
+		t.Fatalf("expected winner of an oversized field to earn MaxPoints, got %d", awards[0].Points)
+	}
+}
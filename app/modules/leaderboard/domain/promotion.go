@@ -0,0 +1,175 @@
+package leaderboarddomain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// SeasonTransitionReason is the tag history reason recorded when tags are seeded for
+// members promoted or relegated at season end.
+const SeasonTransitionReason = "season_transition"
+
+// MaxPromotionCount caps how many members may swap across one boundary.
+const MaxPromotionCount = 50
+
+// ErrInvalidPromotionPolicy is returned when a promotion policy cannot be applied.
+var ErrInvalidPromotionPolicy = errors.New("invalid promotion policy")
+
+// PromotionBoundary swaps the bottom Count members of the upper division with the top
+// Count members of the lower division.
+type PromotionBoundary struct {
+	UpperDivisionID string `json:"upper_division_id"`
+	LowerDivisionID string `json:"lower_division_id"`
+	Count           int    `json:"count"`
+}
+
+// PromotionPolicy moves members between divisions when a season ends. Boundaries are
+// evaluated in order against the divisions as they stood at season end; a member moves
+// at most once.
+type PromotionPolicy struct {
+	Enabled    bool                `json:"enabled"`
+	Boundaries []PromotionBoundary `json:"boundaries"`
+}
+
+// Validate reports whether the policy can be applied. A division may be the upper side
+// of one boundary and the lower side of another, so flights can be chained.
+func (p PromotionPolicy) Validate() error {
+	if p.Enabled && len(p.Boundaries) == 0 {
+		return fmt.Errorf("%w: at least one boundary is required", ErrInvalidPromotionPolicy)
+	}
+	uppers := make(map[string]bool, len(p.Boundaries))
+	lowers := make(map[string]bool, len(p.Boundaries))
+	for _, b := range p.Boundaries {
+		if b.UpperDivisionID == b.LowerDivisionID {
+			return fmt.Errorf("%w: boundary divisions must differ", ErrInvalidPromotionPolicy)
+		}
+		if b.Count <= 0 || b.Count > MaxPromotionCount {
+			return fmt.Errorf("%w: count must be 1-%d", ErrInvalidPromotionPolicy, MaxPromotionCount)
+		}
+		if uppers[b.UpperDivisionID] || lowers[b.LowerDivisionID] {
+			return fmt.Errorf("%w: a division may sit above and below at most one boundary each", ErrInvalidPromotionPolicy)
+		}
+		uppers[b.UpperDivisionID] = true
+		lowers[b.LowerDivisionID] = true
+	}
+	return nil
+}
+
+// PromotionCandidate is a member as they stood at season end. Tag is 0 for untagged
+// members and Standing is zero for members without season standings.
+type PromotionCandidate struct {
+	MemberID   string
+	DivisionID string
+	Tag        int
+	Standing   FinalStanding
+}
+
+// PromotionMove is a member changing division at season end. Tag is the tag they left
+// behind and SeedTag the tag they start with in their new division; 0 means none.
+type PromotionMove struct {
+	MemberID       string `json:"member_id"`
+	FromDivisionID string `json:"from_division_id"`
+	ToDivisionID   string `json:"to_division_id"`
+	Promoted       bool   `json:"promoted"`
+	Tag            int    `json:"tag,omitempty"`
+	SeedTag        int    `json:"seed_tag,omitempty"`
+}
+
+// PlanPromotions decides who moves across each boundary. Divisions are ranked by
+// season points as in RankFinalStandings; only members who played a round can be
+// promoted, and each boundary swaps as many members as both sides can supply, up to
+// its count. Promoted members take the tags the relegated members leave behind and
+// vice versa, best-ranked member first.
+func PlanPromotions(policy PromotionPolicy, candidates []PromotionCandidate) []PromotionMove {
+	if !policy.Enabled {
+		return nil
+	}
+
+	byDivision := make(map[string][]PromotionCandidate)
+	for _, c := range candidates {
+		c.Standing.MemberID = c.MemberID
+		byDivision[c.DivisionID] = append(byDivision[c.DivisionID], c)
+	}
+
+	moved := make(map[string]bool)
+	var moves []PromotionMove
+	for _, b := range policy.Boundaries {
+		upper := rankCandidates(byDivision[b.UpperDivisionID], moved)
+		var promotable []PromotionCandidate
+		for _, c := range rankCandidates(byDivision[b.LowerDivisionID], moved) {
+			if c.Standing.RoundsPlayed > 0 {
+				promotable = append(promotable, c)
+			}
+		}
+
+		n := min(b.Count, len(upper), len(promotable))
+		if n == 0 {
+			continue
+		}
+		promoted := promotable[:n]
+		relegated := upper[len(upper)-n:]
+
+		promotedTags := seedTags(relegated)
+		for i, c := range promoted {
+			moves = append(moves, PromotionMove{
+				MemberID:       c.MemberID,
+				FromDivisionID: b.LowerDivisionID,
+				ToDivisionID:   b.UpperDivisionID,
+				Promoted:       true,
+				Tag:            c.Tag,
+				SeedTag:        tagAt(promotedTags, i),
+			})
+			moved[c.MemberID] = true
+		}
+		relegatedTags := seedTags(promoted)
+		for i, c := range relegated {
+			moves = append(moves, PromotionMove{
+				MemberID:       c.MemberID,
+				FromDivisionID: b.UpperDivisionID,
+				ToDivisionID:   b.LowerDivisionID,
+				Tag:            c.Tag,
+				SeedTag:        tagAt(relegatedTags, i),
+			})
+			moved[c.MemberID] = true
+		}
+	}
+	return moves
+}
+
+// rankCandidates orders the members not yet moved, best first.
+func rankCandidates(candidates []PromotionCandidate, moved map[string]bool) []PromotionCandidate {
+	byMember := make(map[string]PromotionCandidate, len(candidates))
+	standings := make([]FinalStanding, 0, len(candidates))
+	for _, c := range candidates {
+		if moved[c.MemberID] {
+			continue
+		}
+		byMember[c.MemberID] = c
+		standings = append(standings, c.Standing)
+	}
+	ranked := make([]PromotionCandidate, 0, len(standings))
+	for _, st := range RankFinalStandings(standings) {
+		ranked = append(ranked, byMember[st.MemberID])
+	}
+	return ranked
+}
+
+// seedTags returns the tags left behind by members, lowest first.
+func seedTags(members []PromotionCandidate) []int {
+	var tags []int
+	for _, m := range members {
+		if m.Tag > 0 {
+			tags = append(tags, m.Tag)
+		}
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+func tagAt(tags []int, i int) int {
+	if i < len(tags) {
+		return tags[i]
+	}
+	return 0
+}
@@ -0,0 +1,107 @@
+package leaderboarddomain
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestPromotionPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy PromotionPolicy
+		valid  bool
+	}{
+		{"single boundary", PromotionPolicy{Enabled: true, Boundaries: []PromotionBoundary{{"pro", "am", 2}}}, true},
+		{"chained flights", PromotionPolicy{Enabled: true, Boundaries: []PromotionBoundary{{"pro", "am1", 2}, {"am1", "am2", 3}}}, true},
+		{"disabled without boundaries", PromotionPolicy{}, true},
+		{"enabled without boundaries", PromotionPolicy{Enabled: true}, false},
+		{"same division", PromotionPolicy{Boundaries: []PromotionBoundary{{"pro", "pro", 2}}}, false},
+		{"zero count", PromotionPolicy{Boundaries: []PromotionBoundary{{"pro", "am", 0}}}, false},
+		{"count too large", PromotionPolicy{Boundaries: []PromotionBoundary{{"pro", "am", MaxPromotionCount + 1}}}, false},
+		{"upper twice", PromotionPolicy{Boundaries: []PromotionBoundary{{"pro", "am1", 1}, {"pro", "am2", 1}}}, false},
+		{"lower twice", PromotionPolicy{Boundaries: []PromotionBoundary{{"pro", "am", 1}, {"", "am", 1}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidPromotionPolicy) {
+				t.Fatalf("expected ErrInvalidPromotionPolicy, got %v", err)
+			}
+		})
+	}
+}
+
+func candidate(memberID, divisionID string, tag, points, rounds int) PromotionCandidate {
+	return PromotionCandidate{
+		MemberID:   memberID,
+		DivisionID: divisionID,
+		Tag:        tag,
+		Standing:   FinalStanding{TotalPoints: points, RoundsPlayed: rounds},
+	}
+}
+
+func TestPlanPromotions_SwapsAcrossBoundary(t *testing.T) {
+	policy := PromotionPolicy{Enabled: true, Boundaries: []PromotionBoundary{{"pro", "", 2}}}
+	moves := PlanPromotions(policy, []PromotionCandidate{
+		candidate("p1", "pro", 1, 90, 8),
+		candidate("p2", "pro", 2, 60, 8),
+		candidate("p3", "pro", 3, 40, 8),
+		candidate("p4", "pro", 4, 10, 2),
+		candidate("a1", "", 1, 80, 8),
+		candidate("a2", "", 2, 70, 8),
+		candidate("a3", "", 3, 5, 1),
+	})
+
+	want := []PromotionMove{
+		{MemberID: "a1", FromDivisionID: "", ToDivisionID: "pro", Promoted: true, Tag: 1, SeedTag: 3},
+		{MemberID: "a2", FromDivisionID: "", ToDivisionID: "pro", Promoted: true, Tag: 2, SeedTag: 4},
+		{MemberID: "p3", FromDivisionID: "pro", ToDivisionID: "", Tag: 3, SeedTag: 1},
+		{MemberID: "p4", FromDivisionID: "pro", ToDivisionID: "", Tag: 4, SeedTag: 2},
+	}
+	if !slices.Equal(moves, want) {
+		t.Fatalf("moves = %+v, want %+v", moves, want)
+	}
+}
+
+func TestPlanPromotions_OnlyPlayersArePromoted(t *testing.T) {
+	policy := PromotionPolicy{Enabled: true, Boundaries: []PromotionBoundary{{"pro", "am", 3}}}
+	moves := PlanPromotions(policy, []PromotionCandidate{
+		candidate("p1", "pro", 1, 50, 5),
+		candidate("p2", "pro", 0, 0, 0), // untagged, no standing
+		candidate("a1", "am", 0, 30, 3),
+		candidate("a2", "am", 2, 0, 0), // never played
+	})
+
+	want := []PromotionMove{
+		{MemberID: "a1", FromDivisionID: "am", ToDivisionID: "pro", Promoted: true},
+		{MemberID: "p2", FromDivisionID: "pro", ToDivisionID: "am"},
+	}
+	if !slices.Equal(moves, want) {
+		t.Fatalf("moves = %+v, want %+v", moves, want)
+	}
+}
+
+func TestPlanPromotions_ChainedMembersMoveOnce(t *testing.T) {
+	policy := PromotionPolicy{Enabled: true, Boundaries: []PromotionBoundary{{"a", "b", 1}, {"b", "c", 1}}}
+	moves := PlanPromotions(policy, []PromotionCandidate{
+		candidate("a1", "a", 1, 10, 1),
+		candidate("b1", "b", 1, 20, 1),
+		candidate("c1", "c", 1, 30, 1),
+	})
+
+	// b1 is promoted over a; nobody is left in b to relegate to c.
+	if len(moves) != 2 || moves[0].MemberID != "b1" || moves[1].MemberID != "a1" {
+		t.Fatalf("unexpected moves %+v", moves)
+	}
+}
+
+func TestPlanPromotions_Disabled(t *testing.T) {
+	policy := PromotionPolicy{Boundaries: []PromotionBoundary{{"pro", "", 1}}}
+	if moves := PlanPromotions(policy, []PromotionCandidate{candidate("p", "pro", 1, 0, 0), candidate("a", "", 1, 9, 1)}); moves != nil {
+		t.Fatalf("disabled policy should not move anyone, got %+v", moves)
+	}
+}
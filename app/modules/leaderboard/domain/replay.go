@@ -0,0 +1,242 @@
+package leaderboarddomain
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+)
+
+// ReplayStanding is a member's season standing as rebuilt by a replay.
+type ReplayStanding struct {
+	SeasonID      string
+	MemberID      string
+	TotalPoints   int
+	CurrentTier   string
+	SeasonBestTag int
+	RoundsPlayed  int
+}
+
+type standingKey struct {
+	seasonID string
+	memberID string
+}
+
+// ReplayState rebuilds guild tag ownership and season standings by applying
+// historical events one at a time in chronological order.
+//
+// It mirrors the live write path: rounds reallocate tags with the guild's tag
+// allocator (the closed pool unless SetTagAllocator says otherwise) within each
+// division and are scored against the standings accumulated so far, so replaying
+// every event from an empty state reproduces what processing the rounds in order
+// would have produced.
+type ReplayState struct {
+	tagByMember      map[string]int
+	memberByTag      map[divisionTag]string
+	divisionByMember map[string]string
+	standings        map[standingKey]*ReplayStanding
+	seasonSize       map[string]int
+	allocate         TagAllocator
+}
+
+// divisionTag identifies a tag; tag numbers repeat across divisions.
+type divisionTag struct {
+	divisionID string
+	tag        int
+}
+
+// NewReplayState returns an empty replay state with no tags or standings.
+func NewReplayState() *ReplayState {
+	return &ReplayState{
+		tagByMember:      make(map[string]int),
+		memberByTag:      make(map[divisionTag]string),
+		divisionByMember: make(map[string]string),
+		standings:        make(map[standingKey]*ReplayStanding),
+		seasonSize:       make(map[string]int),
+		allocate:         AllocateTagsClosedPool,
+	}
+}
+
+// SetTagAllocator changes how subsequent rounds reallocate tags.
+func (s *ReplayState) SetTagAllocator(allocate TagAllocator) {
+	if allocate != nil {
+		s.allocate = allocate
+	}
+}
+
+// SetDivision moves memberID to divisionID. A member who changes division gives up
+// their tag, as they do on the live path. Members start in the default division.
+func (s *ReplayState) SetDivision(memberID, divisionID string) {
+	if memberID == "" || s.divisionByMember[memberID] == divisionID {
+		return
+	}
+	if tag, ok := s.tagByMember[memberID]; ok {
+		s.ReleaseTag(memberID, tag)
+	}
+	if divisionID == DefaultDivisionID {
+		delete(s.divisionByMember, memberID)
+		return
+	}
+	s.divisionByMember[memberID] = divisionID
+}
+
+// AssignTag gives tag in memberID's division to memberID. Any other holder of the
+// tag loses it, and the member gives up the tag they held before, matching the
+// one-tag-per-member rule.
+func (s *ReplayState) AssignTag(memberID string, tag int) {
+	if tag <= 0 || memberID == "" {
+		return
+	}
+	key := s.tagKey(memberID, tag)
+	if holder, ok := s.memberByTag[key]; ok && holder != memberID {
+		delete(s.tagByMember, holder)
+	}
+	if prev, ok := s.tagByMember[memberID]; ok && prev != tag {
+		delete(s.memberByTag, s.tagKey(memberID, prev))
+	}
+	s.tagByMember[memberID] = tag
+	s.memberByTag[key] = memberID
+}
+
+// ReleaseTag removes tag from memberID if they still hold it.
+func (s *ReplayState) ReleaseTag(memberID string, tag int) {
+	key := s.tagKey(memberID, tag)
+	if holder, ok := s.memberByTag[key]; ok && holder == memberID {
+		delete(s.memberByTag, key)
+		delete(s.tagByMember, memberID)
+	}
+}
+
+// ClearTags removes every tag, as a guild tag reset does before reallocating.
+// Members keep their divisions.
+func (s *ReplayState) ClearTags() {
+	clear(s.tagByMember)
+	clear(s.memberByTag)
+}
+
+// PlayRound moves the round's participants to the divisions they played in,
+// reallocates tags among them within each division and, when seasonID is set,
+// scores the round under policy and folds the awards into the standings.
+// It returns the tag changes and point awards the round produced.
+func (s *ReplayState) PlayRound(seasonID string, policy PointsPolicy, inputs []RoundInput) ([]TagChange, []PointAward) {
+	tagInputs := make([]TagAllocationInput, len(inputs))
+	for i, in := range inputs {
+		s.SetDivision(in.MemberID, in.DivisionID)
+		rank := in.FinishRank
+		if in.TagRank > 0 {
+			rank = in.TagRank
+		}
+		tagInputs[i] = TagAllocationInput{
+			MemberID:   in.MemberID,
+			FinishRank: rank,
+			CurrentTag: s.tagByMember[in.MemberID],
+		}
+	}
+
+	changes := AllocateWithinDivisions(s.allocate, s.divisionByMember)(tagInputs)
+	for _, ch := range changes {
+		if prev, ok := s.tagByMember[ch.NewMemberID]; ok {
+			delete(s.memberByTag, s.tagKey(ch.NewMemberID, prev))
+		}
+		if ch.OldMemberID != "" && s.tagByMember[ch.OldMemberID] == ch.TagNumber {
+			delete(s.tagByMember, ch.OldMemberID)
+		}
+	}
+	for _, ch := range changes {
+		s.tagByMember[ch.NewMemberID] = ch.TagNumber
+		s.memberByTag[s.tagKey(ch.NewMemberID, ch.TagNumber)] = ch.NewMemberID
+	}
+
+	if seasonID == "" {
+		return changes, nil
+	}
+
+	totalMembers := s.seasonSize[seasonID]
+	participants := make([]RoundParticipant, 0, len(inputs))
+	for _, in := range inputs {
+		tag := s.tagByMember[in.MemberID]
+		if tag <= 0 {
+			continue
+		}
+		roundsPlayed, bestTag := 0, 0
+		if st := s.standings[standingKey{seasonID, in.MemberID}]; st != nil {
+			roundsPlayed = st.RoundsPlayed
+			bestTag = st.SeasonBestTag
+		}
+		bestTag = UpdateBestTag(bestTag, tag)
+		participants = append(participants, RoundParticipant{
+			MemberID:     in.MemberID,
+			TagNumber:    tag,
+			FinishRank:   in.FinishRank,
+			RoundsPlayed: roundsPlayed,
+			BestTag:      bestTag,
+			CurrentTier:  policy.DetermineTier(bestTag, totalMembers),
+		})
+	}
+	if len(participants) == 0 {
+		return changes, nil
+	}
+
+	awards := policy.CalculateRoundPoints(participants)
+	for _, award := range awards {
+		s.AddRoundPoints(seasonID, award.MemberID, award.Points, string(award.Tier))
+	}
+	return changes, awards
+}
+
+// AddRoundPoints records a round's points for a member whose round is not being
+// rescored, crediting a played round and their current tag toward season best.
+func (s *ReplayState) AddRoundPoints(seasonID, memberID string, points int, tier string) {
+	st := s.standing(seasonID, memberID)
+	st.TotalPoints += points
+	st.RoundsPlayed++
+	st.SeasonBestTag = UpdateBestTag(st.SeasonBestTag, s.tagByMember[memberID])
+	if tier != "" {
+		st.CurrentTier = tier
+	}
+}
+
+// AdjustPoints applies a manual point adjustment. Totals never drop below zero.
+func (s *ReplayState) AdjustPoints(seasonID, memberID string, delta int) {
+	st := s.standing(seasonID, memberID)
+	st.TotalPoints = max(st.TotalPoints+delta, 0)
+}
+
+func (s *ReplayState) tagKey(memberID string, tag int) divisionTag {
+	return divisionTag{divisionID: s.divisionByMember[memberID], tag: tag}
+}
+
+func (s *ReplayState) standing(seasonID, memberID string) *ReplayStanding {
+	key := standingKey{seasonID, memberID}
+	st := s.standings[key]
+	if st == nil {
+		st = &ReplayStanding{SeasonID: seasonID, MemberID: memberID}
+		s.standings[key] = st
+		s.seasonSize[seasonID]++
+	}
+	return st
+}
+
+// Tags returns the current member -> tag ownership.
+func (s *ReplayState) Tags() map[string]int {
+	return maps.Clone(s.tagByMember)
+}
+
+// Division returns the division memberID is in.
+func (s *ReplayState) Division(memberID string) string {
+	return s.divisionByMember[memberID]
+}
+
+// Standings returns every rebuilt standing ordered by season, then member.
+func (s *ReplayState) Standings() []ReplayStanding {
+	out := make([]ReplayStanding, 0, len(s.standings))
+	for _, st := range s.standings {
+		out = append(out, *st)
+	}
+	slices.SortFunc(out, func(a, b ReplayStanding) int {
+		if c := cmp.Compare(a.SeasonID, b.SeasonID); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.MemberID, b.MemberID)
+	})
+	return out
+}
@@ -0,0 +1,194 @@
+package leaderboarddomain
+
+import (
+	"testing"
+)
+
+func TestReplayState_AssignTag(t *testing.T) {
+	s := NewReplayState()
+	s.AssignTag("alice", 1)
+	s.AssignTag("bob", 2)
+
+	// Swap: alice takes 2 first, which evicts bob; bob then takes 1.
+	s.AssignTag("alice", 2)
+	s.AssignTag("bob", 1)
+
+	tags := s.Tags()
+	if tags["alice"] != 2 || tags["bob"] != 1 {
+		t.Fatalf("expected alice=2 bob=1, got %v", tags)
+	}
+
+	// Moving to a free tag gives up the old one.
+	s.AssignTag("alice", 5)
+	s.AssignTag("carol", 2)
+	tags = s.Tags()
+	if tags["alice"] != 5 || tags["carol"] != 2 || len(tags) != 3 {
+		t.Fatalf("unexpected tags after move: %v", tags)
+	}
+}
+
+func TestReplayState_ReleaseTag(t *testing.T) {
+	s := NewReplayState()
+	s.AssignTag("alice", 1)
+
+	s.ReleaseTag("bob", 1)
+	if s.Tags()["alice"] != 1 {
+		t.Fatal("release by a non-holder must not remove the tag")
+	}
+
+	s.ReleaseTag("alice", 1)
+	if len(s.Tags()) != 0 {
+		t.Fatalf("expected no tags, got %v", s.Tags())
+	}
+
+	s.AssignTag("bob", 1)
+	s.ClearTags()
+	if len(s.Tags()) != 0 {
+		t.Fatalf("expected ClearTags to remove everything, got %v", s.Tags())
+	}
+}
+
+func TestReplayState_Divisions(t *testing.T) {
+	s := NewReplayState()
+	s.AssignTag("alice", 1)
+	s.SetDivision("bob", "pro")
+	s.AssignTag("bob", 1)
+
+	// Tag numbers repeat across divisions.
+	tags := s.Tags()
+	if tags["alice"] != 1 || tags["bob"] != 1 {
+		t.Fatalf("expected alice and bob to both hold tag 1, got %v", tags)
+	}
+	if s.Division("bob") != "pro" || s.Division("alice") != DefaultDivisionID {
+		t.Fatalf("unexpected divisions: bob=%q alice=%q", s.Division("bob"), s.Division("alice"))
+	}
+
+	// Releasing in one division leaves the other's holder alone.
+	s.ReleaseTag("bob", 1)
+	if tags := s.Tags(); tags["alice"] != 1 || len(tags) != 1 {
+		t.Fatalf("expected alice to keep tag 1, got %v", tags)
+	}
+
+	// Moving division gives up the tag held in the old one.
+	s.AssignTag("bob", 2)
+	s.SetDivision("bob", DefaultDivisionID)
+	if _, ok := s.Tags()["bob"]; ok {
+		t.Fatal("moving division must release the member's tag")
+	}
+}
+
+func TestReplayState_PlayRoundWithinDivisions(t *testing.T) {
+	s := NewReplayState()
+	s.AssignTag("alice", 1)
+	s.AssignTag("bob", 2)
+	s.SetDivision("carol", "pro")
+	s.AssignTag("carol", 1)
+	s.SetDivision("dave", "pro")
+	s.AssignTag("dave", 2)
+
+	changes, _ := s.PlayRound("", DefaultPointsPolicy(), []RoundInput{
+		{MemberID: "alice", FinishRank: 4},
+		{MemberID: "bob", FinishRank: 3},
+		{MemberID: "carol", FinishRank: 2, DivisionID: "pro"},
+		{MemberID: "dave", FinishRank: 1, DivisionID: "pro"},
+	})
+	if len(changes) != 4 {
+		t.Fatalf("expected each division to swap its two tags, got %v", changes)
+	}
+	tags := s.Tags()
+	if tags["bob"] != 1 || tags["alice"] != 2 || tags["dave"] != 1 || tags["carol"] != 2 {
+		t.Fatalf("expected swaps within each division, got %v", tags)
+	}
+}
+
+func TestReplayState_PlayRound(t *testing.T) {
+	s := NewReplayState()
+	s.AssignTag("alice", 1)
+	s.AssignTag("bob", 2)
+
+	changes, awards := s.PlayRound("s1", DefaultPointsPolicy(), []RoundInput{
+		{MemberID: "alice", FinishRank: 2},
+		{MemberID: "bob", FinishRank: 1},
+		{MemberID: "carol", FinishRank: 3}, // untagged: no tag, no points
+	})
+
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 tag changes, got %d", len(changes))
+	}
+	tags := s.Tags()
+	if tags["bob"] != 1 || tags["alice"] != 2 {
+		t.Fatalf("expected bob=1 alice=2, got %v", tags)
+	}
+	if _, ok := tags["carol"]; ok {
+		t.Fatal("untagged participant must not gain a tag")
+	}
+	if len(awards) != 2 {
+		t.Fatalf("expected awards for 2 tagged players, got %d", len(awards))
+	}
+
+	standings := s.Standings()
+	if len(standings) != 2 {
+		t.Fatalf("expected 2 standings, got %d", len(standings))
+	}
+	for _, st := range standings {
+		if st.RoundsPlayed != 1 {
+			t.Fatalf("expected 1 round played for %s, got %d", st.MemberID, st.RoundsPlayed)
+		}
+		if st.MemberID == "bob" && (st.TotalPoints <= 0 || st.SeasonBestTag != 1) {
+			t.Fatalf("unexpected bob standing: %+v", st)
+		}
+	}
+}
+
+func TestReplayState_PlayRoundUsesTagRank(t *testing.T) {
+	s := NewReplayState()
+	s.AssignTag("alice", 1)
+	s.AssignTag("bob", 2)
+
+	// Bob wins on net but alice keeps the better tag on gross order.
+	changes, _ := s.PlayRound("", DefaultPointsPolicy(), []RoundInput{
+		{MemberID: "alice", FinishRank: 2, TagRank: 1},
+		{MemberID: "bob", FinishRank: 1, TagRank: 2},
+	})
+	if len(changes) != 0 {
+		t.Fatalf("expected no tag changes, got %v", changes)
+	}
+	if len(s.Standings()) != 0 {
+		t.Fatal("rounds outside a season must not create standings")
+	}
+}
+
+func TestReplayState_PlayRoundOpenPoolReleasesDisplacedHolder(t *testing.T) {
+	s := NewReplayState()
+	s.SetTagAllocator(TagPoolPolicy{Model: TagPoolOpen}.Allocator())
+	s.AssignTag("alice", 1)
+
+	s.PlayRound("", DefaultPointsPolicy(), []RoundInput{
+		{MemberID: "alice", FinishRank: 2},
+		{MemberID: "bob", FinishRank: 1},
+	})
+	if tags := s.Tags(); len(tags) != 1 || tags["bob"] != 1 {
+		t.Fatalf("expected bob alone to hold tag 1, got %v", tags)
+	}
+}
+
+func TestReplayState_VerbatimPointsAndAdjustments(t *testing.T) {
+	s := NewReplayState()
+	s.AssignTag("alice", 3)
+
+	s.AddRoundPoints("s1", "alice", 150, string(TierSilver))
+	s.AdjustPoints("s1", "alice", -200)
+	s.AdjustPoints("s1", "bob", 25)
+
+	standings := s.Standings()
+	if len(standings) != 2 {
+		t.Fatalf("expected 2 standings, got %d", len(standings))
+	}
+	alice, bob := standings[0], standings[1]
+	if alice.TotalPoints != 0 || alice.RoundsPlayed != 1 || alice.SeasonBestTag != 3 || alice.CurrentTier != string(TierSilver) {
+		t.Fatalf("unexpected alice standing: %+v", alice)
+	}
+	if bob.TotalPoints != 25 || bob.RoundsPlayed != 0 {
+		t.Fatalf("unexpected bob standing: %+v", bob)
+	}
+}
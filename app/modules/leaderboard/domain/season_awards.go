@@ -0,0 +1,178 @@
+package leaderboarddomain
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// AwardKind identifies a season-end award.
+type AwardKind string
+
+const (
+	AwardPointsChampion  AwardKind = "points_champion"
+	AwardMostImprovedTag AwardKind = "most_improved_tag"
+	AwardMostRounds      AwardKind = "most_rounds_played"
+	AwardGiantSlayer     AwardKind = "giant_slayer"
+)
+
+// SeasonAward is an award granted when a season closes. Value is the figure the award
+// was decided on: points, tag places gained, rounds played or giant slays.
+type SeasonAward struct {
+	Kind     AwardKind
+	MemberID string
+	Value    int
+}
+
+// FinalStanding is a member's closing standing for a season.
+type FinalStanding struct {
+	MemberID      string
+	Rank          int // 1-based; members level on points share a rank
+	TotalPoints   int
+	CurrentTier   string
+	SeasonBestTag int
+	RoundsPlayed  int
+}
+
+// TagLedgerEntry is a tag history row as seen by domain calculations.
+// Entries written together (one round, one reset) share At.
+type TagLedgerEntry struct {
+	At          time.Time
+	TagNumber   int
+	OldMemberID string
+	NewMemberID string
+	Reason      string
+}
+
+// RankFinalStandings orders standings by points, highest first, and assigns
+// competition ranks. Members level on points share a rank and are listed by best
+// season tag, then member ID.
+func RankFinalStandings(standings []FinalStanding) []FinalStanding {
+	ranked := slices.Clone(standings)
+	slices.SortFunc(ranked, func(a, b FinalStanding) int {
+		if c := cmp.Compare(b.TotalPoints, a.TotalPoints); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(bestTagOrder(a.SeasonBestTag), bestTagOrder(b.SeasonBestTag)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.MemberID, b.MemberID)
+	})
+	for i := range ranked {
+		if i > 0 && ranked[i].TotalPoints == ranked[i-1].TotalPoints {
+			ranked[i].Rank = ranked[i-1].Rank
+			continue
+		}
+		ranked[i].Rank = i + 1
+	}
+	return ranked
+}
+
+// SeasonStartTags returns, per member, the first tag they are seen holding in a season's
+// ledger: the tag they gave up in their first entry, or the tag they received if they
+// gave none up (they held no tag before). entries must be in chronological order.
+func SeasonStartTags(entries []TagLedgerEntry) map[string]int {
+	start := make(map[string]int)
+	for i := 0; i < len(entries); {
+		j := i
+		for j < len(entries) && entries[j].At.Equal(entries[i].At) {
+			j++
+		}
+		batch := entries[i:j]
+		gaveUp := make(map[string]int)
+		for _, e := range batch {
+			if e.OldMemberID != "" {
+				gaveUp[e.OldMemberID] = e.TagNumber
+			}
+		}
+		for _, e := range batch {
+			for _, memberID := range []string{e.OldMemberID, e.NewMemberID} {
+				if memberID == "" {
+					continue
+				}
+				if _, seen := start[memberID]; seen {
+					continue
+				}
+				if tag, ok := gaveUp[memberID]; ok {
+					start[memberID] = tag
+				} else {
+					start[memberID] = e.TagNumber
+				}
+			}
+		}
+		i = j
+	}
+	return start
+}
+
+// ComputeSeasonAwards decides the season-end awards. Awards with no qualifying member
+// (no points scored, no tag improved, no giant slain) are omitted.
+//
+//   - Points champion: most points.
+//   - Most improved tag: largest gain from the season start tag to the season-best tag.
+//   - Most rounds played: most rounds, ties to the higher points total.
+//   - Giant slayer: most Gold-tier opponents beaten while Bronze.
+//
+// Remaining ties go to the lower member ID so results are deterministic.
+func ComputeSeasonAwards(standings []FinalStanding, startTags map[string]int, giantSlays map[string]int) []SeasonAward {
+	var awards []SeasonAward
+
+	ranked := RankFinalStandings(standings)
+	if len(ranked) > 0 && ranked[0].TotalPoints > 0 {
+		awards = append(awards, SeasonAward{Kind: AwardPointsChampion, MemberID: ranked[0].MemberID, Value: ranked[0].TotalPoints})
+	}
+
+	var improved *SeasonAward
+	for _, st := range ranked {
+		startTag := startTags[st.MemberID]
+		if startTag <= 0 || st.SeasonBestTag <= 0 {
+			continue
+		}
+		gain := startTag - st.SeasonBestTag
+		if gain <= 0 {
+			continue
+		}
+		if improved == nil || gain > improved.Value || (gain == improved.Value && st.MemberID < improved.MemberID) {
+			improved = &SeasonAward{Kind: AwardMostImprovedTag, MemberID: st.MemberID, Value: gain}
+		}
+	}
+	if improved != nil {
+		awards = append(awards, *improved)
+	}
+
+	var mostRounds *FinalStanding
+	for i, st := range ranked {
+		if st.RoundsPlayed <= 0 {
+			continue
+		}
+		if mostRounds == nil || st.RoundsPlayed > mostRounds.RoundsPlayed {
+			mostRounds = &ranked[i]
+		}
+	}
+	if mostRounds != nil {
+		awards = append(awards, SeasonAward{Kind: AwardMostRounds, MemberID: mostRounds.MemberID, Value: mostRounds.RoundsPlayed})
+	}
+
+	var slayer *SeasonAward
+	for memberID, count := range giantSlays {
+		if count <= 0 {
+			continue
+		}
+		if slayer == nil || count > slayer.Value || (count == slayer.Value && memberID < slayer.MemberID) {
+			slayer = &SeasonAward{Kind: AwardGiantSlayer, MemberID: memberID, Value: count}
+		}
+	}
+	if slayer != nil {
+		awards = append(awards, *slayer)
+	}
+
+	return awards
+}
+
+// bestTagOrder sorts "no tag" (0) after every real tag.
+func bestTagOrder(tag int) int {
+	if tag <= 0 {
+		return int(^uint(0) >> 1)
+	}
+	return tag
+}
@@ -0,0 +1,77 @@
+package leaderboarddomain
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRankFinalStandings(t *testing.T) {
+	ranked := RankFinalStandings([]FinalStanding{
+		{MemberID: "carol", TotalPoints: 300, SeasonBestTag: 4},
+		{MemberID: "alice", TotalPoints: 500, SeasonBestTag: 2},
+		{MemberID: "bob", TotalPoints: 300, SeasonBestTag: 1},
+		{MemberID: "dave", TotalPoints: 100},
+	})
+
+	gotOrder := make([]string, len(ranked))
+	gotRanks := make([]int, len(ranked))
+	for i, st := range ranked {
+		gotOrder[i] = st.MemberID
+		gotRanks[i] = st.Rank
+	}
+	if want := []string{"alice", "bob", "carol", "dave"}; !slices.Equal(gotOrder, want) {
+		t.Fatalf("order = %v, want %v", gotOrder, want)
+	}
+	if want := []int{1, 2, 2, 4}; !slices.Equal(gotRanks, want) {
+		t.Fatalf("ranks = %v, want %v", gotRanks, want)
+	}
+}
+
+func TestSeasonStartTags(t *testing.T) {
+	t0 := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	start := SeasonStartTags([]TagLedgerEntry{
+		// Round: alice (5) takes 3 from bob, bob takes 5.
+		{At: t0, TagNumber: 3, OldMemberID: "bob", NewMemberID: "alice"},
+		{At: t0, TagNumber: 5, OldMemberID: "alice", NewMemberID: "bob"},
+		// New member claims an open tag.
+		{At: t0.Add(time.Hour), TagNumber: 9, NewMemberID: "carol"},
+		// Later moves do not change anyone's start tag.
+		{At: t0.Add(2 * time.Hour), TagNumber: 1, OldMemberID: "dave", NewMemberID: "alice"},
+	})
+
+	want := map[string]int{"alice": 5, "bob": 3, "carol": 9, "dave": 1}
+	for member, tag := range want {
+		if start[member] != tag {
+			t.Errorf("start tag for %s = %d, want %d", member, start[member], tag)
+		}
+	}
+}
+
+func TestComputeSeasonAwards(t *testing.T) {
+	standings := []FinalStanding{
+		{MemberID: "alice", TotalPoints: 900, SeasonBestTag: 1, RoundsPlayed: 8},
+		{MemberID: "bob", TotalPoints: 700, SeasonBestTag: 2, RoundsPlayed: 10},
+		{MemberID: "carol", TotalPoints: 650, SeasonBestTag: 3, RoundsPlayed: 10},
+	}
+	startTags := map[string]int{"alice": 2, "bob": 8, "carol": 12}
+	giantSlays := map[string]int{"carol": 3, "bob": 3, "alice": 0}
+
+	got := ComputeSeasonAwards(standings, startTags, giantSlays)
+	want := []SeasonAward{
+		{Kind: AwardPointsChampion, MemberID: "alice", Value: 900},
+		{Kind: AwardMostImprovedTag, MemberID: "carol", Value: 9},
+		{Kind: AwardMostRounds, MemberID: "bob", Value: 10},
+		{Kind: AwardGiantSlayer, MemberID: "bob", Value: 3},
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("awards = %+v, want %+v", got, want)
+	}
+}
+
+func TestComputeSeasonAwards_EmptySeason(t *testing.T) {
+	got := ComputeSeasonAwards([]FinalStanding{{MemberID: "alice"}}, nil, nil)
+	if len(got) != 0 {
+		t.Fatalf("expected no awards for a season without play, got %+v", got)
+	}
+}
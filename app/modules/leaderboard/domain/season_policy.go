@@ -1,6 +1,9 @@
 package leaderboarddomain
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // ResolvedSeason holds the resolved season context for a round processing operation.
 type ResolvedSeason struct {
@@ -8,22 +11,42 @@ type ResolvedSeason struct {
 	IsActive bool
 }
 
-// SeasonState represents the current season configuration for a guild.
+// SeasonState represents a season's configuration for a guild. StartDate and EndDate
+// bound the season; a nil EndDate on the active season means it runs until ended.
 type SeasonState struct {
 	GuildID   string
 	SeasonID  string
 	IsActive  bool
+	Scheduled bool // created in advance and not yet started
 	StartDate *time.Time
 	EndDate   *time.Time
 }
 
+// contains reports whether t falls within the season's [StartDate, EndDate) window.
+// Seasons without a start date predate scheduling and only match while active; ended
+// seasons without an end date cannot be placed and never match.
+func (s SeasonState) contains(t time.Time) bool {
+	if s.StartDate == nil {
+		return s.IsActive
+	}
+	if t.Before(*s.StartDate) {
+		return false
+	}
+	if s.EndDate == nil {
+		return s.IsActive || s.Scheduled
+	}
+	return t.Before(*s.EndDate)
+}
+
 // ResolveSeasonForRound determines which season a round should be processed under.
 //
 // Rules:
 //   - If rollbackSeasonID is non-empty, use it (recalculation preserves original season).
-//   - If an active season exists, use it.
-//   - If no active season, return empty ResolvedSeason (points will be skipped).
-func ResolveSeasonForRound(rollbackSeasonID string, activeSeason *SeasonState) ResolvedSeason {
+//   - If roundStart is set, use the season whose window contains it, preferring the
+//     latest start. A round that started before its season ended still counts for it.
+//   - Otherwise use the active season.
+//   - If nothing matches, return empty ResolvedSeason (points will be skipped).
+func ResolveSeasonForRound(rollbackSeasonID string, seasons []SeasonState, roundStart time.Time) ResolvedSeason {
 	if rollbackSeasonID != "" {
 		return ResolvedSeason{
 			SeasonID: rollbackSeasonID,
@@ -31,15 +54,42 @@ func ResolveSeasonForRound(rollbackSeasonID string, activeSeason *SeasonState) R
 		}
 	}
 
-	if activeSeason != nil && activeSeason.IsActive {
-		return ResolvedSeason{
-			SeasonID: activeSeason.SeasonID,
-			IsActive: true,
+	if roundStart.IsZero() {
+		for _, season := range seasons {
+			if season.IsActive {
+				return ResolvedSeason{
+					SeasonID: season.SeasonID,
+					IsActive: true,
+				}
+			}
+		}
+		// No active season = off-season. Tags still update, points are skipped.
+		return ResolvedSeason{}
+	}
+
+	var match *SeasonState
+	for i, season := range seasons {
+		if !season.contains(roundStart) {
+			continue
 		}
+		if match == nil || startsAfter(season, *match) {
+			match = &seasons[i]
+		}
+	}
+	if match == nil {
+		return ResolvedSeason{}
 	}
+	return ResolvedSeason{
+		SeasonID: match.SeasonID,
+		IsActive: match.IsActive,
+	}
+}
 
-	// No active season = off-season. Tags still update, points are skipped.
-	return ResolvedSeason{}
+func startsAfter(a, b SeasonState) bool {
+	if a.StartDate == nil {
+		return false
+	}
+	return b.StartDate == nil || a.StartDate.After(*b.StartDate)
 }
 
 // ShouldAwardPoints determines whether points should be awarded for a round.
@@ -59,3 +109,66 @@ func ValidateSeasonStart(seasonID, seasonName string) string {
 	}
 	return ""
 }
+
+// seasonDateLayouts are the accepted forms of a scheduled season boundary, read as wall
+// time in the season's timezone. A bare date means midnight.
+var seasonDateLayouts = []string{"2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02"}
+
+// ParseSeasonBoundary converts a wall-clock date in timezone (an IANA name; empty means
+// UTC) into the instant a scheduled season starts or ends.
+func ParseSeasonBoundary(value, timezone string) (time.Time, error) {
+	loc := time.UTC
+	if timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return time.Time{}, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+		}
+	}
+	for _, layout := range seasonDateLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid season date %q: want YYYY-MM-DD or YYYY-MM-DD HH:MM", value)
+}
+
+// ValidateSeasonSchedule checks whether a season can be scheduled to run from start to
+// end (nil end: until the next season starts or an admin ends it). The season must start
+// in the future, after the active season began, and must not overlap another scheduled
+// season. Returns an error message if validation fails, empty string if OK.
+func ValidateSeasonSchedule(seasonID, seasonName string, start time.Time, end *time.Time, now time.Time, seasons []SeasonState) string {
+	if msg := ValidateSeasonStart(seasonID, seasonName); msg != "" {
+		return msg
+	}
+	if !start.After(now) {
+		return "start_date must be in the future"
+	}
+	if end != nil && !end.After(start) {
+		return "end_date must be after start_date"
+	}
+	for _, season := range seasons {
+		if season.SeasonID == seasonID {
+			return "season_id already exists"
+		}
+		if season.StartDate == nil {
+			continue
+		}
+		switch {
+		case season.IsActive:
+			if start.Before(*season.StartDate) {
+				return "start_date is before the active season started"
+			}
+		case season.Scheduled:
+			if start.Equal(*season.StartDate) {
+				return "another season is scheduled to start at start_date"
+			}
+			if season.contains(start) && season.EndDate != nil {
+				return "start_date falls within scheduled season " + season.SeasonID
+			}
+			if end != nil && season.StartDate.After(start) && season.StartDate.Before(*end) {
+				return "scheduled season " + season.SeasonID + " starts before end_date"
+			}
+		}
+	}
+	return ""
+}
@@ -2,16 +2,17 @@ package leaderboarddomain
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestResolveSeasonForRound(t *testing.T) {
-	activeSeason := &SeasonState{
+	activeSeason := SeasonState{
 		SeasonID: "season-1",
 		IsActive: true,
 	}
-	inactiveSeason := &SeasonState{
+	inactiveSeason := SeasonState{
 		SeasonID: "season-1",
 		IsActive: false,
 	}
@@ -19,35 +20,35 @@ func TestResolveSeasonForRound(t *testing.T) {
 	tests := []struct {
 		name             string
 		rollbackSeasonID string
-		activeSeason     *SeasonState
+		seasons          []SeasonState
 		expectedID       string
 		expectedActive   bool
 	}{
 		{
 			name:             "Explicit rollback season ID takes precedence",
 			rollbackSeasonID: "season-old",
-			activeSeason:     activeSeason,
+			seasons:          []SeasonState{activeSeason},
 			expectedID:       "season-old",
 			expectedActive:   true,
 		},
 		{
 			name:             "Use active season if no rollback ID",
 			rollbackSeasonID: "",
-			activeSeason:     activeSeason,
+			seasons:          []SeasonState{activeSeason},
 			expectedID:       "season-1",
 			expectedActive:   true,
 		},
 		{
 			name:             "No active season returns empty info",
 			rollbackSeasonID: "",
-			activeSeason:     nil,
+			seasons:          nil,
 			expectedID:       "",
 			expectedActive:   false,
 		},
 		{
 			name:             "Inactive season returns empty info",
 			rollbackSeasonID: "",
-			activeSeason:     inactiveSeason,
+			seasons:          []SeasonState{inactiveSeason},
 			expectedID:       "",
 			expectedActive:   false,
 		},
@@ -55,13 +56,45 @@ func TestResolveSeasonForRound(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ResolveSeasonForRound(tt.rollbackSeasonID, tt.activeSeason)
+			result := ResolveSeasonForRound(tt.rollbackSeasonID, tt.seasons, time.Time{})
 			assert.Equal(t, tt.expectedID, result.SeasonID)
 			assert.Equal(t, tt.expectedActive, result.IsActive)
 		})
 	}
 }
 
+func TestResolveSeasonForRound_ByStartTime(t *testing.T) {
+	day := func(d int) *time.Time {
+		t := time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	seasons := []SeasonState{
+		{SeasonID: "winter", StartDate: day(1), EndDate: day(10)},
+		{SeasonID: "spring", IsActive: true, StartDate: day(10)},
+		{SeasonID: "summer", Scheduled: true, StartDate: day(20), EndDate: day(30)},
+		{SeasonID: "legacy"}, // ended before seasons had dates
+	}
+
+	tests := []struct {
+		name       string
+		roundStart time.Time
+		expectedID string
+		active     bool
+	}{
+		{name: "Round started in an ended season", roundStart: day(9).Add(23 * time.Hour), expectedID: "winter"},
+		{name: "Round started at the active season boundary", roundStart: *day(10), expectedID: "spring", active: true},
+		{name: "Round started after a scheduled season began", roundStart: day(20).Add(time.Hour), expectedID: "summer"},
+		{name: "Round started before any season", roundStart: day(1).Add(-time.Hour), expectedID: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ResolveSeasonForRound("", seasons, tt.roundStart)
+			assert.Equal(t, tt.expectedID, result.SeasonID)
+			assert.Equal(t, tt.active, result.IsActive)
+		})
+	}
+}
+
 func TestShouldAwardPoints(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -125,3 +158,57 @@ func TestValidateSeasonStart(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSeasonBoundary(t *testing.T) {
+	got, err := ParseSeasonBoundary("2026-06-01", "America/Chicago")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 6, 1, 5, 0, 0, 0, time.UTC), got)
+
+	got, err = ParseSeasonBoundary("2026-06-01 18:30", "")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 6, 1, 18, 30, 0, 0, time.UTC), got)
+
+	_, err = ParseSeasonBoundary("June 1st", "UTC")
+	assert.Error(t, err)
+
+	_, err = ParseSeasonBoundary("2026-06-01", "Mars/Olympus")
+	assert.Error(t, err)
+}
+
+func TestValidateSeasonSchedule(t *testing.T) {
+	now := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	at := func(d int) time.Time { return time.Date(2026, 4, d, 0, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+	activeStart := now.AddDate(0, -1, 0)
+	seasons := []SeasonState{
+		{SeasonID: "spring", IsActive: true, StartDate: &activeStart},
+		{SeasonID: "summer", Scheduled: true, StartDate: ptr(at(10)), EndDate: ptr(at(20))},
+	}
+
+	tests := []struct {
+		name      string
+		seasonID  string
+		start     time.Time
+		end       *time.Time
+		wantError bool
+	}{
+		{name: "Valid schedule before another", seasonID: "s1", start: at(1), end: ptr(at(10))},
+		{name: "Valid open-ended schedule after another", seasonID: "s1", start: at(20)},
+		{name: "Start in the past", seasonID: "s1", start: now.Add(-time.Hour), wantError: true},
+		{name: "End before start", seasonID: "s1", start: at(2), end: ptr(at(1)), wantError: true},
+		{name: "Duplicate season ID", seasonID: "summer", start: at(25), wantError: true},
+		{name: "Start inside a scheduled season", seasonID: "s1", start: at(15), wantError: true},
+		{name: "Window swallows a scheduled start", seasonID: "s1", start: at(1), end: ptr(at(15)), wantError: true},
+		{name: "Same start as a scheduled season", seasonID: "s1", start: at(10), wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := ValidateSeasonSchedule(tt.seasonID, "Season", tt.start, tt.end, now, seasons)
+			if tt.wantError {
+				assert.NotEmpty(t, msg)
+			} else {
+				assert.Empty(t, msg)
+			}
+		})
+	}
+}
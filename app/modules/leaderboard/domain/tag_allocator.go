@@ -43,46 +43,13 @@ func AllocateTagsClosedPool(inputs []TagAllocationInput) []TagChange {
 		return nil
 	}
 
-	// Collect the tag pool from eligible participants
-	tagPool := make([]int, len(eligible))
-	for i, e := range eligible {
-		tagPool[i] = e.CurrentTag
-	}
-
-	// Sort tag pool ascending (best tags first)
-	slices.Sort(tagPool)
+	tagPool, tagToOldMember := collectTagPool(eligible)
 
 	// Sort eligible participants by finish rank, then by current tag for deterministic tie-break
-	slices.SortFunc(eligible, func(a, b TagAllocationInput) int {
-		if c := cmp.Compare(a.FinishRank, b.FinishRank); c != 0 {
-			return c
-		}
-		return cmp.Compare(a.CurrentTag, b.CurrentTag)
-	})
-
-	// Build old tag -> member mapping for change detection
-	tagToOldMember := make(map[int]string, len(eligible))
-	for _, e := range eligible {
-		tagToOldMember[e.CurrentTag] = e.MemberID
-	}
+	slices.SortFunc(eligible, compareFinishThenTag)
 
 	// Assign pooled tags by rank order
-	var changes []TagChange
-	for i, participant := range eligible {
-		newTag := tagPool[i]
-		if newTag == participant.CurrentTag {
-			continue // no change
-		}
-
-		oldHolder := tagToOldMember[newTag]
-		changes = append(changes, TagChange{
-			TagNumber:   newTag,
-			OldMemberID: oldHolder,
-			NewMemberID: participant.MemberID,
-		})
-	}
-
-	return changes
+	return assignPooledTags(eligible, tagPool, tagToOldMember)
 }
 
 // AllocateTagsFromReset assigns tags 1..N based on qualifying round finish order.
@@ -113,6 +80,18 @@ func ComputeFinalTagState(
 	result := make(map[string]int, len(currentState))
 	maps.Copy(result, currentState)
 
+	// Holders whose tag was claimed by someone else give it up; those who received
+	// no other tag end untagged.
+	claimedBy := make(map[int]string, len(changes))
+	for _, ch := range changes {
+		claimedBy[ch.TagNumber] = ch.NewMemberID
+	}
+	for memberID, tag := range result {
+		if holder, ok := claimedBy[tag]; ok && holder != memberID {
+			delete(result, memberID)
+		}
+	}
+
 	// Apply changes
 	for _, ch := range changes {
 		result[ch.NewMemberID] = ch.TagNumber
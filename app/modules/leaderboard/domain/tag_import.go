@@ -0,0 +1,230 @@
+package leaderboarddomain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TagImportReason is the tag history reason recorded for imported tags.
+const TagImportReason = "import"
+
+// MaxTagImportRows caps how many rows one tag history import may contain.
+const MaxTagImportRows = 5000
+
+// ErrInvalidTagImport is returned when a tag history file cannot be imported.
+var ErrInvalidTagImport = errors.New("invalid tag import")
+
+// Column names accepted in a tag history import, matched case-insensitively. The
+// export's tag history columns are among them, so an export can be imported again.
+var (
+	tagImportMemberColumns   = []string{"member_id", "new_member_id", "user_id", "member"}
+	tagImportTagColumns      = []string{"tag_number", "tag"}
+	tagImportDateColumns     = []string{"date", "created_at", "at"}
+	tagImportReleasedColumns = []string{"old_member_id"}
+)
+
+var tagImportDateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"}
+
+// TagImportRow is one row of a tag history import: MemberID took TagNumber at At. A
+// row without a member releases TagNumber from ReleasedFrom.
+type TagImportRow struct {
+	Line         int
+	At           time.Time
+	TagNumber    int
+	MemberID     string
+	ReleasedFrom string
+}
+
+// TagImportIssue is a problem with an import file. Line is the 1-based file line; 0
+// means the file as a whole.
+type TagImportIssue struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
+
+// TagImportChange is a member whose tag an import changes. A tag of 0 means none.
+type TagImportChange struct {
+	MemberID    string `json:"member_id"`
+	CurrentTag  int    `json:"current_tag,omitempty"`
+	ImportedTag int    `json:"imported_tag,omitempty"`
+}
+
+// ParseTagImportRows reads a tag history table whose first row names the columns.
+// member_id and tag_number are required; date is optional but, when used, every row
+// needs one. Blank rows are skipped. Rows with problems are reported as issues.
+func ParseTagImportRows(table [][]string) ([]TagImportRow, []TagImportIssue) {
+	if len(table) == 0 {
+		return nil, []TagImportIssue{{Reason: "file is empty"}}
+	}
+	if len(table)-1 > MaxTagImportRows {
+		return nil, []TagImportIssue{{Reason: fmt.Sprintf("file has more than %d rows", MaxTagImportRows)}}
+	}
+
+	header := table[0]
+	memberCol := findTagImportColumn(header, tagImportMemberColumns)
+	tagCol := findTagImportColumn(header, tagImportTagColumns)
+	dateCol := findTagImportColumn(header, tagImportDateColumns)
+	releasedCol := findTagImportColumn(header, tagImportReleasedColumns)
+	var issues []TagImportIssue
+	if memberCol < 0 {
+		issues = append(issues, TagImportIssue{Line: 1, Reason: "missing member_id column"})
+	}
+	if tagCol < 0 {
+		issues = append(issues, TagImportIssue{Line: 1, Reason: "missing tag_number column"})
+	}
+	if len(issues) > 0 {
+		return nil, issues
+	}
+
+	var rows []TagImportRow
+	dated, undated := 0, 0
+	for i, record := range table[1:] {
+		line := i + 2
+		if isBlankRecord(record) {
+			continue
+		}
+		row := TagImportRow{
+			Line:         line,
+			MemberID:     tagImportCell(record, memberCol),
+			ReleasedFrom: tagImportCell(record, releasedCol),
+		}
+
+		tag, err := strconv.Atoi(tagImportCell(record, tagCol))
+		if err != nil || tag <= 0 {
+			issues = append(issues, TagImportIssue{Line: line, Reason: "tag_number must be a positive whole number"})
+			continue
+		}
+		row.TagNumber = tag
+
+		if row.MemberID == "" && row.ReleasedFrom == "" {
+			issues = append(issues, TagImportIssue{Line: line, Reason: "member_id is required"})
+			continue
+		}
+
+		if raw := tagImportCell(record, dateCol); raw != "" {
+			at, ok := parseTagImportDate(raw)
+			if !ok {
+				issues = append(issues, TagImportIssue{Line: line, Reason: fmt.Sprintf("unrecognised date %q", raw)})
+				continue
+			}
+			row.At = at
+			dated++
+		} else {
+			undated++
+		}
+		rows = append(rows, row)
+	}
+
+	if dated > 0 && undated > 0 {
+		issues = append(issues, TagImportIssue{Reason: "either every row or no row may have a date"})
+	}
+	if len(rows) == 0 && len(issues) == 0 {
+		issues = append(issues, TagImportIssue{Reason: "file has no rows"})
+	}
+	return rows, issues
+}
+
+// PlanTagImport replays rows in date order, file order breaking ties, and returns the
+// changes that bring current ownership in line with the file: every member the file
+// names ends with the tag the file leaves them, and current holders of imported tags
+// the file does not name lose them. A positive tagCap rejects tags above it.
+func PlanTagImport(rows []TagImportRow, current map[string]int, tagCap int) ([]TagImportChange, []TagImportIssue) {
+	var issues []TagImportIssue
+	if tagCap > 0 {
+		for _, row := range rows {
+			if row.TagNumber > tagCap {
+				issues = append(issues, TagImportIssue{Line: row.Line, Reason: fmt.Sprintf("tag %d is above the pool cap of %d", row.TagNumber, tagCap)})
+			}
+		}
+	}
+	if len(issues) > 0 {
+		return nil, issues
+	}
+
+	state := NewReplayState()
+	named := make(map[string]bool)
+	for _, row := range SortTagImportRows(rows) {
+		if row.MemberID == "" {
+			state.ReleaseTag(row.ReleasedFrom, row.TagNumber)
+			named[row.ReleasedFrom] = true
+			continue
+		}
+		state.AssignTag(row.MemberID, row.TagNumber)
+		named[row.MemberID] = true
+	}
+	imported := state.Tags()
+
+	holderByTag := make(map[int]string, len(current))
+	for memberID, tag := range current {
+		if tag > 0 {
+			holderByTag[tag] = memberID
+		}
+	}
+
+	var changes []TagImportChange
+	for memberID := range named {
+		if imported[memberID] != current[memberID] {
+			changes = append(changes, TagImportChange{MemberID: memberID, CurrentTag: current[memberID], ImportedTag: imported[memberID]})
+		}
+	}
+	for memberID, tag := range imported {
+		holder, ok := holderByTag[tag]
+		if ok && holder != memberID && !named[holder] {
+			changes = append(changes, TagImportChange{MemberID: holder, CurrentTag: tag})
+		}
+	}
+	slices.SortFunc(changes, func(a, b TagImportChange) int {
+		return cmp.Compare(a.MemberID, b.MemberID)
+	})
+	return changes, nil
+}
+
+// SortTagImportRows returns rows in the order an import replays them: by date, file
+// order breaking ties.
+func SortTagImportRows(rows []TagImportRow) []TagImportRow {
+	ordered := slices.Clone(rows)
+	slices.SortStableFunc(ordered, func(a, b TagImportRow) int {
+		return a.At.Compare(b.At)
+	})
+	return ordered
+}
+
+func findTagImportColumn(header []string, names []string) int {
+	for i, h := range header {
+		normalized := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+		if slices.Contains(names, normalized) {
+			return i
+		}
+	}
+	return -1
+}
+
+func parseTagImportDate(raw string) (time.Time, bool) {
+	for _, layout := range tagImportDateLayouts {
+		if at, err := time.Parse(layout, raw); err == nil {
+			return at.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func tagImportCell(record []string, col int) string {
+	if col < 0 || col >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[col])
+}
+
+func isBlankRecord(record []string) bool {
+	for _, c := range record {
+		if strings.TrimSpace(c) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+package leaderboarddomain
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseTagImportRows(t *testing.T) {
+	rows, issues := ParseTagImportRows([][]string{
+		{"\ufeffDate", "Tag_Number", "Member_ID"},
+		{"2025-06-01", "1", "alice"},
+		{"", "", ""},
+		{"2025-06-02T10:00:00Z", "2", "bob"},
+	})
+	if len(issues) != 0 {
+		t.Fatalf("unexpected issues %+v", issues)
+	}
+	want := []TagImportRow{
+		{Line: 2, At: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), TagNumber: 1, MemberID: "alice"},
+		{Line: 4, At: time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC), TagNumber: 2, MemberID: "bob"},
+	}
+	if !slices.Equal(rows, want) {
+		t.Fatalf("rows = %+v, want %+v", rows, want)
+	}
+}
+
+func TestParseTagImportRows_ExportedHistory(t *testing.T) {
+	rows, issues := ParseTagImportRows([][]string{
+		{"date", "tag_number", "old_member_id", "new_member_id", "reason", "round_id"},
+		{"2025-06-01T00:00:00Z", "1", "", "alice", "claim", ""},
+		{"2025-06-03T00:00:00Z", "1", "alice", "", "inactivity", ""},
+	})
+	if len(issues) != 0 {
+		t.Fatalf("unexpected issues %+v", issues)
+	}
+	if len(rows) != 2 || rows[1].MemberID != "" || rows[1].ReleasedFrom != "alice" {
+		t.Fatalf("unexpected rows %+v", rows)
+	}
+}
+
+func TestParseTagImportRows_Issues(t *testing.T) {
+	tests := []struct {
+		name  string
+		table [][]string
+		want  []TagImportIssue
+	}{
+		{"empty file", nil, []TagImportIssue{{Reason: "file is empty"}}},
+		{"missing columns", [][]string{{"name"}}, []TagImportIssue{{Line: 1, Reason: "missing member_id column"}, {Line: 1, Reason: "missing tag_number column"}}},
+		{"header only", [][]string{{"member_id", "tag"}}, []TagImportIssue{{Reason: "file has no rows"}}},
+		{"bad rows", [][]string{
+			{"member_id", "tag", "date"},
+			{"alice", "zero", ""},
+			{"", "2", ""},
+			{"carol", "3", "June"},
+		}, []TagImportIssue{
+			{Line: 2, Reason: "tag_number must be a positive whole number"},
+			{Line: 3, Reason: "member_id is required"},
+			{Line: 4, Reason: `unrecognised date "June"`},
+		}},
+		{"mixed dates", [][]string{
+			{"member_id", "tag", "date"},
+			{"alice", "1", "2025-06-01"},
+			{"bob", "2", ""},
+		}, []TagImportIssue{{Reason: "either every row or no row may have a date"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, issues := ParseTagImportRows(tt.table)
+			if !slices.Equal(issues, tt.want) {
+				t.Fatalf("issues = %+v, want %+v", issues, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanTagImport(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 6, d, 0, 0, 0, 0, time.UTC) }
+	rows := []TagImportRow{
+		{Line: 2, At: day(3), TagNumber: 1, MemberID: "bob"},
+		{Line: 3, At: day(1), TagNumber: 1, MemberID: "alice"},
+		{Line: 4, At: day(2), TagNumber: 2, MemberID: "bob"},
+		{Line: 5, At: day(4), TagNumber: 4, MemberID: "dave"},
+	}
+	current := map[string]int{"alice": 3, "carol": 2, "erin": 4, "frank": 5}
+
+	changes, issues := PlanTagImport(rows, current, 0)
+	if len(issues) != 0 {
+		t.Fatalf("unexpected issues %+v", issues)
+	}
+	// bob ends on tag 1 after taking it from alice, who is left without a tag; erin
+	// loses tag 4 to dave. carol keeps tag 2 because the file left it unheld.
+	want := []TagImportChange{
+		{MemberID: "alice", CurrentTag: 3},
+		{MemberID: "bob", ImportedTag: 1},
+		{MemberID: "dave", ImportedTag: 4},
+		{MemberID: "erin", CurrentTag: 4},
+	}
+	if !slices.Equal(changes, want) {
+		t.Fatalf("changes = %+v, want %+v", changes, want)
+	}
+}
+
+func TestPlanTagImport_Release(t *testing.T) {
+	rows := []TagImportRow{{Line: 2, TagNumber: 3, ReleasedFrom: "alice"}}
+	changes, _ := PlanTagImport(rows, map[string]int{"alice": 3}, 0)
+	if !slices.Equal(changes, []TagImportChange{{MemberID: "alice", CurrentTag: 3}}) {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+}
+
+func TestPlanTagImport_Cap(t *testing.T) {
+	rows := []TagImportRow{{Line: 2, TagNumber: 3, MemberID: "alice"}, {Line: 3, TagNumber: 12, MemberID: "bob"}}
+	changes, issues := PlanTagImport(rows, nil, 10)
+	if changes != nil || !slices.Equal(issues, []TagImportIssue{{Line: 3, Reason: "tag 12 is above the pool cap of 10"}}) {
+		t.Fatalf("unexpected plan %+v, %+v", changes, issues)
+	}
+}
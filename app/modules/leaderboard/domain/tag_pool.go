@@ -0,0 +1,185 @@
+package leaderboarddomain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// TagPoolModel selects which round participants compete for which tags.
+type TagPoolModel string
+
+const (
+	// TagPoolClosed reshuffles the tags held by participants among those holders only;
+	// untagged players are ignored.
+	TagPoolClosed TagPoolModel = "closed"
+	// TagPoolOpen lets untagged finishers claim the tags of holders who finished behind
+	// them. Holders pushed out of the pool lose their tag.
+	TagPoolOpen TagPoolModel = "open"
+	// TagPoolCapped lets only the Cap best-finishing holders claim the best pooled tags;
+	// the other holders take the remaining tags in their previous order.
+	TagPoolCapped TagPoolModel = "capped"
+)
+
+// ErrInvalidTagPoolPolicy is returned when a tag pool policy cannot be applied.
+var ErrInvalidTagPoolPolicy = errors.New("invalid tag pool policy")
+
+// TagPoolPolicy is a guild's tag allocation rule for finalized rounds.
+type TagPoolPolicy struct {
+	Model TagPoolModel `json:"model"`
+	Cap   int          `json:"cap,omitempty"`
+}
+
+// DefaultTagPoolPolicy returns the closed pool every guild starts with.
+func DefaultTagPoolPolicy() TagPoolPolicy {
+	return TagPoolPolicy{Model: TagPoolClosed}
+}
+
+// Validate reports whether the policy can be applied.
+func (p TagPoolPolicy) Validate() error {
+	switch p.Model {
+	case TagPoolClosed, TagPoolOpen:
+		if p.Cap != 0 {
+			return fmt.Errorf("%w: cap only applies to the capped model", ErrInvalidTagPoolPolicy)
+		}
+	case TagPoolCapped:
+		if p.Cap <= 0 {
+			return fmt.Errorf("%w: capped pool needs a positive cap", ErrInvalidTagPoolPolicy)
+		}
+	default:
+		return fmt.Errorf("%w: unknown model %q", ErrInvalidTagPoolPolicy, p.Model)
+	}
+	return nil
+}
+
+// TagAllocator reallocates tags among a round's participants and returns the changes.
+// Every allocator only moves tags already held by participants, so no tag is created,
+// duplicated or lost; a holder whose tag is taken without receiving another ends the
+// round untagged.
+type TagAllocator func(inputs []TagAllocationInput) []TagChange
+
+// Allocator returns the allocation strategy for the policy. Policies that fail
+// Validate fall back to the closed pool.
+func (p TagPoolPolicy) Allocator() TagAllocator {
+	if p.Validate() != nil {
+		return AllocateTagsClosedPool
+	}
+	switch p.Model {
+	case TagPoolOpen:
+		return AllocateTagsOpenPool
+	case TagPoolCapped:
+		limit := p.Cap
+		return func(inputs []TagAllocationInput) []TagChange {
+			return AllocateTagsCappedPool(inputs, limit)
+		}
+	default:
+		return AllocateTagsClosedPool
+	}
+}
+
+// AllocateTagsOpenPool performs open-pool tag reassignment.
+//
+// The pool is the tags held by participants, but every participant competes for it:
+// the best len(pool) finishers receive the pooled tags in finish order, and holders
+// who finish outside that range give up their tag.
+//
+// Ties on FinishRank favour holders over untagged players, then the lower current
+// tag, then member ID, so results do not depend on input order.
+func AllocateTagsOpenPool(inputs []TagAllocationInput) []TagChange {
+	tagPool, holders := collectTagPool(inputs)
+	if len(tagPool) == 0 {
+		return nil
+	}
+
+	ranked := slices.Clone(inputs)
+	slices.SortFunc(ranked, func(a, b TagAllocationInput) int {
+		if c := cmp.Compare(a.FinishRank, b.FinishRank); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(openPoolTagKey(a.CurrentTag), openPoolTagKey(b.CurrentTag)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.MemberID, b.MemberID)
+	})
+
+	return assignPooledTags(ranked[:len(tagPool)], tagPool, holders)
+}
+
+// AllocateTagsCappedPool performs top-N tag reassignment.
+//
+// Only holders compete, as in the closed pool, but only the limit best finishers
+// among them claim tags: they take the limit best pooled tags in finish order. The
+// remaining holders keep their relative tag order and take the tags left over, so a
+// holder displaced from a top tag moves down rather than swapping with the claimant.
+// A limit of zero or at least the number of holders is the closed pool.
+func AllocateTagsCappedPool(inputs []TagAllocationInput, limit int) []TagChange {
+	var eligible []TagAllocationInput
+	for _, inp := range inputs {
+		if inp.CurrentTag > 0 {
+			eligible = append(eligible, inp)
+		}
+	}
+	if limit <= 0 || limit >= len(eligible) {
+		return AllocateTagsClosedPool(inputs)
+	}
+
+	tagPool, holders := collectTagPool(eligible)
+
+	slices.SortFunc(eligible, compareFinishThenTag)
+	rest := slices.Clone(eligible[limit:])
+	slices.SortFunc(rest, func(a, b TagAllocationInput) int {
+		return cmp.Compare(a.CurrentTag, b.CurrentTag)
+	})
+	order := append(eligible[:limit:limit], rest...)
+
+	return assignPooledTags(order, tagPool, holders)
+}
+
+// collectTagPool returns the sorted tags held by inputs and who holds each.
+func collectTagPool(inputs []TagAllocationInput) ([]int, map[int]string) {
+	var tagPool []int
+	holders := make(map[int]string, len(inputs))
+	for _, inp := range inputs {
+		if inp.CurrentTag > 0 {
+			tagPool = append(tagPool, inp.CurrentTag)
+			holders[inp.CurrentTag] = inp.MemberID
+		}
+	}
+	slices.Sort(tagPool)
+	return tagPool, holders
+}
+
+// assignPooledTags gives order[i] the tag tagPool[i] and returns the changes that
+// are not no-ops. order must not be longer than tagPool.
+func assignPooledTags(order []TagAllocationInput, tagPool []int, holders map[int]string) []TagChange {
+	var changes []TagChange
+	for i, participant := range order {
+		newTag := tagPool[i]
+		if newTag == participant.CurrentTag {
+			continue
+		}
+		changes = append(changes, TagChange{
+			TagNumber:   newTag,
+			OldMemberID: holders[newTag],
+			NewMemberID: participant.MemberID,
+		})
+	}
+	return changes
+}
+
+func compareFinishThenTag(a, b TagAllocationInput) int {
+	if c := cmp.Compare(a.FinishRank, b.FinishRank); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.CurrentTag, b.CurrentTag)
+}
+
+// openPoolTagKey orders untagged players after every holder on a finish tie.
+func openPoolTagKey(tag int) int {
+	if tag <= 0 {
+		return math.MaxInt
+	}
+	return tag
+}
@@ -0,0 +1,213 @@
+package leaderboarddomain
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+	"testing/quick"
+)
+
+func TestTagPoolPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy TagPoolPolicy
+		valid  bool
+	}{
+		{"closed", TagPoolPolicy{Model: TagPoolClosed}, true},
+		{"open", TagPoolPolicy{Model: TagPoolOpen}, true},
+		{"capped", TagPoolPolicy{Model: TagPoolCapped, Cap: 3}, true},
+		{"capped without cap", TagPoolPolicy{Model: TagPoolCapped}, false},
+		{"cap on open pool", TagPoolPolicy{Model: TagPoolOpen, Cap: 3}, false},
+		{"unknown model", TagPoolPolicy{Model: "lottery"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidTagPoolPolicy) {
+				t.Fatalf("expected ErrInvalidTagPoolPolicy, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAllocateTagsOpenPool(t *testing.T) {
+	t.Run("untagged finisher claims the tag of a holder behind them", func(t *testing.T) {
+		changes := AllocateTagsOpenPool([]TagAllocationInput{
+			{MemberID: "alice", FinishRank: 1, CurrentTag: 0},
+			{MemberID: "bob", FinishRank: 2, CurrentTag: 3},
+			{MemberID: "carol", FinishRank: 3, CurrentTag: 5},
+		})
+		final := ComputeFinalTagState(map[string]int{"bob": 3, "carol": 5}, changes)
+		want := []MemberTagAssignment{{MemberID: "alice", Tag: 3}, {MemberID: "bob", Tag: 5}}
+		if !slices.Equal(final, want) {
+			t.Fatalf("final state %+v, want %+v", final, want)
+		}
+	})
+
+	t.Run("untagged finisher behind every holder gets nothing", func(t *testing.T) {
+		changes := AllocateTagsOpenPool([]TagAllocationInput{
+			{MemberID: "alice", FinishRank: 1, CurrentTag: 2},
+			{MemberID: "bob", FinishRank: 2, CurrentTag: 0},
+		})
+		if len(changes) != 0 {
+			t.Fatalf("expected no changes, got %+v", changes)
+		}
+	})
+
+	t.Run("holder wins a tie against an untagged player", func(t *testing.T) {
+		changes := AllocateTagsOpenPool([]TagAllocationInput{
+			{MemberID: "alice", FinishRank: 1, CurrentTag: 0},
+			{MemberID: "bob", FinishRank: 1, CurrentTag: 4},
+		})
+		if len(changes) != 0 {
+			t.Fatalf("expected no changes, got %+v", changes)
+		}
+	})
+}
+
+func TestAllocateTagsCappedPool(t *testing.T) {
+	t.Run("only the winner claims and the rest shift down in order", func(t *testing.T) {
+		changes := AllocateTagsCappedPool([]TagAllocationInput{
+			{MemberID: "alice", FinishRank: 2, CurrentTag: 1},
+			{MemberID: "bob", FinishRank: 3, CurrentTag: 2},
+			{MemberID: "carol", FinishRank: 1, CurrentTag: 4},
+			{MemberID: "dave", FinishRank: 4, CurrentTag: 0},
+		}, 1)
+		final := ComputeFinalTagState(map[string]int{"alice": 1, "bob": 2, "carol": 4}, changes)
+		want := []MemberTagAssignment{{MemberID: "carol", Tag: 1}, {MemberID: "alice", Tag: 2}, {MemberID: "bob", Tag: 4}}
+		if !slices.Equal(final, want) {
+			t.Fatalf("final state %+v, want %+v", final, want)
+		}
+	})
+
+	t.Run("cap covering every holder is the closed pool", func(t *testing.T) {
+		inputs := []TagAllocationInput{
+			{MemberID: "alice", FinishRank: 3, CurrentTag: 1},
+			{MemberID: "bob", FinishRank: 1, CurrentTag: 2},
+			{MemberID: "carol", FinishRank: 2, CurrentTag: 3},
+		}
+		if got, want := AllocateTagsCappedPool(inputs, 3), AllocateTagsClosedPool(inputs); !slices.Equal(got, want) {
+			t.Fatalf("capped %+v, closed %+v", got, want)
+		}
+	})
+}
+
+func TestTagPoolPolicyAllocator_FallsBackToClosedPool(t *testing.T) {
+	inputs := []TagAllocationInput{
+		{MemberID: "alice", FinishRank: 1, CurrentTag: 0},
+		{MemberID: "bob", FinishRank: 2, CurrentTag: 1},
+	}
+	changes := TagPoolPolicy{Model: TagPoolCapped}.Allocator()(inputs)
+	if len(changes) != 0 {
+		t.Fatalf("invalid policy should behave as the closed pool, got %+v", changes)
+	}
+}
+
+// poolRound is a randomly generated round: distinct members, distinct tags held by
+// some of them, and finish ranks with ties.
+type poolRound struct {
+	Inputs []TagAllocationInput
+	Cap    int
+}
+
+func (poolRound) Generate(r *rand.Rand, size int) reflect.Value {
+	n := 1 + r.Intn(size+1)
+	tags := r.Perm(n * 2)
+	round := poolRound{Inputs: make([]TagAllocationInput, n), Cap: r.Intn(n + 1)}
+	for i := range round.Inputs {
+		tag := 0
+		if r.Intn(3) > 0 {
+			tag = tags[i] + 1
+		}
+		round.Inputs[i] = TagAllocationInput{
+			MemberID:   fmt.Sprintf("m%02d", i),
+			FinishRank: 1 + r.Intn(n),
+			CurrentTag: tag,
+		}
+	}
+	return reflect.ValueOf(round)
+}
+
+func TestTagAllocators_NeverDuplicateOrLoseTags(t *testing.T) {
+	allocators := map[string]func(poolRound) []TagChange{
+		"closed": func(p poolRound) []TagChange { return AllocateTagsClosedPool(p.Inputs) },
+		"open":   func(p poolRound) []TagChange { return AllocateTagsOpenPool(p.Inputs) },
+		"capped": func(p poolRound) []TagChange { return AllocateTagsCappedPool(p.Inputs, p.Cap) },
+	}
+
+	for name, allocate := range allocators {
+		t.Run(name, func(t *testing.T) {
+			property := func(p poolRound) bool {
+				before := make(map[string]int, len(p.Inputs))
+				var tagsBefore []int
+				for _, in := range p.Inputs {
+					if in.CurrentTag > 0 {
+						before[in.MemberID] = in.CurrentTag
+						tagsBefore = append(tagsBefore, in.CurrentTag)
+					}
+				}
+
+				changes := allocate(p)
+				for _, ch := range changes {
+					if ch.OldMemberID == ch.NewMemberID || ch.TagNumber <= 0 {
+						t.Logf("no-op or invalid change %+v", ch)
+						return false
+					}
+					if holder, ok := holderOf(before, ch.TagNumber); !ok || holder != ch.OldMemberID {
+						t.Logf("change %+v does not name the previous holder %q", ch, holder)
+						return false
+					}
+				}
+
+				var tagsAfter []int
+				for _, a := range ComputeFinalTagState(before, changes) {
+					tagsAfter = append(tagsAfter, a.Tag)
+				}
+				slices.Sort(tagsBefore)
+				if !slices.Equal(tagsBefore, tagsAfter) {
+					t.Logf("tags before %v, after %v", tagsBefore, tagsAfter)
+					return false
+				}
+				return true
+			}
+			if err := quick.Check(property, &quick.Config{MaxCount: 1000}); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestTagAllocators_ClosedAndCappedIgnoreUntaggedPlayers(t *testing.T) {
+	property := func(p poolRound) bool {
+		untagged := make(map[string]bool)
+		for _, in := range p.Inputs {
+			if in.CurrentTag == 0 {
+				untagged[in.MemberID] = true
+			}
+		}
+		for _, ch := range append(AllocateTagsClosedPool(p.Inputs), AllocateTagsCappedPool(p.Inputs, p.Cap)...) {
+			if untagged[ch.NewMemberID] {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 1000}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func holderOf(state map[string]int, tag int) (string, bool) {
+	for member, held := range state {
+		if held == tag {
+			return member, true
+		}
+	}
+	return "", false
+}
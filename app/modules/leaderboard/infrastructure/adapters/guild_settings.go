@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	guildservice "github.com/Black-And-White-Club/frolf-bot/app/modules/guild/application"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+)
+
+// GuildSettingsAdapter adapts the guild service to the leaderboard service's
+// GuildSettingsSource port.
+type GuildSettingsAdapter struct {
+	guildService guildservice.Service
+}
+
+func NewGuildSettingsAdapter(guildService guildservice.Service) *GuildSettingsAdapter {
+	return &GuildSettingsAdapter{guildService: guildService}
+}
+
+// GetGuildSettings returns the guild's tag pool model and custom leaderboards switch,
+// or nil when the guild has no active config.
+func (a *GuildSettingsAdapter) GetGuildSettings(ctx context.Context, guildID sharedtypes.GuildID) (*leaderboardservice.GuildSettings, error) {
+	result, err := a.guildService.GetTagPoolConfig(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsFailure() {
+		if result.Failure != nil && errors.Is(*result.Failure, guildservice.ErrGuildConfigNotFound) {
+			return nil, nil
+		}
+		if result.Failure == nil {
+			return nil, fmt.Errorf("guild settings lookup failed with nil error")
+		}
+		return nil, fmt.Errorf("guild settings lookup failed: %w", *result.Failure)
+	}
+	if result.Success == nil || *result.Success == nil {
+		return nil, nil
+	}
+	config := *result.Success
+	return &leaderboardservice.GuildSettings{
+		TagPool: leaderboarddomain.TagPoolPolicy{
+			Model: leaderboarddomain.TagPoolModel(config.Model),
+			Cap:   config.Cap,
+		},
+		CustomLeaderboardsEnabled: config.CustomLeaderboardsEnabled,
+	}, nil
+}
+
+// UpdateTagPoolPolicy stores the tag pool model on the guild's config. Guilds without
+// an active config return leaderboardservice.ErrGuildConfigNotFound.
+func (a *GuildSettingsAdapter) UpdateTagPoolPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.TagPoolPolicy) error {
+	result, err := a.guildService.UpdateTagPoolConfig(ctx, guildID, string(policy.Model), policy.Cap)
+	if err != nil {
+		return err
+	}
+	if result.IsFailure() {
+		if result.Failure != nil && errors.Is(*result.Failure, guildservice.ErrGuildConfigNotFound) {
+			return leaderboardservice.ErrGuildConfigNotFound
+		}
+		if result.Failure == nil {
+			return fmt.Errorf("tag pool update failed with nil error")
+		}
+		return fmt.Errorf("tag pool update failed: %w", *result.Failure)
+	}
+	return nil
+}
@@ -3,10 +3,13 @@ package adapters
 import (
 	"context"
 	"fmt"
+	"time"
 
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
 	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	"github.com/google/uuid"
 )
 
 // RoundLookupAdapter adapts the round service to the leaderboard handler's RoundLookup port.
@@ -35,3 +38,49 @@ func (a *RoundLookupAdapter) GetRound(ctx context.Context, guildID sharedtypes.G
 	}
 	return *result.Success, nil
 }
+
+// GetCourseLayoutRounds returns finalized rounds played on a course layout since startTime.
+func (a *RoundLookupAdapter) GetCourseLayoutRounds(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error) {
+	return a.roundService.GetCourseLayoutRounds(ctx, guildID, layoutID, startTime)
+}
+
+// GetRoundPar returns the total par of a round's layout, or 0 when the round has no
+// par scores.
+func (a *RoundLookupAdapter) GetRoundPar(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (int, error) {
+	round, err := a.GetRound(ctx, guildID, roundID)
+	if err != nil || round == nil {
+		return 0, err
+	}
+	par := 0
+	for _, holePar := range round.ParScores {
+		par += holePar
+	}
+	return par, nil
+}
+
+// GetMemberReliability returns the member's check-in record, or nil when the member
+// has never been tracked by a round with check-in enabled.
+func (a *RoundLookupAdapter) GetMemberReliability(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (*leaderboarddomain.Reliability, error) {
+	result, err := a.roundService.GetMemberReliability(ctx, guildID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsFailure() {
+		if result.Failure == nil {
+			return nil, fmt.Errorf("reliability lookup failed with nil error")
+		}
+		return nil, fmt.Errorf("reliability lookup failed: %w", *result.Failure)
+	}
+	if result.Success == nil || *result.Success == nil {
+		return nil, nil
+	}
+	reliability := *result.Success
+	if reliability.CheckedIn+reliability.NoShows == 0 {
+		return nil, nil
+	}
+	return &leaderboarddomain.Reliability{
+		CheckedIn: reliability.CheckedIn,
+		NoShows:   reliability.NoShows,
+		Rate:      reliability.Rate,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
 	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -18,7 +19,8 @@ import (
 type StubRoundService struct {
 	roundservice.Service // Embedded to satisfy interface for methods we don't implement
 
-	GetRoundFunc func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[*roundtypes.Round, error], error)
+	GetRoundFunc             func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[*roundtypes.Round, error], error)
+	GetMemberReliabilityFunc func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[*roundservice.MemberReliability, error], error)
 }
 
 func (s *StubRoundService) GetRound(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[*roundtypes.Round, error], error) {
@@ -28,6 +30,46 @@ func (s *StubRoundService) GetRound(ctx context.Context, guildID sharedtypes.Gui
 	return results.OperationResult[*roundtypes.Round, error]{}, nil // Default return
 }
 
+func (s *StubRoundService) GetMemberReliability(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[*roundservice.MemberReliability, error], error) {
+	if s.GetMemberReliabilityFunc != nil {
+		return s.GetMemberReliabilityFunc(ctx, guildID, userID)
+	}
+	return results.OperationResult[*roundservice.MemberReliability, error]{}, nil
+}
+
+func TestRoundLookupAdapter_GetMemberReliability(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		record roundservice.MemberReliability
+		want   *leaderboarddomain.Reliability
+	}{
+		{name: "no check-in history", record: roundservice.MemberReliability{Rate: 1}},
+		{
+			name:   "tracked rounds",
+			record: roundservice.MemberReliability{CheckedIn: 3, NoShows: 1, Rate: 0.75},
+			want:   &leaderboarddomain.Reliability{CheckedIn: 3, NoShows: 1, Rate: 0.75},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubService := &StubRoundService{
+				GetMemberReliabilityFunc: func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[*roundservice.MemberReliability, error], error) {
+					record := tt.record
+					return results.SuccessResult[*roundservice.MemberReliability, error](&record), nil
+				},
+			}
+			adapter := NewRoundLookupAdapter(stubService)
+
+			got, err := adapter.GetMemberReliability(ctx, "guild-123", "user-1")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestRoundLookupAdapter_GetRound(t *testing.T) {
 	__codexTDCases := []struct {
 		name string
@@ -115,3 +157,23 @@ func TestRoundLookupAdapter_GetRound(t *testing.T) {
 		})
 	}
 }
+
+func TestRoundLookupAdapter_GetRoundPar(t *testing.T) {
+	ctx := context.Background()
+	roundID := sharedtypes.RoundID(uuid.New())
+	stub := func(round *roundtypes.Round) *StubRoundService {
+		return &StubRoundService{
+			GetRoundFunc: func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[*roundtypes.Round, error], error) {
+				return results.OperationResult[*roundtypes.Round, error]{Success: &round}, nil
+			},
+		}
+	}
+
+	par, err := NewRoundLookupAdapter(stub(&roundtypes.Round{ID: roundID, ParScores: []int{3, 3, 4, 3}})).GetRoundPar(ctx, "guild-123", roundID)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, par)
+
+	par, err = NewRoundLookupAdapter(stub(&roundtypes.Round{ID: roundID})).GetRoundPar(ctx, "guild-123", roundID)
+	assert.NoError(t, err)
+	assert.Zero(t, par)
+}
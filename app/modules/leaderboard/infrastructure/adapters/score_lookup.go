@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	scoreservice "github.com/Black-And-White-Club/frolf-bot/app/modules/score/application"
+)
+
+// ScoreLookupAdapter adapts the score service to the leaderboard handler's ScoreLookup
+// port and the leaderboard service's RoundScoreSource port.
+type ScoreLookupAdapter struct {
+	scoreService scoreservice.Service
+}
+
+func NewScoreLookupAdapter(scoreService scoreservice.Service) *ScoreLookupAdapter {
+	return &ScoreLookupAdapter{scoreService: scoreService}
+}
+
+// GetTagRanks returns gross ranks for net rounds whose guild reallocates tags by gross
+// order. Every other round returns nil so tags follow the finish ranks.
+func (a *ScoreLookupAdapter) GetTagRanks(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (map[sharedtypes.DiscordID]int, error) {
+	result, err := a.scoreService.GetRoundResults(ctx, guildID, roundID)
+	if err != nil {
+		return nil, fmt.Errorf("round results lookup failed: %w", err)
+	}
+	// Rounds without stored scores (e.g. admin batches) have no separate tag order.
+	if result.Success == nil {
+		return nil, nil
+	}
+
+	round := result.Success
+	if round.ScoringMode != scoreservice.ScoringModeNet || round.TagOrder != scoreservice.ScoringModeGross {
+		return nil, nil
+	}
+
+	ranks := make(map[sharedtypes.DiscordID]int, len(round.Results))
+	for _, r := range round.Results {
+		ranks[r.UserID] = r.GrossRank
+	}
+	return ranks, nil
+}
+
+// GetRoundScores returns each member's gross stroke total for a round. Rounds without
+// stored scores return nil.
+func (a *ScoreLookupAdapter) GetRoundScores(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (map[sharedtypes.DiscordID]int, error) {
+	result, err := a.scoreService.GetRoundResults(ctx, guildID, roundID)
+	if err != nil {
+		return nil, fmt.Errorf("round results lookup failed: %w", err)
+	}
+	if result.Success == nil {
+		return nil, nil
+	}
+
+	scores := make(map[sharedtypes.DiscordID]int, len(result.Success.Results))
+	for _, r := range result.Success.Results {
+		scores[r.UserID] = int(r.GrossScore)
+	}
+	return scores, nil
+}
@@ -0,0 +1,104 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	scoreservice "github.com/Black-And-White-Club/frolf-bot/app/modules/score/application"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// StubScoreService is a test stub for scoreservice.Service.
+type StubScoreService struct {
+	scoreservice.Service
+
+	GetRoundResultsFunc func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[scoreservice.RoundResults, error], error)
+}
+
+func (s *StubScoreService) GetRoundResults(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[scoreservice.RoundResults, error], error) {
+	return s.GetRoundResultsFunc(ctx, guildID, roundID)
+}
+
+func TestScoreLookupAdapter_GetTagRanks(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-123")
+	roundID := sharedtypes.RoundID(uuid.New())
+	players := []scoreservice.PlayerResult{
+		{UserID: "a", GrossRank: 2, NetRank: 1},
+		{UserID: "b", GrossRank: 1, NetRank: 2},
+	}
+
+	withResults := func(mode, tagOrder string) *StubScoreService {
+		return &StubScoreService{GetRoundResultsFunc: func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[scoreservice.RoundResults, error], error) {
+			return results.SuccessResult[scoreservice.RoundResults, error](scoreservice.RoundResults{
+				RoundID: roundID, ScoringMode: mode, TagOrder: tagOrder, Results: players,
+			}), nil
+		}}
+	}
+
+	t.Run("NetRoundGrossTags", func(t *testing.T) {
+		ranks, err := NewScoreLookupAdapter(withResults(scoreservice.ScoringModeNet, scoreservice.ScoringModeGross)).GetTagRanks(ctx, guildID, roundID)
+		assert.NoError(t, err)
+		assert.Equal(t, map[sharedtypes.DiscordID]int{"a": 2, "b": 1}, ranks)
+	})
+
+	t.Run("NetRoundNetTags", func(t *testing.T) {
+		ranks, err := NewScoreLookupAdapter(withResults(scoreservice.ScoringModeNet, scoreservice.ScoringModeNet)).GetTagRanks(ctx, guildID, roundID)
+		assert.NoError(t, err)
+		assert.Nil(t, ranks)
+	})
+
+	t.Run("GrossRound", func(t *testing.T) {
+		ranks, err := NewScoreLookupAdapter(withResults(scoreservice.ScoringModeGross, scoreservice.ScoringModeGross)).GetTagRanks(ctx, guildID, roundID)
+		assert.NoError(t, err)
+		assert.Nil(t, ranks)
+	})
+
+	t.Run("NoStoredScores", func(t *testing.T) {
+		stub := &StubScoreService{GetRoundResultsFunc: func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[scoreservice.RoundResults, error], error) {
+			return results.FailureResult[scoreservice.RoundResults, error](scoreservice.ErrRoundScoresNotFound), nil
+		}}
+		ranks, err := NewScoreLookupAdapter(stub).GetTagRanks(ctx, guildID, roundID)
+		assert.NoError(t, err)
+		assert.Nil(t, ranks)
+	})
+
+	t.Run("ServiceError", func(t *testing.T) {
+		stub := &StubScoreService{GetRoundResultsFunc: func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[scoreservice.RoundResults, error], error) {
+			return results.OperationResult[scoreservice.RoundResults, error]{}, errors.New("db down")
+		}}
+		_, err := NewScoreLookupAdapter(stub).GetTagRanks(ctx, guildID, roundID)
+		assert.Error(t, err)
+	})
+}
+
+func TestScoreLookupAdapter_GetRoundScores(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-123")
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	t.Run("GrossScores", func(t *testing.T) {
+		stub := &StubScoreService{GetRoundResultsFunc: func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[scoreservice.RoundResults, error], error) {
+			return results.SuccessResult[scoreservice.RoundResults, error](scoreservice.RoundResults{
+				RoundID: roundID,
+				Results: []scoreservice.PlayerResult{{UserID: "a", GrossScore: 54, NetScore: 50.5}, {UserID: "b", GrossScore: 57}},
+			}), nil
+		}}
+		scores, err := NewScoreLookupAdapter(stub).GetRoundScores(ctx, guildID, roundID)
+		assert.NoError(t, err)
+		assert.Equal(t, map[sharedtypes.DiscordID]int{"a": 54, "b": 57}, scores)
+	})
+
+	t.Run("NoStoredScores", func(t *testing.T) {
+		stub := &StubScoreService{GetRoundResultsFunc: func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[scoreservice.RoundResults, error], error) {
+			return results.FailureResult[scoreservice.RoundResults, error](scoreservice.ErrRoundScoresNotFound), nil
+		}}
+		scores, err := NewScoreLookupAdapter(stub).GetRoundScores(ctx, guildID, roundID)
+		assert.NoError(t, err)
+		assert.Nil(t, scores)
+	})
+}
@@ -171,11 +171,13 @@ func (h *LeaderboardHandlers) HandleRecalculateRound(
 	}}, nil
 }
 
-// HandleStartNewSeason creates a new season.
+// HandleStartNewSeason creates a new season. The season it closes is announced as on
+// HandleEndSeason.
 func (h *LeaderboardHandlers) HandleStartNewSeason(
 	ctx context.Context,
 	payload *leaderboardevents.StartNewSeasonPayloadV1,
 ) ([]handlerwrapper.Result, error) {
+	previous := h.activeSeasonID(ctx, payload.GuildID)
 	result, err := h.service.StartNewSeason(ctx, payload.GuildID, payload.SeasonID, payload.SeasonName)
 	if err != nil {
 		return []handlerwrapper.Result{{
@@ -191,14 +193,18 @@ func (h *LeaderboardHandlers) HandleStartNewSeason(
 		}}, nil
 	}
 
-	return []handlerwrapper.Result{{
+	out := []handlerwrapper.Result{{
 		Topic: leaderboardevents.LeaderboardStartNewSeasonSuccessV1,
 		Payload: &leaderboardevents.StartNewSeasonSuccessPayloadV1{
 			GuildID:    payload.GuildID,
 			SeasonID:   payload.SeasonID,
 			SeasonName: payload.SeasonName,
 		},
-	}}, nil
+	}}
+	if previous != "" {
+		out = append(out, h.seasonEndedResults(ctx, payload.GuildID, previous)...)
+	}
+	return out, nil
 }
 
 // HandleGetSeasonStandings returns standings for a specific season.
@@ -288,10 +294,30 @@ func (h *LeaderboardHandlers) HandleListSeasonsRequest(
 }
 
 // HandleSeasonStandingsRequest returns standings for a season via NATS request-reply.
+// Ended seasons are answered from their archive; active seasons from live standings.
 func (h *LeaderboardHandlers) HandleSeasonStandingsRequest(
 	ctx context.Context,
 	payload *leaderboardevents.SeasonStandingsRequestPayloadV1,
 ) ([]handlerwrapper.Result, error) {
+	// Use reply_to for request-reply pattern
+	topic := leaderboardevents.LeaderboardSeasonStandingsResponseV1
+	if replyTo, ok := ctx.Value(handlerwrapper.CtxKeyReplyTo).(string); ok && replyTo != "" {
+		topic = replyTo
+	}
+
+	if payload.SeasonID != "" {
+		archive, err := h.service.GetSeasonArchive(ctx, payload.GuildID, payload.SeasonID)
+		if err != nil {
+			return []handlerwrapper.Result{{
+				Topic:   leaderboardevents.LeaderboardSeasonStandingsFailedV1,
+				Payload: &leaderboardevents.AdminFailedPayloadV1{GuildID: payload.GuildID, Reason: err.Error()},
+			}}, nil
+		}
+		if archive.IsSuccess() {
+			return []handlerwrapper.Result{{Topic: topic, Payload: toArchivedStandingsResponse(payload.GuildID, *archive.Success)}}, nil
+		}
+	}
+
 	result, err := h.service.GetSeasonStandingsForSeason(ctx, payload.GuildID, payload.SeasonID)
 	if err != nil {
 		return []handlerwrapper.Result{{
@@ -328,16 +354,11 @@ func (h *LeaderboardHandlers) HandleSeasonStandingsRequest(
 		Standings:  items,
 	}
 
-	// Use reply_to for request-reply pattern
-	topic := leaderboardevents.LeaderboardSeasonStandingsResponseV1
-	if replyTo, ok := ctx.Value(handlerwrapper.CtxKeyReplyTo).(string); ok && replyTo != "" {
-		topic = replyTo
-	}
-
 	return []handlerwrapper.Result{{Topic: topic, Payload: resp}}, nil
 }
 
-// HandleEndSeason ends the active season.
+// HandleEndSeason ends the active season and announces its final standings, awards and
+// the division moves made by the guild's promotion policy.
 func (h *LeaderboardHandlers) HandleEndSeason(
 	ctx context.Context,
 	payload *leaderboardevents.EndSeasonPayloadV1,
@@ -357,10 +378,57 @@ func (h *LeaderboardHandlers) HandleEndSeason(
 		}}, nil
 	}
 
-	return []handlerwrapper.Result{{
+	out := []handlerwrapper.Result{{
 		Topic: leaderboardevents.LeaderboardEndSeasonSuccessV1,
 		Payload: &leaderboardevents.EndSeasonSuccessPayloadV1{
 			GuildID: payload.GuildID,
 		},
-	}}, nil
+	}}
+	// False means the guild had no active season, so nothing ended.
+	if result.Success == nil || !*result.Success {
+		return out, nil
+	}
+	return append(out, h.seasonEndedResults(ctx, payload.GuildID, "")...), nil
+}
+
+// seasonEndedResults announces an ended season's final standings and awards and the
+// division moves its promotion policy made. An empty seasonID selects the latest ended
+// season. The season is closed either way; a failed archive read only skips the
+// announcement.
+func (h *LeaderboardHandlers) seasonEndedResults(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) []handlerwrapper.Result {
+	var out []handlerwrapper.Result
+	archive, err := h.service.GetSeasonArchive(ctx, guildID, seasonID)
+	if err == nil && archive.IsFailure() {
+		err = *archive.Failure
+	}
+	if err != nil {
+		if h.logger != nil {
+			h.logger.WarnContext(ctx, "failed to load season archive after ending season; skipping season ended event",
+				"guild_id", guildID,
+				"season_id", seasonID,
+				"error", err,
+			)
+		}
+	} else {
+		out = append(out, handlerwrapper.Result{
+			Topic:   LeaderboardSeasonEndedV1,
+			Payload: toSeasonEndedPayload(guildID, *archive.Success),
+		})
+	}
+	return append(out, h.seasonPromotionResults(ctx, guildID, seasonID)...)
+}
+
+// activeSeasonID returns the guild's active season, or "" when it has none or the
+// seasons cannot be read.
+func (h *LeaderboardHandlers) activeSeasonID(ctx context.Context, guildID sharedtypes.GuildID) string {
+	result, err := h.service.ListSeasons(ctx, guildID)
+	if err != nil || result.Success == nil {
+		return ""
+	}
+	for _, season := range *result.Success {
+		if season.IsActive {
+			return season.ID
+		}
+	}
+	return ""
 }
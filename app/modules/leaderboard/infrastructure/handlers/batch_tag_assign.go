@@ -13,6 +13,7 @@ import (
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
 	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
 	"github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/saga"
 	"github.com/google/uuid"
 )
@@ -102,11 +103,13 @@ func (h *LeaderboardHandlers) handleRoundBasedAssignmentWithCommandFlow(
 			FinishRank: r.FinishRank,
 		})
 	}
+	h.applyTagRanks(ctx, payload.GuildID, *payload.RoundID, participants)
 
 	output, err := h.service.ProcessRoundCommand(ctx, leaderboardservice.ProcessRoundCommand{
 		GuildID:      string(payload.GuildID),
 		RoundID:      uuid.UUID(*payload.RoundID),
 		Participants: participants,
+		StartTime:    h.roundStartTime(ctx, payload.GuildID, *payload.RoundID),
 	})
 	if err != nil {
 		return nil, err
@@ -223,7 +226,8 @@ func (h *LeaderboardHandlers) buildLeaderboardUpdatedResult(
 	guildID sharedtypes.GuildID,
 	roundID sharedtypes.RoundID,
 ) (handlerwrapper.Result, error) {
-	fullLeaderboardResult, err := h.service.GetLeaderboard(ctx, guildID, "")
+	// Tags are only unique within a division; see handleLeaderboardUpdateWithServiceCommand.
+	fullLeaderboardResult, err := h.service.GetDivisionLeaderboard(ctx, guildID, leaderboarddomain.DefaultDivisionID, "")
 	if err != nil {
 		return handlerwrapper.Result{}, fmt.Errorf("fetch full leaderboard after round processing: %w", err)
 	}
@@ -3,6 +3,7 @@ package leaderboardhandlers
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -145,7 +146,7 @@ func TestLeaderboardHandlers_HandleRoundBasedAssignment(t *testing.T) {
 						},
 					}, nil
 				}
-				f.GetLeaderboardFunc = func(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
+				f.GetDivisionLeaderboardFunc = func(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
 					return results.SuccessResult[[]leaderboardtypes.LeaderboardEntry, error]([]leaderboardtypes.LeaderboardEntry{
 						{UserID: "user-2", TagNumber: 2},
 						{UserID: "user-1", TagNumber: 1},
@@ -265,7 +266,7 @@ func TestLeaderboardHandlers_HandleRoundBasedAssignment(t *testing.T) {
 						},
 					}, nil
 				}
-				f.GetLeaderboardFunc = func(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
+				f.GetDivisionLeaderboardFunc = func(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
 					return results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error]{}, fmt.Errorf("snapshot read failed")
 				}
 			},
@@ -590,3 +591,77 @@ func TestMapSuccessResults_TagRemovalBehavior(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleRoundBasedAssignment_AppliesTagRanks(t *testing.T) {
+	testClubUUID := uuid.MustParse("77cc76fe-8947-4c7e-bbb2-2cd33ad366c1")
+	testGuildID := sharedtypes.GuildID("test-guild-net")
+	testRoundID := sharedtypes.RoundID(uuid.New())
+
+	payload := &sharedevents.BatchTagAssignmentRequestedPayloadV1{
+		ScopedGuildID: sharedevents.ScopedGuildID{GuildID: testGuildID},
+		BatchID:       uuid.New().String(),
+		RoundID:       &testRoundID,
+		Source:        sharedtypes.ServiceUpdateSourceProcessScores,
+		Assignments: []sharedevents.TagAssignmentInfoV1{
+			{UserID: "user-a", TagNumber: 1, FinishRank: 1},
+			{UserID: "user-b", TagNumber: 2, FinishRank: 2},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		scoreLookup  ScoreLookup
+		wantTagRanks map[string]int
+	}{
+		{
+			name: "net round with gross tag order",
+			scoreLookup: &FakeScoreLookup{GetTagRanksFunc: func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (map[sharedtypes.DiscordID]int, error) {
+				return map[sharedtypes.DiscordID]int{"user-a": 2, "user-b": 1}, nil
+			}},
+			wantTagRanks: map[string]int{"user-a": 2, "user-b": 1},
+		},
+		{
+			name:         "round without separate tag order",
+			scoreLookup:  &FakeScoreLookup{},
+			wantTagRanks: map[string]int{"user-a": 0, "user-b": 0},
+		},
+		{
+			name: "lookup failure falls back to finish order",
+			scoreLookup: &FakeScoreLookup{GetTagRanksFunc: func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (map[sharedtypes.DiscordID]int, error) {
+				return nil, errors.New("score store unavailable")
+			}},
+			wantTagRanks: map[string]int{"user-a": 0, "user-b": 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured []leaderboardservice.RoundParticipantInput
+
+			fakeSvc := NewFakeService()
+			fakeSvc.ProcessRoundCommandFunc = func(ctx context.Context, cmd leaderboardservice.ProcessRoundCommand) (*leaderboardservice.ProcessRoundOutput, error) {
+				captured = cmd.Participants
+				return &leaderboardservice.ProcessRoundOutput{
+					FinalParticipantTags: map[string]int{"user-a": 2, "user-b": 1},
+					PointsSkipped:        true,
+				}, nil
+			}
+
+			h := &LeaderboardHandlers{
+				service:     fakeSvc,
+				userService: &FakeUserService{GetClubUUIDByDiscordGuildIDFunc: func(ctx context.Context, guildID sharedtypes.GuildID) (uuid.UUID, error) { return testClubUUID, nil }},
+				logger:      slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			h.SetScoreLookup(tt.scoreLookup)
+
+			if _, err := h.HandleBatchTagAssignmentRequested(context.Background(), payload); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, p := range captured {
+				if p.TagRank != tt.wantTagRanks[p.MemberID] {
+					t.Errorf("participant %s: expected TagRank %d, got %d", p.MemberID, tt.wantTagRanks[p.MemberID], p.TagRank)
+				}
+			}
+		})
+	}
+}
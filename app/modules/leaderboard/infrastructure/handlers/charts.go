@@ -0,0 +1,131 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Statistics chart topics. Each chart kind is requested on its own subject, suffixed
+// with the guild ID, and answered with a ChartResponsePayloadV1.
+const (
+	LeaderboardChartPointsRaceRequestedV1        = "leaderboard.chart.points_race.requested.v1"
+	LeaderboardChartScoreDistributionRequestedV1 = "leaderboard.chart.score_distribution.requested.v1"
+	LeaderboardChartAttendanceHeatmapRequestedV1 = "leaderboard.chart.attendance_heatmap.requested.v1"
+	LeaderboardChartTagComparisonRequestedV1     = "leaderboard.chart.tag_comparison.requested.v1"
+	LeaderboardChartResponseV1                   = "leaderboard.chart.response.v1"
+	LeaderboardChartFailedV1                     = "leaderboard.chart.failed.v1"
+)
+
+// ChartRequestedPayloadV1 asks for a statistics chart. Format is "png" (default) or
+// "svg". Fields a chart kind does not use are ignored: SeasonID applies to points
+// races and score distributions, MemberIDs to score distributions (one member) and tag
+// comparisons, TopN to points races and Weeks to attendance heatmaps. Labels maps
+// member IDs to legend names.
+type ChartRequestedPayloadV1 struct {
+	GuildID   string            `json:"guild_id"`
+	ClubUUID  *string           `json:"club_uuid,omitempty"`
+	Format    string            `json:"format,omitempty"`
+	SeasonID  string            `json:"season_id,omitempty"`
+	MemberIDs []string          `json:"member_ids,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	TopN      int               `json:"top_n,omitempty"`
+	Weeks     int               `json:"weeks,omitempty"`
+}
+
+// ChartResponsePayloadV1 carries a rendered chart.
+type ChartResponsePayloadV1 struct {
+	GuildID     string `json:"guild_id"`
+	Kind        string `json:"kind"`
+	Format      string `json:"format"`
+	ContentType string `json:"content_type"`
+	Data        []byte `json:"data"`
+}
+
+// ChartFailedPayloadV1 reports a chart request that could not be served.
+type ChartFailedPayloadV1 struct {
+	GuildID string `json:"guild_id"`
+	Kind    string `json:"kind"`
+	Reason  string `json:"reason"`
+}
+
+// HandlePointsRaceChartRequest renders the season points race of the top members.
+func (h *LeaderboardHandlers) HandlePointsRaceChartRequest(ctx context.Context, payload *ChartRequestedPayloadV1) ([]handlerwrapper.Result, error) {
+	return h.handleChartRequest(ctx, leaderboardservice.ChartPointsRace, payload)
+}
+
+// HandleScoreDistributionChartRequest renders a histogram of one member's scores.
+func (h *LeaderboardHandlers) HandleScoreDistributionChartRequest(ctx context.Context, payload *ChartRequestedPayloadV1) ([]handlerwrapper.Result, error) {
+	return h.handleChartRequest(ctx, leaderboardservice.ChartScoreDistribution, payload)
+}
+
+// HandleAttendanceHeatmapChartRequest renders round attendance by weekday and week.
+func (h *LeaderboardHandlers) HandleAttendanceHeatmapChartRequest(ctx context.Context, payload *ChartRequestedPayloadV1) ([]handlerwrapper.Result, error) {
+	return h.handleChartRequest(ctx, leaderboardservice.ChartAttendanceHeatmap, payload)
+}
+
+// HandleTagComparisonChartRequest renders several members' tag history on one chart.
+func (h *LeaderboardHandlers) HandleTagComparisonChartRequest(ctx context.Context, payload *ChartRequestedPayloadV1) ([]handlerwrapper.Result, error) {
+	return h.handleChartRequest(ctx, leaderboardservice.ChartTagComparison, payload)
+}
+
+func (h *LeaderboardHandlers) handleChartRequest(
+	ctx context.Context,
+	kind leaderboardservice.ChartKind,
+	payload *ChartRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	fail := func(reason string) []handlerwrapper.Result {
+		return []handlerwrapper.Result{{
+			Topic: LeaderboardChartFailedV1,
+			Payload: &ChartFailedPayloadV1{
+				GuildID: payload.GuildID,
+				Kind:    string(kind),
+				Reason:  reason,
+			},
+		}}
+	}
+
+	guildID := payload.GuildID
+	if payload.ClubUUID != nil && *payload.ClubUUID != "" {
+		guildID = *payload.ClubUUID
+	}
+
+	result, err := h.service.RenderChart(ctx, sharedtypes.GuildID(guildID), leaderboardservice.ChartRequest{
+		Kind:      kind,
+		Format:    leaderboardservice.ChartFormat(payload.Format),
+		SeasonID:  payload.SeasonID,
+		MemberIDs: payload.MemberIDs,
+		Labels:    payload.Labels,
+		TopN:      payload.TopN,
+		Weeks:     payload.Weeks,
+	})
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to render chart",
+			slog.String("guild_id", payload.GuildID),
+			slog.String("kind", string(kind)),
+			slog.String("error", err.Error()),
+		)
+		return fail("unable to render chart"), nil
+	}
+	if result.IsFailure() {
+		return fail(fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	chart := *result.Success
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardChartResponseV1)
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &ChartResponsePayloadV1{
+			GuildID:     payload.GuildID,
+			Kind:        string(chart.Kind),
+			Format:      string(chart.Format),
+			ContentType: chart.ContentType,
+			Data:        chart.Data,
+		},
+	}}, nil
+}
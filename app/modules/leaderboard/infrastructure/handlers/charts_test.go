@@ -0,0 +1,67 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleChartRequests(t *testing.T) {
+	t.Run("each subject renders its chart kind", func(t *testing.T) {
+		service := NewFakeService()
+		var kinds []leaderboardservice.ChartKind
+		service.RenderChartFunc = func(ctx context.Context, guildID sharedtypes.GuildID, req leaderboardservice.ChartRequest) (results.OperationResult[leaderboardservice.RenderedChart, error], error) {
+			kinds = append(kinds, req.Kind)
+			return results.SuccessResult[leaderboardservice.RenderedChart, error](leaderboardservice.RenderedChart{
+				Kind: req.Kind, Format: req.Format, ContentType: req.Format.ContentType(), Data: []byte("<svg/>"),
+			}), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+		ctx := context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.chart")
+		payload := &ChartRequestedPayloadV1{GuildID: "guild-123", Format: "svg", MemberIDs: []string{"alice"}}
+
+		for _, handle := range []func(context.Context, *ChartRequestedPayloadV1) ([]handlerwrapper.Result, error){
+			h.HandlePointsRaceChartRequest,
+			h.HandleScoreDistributionChartRequest,
+			h.HandleAttendanceHeatmapChartRequest,
+			h.HandleTagComparisonChartRequest,
+		} {
+			res, err := handle(ctx, payload)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, "_INBOX.chart", res[0].Topic)
+			reply, ok := res[0].Payload.(*ChartResponsePayloadV1)
+			require.True(t, ok)
+			assert.Equal(t, "image/svg+xml", reply.ContentType)
+		}
+		assert.Equal(t, []leaderboardservice.ChartKind{
+			leaderboardservice.ChartPointsRace,
+			leaderboardservice.ChartScoreDistribution,
+			leaderboardservice.ChartAttendanceHeatmap,
+			leaderboardservice.ChartTagComparison,
+		}, kinds)
+	})
+
+	t.Run("invalid request publishes failure", func(t *testing.T) {
+		service := NewFakeService()
+		service.RenderChartFunc = func(ctx context.Context, guildID sharedtypes.GuildID, req leaderboardservice.ChartRequest) (results.OperationResult[leaderboardservice.RenderedChart, error], error) {
+			return results.FailureResult[leaderboardservice.RenderedChart](leaderboardservice.ErrInvalidChartRequest), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleTagComparisonChartRequest(context.Background(), &ChartRequestedPayloadV1{GuildID: "guild-123"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardChartFailedV1, res[0].Topic)
+		failed, ok := res[0].Payload.(*ChartFailedPayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, string(leaderboardservice.ChartTagComparison), failed.Kind)
+	})
+}
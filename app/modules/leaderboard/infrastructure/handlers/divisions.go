@@ -0,0 +1,277 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Division topics. An empty division ID names the default division.
+const (
+	LeaderboardDivisionCreateRequestedV1       = "leaderboard.division.create.requested.v1"
+	LeaderboardDivisionCreatedV1               = "leaderboard.division.created.v1"
+	LeaderboardDivisionListRequestV1           = "leaderboard.division.list.request.v1"
+	LeaderboardDivisionListResponseV1          = "leaderboard.division.list.response.v1"
+	LeaderboardDivisionMemberAssignRequestedV1 = "leaderboard.division.member.assign.requested.v1"
+	LeaderboardDivisionMemberAssignedV1        = "leaderboard.division.member.assigned.v1"
+	LeaderboardDivisionLeaderboardRequestV1    = "leaderboard.division.leaderboard.request.v1"
+	LeaderboardDivisionLeaderboardResponseV1   = "leaderboard.division.leaderboard.response.v1"
+	LeaderboardDivisionTagListRequestV1        = "leaderboard.division.tag_list.request.v1"
+	LeaderboardDivisionTagListResponseV1       = "leaderboard.division.tag_list.response.v1"
+	LeaderboardDivisionStandingsRequestV1      = "leaderboard.division.standings.request.v1"
+	LeaderboardDivisionStandingsResponseV1     = "leaderboard.division.standings.response.v1"
+	LeaderboardDivisionFailedV1                = "leaderboard.division.failed.v1"
+)
+
+// DivisionCreateRequestedPayloadV1 adds a division to a guild.
+type DivisionCreateRequestedPayloadV1 struct {
+	GuildID  sharedtypes.GuildID        `json:"guild_id"`
+	Division leaderboarddomain.Division `json:"division"`
+}
+
+// DivisionPayloadV1 confirms a created division.
+type DivisionPayloadV1 struct {
+	GuildID  sharedtypes.GuildID        `json:"guild_id"`
+	Division leaderboarddomain.Division `json:"division"`
+}
+
+// DivisionListRequestPayloadV1 asks for a guild's divisions.
+type DivisionListRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+}
+
+// DivisionListResponsePayloadV1 lists a guild's divisions, excluding the default one.
+type DivisionListResponsePayloadV1 struct {
+	GuildID   sharedtypes.GuildID          `json:"guild_id"`
+	Divisions []leaderboarddomain.Division `json:"divisions"`
+}
+
+// DivisionMemberAssignRequestedPayloadV1 moves a member to a division. The member's
+// tag is released.
+type DivisionMemberAssignRequestedPayloadV1 struct {
+	GuildID    sharedtypes.GuildID   `json:"guild_id"`
+	MemberID   sharedtypes.DiscordID `json:"member_id"`
+	DivisionID string                `json:"division_id"`
+}
+
+// DivisionMemberAssignedPayloadV1 confirms a member's division.
+type DivisionMemberAssignedPayloadV1 struct {
+	GuildID  sharedtypes.GuildID        `json:"guild_id"`
+	MemberID sharedtypes.DiscordID      `json:"member_id"`
+	Division leaderboarddomain.Division `json:"division"`
+}
+
+// DivisionReadRequestPayloadV1 asks for one division's leaderboard, tag list or
+// season standings. SeasonID applies to leaderboards and standings, ClubUUID to tag
+// lists.
+type DivisionReadRequestPayloadV1 struct {
+	GuildID    sharedtypes.GuildID `json:"guild_id"`
+	DivisionID string              `json:"division_id"`
+	SeasonID   string              `json:"season_id,omitempty"`
+	ClubUUID   *string             `json:"club_uuid,omitempty"`
+}
+
+// DivisionLeaderboardResponsePayloadV1 carries one division's tag leaderboard.
+type DivisionLeaderboardResponsePayloadV1 struct {
+	GuildID     sharedtypes.GuildID                 `json:"guild_id"`
+	DivisionID  string                              `json:"division_id"`
+	Leaderboard []leaderboardtypes.LeaderboardEntry `json:"leaderboard"`
+}
+
+// DivisionTagListResponsePayloadV1 carries one division's members, tagged or not.
+type DivisionTagListResponsePayloadV1 struct {
+	GuildID    sharedtypes.GuildID                 `json:"guild_id"`
+	DivisionID string                              `json:"division_id"`
+	Members    []leaderboardevents.TagListMemberV1 `json:"members"`
+}
+
+// DivisionStandingsResponsePayloadV1 carries the season standings of one division.
+type DivisionStandingsResponsePayloadV1 struct {
+	GuildID    sharedtypes.GuildID                      `json:"guild_id"`
+	DivisionID string                                   `json:"division_id"`
+	SeasonID   string                                   `json:"season_id"`
+	Standings  []leaderboardevents.SeasonStandingItemV1 `json:"standings"`
+}
+
+// DivisionFailedPayloadV1 reports a division request that could not be served.
+type DivisionFailedPayloadV1 struct {
+	GuildID    sharedtypes.GuildID `json:"guild_id"`
+	DivisionID string              `json:"division_id,omitempty"`
+	Reason     string              `json:"reason"`
+}
+
+// HandleDivisionCreateRequested adds a division to a guild.
+func (h *LeaderboardHandlers) HandleDivisionCreateRequested(
+	ctx context.Context,
+	payload *DivisionCreateRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.CreateDivision(ctx, payload.GuildID, payload.Division)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsFailure() {
+		return divisionFailed(payload.GuildID, payload.Division.ID, fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	return []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, LeaderboardDivisionCreatedV1),
+		Payload: &DivisionPayloadV1{
+			GuildID:  payload.GuildID,
+			Division: *result.Success,
+		},
+	}}, nil
+}
+
+// HandleDivisionListRequest replies with a guild's divisions.
+func (h *LeaderboardHandlers) HandleDivisionListRequest(
+	ctx context.Context,
+	payload *DivisionListRequestPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.ListDivisions(ctx, payload.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsFailure() {
+		return divisionFailed(payload.GuildID, "", fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	return []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, LeaderboardDivisionListResponseV1),
+		Payload: &DivisionListResponsePayloadV1{
+			GuildID:   payload.GuildID,
+			Divisions: *result.Success,
+		},
+	}}, nil
+}
+
+// HandleDivisionMemberAssignRequested moves a member to a division.
+func (h *LeaderboardHandlers) HandleDivisionMemberAssignRequested(
+	ctx context.Context,
+	payload *DivisionMemberAssignRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.AssignMemberDivision(ctx, payload.GuildID, payload.MemberID, payload.DivisionID)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsFailure() {
+		return divisionFailed(payload.GuildID, payload.DivisionID, fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	return []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, LeaderboardDivisionMemberAssignedV1),
+		Payload: &DivisionMemberAssignedPayloadV1{
+			GuildID:  payload.GuildID,
+			MemberID: payload.MemberID,
+			Division: *result.Success,
+		},
+	}}, nil
+}
+
+// HandleDivisionLeaderboardRequest replies with one division's tag leaderboard.
+func (h *LeaderboardHandlers) HandleDivisionLeaderboardRequest(
+	ctx context.Context,
+	payload *DivisionReadRequestPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.GetDivisionLeaderboard(ctx, payload.GuildID, payload.DivisionID, payload.SeasonID)
+	if err != nil {
+		return divisionFailed(payload.GuildID, payload.DivisionID, err.Error()), nil
+	}
+	if result.IsFailure() {
+		return divisionFailed(payload.GuildID, payload.DivisionID, fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	return []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, LeaderboardDivisionLeaderboardResponseV1),
+		Payload: &DivisionLeaderboardResponsePayloadV1{
+			GuildID:     payload.GuildID,
+			DivisionID:  payload.DivisionID,
+			Leaderboard: *result.Success,
+		},
+	}}, nil
+}
+
+// HandleDivisionTagListRequest replies with one division's members and their tags.
+func (h *LeaderboardHandlers) HandleDivisionTagListRequest(
+	ctx context.Context,
+	payload *DivisionReadRequestPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	tagList, err := h.service.GetDivisionTagList(ctx, payload.GuildID, payload.DivisionID, payload.ClubUUID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to get division tag list",
+			slog.String("guild_id", string(payload.GuildID)),
+			slog.String("division_id", payload.DivisionID),
+			slog.String("error", err.Error()),
+		)
+		return divisionFailed(payload.GuildID, payload.DivisionID, err.Error()), nil
+	}
+
+	members := make([]leaderboardevents.TagListMemberV1, len(tagList))
+	for i, m := range tagList {
+		members[i] = leaderboardevents.TagListMemberV1{
+			MemberID:   m.MemberID,
+			CurrentTag: m.Tag,
+		}
+	}
+
+	return []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, LeaderboardDivisionTagListResponseV1),
+		Payload: &DivisionTagListResponsePayloadV1{
+			GuildID:    payload.GuildID,
+			DivisionID: payload.DivisionID,
+			Members:    members,
+		},
+	}}, nil
+}
+
+// HandleDivisionStandingsRequest replies with one division's season standings.
+func (h *LeaderboardHandlers) HandleDivisionStandingsRequest(
+	ctx context.Context,
+	payload *DivisionReadRequestPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.GetDivisionSeasonStandings(ctx, payload.GuildID, payload.DivisionID, payload.SeasonID)
+	if err != nil {
+		return divisionFailed(payload.GuildID, payload.DivisionID, err.Error()), nil
+	}
+	if result.IsFailure() {
+		return divisionFailed(payload.GuildID, payload.DivisionID, fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	items := make([]leaderboardevents.SeasonStandingItemV1, len(*result.Success))
+	for i, entry := range *result.Success {
+		items[i] = leaderboardevents.SeasonStandingItemV1{
+			MemberID:      entry.MemberID,
+			TotalPoints:   entry.TotalPoints,
+			CurrentTier:   entry.CurrentTier,
+			SeasonBestTag: entry.SeasonBestTag,
+			RoundsPlayed:  entry.RoundsPlayed,
+		}
+	}
+
+	return []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, LeaderboardDivisionStandingsResponseV1),
+		Payload: &DivisionStandingsResponsePayloadV1{
+			GuildID:    payload.GuildID,
+			DivisionID: payload.DivisionID,
+			SeasonID:   payload.SeasonID,
+			Standings:  items,
+		},
+	}}, nil
+}
+
+func divisionFailed(guildID sharedtypes.GuildID, divisionID, reason string) []handlerwrapper.Result {
+	return []handlerwrapper.Result{{
+		Topic: LeaderboardDivisionFailedV1,
+		Payload: &DivisionFailedPayloadV1{
+			GuildID:    guildID,
+			DivisionID: divisionID,
+			Reason:     reason,
+		},
+	}}
+}
@@ -0,0 +1,140 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleDivisionCreateRequested(t *testing.T) {
+	t.Run("publishes the created division", func(t *testing.T) {
+		service := NewFakeService()
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleDivisionCreateRequested(context.Background(), &DivisionCreateRequestedPayloadV1{
+			GuildID:  "guild-123",
+			Division: leaderboarddomain.Division{ID: "pro", Name: "Pro"},
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardDivisionCreatedV1, res[0].Topic)
+		created, ok := res[0].Payload.(*DivisionPayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, "pro", created.Division.ID)
+	})
+
+	t.Run("disabled guild publishes failure", func(t *testing.T) {
+		service := NewFakeService()
+		service.CreateDivisionFunc = func(ctx context.Context, guildID sharedtypes.GuildID, division leaderboarddomain.Division) (results.OperationResult[leaderboarddomain.Division, error], error) {
+			return results.FailureResult[leaderboarddomain.Division](leaderboardservice.ErrCustomLeaderboardsDisabled), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleDivisionCreateRequested(context.Background(), &DivisionCreateRequestedPayloadV1{
+			GuildID:  "guild-123",
+			Division: leaderboarddomain.Division{ID: "pro", Name: "Pro"},
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardDivisionFailedV1, res[0].Topic)
+		failure, ok := res[0].Payload.(*DivisionFailedPayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, "pro", failure.DivisionID)
+		assert.Equal(t, leaderboardservice.ErrCustomLeaderboardsDisabled.Error(), failure.Reason)
+	})
+
+	t.Run("service error is returned", func(t *testing.T) {
+		service := NewFakeService()
+		service.CreateDivisionFunc = func(ctx context.Context, guildID sharedtypes.GuildID, division leaderboarddomain.Division) (results.OperationResult[leaderboarddomain.Division, error], error) {
+			return results.OperationResult[leaderboarddomain.Division, error]{}, errors.New("db down")
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		_, err := h.HandleDivisionCreateRequested(context.Background(), &DivisionCreateRequestedPayloadV1{GuildID: "guild-123"})
+		require.Error(t, err)
+	})
+}
+
+func TestHandleDivisionMemberAssignRequested(t *testing.T) {
+	service := NewFakeService()
+	service.AssignMemberDivisionFunc = func(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, divisionID string) (results.OperationResult[leaderboarddomain.Division, error], error) {
+		assert.Equal(t, sharedtypes.DiscordID("alice"), memberID)
+		return results.SuccessResult[leaderboarddomain.Division, error](leaderboarddomain.Division{ID: divisionID, Name: "Pro"}), nil
+	}
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+	res, err := h.HandleDivisionMemberAssignRequested(context.Background(), &DivisionMemberAssignRequestedPayloadV1{
+		GuildID:    "guild-123",
+		MemberID:   "alice",
+		DivisionID: "pro",
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, LeaderboardDivisionMemberAssignedV1, res[0].Topic)
+	assigned, ok := res[0].Payload.(*DivisionMemberAssignedPayloadV1)
+	require.True(t, ok)
+	assert.Equal(t, "Pro", assigned.Division.Name)
+}
+
+func TestHandleDivisionTagListRequest(t *testing.T) {
+	t.Run("replies with the division's members", func(t *testing.T) {
+		service := NewFakeService()
+		service.GetDivisionTagListFunc = func(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, clubUUID *string) ([]leaderboardservice.MemberTagView, error) {
+			assert.Equal(t, "pro", divisionID)
+			tag := 1
+			return []leaderboardservice.MemberTagView{{MemberID: "alice", Tag: &tag, DivisionID: divisionID}}, nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+		ctx := context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.division")
+
+		res, err := h.HandleDivisionTagListRequest(ctx, &DivisionReadRequestPayloadV1{GuildID: "guild-123", DivisionID: "pro"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, "_INBOX.division", res[0].Topic)
+		reply, ok := res[0].Payload.(*DivisionTagListResponsePayloadV1)
+		require.True(t, ok)
+		require.Len(t, reply.Members, 1)
+		assert.Equal(t, "alice", reply.Members[0].MemberID)
+	})
+
+	t.Run("unknown division publishes failure", func(t *testing.T) {
+		service := NewFakeService()
+		service.GetDivisionTagListFunc = func(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, clubUUID *string) ([]leaderboardservice.MemberTagView, error) {
+			return nil, leaderboardservice.ErrDivisionNotFound
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleDivisionTagListRequest(context.Background(), &DivisionReadRequestPayloadV1{GuildID: "guild-123", DivisionID: "missing"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardDivisionFailedV1, res[0].Topic)
+	})
+}
+
+func TestHandleDivisionStandingsRequest(t *testing.T) {
+	service := NewFakeService()
+	service.GetDivisionSeasonStandingsFunc = func(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]leaderboardservice.SeasonStandingEntry, error], error) {
+		return results.SuccessResult[[]leaderboardservice.SeasonStandingEntry, error]([]leaderboardservice.SeasonStandingEntry{
+			{MemberID: "alice", SeasonID: seasonID, TotalPoints: 40, RoundsPlayed: 3},
+		}), nil
+	}
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+	res, err := h.HandleDivisionStandingsRequest(context.Background(), &DivisionReadRequestPayloadV1{GuildID: "guild-123", DivisionID: "pro", SeasonID: "s1"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, LeaderboardDivisionStandingsResponseV1, res[0].Topic)
+	reply, ok := res[0].Payload.(*DivisionStandingsResponsePayloadV1)
+	require.True(t, ok)
+	require.Len(t, reply.Standings, 1)
+	assert.Equal(t, 40, reply.Standings[0].TotalPoints)
+}
@@ -2,6 +2,7 @@ package leaderboardhandlers
 
 import (
 	"context"
+	"time"
 
 	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
@@ -25,6 +26,7 @@ type FakeService struct {
 	ExecuteBatchTagAssignmentFunc func(ctx context.Context, guildID sharedtypes.GuildID, requests []sharedtypes.TagAssignmentRequest, updateID sharedtypes.RoundID, source sharedtypes.ServiceUpdateSource) (results.OperationResult[leaderboardtypes.LeaderboardData, error], error)
 	TagSwapRequestedFunc          func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID, targetTag sharedtypes.TagNumber) (results.OperationResult[leaderboardtypes.LeaderboardData, error], error)
 	GetLeaderboardFunc            func(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error)
+	GetLeaderboardAtFunc          func(ctx context.Context, guildID sharedtypes.GuildID, divisionID *string, seasonID string, asOf *time.Time, roundID *sharedtypes.RoundID) (results.OperationResult[leaderboardservice.LeaderboardSnapshot, error], error)
 	GetTagByUserIDFunc            func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[sharedtypes.TagNumber, error], error)
 
 	RoundGetTagByUserIDFunc          func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[sharedtypes.TagNumber, error], error)
@@ -44,9 +46,37 @@ type FakeService struct {
 	GetPointHistoryForMemberFunc    func(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, limit int) (results.OperationResult[[]leaderboardservice.PointHistoryEntry, error], error)
 	AdjustPointsFunc                func(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, pointsDelta int, reason string) (results.OperationResult[bool, error], error)
 	StartNewSeasonFunc              func(ctx context.Context, guildID sharedtypes.GuildID, seasonID string, seasonName string) (results.OperationResult[bool, error], error)
+	StartNewSeasonWithPolicyFunc    func(ctx context.Context, guildID sharedtypes.GuildID, seasonID string, seasonName string, policy leaderboarddomain.PointsPolicy) (results.OperationResult[bool, error], error)
+	GetSeasonPointsPolicyFunc       func(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboarddomain.PointsPolicy, error], error)
+	ReplayGuildFunc                 func(ctx context.Context, guildID sharedtypes.GuildID, dryRun bool) (results.OperationResult[leaderboardservice.ReplayResult, error], error)
 	GetSeasonStandingsForSeasonFunc func(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[[]leaderboardservice.SeasonStandingEntry, error], error)
 	ListSeasonsFunc                 func(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[[]leaderboardservice.SeasonInfo, error], error)
 	GetSeasonNameFunc               func(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (string, error)
+	GetSeasonArchiveFunc            func(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonArchive, error], error)
+	ScheduleSeasonFunc              func(ctx context.Context, guildID sharedtypes.GuildID, schedule leaderboardservice.SeasonSchedule) (results.OperationResult[leaderboardservice.SeasonInfo, error], error)
+	RunSeasonScheduleFunc           func(ctx context.Context, now time.Time) ([]leaderboardservice.SeasonTransition, error)
+	GetInactivityPolicyFunc         func(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error)
+	SetInactivityPolicyFunc         func(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.InactivityPolicy) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error)
+	PreviewInactivityDecayFunc      func(ctx context.Context, guildID sharedtypes.GuildID, policy *leaderboarddomain.InactivityPolicy) (results.OperationResult[leaderboardservice.InactivityPreview, error], error)
+	RunInactivityDecayFunc          func(ctx context.Context, now time.Time) ([]leaderboardservice.InactivityDecayRun, error)
+	GetTagPoolPolicyFunc            func(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error)
+	SetTagPoolPolicyFunc            func(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.TagPoolPolicy) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error)
+	RenderChartFunc                 func(ctx context.Context, guildID sharedtypes.GuildID, req leaderboardservice.ChartRequest) (results.OperationResult[leaderboardservice.RenderedChart, error], error)
+	ExportLeaderboardDataFunc       func(ctx context.Context, guildID sharedtypes.GuildID, dataset leaderboardservice.ExportDataset, format leaderboardservice.ExportFormat, seasonID string) (results.OperationResult[leaderboardservice.ExportFile, error], error)
+	ImportTagHistoryFunc            func(ctx context.Context, guildID sharedtypes.GuildID, fileName string, data []byte, dryRun bool) (results.OperationResult[leaderboardservice.TagImportResult, error], error)
+	GetHeadToHeadFunc               func(ctx context.Context, guildID sharedtypes.GuildID, memberA, memberB sharedtypes.DiscordID, seasonID string) (results.OperationResult[leaderboarddomain.HeadToHeadRecord, error], error)
+	GetMemberStatsFunc              func(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (results.OperationResult[leaderboarddomain.MemberStats, error], error)
+	InvalidateMemberStatsFunc       func(ctx context.Context, guildID sharedtypes.GuildID, memberIDs ...sharedtypes.DiscordID)
+	GetPromotionPolicyFunc          func(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error)
+	SetPromotionPolicyFunc          func(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.PromotionPolicy) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error)
+	PreviewPromotionsFunc           func(ctx context.Context, guildID sharedtypes.GuildID, policy *leaderboarddomain.PromotionPolicy) (results.OperationResult[leaderboardservice.PromotionPreview, error], error)
+	GetSeasonPromotionsFunc         func(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonPromotions, error], error)
+	ListDivisionsFunc               func(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[[]leaderboarddomain.Division, error], error)
+	CreateDivisionFunc              func(ctx context.Context, guildID sharedtypes.GuildID, division leaderboarddomain.Division) (results.OperationResult[leaderboarddomain.Division, error], error)
+	AssignMemberDivisionFunc        func(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, divisionID string) (results.OperationResult[leaderboarddomain.Division, error], error)
+	GetDivisionLeaderboardFunc      func(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error)
+	GetDivisionTagListFunc          func(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, clubUUID *string) ([]leaderboardservice.MemberTagView, error)
+	GetDivisionSeasonStandingsFunc  func(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]leaderboardservice.SeasonStandingEntry, error], error)
 }
 
 func NewFakeService() *FakeService {
@@ -70,6 +100,8 @@ type FakeSagaCoordinator struct {
 
 	// ProcessIntentFunc allows per-test behavior configuration (e.g., returning errors)
 	ProcessIntentFunc func(ctx context.Context, intent saga.SwapIntent) error
+	CancelIntentFunc  func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) error
+	ListPendingFunc   func(ctx context.Context, guildID sharedtypes.GuildID) (saga.PendingSwaps, error)
 }
 
 func NewFakeSagaCoordinator() *FakeSagaCoordinator {
@@ -97,6 +129,22 @@ func (f *FakeSagaCoordinator) ProcessIntent(ctx context.Context, intent saga.Swa
 	return nil
 }
 
+func (f *FakeSagaCoordinator) CancelIntent(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) error {
+	f.record("CancelIntent")
+	if f.CancelIntentFunc != nil {
+		return f.CancelIntentFunc(ctx, guildID, userID)
+	}
+	return nil
+}
+
+func (f *FakeSagaCoordinator) ListPending(ctx context.Context, guildID sharedtypes.GuildID) (saga.PendingSwaps, error) {
+	f.record("ListPending")
+	if f.ListPendingFunc != nil {
+		return f.ListPendingFunc(ctx, guildID)
+	}
+	return saga.PendingSwaps{}, nil
+}
+
 // --- Interface Implementation ---
 func (f *FakeService) ExecuteBatchTagAssignment(
 	ctx context.Context,
@@ -129,6 +177,14 @@ func (f *FakeService) GetLeaderboard(ctx context.Context, guildID sharedtypes.Gu
 	return results.SuccessResult[[]leaderboardtypes.LeaderboardEntry, error]([]leaderboardtypes.LeaderboardEntry{}), nil
 }
 
+func (f *FakeService) GetLeaderboardAt(ctx context.Context, guildID sharedtypes.GuildID, divisionID *string, seasonID string, asOf *time.Time, roundID *sharedtypes.RoundID) (results.OperationResult[leaderboardservice.LeaderboardSnapshot, error], error) {
+	f.record("GetLeaderboardAt")
+	if f.GetLeaderboardAtFunc != nil {
+		return f.GetLeaderboardAtFunc(ctx, guildID, divisionID, seasonID, asOf, roundID)
+	}
+	return results.SuccessResult[leaderboardservice.LeaderboardSnapshot, error](leaderboardservice.LeaderboardSnapshot{}), nil
+}
+
 func (f *FakeService) GetTagByUserID(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[sharedtypes.TagNumber, error], error) {
 	f.record("GetTagByUserID")
 	if f.GetTagByUserIDFunc != nil {
@@ -256,6 +312,30 @@ func (f *FakeService) StartNewSeason(ctx context.Context, guildID sharedtypes.Gu
 	return results.SuccessResult[bool, error](true), nil
 }
 
+func (f *FakeService) StartNewSeasonWithPolicy(ctx context.Context, guildID sharedtypes.GuildID, seasonID string, seasonName string, policy leaderboarddomain.PointsPolicy) (results.OperationResult[bool, error], error) {
+	f.record("StartNewSeasonWithPolicy")
+	if f.StartNewSeasonWithPolicyFunc != nil {
+		return f.StartNewSeasonWithPolicyFunc(ctx, guildID, seasonID, seasonName, policy)
+	}
+	return results.SuccessResult[bool, error](true), nil
+}
+
+func (f *FakeService) GetSeasonPointsPolicy(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboarddomain.PointsPolicy, error], error) {
+	f.record("GetSeasonPointsPolicy")
+	if f.GetSeasonPointsPolicyFunc != nil {
+		return f.GetSeasonPointsPolicyFunc(ctx, guildID, seasonID)
+	}
+	return results.SuccessResult[leaderboarddomain.PointsPolicy, error](leaderboarddomain.DefaultPointsPolicy()), nil
+}
+
+func (f *FakeService) ReplayGuild(ctx context.Context, guildID sharedtypes.GuildID, dryRun bool) (results.OperationResult[leaderboardservice.ReplayResult, error], error) {
+	f.record("ReplayGuild")
+	if f.ReplayGuildFunc != nil {
+		return f.ReplayGuildFunc(ctx, guildID, dryRun)
+	}
+	return results.SuccessResult[leaderboardservice.ReplayResult, error](leaderboardservice.ReplayResult{GuildID: string(guildID), DryRun: dryRun}), nil
+}
+
 func (f *FakeService) GetSeasonStandingsForSeason(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[[]leaderboardservice.SeasonStandingEntry, error], error) {
 	f.record("GetSeasonStandingsForSeason")
 	if f.GetSeasonStandingsForSeasonFunc != nil {
@@ -280,6 +360,205 @@ func (f *FakeService) GetSeasonName(ctx context.Context, guildID sharedtypes.Gui
 	return "", nil
 }
 
+func (f *FakeService) GetSeasonArchive(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonArchive, error], error) {
+	f.record("GetSeasonArchive")
+	if f.GetSeasonArchiveFunc != nil {
+		return f.GetSeasonArchiveFunc(ctx, guildID, seasonID)
+	}
+	return results.FailureResult[leaderboardservice.SeasonArchive](leaderboardservice.ErrSeasonArchiveNotFound), nil
+}
+
+func (f *FakeService) ScheduleSeason(ctx context.Context, guildID sharedtypes.GuildID, schedule leaderboardservice.SeasonSchedule) (results.OperationResult[leaderboardservice.SeasonInfo, error], error) {
+	f.record("ScheduleSeason")
+	if f.ScheduleSeasonFunc != nil {
+		return f.ScheduleSeasonFunc(ctx, guildID, schedule)
+	}
+	return results.SuccessResult[leaderboardservice.SeasonInfo, error](leaderboardservice.SeasonInfo{ID: schedule.SeasonID, Name: schedule.SeasonName, Scheduled: true}), nil
+}
+
+func (f *FakeService) RunSeasonSchedule(ctx context.Context, now time.Time) ([]leaderboardservice.SeasonTransition, error) {
+	f.record("RunSeasonSchedule")
+	if f.RunSeasonScheduleFunc != nil {
+		return f.RunSeasonScheduleFunc(ctx, now)
+	}
+	return nil, nil
+}
+
+func (f *FakeService) GetInactivityPolicy(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error) {
+	f.record("GetInactivityPolicy")
+	if f.GetInactivityPolicyFunc != nil {
+		return f.GetInactivityPolicyFunc(ctx, guildID)
+	}
+	return results.SuccessResult[leaderboarddomain.InactivityPolicy, error](leaderboarddomain.InactivityPolicy{}), nil
+}
+
+func (f *FakeService) SetInactivityPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.InactivityPolicy) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error) {
+	f.record("SetInactivityPolicy")
+	if f.SetInactivityPolicyFunc != nil {
+		return f.SetInactivityPolicyFunc(ctx, guildID, policy)
+	}
+	return results.SuccessResult[leaderboarddomain.InactivityPolicy, error](policy), nil
+}
+
+func (f *FakeService) PreviewInactivityDecay(ctx context.Context, guildID sharedtypes.GuildID, policy *leaderboarddomain.InactivityPolicy) (results.OperationResult[leaderboardservice.InactivityPreview, error], error) {
+	f.record("PreviewInactivityDecay")
+	if f.PreviewInactivityDecayFunc != nil {
+		return f.PreviewInactivityDecayFunc(ctx, guildID, policy)
+	}
+	return results.SuccessResult[leaderboardservice.InactivityPreview, error](leaderboardservice.InactivityPreview{}), nil
+}
+
+func (f *FakeService) RunInactivityDecay(ctx context.Context, now time.Time) ([]leaderboardservice.InactivityDecayRun, error) {
+	f.record("RunInactivityDecay")
+	if f.RunInactivityDecayFunc != nil {
+		return f.RunInactivityDecayFunc(ctx, now)
+	}
+	return nil, nil
+}
+
+func (f *FakeService) GetTagPoolPolicy(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error) {
+	f.record("GetTagPoolPolicy")
+	if f.GetTagPoolPolicyFunc != nil {
+		return f.GetTagPoolPolicyFunc(ctx, guildID)
+	}
+	return results.SuccessResult[leaderboarddomain.TagPoolPolicy, error](leaderboarddomain.DefaultTagPoolPolicy()), nil
+}
+
+func (f *FakeService) SetTagPoolPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.TagPoolPolicy) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error) {
+	f.record("SetTagPoolPolicy")
+	if f.SetTagPoolPolicyFunc != nil {
+		return f.SetTagPoolPolicyFunc(ctx, guildID, policy)
+	}
+	return results.SuccessResult[leaderboarddomain.TagPoolPolicy, error](policy), nil
+}
+
+func (f *FakeService) GetHeadToHead(ctx context.Context, guildID sharedtypes.GuildID, memberA, memberB sharedtypes.DiscordID, seasonID string) (results.OperationResult[leaderboarddomain.HeadToHeadRecord, error], error) {
+	f.record("GetHeadToHead")
+	if f.GetHeadToHeadFunc != nil {
+		return f.GetHeadToHeadFunc(ctx, guildID, memberA, memberB, seasonID)
+	}
+	return results.SuccessResult[leaderboarddomain.HeadToHeadRecord, error](leaderboarddomain.HeadToHeadRecord{MemberA: string(memberA), MemberB: string(memberB)}), nil
+}
+
+func (f *FakeService) GetMemberStats(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (results.OperationResult[leaderboarddomain.MemberStats, error], error) {
+	f.record("GetMemberStats")
+	if f.GetMemberStatsFunc != nil {
+		return f.GetMemberStatsFunc(ctx, guildID, memberID)
+	}
+	return results.SuccessResult[leaderboarddomain.MemberStats, error](leaderboarddomain.MemberStats{MemberID: string(memberID)}), nil
+}
+
+func (f *FakeService) InvalidateMemberStats(ctx context.Context, guildID sharedtypes.GuildID, memberIDs ...sharedtypes.DiscordID) {
+	f.record("InvalidateMemberStats")
+	if f.InvalidateMemberStatsFunc != nil {
+		f.InvalidateMemberStatsFunc(ctx, guildID, memberIDs...)
+	}
+}
+
+func (f *FakeService) GetPromotionPolicy(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error) {
+	f.record("GetPromotionPolicy")
+	if f.GetPromotionPolicyFunc != nil {
+		return f.GetPromotionPolicyFunc(ctx, guildID)
+	}
+	return results.SuccessResult[leaderboarddomain.PromotionPolicy, error](leaderboarddomain.PromotionPolicy{}), nil
+}
+
+func (f *FakeService) SetPromotionPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.PromotionPolicy) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error) {
+	f.record("SetPromotionPolicy")
+	if f.SetPromotionPolicyFunc != nil {
+		return f.SetPromotionPolicyFunc(ctx, guildID, policy)
+	}
+	return results.SuccessResult[leaderboarddomain.PromotionPolicy, error](policy), nil
+}
+
+func (f *FakeService) PreviewPromotions(ctx context.Context, guildID sharedtypes.GuildID, policy *leaderboarddomain.PromotionPolicy) (results.OperationResult[leaderboardservice.PromotionPreview, error], error) {
+	f.record("PreviewPromotions")
+	if f.PreviewPromotionsFunc != nil {
+		return f.PreviewPromotionsFunc(ctx, guildID, policy)
+	}
+	return results.SuccessResult[leaderboardservice.PromotionPreview, error](leaderboardservice.PromotionPreview{}), nil
+}
+
+func (f *FakeService) GetSeasonPromotions(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonPromotions, error], error) {
+	f.record("GetSeasonPromotions")
+	if f.GetSeasonPromotionsFunc != nil {
+		return f.GetSeasonPromotionsFunc(ctx, guildID, seasonID)
+	}
+	return results.SuccessResult[leaderboardservice.SeasonPromotions, error](leaderboardservice.SeasonPromotions{SeasonID: seasonID}), nil
+}
+
+func (f *FakeService) ListDivisions(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[[]leaderboarddomain.Division, error], error) {
+	f.record("ListDivisions")
+	if f.ListDivisionsFunc != nil {
+		return f.ListDivisionsFunc(ctx, guildID)
+	}
+	return results.SuccessResult[[]leaderboarddomain.Division, error](nil), nil
+}
+
+func (f *FakeService) CreateDivision(ctx context.Context, guildID sharedtypes.GuildID, division leaderboarddomain.Division) (results.OperationResult[leaderboarddomain.Division, error], error) {
+	f.record("CreateDivision")
+	if f.CreateDivisionFunc != nil {
+		return f.CreateDivisionFunc(ctx, guildID, division)
+	}
+	return results.SuccessResult[leaderboarddomain.Division, error](division), nil
+}
+
+func (f *FakeService) AssignMemberDivision(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, divisionID string) (results.OperationResult[leaderboarddomain.Division, error], error) {
+	f.record("AssignMemberDivision")
+	if f.AssignMemberDivisionFunc != nil {
+		return f.AssignMemberDivisionFunc(ctx, guildID, memberID, divisionID)
+	}
+	return results.SuccessResult[leaderboarddomain.Division, error](leaderboarddomain.Division{ID: divisionID}), nil
+}
+
+func (f *FakeService) GetDivisionLeaderboard(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
+	f.record("GetDivisionLeaderboard")
+	if f.GetDivisionLeaderboardFunc != nil {
+		return f.GetDivisionLeaderboardFunc(ctx, guildID, divisionID, seasonID)
+	}
+	return results.SuccessResult[[]leaderboardtypes.LeaderboardEntry, error](nil), nil
+}
+
+func (f *FakeService) GetDivisionTagList(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, clubUUID *string) ([]leaderboardservice.MemberTagView, error) {
+	f.record("GetDivisionTagList")
+	if f.GetDivisionTagListFunc != nil {
+		return f.GetDivisionTagListFunc(ctx, guildID, divisionID, clubUUID)
+	}
+	return nil, nil
+}
+
+func (f *FakeService) GetDivisionSeasonStandings(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]leaderboardservice.SeasonStandingEntry, error], error) {
+	f.record("GetDivisionSeasonStandings")
+	if f.GetDivisionSeasonStandingsFunc != nil {
+		return f.GetDivisionSeasonStandingsFunc(ctx, guildID, divisionID, seasonID)
+	}
+	return results.SuccessResult[[]leaderboardservice.SeasonStandingEntry, error](nil), nil
+}
+
+func (f *FakeService) RenderChart(ctx context.Context, guildID sharedtypes.GuildID, req leaderboardservice.ChartRequest) (results.OperationResult[leaderboardservice.RenderedChart, error], error) {
+	f.record("RenderChart")
+	if f.RenderChartFunc != nil {
+		return f.RenderChartFunc(ctx, guildID, req)
+	}
+	return results.SuccessResult[leaderboardservice.RenderedChart, error](leaderboardservice.RenderedChart{Kind: req.Kind, Format: req.Format}), nil
+}
+
+func (f *FakeService) ExportLeaderboardData(ctx context.Context, guildID sharedtypes.GuildID, dataset leaderboardservice.ExportDataset, format leaderboardservice.ExportFormat, seasonID string) (results.OperationResult[leaderboardservice.ExportFile, error], error) {
+	f.record("ExportLeaderboardData")
+	if f.ExportLeaderboardDataFunc != nil {
+		return f.ExportLeaderboardDataFunc(ctx, guildID, dataset, format, seasonID)
+	}
+	return results.SuccessResult[leaderboardservice.ExportFile, error](leaderboardservice.ExportFile{}), nil
+}
+
+func (f *FakeService) ImportTagHistory(ctx context.Context, guildID sharedtypes.GuildID, fileName string, data []byte, dryRun bool) (results.OperationResult[leaderboardservice.TagImportResult, error], error) {
+	f.record("ImportTagHistory")
+	if f.ImportTagHistoryFunc != nil {
+		return f.ImportTagHistoryFunc(ctx, guildID, fileName, data, dryRun)
+	}
+	return results.SuccessResult[leaderboardservice.TagImportResult, error](leaderboardservice.TagImportResult{DryRun: dryRun}), nil
+}
+
 // Ensure interface compliance
 var _ leaderboardservice.Service = (*FakeService)(nil)
 var _ saga.SagaCoordinator = (*FakeSagaCoordinator)(nil)
@@ -371,7 +650,8 @@ func (f *FakeHelpers) UnmarshalPayload(msg *message.Message, payload any) error
 
 // FakeRoundLookup implements RoundLookup for handler testing.
 type FakeRoundLookup struct {
-	GetRoundFunc func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*roundtypes.Round, error)
+	GetRoundFunc              func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*roundtypes.Round, error)
+	GetCourseLayoutRoundsFunc func(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error)
 }
 
 func (f *FakeRoundLookup) GetRound(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*roundtypes.Round, error) {
@@ -380,3 +660,22 @@ func (f *FakeRoundLookup) GetRound(ctx context.Context, guildID sharedtypes.Guil
 	}
 	return f.GetRoundFunc(ctx, guildID, roundID)
 }
+
+func (f *FakeRoundLookup) GetCourseLayoutRounds(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error) {
+	if f == nil || f.GetCourseLayoutRoundsFunc == nil {
+		return nil, nil
+	}
+	return f.GetCourseLayoutRoundsFunc(ctx, guildID, layoutID, startTime)
+}
+
+// FakeScoreLookup implements ScoreLookup for handler testing.
+type FakeScoreLookup struct {
+	GetTagRanksFunc func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (map[sharedtypes.DiscordID]int, error)
+}
+
+func (f *FakeScoreLookup) GetTagRanks(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (map[sharedtypes.DiscordID]int, error) {
+	if f == nil || f.GetTagRanksFunc == nil {
+		return nil, nil
+	}
+	return f.GetTagRanksFunc(ctx, guildID, roundID)
+}
@@ -24,6 +24,13 @@ import (
 // RoundLookup provides read-only round data for cross-module enrichment.
 type RoundLookup interface {
 	GetRound(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*roundtypes.Round, error)
+	GetCourseLayoutRounds(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error)
+}
+
+// ScoreLookup provides the per-player ranks that should drive tag reallocation when a
+// round's tag order differs from its finish order (net rounds reallocating by gross).
+type ScoreLookup interface {
+	GetTagRanks(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (map[sharedtypes.DiscordID]int, error)
 }
 
 // LeaderboardHandlers implements the Handlers interface for leaderboard events.
@@ -34,6 +41,7 @@ type LeaderboardHandlers struct {
 	helpers         utils.Helpers
 	logger          *slog.Logger
 	roundLookup     RoundLookup
+	scoreLookup     ScoreLookup
 }
 
 // NewLeaderboardHandlers creates a new LeaderboardHandlers instance.
@@ -57,6 +65,63 @@ func NewLeaderboardHandlers(
 	}
 }
 
+// SetScoreLookup wires the score module in after construction; the score module is
+// initialized after the leaderboard module.
+func (h *LeaderboardHandlers) SetScoreLookup(scoreLookup ScoreLookup) {
+	h.scoreLookup = scoreLookup
+}
+
+// applyTagRanks sets TagRank on round participants when the round's tags follow a
+// different order than its finish ranks. Lookup failures fall back to finish order.
+func (h *LeaderboardHandlers) applyTagRanks(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	roundID sharedtypes.RoundID,
+	participants []leaderboardservice.RoundParticipantInput,
+) {
+	if h.scoreLookup == nil {
+		return
+	}
+	tagRanks, err := h.scoreLookup.GetTagRanks(ctx, guildID, roundID)
+	if err != nil {
+		if h.logger != nil {
+			h.logger.WarnContext(ctx, "failed to fetch tag ranks; allocating tags by finish rank",
+				"guild_id", guildID,
+				"round_id", roundID.String(),
+				"error", err,
+			)
+		}
+		return
+	}
+	for i := range participants {
+		participants[i].TagRank = tagRanks[sharedtypes.DiscordID(participants[i].MemberID)]
+	}
+}
+
+// roundStartTime returns when a round started so its points land in the season that
+// was running at the time. A zero time, returned when the round cannot be looked up,
+// selects the active season.
+func (h *LeaderboardHandlers) roundStartTime(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) time.Time {
+	if h.roundLookup == nil {
+		return time.Time{}
+	}
+	round, err := h.roundLookup.GetRound(ctx, guildID, roundID)
+	if err != nil {
+		if h.logger != nil {
+			h.logger.WarnContext(ctx, "failed to fetch round start time; using the active season",
+				"guild_id", guildID,
+				"round_id", roundID.String(),
+				"error", err,
+			)
+		}
+		return time.Time{}
+	}
+	if round == nil || round.StartTime == nil {
+		return time.Time{}
+	}
+	return round.StartTime.AsTime()
+}
+
 // mapSuccessResults is a private helper to build consistent batch completion events.
 func (h *LeaderboardHandlers) mapSuccessResults(
 	ctx context.Context,
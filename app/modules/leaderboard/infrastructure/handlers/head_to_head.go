@@ -0,0 +1,121 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Head-to-head topics. Requests are served request-reply on
+// LeaderboardHeadToHeadRequestedV1 + ".<guild_id>", like tag history.
+const (
+	LeaderboardHeadToHeadRequestedV1 = "leaderboard.head_to_head.requested.v1"
+	LeaderboardHeadToHeadResponseV1  = "leaderboard.head_to_head.response.v1"
+	LeaderboardHeadToHeadFailedV1    = "leaderboard.head_to_head.failed.v1"
+)
+
+// HeadToHeadRequestedPayloadV1 asks for MemberA's record against MemberB. SeasonID is
+// optional; empty covers every processed round.
+type HeadToHeadRequestedPayloadV1 struct {
+	GuildID  string                `json:"guild_id"`
+	ClubUUID *string               `json:"club_uuid,omitempty"`
+	MemberA  sharedtypes.DiscordID `json:"member_a"`
+	MemberB  sharedtypes.DiscordID `json:"member_b"`
+	SeasonID string                `json:"season_id,omitempty"`
+}
+
+// HeadToHeadResponsePayloadV1 is the reply for LeaderboardHeadToHeadRequestedV1.
+// Results are from MemberA's side; a negative stroke differential favours MemberA.
+// Series holds one point per shared round, oldest first, with running totals.
+type HeadToHeadResponsePayloadV1 struct {
+	GuildID  string                `json:"guild_id"`
+	MemberA  sharedtypes.DiscordID `json:"member_a"`
+	MemberB  sharedtypes.DiscordID `json:"member_b"`
+	SeasonID string                `json:"season_id,omitempty"`
+
+	Rounds int `json:"rounds"`
+	Wins   int `json:"wins"`
+	Losses int `json:"losses"`
+	Ties   int `json:"ties"`
+
+	StrokeRounds              int     `json:"stroke_rounds"`
+	AverageStrokeDifferential float64 `json:"average_stroke_differential"`
+
+	TagsTaken int                             `json:"tags_taken"`
+	TagsLost  int                             `json:"tags_lost"`
+	Exchanges []leaderboarddomain.TagExchange `json:"exchanges"`
+
+	Series []leaderboarddomain.HeadToHeadPoint `json:"series"`
+}
+
+// HeadToHeadFailedPayloadV1 reports a head-to-head request that could not be served.
+type HeadToHeadFailedPayloadV1 struct {
+	GuildID string                `json:"guild_id"`
+	MemberA sharedtypes.DiscordID `json:"member_a"`
+	MemberB sharedtypes.DiscordID `json:"member_b"`
+	Reason  string                `json:"reason"`
+}
+
+// HandleHeadToHeadRequest replies with two members' record against each other.
+func (h *LeaderboardHandlers) HandleHeadToHeadRequest(
+	ctx context.Context,
+	payload *HeadToHeadRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	fail := func(reason string) []handlerwrapper.Result {
+		return []handlerwrapper.Result{{
+			Topic: LeaderboardHeadToHeadFailedV1,
+			Payload: &HeadToHeadFailedPayloadV1{
+				GuildID: payload.GuildID,
+				MemberA: payload.MemberA,
+				MemberB: payload.MemberB,
+				Reason:  reason,
+			},
+		}}
+	}
+
+	guildID := payload.GuildID
+	if payload.ClubUUID != nil && *payload.ClubUUID != "" {
+		guildID = *payload.ClubUUID
+	}
+
+	result, err := h.service.GetHeadToHead(ctx, sharedtypes.GuildID(guildID), payload.MemberA, payload.MemberB, payload.SeasonID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to get head-to-head record",
+			slog.String("guild_id", payload.GuildID),
+			slog.String("member_a", string(payload.MemberA)),
+			slog.String("member_b", string(payload.MemberB)),
+			slog.String("error", err.Error()),
+		)
+		return fail("unable to retrieve head-to-head record"), nil
+	}
+	if result.IsFailure() {
+		return fail(fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	record := *result.Success
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardHeadToHeadResponseV1)
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &HeadToHeadResponsePayloadV1{
+			GuildID:                   payload.GuildID,
+			MemberA:                   payload.MemberA,
+			MemberB:                   payload.MemberB,
+			SeasonID:                  payload.SeasonID,
+			Rounds:                    record.Rounds,
+			Wins:                      record.Wins,
+			Losses:                    record.Losses,
+			Ties:                      record.Ties,
+			StrokeRounds:              record.StrokeRounds,
+			AverageStrokeDifferential: record.AverageStrokeDifferential,
+			TagsTaken:                 record.TagsTaken,
+			TagsLost:                  record.TagsLost,
+			Exchanges:                 record.Exchanges,
+			Series:                    record.Series,
+		},
+	}}, nil
+}
@@ -0,0 +1,63 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleHeadToHeadRequest(t *testing.T) {
+	t.Run("replies with record and series", func(t *testing.T) {
+		clubUUID := "9b2f3c4d-0000-4000-8000-000000000001"
+		service := NewFakeService()
+		service.GetHeadToHeadFunc = func(ctx context.Context, guildID sharedtypes.GuildID, memberA, memberB sharedtypes.DiscordID, seasonID string) (results.OperationResult[leaderboarddomain.HeadToHeadRecord, error], error) {
+			assert.Equal(t, sharedtypes.GuildID(clubUUID), guildID)
+			assert.Equal(t, "2026-spring", seasonID)
+			return results.SuccessResult[leaderboarddomain.HeadToHeadRecord, error](leaderboarddomain.BuildHeadToHead(
+				string(memberA), string(memberB),
+				[]leaderboarddomain.HeadToHeadRound{{RoundID: "r1", PlayedAt: time.Now(), RankA: 1, RankB: 2}},
+				nil,
+			)), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+		ctx := context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.h2h")
+
+		res, err := h.HandleHeadToHeadRequest(ctx, &HeadToHeadRequestedPayloadV1{
+			GuildID:  "guild-123",
+			ClubUUID: &clubUUID,
+			MemberA:  "alice",
+			MemberB:  "bob",
+			SeasonID: "2026-spring",
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, "_INBOX.h2h", res[0].Topic)
+		reply, ok := res[0].Payload.(*HeadToHeadResponsePayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, 1, reply.Wins)
+		require.Len(t, reply.Series, 1)
+		assert.Equal(t, leaderboarddomain.HeadToHeadWin, reply.Series[0].Result)
+	})
+
+	t.Run("service error publishes failure", func(t *testing.T) {
+		service := NewFakeService()
+		service.GetHeadToHeadFunc = func(ctx context.Context, guildID sharedtypes.GuildID, memberA, memberB sharedtypes.DiscordID, seasonID string) (results.OperationResult[leaderboarddomain.HeadToHeadRecord, error], error) {
+			return results.OperationResult[leaderboarddomain.HeadToHeadRecord, error]{}, errors.New("db down")
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleHeadToHeadRequest(context.Background(), &HeadToHeadRequestedPayloadV1{GuildID: "guild-123", MemberA: "alice", MemberB: "bob"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardHeadToHeadFailedV1, res[0].Topic)
+	})
+}
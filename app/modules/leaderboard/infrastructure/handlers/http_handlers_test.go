@@ -0,0 +1,79 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+const testSessionToken = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestHTTPHandlers_HandleGetMemberStats(t *testing.T) {
+	userUUID := uuid.New()
+	clubUUID := uuid.New()
+
+	tests := []struct {
+		name       string
+		withCookie bool
+		failure    error
+		wantStatus int
+	}{
+		{name: "member reads stats", withCookie: true, wantStatus: http.StatusOK},
+		{name: "missing session", wantStatus: http.StatusUnauthorized},
+		{name: "unknown member", withCookie: true, failure: leaderboardservice.ErrMemberNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &userdb.FakeRepository{}
+			repo.GetRefreshTokenFn = func(_ context.Context, _ bun.IDB, _ string) (*userdb.RefreshToken, error) {
+				return &userdb.RefreshToken{UserUUID: userUUID, ExpiresAt: time.Now().Add(time.Hour)}, nil
+			}
+			repo.GetClubMembershipFn = func(_ context.Context, _ bun.IDB, _, _ uuid.UUID) (*userdb.ClubMembership, error) {
+				return &userdb.ClubMembership{}, nil
+			}
+			repo.GetDiscordGuildIDByClubUUIDFn = func(_ context.Context, _ bun.IDB, _ uuid.UUID) (sharedtypes.GuildID, error) {
+				return "guild-1", nil
+			}
+
+			svc := NewFakeService()
+			svc.GetMemberStatsFunc = func(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (results.OperationResult[leaderboarddomain.MemberStats, error], error) {
+				if guildID != "guild-1" || memberID != "alice" {
+					t.Errorf("got guild %s member %s, want guild-1 alice", guildID, memberID)
+				}
+				if tt.failure != nil {
+					return results.FailureResult[leaderboarddomain.MemberStats](tt.failure), nil
+				}
+				return results.SuccessResult[leaderboarddomain.MemberStats, error](leaderboarddomain.MemberStats{MemberID: string(memberID)}), nil
+			}
+			h := NewHTTPHandlers(svc, nil, repo, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+			router := chi.NewRouter()
+			router.Get("/api/leaderboard/members/{memberID}/stats", h.HandleGetMemberStats)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/leaderboard/members/alice/stats?club_uuid="+clubUUID.String(), nil)
+			if tt.withCookie {
+				req.AddCookie(&http.Cookie{Name: refreshTokenCookie, Value: testSessionToken})
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
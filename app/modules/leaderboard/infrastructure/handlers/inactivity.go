@@ -0,0 +1,175 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboardqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/queue"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Inactivity policy topics. Decay runs on the inactivity decay tick; each guild whose
+// tags change is announced on LeaderboardInactivityDecayedV1 followed by the usual batch
+// tag assignment events.
+const (
+	LeaderboardInactivityPolicySetRequestedV1 = "leaderboard.inactivity.policy.set.requested.v1"
+	LeaderboardInactivityPolicyUpdatedV1      = "leaderboard.inactivity.policy.updated.v1"
+	LeaderboardInactivityPolicyFailedV1       = "leaderboard.inactivity.policy.failed.v1"
+	LeaderboardInactivityPreviewRequestedV1   = "leaderboard.inactivity.preview.requested.v1"
+	LeaderboardInactivityPreviewResponseV1    = "leaderboard.inactivity.preview.response.v1"
+	LeaderboardInactivityDecayedV1            = "leaderboard.inactivity.decayed.v1"
+)
+
+// InactivityPolicySetRequestedPayloadV1 replaces a guild's inactivity policy.
+type InactivityPolicySetRequestedPayloadV1 struct {
+	GuildID sharedtypes.GuildID                `json:"guild_id"`
+	Policy  leaderboarddomain.InactivityPolicy `json:"policy"`
+}
+
+// InactivityPolicyUpdatedPayloadV1 confirms a guild's inactivity policy.
+type InactivityPolicyUpdatedPayloadV1 struct {
+	GuildID sharedtypes.GuildID                `json:"guild_id"`
+	Policy  leaderboarddomain.InactivityPolicy `json:"policy"`
+}
+
+// InactivityPreviewRequestedPayloadV1 asks who the next decay run would affect. Policy,
+// when set, is previewed instead of the stored policy.
+type InactivityPreviewRequestedPayloadV1 struct {
+	GuildID sharedtypes.GuildID                 `json:"guild_id"`
+	Policy  *leaderboarddomain.InactivityPolicy `json:"policy,omitempty"`
+}
+
+// InactiveMemberV1 is a member whose tag decays. NewTag is 0 when the tag is released.
+type InactiveMemberV1 struct {
+	MemberID     sharedtypes.DiscordID `json:"member_id"`
+	Tag          sharedtypes.TagNumber `json:"tag"`
+	NewTag       sharedtypes.TagNumber `json:"new_tag"`
+	LastActiveAt *time.Time            `json:"last_active_at,omitempty"`
+}
+
+// InactivityPreviewResponsePayloadV1 is the reply for LeaderboardInactivityPreviewRequestedV1.
+// Changes also covers members who move up past a dropped member.
+type InactivityPreviewResponsePayloadV1 struct {
+	GuildID sharedtypes.GuildID                     `json:"guild_id"`
+	Policy  leaderboarddomain.InactivityPolicy      `json:"policy"`
+	Members []InactiveMemberV1                      `json:"members"`
+	Changes []leaderboardevents.TagAssignmentInfoV1 `json:"changes"`
+}
+
+// InactivityDecayedPayloadV1 announces the members decayed in a guild by a decay run.
+type InactivityDecayedPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+	Members []InactiveMemberV1  `json:"members"`
+}
+
+// HandleInactivityPolicySetRequested stores a guild's inactivity policy.
+func (h *LeaderboardHandlers) HandleInactivityPolicySetRequested(
+	ctx context.Context,
+	payload *InactivityPolicySetRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.SetInactivityPolicy(ctx, payload.GuildID, payload.Policy)
+	if err != nil {
+		return inactivityPolicyFailed(payload.GuildID, err.Error()), nil
+	}
+	if result.IsFailure() {
+		return inactivityPolicyFailed(payload.GuildID, fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardInactivityPolicyUpdatedV1)
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &InactivityPolicyUpdatedPayloadV1{
+			GuildID: payload.GuildID,
+			Policy:  *result.Success,
+		},
+	}}, nil
+}
+
+// HandleInactivityPreviewRequested replies with the members the next decay run would affect.
+func (h *LeaderboardHandlers) HandleInactivityPreviewRequested(
+	ctx context.Context,
+	payload *InactivityPreviewRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.PreviewInactivityDecay(ctx, payload.GuildID, payload.Policy)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsFailure() {
+		return inactivityPolicyFailed(payload.GuildID, fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardInactivityPreviewResponseV1)
+	preview := *result.Success
+	changes := make([]leaderboardevents.TagAssignmentInfoV1, len(preview.Changes))
+	for i, c := range preview.Changes {
+		changes[i] = leaderboardevents.TagAssignmentInfoV1{UserID: c.UserID, TagNumber: c.TagNumber}
+	}
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &InactivityPreviewResponsePayloadV1{
+			GuildID: payload.GuildID,
+			Policy:  preview.Policy,
+			Members: toInactiveMembers(preview.Decayed),
+			Changes: changes,
+		},
+	}}, nil
+}
+
+// HandleInactivityDecayTick applies every enabled inactivity policy and announces the
+// resulting tag changes.
+func (h *LeaderboardHandlers) HandleInactivityDecayTick(
+	ctx context.Context,
+	payload *leaderboardqueue.InactivityDecayTickPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	now := payload.RequestedAt
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+
+	runs, err := h.service.RunInactivityDecay(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []handlerwrapper.Result
+	for _, run := range runs {
+		out = append(out, handlerwrapper.Result{
+			Topic: LeaderboardInactivityDecayedV1,
+			Payload: &InactivityDecayedPayloadV1{
+				GuildID: run.GuildID,
+				Members: toInactiveMembers(run.Decayed),
+			},
+		})
+		out = append(out, h.mapSuccessResults(ctx, run.GuildID, "", "", run.Changes, leaderboardservice.ServiceUpdateSourceInactivity, "")...)
+	}
+	return out, nil
+}
+
+func inactivityPolicyFailed(guildID sharedtypes.GuildID, reason string) []handlerwrapper.Result {
+	return []handlerwrapper.Result{{
+		Topic:   LeaderboardInactivityPolicyFailedV1,
+		Payload: &leaderboardevents.AdminFailedPayloadV1{GuildID: guildID, Reason: reason},
+	}}
+}
+
+func toInactiveMembers(decayed []leaderboarddomain.InactivityDecay) []InactiveMemberV1 {
+	members := make([]InactiveMemberV1, len(decayed))
+	for i, d := range decayed {
+		members[i] = InactiveMemberV1{
+			MemberID: sharedtypes.DiscordID(d.MemberID),
+			Tag:      sharedtypes.TagNumber(d.Tag),
+			NewTag:   sharedtypes.TagNumber(d.NewTag),
+		}
+		if !d.LastActiveAt.IsZero() {
+			lastActive := d.LastActiveAt
+			members[i].LastActiveAt = &lastActive
+		}
+	}
+	return members
+}
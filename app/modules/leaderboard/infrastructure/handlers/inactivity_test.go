@@ -0,0 +1,131 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedevents "github.com/Black-And-White-Club/frolf-bot-shared/events/shared"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboardqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleInactivityPolicySetRequested(t *testing.T) {
+	policy := leaderboarddomain.InactivityPolicy{Enabled: true, InactiveWeeks: 6, Action: leaderboarddomain.InactivityActionRelease}
+
+	t.Run("confirms stored policy", func(t *testing.T) {
+		service := NewFakeService()
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleInactivityPolicySetRequested(context.Background(), &InactivityPolicySetRequestedPayloadV1{GuildID: "guild-123", Policy: policy})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardInactivityPolicyUpdatedV1, res[0].Topic)
+		updated, ok := res[0].Payload.(*InactivityPolicyUpdatedPayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, policy, updated.Policy)
+	})
+
+	t.Run("invalid policy fails", func(t *testing.T) {
+		service := NewFakeService()
+		service.SetInactivityPolicyFunc = func(ctx context.Context, guildID sharedtypes.GuildID, p leaderboarddomain.InactivityPolicy) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error) {
+			return results.FailureResult[leaderboarddomain.InactivityPolicy](p.Validate()), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleInactivityPolicySetRequested(context.Background(), &InactivityPolicySetRequestedPayloadV1{
+			GuildID: "guild-123",
+			Policy:  leaderboarddomain.InactivityPolicy{InactiveWeeks: 4, Action: leaderboarddomain.InactivityActionDrop},
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardInactivityPolicyFailedV1, res[0].Topic)
+	})
+}
+
+func TestHandleInactivityPreviewRequested_ListsAffectedMembers(t *testing.T) {
+	lastActive := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	service := NewFakeService()
+	service.PreviewInactivityDecayFunc = func(ctx context.Context, guildID sharedtypes.GuildID, policy *leaderboarddomain.InactivityPolicy) (results.OperationResult[leaderboardservice.InactivityPreview, error], error) {
+		assert.Nil(t, policy)
+		return results.SuccessResult[leaderboardservice.InactivityPreview, error](leaderboardservice.InactivityPreview{
+			Policy: leaderboarddomain.InactivityPolicy{Enabled: true, InactiveWeeks: 4, Action: leaderboarddomain.InactivityActionDrop, DropPositions: 1},
+			Decayed: []leaderboarddomain.InactivityDecay{
+				{MemberID: "alice", Tag: 1, NewTag: 2, LastActiveAt: lastActive},
+				{MemberID: "carol", Tag: 5, NewTag: 6},
+			},
+			Changes: []sharedtypes.TagAssignmentRequest{
+				{UserID: "alice", TagNumber: 2},
+				{UserID: "bob", TagNumber: 1},
+			},
+		}), nil
+	}
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+	res, err := h.HandleInactivityPreviewRequested(context.Background(), &InactivityPreviewRequestedPayloadV1{GuildID: "guild-123"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, LeaderboardInactivityPreviewResponseV1, res[0].Topic)
+
+	preview, ok := res[0].Payload.(*InactivityPreviewResponsePayloadV1)
+	require.True(t, ok)
+	require.Len(t, preview.Members, 2)
+	require.NotNil(t, preview.Members[0].LastActiveAt)
+	assert.Equal(t, lastActive, *preview.Members[0].LastActiveAt)
+	assert.Nil(t, preview.Members[1].LastActiveAt)
+	assert.Len(t, preview.Changes, 2)
+}
+
+func TestHandleInactivityDecayTick(t *testing.T) {
+	tick := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("announces decay and tag changes", func(t *testing.T) {
+		service := NewFakeService()
+		service.RunInactivityDecayFunc = func(ctx context.Context, now time.Time) ([]leaderboardservice.InactivityDecayRun, error) {
+			assert.Equal(t, tick, now)
+			return []leaderboardservice.InactivityDecayRun{{
+				GuildID: "guild-123",
+				Decayed: []leaderboarddomain.InactivityDecay{{MemberID: "alice", Tag: 3}},
+				Changes: []sharedtypes.TagAssignmentRequest{{UserID: "alice", TagNumber: 0}},
+			}}, nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleInactivityDecayTick(context.Background(), &leaderboardqueue.InactivityDecayTickPayloadV1{RequestedAt: tick})
+		require.NoError(t, err)
+		require.NotEmpty(t, res)
+		assert.Equal(t, LeaderboardInactivityDecayedV1, res[0].Topic)
+
+		var sync *sharedevents.SyncRoundsTagRequestPayloadV1
+		for _, r := range res {
+			if p, ok := r.Payload.(*sharedevents.SyncRoundsTagRequestPayloadV1); ok {
+				sync = p
+			}
+			if p, ok := r.Payload.(*leaderboardevents.LeaderboardBatchTagAssignedPayloadV1); ok {
+				assert.Empty(t, p.Assignments, "released tags are not assignments")
+			}
+		}
+		require.NotNil(t, sync)
+		assert.Equal(t, leaderboardservice.ServiceUpdateSourceInactivity, sync.Source)
+		assert.Equal(t, sharedtypes.TagNumber(0), sync.ChangedTags["alice"])
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		service := NewFakeService()
+		service.RunInactivityDecayFunc = func(ctx context.Context, now time.Time) ([]leaderboardservice.InactivityDecayRun, error) {
+			return nil, errors.New("db down")
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		_, err := h.HandleInactivityDecayTick(context.Background(), &leaderboardqueue.InactivityDecayTickPayloadV1{RequestedAt: tick})
+		assert.Error(t, err)
+	})
+}
@@ -5,8 +5,10 @@ import (
 
 	guildevents "github.com/Black-And-White-Club/frolf-bot-shared/events/guild"
 	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	sharedevents "github.com/Black-And-White-Club/frolf-bot-shared/events/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboardqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/queue"
 )
 
 // Handlers defines the interface for leaderboard event handlers.
@@ -24,10 +26,18 @@ type Handlers interface {
 	// HandleTagSwapRequested manages manual intent for one user to claim another's tag.
 	HandleTagSwapRequested(ctx context.Context, payload *leaderboardevents.TagSwapRequestedPayloadV1) ([]handlerwrapper.Result, error)
 
+	// HandleSwapIntentCancelRequested withdraws a user's pending swap intent.
+	HandleSwapIntentCancelRequested(ctx context.Context, payload *SwapIntentCancelRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleSwapIntentsRequest returns a guild's pending swap intents via request-reply.
+	HandleSwapIntentsRequest(ctx context.Context, payload *SwapIntentsRequestPayloadV1) ([]handlerwrapper.Result, error)
+
 	// --- READS ---
 
 	// HandleGetLeaderboardRequest returns the full current state of the leaderboard.
 	HandleGetLeaderboardRequest(ctx context.Context, payload *leaderboardevents.GetLeaderboardRequestedPayloadV1) ([]handlerwrapper.Result, error)
+	// HandleLeaderboardSnapshotRequest returns the current leaderboard, or a past one as of a time or round.
+	HandleLeaderboardSnapshotRequest(ctx context.Context, payload *LeaderboardSnapshotRequestPayloadV2) ([]handlerwrapper.Result, error)
 
 	// HandleGetTagByUserIDRequest performs a general tag lookup for a specific user.
 	HandleGetTagByUserIDRequest(ctx context.Context, payload *sharedevents.DiscordTagLookupRequestedPayloadV1) ([]handlerwrapper.Result, error)
@@ -52,6 +62,69 @@ type Handlers interface {
 	// HandleStartNewSeason creates a new season.
 	HandleStartNewSeason(ctx context.Context, payload *leaderboardevents.StartNewSeasonPayloadV1) ([]handlerwrapper.Result, error)
 
+	// HandleStartSeasonWithPolicy creates a new season scored under a chosen points policy.
+	HandleStartSeasonWithPolicy(ctx context.Context, payload *StartSeasonWithPolicyPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleSeasonPointsPolicyRequest returns the points policy of a season via request-reply.
+	HandleSeasonPointsPolicyRequest(ctx context.Context, payload *SeasonPointsPolicyRequestPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleReplayRequested replays a guild's rounds to rebuild tags and standings.
+	HandleReplayRequested(ctx context.Context, payload *ReplayRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleSeasonScheduleRequested schedules a season to start and end automatically.
+	HandleSeasonScheduleRequested(ctx context.Context, payload *SeasonScheduleRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleSeasonScheduleTick starts and ends seasons whose scheduled time has passed.
+	HandleSeasonScheduleTick(ctx context.Context, payload *leaderboardqueue.SeasonScheduleTickPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleInactivityPolicySetRequested stores a guild's inactivity tag decay policy.
+	HandleInactivityPolicySetRequested(ctx context.Context, payload *InactivityPolicySetRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleInactivityPreviewRequested lists who the next inactivity decay run would affect.
+	HandleInactivityPreviewRequested(ctx context.Context, payload *InactivityPreviewRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleInactivityDecayTick applies every enabled inactivity policy.
+	HandleInactivityDecayTick(ctx context.Context, payload *leaderboardqueue.InactivityDecayTickPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleTagPoolPolicySetRequested stores a guild's tag pool model.
+	HandleTagPoolPolicySetRequested(ctx context.Context, payload *TagPoolPolicySetRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleTagPoolPolicyRequest replies with a guild's tag pool model.
+	HandleTagPoolPolicyRequest(ctx context.Context, payload *TagPoolPolicyRequestPayloadV1) ([]handlerwrapper.Result, error)
+
+	// --- PROMOTION ---
+
+	// HandlePromotionPolicySetRequested stores a guild's promotion policy.
+	HandlePromotionPolicySetRequested(ctx context.Context, payload *PromotionPolicySetRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandlePromotionPolicyRequest replies with a guild's promotion policy.
+	HandlePromotionPolicyRequest(ctx context.Context, payload *PromotionPolicyRequestPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandlePromotionPreviewRequested lists who would change division if the active season ended now.
+	HandlePromotionPreviewRequested(ctx context.Context, payload *PromotionPreviewRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// --- EXPORT / IMPORT ---
+
+	// HandleDataExportRequested replies with tag history, members or standings as CSV or XLSX.
+	HandleDataExportRequested(ctx context.Context, payload *DataExportRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleTagHistoryImportRequested validates, dry-runs or applies a tag history file.
+	HandleTagHistoryImportRequested(ctx context.Context, payload *TagHistoryImportRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// --- DIVISIONS ---
+
+	// HandleDivisionCreateRequested adds a division to a guild.
+	HandleDivisionCreateRequested(ctx context.Context, payload *DivisionCreateRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleDivisionMemberAssignRequested moves a member to a division.
+	HandleDivisionMemberAssignRequested(ctx context.Context, payload *DivisionMemberAssignRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Division reads (Request-Reply).
+	HandleDivisionListRequest(ctx context.Context, payload *DivisionListRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleDivisionLeaderboardRequest(ctx context.Context, payload *DivisionReadRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleDivisionTagListRequest(ctx context.Context, payload *DivisionReadRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleDivisionStandingsRequest(ctx context.Context, payload *DivisionReadRequestPayloadV1) ([]handlerwrapper.Result, error)
+
 	// HandleEndSeason ends the active season.
 	HandleEndSeason(ctx context.Context, payload *leaderboardevents.EndSeasonPayloadV1) ([]handlerwrapper.Result, error)
 
@@ -71,12 +144,40 @@ type Handlers interface {
 	// HandleTagHistoryRequest returns tag history for a member or guild.
 	HandleTagHistoryRequest(ctx context.Context, payload *leaderboardevents.TagHistoryRequestedPayloadV1) ([]handlerwrapper.Result, error)
 
+	// HandleHeadToHeadRequest returns two members' record against each other.
+	HandleHeadToHeadRequest(ctx context.Context, payload *HeadToHeadRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleMemberStatsRequest returns a member's statistics profile.
+	HandleMemberStatsRequest(ctx context.Context, payload *MemberStatsRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Statistics charts (PNG or SVG).
+	HandlePointsRaceChartRequest(ctx context.Context, payload *ChartRequestedPayloadV1) ([]handlerwrapper.Result, error)
+	HandleScoreDistributionChartRequest(ctx context.Context, payload *ChartRequestedPayloadV1) ([]handlerwrapper.Result, error)
+	HandleAttendanceHeatmapChartRequest(ctx context.Context, payload *ChartRequestedPayloadV1) ([]handlerwrapper.Result, error)
+	HandleTagComparisonChartRequest(ctx context.Context, payload *ChartRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
 	// HandleTagGraphRequest returns a PNG tag history chart for a member.
 	HandleTagGraphRequest(ctx context.Context, payload *leaderboardevents.TagGraphRequestedPayloadV1) ([]handlerwrapper.Result, error)
 
 	// HandleTagListRequest returns the master tag list for a guild.
 	HandleTagListRequest(ctx context.Context, payload *leaderboardevents.TagListRequestedPayloadV1) ([]handlerwrapper.Result, error)
 
+	// --- COURSE STATS (Request-Reply) ---
+
+	// HandleCourseLayoutStatsRequest returns par-relative member stats for a course layout.
+	HandleCourseLayoutStatsRequest(ctx context.Context, payload *CourseLayoutStatsRequestPayloadV1) ([]handlerwrapper.Result, error)
+
+	// --- STATS CACHE INVALIDATION ---
+
+	// HandleRoundFinalized drops the guild's cached member stats.
+	HandleRoundFinalized(ctx context.Context, payload *roundevents.RoundFinalizedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleScoreUpdated drops the corrected member's cached stats.
+	HandleScoreUpdated(ctx context.Context, payload *sharedevents.ScoreUpdatedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// HandleScoreBulkUpdated drops the cached stats of members whose scores were corrected.
+	HandleScoreBulkUpdated(ctx context.Context, payload *sharedevents.ScoreBulkUpdatedPayloadV1) ([]handlerwrapper.Result, error)
+
 	// --- INFRASTRUCTURE ---
 
 	// HandleGuildConfigCreated ensures a leaderboard exists when a new guild is configured.
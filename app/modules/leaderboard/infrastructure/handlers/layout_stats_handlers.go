@@ -0,0 +1,133 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+	"github.com/google/uuid"
+)
+
+// Course layout stats topics.
+const (
+	LeaderboardCourseLayoutStatsRequestV1  = "leaderboard.course_layout.stats.request.v1"
+	LeaderboardCourseLayoutStatsResponseV1 = "leaderboard.course_layout.stats.response.v1"
+	LeaderboardCourseLayoutStatsFailedV1   = "leaderboard.course_layout.stats.failed.v1"
+)
+
+// CourseLayoutStatsRequestPayloadV1 asks for par-relative member stats on one layout.
+// Since is optional; the zero value covers every finalized round on the layout.
+type CourseLayoutStatsRequestPayloadV1 struct {
+	GuildID  sharedtypes.GuildID `json:"guild_id"`
+	LayoutID uuid.UUID           `json:"layout_id"`
+	Since    time.Time           `json:"since,omitempty"`
+}
+
+// CourseLayoutMemberStatsV1 is one member's summary on a layout.
+type CourseLayoutMemberStatsV1 struct {
+	MemberID     sharedtypes.DiscordID `json:"member_id"`
+	RoundsPlayed int                   `json:"rounds_played"`
+	AverageToPar float64               `json:"average_to_par"`
+	BestToPar    int                   `json:"best_to_par"`
+	LatestToPar  int                   `json:"latest_to_par"`
+}
+
+// CourseLayoutStatsResponsePayloadV1 is the reply for LeaderboardCourseLayoutStatsRequestV1.
+type CourseLayoutStatsResponsePayloadV1 struct {
+	GuildID    sharedtypes.GuildID         `json:"guild_id"`
+	LayoutID   uuid.UUID                   `json:"layout_id"`
+	RoundCount int                         `json:"round_count"`
+	Members    []CourseLayoutMemberStatsV1 `json:"members"`
+}
+
+// CourseLayoutStatsFailedPayloadV1 reports a stats request that could not be served.
+type CourseLayoutStatsFailedPayloadV1 struct {
+	GuildID  sharedtypes.GuildID `json:"guild_id"`
+	LayoutID uuid.UUID           `json:"layout_id"`
+	Reason   string              `json:"reason"`
+}
+
+// HandleCourseLayoutStatsRequest returns per-member par-relative stats across the
+// finalized rounds played on a course layout. DNF cards are left out so partial
+// rounds do not flatter the averages.
+func (h *LeaderboardHandlers) HandleCourseLayoutStatsRequest(
+	ctx context.Context,
+	payload *CourseLayoutStatsRequestPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardCourseLayoutStatsResponseV1)
+
+	fail := func(reason string) []handlerwrapper.Result {
+		return []handlerwrapper.Result{{
+			Topic: LeaderboardCourseLayoutStatsFailedV1,
+			Payload: &CourseLayoutStatsFailedPayloadV1{
+				GuildID:  payload.GuildID,
+				LayoutID: payload.LayoutID,
+				Reason:   reason,
+			},
+		}}
+	}
+
+	if h.roundLookup == nil {
+		return fail("round data unavailable"), nil
+	}
+	if payload.LayoutID == uuid.Nil {
+		return fail("layout_id is required"), nil
+	}
+
+	rounds, err := h.roundLookup.GetCourseLayoutRounds(ctx, payload.GuildID, payload.LayoutID, payload.Since)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to load course layout rounds",
+			slog.String("guild_id", string(payload.GuildID)),
+			slog.String("layout_id", payload.LayoutID.String()),
+			slog.String("error", err.Error()),
+		)
+		return fail("unable to retrieve course layout rounds"), nil
+	}
+
+	summary := leaderboarddomain.SummarizeLayoutScores(layoutScoresFromRounds(rounds))
+	members := make([]CourseLayoutMemberStatsV1, len(summary))
+	for i, s := range summary {
+		members[i] = CourseLayoutMemberStatsV1{
+			MemberID:     sharedtypes.DiscordID(s.MemberID),
+			RoundsPlayed: s.RoundsPlayed,
+			AverageToPar: s.AverageToPar,
+			BestToPar:    s.BestToPar,
+			LatestToPar:  s.LatestToPar,
+		}
+	}
+
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &CourseLayoutStatsResponsePayloadV1{
+			GuildID:    payload.GuildID,
+			LayoutID:   payload.LayoutID,
+			RoundCount: len(rounds),
+			Members:    members,
+		},
+	}}, nil
+}
+
+// layoutScoresFromRounds flattens completed, non-DNF participant scores in round order.
+func layoutScoresFromRounds(rounds []*roundtypes.Round) []leaderboarddomain.LayoutScore {
+	scores := make([]leaderboarddomain.LayoutScore, 0)
+	for _, round := range rounds {
+		if round == nil {
+			continue
+		}
+		for _, p := range round.Participants {
+			if p.Score == nil || p.IsDNF {
+				continue
+			}
+			scores = append(scores, leaderboarddomain.LayoutScore{
+				MemberID: string(p.UserID),
+				ToPar:    int(*p.Score),
+			})
+		}
+	}
+	return scores
+}
@@ -0,0 +1,131 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/google/uuid"
+)
+
+func TestLeaderboardHandlers_HandleCourseLayoutStatsRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	layoutID := uuid.New()
+	score := func(v int) *sharedtypes.Score {
+		s := sharedtypes.Score(v)
+		return &s
+	}
+
+	tests := []struct {
+		name        string
+		payload     *CourseLayoutStatsRequestPayloadV1
+		replyTo     string
+		setupLookup func(*FakeRoundLookup)
+		nilLookup   bool
+		wantTopic   string
+		verify      func(t *testing.T, results []handlerwrapper.Result)
+	}{
+		{
+			name:    "summarizes scores and skips DNF cards",
+			payload: &CourseLayoutStatsRequestPayloadV1{GuildID: guildID, LayoutID: layoutID},
+			setupLookup: func(f *FakeRoundLookup) {
+				f.GetCourseLayoutRoundsFunc = func(ctx context.Context, g sharedtypes.GuildID, l uuid.UUID, since time.Time) ([]*roundtypes.Round, error) {
+					return []*roundtypes.Round{
+						{Participants: []roundtypes.Participant{
+							{UserID: "a", Score: score(-2)},
+							{UserID: "b", Score: score(3), IsDNF: true},
+						}},
+						{Participants: []roundtypes.Participant{
+							{UserID: "a", Score: score(0)},
+							{UserID: "b", Score: score(1)},
+							{UserID: "c"},
+						}},
+					}, nil
+				}
+			},
+			wantTopic: LeaderboardCourseLayoutStatsResponseV1,
+			verify: func(t *testing.T, results []handlerwrapper.Result) {
+				payload, ok := results[0].Payload.(*CourseLayoutStatsResponsePayloadV1)
+				if !ok {
+					t.Fatalf("unexpected payload type %T", results[0].Payload)
+				}
+				if payload.RoundCount != 2 {
+					t.Errorf("RoundCount = %d, want 2", payload.RoundCount)
+				}
+				if len(payload.Members) != 2 {
+					t.Fatalf("expected 2 members, got %+v", payload.Members)
+				}
+				if payload.Members[0].MemberID != "a" || payload.Members[0].RoundsPlayed != 2 || payload.Members[0].BestToPar != -2 {
+					t.Errorf("unexpected leader: %+v", payload.Members[0])
+				}
+				if payload.Members[1].MemberID != "b" || payload.Members[1].RoundsPlayed != 1 {
+					t.Errorf("DNF card should be excluded: %+v", payload.Members[1])
+				}
+			},
+		},
+		{
+			name:      "replies to request inbox",
+			payload:   &CourseLayoutStatsRequestPayloadV1{GuildID: guildID, LayoutID: layoutID},
+			replyTo:   "_INBOX.stats",
+			wantTopic: "_INBOX.stats",
+		},
+		{
+			name:      "missing layout id fails",
+			payload:   &CourseLayoutStatsRequestPayloadV1{GuildID: guildID},
+			wantTopic: LeaderboardCourseLayoutStatsFailedV1,
+		},
+		{
+			name:      "nil round lookup fails",
+			payload:   &CourseLayoutStatsRequestPayloadV1{GuildID: guildID, LayoutID: layoutID},
+			nilLookup: true,
+			wantTopic: LeaderboardCourseLayoutStatsFailedV1,
+		},
+		{
+			name:    "lookup error fails",
+			payload: &CourseLayoutStatsRequestPayloadV1{GuildID: guildID, LayoutID: layoutID},
+			setupLookup: func(f *FakeRoundLookup) {
+				f.GetCourseLayoutRoundsFunc = func(ctx context.Context, g sharedtypes.GuildID, l uuid.UUID, since time.Time) ([]*roundtypes.Round, error) {
+					return nil, errors.New("db down")
+				}
+			},
+			wantTopic: LeaderboardCourseLayoutStatsFailedV1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &LeaderboardHandlers{service: NewFakeService(), logger: slog.Default()}
+			if !tt.nilLookup {
+				lookup := &FakeRoundLookup{}
+				if tt.setupLookup != nil {
+					tt.setupLookup(lookup)
+				}
+				h.roundLookup = lookup
+			}
+
+			ctx := context.Background()
+			if tt.replyTo != "" {
+				ctx = context.WithValue(ctx, handlerwrapper.CtxKeyReplyTo, tt.replyTo)
+			}
+
+			got, err := h.HandleCourseLayoutStatsRequest(ctx, tt.payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 1 {
+				t.Fatalf("expected 1 result, got %d", len(got))
+			}
+			if got[0].Topic != tt.wantTopic {
+				t.Errorf("topic = %s, want %s", got[0].Topic, tt.wantTopic)
+			}
+			if tt.verify != nil {
+				tt.verify(t, got)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
 	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
 	"github.com/google/uuid"
 )
 
@@ -33,11 +34,13 @@ func (h *LeaderboardHandlers) handleLeaderboardUpdateWithServiceCommand(
 	if len(participants) == 0 {
 		return nil, fmt.Errorf("leaderboard update payload has no participants")
 	}
+	h.applyTagRanks(ctx, payload.GuildID, payload.RoundID, participants)
 
 	output, err := h.service.ProcessRoundCommand(ctx, leaderboardservice.ProcessRoundCommand{
 		GuildID:      string(payload.GuildID),
 		RoundID:      uuid.UUID(payload.RoundID),
 		Participants: participants,
+		StartTime:    h.roundStartTime(ctx, payload.GuildID, payload.RoundID),
 	})
 	if err != nil {
 		return nil, err
@@ -46,8 +49,10 @@ func (h *LeaderboardHandlers) handleLeaderboardUpdateWithServiceCommand(
 		return nil, fmt.Errorf("process round returned nil output")
 	}
 
-	// Fetch full leaderboard to ensure all members are displayed
-	fullLeaderboardResult, err := h.service.GetLeaderboard(ctx, payload.GuildID, "")
+	// Fetch full leaderboard to ensure all members are displayed. The update event keys
+	// holders by tag, which is only unique within a division, so it carries the
+	// default division; other divisions are read through GetDivisionLeaderboard.
+	fullLeaderboardResult, err := h.service.GetDivisionLeaderboard(ctx, payload.GuildID, leaderboarddomain.DefaultDivisionID, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch full leaderboard after update: %w", err)
 	}
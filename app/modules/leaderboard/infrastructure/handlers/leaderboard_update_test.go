@@ -9,8 +9,11 @@ import (
 
 	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
 	sharedevents "github.com/Black-And-White-Club/frolf-bot-shared/events/shared"
+	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
 	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -314,3 +317,36 @@ func TestLeaderboardHandlers_buildTagUpdatedResults_ResolvesClubUUIDOnce(t *test
 	assert.Equal(t, leaderboardevents.LeaderboardTagUpdatedV2+"."+string(sharedtypes.GuildID("test-guild")), results[1].Topic)
 	assert.Equal(t, leaderboardevents.LeaderboardTagUpdatedV2+"."+testClubUUID.String(), results[2].Topic)
 }
+
+func TestHandleLeaderboardUpdateRequested_PublishesDefaultDivision(t *testing.T) {
+	fakeSvc := NewFakeService()
+	fakeSvc.ProcessRoundCommandFunc = func(ctx context.Context, cmd leaderboardservice.ProcessRoundCommand) (*leaderboardservice.ProcessRoundOutput, error) {
+		return &leaderboardservice.ProcessRoundOutput{FinalParticipantTags: map[string]int{"12345678901234567": 1}, PointsSkipped: true}, nil
+	}
+	var divisions []string
+	fakeSvc.GetDivisionLeaderboardFunc = func(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
+		divisions = append(divisions, divisionID)
+		return results.SuccessResult[[]leaderboardtypes.LeaderboardEntry, error]([]leaderboardtypes.LeaderboardEntry{
+			{UserID: "12345678901234567", TagNumber: 1},
+		}), nil
+	}
+	h := &LeaderboardHandlers{
+		service:         fakeSvc,
+		userService:     NewFakeUserService(),
+		sagaCoordinator: NewFakeSagaCoordinator(),
+		logger:          slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	res, err := h.HandleLeaderboardUpdateRequested(context.Background(), &leaderboardevents.LeaderboardUpdateRequestedPayloadV1{
+		GuildID:               "test-guild",
+		RoundID:               sharedtypes.RoundID(uuid.New()),
+		SortedParticipantTags: []string{"1:12345678901234567"},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, res)
+
+	assert.Equal(t, []string{leaderboarddomain.DefaultDivisionID}, divisions)
+	updated, ok := res[0].Payload.(*leaderboardevents.LeaderboardUpdatedPayloadV1)
+	require.True(t, ok)
+	assert.Equal(t, sharedtypes.DiscordID("12345678901234567"), updated.LeaderboardData[1])
+}
@@ -0,0 +1,102 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	sharedevents "github.com/Black-And-White-Club/frolf-bot-shared/events/shared"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Member stats topics.
+const (
+	LeaderboardMemberStatsRequestedV1 = "leaderboard.member_stats.requested.v1"
+	LeaderboardMemberStatsResponseV1  = "leaderboard.member_stats.response.v1"
+	LeaderboardMemberStatsFailedV1    = "leaderboard.member_stats.failed.v1"
+)
+
+// MemberStatsRequestedPayloadV1 asks for one member's statistics profile.
+type MemberStatsRequestedPayloadV1 struct {
+	GuildID  string                `json:"guild_id"`
+	ClubUUID *string               `json:"club_uuid,omitempty"`
+	MemberID sharedtypes.DiscordID `json:"member_id"`
+}
+
+// MemberStatsResponsePayloadV1 carries a member's statistics profile.
+type MemberStatsResponsePayloadV1 struct {
+	GuildID string                        `json:"guild_id"`
+	Stats   leaderboarddomain.MemberStats `json:"stats"`
+}
+
+// MemberStatsFailedPayloadV1 reports a stats request that could not be served.
+type MemberStatsFailedPayloadV1 struct {
+	GuildID  string                `json:"guild_id"`
+	MemberID sharedtypes.DiscordID `json:"member_id"`
+	Reason   string                `json:"reason"`
+}
+
+// HandleMemberStatsRequest returns a member's statistics profile via request-reply.
+func (h *LeaderboardHandlers) HandleMemberStatsRequest(ctx context.Context, payload *MemberStatsRequestedPayloadV1) ([]handlerwrapper.Result, error) {
+	fail := func(reason string) []handlerwrapper.Result {
+		return []handlerwrapper.Result{{
+			Topic: LeaderboardMemberStatsFailedV1,
+			Payload: &MemberStatsFailedPayloadV1{
+				GuildID:  payload.GuildID,
+				MemberID: payload.MemberID,
+				Reason:   reason,
+			},
+		}}
+	}
+
+	guildID := payload.GuildID
+	if payload.ClubUUID != nil && *payload.ClubUUID != "" {
+		guildID = *payload.ClubUUID
+	}
+
+	result, err := h.service.GetMemberStats(ctx, sharedtypes.GuildID(guildID), payload.MemberID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to get member stats",
+			slog.String("guild_id", payload.GuildID),
+			slog.String("member_id", string(payload.MemberID)),
+			slog.String("error", err.Error()),
+		)
+		return fail("unable to retrieve member stats"), nil
+	}
+	if result.IsFailure() {
+		return fail(fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardMemberStatsResponseV1)
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &MemberStatsResponsePayloadV1{
+			GuildID: payload.GuildID,
+			Stats:   *result.Success,
+		},
+	}}, nil
+}
+
+// HandleRoundFinalized drops the guild's cached member stats. Every profile is
+// affected: members who missed the round lose their attendance streak.
+func (h *LeaderboardHandlers) HandleRoundFinalized(ctx context.Context, payload *roundevents.RoundFinalizedPayloadV1) ([]handlerwrapper.Result, error) {
+	h.service.InvalidateMemberStats(ctx, payload.GuildID)
+	return nil, nil
+}
+
+// HandleScoreUpdated drops the corrected member's cached stats.
+func (h *LeaderboardHandlers) HandleScoreUpdated(ctx context.Context, payload *sharedevents.ScoreUpdatedPayloadV1) ([]handlerwrapper.Result, error) {
+	h.service.InvalidateMemberStats(ctx, payload.GuildID, payload.UserID)
+	return nil, nil
+}
+
+// HandleScoreBulkUpdated drops the cached stats of every member whose score was
+// corrected, or the whole guild's when the event does not name them.
+func (h *LeaderboardHandlers) HandleScoreBulkUpdated(ctx context.Context, payload *sharedevents.ScoreBulkUpdatedPayloadV1) ([]handlerwrapper.Result, error) {
+	h.service.InvalidateMemberStats(ctx, payload.GuildID, payload.UserIDsApplied...)
+	return nil, nil
+}
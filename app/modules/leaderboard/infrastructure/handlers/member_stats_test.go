@@ -0,0 +1,95 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	sharedevents "github.com/Black-And-White-Club/frolf-bot-shared/events/shared"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleMemberStatsRequest(t *testing.T) {
+	t.Run("replies with the profile", func(t *testing.T) {
+		clubUUID := "9b2f3c4d-0000-4000-8000-000000000001"
+		service := NewFakeService()
+		service.GetMemberStatsFunc = func(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (results.OperationResult[leaderboarddomain.MemberStats, error], error) {
+			assert.Equal(t, sharedtypes.GuildID(clubUUID), guildID)
+			return results.SuccessResult[leaderboarddomain.MemberStats, error](leaderboarddomain.MemberStats{MemberID: string(memberID), RoundsPlayed: 12, Wins: 3}), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+		ctx := context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.stats")
+
+		res, err := h.HandleMemberStatsRequest(ctx, &MemberStatsRequestedPayloadV1{GuildID: "guild-123", ClubUUID: &clubUUID, MemberID: "alice"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, "_INBOX.stats", res[0].Topic)
+		reply, ok := res[0].Payload.(*MemberStatsResponsePayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, "alice", reply.Stats.MemberID)
+		assert.Equal(t, 12, reply.Stats.RoundsPlayed)
+	})
+
+	t.Run("unknown member publishes failure", func(t *testing.T) {
+		service := NewFakeService()
+		service.GetMemberStatsFunc = func(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (results.OperationResult[leaderboarddomain.MemberStats, error], error) {
+			return results.FailureResult[leaderboarddomain.MemberStats](leaderboardservice.ErrMemberNotFound), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleMemberStatsRequest(context.Background(), &MemberStatsRequestedPayloadV1{GuildID: "guild-123", MemberID: "ghost"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardMemberStatsFailedV1, res[0].Topic)
+		failure, ok := res[0].Payload.(*MemberStatsFailedPayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, "member not found", failure.Reason)
+	})
+
+	t.Run("service error publishes failure", func(t *testing.T) {
+		service := NewFakeService()
+		service.GetMemberStatsFunc = func(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (results.OperationResult[leaderboarddomain.MemberStats, error], error) {
+			return results.OperationResult[leaderboarddomain.MemberStats, error]{}, errors.New("db down")
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleMemberStatsRequest(context.Background(), &MemberStatsRequestedPayloadV1{GuildID: "guild-123", MemberID: "alice"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardMemberStatsFailedV1, res[0].Topic)
+	})
+}
+
+func TestMemberStatsInvalidation(t *testing.T) {
+	type call struct {
+		guildID   sharedtypes.GuildID
+		memberIDs []sharedtypes.DiscordID
+	}
+	var calls []call
+	service := NewFakeService()
+	service.InvalidateMemberStatsFunc = func(ctx context.Context, guildID sharedtypes.GuildID, memberIDs ...sharedtypes.DiscordID) {
+		calls = append(calls, call{guildID, memberIDs})
+	}
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+	ctx := context.Background()
+
+	_, err := h.HandleRoundFinalized(ctx, &roundevents.RoundFinalizedPayloadV1{GuildID: "guild-1"})
+	require.NoError(t, err)
+	_, err = h.HandleScoreUpdated(ctx, &sharedevents.ScoreUpdatedPayloadV1{GuildID: "guild-1", UserID: "alice"})
+	require.NoError(t, err)
+	_, err = h.HandleScoreBulkUpdated(ctx, &sharedevents.ScoreBulkUpdatedPayloadV1{GuildID: "guild-1", UserIDsApplied: []sharedtypes.DiscordID{"bob", "carol"}})
+	require.NoError(t, err)
+
+	require.Len(t, calls, 3)
+	assert.Empty(t, calls[0].memberIDs, "round finalization drops the whole guild")
+	assert.Equal(t, []sharedtypes.DiscordID{"alice"}, calls[1].memberIDs)
+	assert.Equal(t, []sharedtypes.DiscordID{"bob", "carol"}, calls[2].memberIDs)
+}
@@ -0,0 +1,117 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"fmt"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Season points policy topics. Starting a season with a policy replies on the shared
+// start-season success and failure topics.
+const (
+	LeaderboardStartSeasonWithPolicyV1      = "leaderboard.season.start_with_policy.requested.v1"
+	LeaderboardSeasonPointsPolicyRequestV1  = "leaderboard.season.points_policy.request.v1"
+	LeaderboardSeasonPointsPolicyResponseV1 = "leaderboard.season.points_policy.response.v1"
+	LeaderboardSeasonPointsPolicyFailedV1   = "leaderboard.season.points_policy.failed.v1"
+)
+
+// StartSeasonWithPolicyPayloadV1 starts a season under a points system. Policy, when
+// set, replaces the built-in defaults of System entirely.
+type StartSeasonWithPolicyPayloadV1 struct {
+	GuildID    sharedtypes.GuildID             `json:"guild_id"`
+	SeasonID   string                          `json:"season_id"`
+	SeasonName string                          `json:"season_name"`
+	System     leaderboarddomain.PointsSystem  `json:"system,omitempty"`
+	Policy     *leaderboarddomain.PointsPolicy `json:"policy,omitempty"`
+}
+
+// SeasonPointsPolicyRequestPayloadV1 asks for a season's points policy. An empty
+// SeasonID selects the active season.
+type SeasonPointsPolicyRequestPayloadV1 struct {
+	GuildID  sharedtypes.GuildID `json:"guild_id"`
+	SeasonID string              `json:"season_id,omitempty"`
+}
+
+// SeasonPointsPolicyResponsePayloadV1 is the reply for LeaderboardSeasonPointsPolicyRequestV1.
+type SeasonPointsPolicyResponsePayloadV1 struct {
+	GuildID  sharedtypes.GuildID            `json:"guild_id"`
+	SeasonID string                         `json:"season_id,omitempty"`
+	Policy   leaderboarddomain.PointsPolicy `json:"policy"`
+}
+
+// HandleStartSeasonWithPolicy starts a new season scored under the requested points policy.
+// The season it closes is announced as on HandleEndSeason.
+func (h *LeaderboardHandlers) HandleStartSeasonWithPolicy(
+	ctx context.Context,
+	payload *StartSeasonWithPolicyPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	fail := func(reason string) []handlerwrapper.Result {
+		return []handlerwrapper.Result{{
+			Topic:   leaderboardevents.LeaderboardStartNewSeasonFailedV1,
+			Payload: &leaderboardevents.AdminFailedPayloadV1{GuildID: payload.GuildID, Reason: reason},
+		}}
+	}
+
+	policy, ok := leaderboarddomain.BuiltInPointsPolicy(payload.System)
+	if payload.Policy != nil {
+		policy, ok = *payload.Policy, true
+	}
+	if !ok {
+		return fail(fmt.Sprintf("unknown points system %q", payload.System)), nil
+	}
+
+	previous := h.activeSeasonID(ctx, payload.GuildID)
+	result, err := h.service.StartNewSeasonWithPolicy(ctx, payload.GuildID, payload.SeasonID, payload.SeasonName, policy)
+	if err != nil {
+		return fail(err.Error()), nil
+	}
+	if result.IsFailure() {
+		return fail(fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	out := []handlerwrapper.Result{{
+		Topic: leaderboardevents.LeaderboardStartNewSeasonSuccessV1,
+		Payload: &leaderboardevents.StartNewSeasonSuccessPayloadV1{
+			GuildID:    payload.GuildID,
+			SeasonID:   payload.SeasonID,
+			SeasonName: payload.SeasonName,
+		},
+	}}
+	if previous != "" {
+		out = append(out, h.seasonEndedResults(ctx, payload.GuildID, previous)...)
+	}
+	return out, nil
+}
+
+// HandleSeasonPointsPolicyRequest replies with the points policy a season is scored under.
+func (h *LeaderboardHandlers) HandleSeasonPointsPolicyRequest(
+	ctx context.Context,
+	payload *SeasonPointsPolicyRequestPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardSeasonPointsPolicyResponseV1)
+
+	result, err := h.service.GetSeasonPointsPolicy(ctx, payload.GuildID, payload.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsFailure() {
+		return []handlerwrapper.Result{{
+			Topic:   LeaderboardSeasonPointsPolicyFailedV1,
+			Payload: &leaderboardevents.AdminFailedPayloadV1{GuildID: payload.GuildID, Reason: fmt.Sprintf("%v", *result.Failure)},
+		}}, nil
+	}
+
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &SeasonPointsPolicyResponsePayloadV1{
+			GuildID:  payload.GuildID,
+			SeasonID: payload.SeasonID,
+			Policy:   *result.Success,
+		},
+	}}, nil
+}
@@ -0,0 +1,111 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+)
+
+func TestLeaderboardHandlers_HandleStartSeasonWithPolicy(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	custom := leaderboarddomain.DefaultLinearPolicy()
+	custom.FirstPlacePoints = 250
+
+	tests := []struct {
+		name       string
+		payload    *StartSeasonWithPolicyPayloadV1
+		wantTopic  string
+		wantSystem leaderboarddomain.PointsSystem
+		wantFirst  int
+	}{
+		{
+			name:       "built-in system",
+			payload:    &StartSeasonWithPolicyPayloadV1{GuildID: guildID, SeasonID: "s1", SeasonName: "S1", System: leaderboarddomain.PointsSystemFieldScaled},
+			wantTopic:  leaderboardevents.LeaderboardStartNewSeasonSuccessV1,
+			wantSystem: leaderboarddomain.PointsSystemFieldScaled,
+		},
+		{
+			name:       "custom policy overrides system",
+			payload:    &StartSeasonWithPolicyPayloadV1{GuildID: guildID, SeasonID: "s1", SeasonName: "S1", System: leaderboarddomain.PointsSystemMatchup, Policy: &custom},
+			wantTopic:  leaderboardevents.LeaderboardStartNewSeasonSuccessV1,
+			wantSystem: leaderboarddomain.PointsSystemLinear,
+			wantFirst:  250,
+		},
+		{
+			name:      "unknown system",
+			payload:   &StartSeasonWithPolicyPayloadV1{GuildID: guildID, SeasonID: "s1", SeasonName: "S1", System: "elo"},
+			wantTopic: leaderboardevents.LeaderboardStartNewSeasonFailedV1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var started *leaderboarddomain.PointsPolicy
+			fakeSvc := NewFakeService()
+			fakeSvc.StartNewSeasonWithPolicyFunc = func(ctx context.Context, g sharedtypes.GuildID, sid, sname string, policy leaderboarddomain.PointsPolicy) (results.OperationResult[bool, error], error) {
+				started = &policy
+				return results.SuccessResult[bool, error](true), nil
+			}
+
+			h := &LeaderboardHandlers{service: fakeSvc, logger: slog.Default()}
+			got, err := h.HandleStartSeasonWithPolicy(context.Background(), tt.payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 1 || got[0].Topic != tt.wantTopic {
+				t.Fatalf("unexpected results: %+v", got)
+			}
+			if tt.wantSystem == "" {
+				if started != nil {
+					t.Fatal("expected service not to be called")
+				}
+				return
+			}
+			if started == nil || started.System != tt.wantSystem {
+				t.Fatalf("expected season started with %s, got %+v", tt.wantSystem, started)
+			}
+			if tt.wantFirst != 0 && started.FirstPlacePoints != tt.wantFirst {
+				t.Errorf("expected first place points %d, got %d", tt.wantFirst, started.FirstPlacePoints)
+			}
+		})
+	}
+}
+
+func TestLeaderboardHandlers_HandleSeasonPointsPolicyRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+
+	t.Run("returns policy", func(t *testing.T) {
+		h := &LeaderboardHandlers{service: NewFakeService(), logger: slog.Default()}
+		got, err := h.HandleSeasonPointsPolicyRequest(context.Background(), &SeasonPointsPolicyRequestPayloadV1{GuildID: guildID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) != 1 || got[0].Topic != LeaderboardSeasonPointsPolicyResponseV1 {
+			t.Fatalf("unexpected results: %+v", got)
+		}
+		if got[0].Payload.(*SeasonPointsPolicyResponsePayloadV1).Policy.System != leaderboarddomain.PointsSystemMatchup {
+			t.Errorf("expected default matchup policy")
+		}
+	})
+
+	t.Run("missing season", func(t *testing.T) {
+		fakeSvc := NewFakeService()
+		fakeSvc.GetSeasonPointsPolicyFunc = func(ctx context.Context, g sharedtypes.GuildID, sid string) (results.OperationResult[leaderboarddomain.PointsPolicy, error], error) {
+			return results.FailureResult[leaderboarddomain.PointsPolicy, error](leaderboardservice.ErrSeasonNotFound), nil
+		}
+		h := &LeaderboardHandlers{service: fakeSvc, logger: slog.Default()}
+		got, err := h.HandleSeasonPointsPolicyRequest(context.Background(), &SeasonPointsPolicyRequestPayloadV1{GuildID: guildID, SeasonID: "nope"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) != 1 || got[0].Topic != LeaderboardSeasonPointsPolicyFailedV1 {
+			t.Fatalf("unexpected results: %+v", got)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"fmt"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Promotion policy topics. The policy is applied when a season ends; the moves it makes
+// are announced on LeaderboardSeasonPromotionsAppliedV1 followed by the usual batch tag
+// assignment events for the seeded tags.
+const (
+	LeaderboardPromotionPolicySetRequestedV1 = "leaderboard.promotion.policy.set.requested.v1"
+	LeaderboardPromotionPolicyUpdatedV1      = "leaderboard.promotion.policy.updated.v1"
+	LeaderboardPromotionPolicyFailedV1       = "leaderboard.promotion.policy.failed.v1"
+	LeaderboardPromotionPolicyRequestV1      = "leaderboard.promotion.policy.request.v1"
+	LeaderboardPromotionPolicyResponseV1     = "leaderboard.promotion.policy.response.v1"
+	LeaderboardPromotionPreviewRequestedV1   = "leaderboard.promotion.preview.requested.v1"
+	LeaderboardPromotionPreviewResponseV1    = "leaderboard.promotion.preview.response.v1"
+	LeaderboardSeasonPromotionsAppliedV1     = "leaderboard.season.promotions.applied.v1"
+)
+
+// PromotionPolicySetRequestedPayloadV1 replaces a guild's promotion policy.
+type PromotionPolicySetRequestedPayloadV1 struct {
+	GuildID sharedtypes.GuildID               `json:"guild_id"`
+	Policy  leaderboarddomain.PromotionPolicy `json:"policy"`
+}
+
+// PromotionPolicyRequestPayloadV1 asks for a guild's promotion policy.
+type PromotionPolicyRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+}
+
+// PromotionPolicyPayloadV1 confirms or reports a guild's promotion policy.
+type PromotionPolicyPayloadV1 struct {
+	GuildID sharedtypes.GuildID               `json:"guild_id"`
+	Policy  leaderboarddomain.PromotionPolicy `json:"policy"`
+}
+
+// PromotionPreviewRequestedPayloadV1 asks who would change division if the active
+// season ended now. Policy, when set, is previewed instead of the stored policy.
+type PromotionPreviewRequestedPayloadV1 struct {
+	GuildID sharedtypes.GuildID                `json:"guild_id"`
+	Policy  *leaderboarddomain.PromotionPolicy `json:"policy,omitempty"`
+}
+
+// PromotionPreviewResponsePayloadV1 is the reply for LeaderboardPromotionPreviewRequestedV1.
+type PromotionPreviewResponsePayloadV1 struct {
+	GuildID  sharedtypes.GuildID               `json:"guild_id"`
+	SeasonID string                            `json:"season_id"`
+	Policy   leaderboarddomain.PromotionPolicy `json:"policy"`
+	Moves    []leaderboarddomain.PromotionMove `json:"moves"`
+}
+
+// SeasonPromotionsAppliedPayloadV1 announces the division moves made when a season ended.
+type SeasonPromotionsAppliedPayloadV1 struct {
+	GuildID  sharedtypes.GuildID               `json:"guild_id"`
+	SeasonID string                            `json:"season_id"`
+	Moves    []leaderboarddomain.PromotionMove `json:"moves"`
+}
+
+// HandlePromotionPolicySetRequested stores a guild's promotion policy.
+func (h *LeaderboardHandlers) HandlePromotionPolicySetRequested(
+	ctx context.Context,
+	payload *PromotionPolicySetRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.SetPromotionPolicy(ctx, payload.GuildID, payload.Policy)
+	if err != nil {
+		return promotionPolicyFailed(payload.GuildID, err.Error()), nil
+	}
+	if result.IsFailure() {
+		return promotionPolicyFailed(payload.GuildID, fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	return []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, LeaderboardPromotionPolicyUpdatedV1),
+		Payload: &PromotionPolicyPayloadV1{
+			GuildID: payload.GuildID,
+			Policy:  *result.Success,
+		},
+	}}, nil
+}
+
+// HandlePromotionPolicyRequest replies with a guild's promotion policy.
+func (h *LeaderboardHandlers) HandlePromotionPolicyRequest(
+	ctx context.Context,
+	payload *PromotionPolicyRequestPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.GetPromotionPolicy(ctx, payload.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsFailure() {
+		return promotionPolicyFailed(payload.GuildID, fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	return []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, LeaderboardPromotionPolicyResponseV1),
+		Payload: &PromotionPolicyPayloadV1{
+			GuildID: payload.GuildID,
+			Policy:  *result.Success,
+		},
+	}}, nil
+}
+
+// HandlePromotionPreviewRequested replies with the moves the active season would end with.
+func (h *LeaderboardHandlers) HandlePromotionPreviewRequested(
+	ctx context.Context,
+	payload *PromotionPreviewRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.PreviewPromotions(ctx, payload.GuildID, payload.Policy)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsFailure() {
+		return promotionPolicyFailed(payload.GuildID, fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	preview := *result.Success
+	return []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, LeaderboardPromotionPreviewResponseV1),
+		Payload: &PromotionPreviewResponsePayloadV1{
+			GuildID:  payload.GuildID,
+			SeasonID: preview.SeasonID,
+			Policy:   preview.Policy,
+			Moves:    preview.Moves,
+		},
+	}}, nil
+}
+
+// seasonPromotionResults announces the division moves made when a season ended and
+// the resulting tag changes. Every moved member lost their old tag, so members without
+// a seed tag are reported as untagged. Failing to load the moves only skips the
+// announcement; the season has already ended.
+func (h *LeaderboardHandlers) seasonPromotionResults(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) []handlerwrapper.Result {
+	result, err := h.service.GetSeasonPromotions(ctx, guildID, seasonID)
+	if err == nil && result.IsFailure() {
+		err = *result.Failure
+	}
+	if err != nil {
+		if h.logger != nil {
+			h.logger.WarnContext(ctx, "failed to load season promotions; skipping promotions event",
+				attr.String("guild_id", string(guildID)),
+				attr.String("season_id", seasonID),
+				attr.Error(err),
+			)
+		}
+		return nil
+	}
+	promotions := *result.Success
+	if len(promotions.Moves) == 0 {
+		return nil
+	}
+
+	changes := make([]sharedtypes.TagAssignmentRequest, len(promotions.Moves))
+	for i, move := range promotions.Moves {
+		changes[i] = sharedtypes.TagAssignmentRequest{
+			UserID:    sharedtypes.DiscordID(move.MemberID),
+			TagNumber: sharedtypes.TagNumber(move.SeedTag),
+		}
+	}
+	out := []handlerwrapper.Result{{
+		Topic: LeaderboardSeasonPromotionsAppliedV1,
+		Payload: &SeasonPromotionsAppliedPayloadV1{
+			GuildID:  guildID,
+			SeasonID: promotions.SeasonID,
+			Moves:    promotions.Moves,
+		},
+	}}
+	return append(out, h.mapSuccessResults(ctx, guildID, "", "", changes, leaderboardservice.ServiceUpdateSourceSeasonTransition, "")...)
+}
+
+func promotionPolicyFailed(guildID sharedtypes.GuildID, reason string) []handlerwrapper.Result {
+	return []handlerwrapper.Result{{
+		Topic:   LeaderboardPromotionPolicyFailedV1,
+		Payload: &leaderboardevents.AdminFailedPayloadV1{GuildID: guildID, Reason: reason},
+	}}
+}
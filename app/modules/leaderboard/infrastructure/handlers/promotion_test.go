@@ -0,0 +1,135 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlePromotionPolicySetRequested(t *testing.T) {
+	policy := leaderboarddomain.PromotionPolicy{
+		Enabled:    true,
+		Boundaries: []leaderboarddomain.PromotionBoundary{{UpperDivisionID: "pro", LowerDivisionID: "am", Count: 2}},
+	}
+
+	t.Run("confirms stored policy", func(t *testing.T) {
+		service := NewFakeService()
+		service.SetPromotionPolicyFunc = func(ctx context.Context, guildID sharedtypes.GuildID, p leaderboarddomain.PromotionPolicy) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error) {
+			assert.Equal(t, policy, p)
+			return results.SuccessResult[leaderboarddomain.PromotionPolicy, error](p), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandlePromotionPolicySetRequested(context.Background(), &PromotionPolicySetRequestedPayloadV1{GuildID: "guild-1", Policy: policy})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardPromotionPolicyUpdatedV1, res[0].Topic)
+		payload, ok := res[0].Payload.(*PromotionPolicyPayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, policy, payload.Policy)
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		service := NewFakeService()
+		service.SetPromotionPolicyFunc = func(ctx context.Context, guildID sharedtypes.GuildID, p leaderboarddomain.PromotionPolicy) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error) {
+			return results.FailureResult[leaderboarddomain.PromotionPolicy, error](leaderboarddomain.ErrInvalidPromotionPolicy), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandlePromotionPolicySetRequested(context.Background(), &PromotionPolicySetRequestedPayloadV1{GuildID: "guild-1", Policy: policy})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardPromotionPolicyFailedV1, res[0].Topic)
+		failed, ok := res[0].Payload.(*leaderboardevents.AdminFailedPayloadV1)
+		require.True(t, ok)
+		assert.Contains(t, failed.Reason, "invalid promotion policy")
+	})
+}
+
+func TestHandlePromotionPreviewRequested(t *testing.T) {
+	t.Run("replies with planned moves", func(t *testing.T) {
+		service := NewFakeService()
+		service.PreviewPromotionsFunc = func(ctx context.Context, guildID sharedtypes.GuildID, policy *leaderboarddomain.PromotionPolicy) (results.OperationResult[leaderboardservice.PromotionPreview, error], error) {
+			assert.Nil(t, policy)
+			return results.SuccessResult[leaderboardservice.PromotionPreview, error](leaderboardservice.PromotionPreview{
+				SeasonID: "s1",
+				Moves:    []leaderboarddomain.PromotionMove{{MemberID: "am", ToDivisionID: "pro", Promoted: true}},
+			}), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandlePromotionPreviewRequested(context.Background(), &PromotionPreviewRequestedPayloadV1{GuildID: "guild-1"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardPromotionPreviewResponseV1, res[0].Topic)
+		payload, ok := res[0].Payload.(*PromotionPreviewResponsePayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, "s1", payload.SeasonID)
+		require.Len(t, payload.Moves, 1)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		service := NewFakeService()
+		service.PreviewPromotionsFunc = func(ctx context.Context, guildID sharedtypes.GuildID, policy *leaderboarddomain.PromotionPolicy) (results.OperationResult[leaderboardservice.PromotionPreview, error], error) {
+			return results.OperationResult[leaderboardservice.PromotionPreview, error]{}, errors.New("db down")
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		_, err := h.HandlePromotionPreviewRequested(context.Background(), &PromotionPreviewRequestedPayloadV1{GuildID: "guild-1"})
+		require.Error(t, err)
+	})
+}
+
+func TestHandleEndSeason_AnnouncesPromotions(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-123")
+	service := NewFakeService()
+	service.EndSeasonFunc = func(ctx context.Context, gID sharedtypes.GuildID) (results.OperationResult[bool, error], error) {
+		return results.SuccessResult[bool, error](true), nil
+	}
+	service.GetSeasonArchiveFunc = func(ctx context.Context, gID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonArchive, error], error) {
+		return results.SuccessResult[leaderboardservice.SeasonArchive, error](testSeasonArchive(2)), nil
+	}
+	service.GetSeasonPromotionsFunc = func(ctx context.Context, gID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonPromotions, error], error) {
+		assert.Empty(t, seasonID, "the just-ended season is looked up as the latest")
+		return results.SuccessResult[leaderboardservice.SeasonPromotions, error](leaderboardservice.SeasonPromotions{
+			SeasonID: "2026-spring",
+			Moves: []leaderboarddomain.PromotionMove{
+				{MemberID: "am-top", ToDivisionID: "pro", Promoted: true, Tag: 1, SeedTag: 2},
+				{MemberID: "pro-low", FromDivisionID: "pro", Tag: 2},
+			},
+		}), nil
+	}
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+	res, err := h.HandleEndSeason(context.Background(), &leaderboardevents.EndSeasonPayloadV1{GuildID: guildID})
+	require.NoError(t, err)
+	require.Greater(t, len(res), 3)
+	assert.Equal(t, LeaderboardSeasonEndedV1, res[1].Topic)
+	assert.Equal(t, LeaderboardSeasonPromotionsAppliedV1, res[2].Topic)
+
+	applied, ok := res[2].Payload.(*SeasonPromotionsAppliedPayloadV1)
+	require.True(t, ok)
+	assert.Equal(t, "2026-spring", applied.SeasonID)
+	assert.Len(t, applied.Moves, 2)
+
+	newTags := map[sharedtypes.DiscordID]*sharedtypes.TagNumber{}
+	for _, r := range res {
+		if p, ok := r.Payload.(*leaderboardevents.LeaderboardTagUpdatedPayloadV1); ok {
+			newTags[p.UserID] = p.NewTag
+		}
+	}
+	require.Contains(t, newTags, sharedtypes.DiscordID("am-top"))
+	require.NotNil(t, newTags["am-top"])
+	assert.Equal(t, sharedtypes.TagNumber(2), *newTags["am-top"])
+	require.Contains(t, newTags, sharedtypes.DiscordID("pro-low"))
+	assert.Nil(t, newTags["pro-low"], "relegated member without a seed tag is untagged")
+}
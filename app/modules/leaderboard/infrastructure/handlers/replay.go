@@ -0,0 +1,113 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"fmt"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Season replay topics. A replay rebuilds a guild's tags, points and standings from its
+// processed rounds; DryRun reports the differences without writing them.
+const (
+	LeaderboardReplayRequestedV1 = "leaderboard.replay.requested.v1"
+	LeaderboardReplayCompletedV1 = "leaderboard.replay.completed.v1"
+	LeaderboardReplayFailedV1    = "leaderboard.replay.failed.v1"
+)
+
+// ReplayRequestedPayloadV1 asks for a guild replay.
+type ReplayRequestedPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+	DryRun  bool                `json:"dry_run"`
+}
+
+// ReplayTagDiffV1 is a member whose tag changes under replay. A tag of 0 means no tag.
+type ReplayTagDiffV1 struct {
+	MemberID    sharedtypes.DiscordID `json:"member_id"`
+	CurrentTag  int                   `json:"current_tag"`
+	ReplayedTag int                   `json:"replayed_tag"`
+}
+
+// ReplayStandingDiffV1 is a season standing that changes under replay.
+type ReplayStandingDiffV1 struct {
+	SeasonID       string                `json:"season_id"`
+	MemberID       sharedtypes.DiscordID `json:"member_id"`
+	CurrentPoints  int                   `json:"current_points"`
+	ReplayedPoints int                   `json:"replayed_points"`
+	CurrentRounds  int                   `json:"current_rounds"`
+	ReplayedRounds int                   `json:"replayed_rounds"`
+}
+
+// ReplayCompletedPayloadV1 reports a replay. When DryRun is set nothing was written.
+type ReplayCompletedPayloadV1 struct {
+	GuildID        sharedtypes.GuildID    `json:"guild_id"`
+	DryRun         bool                   `json:"dry_run"`
+	RoundsReplayed int                    `json:"rounds_replayed"`
+	RoundsVerbatim int                    `json:"rounds_verbatim"`
+	TagDiffs       []ReplayTagDiffV1      `json:"tag_diffs"`
+	StandingDiffs  []ReplayStandingDiffV1 `json:"standing_diffs"`
+}
+
+// HandleReplayRequested replays a guild's season history, optionally as a dry run.
+func (h *LeaderboardHandlers) HandleReplayRequested(
+	ctx context.Context,
+	payload *ReplayRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("payload is nil")
+	}
+	fail := func(reason string) []handlerwrapper.Result {
+		return []handlerwrapper.Result{{
+			Topic:   LeaderboardReplayFailedV1,
+			Payload: &leaderboardevents.AdminFailedPayloadV1{GuildID: payload.GuildID, Reason: reason},
+		}}
+	}
+
+	result, err := h.service.ReplayGuild(ctx, payload.GuildID, payload.DryRun)
+	if err != nil {
+		return fail(err.Error()), nil
+	}
+	if result.IsFailure() {
+		return fail(fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardReplayCompletedV1)
+
+	return []handlerwrapper.Result{{
+		Topic:   topic,
+		Payload: toReplayCompletedPayload(payload.GuildID, *result.Success),
+	}}, nil
+}
+
+func toReplayCompletedPayload(guildID sharedtypes.GuildID, r leaderboardservice.ReplayResult) *ReplayCompletedPayloadV1 {
+	out := &ReplayCompletedPayloadV1{
+		GuildID:        guildID,
+		DryRun:         r.DryRun,
+		RoundsReplayed: r.RoundsReplayed,
+		RoundsVerbatim: r.RoundsVerbatim,
+		TagDiffs:       make([]ReplayTagDiffV1, len(r.TagDiffs)),
+		StandingDiffs:  make([]ReplayStandingDiffV1, len(r.StandingDiffs)),
+	}
+	for i, d := range r.TagDiffs {
+		out.TagDiffs[i] = ReplayTagDiffV1{
+			MemberID:    sharedtypes.DiscordID(d.MemberID),
+			CurrentTag:  d.CurrentTag,
+			ReplayedTag: d.ReplayedTag,
+		}
+	}
+	for i, d := range r.StandingDiffs {
+		out.StandingDiffs[i] = ReplayStandingDiffV1{
+			SeasonID:       d.SeasonID,
+			MemberID:       sharedtypes.DiscordID(d.MemberID),
+			CurrentPoints:  d.CurrentPoints,
+			ReplayedPoints: d.ReplayedPoints,
+			CurrentRounds:  d.CurrentRounds,
+			ReplayedRounds: d.ReplayedRounds,
+		}
+	}
+	return out
+}
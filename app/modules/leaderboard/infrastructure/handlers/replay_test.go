@@ -0,0 +1,80 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+)
+
+func TestLeaderboardHandlers_HandleReplayRequested(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		replay    func(ctx context.Context, g sharedtypes.GuildID, dryRun bool) (results.OperationResult[leaderboardservice.ReplayResult, error], error)
+		wantTopic string
+	}{
+		{
+			name: "dry run reports diff",
+			ctx:  context.Background(),
+			replay: func(ctx context.Context, g sharedtypes.GuildID, dryRun bool) (results.OperationResult[leaderboardservice.ReplayResult, error], error) {
+				return results.SuccessResult[leaderboardservice.ReplayResult, error](leaderboardservice.ReplayResult{
+					GuildID:        string(g),
+					DryRun:         dryRun,
+					RoundsReplayed: 2,
+					TagDiffs:       []leaderboardservice.ReplayTagDiff{{MemberID: "bob", CurrentTag: 1, ReplayedTag: 2}},
+				}), nil
+			},
+			wantTopic: LeaderboardReplayCompletedV1,
+		},
+		{
+			name: "reply-to overrides completed topic",
+			ctx:  context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.replay"),
+			replay: func(ctx context.Context, g sharedtypes.GuildID, dryRun bool) (results.OperationResult[leaderboardservice.ReplayResult, error], error) {
+				return results.SuccessResult[leaderboardservice.ReplayResult, error](leaderboardservice.ReplayResult{DryRun: dryRun}), nil
+			},
+			wantTopic: "_INBOX.replay",
+		},
+		{
+			name: "service error",
+			ctx:  context.Background(),
+			replay: func(ctx context.Context, g sharedtypes.GuildID, dryRun bool) (results.OperationResult[leaderboardservice.ReplayResult, error], error) {
+				return results.OperationResult[leaderboardservice.ReplayResult, error]{}, errors.New("db down")
+			},
+			wantTopic: LeaderboardReplayFailedV1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSvc := NewFakeService()
+			fakeSvc.ReplayGuildFunc = tt.replay
+
+			h := &LeaderboardHandlers{service: fakeSvc, logger: slog.Default()}
+			got, err := h.HandleReplayRequested(tt.ctx, &ReplayRequestedPayloadV1{GuildID: guildID, DryRun: true})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 1 || got[0].Topic != tt.wantTopic {
+				t.Fatalf("unexpected results: %+v", got)
+			}
+			if tt.wantTopic == LeaderboardReplayFailedV1 {
+				return
+			}
+			payload, ok := got[0].Payload.(*ReplayCompletedPayloadV1)
+			if !ok {
+				t.Fatalf("unexpected payload type %T", got[0].Payload)
+			}
+			if !payload.DryRun || payload.GuildID != guildID {
+				t.Fatalf("unexpected payload: %+v", payload)
+			}
+		})
+	}
+}
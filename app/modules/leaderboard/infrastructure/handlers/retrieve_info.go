@@ -6,6 +6,7 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
 	sharedevents "github.com/Black-And-White-Club/frolf-bot-shared/events/shared"
@@ -14,6 +15,7 @@ import (
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	usertypes "github.com/Black-And-White-Club/frolf-bot-shared/types/user"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
 )
 
 // HandleGetLeaderboardRequest returns the full current state.
@@ -41,21 +43,7 @@ func (h *LeaderboardHandlers) HandleGetLeaderboardRequest(
 		}}, nil
 	}
 	leaderboard := *result.Success
-
-	userIDs := leaderboardProfileLookupIDs(leaderboard)
-
-	// Lookup profiles
-	profiles := make(map[sharedtypes.DiscordID]*usertypes.UserProfile)
-	var syncRequests []*userevents.UserProfileSyncRequestPayloadV1
-
-	if len(userIDs) > 0 {
-		profileResult, _ := h.userService.LookupProfiles(ctx, userIDs, payload.GuildID)
-		if profileResult.IsSuccess() {
-			resp := profileResult.Success
-			profiles = (*resp).Profiles
-			syncRequests = (*resp).SyncRequests
-		}
-	}
+	profiles, syncResults := h.lookupLeaderboardProfiles(ctx, payload.GuildID, leaderboard)
 
 	resp := &leaderboardevents.GetLeaderboardResponsePayloadV1{
 		GuildID:     payload.GuildID,
@@ -72,16 +60,98 @@ func (h *LeaderboardHandlers) HandleGetLeaderboardRequest(
 		h.logger.WarnContext(ctx, "leaderboard: no reply_to in context, publishing to static topic", "topic", topic, "leaderboard_count", len(leaderboard))
 	}
 
-	results := []handlerwrapper.Result{{Topic: topic, Payload: resp}}
+	return append([]handlerwrapper.Result{{Topic: topic, Payload: resp}}, syncResults...), nil
+}
+
+// LeaderboardSnapshotRequestPayloadV2 is the request on "leaderboard.snapshot.request.v2.>".
+// Without AsOf or RoundID it asks for the current leaderboard; with one of them it asks
+// for the leaderboard as it stood at that time or right after that round, limited to
+// DivisionID when it is set ("" is the default division).
+type LeaderboardSnapshotRequestPayloadV2 struct {
+	leaderboardevents.GetLeaderboardRequestedPayloadV1
+	AsOf       *time.Time           `json:"as_of,omitempty"`
+	RoundID    *sharedtypes.RoundID `json:"round_id,omitempty"`
+	DivisionID *string              `json:"division_id,omitempty"`
+}
+
+// LeaderboardSnapshotResponsePayloadV2 is the reply to a point-in-time snapshot request.
+// SeasonID is the season the points come from.
+type LeaderboardSnapshotResponsePayloadV2 struct {
+	leaderboardevents.GetLeaderboardResponsePayloadV1
+	SeasonID   string               `json:"season_id,omitempty"`
+	AsOf       time.Time            `json:"as_of"`
+	RoundID    *sharedtypes.RoundID `json:"round_id,omitempty"`
+	DivisionID *string              `json:"division_id,omitempty"`
+}
 
-	for _, syncReq := range syncRequests {
-		results = append(results, handlerwrapper.Result{
+// HandleLeaderboardSnapshotRequest returns the current leaderboard, or a past one when
+// the request names a time or round.
+func (h *LeaderboardHandlers) HandleLeaderboardSnapshotRequest(
+	ctx context.Context,
+	payload *LeaderboardSnapshotRequestPayloadV2,
+) ([]handlerwrapper.Result, error) {
+	request := payload.GetLeaderboardRequestedPayloadV1
+	if payload.AsOf == nil && payload.RoundID == nil {
+		return h.HandleGetLeaderboardRequest(ctx, &request)
+	}
+
+	result, err := h.service.GetLeaderboardAt(ctx, request.GuildID, payload.DivisionID, request.SeasonID, payload.AsOf, payload.RoundID)
+	if err == nil && result.IsFailure() {
+		err = *result.Failure
+	}
+	if err != nil {
+		return []handlerwrapper.Result{{
+			Topic: leaderboardevents.GetLeaderboardFailedV1,
+			Payload: &leaderboardevents.GetLeaderboardFailedPayloadV1{
+				GuildID: request.GuildID,
+				Reason:  err.Error(),
+			},
+		}}, nil
+	}
+	snapshot := *result.Success
+	profiles, syncResults := h.lookupLeaderboardProfiles(ctx, request.GuildID, snapshot.Entries)
+
+	resp := &LeaderboardSnapshotResponsePayloadV2{
+		GetLeaderboardResponsePayloadV1: leaderboardevents.GetLeaderboardResponsePayloadV1{
+			GuildID:     request.GuildID,
+			Leaderboard: snapshot.Entries,
+			Profiles:    profiles,
+		},
+		SeasonID:   snapshot.SeasonID,
+		AsOf:       snapshot.AsOf,
+		RoundID:    payload.RoundID,
+		DivisionID: payload.DivisionID,
+	}
+	topic := handlerutil.ReplyTopic(ctx, leaderboardevents.GetLeaderboardResponseV1)
+	return append([]handlerwrapper.Result{{Topic: topic, Payload: resp}}, syncResults...), nil
+}
+
+// lookupLeaderboardProfiles resolves the profiles shown next to leaderboard entries and
+// the profile sync requests the lookup asked for. A failed lookup leaves profiles empty.
+func (h *LeaderboardHandlers) lookupLeaderboardProfiles(
+	ctx context.Context,
+	guildID sharedtypes.GuildID,
+	leaderboard leaderboardtypes.LeaderboardData,
+) (map[sharedtypes.DiscordID]*usertypes.UserProfile, []handlerwrapper.Result) {
+	profiles := make(map[sharedtypes.DiscordID]*usertypes.UserProfile)
+	userIDs := leaderboardProfileLookupIDs(leaderboard)
+	if len(userIDs) == 0 {
+		return profiles, nil
+	}
+
+	profileResult, _ := h.userService.LookupProfiles(ctx, userIDs, guildID)
+	if !profileResult.IsSuccess() {
+		return profiles, nil
+	}
+	resp := *profileResult.Success
+	var syncResults []handlerwrapper.Result
+	for _, syncReq := range resp.SyncRequests {
+		syncResults = append(syncResults, handlerwrapper.Result{
 			Topic:   userevents.UserProfileSyncRequestTopicV1,
 			Payload: syncReq,
 		})
 	}
-
-	return results, nil
+	return resp.Profiles, syncResults
 }
 
 func leaderboardProfileLookupIDs(leaderboard leaderboardtypes.LeaderboardData) []sharedtypes.DiscordID {
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"slices"
 	"testing"
+	"time"
 
 	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
 	sharedevents "github.com/Black-And-White-Club/frolf-bot-shared/events/shared"
@@ -15,6 +16,7 @@ import (
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	usertypes "github.com/Black-And-White-Club/frolf-bot-shared/types/user"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
 	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
 	"github.com/google/uuid"
 )
@@ -127,6 +129,106 @@ func TestLeaderboardHandlers_HandleGetLeaderboardRequest_ProfileLookupUsesTagFal
 	}
 }
 
+func TestLeaderboardHandlers_HandleLeaderboardSnapshotRequest(t *testing.T) {
+	testGuildID := sharedtypes.GuildID("test-guild-123")
+	asOf := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	roundID := sharedtypes.RoundID(uuid.New())
+	proDivision := "pro"
+
+	tests := []struct {
+		name      string
+		payload   *LeaderboardSnapshotRequestPayloadV2
+		setupFake func(t *testing.T, f *FakeService)
+		wantTopic string
+		wantCalls []string
+	}{
+		{
+			name:      "No point in time returns current leaderboard",
+			payload:   &LeaderboardSnapshotRequestPayloadV2{GetLeaderboardRequestedPayloadV1: leaderboardevents.GetLeaderboardRequestedPayloadV1{GuildID: testGuildID}},
+			setupFake: func(t *testing.T, f *FakeService) {},
+			wantTopic: leaderboardevents.GetLeaderboardResponseV1,
+			wantCalls: []string{"GetLeaderboard"},
+		},
+		{
+			name: "As-of time returns past leaderboard",
+			payload: &LeaderboardSnapshotRequestPayloadV2{
+				GetLeaderboardRequestedPayloadV1: leaderboardevents.GetLeaderboardRequestedPayloadV1{GuildID: testGuildID},
+				AsOf:                             &asOf,
+			},
+			setupFake: func(t *testing.T, f *FakeService) {
+				f.GetLeaderboardAtFunc = func(ctx context.Context, guildID sharedtypes.GuildID, divisionID *string, seasonID string, gotAsOf *time.Time, gotRound *sharedtypes.RoundID) (results.OperationResult[leaderboardservice.LeaderboardSnapshot, error], error) {
+					if gotAsOf == nil || !gotAsOf.Equal(asOf) || gotRound != nil {
+						t.Fatalf("unexpected point in time %v, %v", gotAsOf, gotRound)
+					}
+					return results.SuccessResult[leaderboardservice.LeaderboardSnapshot, error](leaderboardservice.LeaderboardSnapshot{
+						AsOf:     asOf,
+						SeasonID: "2026-spring",
+						Entries:  []leaderboardtypes.LeaderboardEntry{{UserID: "user-1", TagNumber: 1}},
+					}), nil
+				}
+			},
+			wantTopic: leaderboardevents.GetLeaderboardResponseV1,
+			wantCalls: []string{"GetLeaderboardAt"},
+		},
+		{
+			name: "Division is passed through",
+			payload: &LeaderboardSnapshotRequestPayloadV2{
+				GetLeaderboardRequestedPayloadV1: leaderboardevents.GetLeaderboardRequestedPayloadV1{GuildID: testGuildID},
+				RoundID:                          &roundID,
+				DivisionID:                       &proDivision,
+			},
+			setupFake: func(t *testing.T, f *FakeService) {
+				f.GetLeaderboardAtFunc = func(ctx context.Context, guildID sharedtypes.GuildID, divisionID *string, seasonID string, gotAsOf *time.Time, gotRound *sharedtypes.RoundID) (results.OperationResult[leaderboardservice.LeaderboardSnapshot, error], error) {
+					if divisionID == nil || *divisionID != proDivision {
+						t.Fatalf("expected division %q, got %v", proDivision, divisionID)
+					}
+					return results.SuccessResult[leaderboardservice.LeaderboardSnapshot, error](leaderboardservice.LeaderboardSnapshot{AsOf: asOf}), nil
+				}
+			},
+			wantTopic: leaderboardevents.GetLeaderboardResponseV1,
+			wantCalls: []string{"GetLeaderboardAt"},
+		},
+		{
+			name: "Unprocessed round returns Failed event",
+			payload: &LeaderboardSnapshotRequestPayloadV2{
+				GetLeaderboardRequestedPayloadV1: leaderboardevents.GetLeaderboardRequestedPayloadV1{GuildID: testGuildID},
+				RoundID:                          &roundID,
+			},
+			setupFake: func(t *testing.T, f *FakeService) {
+				f.GetLeaderboardAtFunc = func(ctx context.Context, guildID sharedtypes.GuildID, divisionID *string, seasonID string, gotAsOf *time.Time, gotRound *sharedtypes.RoundID) (results.OperationResult[leaderboardservice.LeaderboardSnapshot, error], error) {
+					return results.FailureResult[leaderboardservice.LeaderboardSnapshot, error](leaderboardservice.ErrRoundNotProcessed), nil
+				}
+			},
+			wantTopic: leaderboardevents.GetLeaderboardFailedV1,
+			wantCalls: []string{"GetLeaderboardAt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSvc := NewFakeService()
+			tt.setupFake(t, fakeSvc)
+			h := &LeaderboardHandlers{service: fakeSvc, userService: NewFakeUserService(), logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+
+			res, err := h.HandleLeaderboardSnapshotRequest(context.Background(), tt.payload)
+			if err != nil {
+				t.Fatalf("HandleLeaderboardSnapshotRequest() error = %v", err)
+			}
+			if len(res) != 1 || res[0].Topic != tt.wantTopic {
+				t.Fatalf("results = %+v, want one on %s", res, tt.wantTopic)
+			}
+			if !slices.Equal(fakeSvc.Trace(), tt.wantCalls) {
+				t.Fatalf("calls = %v, want %v", fakeSvc.Trace(), tt.wantCalls)
+			}
+			if resp, ok := res[0].Payload.(*LeaderboardSnapshotResponsePayloadV2); ok {
+				if resp.SeasonID != "2026-spring" || !resp.AsOf.Equal(asOf) || len(resp.GetLeaderboardResponsePayloadV1.Leaderboard) != 1 {
+					t.Fatalf("unexpected snapshot response %+v", resp)
+				}
+			}
+		})
+	}
+}
+
 func TestLeaderboardHandlers_HandleGetTagByUserIDRequest(t *testing.T) {
 	testGuildID := sharedtypes.GuildID("test-guild-123")
 	testUserID := sharedtypes.DiscordID("user-456")
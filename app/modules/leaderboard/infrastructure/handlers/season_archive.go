@@ -0,0 +1,108 @@
+package leaderboardhandlers
+
+import (
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+)
+
+// LeaderboardSeasonEndedV1 announces an ended season's final standings and awards.
+const LeaderboardSeasonEndedV1 = "leaderboard.season.ended.v1"
+
+// seasonEndedTopStandings caps how many final standings the ended event carries; the
+// full table is available through the season standings request.
+const seasonEndedTopStandings = 10
+
+// SeasonAwardV1 is an award granted when a season ended.
+type SeasonAwardV1 struct {
+	Award    string                `json:"award"` // points_champion|most_improved_tag|most_rounds_played|giant_slayer
+	MemberID sharedtypes.DiscordID `json:"member_id"`
+	Value    int                   `json:"value"`
+}
+
+// ArchivedStandingItemV1 is a member's final standing in an ended season.
+type ArchivedStandingItemV1 struct {
+	FinalRank     int                   `json:"final_rank"`
+	MemberID      sharedtypes.DiscordID `json:"member_id"`
+	TotalPoints   int                   `json:"total_points"`
+	CurrentTier   string                `json:"current_tier"`
+	SeasonBestTag int                   `json:"season_best_tag"`
+	RoundsPlayed  int                   `json:"rounds_played"`
+}
+
+// SeasonEndedPayloadV1 summarises an ended season for announcement.
+type SeasonEndedPayloadV1 struct {
+	GuildID      sharedtypes.GuildID      `json:"guild_id"`
+	SeasonID     string                   `json:"season_id"`
+	SeasonName   string                   `json:"season_name"`
+	EndedAt      time.Time                `json:"ended_at"`
+	TopStandings []ArchivedStandingItemV1 `json:"top_standings"`
+	MemberCount  int                      `json:"member_count"`
+	Awards       []SeasonAwardV1          `json:"awards"`
+}
+
+// ArchivedSeasonStandingsResponsePayloadV1 answers a season standings request for an
+// ended season from its archive. It shares its field names with the live standings
+// response and adds the final rank, end time and awards.
+type ArchivedSeasonStandingsResponsePayloadV1 struct {
+	GuildID    sharedtypes.GuildID      `json:"guild_id"`
+	SeasonID   string                   `json:"season_id"`
+	SeasonName string                   `json:"season_name"`
+	Standings  []ArchivedStandingItemV1 `json:"standings"`
+	Archived   bool                     `json:"archived"`
+	EndedAt    time.Time                `json:"ended_at"`
+	Awards     []SeasonAwardV1          `json:"awards"`
+}
+
+func toArchivedStandingItems(archive leaderboardservice.SeasonArchive) []ArchivedStandingItemV1 {
+	items := make([]ArchivedStandingItemV1, len(archive.Standings))
+	for i, st := range archive.Standings {
+		items[i] = ArchivedStandingItemV1{
+			FinalRank:     st.Rank,
+			MemberID:      sharedtypes.DiscordID(st.MemberID),
+			TotalPoints:   st.TotalPoints,
+			CurrentTier:   st.CurrentTier,
+			SeasonBestTag: st.SeasonBestTag,
+			RoundsPlayed:  st.RoundsPlayed,
+		}
+	}
+	return items
+}
+
+func toSeasonAwards(archive leaderboardservice.SeasonArchive) []SeasonAwardV1 {
+	awards := make([]SeasonAwardV1, len(archive.Awards))
+	for i, a := range archive.Awards {
+		awards[i] = SeasonAwardV1{
+			Award:    string(a.Kind),
+			MemberID: sharedtypes.DiscordID(a.MemberID),
+			Value:    a.Value,
+		}
+	}
+	return awards
+}
+
+func toSeasonEndedPayload(guildID sharedtypes.GuildID, archive leaderboardservice.SeasonArchive) *SeasonEndedPayloadV1 {
+	standings := toArchivedStandingItems(archive)
+	return &SeasonEndedPayloadV1{
+		GuildID:      guildID,
+		SeasonID:     archive.SeasonID,
+		SeasonName:   archive.SeasonName,
+		EndedAt:      archive.EndedAt,
+		TopStandings: standings[:min(len(standings), seasonEndedTopStandings)],
+		MemberCount:  len(standings),
+		Awards:       toSeasonAwards(archive),
+	}
+}
+
+func toArchivedStandingsResponse(guildID sharedtypes.GuildID, archive leaderboardservice.SeasonArchive) *ArchivedSeasonStandingsResponsePayloadV1 {
+	return &ArchivedSeasonStandingsResponsePayloadV1{
+		GuildID:    guildID,
+		SeasonID:   archive.SeasonID,
+		SeasonName: archive.SeasonName,
+		Standings:  toArchivedStandingItems(archive),
+		Archived:   true,
+		EndedAt:    archive.EndedAt,
+		Awards:     toSeasonAwards(archive),
+	}
+}
@@ -0,0 +1,174 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSeasonArchive(members int) leaderboardservice.SeasonArchive {
+	archive := leaderboardservice.SeasonArchive{
+		SeasonID:   "2026-spring",
+		SeasonName: "Spring 2026",
+		EndedAt:    time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+		Awards: []leaderboarddomain.SeasonAward{
+			{Kind: leaderboarddomain.AwardPointsChampion, MemberID: "member-1", Value: 900},
+		},
+	}
+	for i := range members {
+		archive.Standings = append(archive.Standings, leaderboarddomain.FinalStanding{
+			MemberID:    "member-" + string(rune('a'+i)),
+			Rank:        i + 1,
+			TotalPoints: 900 - i*10,
+		})
+	}
+	return archive
+}
+
+func TestHandleEndSeason_PublishesSeasonEnded(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-123")
+	service := NewFakeService()
+	service.EndSeasonFunc = func(ctx context.Context, gID sharedtypes.GuildID) (results.OperationResult[bool, error], error) {
+		return results.SuccessResult[bool, error](true), nil
+	}
+	service.GetSeasonArchiveFunc = func(ctx context.Context, gID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonArchive, error], error) {
+		assert.Empty(t, seasonID, "the just-ended season is looked up as the latest")
+		return results.SuccessResult[leaderboardservice.SeasonArchive, error](testSeasonArchive(12)), nil
+	}
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+	res, err := h.HandleEndSeason(context.Background(), &leaderboardevents.EndSeasonPayloadV1{GuildID: guildID})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, leaderboardevents.LeaderboardEndSeasonSuccessV1, res[0].Topic)
+	assert.Equal(t, LeaderboardSeasonEndedV1, res[1].Topic)
+
+	ended, ok := res[1].Payload.(*SeasonEndedPayloadV1)
+	require.True(t, ok)
+	assert.Equal(t, guildID, ended.GuildID)
+	assert.Equal(t, "2026-spring", ended.SeasonID)
+	assert.Len(t, ended.TopStandings, seasonEndedTopStandings)
+	assert.Equal(t, 12, ended.MemberCount)
+	require.Len(t, ended.Awards, 1)
+	assert.Equal(t, "points_champion", ended.Awards[0].Award)
+}
+
+func TestHandleEndSeason_NoActiveSeasonIsSilent(t *testing.T) {
+	service := NewFakeService()
+	service.EndSeasonFunc = func(ctx context.Context, gID sharedtypes.GuildID) (results.OperationResult[bool, error], error) {
+		return results.SuccessResult[bool, error](false), nil
+	}
+	service.GetSeasonArchiveFunc = func(ctx context.Context, gID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonArchive, error], error) {
+		t.Fatal("an older archive must not be announced again")
+		return results.OperationResult[leaderboardservice.SeasonArchive, error]{}, nil
+	}
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+	res, err := h.HandleEndSeason(context.Background(), &leaderboardevents.EndSeasonPayloadV1{GuildID: "guild-123"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, leaderboardevents.LeaderboardEndSeasonSuccessV1, res[0].Topic)
+}
+
+func TestHandleStartNewSeason_PublishesClosedSeason(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-123")
+	activeSeasons := func(ctx context.Context, gID sharedtypes.GuildID) (results.OperationResult[[]leaderboardservice.SeasonInfo, error], error) {
+		return results.SuccessResult[[]leaderboardservice.SeasonInfo, error]([]leaderboardservice.SeasonInfo{
+			{ID: "2026-spring", IsActive: true},
+			{ID: "2025-fall"},
+		}), nil
+	}
+	archive := func(ctx context.Context, gID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonArchive, error], error) {
+		assert.Equal(t, "2026-spring", seasonID, "the season active before the start is announced")
+		return results.SuccessResult[leaderboardservice.SeasonArchive, error](testSeasonArchive(2)), nil
+	}
+
+	t.Run("start new season", func(t *testing.T) {
+		service := NewFakeService()
+		service.ListSeasonsFunc = activeSeasons
+		service.GetSeasonArchiveFunc = archive
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleStartNewSeason(context.Background(), &leaderboardevents.StartNewSeasonPayloadV1{GuildID: guildID, SeasonID: "2026-summer", SeasonName: "Summer 2026"})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, leaderboardevents.LeaderboardStartNewSeasonSuccessV1, res[0].Topic)
+		assert.Equal(t, LeaderboardSeasonEndedV1, res[1].Topic)
+	})
+
+	t.Run("start season with policy", func(t *testing.T) {
+		service := NewFakeService()
+		service.ListSeasonsFunc = activeSeasons
+		service.GetSeasonArchiveFunc = archive
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleStartSeasonWithPolicy(context.Background(), &StartSeasonWithPolicyPayloadV1{GuildID: guildID, SeasonID: "2026-summer", SeasonName: "Summer 2026", System: leaderboarddomain.PointsSystemMatchup})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, leaderboardevents.LeaderboardStartNewSeasonSuccessV1, res[0].Topic)
+		assert.Equal(t, LeaderboardSeasonEndedV1, res[1].Topic)
+	})
+
+	t.Run("no previous season", func(t *testing.T) {
+		service := NewFakeService()
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleStartNewSeason(context.Background(), &leaderboardevents.StartNewSeasonPayloadV1{GuildID: guildID, SeasonID: "2026-summer", SeasonName: "Summer 2026"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+	})
+}
+
+func TestHandleSeasonStandingsRequest_ArchivedSeason(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-123")
+	service := NewFakeService()
+	service.GetSeasonArchiveFunc = func(ctx context.Context, gID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonArchive, error], error) {
+		assert.Equal(t, "2026-spring", seasonID)
+		return results.SuccessResult[leaderboardservice.SeasonArchive, error](testSeasonArchive(2)), nil
+	}
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+	ctx := context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.abc")
+	res, err := h.HandleSeasonStandingsRequest(ctx, &leaderboardevents.SeasonStandingsRequestPayloadV1{GuildID: guildID, SeasonID: "2026-spring"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "_INBOX.abc", res[0].Topic)
+
+	resp, ok := res[0].Payload.(*ArchivedSeasonStandingsResponsePayloadV1)
+	require.True(t, ok)
+	assert.True(t, resp.Archived)
+	require.Len(t, resp.Standings, 2)
+	assert.Equal(t, 1, resp.Standings[0].FinalRank)
+	assert.Len(t, resp.Awards, 1)
+	assert.NotContains(t, service.trace, "GetSeasonStandingsForSeason")
+}
+
+func TestHandleSeasonStandingsRequest_ActiveSeasonUsesLiveStandings(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-123")
+	service := NewFakeService()
+	service.GetSeasonStandingsForSeasonFunc = func(ctx context.Context, gID sharedtypes.GuildID, seasonID string) (results.OperationResult[[]leaderboardservice.SeasonStandingEntry, error], error) {
+		return results.SuccessResult[[]leaderboardservice.SeasonStandingEntry, error]([]leaderboardservice.SeasonStandingEntry{
+			{MemberID: "member-1", SeasonID: seasonID, TotalPoints: 100},
+		}), nil
+	}
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+	res, err := h.HandleSeasonStandingsRequest(context.Background(), &leaderboardevents.SeasonStandingsRequestPayloadV1{GuildID: guildID, SeasonID: "2026-summer"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, leaderboardevents.LeaderboardSeasonStandingsResponseV1, res[0].Topic)
+
+	resp, ok := res[0].Payload.(*leaderboardevents.SeasonStandingsResponsePayloadV1)
+	require.True(t, ok)
+	assert.Len(t, resp.Standings, 1)
+}
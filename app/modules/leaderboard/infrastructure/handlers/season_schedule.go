@@ -0,0 +1,146 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	leaderboardqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/queue"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Season scheduling topics. Scheduled seasons are started and ended by the season
+// schedule tick, which announces each change on the shared start-season success topic
+// and on LeaderboardSeasonEndedV1.
+const (
+	LeaderboardSeasonScheduleRequestedV1 = "leaderboard.season.schedule.requested.v1"
+	LeaderboardSeasonScheduledV1         = "leaderboard.season.scheduled.v1"
+	LeaderboardSeasonScheduleFailedV1    = "leaderboard.season.schedule.failed.v1"
+)
+
+// SeasonScheduleRequestedPayloadV1 schedules a season to start, and optionally end, at
+// wall-clock times in Timezone (an IANA name such as "America/Chicago"; empty means UTC).
+// Dates are "YYYY-MM-DD" (midnight) or "YYYY-MM-DD HH:MM". The points policy is chosen
+// as for StartSeasonWithPolicyPayloadV1.
+type SeasonScheduleRequestedPayloadV1 struct {
+	GuildID    sharedtypes.GuildID             `json:"guild_id"`
+	SeasonID   string                          `json:"season_id"`
+	SeasonName string                          `json:"season_name"`
+	StartDate  string                          `json:"start_date"`
+	EndDate    string                          `json:"end_date,omitempty"`
+	Timezone   string                          `json:"timezone,omitempty"`
+	System     leaderboarddomain.PointsSystem  `json:"system,omitempty"`
+	Policy     *leaderboarddomain.PointsPolicy `json:"policy,omitempty"`
+}
+
+// SeasonScheduledPayloadV1 confirms a scheduled season.
+type SeasonScheduledPayloadV1 struct {
+	GuildID    sharedtypes.GuildID `json:"guild_id"`
+	SeasonID   string              `json:"season_id"`
+	SeasonName string              `json:"season_name"`
+	StartDate  string              `json:"start_date"`
+	EndDate    *string             `json:"end_date,omitempty"`
+	Timezone   string              `json:"timezone"`
+}
+
+// HandleSeasonScheduleRequested schedules a future season.
+func (h *LeaderboardHandlers) HandleSeasonScheduleRequested(
+	ctx context.Context,
+	payload *SeasonScheduleRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	fail := func(reason string) []handlerwrapper.Result {
+		return []handlerwrapper.Result{{
+			Topic:   LeaderboardSeasonScheduleFailedV1,
+			Payload: &leaderboardevents.AdminFailedPayloadV1{GuildID: payload.GuildID, Reason: reason},
+		}}
+	}
+
+	schedule := leaderboardservice.SeasonSchedule{
+		SeasonID:   payload.SeasonID,
+		SeasonName: payload.SeasonName,
+		Timezone:   payload.Timezone,
+	}
+	var err error
+	if schedule.StartDate, err = leaderboarddomain.ParseSeasonBoundary(payload.StartDate, payload.Timezone); err != nil {
+		return fail(err.Error()), nil
+	}
+	if payload.EndDate != "" {
+		end, err := leaderboarddomain.ParseSeasonBoundary(payload.EndDate, payload.Timezone)
+		if err != nil {
+			return fail(err.Error()), nil
+		}
+		schedule.EndDate = &end
+	}
+
+	policy, ok := leaderboarddomain.BuiltInPointsPolicy(payload.System)
+	if payload.Policy != nil {
+		policy, ok = *payload.Policy, true
+	}
+	if !ok {
+		return fail(fmt.Sprintf("unknown points system %q", payload.System)), nil
+	}
+	schedule.Policy = policy
+
+	result, err := h.service.ScheduleSeason(ctx, payload.GuildID, schedule)
+	if err != nil {
+		return fail(err.Error()), nil
+	}
+	if result.IsFailure() {
+		return fail(fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardSeasonScheduledV1)
+	season := *result.Success
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &SeasonScheduledPayloadV1{
+			GuildID:    payload.GuildID,
+			SeasonID:   season.ID,
+			SeasonName: season.Name,
+			StartDate:  season.StartDate,
+			EndDate:    season.EndDate,
+			Timezone:   season.Timezone,
+		},
+	}}, nil
+}
+
+// HandleSeasonScheduleTick starts and ends seasons whose scheduled time has passed and
+// announces each change.
+func (h *LeaderboardHandlers) HandleSeasonScheduleTick(
+	ctx context.Context,
+	payload *leaderboardqueue.SeasonScheduleTickPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	now := payload.RequestedAt
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+
+	transitions, err := h.service.RunSeasonSchedule(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []handlerwrapper.Result
+	for _, t := range transitions {
+		if t.EndedSeasonID != "" {
+			out = append(out, h.seasonEndedResults(ctx, t.GuildID, t.EndedSeasonID)...)
+		}
+		if t.StartedSeasonID != "" {
+			out = append(out, handlerwrapper.Result{
+				Topic: leaderboardevents.LeaderboardStartNewSeasonSuccessV1,
+				Payload: &leaderboardevents.StartNewSeasonSuccessPayloadV1{
+					GuildID:    t.GuildID,
+					SeasonID:   t.StartedSeasonID,
+					SeasonName: t.StartedSeasonName,
+				},
+			})
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,102 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboardqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/queue"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSeasonScheduleRequested_ConvertsGuildTimezone(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-123")
+	service := NewFakeService()
+	var got leaderboardservice.SeasonSchedule
+	service.ScheduleSeasonFunc = func(ctx context.Context, gID sharedtypes.GuildID, schedule leaderboardservice.SeasonSchedule) (results.OperationResult[leaderboardservice.SeasonInfo, error], error) {
+		got = schedule
+		return results.SuccessResult[leaderboardservice.SeasonInfo, error](leaderboardservice.SeasonInfo{
+			ID:        schedule.SeasonID,
+			Name:      schedule.SeasonName,
+			Scheduled: true,
+			StartDate: schedule.StartDate.Format(time.RFC3339),
+			Timezone:  schedule.Timezone,
+		}), nil
+	}
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+	res, err := h.HandleSeasonScheduleRequested(context.Background(), &SeasonScheduleRequestedPayloadV1{
+		GuildID:    guildID,
+		SeasonID:   "2026-summer",
+		SeasonName: "Summer 2026",
+		StartDate:  "2026-06-01",
+		EndDate:    "2026-09-01 18:30",
+		Timezone:   "America/Chicago",
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, LeaderboardSeasonScheduledV1, res[0].Topic)
+
+	assert.Equal(t, time.Date(2026, 6, 1, 5, 0, 0, 0, time.UTC), got.StartDate)
+	require.NotNil(t, got.EndDate)
+	assert.Equal(t, time.Date(2026, 9, 1, 23, 30, 0, 0, time.UTC), *got.EndDate)
+	assert.NoError(t, got.Policy.Validate())
+
+	scheduled, ok := res[0].Payload.(*SeasonScheduledPayloadV1)
+	require.True(t, ok)
+	assert.Equal(t, "America/Chicago", scheduled.Timezone)
+}
+
+func TestHandleSeasonScheduleRequested_InvalidTimezone(t *testing.T) {
+	service := NewFakeService()
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+	res, err := h.HandleSeasonScheduleRequested(context.Background(), &SeasonScheduleRequestedPayloadV1{
+		GuildID:    "guild-123",
+		SeasonID:   "2026-summer",
+		SeasonName: "Summer 2026",
+		StartDate:  "2026-06-01",
+		Timezone:   "Mars/Olympus_Mons",
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, LeaderboardSeasonScheduleFailedV1, res[0].Topic)
+	assert.NotContains(t, service.trace, "ScheduleSeason")
+}
+
+func TestHandleSeasonScheduleTick_AnnouncesTransitions(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-123")
+	tick := time.Date(2026, 6, 1, 5, 0, 30, 0, time.UTC)
+	service := NewFakeService()
+	service.RunSeasonScheduleFunc = func(ctx context.Context, now time.Time) ([]leaderboardservice.SeasonTransition, error) {
+		assert.Equal(t, tick, now)
+		return []leaderboardservice.SeasonTransition{{
+			GuildID:           guildID,
+			EndedSeasonID:     "2026-spring",
+			StartedSeasonID:   "2026-summer",
+			StartedSeasonName: "Summer 2026",
+		}}, nil
+	}
+	service.GetSeasonArchiveFunc = func(ctx context.Context, gID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonArchive, error], error) {
+		assert.Equal(t, "2026-spring", seasonID)
+		return results.SuccessResult[leaderboardservice.SeasonArchive, error](testSeasonArchive(3)), nil
+	}
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+	res, err := h.HandleSeasonScheduleTick(context.Background(), &leaderboardqueue.SeasonScheduleTickPayloadV1{RequestedAt: tick})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, LeaderboardSeasonEndedV1, res[0].Topic)
+	assert.Equal(t, leaderboardevents.LeaderboardStartNewSeasonSuccessV1, res[1].Topic)
+
+	started, ok := res[1].Payload.(*leaderboardevents.StartNewSeasonSuccessPayloadV1)
+	require.True(t, ok)
+	assert.Equal(t, "2026-summer", started.SeasonID)
+	assert.Equal(t, "Summer 2026", started.SeasonName)
+}
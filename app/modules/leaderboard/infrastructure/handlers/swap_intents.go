@@ -0,0 +1,115 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/saga"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Swap intent topics. Intents are created by tag swap requests that cannot complete
+// immediately; these topics let players withdraw them and let anyone in the guild see
+// what is pending. The list request is guild-scoped: the guild ID is appended to the
+// subject.
+const (
+	LeaderboardSwapIntentCancelRequestedV1 = "leaderboard.tag.swap.intent.cancel.requested.v1"
+	LeaderboardSwapIntentCancelledV1       = "leaderboard.tag.swap.intent.cancelled.v1"
+	LeaderboardSwapIntentCancelFailedV1    = "leaderboard.tag.swap.intent.cancel.failed.v1"
+	LeaderboardSwapIntentsRequestV1        = "leaderboard.tag.swap.intents.request.v1"
+	LeaderboardSwapIntentsResponseV1       = "leaderboard.tag.swap.intents.response.v1"
+	LeaderboardSwapIntentsFailedV1         = "leaderboard.tag.swap.intents.failed.v1"
+)
+
+// SwapIntentCancelRequestedPayloadV1 withdraws a user's pending swap intent.
+type SwapIntentCancelRequestedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+}
+
+// SwapIntentCancelledPayloadV1 confirms a withdrawn swap intent.
+type SwapIntentCancelledPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+}
+
+// SwapIntentFailedPayloadV1 reports a failed swap intent request.
+type SwapIntentFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id,omitempty"`
+	Reason  string                `json:"reason"`
+}
+
+// SwapIntentsRequestPayloadV1 asks for a guild's pending swap intents.
+type SwapIntentsRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+}
+
+// SwapIntentsResponsePayloadV1 is the reply for LeaderboardSwapIntentsRequestV1.
+type SwapIntentsResponsePayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+	saga.PendingSwaps
+}
+
+// HandleSwapIntentCancelRequested withdraws a user's pending swap intent.
+func (h *LeaderboardHandlers) HandleSwapIntentCancelRequested(
+	ctx context.Context,
+	payload *SwapIntentCancelRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	if err := h.sagaCoordinator.CancelIntent(ctx, payload.GuildID, payload.UserID); err != nil {
+		if !errors.Is(err, saga.ErrIntentNotFound) {
+			return nil, err
+		}
+		return []handlerwrapper.Result{{
+			Topic: LeaderboardSwapIntentCancelFailedV1,
+			Payload: &SwapIntentFailedPayloadV1{
+				GuildID: payload.GuildID,
+				UserID:  payload.UserID,
+				Reason:  "no_pending_intent",
+			},
+		}}, nil
+	}
+
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardSwapIntentCancelledV1)
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &SwapIntentCancelledPayloadV1{
+			GuildID: payload.GuildID,
+			UserID:  payload.UserID,
+		},
+	}}, nil
+}
+
+// HandleSwapIntentsRequest replies with a guild's pending swap intents and the partial
+// chains they form.
+func (h *LeaderboardHandlers) HandleSwapIntentsRequest(
+	ctx context.Context,
+	payload *SwapIntentsRequestPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	pending, err := h.sagaCoordinator.ListPending(ctx, payload.GuildID)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "failed to list swap intents",
+			attr.String("guild_id", string(payload.GuildID)),
+			attr.Error(err),
+		)
+		return []handlerwrapper.Result{{
+			Topic: LeaderboardSwapIntentsFailedV1,
+			Payload: &SwapIntentFailedPayloadV1{
+				GuildID: payload.GuildID,
+				Reason:  "unable to retrieve swap intents",
+			},
+		}}, nil
+	}
+
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardSwapIntentsResponseV1)
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &SwapIntentsResponsePayloadV1{
+			GuildID:      payload.GuildID,
+			PendingSwaps: pending,
+		},
+	}}, nil
+}
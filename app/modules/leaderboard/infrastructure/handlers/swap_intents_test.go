@@ -0,0 +1,73 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/saga"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSwapIntentCancelRequested(t *testing.T) {
+	payload := &SwapIntentCancelRequestedPayloadV1{GuildID: "guild-123", UserID: "user-1"}
+
+	tests := []struct {
+		name      string
+		cancelErr error
+		wantTopic string
+		wantErr   bool
+	}{
+		{name: "cancelled", wantTopic: LeaderboardSwapIntentCancelledV1},
+		{name: "nothing pending", cancelErr: saga.ErrIntentNotFound, wantTopic: LeaderboardSwapIntentCancelFailedV1},
+		{name: "kv error", cancelErr: errors.New("kv down"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSaga := NewFakeSagaCoordinator()
+			fakeSaga.CancelIntentFunc = func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) error {
+				assert.Equal(t, payload.GuildID, guildID)
+				assert.Equal(t, payload.UserID, userID)
+				return tt.cancelErr
+			}
+			h := &LeaderboardHandlers{service: NewFakeService(), sagaCoordinator: fakeSaga, logger: slog.Default()}
+
+			res, err := h.HandleSwapIntentCancelRequested(context.Background(), payload)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, tt.wantTopic, res[0].Topic)
+		})
+	}
+}
+
+func TestHandleSwapIntentsRequest_RepliesWithPendingSwaps(t *testing.T) {
+	intent := saga.SwapIntent{UserID: "user-1", CurrentTag: 1, TargetTag: 2, GuildID: "guild-123"}
+	fakeSaga := NewFakeSagaCoordinator()
+	fakeSaga.ListPendingFunc = func(ctx context.Context, guildID sharedtypes.GuildID) (saga.PendingSwaps, error) {
+		return saga.PendingSwaps{
+			Intents: []saga.SwapIntent{intent},
+			Chains:  []saga.SwapChain{{Intents: []saga.SwapIntent{intent}, WaitingOnTag: 2}},
+		}, nil
+	}
+	h := &LeaderboardHandlers{service: NewFakeService(), sagaCoordinator: fakeSaga, logger: slog.Default()}
+
+	ctx := context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.reply")
+	res, err := h.HandleSwapIntentsRequest(ctx, &SwapIntentsRequestPayloadV1{GuildID: "guild-123"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "_INBOX.reply", res[0].Topic)
+
+	resp, ok := res[0].Payload.(*SwapIntentsResponsePayloadV1)
+	require.True(t, ok)
+	assert.Len(t, resp.Intents, 1)
+	require.Len(t, resp.Chains, 1)
+	assert.Equal(t, sharedtypes.TagNumber(2), resp.Chains[0].WaitingOnTag)
+}
@@ -0,0 +1,156 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Data export and tag history import topics. An applied import is followed by the usual
+// batch tag assignment events for the changed tags.
+const (
+	LeaderboardDataExportRequestedV1       = "leaderboard.data.export.requested.v1"
+	LeaderboardDataExportReadyV1           = "leaderboard.data.export.ready.v1"
+	LeaderboardDataExportFailedV1          = "leaderboard.data.export.failed.v1"
+	LeaderboardTagHistoryImportRequestedV1 = "leaderboard.tag_history.import.requested.v1"
+	LeaderboardTagHistoryImportCompletedV1 = "leaderboard.tag_history.import.completed.v1"
+	LeaderboardTagHistoryImportFailedV1    = "leaderboard.tag_history.import.failed.v1"
+)
+
+// DataExportRequestedPayloadV1 asks for a dataset as a CSV or XLSX file. SeasonID
+// selects the standings exported, defaulting to the active season.
+type DataExportRequestedPayloadV1 struct {
+	GuildID  sharedtypes.GuildID              `json:"guild_id"`
+	Dataset  leaderboardservice.ExportDataset `json:"dataset"`
+	Format   leaderboardservice.ExportFormat  `json:"format"`
+	SeasonID string                           `json:"season_id,omitempty"`
+}
+
+// DataExportReadyPayloadV1 carries an exported file.
+type DataExportReadyPayloadV1 struct {
+	GuildID     sharedtypes.GuildID              `json:"guild_id"`
+	Dataset     leaderboardservice.ExportDataset `json:"dataset"`
+	Format      leaderboardservice.ExportFormat  `json:"format"`
+	SeasonID    string                           `json:"season_id,omitempty"`
+	FileName    string                           `json:"file_name"`
+	ContentType string                           `json:"content_type"`
+	Data        []byte                           `json:"data"`
+}
+
+// TagHistoryImportRequestedPayloadV1 uploads a CSV or XLSX tag history file. With
+// DryRun set the file is only validated and planned.
+type TagHistoryImportRequestedPayloadV1 struct {
+	GuildID  sharedtypes.GuildID `json:"guild_id"`
+	FileName string              `json:"file_name"`
+	FileData []byte              `json:"file_data"`
+	DryRun   bool                `json:"dry_run"`
+}
+
+// TagHistoryImportCompletedPayloadV1 reports a valid import. When DryRun is set nothing
+// was written.
+type TagHistoryImportCompletedPayloadV1 struct {
+	GuildID sharedtypes.GuildID                 `json:"guild_id"`
+	DryRun  bool                                `json:"dry_run"`
+	Rows    int                                 `json:"rows"`
+	Changes []leaderboarddomain.TagImportChange `json:"changes"`
+}
+
+// TagHistoryImportFailedPayloadV1 reports an import that was rejected, with the file's
+// problems when it had any.
+type TagHistoryImportFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID                `json:"guild_id"`
+	Reason  string                             `json:"reason"`
+	Issues  []leaderboarddomain.TagImportIssue `json:"issues,omitempty"`
+}
+
+// HandleDataExportRequested replies with an exported dataset.
+func (h *LeaderboardHandlers) HandleDataExportRequested(
+	ctx context.Context,
+	payload *DataExportRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("payload is nil")
+	}
+	fail := func(reason string) []handlerwrapper.Result {
+		return []handlerwrapper.Result{{
+			Topic:   LeaderboardDataExportFailedV1,
+			Payload: &leaderboardevents.AdminFailedPayloadV1{GuildID: payload.GuildID, Reason: reason},
+		}}
+	}
+
+	result, err := h.service.ExportLeaderboardData(ctx, payload.GuildID, payload.Dataset, payload.Format, payload.SeasonID)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsFailure() {
+		return fail(fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	file := *result.Success
+	return []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, LeaderboardDataExportReadyV1),
+		Payload: &DataExportReadyPayloadV1{
+			GuildID:     payload.GuildID,
+			Dataset:     payload.Dataset,
+			Format:      payload.Format,
+			SeasonID:    payload.SeasonID,
+			FileName:    file.FileName,
+			ContentType: file.ContentType,
+			Data:        file.Data,
+		},
+	}}, nil
+}
+
+// HandleTagHistoryImportRequested validates a tag history file and, unless it is a dry
+// run, applies it.
+func (h *LeaderboardHandlers) HandleTagHistoryImportRequested(
+	ctx context.Context,
+	payload *TagHistoryImportRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("payload is nil")
+	}
+	fail := func(reason string, issues []leaderboarddomain.TagImportIssue) []handlerwrapper.Result {
+		return []handlerwrapper.Result{{
+			Topic:   LeaderboardTagHistoryImportFailedV1,
+			Payload: &TagHistoryImportFailedPayloadV1{GuildID: payload.GuildID, Reason: reason, Issues: issues},
+		}}
+	}
+
+	result, err := h.service.ImportTagHistory(ctx, payload.GuildID, payload.FileName, payload.FileData, payload.DryRun)
+	if err != nil {
+		return fail(err.Error(), nil), nil
+	}
+	if result.IsFailure() {
+		var swapErr *leaderboardservice.TagSwapNeededError
+		if errors.As(*result.Failure, &swapErr) {
+			return fail("tags changed while the import was applied; run it again", nil), nil
+		}
+		return fail(fmt.Sprintf("%v", *result.Failure), nil), nil
+	}
+	imported := *result.Success
+	if len(imported.Issues) > 0 {
+		return fail(leaderboarddomain.ErrInvalidTagImport.Error(), imported.Issues), nil
+	}
+
+	out := []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, LeaderboardTagHistoryImportCompletedV1),
+		Payload: &TagHistoryImportCompletedPayloadV1{
+			GuildID: payload.GuildID,
+			DryRun:  imported.DryRun,
+			Rows:    imported.Rows,
+			Changes: imported.Changes,
+		},
+	}}
+	if len(imported.Assignments) == 0 {
+		return out, nil
+	}
+	return append(out, h.mapSuccessResults(ctx, payload.GuildID, "", "", imported.Assignments, leaderboardservice.ServiceUpdateSourceTagImport, "")...), nil
+}
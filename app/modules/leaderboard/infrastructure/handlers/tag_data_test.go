@@ -0,0 +1,150 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleDataExportRequested(t *testing.T) {
+	t.Run("replies with the file", func(t *testing.T) {
+		service := NewFakeService()
+		service.ExportLeaderboardDataFunc = func(ctx context.Context, guildID sharedtypes.GuildID, dataset leaderboardservice.ExportDataset, format leaderboardservice.ExportFormat, seasonID string) (results.OperationResult[leaderboardservice.ExportFile, error], error) {
+			assert.Equal(t, leaderboardservice.ExportMembers, dataset)
+			assert.Equal(t, leaderboardservice.ExportFormatXLSX, format)
+			return results.SuccessResult[leaderboardservice.ExportFile, error](leaderboardservice.ExportFile{
+				FileName:    "members.xlsx",
+				ContentType: format.ContentType(),
+				Data:        []byte("xlsx"),
+			}), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleDataExportRequested(context.Background(), &DataExportRequestedPayloadV1{
+			GuildID: "guild-1",
+			Dataset: leaderboardservice.ExportMembers,
+			Format:  leaderboardservice.ExportFormatXLSX,
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardDataExportReadyV1, res[0].Topic)
+		payload, ok := res[0].Payload.(*DataExportReadyPayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, "members.xlsx", payload.FileName)
+		assert.Equal(t, []byte("xlsx"), payload.Data)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		service := NewFakeService()
+		service.ExportLeaderboardDataFunc = func(ctx context.Context, guildID sharedtypes.GuildID, dataset leaderboardservice.ExportDataset, format leaderboardservice.ExportFormat, seasonID string) (results.OperationResult[leaderboardservice.ExportFile, error], error) {
+			return results.FailureResult[leaderboardservice.ExportFile, error](leaderboardservice.ErrInvalidExportRequest), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleDataExportRequested(context.Background(), &DataExportRequestedPayloadV1{GuildID: "guild-1", Dataset: "rounds"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardDataExportFailedV1, res[0].Topic)
+		failed, ok := res[0].Payload.(*leaderboardevents.AdminFailedPayloadV1)
+		require.True(t, ok)
+		assert.Contains(t, failed.Reason, "invalid export request")
+	})
+}
+
+func TestHandleTagHistoryImportRequested(t *testing.T) {
+	changes := []leaderboarddomain.TagImportChange{
+		{MemberID: "alice", CurrentTag: 1},
+		{MemberID: "bob", ImportedTag: 1},
+	}
+
+	t.Run("dry run reports planned changes", func(t *testing.T) {
+		service := NewFakeService()
+		service.ImportTagHistoryFunc = func(ctx context.Context, guildID sharedtypes.GuildID, fileName string, data []byte, dryRun bool) (results.OperationResult[leaderboardservice.TagImportResult, error], error) {
+			assert.True(t, dryRun)
+			return results.SuccessResult[leaderboardservice.TagImportResult, error](leaderboardservice.TagImportResult{DryRun: true, Rows: 3, Changes: changes}), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleTagHistoryImportRequested(context.Background(), &TagHistoryImportRequestedPayloadV1{GuildID: "guild-1", FileName: "tags.csv", DryRun: true})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardTagHistoryImportCompletedV1, res[0].Topic)
+		payload, ok := res[0].Payload.(*TagHistoryImportCompletedPayloadV1)
+		require.True(t, ok)
+		assert.True(t, payload.DryRun)
+		assert.Equal(t, changes, payload.Changes)
+	})
+
+	t.Run("applied import announces tag changes", func(t *testing.T) {
+		service := NewFakeService()
+		service.ImportTagHistoryFunc = func(ctx context.Context, guildID sharedtypes.GuildID, fileName string, data []byte, dryRun bool) (results.OperationResult[leaderboardservice.TagImportResult, error], error) {
+			return results.SuccessResult[leaderboardservice.TagImportResult, error](leaderboardservice.TagImportResult{
+				Applied: true,
+				Rows:    3,
+				Changes: changes,
+				Assignments: []sharedtypes.TagAssignmentRequest{
+					{UserID: "alice"},
+					{UserID: "bob", TagNumber: 1},
+				},
+			}), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleTagHistoryImportRequested(context.Background(), &TagHistoryImportRequestedPayloadV1{GuildID: "guild-1", FileName: "tags.csv"})
+		require.NoError(t, err)
+		require.Greater(t, len(res), 1)
+		assert.Equal(t, LeaderboardTagHistoryImportCompletedV1, res[0].Topic)
+
+		newTags := map[sharedtypes.DiscordID]*sharedtypes.TagNumber{}
+		for _, r := range res {
+			if p, ok := r.Payload.(*leaderboardevents.LeaderboardTagUpdatedPayloadV1); ok {
+				newTags[p.UserID] = p.NewTag
+			}
+		}
+		require.Contains(t, newTags, sharedtypes.DiscordID("alice"))
+		assert.Nil(t, newTags["alice"])
+		require.NotNil(t, newTags["bob"])
+		assert.Equal(t, sharedtypes.TagNumber(1), *newTags["bob"])
+	})
+
+	t.Run("file issues are reported", func(t *testing.T) {
+		service := NewFakeService()
+		service.ImportTagHistoryFunc = func(ctx context.Context, guildID sharedtypes.GuildID, fileName string, data []byte, dryRun bool) (results.OperationResult[leaderboardservice.TagImportResult, error], error) {
+			return results.SuccessResult[leaderboardservice.TagImportResult, error](leaderboardservice.TagImportResult{
+				Issues: []leaderboarddomain.TagImportIssue{{Line: 2, Reason: "member_id is required"}},
+			}), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleTagHistoryImportRequested(context.Background(), &TagHistoryImportRequestedPayloadV1{GuildID: "guild-1", FileName: "tags.csv"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardTagHistoryImportFailedV1, res[0].Topic)
+		failed, ok := res[0].Payload.(*TagHistoryImportFailedPayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, leaderboarddomain.ErrInvalidTagImport.Error(), failed.Reason)
+		require.Len(t, failed.Issues, 1)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		service := NewFakeService()
+		service.ImportTagHistoryFunc = func(ctx context.Context, guildID sharedtypes.GuildID, fileName string, data []byte, dryRun bool) (results.OperationResult[leaderboardservice.TagImportResult, error], error) {
+			return results.OperationResult[leaderboardservice.TagImportResult, error]{}, errors.New("db down")
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleTagHistoryImportRequested(context.Background(), &TagHistoryImportRequestedPayloadV1{GuildID: "guild-1", FileName: "tags.csv"})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardTagHistoryImportFailedV1, res[0].Topic)
+	})
+}
@@ -0,0 +1,92 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"fmt"
+
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Tag pool policy topics. The policy is stored on the guild config but only the
+// leaderboard applies it.
+const (
+	LeaderboardTagPoolPolicySetRequestedV1 = "leaderboard.tag.pool.policy.set.requested.v1"
+	LeaderboardTagPoolPolicyUpdatedV1      = "leaderboard.tag.pool.policy.updated.v1"
+	LeaderboardTagPoolPolicyFailedV1       = "leaderboard.tag.pool.policy.failed.v1"
+	LeaderboardTagPoolPolicyRequestV1      = "leaderboard.tag.pool.policy.request.v1"
+	LeaderboardTagPoolPolicyResponseV1     = "leaderboard.tag.pool.policy.response.v1"
+)
+
+// TagPoolPolicySetRequestedPayloadV1 selects the tag pool model for a guild's rounds.
+type TagPoolPolicySetRequestedPayloadV1 struct {
+	GuildID sharedtypes.GuildID             `json:"guild_id"`
+	Policy  leaderboarddomain.TagPoolPolicy `json:"policy"`
+}
+
+// TagPoolPolicyRequestPayloadV1 asks for a guild's tag pool model.
+type TagPoolPolicyRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+}
+
+// TagPoolPolicyPayloadV1 confirms or reports a guild's tag pool model.
+type TagPoolPolicyPayloadV1 struct {
+	GuildID sharedtypes.GuildID             `json:"guild_id"`
+	Policy  leaderboarddomain.TagPoolPolicy `json:"policy"`
+}
+
+// HandleTagPoolPolicySetRequested stores a guild's tag pool model.
+func (h *LeaderboardHandlers) HandleTagPoolPolicySetRequested(
+	ctx context.Context,
+	payload *TagPoolPolicySetRequestedPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.SetTagPoolPolicy(ctx, payload.GuildID, payload.Policy)
+	if err != nil {
+		return tagPoolPolicyFailed(payload.GuildID, err.Error()), nil
+	}
+	if result.IsFailure() {
+		return tagPoolPolicyFailed(payload.GuildID, fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardTagPoolPolicyUpdatedV1)
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &TagPoolPolicyPayloadV1{
+			GuildID: payload.GuildID,
+			Policy:  *result.Success,
+		},
+	}}, nil
+}
+
+// HandleTagPoolPolicyRequest replies with a guild's tag pool model.
+func (h *LeaderboardHandlers) HandleTagPoolPolicyRequest(
+	ctx context.Context,
+	payload *TagPoolPolicyRequestPayloadV1,
+) ([]handlerwrapper.Result, error) {
+	result, err := h.service.GetTagPoolPolicy(ctx, payload.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsFailure() {
+		return tagPoolPolicyFailed(payload.GuildID, fmt.Sprintf("%v", *result.Failure)), nil
+	}
+
+	topic := handlerutil.ReplyTopic(ctx, LeaderboardTagPoolPolicyResponseV1)
+	return []handlerwrapper.Result{{
+		Topic: topic,
+		Payload: &TagPoolPolicyPayloadV1{
+			GuildID: payload.GuildID,
+			Policy:  *result.Success,
+		},
+	}}, nil
+}
+
+func tagPoolPolicyFailed(guildID sharedtypes.GuildID, reason string) []handlerwrapper.Result {
+	return []handlerwrapper.Result{{
+		Topic:   LeaderboardTagPoolPolicyFailedV1,
+		Payload: &leaderboardevents.AdminFailedPayloadV1{GuildID: guildID, Reason: reason},
+	}}
+}
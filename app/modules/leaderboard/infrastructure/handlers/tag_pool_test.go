@@ -0,0 +1,61 @@
+package leaderboardhandlers
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleTagPoolPolicySetRequested(t *testing.T) {
+	policy := leaderboarddomain.TagPoolPolicy{Model: leaderboarddomain.TagPoolCapped, Cap: 3}
+
+	t.Run("confirms stored policy", func(t *testing.T) {
+		service := NewFakeService()
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleTagPoolPolicySetRequested(context.Background(), &TagPoolPolicySetRequestedPayloadV1{GuildID: "guild-123", Policy: policy})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardTagPoolPolicyUpdatedV1, res[0].Topic)
+		updated, ok := res[0].Payload.(*TagPoolPolicyPayloadV1)
+		require.True(t, ok)
+		assert.Equal(t, policy, updated.Policy)
+	})
+
+	t.Run("invalid policy fails", func(t *testing.T) {
+		service := NewFakeService()
+		service.SetTagPoolPolicyFunc = func(ctx context.Context, guildID sharedtypes.GuildID, p leaderboarddomain.TagPoolPolicy) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error) {
+			return results.FailureResult[leaderboarddomain.TagPoolPolicy](p.Validate()), nil
+		}
+		h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+
+		res, err := h.HandleTagPoolPolicySetRequested(context.Background(), &TagPoolPolicySetRequestedPayloadV1{
+			GuildID: "guild-123",
+			Policy:  leaderboarddomain.TagPoolPolicy{Model: "lottery"},
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, LeaderboardTagPoolPolicyFailedV1, res[0].Topic)
+	})
+}
+
+func TestHandleTagPoolPolicyRequest_RepliesOnReplyTopic(t *testing.T) {
+	service := NewFakeService()
+	h := &LeaderboardHandlers{service: service, logger: slog.Default()}
+	ctx := context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.tag-pool")
+
+	res, err := h.HandleTagPoolPolicyRequest(ctx, &TagPoolPolicyRequestPayloadV1{GuildID: "guild-123"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, "_INBOX.tag-pool", res[0].Topic)
+	reply, ok := res[0].Payload.(*TagPoolPolicyPayloadV1)
+	require.True(t, ok)
+	assert.Equal(t, leaderboarddomain.DefaultTagPoolPolicy(), reply.Policy)
+}
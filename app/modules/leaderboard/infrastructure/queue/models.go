@@ -0,0 +1,33 @@
+package leaderboardqueue
+
+import "time"
+
+// LeaderboardSeasonScheduleTickV1 is published by the periodic season schedule job so
+// the leaderboard module can start and end seasons whose scheduled time has passed.
+const LeaderboardSeasonScheduleTickV1 = "leaderboard.season.schedule.tick.v1"
+
+// SeasonScheduleTickPayloadV1 is the payload for LeaderboardSeasonScheduleTickV1.
+type SeasonScheduleTickPayloadV1 struct {
+	RequestedAt time.Time `json:"requested_at"`
+}
+
+// SeasonScheduleJob is a periodic job that triggers scheduled season transitions.
+type SeasonScheduleJob struct{}
+
+// Kind returns the job type identifier for River
+func (SeasonScheduleJob) Kind() string { return "leaderboard_season_schedule" }
+
+// LeaderboardInactivityDecayTickV1 is published by the periodic inactivity decay job so
+// the leaderboard module can apply each guild's inactivity policy.
+const LeaderboardInactivityDecayTickV1 = "leaderboard.inactivity.decay.tick.v1"
+
+// InactivityDecayTickPayloadV1 is the payload for LeaderboardInactivityDecayTickV1.
+type InactivityDecayTickPayloadV1 struct {
+	RequestedAt time.Time `json:"requested_at"`
+}
+
+// InactivityDecayJob is a periodic job that triggers inactivity tag decay.
+type InactivityDecayJob struct{}
+
+// Kind returns the job type identifier for River
+func (InactivityDecayJob) Kind() string { return "leaderboard_inactivity_decay" }
@@ -0,0 +1,107 @@
+package leaderboardqueue
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/riverdriver/riverpgxv5"
+)
+
+// seasonScheduleInterval is how often scheduled season transitions are checked. Seasons
+// therefore start and end within a minute of their configured time.
+const seasonScheduleInterval = time.Minute
+
+// inactivityDecayInterval is how often inactivity policies are applied. Policies are
+// measured in weeks, and a decay restarts the member's window, so running more often
+// only shortens the delay after a member crosses the threshold.
+const inactivityDecayInterval = time.Hour
+
+// QueueService defines the leaderboard background job operations.
+type QueueService interface {
+	HealthCheck(ctx context.Context) error
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+type Service struct {
+	client *river.Client[pgx.Tx]
+	pool   *pgxpool.Pool
+	logger *slog.Logger
+}
+
+func NewService(ctx context.Context, logger *slog.Logger, dsn string, eventBus eventbus.EventBus, helpers utils.Helpers) (*Service, error) {
+	config, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("parse dsn: %w", err)
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("create pgx pool: %w", err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("ping leaderboard queue db: %w", err)
+	}
+
+	workers := river.NewWorkers()
+	river.AddWorker(workers, NewSeasonScheduleWorker(logger, eventBus, helpers))
+	river.AddWorker(workers, NewInactivityDecayWorker(logger, eventBus, helpers))
+
+	client, err := river.NewClient(riverpgxv5.New(pool), &river.Config{
+		Queues: map[string]river.QueueConfig{
+			"leaderboard": {MaxWorkers: 2},
+		},
+		Workers: workers,
+		PeriodicJobs: []*river.PeriodicJob{
+			river.NewPeriodicJob(
+				river.PeriodicInterval(seasonScheduleInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return SeasonScheduleJob{}, &river.InsertOpts{Queue: "leaderboard"}
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+			river.NewPeriodicJob(
+				river.PeriodicInterval(inactivityDecayInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return InactivityDecayJob{}, &river.InsertOpts{Queue: "leaderboard"}
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+		},
+	})
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("create river client: %w", err)
+	}
+
+	return &Service{
+		client: client,
+		pool:   pool,
+		logger: logger.With(attr.String("component", "leaderboard_queue")),
+	}, nil
+}
+
+func (s *Service) Start(ctx context.Context) error {
+	s.logger.Info("starting leaderboard queue service")
+	return s.client.Start(ctx)
+}
+
+func (s *Service) Stop(ctx context.Context) error {
+	s.logger.Info("stopping leaderboard queue service")
+	err := s.client.Stop(ctx)
+	s.pool.Close()
+	return err
+}
+
+func (s *Service) HealthCheck(ctx context.Context) error {
+	return s.pool.Ping(ctx)
+}
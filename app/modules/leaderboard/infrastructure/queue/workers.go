@@ -0,0 +1,69 @@
+package leaderboardqueue
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	"github.com/riverqueue/river"
+)
+
+type SeasonScheduleWorker struct {
+	river.WorkerDefaults[SeasonScheduleJob]
+	logger   *slog.Logger
+	eventBus eventbus.EventBus
+	helpers  utils.Helpers
+}
+
+func NewSeasonScheduleWorker(logger *slog.Logger, eventBus eventbus.EventBus, helpers utils.Helpers) *SeasonScheduleWorker {
+	return &SeasonScheduleWorker{logger: logger, eventBus: eventBus, helpers: helpers}
+}
+
+func (w *SeasonScheduleWorker) Work(ctx context.Context, job *river.Job[SeasonScheduleJob]) error {
+	ctxLogger := w.logger.With(attr.Int64("job_id", job.ID))
+	payload := SeasonScheduleTickPayloadV1{
+		RequestedAt: time.Now().UTC(),
+	}
+	msg, err := w.helpers.CreateNewMessage(payload, LeaderboardSeasonScheduleTickV1)
+	if err != nil {
+		ctxLogger.Error("failed to create season schedule tick message", attr.Error(err))
+		return fmt.Errorf("create season schedule tick message: %w", err)
+	}
+	if err := w.eventBus.Publish(LeaderboardSeasonScheduleTickV1, msg); err != nil {
+		ctxLogger.Error("failed to publish season schedule tick", attr.Error(err))
+		return fmt.Errorf("publish season schedule tick: %w", err)
+	}
+	return nil
+}
+
+type InactivityDecayWorker struct {
+	river.WorkerDefaults[InactivityDecayJob]
+	logger   *slog.Logger
+	eventBus eventbus.EventBus
+	helpers  utils.Helpers
+}
+
+func NewInactivityDecayWorker(logger *slog.Logger, eventBus eventbus.EventBus, helpers utils.Helpers) *InactivityDecayWorker {
+	return &InactivityDecayWorker{logger: logger, eventBus: eventBus, helpers: helpers}
+}
+
+func (w *InactivityDecayWorker) Work(ctx context.Context, job *river.Job[InactivityDecayJob]) error {
+	ctxLogger := w.logger.With(attr.Int64("job_id", job.ID))
+	payload := InactivityDecayTickPayloadV1{
+		RequestedAt: time.Now().UTC(),
+	}
+	msg, err := w.helpers.CreateNewMessage(payload, LeaderboardInactivityDecayTickV1)
+	if err != nil {
+		ctxLogger.Error("failed to create inactivity decay tick message", attr.Error(err))
+		return fmt.Errorf("create inactivity decay tick message: %w", err)
+	}
+	if err := w.eventBus.Publish(LeaderboardInactivityDecayTickV1, msg); err != nil {
+		ctxLogger.Error("failed to publish inactivity decay tick", attr.Error(err))
+		return fmt.Errorf("publish inactivity decay tick: %w", err)
+	}
+	return nil
+}
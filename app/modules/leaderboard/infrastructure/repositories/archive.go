@@ -0,0 +1,97 @@
+package leaderboarddb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// CloseSeason deactivates a season and stamps its end date.
+func (r *Impl) CloseSeason(ctx context.Context, db bun.IDB, guildID string, seasonID string, endedAt time.Time) error {
+	if db == nil {
+		db = r.db
+	}
+	_, err := db.NewUpdate().
+		Model((*Season)(nil)).
+		Set("is_active = false").
+		Set("end_date = ?", endedAt).
+		Where("guild_id = ?", guildID).
+		Where("id = ?", seasonID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("leaderboarddb.CloseSeason: %w", err)
+	}
+	return nil
+}
+
+// ArchiveSeason writes a season's final standings and awards. Existing rows are kept.
+func (r *Impl) ArchiveSeason(ctx context.Context, db bun.IDB, guildID string, standings []SeasonArchiveStanding, awards []SeasonAward) error {
+	if db == nil {
+		db = r.db
+	}
+	if len(standings) > 0 {
+		for i := range standings {
+			standings[i].GuildID = guildID
+		}
+		_, err := db.NewInsert().
+			Model(&standings).
+			On("CONFLICT (guild_id, season_id, member_id) DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("leaderboarddb.ArchiveSeason: standings: %w", err)
+		}
+	}
+	if len(awards) > 0 {
+		for i := range awards {
+			awards[i].GuildID = guildID
+		}
+		_, err := db.NewInsert().
+			Model(&awards).
+			On("CONFLICT (guild_id, season_id, award) DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("leaderboarddb.ArchiveSeason: awards: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetArchivedStandings retrieves a season's archived standings ordered by final rank.
+func (r *Impl) GetArchivedStandings(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]SeasonArchiveStanding, error) {
+	if db == nil {
+		db = r.db
+	}
+	var standings []SeasonArchiveStanding
+	err := db.NewSelect().
+		Model(&standings).
+		Where("guild_id = ?", guildID).
+		Where("season_id = ?", seasonID).
+		OrderExpr("final_rank ASC, member_id ASC").
+		Scan(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("leaderboarddb.GetArchivedStandings: %w", err)
+	}
+	return standings, nil
+}
+
+// GetSeasonAwards retrieves the awards granted when a season ended.
+func (r *Impl) GetSeasonAwards(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]SeasonAward, error) {
+	if db == nil {
+		db = r.db
+	}
+	var awards []SeasonAward
+	err := db.NewSelect().
+		Model(&awards).
+		Where("guild_id = ?", guildID).
+		Where("season_id = ?", seasonID).
+		Order("award ASC").
+		Scan(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("leaderboarddb.GetSeasonAwards: %w", err)
+	}
+	return awards, nil
+}
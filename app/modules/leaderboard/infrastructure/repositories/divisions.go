@@ -0,0 +1,66 @@
+package leaderboarddb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+// CreateDivision inserts a division. Returns ErrAlreadyExists if the guild already
+// has a division with the same ID.
+func (r *Impl) CreateDivision(ctx context.Context, db bun.IDB, division *Division) error {
+	if db == nil {
+		db = r.db
+	}
+	res, err := db.NewInsert().
+		Model(division).
+		On("CONFLICT (guild_id, id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("leaderboarddb.CreateDivision: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return ErrAlreadyExists
+	}
+	return nil
+}
+
+// GetDivision retrieves a division. Returns nil if the guild has no such division.
+func (r *Impl) GetDivision(ctx context.Context, db bun.IDB, guildID, divisionID string) (*Division, error) {
+	if db == nil {
+		db = r.db
+	}
+	division := new(Division)
+	err := db.NewSelect().
+		Model(division).
+		Where("guild_id = ?", guildID).
+		Where("id = ?", divisionID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("leaderboarddb.GetDivision: %w", err)
+	}
+	return division, nil
+}
+
+// ListDivisions retrieves a guild's divisions in creation order.
+func (r *Impl) ListDivisions(ctx context.Context, db bun.IDB, guildID string) ([]Division, error) {
+	if db == nil {
+		db = r.db
+	}
+	var divisions []Division
+	err := db.NewSelect().
+		Model(&divisions).
+		Where("guild_id = ?", guildID).
+		Order("created_at ASC", "id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("leaderboarddb.ListDivisions: %w", err)
+	}
+	return divisions, nil
+}
@@ -18,4 +18,7 @@ var (
 
 	// ErrNoRowsAffected indicates an UPDATE/DELETE matched no rows.
 	ErrNoRowsAffected = errors.New("no rows affected")
+
+	// ErrAlreadyExists indicates an INSERT conflicted with an existing record.
+	ErrAlreadyExists = errors.New("already exists")
 )
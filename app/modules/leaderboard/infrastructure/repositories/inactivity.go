@@ -0,0 +1,68 @@
+package leaderboarddb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// GetInactivityPolicy retrieves a guild's inactivity policy. Returns nil if none is set.
+func (r *Impl) GetInactivityPolicy(ctx context.Context, db bun.IDB, guildID string) (*InactivityPolicy, error) {
+	if db == nil {
+		db = r.db
+	}
+	policy := new(InactivityPolicy)
+	err := db.NewSelect().
+		Model(policy).
+		Where("guild_id = ?", guildID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("leaderboarddb.GetInactivityPolicy: %w", err)
+	}
+	return policy, nil
+}
+
+// UpsertInactivityPolicy creates or replaces a guild's inactivity policy.
+func (r *Impl) UpsertInactivityPolicy(ctx context.Context, db bun.IDB, policy *InactivityPolicy) error {
+	if db == nil {
+		db = r.db
+	}
+	policy.UpdatedAt = time.Now().UTC()
+	_, err := db.NewInsert().
+		Model(policy).
+		On("CONFLICT (guild_id) DO UPDATE").
+		Set("enabled = EXCLUDED.enabled").
+		Set("inactive_weeks = EXCLUDED.inactive_weeks").
+		Set("action = EXCLUDED.action").
+		Set("drop_positions = EXCLUDED.drop_positions").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("leaderboarddb.UpsertInactivityPolicy: %w", err)
+	}
+	return nil
+}
+
+// ListEnabledInactivityPolicies retrieves the policies of every guild with decay enabled.
+func (r *Impl) ListEnabledInactivityPolicies(ctx context.Context, db bun.IDB) ([]InactivityPolicy, error) {
+	if db == nil {
+		db = r.db
+	}
+	var policies []InactivityPolicy
+	err := db.NewSelect().
+		Model(&policies).
+		Where("enabled = true").
+		Order("guild_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("leaderboarddb.ListEnabledInactivityPolicies: %w", err)
+	}
+	return policies, nil
+}
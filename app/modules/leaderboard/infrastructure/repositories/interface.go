@@ -2,6 +2,7 @@ package leaderboarddb
 
 import (
 	"context"
+	"time"
 
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/uptrace/bun"
@@ -13,6 +14,7 @@ import (
 // Error semantics:
 //   - ErrNotFound: Record does not exist
 //   - ErrNoRowsAffected: UPDATE/DELETE matched no rows
+//   - ErrAlreadyExists: INSERT conflicted with an existing record
 //   - Other errors: Infrastructure failures (DB connection, query errors)
 type Repository interface {
 	// SavePointHistory records points earned by a member.
@@ -57,6 +59,16 @@ type Repository interface {
 	// DecrementSeasonStandingsBatch decrements multiple season standings in one statement.
 	DecrementSeasonStandingsBatch(ctx context.Context, db bun.IDB, guildID string, deltas []SeasonStandingDecrement) error
 
+	// ListPointHistoryForGuild retrieves all point history for a guild, oldest first.
+	ListPointHistoryForGuild(ctx context.Context, db bun.IDB, guildID string) ([]PointHistory, error)
+
+	// ListPointHistoryForSeasonUntil retrieves a season's point history written at or
+	// before until, oldest first.
+	ListPointHistoryForSeasonUntil(ctx context.Context, db bun.IDB, guildID string, seasonID string, until time.Time) ([]PointHistory, error)
+
+	// DeleteSeasonStandingsForGuild deletes every season standing for a guild across all seasons.
+	DeleteSeasonStandingsForGuild(ctx context.Context, db bun.IDB, guildID string) error
+
 	// --- Season Management ---
 
 	// GetActiveSeason retrieves the currently active season.
@@ -79,4 +91,66 @@ type Repository interface {
 
 	// GetSeasonByID retrieves a single season by its ID.
 	GetSeasonByID(ctx context.Context, db bun.IDB, guildID string, seasonID string) (*Season, error)
+
+	// CloseSeason deactivates a season and stamps its end date.
+	CloseSeason(ctx context.Context, db bun.IDB, guildID string, seasonID string, endedAt time.Time) error
+
+	// ActivateSeason makes a scheduled season the active one.
+	ActivateSeason(ctx context.Context, db bun.IDB, guildID string, seasonID string) error
+
+	// ListGuildsWithDueSeasonTransitions returns the guilds with a scheduled season whose
+	// start has passed or an active season whose end has passed.
+	ListGuildsWithDueSeasonTransitions(ctx context.Context, db bun.IDB, now time.Time) ([]string, error)
+
+	// --- Season Archive ---
+
+	// ArchiveSeason writes a season's final standings and awards. Rows that already exist
+	// are left untouched, so archiving is write-once.
+	ArchiveSeason(ctx context.Context, db bun.IDB, guildID string, standings []SeasonArchiveStanding, awards []SeasonAward) error
+
+	// GetArchivedStandings retrieves a season's archived standings ordered by final rank.
+	// Returns an empty slice if the season has not been archived.
+	GetArchivedStandings(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]SeasonArchiveStanding, error)
+
+	// GetSeasonAwards retrieves the awards granted when a season ended.
+	GetSeasonAwards(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]SeasonAward, error)
+
+	// --- Inactivity Policy ---
+
+	// GetInactivityPolicy retrieves a guild's inactivity policy. Returns nil if none is set.
+	GetInactivityPolicy(ctx context.Context, db bun.IDB, guildID string) (*InactivityPolicy, error)
+
+	// UpsertInactivityPolicy creates or replaces a guild's inactivity policy.
+	UpsertInactivityPolicy(ctx context.Context, db bun.IDB, policy *InactivityPolicy) error
+
+	// ListEnabledInactivityPolicies retrieves the policies of every guild with decay enabled.
+	ListEnabledInactivityPolicies(ctx context.Context, db bun.IDB) ([]InactivityPolicy, error)
+
+	// --- Promotion Policy ---
+
+	// GetPromotionPolicy retrieves a guild's promotion policy. Returns nil if none is set.
+	GetPromotionPolicy(ctx context.Context, db bun.IDB, guildID string) (*PromotionPolicy, error)
+
+	// UpsertPromotionPolicy creates or replaces a guild's promotion policy.
+	UpsertPromotionPolicy(ctx context.Context, db bun.IDB, policy *PromotionPolicy) error
+
+	// InsertSeasonPromotions records the division moves made when a season ended. Rows
+	// that already exist are left untouched.
+	InsertSeasonPromotions(ctx context.Context, db bun.IDB, promotions []SeasonPromotion) error
+
+	// ListSeasonPromotions retrieves the division moves made when a season ended, in plan
+	// order. Returns an empty slice if none were made.
+	ListSeasonPromotions(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]SeasonPromotion, error)
+
+	// --- Divisions ---
+
+	// CreateDivision inserts a division. Returns ErrAlreadyExists if the guild already
+	// has a division with the same ID.
+	CreateDivision(ctx context.Context, db bun.IDB, division *Division) error
+
+	// GetDivision retrieves a division. Returns nil if the guild has no such division.
+	GetDivision(ctx context.Context, db bun.IDB, guildID, divisionID string) (*Division, error)
+
+	// ListDivisions retrieves a guild's divisions in creation order.
+	ListDivisions(ctx context.Context, db bun.IDB, guildID string) ([]Division, error)
 }
@@ -24,11 +24,11 @@ type LeagueMemberRepository interface {
 	// GetMemberByID retrieves a single member.
 	GetMemberByID(ctx context.Context, db bun.IDB, guildID, memberID string) (*LeagueMember, error)
 
-	// GetMemberByTag retrieves the member holding a specific tag in a guild.
-	GetMemberByTag(ctx context.Context, db bun.IDB, guildID string, tag int) (*LeagueMember, error)
+	// GetMemberByTag retrieves the member holding a specific tag in a guild division.
+	GetMemberByTag(ctx context.Context, db bun.IDB, guildID, divisionID string, tag int) (*LeagueMember, error)
 
-	// GetMembersByTags retrieves members holding specific tags in a guild.
-	GetMembersByTags(ctx context.Context, db bun.IDB, guildID string, tags []int) ([]LeagueMember, error)
+	// GetMembersByTags retrieves members holding specific tags in a guild division.
+	GetMembersByTags(ctx context.Context, db bun.IDB, guildID, divisionID string, tags []int) ([]LeagueMember, error)
 
 	// UpsertMember creates or updates a league member.
 	UpsertMember(ctx context.Context, db bun.IDB, member *LeagueMember) error
@@ -36,6 +36,10 @@ type LeagueMemberRepository interface {
 	// BulkUpsertMembers creates or updates multiple league members.
 	BulkUpsertMembers(ctx context.Context, db bun.IDB, members []LeagueMember) error
 
+	// SetMemberDivision moves a member to a division, creating the member if needed.
+	// The member's tag is cleared, since tags are only unique within a division.
+	SetMemberDivision(ctx context.Context, db bun.IDB, guildID, memberID, divisionID string) error
+
 	// ClearAllTags sets current_tag=NULL for all members in a guild (for tag reset).
 	ClearAllTags(ctx context.Context, db bun.IDB, guildID string) error
 
@@ -120,11 +124,12 @@ func (r *LeagueMemberRepo) GetMemberByID(ctx context.Context, db bun.IDB, guildI
 	return member, nil
 }
 
-func (r *LeagueMemberRepo) GetMemberByTag(ctx context.Context, db bun.IDB, guildID string, tag int) (*LeagueMember, error) {
+func (r *LeagueMemberRepo) GetMemberByTag(ctx context.Context, db bun.IDB, guildID, divisionID string, tag int) (*LeagueMember, error) {
 	member := new(LeagueMember)
 	err := db.NewSelect().
 		Model(member).
 		Where("guild_id = ?", guildID).
+		Where("division_id = ?", divisionID).
 		Where("current_tag = ?", tag).
 		Scan(ctx)
 	if err != nil {
@@ -136,7 +141,7 @@ func (r *LeagueMemberRepo) GetMemberByTag(ctx context.Context, db bun.IDB, guild
 	return member, nil
 }
 
-func (r *LeagueMemberRepo) GetMembersByTags(ctx context.Context, db bun.IDB, guildID string, tags []int) ([]LeagueMember, error) {
+func (r *LeagueMemberRepo) GetMembersByTags(ctx context.Context, db bun.IDB, guildID, divisionID string, tags []int) ([]LeagueMember, error) {
 	if len(tags) == 0 {
 		return nil, nil
 	}
@@ -144,6 +149,7 @@ func (r *LeagueMemberRepo) GetMembersByTags(ctx context.Context, db bun.IDB, gui
 	err := db.NewSelect().
 		Model(&members).
 		Where("guild_id = ?", guildID).
+		Where("division_id = ?", divisionID).
 		Where("current_tag IN (?)", bun.In(tags)).
 		Scan(ctx)
 	if err != nil {
@@ -193,6 +199,29 @@ func (r *LeagueMemberRepo) BulkUpsertMembers(ctx context.Context, db bun.IDB, me
 	return nil
 }
 
+func (r *LeagueMemberRepo) SetMemberDivision(ctx context.Context, db bun.IDB, guildID, memberID, divisionID string) error {
+	now := time.Now().UTC()
+	member := &LeagueMember{
+		GuildID:      guildID,
+		MemberID:     memberID,
+		DivisionID:   divisionID,
+		LastActiveAt: now,
+		UpdatedAt:    now,
+	}
+
+	_, err := db.NewInsert().
+		Model(member).
+		On("CONFLICT (guild_id, member_id) DO UPDATE").
+		Set("division_id = EXCLUDED.division_id").
+		Set("current_tag = NULL").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("leaguemember.SetMemberDivision: %w", err)
+	}
+	return nil
+}
+
 func (r *LeagueMemberRepo) ClearAllTags(ctx context.Context, db bun.IDB, guildID string) error {
 	_, err := db.NewUpdate().
 		Model((*LeagueMember)(nil)).
@@ -0,0 +1,33 @@
+package leaderboardmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding points_policy to leaderboard_seasons...")
+
+		// NULL means the season predates configurable policies and uses the default matchup system.
+		_, err := db.NewRaw(`
+			ALTER TABLE leaderboard_seasons
+			ADD COLUMN IF NOT EXISTS points_policy JSONB
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("add leaderboard_seasons.points_policy: %w", err)
+		}
+
+		fmt.Println("points_policy added to leaderboard_seasons successfully!")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping points_policy from leaderboard_seasons...")
+
+		_, _ = db.NewRaw("ALTER TABLE leaderboard_seasons DROP COLUMN IF EXISTS points_policy").Exec(ctx)
+
+		fmt.Println("points_policy dropped from leaderboard_seasons successfully!")
+		return nil
+	})
+}
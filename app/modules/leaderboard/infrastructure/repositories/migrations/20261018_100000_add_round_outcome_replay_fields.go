@@ -0,0 +1,60 @@
+package leaderboardmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding replay fields to leaderboard_round_outcomes...")
+
+		// participants stores the round inputs so the round can be replayed. NULL means
+		// the round was processed before inputs were kept and can only be replayed verbatim.
+		// created_at records when the round was first processed; processed_at moves on
+		// every recalculation and cannot be used to order rounds.
+		_, err := db.NewRaw(`
+			ALTER TABLE leaderboard_round_outcomes
+			ADD COLUMN IF NOT EXISTS participants JSONB,
+			ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("add leaderboard_round_outcomes replay columns: %w", err)
+		}
+
+		// Backfill from the earliest ledger entry for the round where one exists.
+		_, err = db.NewRaw(`
+			UPDATE leaderboard_round_outcomes ro
+			SET created_at = LEAST(ro.processed_at, COALESCE((
+				SELECT MIN(th.created_at) FROM tag_history th
+				WHERE th.guild_id = ro.guild_id AND th.round_id = ro.round_id
+			), ro.processed_at))
+			WHERE ro.created_at IS NULL
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("backfill leaderboard_round_outcomes.created_at: %w", err)
+		}
+
+		_, err = db.NewRaw(`
+			ALTER TABLE leaderboard_round_outcomes
+			ALTER COLUMN created_at SET DEFAULT now(),
+			ALTER COLUMN created_at SET NOT NULL
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("constrain leaderboard_round_outcomes.created_at: %w", err)
+		}
+
+		fmt.Println("Replay fields added to leaderboard_round_outcomes successfully!")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping replay fields from leaderboard_round_outcomes...")
+
+		_, _ = db.NewRaw("ALTER TABLE leaderboard_round_outcomes DROP COLUMN IF EXISTS participants").Exec(ctx)
+		_, _ = db.NewRaw("ALTER TABLE leaderboard_round_outcomes DROP COLUMN IF EXISTS created_at").Exec(ctx)
+
+		fmt.Println("Replay fields dropped from leaderboard_round_outcomes successfully!")
+		return nil
+	})
+}
@@ -0,0 +1,69 @@
+package leaderboardmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Creating season archive tables...")
+
+		// giant_slays feeds the giant slayer award. Rows written before it existed count 0.
+		_, err := db.NewRaw(`
+			ALTER TABLE leaderboard_point_history
+			ADD COLUMN IF NOT EXISTS giant_slays integer NOT NULL DEFAULT 0
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("add leaderboard_point_history.giant_slays: %w", err)
+		}
+
+		// Archive rows are written once when a season ends and never updated.
+		_, err = db.NewRaw(`
+			CREATE TABLE IF NOT EXISTS leaderboard_season_archive (
+				guild_id         text         NOT NULL,
+				season_id        text         NOT NULL,
+				member_id        text         NOT NULL,
+				final_rank       integer      NOT NULL,
+				total_points     integer      NOT NULL,
+				current_tier     text         NOT NULL,
+				season_best_tag  integer      NOT NULL,
+				rounds_played    integer      NOT NULL,
+				archived_at      timestamptz  NOT NULL DEFAULT now(),
+				PRIMARY KEY (guild_id, season_id, member_id)
+			)
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create leaderboard_season_archive: %w", err)
+		}
+
+		_, err = db.NewRaw(`
+			CREATE TABLE IF NOT EXISTS leaderboard_season_awards (
+				guild_id     text         NOT NULL,
+				season_id    text         NOT NULL,
+				award        text         NOT NULL,
+				member_id    text         NOT NULL,
+				value        integer      NOT NULL,
+				archived_at  timestamptz  NOT NULL DEFAULT now(),
+				PRIMARY KEY (guild_id, season_id, award)
+			)
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create leaderboard_season_awards: %w", err)
+		}
+
+		fmt.Println("Season archive tables created successfully!")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping season archive tables...")
+
+		_, _ = db.NewRaw("DROP TABLE IF EXISTS leaderboard_season_awards").Exec(ctx)
+		_, _ = db.NewRaw("DROP TABLE IF EXISTS leaderboard_season_archive").Exec(ctx)
+		_, _ = db.NewRaw("ALTER TABLE leaderboard_point_history DROP COLUMN IF EXISTS giant_slays").Exec(ctx)
+
+		fmt.Println("Season archive tables dropped successfully!")
+		return nil
+	})
+}
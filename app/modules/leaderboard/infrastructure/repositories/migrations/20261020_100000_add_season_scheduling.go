@@ -0,0 +1,46 @@
+package leaderboardmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding scheduling fields to leaderboard_seasons...")
+
+		// Scheduled seasons are created ahead of time and started by the season scheduler
+		// once start_date passes. timezone records the zone the dates were entered in.
+		_, err := db.NewRaw(`
+			ALTER TABLE leaderboard_seasons
+			ADD COLUMN IF NOT EXISTS is_scheduled boolean NOT NULL DEFAULT false,
+			ADD COLUMN IF NOT EXISTS timezone text NOT NULL DEFAULT 'UTC'
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("add leaderboard_seasons scheduling columns: %w", err)
+		}
+
+		_, err = db.NewRaw(`
+			CREATE INDEX IF NOT EXISTS idx_leaderboard_seasons_scheduled_start
+			ON leaderboard_seasons (start_date)
+			WHERE is_scheduled = true
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create idx_leaderboard_seasons_scheduled_start: %w", err)
+		}
+
+		fmt.Println("Scheduling fields added to leaderboard_seasons successfully!")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping scheduling fields from leaderboard_seasons...")
+
+		_, _ = db.NewRaw("DROP INDEX IF EXISTS idx_leaderboard_seasons_scheduled_start").Exec(ctx)
+		_, _ = db.NewRaw("ALTER TABLE leaderboard_seasons DROP COLUMN IF EXISTS timezone").Exec(ctx)
+		_, _ = db.NewRaw("ALTER TABLE leaderboard_seasons DROP COLUMN IF EXISTS is_scheduled").Exec(ctx)
+
+		fmt.Println("Scheduling fields dropped from leaderboard_seasons successfully!")
+		return nil
+	})
+}
@@ -0,0 +1,38 @@
+package leaderboardmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Creating inactivity policy table...")
+
+		_, err := db.NewRaw(`
+			CREATE TABLE IF NOT EXISTS leaderboard_inactivity_policies (
+				guild_id        text         PRIMARY KEY,
+				enabled         boolean      NOT NULL DEFAULT false,
+				inactive_weeks  integer      NOT NULL,
+				action          text         NOT NULL,
+				drop_positions  integer      NOT NULL DEFAULT 0,
+				updated_at      timestamptz  NOT NULL DEFAULT now()
+			)
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create leaderboard_inactivity_policies: %w", err)
+		}
+
+		fmt.Println("Inactivity policy table created successfully!")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping inactivity policy table...")
+
+		_, _ = db.NewRaw("DROP TABLE IF EXISTS leaderboard_inactivity_policies").Exec(ctx)
+
+		fmt.Println("Inactivity policy table dropped successfully!")
+		return nil
+	})
+}
@@ -0,0 +1,67 @@
+package leaderboardmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding leaderboard divisions...")
+
+		_, err := db.NewRaw(`
+			CREATE TABLE IF NOT EXISTS leaderboard_divisions (
+				guild_id    text         NOT NULL,
+				id          text         NOT NULL,
+				name        text         NOT NULL,
+				created_at  timestamptz  NOT NULL DEFAULT now(),
+				PRIMARY KEY (guild_id, id)
+			)
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create leaderboard_divisions: %w", err)
+		}
+
+		// Existing members stay in the default division, named by the empty ID.
+		_, err = db.NewRaw(`
+			ALTER TABLE league_members
+			ADD COLUMN IF NOT EXISTS division_id text NOT NULL DEFAULT ''
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("add league_members.division_id: %w", err)
+		}
+
+		// Tags are unique within a division rather than across the guild.
+		_, err = db.NewRaw(`DROP INDEX IF EXISTS uq_league_members_tag_per_guild`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("drop uq_league_members_tag_per_guild: %w", err)
+		}
+		_, err = db.NewRaw(`
+			CREATE UNIQUE INDEX IF NOT EXISTS uq_league_members_tag_per_division
+			ON league_members (guild_id, division_id, current_tag)
+			WHERE current_tag IS NOT NULL AND current_tag > 0
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create uq_league_members_tag_per_division: %w", err)
+		}
+
+		fmt.Println("Leaderboard divisions added successfully!")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Removing leaderboard divisions...")
+
+		_, _ = db.NewRaw("DROP INDEX IF EXISTS uq_league_members_tag_per_division").Exec(ctx)
+		_, _ = db.NewRaw("ALTER TABLE league_members DROP COLUMN IF EXISTS division_id").Exec(ctx)
+		_, _ = db.NewRaw(`
+			CREATE UNIQUE INDEX IF NOT EXISTS uq_league_members_tag_per_guild
+			ON league_members (guild_id, current_tag)
+			WHERE current_tag IS NOT NULL AND current_tag > 0
+		`).Exec(ctx)
+		_, _ = db.NewRaw("DROP TABLE IF EXISTS leaderboard_divisions").Exec(ctx)
+
+		fmt.Println("Leaderboard divisions removed successfully!")
+		return nil
+	})
+}
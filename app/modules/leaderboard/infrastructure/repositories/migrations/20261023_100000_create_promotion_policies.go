@@ -0,0 +1,57 @@
+package leaderboardmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Creating promotion policy tables...")
+
+		_, err := db.NewRaw(`
+			CREATE TABLE IF NOT EXISTS leaderboard_promotion_policies (
+				guild_id    text         PRIMARY KEY,
+				enabled     boolean      NOT NULL DEFAULT false,
+				boundaries  jsonb        NOT NULL DEFAULT '[]',
+				updated_at  timestamptz  NOT NULL DEFAULT now()
+			)
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create leaderboard_promotion_policies: %w", err)
+		}
+
+		// The division moves made when a season ended, kept in plan order.
+		_, err = db.NewRaw(`
+			CREATE TABLE IF NOT EXISTS leaderboard_season_promotions (
+				guild_id          text         NOT NULL,
+				season_id         text         NOT NULL,
+				member_id         text         NOT NULL,
+				position          integer      NOT NULL,
+				from_division_id  text         NOT NULL DEFAULT '',
+				to_division_id    text         NOT NULL DEFAULT '',
+				promoted          boolean      NOT NULL DEFAULT false,
+				tag               integer      NOT NULL DEFAULT 0,
+				seed_tag          integer      NOT NULL DEFAULT 0,
+				created_at        timestamptz  NOT NULL DEFAULT now(),
+				PRIMARY KEY (guild_id, season_id, member_id)
+			)
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("create leaderboard_season_promotions: %w", err)
+		}
+
+		fmt.Println("Promotion policy tables created successfully!")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping promotion policy tables...")
+
+		_, _ = db.NewRaw("DROP TABLE IF EXISTS leaderboard_season_promotions").Exec(ctx)
+		_, _ = db.NewRaw("DROP TABLE IF EXISTS leaderboard_promotion_policies").Exec(ctx)
+
+		fmt.Println("Promotion policy tables dropped successfully!")
+		return nil
+	})
+}
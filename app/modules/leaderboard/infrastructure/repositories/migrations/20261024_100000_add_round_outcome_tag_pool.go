@@ -0,0 +1,35 @@
+package leaderboardmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding tag pool column to leaderboard_round_outcomes...")
+
+		// tag_pool stores the tag pool policy the round was allocated under so replay
+		// does not depend on the guild's current model. NULL for rounds processed before
+		// the policy was kept.
+		_, err := db.NewRaw(`
+			ALTER TABLE leaderboard_round_outcomes
+			ADD COLUMN IF NOT EXISTS tag_pool JSONB
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("add leaderboard_round_outcomes.tag_pool: %w", err)
+		}
+
+		fmt.Println("Tag pool column added to leaderboard_round_outcomes successfully!")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping tag pool column from leaderboard_round_outcomes...")
+
+		_, _ = db.NewRaw("ALTER TABLE leaderboard_round_outcomes DROP COLUMN IF EXISTS tag_pool").Exec(ctx)
+
+		fmt.Println("Tag pool column dropped from leaderboard_round_outcomes successfully!")
+		return nil
+	})
+}
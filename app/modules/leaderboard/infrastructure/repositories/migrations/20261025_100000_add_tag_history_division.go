@@ -0,0 +1,51 @@
+package leaderboardmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding division column to tag_history...")
+
+		// Tag numbers repeat across divisions, so each ledger entry records the division
+		// its tag belongs to. Existing entries are attributed to the division their member
+		// is in now, except division changes, whose member has since left the tag's
+		// division; those and entries written before divisions existed stay in the
+		// default one.
+		_, err := db.NewRaw(`
+			ALTER TABLE tag_history
+			ADD COLUMN IF NOT EXISTS division_id text NOT NULL DEFAULT ''
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("add tag_history.division_id: %w", err)
+		}
+
+		_, err = db.NewRaw(`
+			UPDATE tag_history th
+			SET division_id = lm.division_id
+			FROM league_members lm
+			WHERE lm.guild_id = th.guild_id
+			  AND lm.member_id = COALESCE(NULLIF(th.new_member_id, ''), th.old_member_id)
+			  AND lm.division_id <> ''
+			  AND th.division_id = ''
+			  AND th.reason <> 'division_change'
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("backfill tag_history.division_id: %w", err)
+		}
+
+		fmt.Println("Division column added to tag_history successfully!")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping division column from tag_history...")
+
+		_, _ = db.NewRaw("ALTER TABLE tag_history DROP COLUMN IF EXISTS division_id").Exec(ctx)
+
+		fmt.Println("Division column dropped from tag_history successfully!")
+		return nil
+	})
+}
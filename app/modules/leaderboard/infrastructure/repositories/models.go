@@ -6,6 +6,7 @@ import (
 	"github.com/uptrace/bun"
 
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	leaderboarddomain "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/domain"
 	"github.com/google/uuid"
 )
 
@@ -20,6 +21,14 @@ type Season struct {
 	StartDate time.Time `bun:"start_date,nullzero"`
 	EndDate   time.Time `bun:"end_date,nullzero"`
 	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+
+	// Scheduled seasons were created in advance and start automatically at StartDate.
+	// Timezone is the IANA zone their dates were entered in, kept for display.
+	Scheduled bool   `bun:"is_scheduled,notnull,default:false"`
+	Timezone  string `bun:"timezone,notnull,default:'UTC'"`
+
+	// PointsPolicy is fixed when the season starts; nil means the default matchup system.
+	PointsPolicy *leaderboarddomain.PointsPolicy `bun:"points_policy,type:jsonb"`
 }
 
 // PointHistory records points earned by a member for a specific round.
@@ -35,6 +44,8 @@ type PointHistory struct {
 	Reason    string                `bun:"reason"`    // e.g., "Round Matchups", "Admin adjustment: scorecard error"
 	Tier      string                `bun:"tier"`      // Player's tier at time of calculation (Gold/Silver/Bronze)
 	Opponents int                   `bun:"opponents"` // Number of opponents beaten (base_points = opponents * 100)
+	// GiantSlays counts Gold-tier opponents beaten while Bronze; 0 for rows written before it was tracked.
+	GiantSlays int `bun:"giant_slays,notnull,default:0"`
 
 	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
 }
@@ -54,6 +65,90 @@ type SeasonStanding struct {
 	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
 }
 
+// SeasonArchiveStanding is a member's final standing, frozen when the season ended.
+// Archive rows are written once and never updated.
+type SeasonArchiveStanding struct {
+	bun.BaseModel `bun:"table:leaderboard_season_archive,alias:sa"`
+
+	GuildID       string                `bun:"guild_id,pk,notnull"`
+	SeasonID      string                `bun:"season_id,pk,notnull"`
+	MemberID      sharedtypes.DiscordID `bun:"member_id,pk,notnull"`
+	FinalRank     int                   `bun:"final_rank,notnull"`
+	TotalPoints   int                   `bun:"total_points,notnull"`
+	CurrentTier   string                `bun:"current_tier,notnull"`
+	SeasonBestTag int                   `bun:"season_best_tag,notnull"`
+	RoundsPlayed  int                   `bun:"rounds_played,notnull"`
+
+	ArchivedAt time.Time `bun:"archived_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// SeasonAward records an award granted when a season ended.
+type SeasonAward struct {
+	bun.BaseModel `bun:"table:leaderboard_season_awards,alias:sw"`
+
+	GuildID  string                `bun:"guild_id,pk,notnull"`
+	SeasonID string                `bun:"season_id,pk,notnull"`
+	Award    string                `bun:"award,pk,notnull"` // points_champion|most_improved_tag|most_rounds_played|giant_slayer
+	MemberID sharedtypes.DiscordID `bun:"member_id,notnull"`
+	Value    int                   `bun:"value,notnull"`
+
+	ArchivedAt time.Time `bun:"archived_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// InactivityPolicy is a guild's tag decay configuration for members who stop playing.
+type InactivityPolicy struct {
+	bun.BaseModel `bun:"table:leaderboard_inactivity_policies,alias:ip"`
+
+	GuildID       string `bun:"guild_id,pk,notnull"`
+	Enabled       bool   `bun:"enabled,notnull,default:false"`
+	InactiveWeeks int    `bun:"inactive_weeks,notnull"`
+	Action        string `bun:"action,notnull"` // drop|release
+	DropPositions int    `bun:"drop_positions,notnull,default:0"`
+
+	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// PromotionPolicy is a guild's promotion and relegation configuration, applied when a
+// season ends.
+type PromotionPolicy struct {
+	bun.BaseModel `bun:"table:leaderboard_promotion_policies,alias:pp"`
+
+	GuildID    string                                `bun:"guild_id,pk,notnull"`
+	Enabled    bool                                  `bun:"enabled,notnull,default:false"`
+	Boundaries []leaderboarddomain.PromotionBoundary `bun:"boundaries,type:jsonb,notnull"`
+
+	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// SeasonPromotion is a member's division move made when a season ended. Tag is the tag
+// they left behind and SeedTag the tag seeded in their new division; 0 means none.
+type SeasonPromotion struct {
+	bun.BaseModel `bun:"table:leaderboard_season_promotions,alias:sp"`
+
+	GuildID        string                `bun:"guild_id,pk,notnull"`
+	SeasonID       string                `bun:"season_id,pk,notnull"`
+	MemberID       sharedtypes.DiscordID `bun:"member_id,pk,notnull"`
+	Position       int                   `bun:"position,notnull"`
+	FromDivisionID string                `bun:"from_division_id,notnull,default:''"`
+	ToDivisionID   string                `bun:"to_division_id,notnull,default:''"`
+	Promoted       bool                  `bun:"promoted,notnull,default:false"`
+	Tag            int                   `bun:"tag,notnull,default:0"`
+	SeedTag        int                   `bun:"seed_tag,notnull,default:0"`
+
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// Division is a named tag leaderboard within a guild. Members without a division
+// belong to the default one, whose ID is empty and which has no row.
+type Division struct {
+	bun.BaseModel `bun:"table:leaderboard_divisions,alias:ld"`
+
+	GuildID   string    `bun:"guild_id,pk,notnull"`
+	ID        string    `bun:"id,pk,notnull"`
+	Name      string    `bun:"name,notnull"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
 // SeasonStandingDecrement represents a rollback delta for one member in one season.
 type SeasonStandingDecrement struct {
 	MemberID       sharedtypes.DiscordID
@@ -68,7 +163,8 @@ type LeagueMember struct {
 
 	GuildID      string    `bun:"guild_id,pk,notnull"`
 	MemberID     string    `bun:"member_id,pk,notnull"`
-	CurrentTag   *int      `bun:"current_tag"` // NULL = no tag
+	CurrentTag   *int      `bun:"current_tag"`                    // NULL = no tag
+	DivisionID   string    `bun:"division_id,notnull,default:''"` // "" = default division
 	LastActiveAt time.Time `bun:"last_active_at,notnull,default:now()"`
 	UpdatedAt    time.Time `bun:"updated_at,notnull,default:now()"`
 }
@@ -81,9 +177,10 @@ type TagHistoryEntry struct {
 	GuildID     string     `bun:"guild_id,notnull"`
 	RoundID     *uuid.UUID `bun:"round_id,type:uuid"`
 	TagNumber   int        `bun:"tag_number,notnull"`
+	DivisionID  string     `bun:"division_id,notnull,default:''"` // division the tag belongs to; "" = default
 	OldMemberID *string    `bun:"old_member_id"`
 	NewMemberID string     `bun:"new_member_id,notnull"`
-	Reason      string     `bun:"reason,notnull"` // claim|round_swap|admin_fix|reset
+	Reason      string     `bun:"reason,notnull"` // claim|round_swap|admin_fix|reset|inactivity
 	Metadata    string     `bun:"metadata,type:jsonb,notnull,default:'{}'"`
 	CreatedAt   time.Time  `bun:"created_at,notnull,default:now()"`
 }
@@ -97,4 +194,13 @@ type RoundOutcome struct {
 	SeasonID       *string   `bun:"season_id"`
 	ProcessingHash string    `bun:"processing_hash,notnull"`
 	ProcessedAt    time.Time `bun:"processed_at,notnull,default:now()"`
+
+	// Participants holds the inputs the round was last processed with; nil for rounds
+	// processed before inputs were stored.
+	Participants []leaderboarddomain.RoundInput `bun:"participants,type:jsonb"`
+	// TagPool is the tag pool policy the round was allocated under; nil for rounds
+	// processed before the policy was stored.
+	TagPool *leaderboarddomain.TagPoolPolicy `bun:"tag_pool,type:jsonb"`
+	// CreatedAt is when the round was first processed and orders rounds for replay.
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:now()"`
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/uptrace/bun"
@@ -100,6 +101,57 @@ func (r *Impl) DeletePointHistoryForRound(ctx context.Context, db bun.IDB, guild
 	return nil
 }
 
+// ListPointHistoryForGuild retrieves all point history for a guild, oldest first.
+func (r *Impl) ListPointHistoryForGuild(ctx context.Context, db bun.IDB, guildID string) ([]PointHistory, error) {
+	if db == nil {
+		db = r.db
+	}
+	var history []PointHistory
+	err := db.NewSelect().
+		Model(&history).
+		Where("guild_id = ?", guildID).
+		OrderExpr("created_at ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("leaderboarddb.ListPointHistoryForGuild: %w", err)
+	}
+	return history, nil
+}
+
+// ListPointHistoryForSeasonUntil retrieves a season's point history written at or before until, oldest first.
+func (r *Impl) ListPointHistoryForSeasonUntil(ctx context.Context, db bun.IDB, guildID string, seasonID string, until time.Time) ([]PointHistory, error) {
+	if db == nil {
+		db = r.db
+	}
+	var history []PointHistory
+	err := db.NewSelect().
+		Model(&history).
+		Where("guild_id = ?", guildID).
+		Where("season_id = ?", seasonID).
+		Where("created_at <= ?", until).
+		OrderExpr("created_at ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("leaderboarddb.ListPointHistoryForSeasonUntil: %w", err)
+	}
+	return history, nil
+}
+
+// DeleteSeasonStandingsForGuild deletes every season standing for a guild across all seasons.
+func (r *Impl) DeleteSeasonStandingsForGuild(ctx context.Context, db bun.IDB, guildID string) error {
+	if db == nil {
+		db = r.db
+	}
+	_, err := db.NewDelete().
+		Model((*SeasonStanding)(nil)).
+		Where("guild_id = ?", guildID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("leaderboarddb.DeleteSeasonStandingsForGuild: %w", err)
+	}
+	return nil
+}
+
 // UpsertSeasonStanding updates or creates a season standing record.
 func (r *Impl) UpsertSeasonStanding(ctx context.Context, db bun.IDB, guildID string, standing *SeasonStanding) error {
 	if db == nil {
@@ -402,6 +454,50 @@ func (r *Impl) DeactivateAllSeasons(ctx context.Context, db bun.IDB, guildID str
 	return nil
 }
 
+// ActivateSeason makes a scheduled season the active one.
+func (r *Impl) ActivateSeason(ctx context.Context, db bun.IDB, guildID string, seasonID string) error {
+	if db == nil {
+		db = r.db
+	}
+	_, err := db.NewUpdate().
+		Model((*Season)(nil)).
+		Set("is_active = true").
+		Set("is_scheduled = false").
+		Where("guild_id = ?", guildID).
+		Where("id = ?", seasonID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("leaderboarddb.ActivateSeason: %w", err)
+	}
+	return nil
+}
+
+// ListGuildsWithDueSeasonTransitions returns the guilds with a season due to start or end.
+func (r *Impl) ListGuildsWithDueSeasonTransitions(ctx context.Context, db bun.IDB, now time.Time) ([]string, error) {
+	if db == nil {
+		db = r.db
+	}
+	var guildIDs []string
+	err := db.NewSelect().
+		Model((*Season)(nil)).
+		ColumnExpr("DISTINCT guild_id").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return q.Where("is_scheduled = true").Where("start_date <= ?", now)
+				}).
+				WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return q.Where("is_active = true").Where("end_date IS NOT NULL").Where("end_date <= ?", now)
+				})
+		}).
+		OrderExpr("guild_id ASC").
+		Scan(ctx, &guildIDs)
+	if err != nil {
+		return nil, fmt.Errorf("leaderboarddb.ListGuildsWithDueSeasonTransitions: %w", err)
+	}
+	return guildIDs, nil
+}
+
 // GetPointHistoryForMember retrieves point history for a member, ordered by created_at desc.
 func (r *Impl) GetPointHistoryForMember(ctx context.Context, db bun.IDB, guildID string, memberID sharedtypes.DiscordID, limit int) ([]PointHistory, error) {
 	if db == nil {
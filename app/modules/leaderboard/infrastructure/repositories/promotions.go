@@ -0,0 +1,87 @@
+package leaderboarddb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// GetPromotionPolicy retrieves a guild's promotion policy. Returns nil if none is set.
+func (r *Impl) GetPromotionPolicy(ctx context.Context, db bun.IDB, guildID string) (*PromotionPolicy, error) {
+	if db == nil {
+		db = r.db
+	}
+	policy := new(PromotionPolicy)
+	err := db.NewSelect().
+		Model(policy).
+		Where("guild_id = ?", guildID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("leaderboarddb.GetPromotionPolicy: %w", err)
+	}
+	return policy, nil
+}
+
+// UpsertPromotionPolicy creates or replaces a guild's promotion policy.
+func (r *Impl) UpsertPromotionPolicy(ctx context.Context, db bun.IDB, policy *PromotionPolicy) error {
+	if db == nil {
+		db = r.db
+	}
+	policy.UpdatedAt = time.Now().UTC()
+	_, err := db.NewInsert().
+		Model(policy).
+		On("CONFLICT (guild_id) DO UPDATE").
+		Set("enabled = EXCLUDED.enabled").
+		Set("boundaries = EXCLUDED.boundaries").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("leaderboarddb.UpsertPromotionPolicy: %w", err)
+	}
+	return nil
+}
+
+// InsertSeasonPromotions records the division moves made when a season ended.
+// Existing rows are kept, so recording is write-once.
+func (r *Impl) InsertSeasonPromotions(ctx context.Context, db bun.IDB, promotions []SeasonPromotion) error {
+	if len(promotions) == 0 {
+		return nil
+	}
+	if db == nil {
+		db = r.db
+	}
+	_, err := db.NewInsert().
+		Model(&promotions).
+		On("CONFLICT (guild_id, season_id, member_id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("leaderboarddb.InsertSeasonPromotions: %w", err)
+	}
+	return nil
+}
+
+// ListSeasonPromotions retrieves the division moves made when a season ended, in the
+// order they were planned.
+func (r *Impl) ListSeasonPromotions(ctx context.Context, db bun.IDB, guildID string, seasonID string) ([]SeasonPromotion, error) {
+	if db == nil {
+		db = r.db
+	}
+	var promotions []SeasonPromotion
+	err := db.NewSelect().
+		Model(&promotions).
+		Where("guild_id = ?", guildID).
+		Where("season_id = ?", seasonID).
+		Order("position ASC").
+		Scan(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("leaderboarddb.ListSeasonPromotions: %w", err)
+	}
+	return promotions, nil
+}
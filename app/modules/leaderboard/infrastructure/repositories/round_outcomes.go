@@ -3,6 +3,7 @@ package leaderboarddb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -17,6 +18,13 @@ type RoundOutcomeRepository interface {
 
 	// UpsertRoundOutcome creates or updates a round outcome record.
 	UpsertRoundOutcome(ctx context.Context, db bun.IDB, outcome *RoundOutcome) error
+
+	// ListRoundOutcomes retrieves every round outcome for a guild in first-processed order.
+	ListRoundOutcomes(ctx context.Context, db bun.IDB, guildID string) ([]RoundOutcome, error)
+
+	// ListSharedRoundOutcomes retrieves the round outcomes both members took part in,
+	// in first-processed order. Rounds processed before inputs were stored are skipped.
+	ListSharedRoundOutcomes(ctx context.Context, db bun.IDB, guildID, memberA, memberB string) ([]RoundOutcome, error)
 }
 
 // RoundOutcomeRepo implements RoundOutcomeRepository.
@@ -49,9 +57,49 @@ func (r *RoundOutcomeRepo) UpsertRoundOutcome(ctx context.Context, db bun.IDB, o
 		Set("season_id = EXCLUDED.season_id").
 		Set("processing_hash = EXCLUDED.processing_hash").
 		Set("processed_at = EXCLUDED.processed_at").
+		Set("participants = EXCLUDED.participants").
+		Set("tag_pool = EXCLUDED.tag_pool").
 		Exec(ctx)
 	if err != nil {
 		return fmt.Errorf("roundoutcome.UpsertRoundOutcome: %w", err)
 	}
 	return nil
 }
+
+func (r *RoundOutcomeRepo) ListRoundOutcomes(ctx context.Context, db bun.IDB, guildID string) ([]RoundOutcome, error) {
+	var outcomes []RoundOutcome
+	err := db.NewSelect().
+		Model(&outcomes).
+		Where("guild_id = ?", guildID).
+		OrderExpr("created_at ASC, round_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("roundoutcome.ListRoundOutcomes: %w", err)
+	}
+	return outcomes, nil
+}
+
+func (r *RoundOutcomeRepo) ListSharedRoundOutcomes(ctx context.Context, db bun.IDB, guildID, memberA, memberB string) ([]RoundOutcome, error) {
+	// participants @> '[{"member_id": "..."}]' matches any element with that member.
+	containsA, err := json.Marshal([]map[string]string{{"member_id": memberA}})
+	if err != nil {
+		return nil, fmt.Errorf("roundoutcome.ListSharedRoundOutcomes: %w", err)
+	}
+	containsB, err := json.Marshal([]map[string]string{{"member_id": memberB}})
+	if err != nil {
+		return nil, fmt.Errorf("roundoutcome.ListSharedRoundOutcomes: %w", err)
+	}
+
+	var outcomes []RoundOutcome
+	err = db.NewSelect().
+		Model(&outcomes).
+		Where("guild_id = ?", guildID).
+		Where("participants @> ?::jsonb", string(containsA)).
+		Where("participants @> ?::jsonb", string(containsB)).
+		OrderExpr("created_at ASC, round_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("roundoutcome.ListSharedRoundOutcomes: %w", err)
+	}
+	return outcomes, nil
+}
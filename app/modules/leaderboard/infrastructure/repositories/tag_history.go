@@ -28,6 +28,17 @@ type TagHistoryRepository interface {
 
 	// GetTagHistoryForGuild retrieves tag history for an entire guild since a given time.
 	GetTagHistoryForGuild(ctx context.Context, db bun.IDB, guildID string, since time.Time) ([]TagHistoryEntry, error)
+
+	// GetTagHistoryForGuildUntil retrieves a guild's tag history written at or before until,
+	// oldest first.
+	GetTagHistoryForGuildUntil(ctx context.Context, db bun.IDB, guildID string, until time.Time) ([]TagHistoryEntry, error)
+
+	// GetTagHistoryBetweenMembers retrieves the tag changes that passed a tag directly
+	// from one of the two members to the other, oldest first.
+	GetTagHistoryBetweenMembers(ctx context.Context, db bun.IDB, guildID, memberA, memberB string) ([]TagHistoryEntry, error)
+
+	// DeleteTagHistoryForRounds deletes the entries with the given reason written by the given rounds.
+	DeleteTagHistoryForRounds(ctx context.Context, db bun.IDB, guildID string, roundIDs []uuid.UUID, reason string) error
 }
 
 // TagHistoryRepo implements TagHistoryRepository.
@@ -140,3 +151,55 @@ func (r *TagHistoryRepo) GetTagHistoryForGuild(ctx context.Context, db bun.IDB,
 	}
 	return entries, nil
 }
+
+func (r *TagHistoryRepo) GetTagHistoryForGuildUntil(ctx context.Context, db bun.IDB, guildID string, until time.Time) ([]TagHistoryEntry, error) {
+	var entries []TagHistoryEntry
+	err := db.NewSelect().
+		Model(&entries).
+		Where("guild_id = ?", guildID).
+		Where("created_at <= ?", until).
+		OrderExpr("created_at ASC, id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("taghistory.GetTagHistoryForGuildUntil: %w", err)
+	}
+	return entries, nil
+}
+
+func (r *TagHistoryRepo) DeleteTagHistoryForRounds(ctx context.Context, db bun.IDB, guildID string, roundIDs []uuid.UUID, reason string) error {
+	if len(roundIDs) == 0 {
+		return nil
+	}
+	_, err := db.NewDelete().
+		Model((*TagHistoryEntry)(nil)).
+		Where("guild_id = ?", guildID).
+		Where("round_id IN (?)", bun.In(roundIDs)).
+		Where("reason = ?", reason).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("taghistory.DeleteTagHistoryForRounds: %w", err)
+	}
+	return nil
+}
+
+func (r *TagHistoryRepo) GetTagHistoryBetweenMembers(ctx context.Context, db bun.IDB, guildID, memberA, memberB string) ([]TagHistoryEntry, error) {
+	var entries []TagHistoryEntry
+	err := db.NewSelect().
+		Model(&entries).
+		Where("guild_id = ?", guildID).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return q.Where("old_member_id = ?", memberA).Where("new_member_id = ?", memberB)
+				}).
+				WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+					return q.Where("old_member_id = ?", memberB).Where("new_member_id = ?", memberA)
+				})
+		}).
+		Order("created_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("taghistory.GetTagHistoryBetweenMembers: %w", err)
+	}
+	return entries, nil
+}
@@ -8,10 +8,12 @@ import (
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
 	guildevents "github.com/Black-And-White-Club/frolf-bot-shared/events/guild"
 	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	sharedevents "github.com/Black-And-White-Club/frolf-bot-shared/events/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
 	leaderboardhandlers "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/handlers"
+	leaderboardqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/queue"
 	"github.com/Black-And-White-Club/frolf-bot/config"
 	"github.com/ThreeDotsLabs/watermill/components/metrics"
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -207,6 +209,7 @@ func (r *LeaderboardRouter) RegisterHandlers(ctx context.Context, handlers leade
 	// MUTATIONS: Handlers that change leaderboard state
 	registerHandler(deps, leaderboardevents.LeaderboardUpdateRequestedV1, handlers.HandleLeaderboardUpdateRequested)
 	registerHandler(deps, leaderboardevents.TagSwapRequestedV1, handlers.HandleTagSwapRequested)
+	registerHandler(deps, leaderboardhandlers.LeaderboardSwapIntentCancelRequestedV1, handlers.HandleSwapIntentCancelRequested)
 
 	// SPECIAL CASE: Use registerFanOutHandler for Batch Tag Assignments
 	// because it triggers both Leaderboard events and Round sync events.
@@ -214,7 +217,7 @@ func (r *LeaderboardRouter) RegisterHandlers(ctx context.Context, handlers leade
 
 	// READS: Handlers that query the current state
 	registerHandler(deps, leaderboardevents.GetLeaderboardRequestedV1, handlers.HandleGetLeaderboardRequest)
-	registerHandler(deps, "leaderboard.snapshot.request.v2.>", handlers.HandleGetLeaderboardRequest)
+	registerHandler(deps, "leaderboard.snapshot.request.v2.>", handlers.HandleLeaderboardSnapshotRequest)
 	registerHandler(deps, sharedevents.DiscordTagLookupRequestedV1, handlers.HandleGetTagByUserIDRequest)
 	registerHandler(deps, sharedevents.RoundTagLookupRequestedV1, handlers.HandleRoundGetTagRequest)
 	registerHandler(deps, sharedevents.TagAvailabilityCheckRequestedV1, handlers.HandleTagAvailabilityCheckRequested)
@@ -224,17 +227,53 @@ func (r *LeaderboardRouter) RegisterHandlers(ctx context.Context, handlers leade
 	registerHandler(deps, leaderboardevents.LeaderboardManualPointAdjustmentV2, handlers.HandleManualPointAdjustment)
 	registerHandler(deps, leaderboardevents.LeaderboardRecalculateRoundV1, handlers.HandleRecalculateRound)
 	registerHandler(deps, leaderboardevents.LeaderboardStartNewSeasonV1, handlers.HandleStartNewSeason)
+	registerHandler(deps, leaderboardhandlers.LeaderboardStartSeasonWithPolicyV1, handlers.HandleStartSeasonWithPolicy)
+	registerHandler(deps, leaderboardhandlers.LeaderboardReplayRequestedV1, handlers.HandleReplayRequested)
+	registerHandler(deps, leaderboardhandlers.LeaderboardSeasonScheduleRequestedV1, handlers.HandleSeasonScheduleRequested)
+	registerHandler(deps, leaderboardqueue.LeaderboardSeasonScheduleTickV1, handlers.HandleSeasonScheduleTick)
+	registerHandler(deps, leaderboardhandlers.LeaderboardInactivityPolicySetRequestedV1, handlers.HandleInactivityPolicySetRequested)
+	registerHandler(deps, leaderboardhandlers.LeaderboardInactivityPreviewRequestedV1, handlers.HandleInactivityPreviewRequested)
+	registerHandler(deps, leaderboardqueue.LeaderboardInactivityDecayTickV1, handlers.HandleInactivityDecayTick)
+	registerHandler(deps, leaderboardhandlers.LeaderboardTagPoolPolicySetRequestedV1, handlers.HandleTagPoolPolicySetRequested)
+	registerHandler(deps, leaderboardhandlers.LeaderboardPromotionPolicySetRequestedV1, handlers.HandlePromotionPolicySetRequested)
+	registerHandler(deps, leaderboardhandlers.LeaderboardPromotionPreviewRequestedV1, handlers.HandlePromotionPreviewRequested)
+	registerHandler(deps, leaderboardhandlers.LeaderboardDataExportRequestedV1, handlers.HandleDataExportRequested)
+	registerHandler(deps, leaderboardhandlers.LeaderboardTagHistoryImportRequestedV1, handlers.HandleTagHistoryImportRequested)
+	registerHandler(deps, leaderboardhandlers.LeaderboardDivisionCreateRequestedV1, handlers.HandleDivisionCreateRequested)
+	registerHandler(deps, leaderboardhandlers.LeaderboardDivisionMemberAssignRequestedV1, handlers.HandleDivisionMemberAssignRequested)
 	registerHandler(deps, leaderboardevents.LeaderboardEndSeasonV1, handlers.HandleEndSeason)
 	registerHandler(deps, leaderboardevents.LeaderboardGetSeasonStandingsV1, handlers.HandleGetSeasonStandings)
 
 	// PWA REQUEST-REPLY ENDPOINTS
 	registerHandler(deps, "leaderboard.seasons.list.request.v1.>", handlers.HandleListSeasonsRequest)
 	registerHandler(deps, "leaderboard.season.standings.request.v1.>", handlers.HandleSeasonStandingsRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardSeasonPointsPolicyRequestV1+".>", handlers.HandleSeasonPointsPolicyRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardTagPoolPolicyRequestV1+".>", handlers.HandleTagPoolPolicyRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardPromotionPolicyRequestV1+".>", handlers.HandlePromotionPolicyRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardDivisionListRequestV1+".>", handlers.HandleDivisionListRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardDivisionLeaderboardRequestV1+".>", handlers.HandleDivisionLeaderboardRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardDivisionTagListRequestV1+".>", handlers.HandleDivisionTagListRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardDivisionStandingsRequestV1+".>", handlers.HandleDivisionStandingsRequest)
 
 	// TAG HISTORY REQUEST-REPLY
 	registerHandler(deps, "leaderboard.tag.history.requested.v1.>", handlers.HandleTagHistoryRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardHeadToHeadRequestedV1+".>", handlers.HandleHeadToHeadRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardChartPointsRaceRequestedV1+".>", handlers.HandlePointsRaceChartRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardChartScoreDistributionRequestedV1+".>", handlers.HandleScoreDistributionChartRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardChartAttendanceHeatmapRequestedV1+".>", handlers.HandleAttendanceHeatmapChartRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardChartTagComparisonRequestedV1+".>", handlers.HandleTagComparisonChartRequest)
 	registerHandler(deps, "leaderboard.tag.graph.requested.v1.>", handlers.HandleTagGraphRequest)
 	registerHandler(deps, "leaderboard.tag.list.requested.v1.>", handlers.HandleTagListRequest)
+	registerHandler(deps, leaderboardhandlers.LeaderboardSwapIntentsRequestV1+".>", handlers.HandleSwapIntentsRequest)
+
+	// Course layout stats (Request-Reply)
+	registerHandler(deps, leaderboardhandlers.LeaderboardCourseLayoutStatsRequestV1+".>", handlers.HandleCourseLayoutStatsRequest)
+
+	// Member stats (Request-Reply) and the events that invalidate cached profiles
+	registerHandler(deps, leaderboardhandlers.LeaderboardMemberStatsRequestedV1+".>", handlers.HandleMemberStatsRequest)
+	registerHandler(deps, roundevents.RoundFinalizedV2, handlers.HandleRoundFinalized)
+	registerHandler(deps, sharedevents.ScoreUpdatedV1, handlers.HandleScoreUpdated)
+	registerHandler(deps, sharedevents.ScoreBulkUpdatedV1, handlers.HandleScoreBulkUpdated)
 
 	// INFRASTRUCTURE
 	registerHandler(deps, guildevents.GuildConfigCreatedV1, handlers.HandleGuildConfigCreated)
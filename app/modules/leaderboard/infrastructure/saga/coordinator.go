@@ -1,12 +1,14 @@
 package saga
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
-	"strings"
+	"slices"
+	"time"
 
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
@@ -22,8 +24,17 @@ type SwapSagaCoordinator struct {
 }
 type SagaCoordinator interface {
 	ProcessIntent(ctx context.Context, intent SwapIntent) error
+	CancelIntent(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) error
+	ListPending(ctx context.Context, guildID sharedtypes.GuildID) (PendingSwaps, error)
 }
 
+// IntentTTL is how long a swap intent waits for its chain to close. It is also the
+// bucket TTL, so intents are removed even in guilds that see no further swap activity.
+const IntentTTL = 7 * 24 * time.Hour
+
+// ErrIntentNotFound is returned when cancelling an intent that is not pending.
+var ErrIntentNotFound = errors.New("swap intent not found")
+
 func NewSwapSagaCoordinator(kv jetstream.KeyValue, service leaderboardservice.Service, logger *slog.Logger) *SwapSagaCoordinator {
 	return &SwapSagaCoordinator{
 		kv:      kv,
@@ -32,9 +43,16 @@ func NewSwapSagaCoordinator(kv jetstream.KeyValue, service leaderboardservice.Se
 	}
 }
 
+func intentKey(guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) string {
+	return fmt.Sprintf("intents.%s.%s", guildID, userID)
+}
+
 // ProcessIntent saves a new intent and checks if it completes an N-way swap chain.
+// Saving an intent replaces the user's previous one and restarts its expiry.
 func (s *SwapSagaCoordinator) ProcessIntent(ctx context.Context, intent SwapIntent) error {
-	key := fmt.Sprintf("intents.%s.%s", intent.GuildID, intent.UserID)
+	key := intentKey(intent.GuildID, intent.UserID)
+	intent.CreatedAt = time.Now().UTC()
+	intent.ExpiresAt = intent.CreatedAt.Add(IntentTTL)
 	data, _ := json.Marshal(intent)
 
 	if _, err := s.kv.Put(ctx, key, data); err != nil {
@@ -53,9 +71,91 @@ func (s *SwapSagaCoordinator) ProcessIntent(ctx context.Context, intent SwapInte
 		return nil
 	}
 
+	stale, err := s.staleIntents(ctx, intent.GuildID, cycle, allIntents)
+	if err != nil {
+		return err
+	}
+	if len(stale) > 0 {
+		// A member's tag changed since they stated their intent, so the chain no longer
+		// holds. Their intent is dropped; the rest stay pending.
+		s.logger.WarnContext(ctx, "Swap cycle has stale intents, not executing",
+			attr.Any("stale_users", stale))
+		for _, userID := range stale {
+			s.deleteIntent(ctx, intent.GuildID, userID)
+		}
+		return nil
+	}
+
 	return s.executeCycle(ctx, intent.GuildID, cycle, allIntents)
 }
 
+// CancelIntent withdraws a user's pending swap intent.
+func (s *SwapSagaCoordinator) CancelIntent(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) error {
+	key := intentKey(guildID, userID)
+	if _, err := s.kv.Get(ctx, key); err != nil {
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			return ErrIntentNotFound
+		}
+		return fmt.Errorf("failed to load swap intent: %w", err)
+	}
+	if err := s.kv.Delete(ctx, key); err != nil {
+		return fmt.Errorf("failed to cancel swap intent: %w", err)
+	}
+	return nil
+}
+
+// ListPending returns a guild's pending swap intents, oldest first, and the partial
+// chains they form.
+func (s *SwapSagaCoordinator) ListPending(ctx context.Context, guildID sharedtypes.GuildID) (PendingSwaps, error) {
+	intents, err := s.getGuildIntents(ctx, guildID)
+	if err != nil {
+		return PendingSwaps{}, err
+	}
+
+	pending := PendingSwaps{Intents: make([]SwapIntent, 0, len(intents))}
+	for _, intent := range intents {
+		pending.Intents = append(pending.Intents, intent)
+	}
+	slices.SortFunc(pending.Intents, func(a, b SwapIntent) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+	pending.Chains = buildChains(pending.Intents)
+	return pending, nil
+}
+
+// buildChains follows each intent to the holder of its target tag. A chain starts at
+// an intent whose tag nobody wants and runs until the wanted tag's holder has no
+// intent. Chains that share a tail each list it.
+func buildChains(intents []SwapIntent) []SwapChain {
+	byCurrentTag := make(map[sharedtypes.TagNumber]SwapIntent)
+	wanted := make(map[sharedtypes.TagNumber]bool)
+	for _, intent := range intents {
+		if intent.CurrentTag != 0 {
+			byCurrentTag[intent.CurrentTag] = intent
+		}
+		wanted[intent.TargetTag] = true
+	}
+
+	chains := []SwapChain{}
+	for _, head := range intents {
+		if head.CurrentTag != 0 && wanted[head.CurrentTag] {
+			continue
+		}
+		chain := SwapChain{}
+		seen := make(map[sharedtypes.DiscordID]bool)
+		for curr, ok := head, true; ok && !seen[curr.UserID]; curr, ok = byCurrentTag[curr.TargetTag] {
+			seen[curr.UserID] = true
+			chain.Intents = append(chain.Intents, curr)
+			chain.WaitingOnTag = curr.TargetTag
+		}
+		chains = append(chains, chain)
+	}
+	return chains
+}
+
 func (s *SwapSagaCoordinator) findCycle(start sharedtypes.DiscordID, intents map[sharedtypes.DiscordID]SwapIntent) []sharedtypes.DiscordID {
 	visited := make(map[sharedtypes.DiscordID]bool)
 	path := []sharedtypes.DiscordID{}
@@ -119,44 +219,68 @@ func (s *SwapSagaCoordinator) executeCycle(ctx context.Context, guildID sharedty
 
 	// Cleanup KV entries
 	for _, userID := range cycle {
-		key := fmt.Sprintf("intents.%s.%s", guildID, userID)
-		if err := s.kv.Delete(ctx, key); err != nil {
-			s.logger.WarnContext(ctx, "Failed to delete processed intent",
-				attr.String("key", key),
-				attr.Error(err))
-		}
+		s.deleteIntent(ctx, guildID, userID)
 	}
 
 	return nil
 }
 
+// staleIntents returns the cycle members whose live tag no longer matches the tag
+// their intent was made with.
+func (s *SwapSagaCoordinator) staleIntents(ctx context.Context, guildID sharedtypes.GuildID, cycle []sharedtypes.DiscordID, intents map[sharedtypes.DiscordID]SwapIntent) ([]sharedtypes.DiscordID, error) {
+	var stale []sharedtypes.DiscordID
+	for _, userID := range cycle {
+		result, err := s.service.GetTagByUserID(ctx, guildID, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify tag for swap cycle: %w", err)
+		}
+		var live sharedtypes.TagNumber
+		if result.Success != nil {
+			live = *result.Success
+		}
+		if live != intents[userID].CurrentTag {
+			stale = append(stale, userID)
+		}
+	}
+	return stale, nil
+}
+
+func (s *SwapSagaCoordinator) deleteIntent(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) {
+	key := intentKey(guildID, userID)
+	if err := s.kv.Delete(ctx, key); err != nil {
+		s.logger.WarnContext(ctx, "Failed to delete swap intent",
+			attr.String("key", key),
+			attr.Error(err))
+	}
+}
+
+// getGuildIntents loads a guild's pending intents. Only the guild's own keys are
+// listed, and expired intents are deleted as they are found.
 func (s *SwapSagaCoordinator) getGuildIntents(ctx context.Context, guildID sharedtypes.GuildID) (map[sharedtypes.DiscordID]SwapIntent, error) {
 
 	intents := make(map[sharedtypes.DiscordID]SwapIntent)
-	prefix := fmt.Sprintf("intents.%s.", guildID)
 
-	keys, err := s.kv.Keys(ctx)
+	lister, err := s.kv.ListKeysFiltered(ctx, intentKey(guildID, "*"))
 	if err != nil {
-		if errors.Is(err, jetstream.ErrNoKeysFound) {
-			return intents, nil
-		}
 		return nil, err
 	}
 
-	for _, k := range keys {
-		if !strings.HasPrefix(k, prefix) {
-			continue
-		}
-
+	now := time.Now().UTC()
+	for k := range lister.Keys() {
 		entry, err := s.kv.Get(ctx, k)
 		if err != nil {
 			continue
 		}
 
 		var intent SwapIntent
-		if err := json.Unmarshal(entry.Value(), &intent); err == nil {
-			intents[intent.UserID] = intent
+		if err := json.Unmarshal(entry.Value(), &intent); err != nil {
+			continue
+		}
+		if intent.Expired(now) {
+			s.deleteIntent(ctx, guildID, intent.UserID)
+			continue
 		}
+		intents[intent.UserID] = intent
 	}
 
 	return intents, nil
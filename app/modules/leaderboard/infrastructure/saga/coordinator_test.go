@@ -2,9 +2,13 @@ package saga
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"io"
 	"log/slog"
+	"slices"
 	"testing"
+	"time"
 
 	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
@@ -60,6 +64,7 @@ func TestSwapSagaCoordinator_ProcessIntent(t *testing.T) {
 						batchRequests = reqs
 						return results.SuccessResult[leaderboardtypes.LeaderboardData, error](leaderboardtypes.LeaderboardData{}), nil
 					},
+					GetTagByUserIDFunc: liveTags(map[sharedtypes.DiscordID]sharedtypes.TagNumber{"user-1": 1, "user-2": 2}),
 				}
 				coordinator := NewSwapSagaCoordinator(kv, svc, logger)
 
@@ -101,6 +106,7 @@ func TestSwapSagaCoordinator_ProcessIntent(t *testing.T) {
 						batchRequests = reqs
 						return results.SuccessResult[leaderboardtypes.LeaderboardData, error](leaderboardtypes.LeaderboardData{}), nil
 					},
+					GetTagByUserIDFunc: liveTags(map[sharedtypes.DiscordID]sharedtypes.TagNumber{"u1": 1, "u2": 2, "u3": 3}),
 				}
 				coordinator := NewSwapSagaCoordinator(kv, svc, logger)
 
@@ -127,3 +133,155 @@ func TestSwapSagaCoordinator_ProcessIntent(t *testing.T) {
 		})
 	}
 }
+
+func liveTags(tags map[sharedtypes.DiscordID]sharedtypes.TagNumber) func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[sharedtypes.TagNumber, error], error) {
+	return func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[sharedtypes.TagNumber, error], error) {
+		tag, ok := tags[userID]
+		if !ok {
+			return results.FailureResult[sharedtypes.TagNumber, error](errors.New("no tag")), nil
+		}
+		return results.SuccessResult[sharedtypes.TagNumber, error](tag), nil
+	}
+}
+
+func putIntent(t *testing.T, kv *FakeKeyValue, intent SwapIntent) {
+	t.Helper()
+	data, err := json.Marshal(intent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kv.data[intentKey(intent.GuildID, intent.UserID)] = data
+}
+
+func TestSwapSagaCoordinator_StaleIntentBlocksCycle(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	guildID := sharedtypes.GuildID("guild-1")
+
+	kv := NewFakeKeyValue()
+	svc := &FakeLeaderboardService{
+		// user-2 lost tag 2 since stating their intent.
+		GetTagByUserIDFunc: liveTags(map[sharedtypes.DiscordID]sharedtypes.TagNumber{"user-1": 1, "user-2": 7}),
+	}
+	coordinator := NewSwapSagaCoordinator(kv, svc, logger)
+
+	putIntent(t, kv, SwapIntent{UserID: "user-2", CurrentTag: 2, TargetTag: 1, GuildID: guildID})
+	err := coordinator.ProcessIntent(ctx, SwapIntent{UserID: "user-1", CurrentTag: 1, TargetTag: 2, GuildID: guildID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if slices.Contains(svc.trace, "ExecuteBatchTagAssignment") {
+		t.Fatal("a cycle with a stale intent must not execute")
+	}
+	if _, ok := kv.data["intents.guild-1.user-2"]; ok {
+		t.Error("expected stale intent to be dropped")
+	}
+	if _, ok := kv.data["intents.guild-1.user-1"]; !ok {
+		t.Error("expected valid intent to stay pending")
+	}
+}
+
+func TestSwapSagaCoordinator_ExpiredIntentsAreIgnored(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	guildID := sharedtypes.GuildID("guild-1")
+
+	kv := NewFakeKeyValue()
+	svc := &FakeLeaderboardService{}
+	coordinator := NewSwapSagaCoordinator(kv, svc, logger)
+
+	past := time.Now().UTC().Add(-time.Hour)
+	putIntent(t, kv, SwapIntent{UserID: "user-2", CurrentTag: 2, TargetTag: 1, GuildID: guildID, CreatedAt: past.Add(-IntentTTL), ExpiresAt: past})
+	if err := coordinator.ProcessIntent(ctx, SwapIntent{UserID: "user-1", CurrentTag: 1, TargetTag: 2, GuildID: guildID}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(svc.trace) > 0 {
+		t.Errorf("expired intent must not complete a cycle, got %v", svc.trace)
+	}
+	if _, ok := kv.data["intents.guild-1.user-2"]; ok {
+		t.Error("expected expired intent to be deleted")
+	}
+
+	var stored SwapIntent
+	if err := json.Unmarshal(kv.data["intents.guild-1.user-1"], &stored); err != nil {
+		t.Fatalf("intent not stored: %v", err)
+	}
+	if !stored.ExpiresAt.Equal(stored.CreatedAt.Add(IntentTTL)) {
+		t.Errorf("expected expiry %v after creation, got %+v", IntentTTL, stored)
+	}
+}
+
+func TestSwapSagaCoordinator_CancelIntent(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	kv := NewFakeKeyValue()
+	coordinator := NewSwapSagaCoordinator(kv, &FakeLeaderboardService{}, logger)
+
+	putIntent(t, kv, SwapIntent{UserID: "user-1", CurrentTag: 1, TargetTag: 2, GuildID: "guild-1"})
+
+	if err := coordinator.CancelIntent(ctx, "guild-1", "user-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := kv.data["intents.guild-1.user-1"]; ok {
+		t.Error("expected intent to be deleted")
+	}
+	if err := coordinator.CancelIntent(ctx, "guild-1", "user-1"); !errors.Is(err, ErrIntentNotFound) {
+		t.Errorf("expected ErrIntentNotFound, got %v", err)
+	}
+}
+
+func TestSwapSagaCoordinator_ListPending(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	kv := NewFakeKeyValue()
+	coordinator := NewSwapSagaCoordinator(kv, &FakeLeaderboardService{}, logger)
+
+	base := time.Now().UTC()
+	future := base.Add(IntentTTL)
+	// a -> 2 (held by b), b -> 3 (held by c, no intent); d -> 2 also; e has no tag.
+	putIntent(t, kv, SwapIntent{UserID: "a", CurrentTag: 1, TargetTag: 2, GuildID: "guild-1", CreatedAt: base, ExpiresAt: future})
+	putIntent(t, kv, SwapIntent{UserID: "b", CurrentTag: 2, TargetTag: 3, GuildID: "guild-1", CreatedAt: base.Add(time.Minute), ExpiresAt: future})
+	putIntent(t, kv, SwapIntent{UserID: "d", CurrentTag: 4, TargetTag: 2, GuildID: "guild-1", CreatedAt: base.Add(2 * time.Minute), ExpiresAt: future})
+	putIntent(t, kv, SwapIntent{UserID: "e", TargetTag: 9, GuildID: "guild-1", CreatedAt: base.Add(3 * time.Minute), ExpiresAt: future})
+	// Other guilds are not listed.
+	putIntent(t, kv, SwapIntent{UserID: "x", CurrentTag: 3, TargetTag: 1, GuildID: "guild-10", CreatedAt: base, ExpiresAt: future})
+
+	pending, err := coordinator.ListPending(ctx, "guild-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if slices.Contains(kv.trace, "Keys") {
+		t.Error("listing must not scan the whole bucket")
+	}
+
+	var users []sharedtypes.DiscordID
+	for _, intent := range pending.Intents {
+		users = append(users, intent.UserID)
+	}
+	if !slices.Equal(users, []sharedtypes.DiscordID{"a", "b", "d", "e"}) {
+		t.Fatalf("unexpected intents: %v", users)
+	}
+
+	type chain struct {
+		users   []sharedtypes.DiscordID
+		waiting sharedtypes.TagNumber
+	}
+	var got []chain
+	for _, c := range pending.Chains {
+		var members []sharedtypes.DiscordID
+		for _, intent := range c.Intents {
+			members = append(members, intent.UserID)
+		}
+		got = append(got, chain{members, c.WaitingOnTag})
+	}
+	want := []chain{
+		{[]sharedtypes.DiscordID{"a", "b"}, 3},
+		{[]sharedtypes.DiscordID{"d", "b"}, 3},
+		{[]sharedtypes.DiscordID{"e"}, 9},
+	}
+	if !slices.EqualFunc(got, want, func(a, b chain) bool { return slices.Equal(a.users, b.users) && a.waiting == b.waiting }) {
+		t.Fatalf("chains = %+v, want %+v", got, want)
+	}
+}
@@ -3,6 +3,8 @@ package saga
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
@@ -62,6 +64,30 @@ func (f *FakeKeyValue) Keys(ctx context.Context, opts ...jetstream.WatchOpt) ([]
 	return keys, nil
 }
 
+// ListKeysFiltered supports the "<prefix>.*" filters used by the coordinator.
+func (f *FakeKeyValue) ListKeysFiltered(ctx context.Context, filters ...string) (jetstream.KeyLister, error) {
+	f.trace = append(f.trace, "ListKeysFiltered")
+	keys := make(chan string, len(f.data))
+	for k := range f.data {
+		for _, filter := range filters {
+			prefix := strings.TrimSuffix(filter, "*")
+			if strings.HasPrefix(k, prefix) && !strings.Contains(k[len(prefix):], ".") {
+				keys <- k
+				break
+			}
+		}
+	}
+	close(keys)
+	return &FakeKeyLister{keys: keys}, nil
+}
+
+type FakeKeyLister struct {
+	keys chan string
+}
+
+func (f *FakeKeyLister) Keys() <-chan string { return f.keys }
+func (f *FakeKeyLister) Stop() error         { return nil }
+
 type FakeKeyValueEntry struct {
 	jetstream.KeyValueEntry
 	value []byte
@@ -79,6 +105,7 @@ type FakeLeaderboardService struct {
 	trace []string
 
 	ExecuteBatchTagAssignmentFunc func(ctx context.Context, guildID sharedtypes.GuildID, requests []sharedtypes.TagAssignmentRequest, updateID sharedtypes.RoundID, source sharedtypes.ServiceUpdateSource) (results.OperationResult[leaderboardtypes.LeaderboardData, error], error)
+	GetTagByUserIDFunc            func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[sharedtypes.TagNumber, error], error)
 }
 
 func (f *FakeLeaderboardService) ExecuteBatchTagAssignment(ctx context.Context, guildID sharedtypes.GuildID, requests []sharedtypes.TagAssignmentRequest, updateID sharedtypes.RoundID, source sharedtypes.ServiceUpdateSource) (results.OperationResult[leaderboardtypes.LeaderboardData, error], error) {
@@ -96,7 +123,13 @@ func (f *FakeLeaderboardService) TagSwapRequested(ctx context.Context, guildID s
 func (f *FakeLeaderboardService) GetLeaderboard(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
 	return results.FailureResult[[]leaderboardtypes.LeaderboardEntry, error](errors.New("not implemented")), nil
 }
+func (f *FakeLeaderboardService) GetLeaderboardAt(ctx context.Context, guildID sharedtypes.GuildID, divisionID *string, seasonID string, asOf *time.Time, roundID *sharedtypes.RoundID) (results.OperationResult[leaderboardservice.LeaderboardSnapshot, error], error) {
+	return results.FailureResult[leaderboardservice.LeaderboardSnapshot, error](errors.New("not implemented")), nil
+}
 func (f *FakeLeaderboardService) GetTagByUserID(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[sharedtypes.TagNumber, error], error) {
+	if f.GetTagByUserIDFunc != nil {
+		return f.GetTagByUserIDFunc(ctx, guildID, userID)
+	}
 	return results.FailureResult[sharedtypes.TagNumber, error](errors.New("not implemented")), nil
 }
 func (f *FakeLeaderboardService) RoundGetTagByUserID(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[sharedtypes.TagNumber, error], error) {
@@ -132,6 +165,18 @@ func (f *FakeLeaderboardService) StartNewSeason(ctx context.Context, guildID sha
 	return results.FailureResult[bool, error](errors.New("not implemented")), nil
 }
 
+func (f *FakeLeaderboardService) StartNewSeasonWithPolicy(ctx context.Context, guildID sharedtypes.GuildID, seasonID string, seasonName string, policy leaderboarddomain.PointsPolicy) (results.OperationResult[bool, error], error) {
+	return results.FailureResult[bool, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) GetSeasonPointsPolicy(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboarddomain.PointsPolicy, error], error) {
+	return results.FailureResult[leaderboarddomain.PointsPolicy, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) ReplayGuild(ctx context.Context, guildID sharedtypes.GuildID, dryRun bool) (results.OperationResult[leaderboardservice.ReplayResult, error], error) {
+	return results.FailureResult[leaderboardservice.ReplayResult, error](errors.New("not implemented")), nil
+}
+
 func (f *FakeLeaderboardService) GetSeasonStandingsForSeason(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[[]leaderboardservice.SeasonStandingEntry, error], error) {
 	return results.FailureResult[[]leaderboardservice.SeasonStandingEntry, error](errors.New("not implemented")), nil
 }
@@ -174,3 +219,102 @@ func (f *FakeLeaderboardService) ListSeasons(ctx context.Context, guildID shared
 func (f *FakeLeaderboardService) GetSeasonName(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (string, error) {
 	return "", errors.New("not implemented")
 }
+
+func (f *FakeLeaderboardService) GetSeasonArchive(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonArchive, error], error) {
+	return results.FailureResult[leaderboardservice.SeasonArchive, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) ScheduleSeason(ctx context.Context, guildID sharedtypes.GuildID, schedule leaderboardservice.SeasonSchedule) (results.OperationResult[leaderboardservice.SeasonInfo, error], error) {
+	return results.FailureResult[leaderboardservice.SeasonInfo, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) RunSeasonSchedule(ctx context.Context, now time.Time) ([]leaderboardservice.SeasonTransition, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *FakeLeaderboardService) GetInactivityPolicy(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error) {
+	return results.FailureResult[leaderboarddomain.InactivityPolicy, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) SetInactivityPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.InactivityPolicy) (results.OperationResult[leaderboarddomain.InactivityPolicy, error], error) {
+	return results.FailureResult[leaderboarddomain.InactivityPolicy, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) PreviewInactivityDecay(ctx context.Context, guildID sharedtypes.GuildID, policy *leaderboarddomain.InactivityPolicy) (results.OperationResult[leaderboardservice.InactivityPreview, error], error) {
+	return results.FailureResult[leaderboardservice.InactivityPreview, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) RunInactivityDecay(ctx context.Context, now time.Time) ([]leaderboardservice.InactivityDecayRun, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *FakeLeaderboardService) GetTagPoolPolicy(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error) {
+	return results.FailureResult[leaderboarddomain.TagPoolPolicy, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) SetTagPoolPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.TagPoolPolicy) (results.OperationResult[leaderboarddomain.TagPoolPolicy, error], error) {
+	return results.FailureResult[leaderboarddomain.TagPoolPolicy, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) GetHeadToHead(ctx context.Context, guildID sharedtypes.GuildID, memberA, memberB sharedtypes.DiscordID, seasonID string) (results.OperationResult[leaderboarddomain.HeadToHeadRecord, error], error) {
+	return results.FailureResult[leaderboarddomain.HeadToHeadRecord, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) GetMemberStats(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (results.OperationResult[leaderboarddomain.MemberStats, error], error) {
+	return results.FailureResult[leaderboarddomain.MemberStats, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) InvalidateMemberStats(ctx context.Context, guildID sharedtypes.GuildID, memberIDs ...sharedtypes.DiscordID) {
+}
+
+func (f *FakeLeaderboardService) GetPromotionPolicy(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error) {
+	return results.FailureResult[leaderboarddomain.PromotionPolicy, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) SetPromotionPolicy(ctx context.Context, guildID sharedtypes.GuildID, policy leaderboarddomain.PromotionPolicy) (results.OperationResult[leaderboarddomain.PromotionPolicy, error], error) {
+	return results.FailureResult[leaderboarddomain.PromotionPolicy, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) PreviewPromotions(ctx context.Context, guildID sharedtypes.GuildID, policy *leaderboarddomain.PromotionPolicy) (results.OperationResult[leaderboardservice.PromotionPreview, error], error) {
+	return results.FailureResult[leaderboardservice.PromotionPreview, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) GetSeasonPromotions(ctx context.Context, guildID sharedtypes.GuildID, seasonID string) (results.OperationResult[leaderboardservice.SeasonPromotions, error], error) {
+	return results.FailureResult[leaderboardservice.SeasonPromotions, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) ListDivisions(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[[]leaderboarddomain.Division, error], error) {
+	return results.FailureResult[[]leaderboarddomain.Division, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) CreateDivision(ctx context.Context, guildID sharedtypes.GuildID, division leaderboarddomain.Division) (results.OperationResult[leaderboarddomain.Division, error], error) {
+	return results.FailureResult[leaderboarddomain.Division, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) AssignMemberDivision(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, divisionID string) (results.OperationResult[leaderboarddomain.Division, error], error) {
+	return results.FailureResult[leaderboarddomain.Division, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) GetDivisionLeaderboard(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]leaderboardtypes.LeaderboardEntry, error], error) {
+	return results.FailureResult[[]leaderboardtypes.LeaderboardEntry, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) GetDivisionTagList(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, clubUUID *string) ([]leaderboardservice.MemberTagView, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *FakeLeaderboardService) GetDivisionSeasonStandings(ctx context.Context, guildID sharedtypes.GuildID, divisionID string, seasonID string) (results.OperationResult[[]leaderboardservice.SeasonStandingEntry, error], error) {
+	return results.FailureResult[[]leaderboardservice.SeasonStandingEntry, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) RenderChart(ctx context.Context, guildID sharedtypes.GuildID, req leaderboardservice.ChartRequest) (results.OperationResult[leaderboardservice.RenderedChart, error], error) {
+	return results.FailureResult[leaderboardservice.RenderedChart, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) ExportLeaderboardData(ctx context.Context, guildID sharedtypes.GuildID, dataset leaderboardservice.ExportDataset, format leaderboardservice.ExportFormat, seasonID string) (results.OperationResult[leaderboardservice.ExportFile, error], error) {
+	return results.FailureResult[leaderboardservice.ExportFile, error](errors.New("not implemented")), nil
+}
+
+func (f *FakeLeaderboardService) ImportTagHistory(ctx context.Context, guildID sharedtypes.GuildID, fileName string, data []byte, dryRun bool) (results.OperationResult[leaderboardservice.TagImportResult, error], error) {
+	return results.FailureResult[leaderboardservice.TagImportResult, error](errors.New("not implemented")), nil
+}
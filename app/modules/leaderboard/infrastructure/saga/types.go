@@ -1,6 +1,8 @@
 package saga
 
 import (
+	"time"
+
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 )
 
@@ -10,4 +12,28 @@ type SwapIntent struct {
 	CurrentTag sharedtypes.TagNumber `json:"current_tag"`
 	TargetTag  sharedtypes.TagNumber `json:"target_tag"`
 	GuildID    sharedtypes.GuildID   `json:"guild_id"`
+	CreatedAt  time.Time             `json:"created_at"`
+	// ExpiresAt is when the intent lapses if no swap chain has closed. Intents stored
+	// before expiry was tracked have a zero ExpiresAt and are left to the bucket TTL.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Expired reports whether the intent has lapsed at now.
+func (i SwapIntent) Expired(now time.Time) bool {
+	return !i.ExpiresAt.IsZero() && !now.Before(i.ExpiresAt)
+}
+
+// SwapChain is a run of pending intents in which each member wants the tag held by the
+// next one. The chain is waiting on the holder of the last member's target tag; once
+// that holder wants the first member's tag, the chain closes and the swap executes.
+type SwapChain struct {
+	Intents []SwapIntent `json:"intents"`
+	// WaitingOnTag is the tag wanted by the last member of the chain.
+	WaitingOnTag sharedtypes.TagNumber `json:"waiting_on_tag"`
+}
+
+// PendingSwaps is a guild's pending swap intents and the partial chains they form.
+type PendingSwaps struct {
+	Intents []SwapIntent `json:"intents"`
+	Chains  []SwapChain  `json:"chains"`
 }
@@ -4,20 +4,26 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	guildservice "github.com/Black-And-White-Club/frolf-bot/app/modules/guild/application"
 	leaderboardservice "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/application"
 	leaderboardadapters "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/adapters"
 	leaderboardhandlers "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/handlers"
+	leaderboardqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/queue"
 	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
 	leaderboardrouter "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/router"
 	"github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/saga"
 	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	scoreservice "github.com/Black-And-White-Club/frolf-bot/app/modules/score/application"
 	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
+	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
 	"github.com/Black-And-White-Club/frolf-bot/config"
 	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-chi/chi/v5"
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/uptrace/bun"
@@ -29,6 +35,8 @@ type Module struct {
 	SagaCoordinator    *saga.SwapSagaCoordinator
 	config             *config.Config
 	LeaderboardRouter  *leaderboardrouter.LeaderboardRouter
+	handlers           leaderboardhandlers.Handlers
+	QueueService       leaderboardqueue.QueueService
 	cancelFunc         context.CancelFunc
 	Helper             utils.Helpers
 	observability      observability.Observability
@@ -70,33 +78,86 @@ func NewLeaderboardModule(
 	lbRouter := leaderboardrouter.NewLeaderboardRouter(logger, router, eventBus, eventBus, cfg, helpers, tracer, promRegistry)
 
 	roundLookup := leaderboardadapters.NewRoundLookupAdapter(roundService)
+	service.SetParSource(roundLookup)
+	service.SetReliabilitySource(roundLookup)
 	handlers := leaderboardhandlers.NewLeaderboardHandlers(service, userService, sagaCoord, logger, tracer, helpers, metrics, roundLookup)
 
 	if err := lbRouter.Configure(routerCtx, handlers); err != nil {
 		return nil, fmt.Errorf("failed to configure leaderboard router: %w", err)
 	}
 
+	// 4. Background Jobs (scheduled season transitions)
+	queueService, err := leaderboardqueue.NewService(ctx, logger, cfg.Postgres.DSN, eventBus, helpers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize leaderboard queue service: %w", err)
+	}
+	if err := queueService.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to start leaderboard queue service: %w", err)
+	}
+
 	return &Module{
 		EventBus:           eventBus,
 		LeaderboardService: service,
 		SagaCoordinator:    sagaCoord,
 		config:             cfg,
 		LeaderboardRouter:  lbRouter,
+		handlers:           handlers,
+		QueueService:       queueService,
 		Helper:             helpers,
 		observability:      obs,
 		prometheusRegistry: promRegistry,
 	}, nil
 }
 
-// ensureSagaKV is a private helper to keep the constructor clean.
-func ensureSagaKV(js jetstream.JetStream) (jetstream.KeyValue, error) {
-	kv, err := js.KeyValue(context.Background(), "tag_swap_intents")
-	if err == nil {
-		return kv, nil
+// SetScoreService wires the score module into the round handlers so net rounds can
+// reallocate tags by gross order, and into the service for head-to-head stroke
+// differentials and member score stats. The score module is initialized after this
+// one.
+func (m *Module) SetScoreService(scores scoreservice.Service) {
+	if scores == nil {
+		return
+	}
+	lookup := leaderboardadapters.NewScoreLookupAdapter(scores)
+	if h, ok := m.handlers.(*leaderboardhandlers.LeaderboardHandlers); ok {
+		h.SetScoreLookup(lookup)
+	}
+	if svc, ok := m.LeaderboardService.(*leaderboardservice.LeaderboardService); ok {
+		svc.SetScoreSource(lookup)
+	}
+}
+
+// SetGuildService wires the guild module into the service, which owns the tag pool
+// model and the custom leaderboards switch on the guild's config. The guild module is
+// initialized after this one.
+func (m *Module) SetGuildService(guilds guildservice.Service) {
+	if guilds == nil {
+		return
+	}
+	if svc, ok := m.LeaderboardService.(*leaderboardservice.LeaderboardService); ok {
+		svc.SetGuildSettingsSource(leaderboardadapters.NewGuildSettingsAdapter(guilds))
 	}
+}
 
-	kv, err = js.CreateKeyValue(context.Background(), jetstream.KeyValueConfig{
+// RegisterHTTPRoutes mounts the leaderboard PWA endpoints. It needs the user
+// repository to authenticate callers, which the module is not otherwise given.
+func (m *Module) RegisterHTTPRoutes(httpRouter chi.Router, userRepo userdb.Repository) {
+	if httpRouter == nil || userRepo == nil {
+		return
+	}
+	httpHandlers := leaderboardhandlers.NewHTTPHandlers(m.LeaderboardService, m.SagaCoordinator, userRepo, m.observability.Provider.Logger)
+	httpRouter.Route("/api/leaderboard", func(r chi.Router) {
+		r.Get("/swap-intents", httpHandlers.HandleListSwapIntents)
+		r.Get("/members/{memberID}/stats", httpHandlers.HandleGetMemberStats)
+	})
+}
+
+// ensureSagaKV is a private helper to keep the constructor clean. The bucket TTL
+// backs up intent expiry so lapsed intents are removed even if never read again;
+// existing buckets are updated to it.
+func ensureSagaKV(js jetstream.JetStream) (jetstream.KeyValue, error) {
+	kv, err := js.CreateOrUpdateKeyValue(context.Background(), jetstream.KeyValueConfig{
 		Bucket: "tag_swap_intents",
+		TTL:    saga.IntentTTL,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to bind saga KV bucket: %w", err)
@@ -123,6 +184,13 @@ func (m *Module) Close() error {
 	if m.cancelFunc != nil {
 		m.cancelFunc()
 	}
+	if m.QueueService != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := m.QueueService.Stop(ctx); err != nil {
+			m.observability.Provider.Logger.Error("Error stopping leaderboard queue service", "error", err)
+		}
+	}
 	if m.LeaderboardRouter != nil {
 		return m.LeaderboardRouter.Close()
 	}
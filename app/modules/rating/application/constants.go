@@ -0,0 +1,12 @@
+package ratingservice
+
+const (
+	// defaultListLimit is used when a rating list request does not ask for a size.
+	defaultListLimit = 25
+
+	// maxListLimit bounds rating list requests.
+	maxListLimit = 100
+
+	// memberHistoryLimit is how many rated rounds a member profile includes.
+	memberHistoryLimit = 20
+)
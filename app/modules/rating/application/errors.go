@@ -0,0 +1,25 @@
+package ratingservice
+
+import "errors"
+
+// Domain errors for the rating service.
+// These represent business logic failures that handlers should treat as
+// normal outcomes rather than retrying.
+var (
+	// ErrInvalidGuildID indicates the request did not carry a guild ID.
+	ErrInvalidGuildID = errors.New("invalid guild ID")
+
+	// ErrInvalidMemberID indicates the request did not carry a member ID.
+	ErrInvalidMemberID = errors.New("invalid member ID")
+
+	// ErrInsufficientField indicates fewer than two players finished the round, so
+	// there is nobody to be rated against.
+	ErrInsufficientField = errors.New("at least two finished players are required to rate a round")
+
+	// ErrRoundAlreadyRated indicates the round's results were already applied.
+	ErrRoundAlreadyRated = errors.New("round already rated")
+
+	// ErrRatingSuperseded indicates a corrected round cannot be re-rated because a
+	// later round has already moved one of its players' ratings.
+	ErrRatingSuperseded = errors.New("round rating superseded by a later round")
+)
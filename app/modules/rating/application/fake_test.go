@@ -0,0 +1,172 @@
+package ratingservice
+
+import (
+	"context"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	ratingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ------------------------
+// Fake Rating Repo
+// ------------------------
+
+// FakeRatingRepository provides a programmable stub for the ratingdb.Repository interface.
+// Without overrides it behaves like an in-memory store so multi-round flows can be tested.
+type FakeRatingRepository struct {
+	trace []string
+
+	Ratings map[string]*ratingdb.PlayerRating
+	History []*ratingdb.RatingHistory
+
+	GetRatingsForUpdateFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, memberIDs []sharedtypes.DiscordID) ([]*ratingdb.PlayerRating, error)
+	UpsertRatingsFunc       func(ctx context.Context, db bun.IDB, ratings []*ratingdb.PlayerRating) error
+	ListRoundHistoryFunc    func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID uuid.UUID) ([]*ratingdb.RatingHistory, error)
+}
+
+// NewFakeRatingRepository initializes a new FakeRatingRepository with an empty store.
+func NewFakeRatingRepository() *FakeRatingRepository {
+	return &FakeRatingRepository{
+		trace:   []string{},
+		Ratings: map[string]*ratingdb.PlayerRating{},
+	}
+}
+
+// Trace returns the sequence of method calls made to the fake.
+func (f *FakeRatingRepository) Trace() []string {
+	out := make([]string, len(f.trace))
+	copy(out, f.trace)
+	return out
+}
+
+func (f *FakeRatingRepository) record(step string) {
+	f.trace = append(f.trace, step)
+}
+
+func ratingKey(guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, layoutID uuid.UUID) string {
+	return string(guildID) + "|" + string(memberID) + "|" + layoutID.String()
+}
+
+// --- Repository Interface Implementation ---
+
+func (f *FakeRatingRepository) GetRatingsForUpdate(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, memberIDs []sharedtypes.DiscordID) ([]*ratingdb.PlayerRating, error) {
+	f.record("GetRatingsForUpdate")
+	if f.GetRatingsForUpdateFunc != nil {
+		return f.GetRatingsForUpdateFunc(ctx, db, guildID, layoutID, memberIDs)
+	}
+	return f.lookup(guildID, layoutID, memberIDs), nil
+}
+
+func (f *FakeRatingRepository) GetRatings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, memberIDs []sharedtypes.DiscordID) ([]*ratingdb.PlayerRating, error) {
+	f.record("GetRatings")
+	return f.lookup(guildID, layoutID, memberIDs), nil
+}
+
+func (f *FakeRatingRepository) ListRatings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, limit int) ([]*ratingdb.PlayerRating, error) {
+	f.record("ListRatings")
+	out := []*ratingdb.PlayerRating{}
+	for _, r := range f.Ratings {
+		if r.GuildID == guildID && r.LayoutID == layoutID {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (f *FakeRatingRepository) ListMemberRatings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) ([]*ratingdb.PlayerRating, error) {
+	f.record("ListMemberRatings")
+	out := []*ratingdb.PlayerRating{}
+	for _, r := range f.Ratings {
+		if r.GuildID == guildID && r.MemberID == memberID {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (f *FakeRatingRepository) UpsertRatings(ctx context.Context, db bun.IDB, ratings []*ratingdb.PlayerRating) error {
+	f.record("UpsertRatings")
+	if f.UpsertRatingsFunc != nil {
+		return f.UpsertRatingsFunc(ctx, db, ratings)
+	}
+	for _, r := range ratings {
+		f.Ratings[ratingKey(r.GuildID, r.MemberID, r.LayoutID)] = r
+	}
+	return nil
+}
+
+func (f *FakeRatingRepository) InsertHistory(ctx context.Context, db bun.IDB, entries []*ratingdb.RatingHistory) error {
+	f.record("InsertHistory")
+	f.History = append(f.History, entries...)
+	return nil
+}
+
+func (f *FakeRatingRepository) DeleteRatings(ctx context.Context, db bun.IDB, ratings []*ratingdb.PlayerRating) error {
+	f.record("DeleteRatings")
+	for _, r := range ratings {
+		delete(f.Ratings, ratingKey(r.GuildID, r.MemberID, r.LayoutID))
+	}
+	return nil
+}
+
+func (f *FakeRatingRepository) ListRoundHistory(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID uuid.UUID) ([]*ratingdb.RatingHistory, error) {
+	f.record("ListRoundHistory")
+	if f.ListRoundHistoryFunc != nil {
+		return f.ListRoundHistoryFunc(ctx, db, guildID, roundID)
+	}
+	out := []*ratingdb.RatingHistory{}
+	for _, h := range f.History {
+		if h.GuildID == guildID && h.RoundID == roundID {
+			out = append(out, h)
+		}
+	}
+	return out, nil
+}
+
+func (f *FakeRatingRepository) DeleteRoundHistory(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID uuid.UUID) error {
+	f.record("DeleteRoundHistory")
+	kept := f.History[:0]
+	for _, h := range f.History {
+		if h.GuildID != guildID || h.RoundID != roundID {
+			kept = append(kept, h)
+		}
+	}
+	f.History = kept
+	return nil
+}
+
+func (f *FakeRatingRepository) ListMemberHistory(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, layoutID uuid.UUID, limit int) ([]*ratingdb.RatingHistory, error) {
+	f.record("ListMemberHistory")
+	out := []*ratingdb.RatingHistory{}
+	for i := len(f.History) - 1; i >= 0; i-- {
+		h := f.History[i]
+		if h.GuildID == guildID && h.MemberID == memberID && h.LayoutID == layoutID {
+			out = append(out, h)
+		}
+	}
+	return out, nil
+}
+
+func (f *FakeRatingRepository) lookup(guildID sharedtypes.GuildID, layoutID uuid.UUID, memberIDs []sharedtypes.DiscordID) []*ratingdb.PlayerRating {
+	out := []*ratingdb.PlayerRating{}
+	for _, id := range memberIDs {
+		if r, ok := f.Ratings[ratingKey(guildID, id, layoutID)]; ok {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+var _ ratingdb.Repository = (*FakeRatingRepository)(nil)
+
+// FakeRoundLayoutLookup implements roundLayoutLookup with a fixed answer.
+type FakeRoundLayoutLookup struct {
+	LayoutID *uuid.UUID
+	Err      error
+}
+
+func (f *FakeRoundLayoutLookup) GetRoundCourseLayoutID(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*uuid.UUID, error) {
+	return f.LayoutID, f.Err
+}
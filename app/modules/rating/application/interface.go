@@ -0,0 +1,98 @@
+package ratingservice
+
+import (
+	"context"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	"github.com/google/uuid"
+)
+
+// Result type aliases to reduce generic verbosity.
+type (
+	RoundRatingResult  = results.OperationResult[*RoundRatingSummary, error]
+	MemberRatingResult = results.OperationResult[*MemberRatingProfile, error]
+	RatingListResult   = results.OperationResult[[]RatingView, error]
+)
+
+// Service defines the interface for player rating operations.
+type Service interface {
+	// ApplyRoundResults rates a finalized round guild-wide and, when the round was
+	// played on a known course layout, on that layout. Finalizing a round again with
+	// corrected scores replaces its earlier rating unless a later round superseded it.
+	ApplyRoundResults(ctx context.Context, req ApplyRoundRequest) (RoundRatingResult, error)
+
+	// GetMemberRating returns a member's ratings in every scope plus recent history.
+	GetMemberRating(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (MemberRatingResult, error)
+
+	// ListRatings returns the guild's ratings best first. A nil layoutID lists guild-wide ratings.
+	ListRatings(ctx context.Context, guildID sharedtypes.GuildID, layoutID *uuid.UUID, limit int) (RatingListResult, error)
+
+	// GetRatings returns current ratings for specific members, keyed by member. Members
+	// without a rating in the scope are omitted. Used by other modules (e.g. betting odds).
+	GetRatings(ctx context.Context, guildID sharedtypes.GuildID, layoutID *uuid.UUID, memberIDs []sharedtypes.DiscordID) (map[sharedtypes.DiscordID]RatingView, error)
+}
+
+// RoundScore is one participant's result in a finalized round.
+type RoundScore struct {
+	MemberID sharedtypes.DiscordID
+	Score    int
+	IsDNF    bool
+}
+
+// ApplyRoundRequest carries a finalized round's results. LayoutID is optional; when
+// nil the service looks up the round's course layout itself.
+type ApplyRoundRequest struct {
+	GuildID  sharedtypes.GuildID
+	RoundID  sharedtypes.RoundID
+	LayoutID *uuid.UUID
+	Scores   []RoundScore
+}
+
+// RatingView is the API view of a member's rating in one scope.
+type RatingView struct {
+	MemberID    sharedtypes.DiscordID `json:"member_id"`
+	LayoutID    *uuid.UUID            `json:"layout_id,omitempty"`
+	Rating      float64               `json:"rating"`
+	RD          float64               `json:"rd"`
+	Volatility  float64               `json:"volatility"`
+	RoundsRated int                   `json:"rounds_rated"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+// RatingChange describes how a round moved one member's rating in one scope.
+type RatingChange struct {
+	MemberID sharedtypes.DiscordID `json:"member_id"`
+	LayoutID *uuid.UUID            `json:"layout_id,omitempty"`
+	Before   float64               `json:"before"`
+	After    float64               `json:"after"`
+	RD       float64               `json:"rd"`
+}
+
+// RoundRatingSummary is the outcome of rating one round.
+type RoundRatingSummary struct {
+	GuildID  sharedtypes.GuildID `json:"guild_id"`
+	RoundID  sharedtypes.RoundID `json:"round_id"`
+	LayoutID *uuid.UUID          `json:"layout_id,omitempty"`
+	Changes  []RatingChange      `json:"changes"`
+}
+
+// HistoryEntry is one rated round in a member's history.
+type HistoryEntry struct {
+	RoundID      uuid.UUID  `json:"round_id"`
+	LayoutID     *uuid.UUID `json:"layout_id,omitempty"`
+	RatingBefore float64    `json:"rating_before"`
+	RatingAfter  float64    `json:"rating_after"`
+	RD           float64    `json:"rd"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// MemberRatingProfile is a member's guild-wide rating, per-layout ratings and recent
+// guild-wide history. Overall is nil for members who have never been rated.
+type MemberRatingProfile struct {
+	MemberID sharedtypes.DiscordID `json:"member_id"`
+	Overall  *RatingView           `json:"overall,omitempty"`
+	Layouts  []RatingView          `json:"layouts"`
+	History  []HistoryEntry        `json:"history"`
+}
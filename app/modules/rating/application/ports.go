@@ -0,0 +1,15 @@
+package ratingservice
+
+import (
+	"context"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// roundLayoutLookup resolves the course layout a round was played on. The round
+// repository satisfies it.
+type roundLayoutLookup interface {
+	GetRoundCourseLayoutID(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*uuid.UUID, error)
+}
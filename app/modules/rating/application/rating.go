@@ -0,0 +1,417 @@
+package ratingservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	ratingdomain "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/domain"
+	ratingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ApplyRoundResults rates a finalized round. Every finisher is treated as having
+// played a head-to-head game against every other finisher, and the whole round is
+// one Glicko-2 rating period. DNF cards and players without a score are not rated.
+//
+// A round that is finalized again with corrected scores has its earlier rating
+// reversed and is rated afresh, as long as no later round has moved any of its
+// players' ratings since. Redelivering the same results is a no-op.
+func (s *RatingService) ApplyRoundResults(ctx context.Context, req ApplyRoundRequest) (RoundRatingResult, error) {
+	if req.GuildID == "" {
+		return results.FailureResult[*RoundRatingSummary, error](ErrInvalidGuildID), nil
+	}
+
+	scores := finishedScores(req.Scores)
+	if len(scores) < 2 {
+		return results.FailureResult[*RoundRatingSummary, error](ErrInsufficientField), nil
+	}
+
+	return withTelemetry(s, ctx, "ApplyRoundResults", req.GuildID, func(ctx context.Context) (RoundRatingResult, error) {
+		layoutID := req.LayoutID
+		if layoutID == nil {
+			layoutID = s.lookupRoundLayout(ctx, req.GuildID, req.RoundID)
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (RoundRatingResult, error) {
+			scopes := []uuid.UUID{ratingdb.GuildScope}
+			if layoutID != nil && *layoutID != uuid.Nil {
+				scopes = append(scopes, *layoutID)
+			}
+
+			previous, err := s.repo.ListRoundHistory(ctx, db, req.GuildID, uuid.UUID(req.RoundID))
+			if err != nil {
+				return RoundRatingResult{}, err
+			}
+			if len(previous) > 0 {
+				if sameRoundResults(previous, scopes, scores) {
+					return results.FailureResult[*RoundRatingSummary, error](ErrRoundAlreadyRated), nil
+				}
+				if err := s.reverseRound(ctx, db, req.GuildID, uuid.UUID(req.RoundID), previous); err != nil {
+					if errors.Is(err, ErrRatingSuperseded) {
+						return results.FailureResult[*RoundRatingSummary, error](err), nil
+					}
+					return RoundRatingResult{}, err
+				}
+			}
+
+			summary := &RoundRatingSummary{
+				GuildID:  req.GuildID,
+				RoundID:  req.RoundID,
+				LayoutID: layoutID,
+				Changes:  []RatingChange{},
+			}
+
+			for _, scope := range scopes {
+				changes, err := s.rateScope(ctx, db, req.GuildID, uuid.UUID(req.RoundID), scope, scores)
+				if err != nil {
+					return RoundRatingResult{}, err
+				}
+				summary.Changes = append(summary.Changes, changes...)
+			}
+
+			return results.SuccessResult[*RoundRatingSummary, error](summary), nil
+		})
+	})
+}
+
+// rateScope applies one round to the members' ratings in a single scope and records history.
+func (s *RatingService) rateScope(
+	ctx context.Context,
+	db bun.IDB,
+	guildID sharedtypes.GuildID,
+	roundID uuid.UUID,
+	scope uuid.UUID,
+	scores map[sharedtypes.DiscordID]int,
+) ([]RatingChange, error) {
+	memberIDs := make([]sharedtypes.DiscordID, 0, len(scores))
+	for id := range scores {
+		memberIDs = append(memberIDs, id)
+	}
+	sort.Slice(memberIDs, func(i, j int) bool { return memberIDs[i] < memberIDs[j] })
+
+	existing, err := s.repo.GetRatingsForUpdate(ctx, db, guildID, scope, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[sharedtypes.DiscordID]*ratingdb.PlayerRating, len(existing))
+	for _, r := range existing {
+		stored[r.MemberID] = r
+	}
+
+	before := make(map[string]ratingdomain.Rating, len(memberIDs))
+	strokes := make(map[string]int, len(memberIDs))
+	for _, id := range memberIDs {
+		before[string(id)] = ratingdomain.NewRating()
+		if r, ok := stored[id]; ok {
+			before[string(id)] = ratingdomain.Rating{Rating: r.Rating, RD: r.RD, Volatility: r.Volatility}
+		}
+		strokes[string(id)] = scores[id]
+	}
+	outcomes := ratingdomain.PairwiseOutcomes(strokes, before)
+
+	rows := make([]*ratingdb.PlayerRating, 0, len(memberIDs))
+	history := make([]*ratingdb.RatingHistory, 0, len(memberIDs))
+	changes := make([]RatingChange, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		prev := before[string(id)]
+		next := ratingdomain.Update(prev, outcomes[string(id)])
+
+		roundsRated := 1
+		if r, ok := stored[id]; ok {
+			roundsRated = r.RoundsRated + 1
+		}
+
+		rows = append(rows, &ratingdb.PlayerRating{
+			GuildID:     guildID,
+			MemberID:    id,
+			LayoutID:    scope,
+			Rating:      next.Rating,
+			RD:          next.RD,
+			Volatility:  next.Volatility,
+			RoundsRated: roundsRated,
+			LastRoundID: roundID,
+		})
+		score := scores[id]
+		history = append(history, &ratingdb.RatingHistory{
+			GuildID:          guildID,
+			MemberID:         id,
+			LayoutID:         scope,
+			RoundID:          roundID,
+			RatingBefore:     prev.Rating,
+			RatingAfter:      next.Rating,
+			RDAfter:          next.RD,
+			Volatility:       next.Volatility,
+			RDBefore:         &prev.RD,
+			VolatilityBefore: &prev.Volatility,
+			Score:            &score,
+		})
+		changes = append(changes, RatingChange{
+			MemberID: id,
+			LayoutID: layoutPtr(scope),
+			Before:   prev.Rating,
+			After:    next.Rating,
+			RD:       next.RD,
+		})
+	}
+
+	if err := s.repo.UpsertRatings(ctx, db, rows); err != nil {
+		return nil, err
+	}
+	if err := s.repo.InsertHistory(ctx, db, history); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// sameRoundResults reports whether a round's recorded history already reflects these
+// scores in these scopes, so a redelivered finalization can be skipped.
+func sameRoundResults(history []*ratingdb.RatingHistory, scopes []uuid.UUID, scores map[sharedtypes.DiscordID]int) bool {
+	if len(history) != len(scopes)*len(scores) {
+		return false
+	}
+	wanted := make(map[uuid.UUID]bool, len(scopes))
+	for _, scope := range scopes {
+		wanted[scope] = true
+	}
+	for _, h := range history {
+		score, ok := scores[h.MemberID]
+		if !ok || !wanted[h.LayoutID] || h.Score == nil || *h.Score != score {
+			return false
+		}
+	}
+	return true
+}
+
+// reverseRound restores every rating a round moved to its value before the round and
+// deletes the round's history. It fails with ErrRatingSuperseded when a player has
+// been rated in a later round, because undoing this round would discard that one.
+func (s *RatingService) reverseRound(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID uuid.UUID, history []*ratingdb.RatingHistory) error {
+	byScope := make(map[uuid.UUID][]*ratingdb.RatingHistory)
+	scopes := []uuid.UUID{}
+	for _, h := range history {
+		if _, ok := byScope[h.LayoutID]; !ok {
+			scopes = append(scopes, h.LayoutID)
+		}
+		byScope[h.LayoutID] = append(byScope[h.LayoutID], h)
+	}
+	sort.Slice(scopes, func(i, j int) bool { return scopes[i].String() < scopes[j].String() })
+
+	// Check every scope before writing anything: a superseded round is reported as a
+	// business failure, so the surrounding transaction still commits.
+	var restored, removed []*ratingdb.PlayerRating
+	for _, scope := range scopes {
+		entries := byScope[scope]
+		sort.Slice(entries, func(i, j int) bool { return entries[i].MemberID < entries[j].MemberID })
+		memberIDs := make([]sharedtypes.DiscordID, len(entries))
+		for i, h := range entries {
+			memberIDs[i] = h.MemberID
+		}
+
+		current, err := s.repo.GetRatingsForUpdate(ctx, db, guildID, scope, memberIDs)
+		if err != nil {
+			return err
+		}
+		stored := make(map[sharedtypes.DiscordID]*ratingdb.PlayerRating, len(current))
+		for _, r := range current {
+			stored[r.MemberID] = r
+		}
+
+		for _, h := range entries {
+			r, ok := stored[h.MemberID]
+			if !ok || r.LastRoundID != roundID || h.RDBefore == nil || h.VolatilityBefore == nil {
+				return fmt.Errorf("%w: member %s", ErrRatingSuperseded, h.MemberID)
+			}
+			if r.RoundsRated <= 1 {
+				removed = append(removed, r)
+				continue
+			}
+
+			lastRoundID, err := s.previousRatedRound(ctx, db, guildID, h.MemberID, scope, roundID)
+			if err != nil {
+				return err
+			}
+			restored = append(restored, &ratingdb.PlayerRating{
+				GuildID:     guildID,
+				MemberID:    h.MemberID,
+				LayoutID:    scope,
+				Rating:      h.RatingBefore,
+				RD:          *h.RDBefore,
+				Volatility:  *h.VolatilityBefore,
+				RoundsRated: r.RoundsRated - 1,
+				LastRoundID: lastRoundID,
+			})
+		}
+	}
+
+	if err := s.repo.UpsertRatings(ctx, db, restored); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteRatings(ctx, db, removed); err != nil {
+		return err
+	}
+	return s.repo.DeleteRoundHistory(ctx, db, guildID, roundID)
+}
+
+// previousRatedRound returns the round that rated a member in a scope before roundID.
+func (s *RatingService) previousRatedRound(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, scope, roundID uuid.UUID) (uuid.UUID, error) {
+	entries, err := s.repo.ListMemberHistory(ctx, db, guildID, memberID, scope, 2)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	for _, h := range entries {
+		if h.RoundID != roundID {
+			return h.RoundID, nil
+		}
+	}
+	return uuid.Nil, nil
+}
+
+// GetMemberRating returns a member's ratings in every scope plus recent guild-wide history.
+func (s *RatingService) GetMemberRating(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (MemberRatingResult, error) {
+	if guildID == "" {
+		return results.FailureResult[*MemberRatingProfile, error](ErrInvalidGuildID), nil
+	}
+	if memberID == "" {
+		return results.FailureResult[*MemberRatingProfile, error](ErrInvalidMemberID), nil
+	}
+
+	return withTelemetry(s, ctx, "GetMemberRating", guildID, func(ctx context.Context) (MemberRatingResult, error) {
+		ratings, err := s.repo.ListMemberRatings(ctx, nil, guildID, memberID)
+		if err != nil {
+			return MemberRatingResult{}, err
+		}
+
+		profile := &MemberRatingProfile{
+			MemberID: memberID,
+			Layouts:  []RatingView{},
+			History:  []HistoryEntry{},
+		}
+		for _, r := range ratings {
+			view := toRatingView(r)
+			if r.LayoutID == ratingdb.GuildScope {
+				profile.Overall = &view
+				continue
+			}
+			profile.Layouts = append(profile.Layouts, view)
+		}
+
+		history, err := s.repo.ListMemberHistory(ctx, nil, guildID, memberID, ratingdb.GuildScope, memberHistoryLimit)
+		if err != nil {
+			return MemberRatingResult{}, err
+		}
+		for _, h := range history {
+			profile.History = append(profile.History, HistoryEntry{
+				RoundID:      h.RoundID,
+				LayoutID:     layoutPtr(h.LayoutID),
+				RatingBefore: h.RatingBefore,
+				RatingAfter:  h.RatingAfter,
+				RD:           h.RDAfter,
+				CreatedAt:    h.CreatedAt,
+			})
+		}
+
+		return results.SuccessResult[*MemberRatingProfile, error](profile), nil
+	})
+}
+
+// ListRatings returns the guild's ratings in a scope, best first.
+func (s *RatingService) ListRatings(ctx context.Context, guildID sharedtypes.GuildID, layoutID *uuid.UUID, limit int) (RatingListResult, error) {
+	if guildID == "" {
+		return results.FailureResult[[]RatingView, error](ErrInvalidGuildID), nil
+	}
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	return withTelemetry(s, ctx, "ListRatings", guildID, func(ctx context.Context) (RatingListResult, error) {
+		ratings, err := s.repo.ListRatings(ctx, nil, guildID, scopeOf(layoutID), limit)
+		if err != nil {
+			return RatingListResult{}, err
+		}
+		views := make([]RatingView, 0, len(ratings))
+		for _, r := range ratings {
+			views = append(views, toRatingView(r))
+		}
+		return results.SuccessResult[[]RatingView, error](views), nil
+	})
+}
+
+// GetRatings returns current ratings for specific members in a scope.
+func (s *RatingService) GetRatings(ctx context.Context, guildID sharedtypes.GuildID, layoutID *uuid.UUID, memberIDs []sharedtypes.DiscordID) (map[sharedtypes.DiscordID]RatingView, error) {
+	ratings, err := s.repo.GetRatings(ctx, nil, guildID, scopeOf(layoutID), memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[sharedtypes.DiscordID]RatingView, len(ratings))
+	for _, r := range ratings {
+		out[r.MemberID] = toRatingView(r)
+	}
+	return out, nil
+}
+
+// lookupRoundLayout returns the round's course layout, or nil when the round has
+// none or the lookup fails. Layout ratings are a refinement, so a failed lookup
+// still lets the guild-wide rating go ahead.
+func (s *RatingService) lookupRoundLayout(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) *uuid.UUID {
+	if s.rounds == nil {
+		return nil
+	}
+	layoutID, err := s.rounds.GetRoundCourseLayoutID(ctx, nil, guildID, roundID)
+	if err != nil {
+		s.logger.WarnContext(ctx, "Could not resolve round course layout for rating",
+			attr.String("guild_id", string(guildID)),
+			attr.String("round_id", roundID.String()),
+			attr.Error(err),
+		)
+		return nil
+	}
+	return layoutID
+}
+
+// finishedScores keeps one score per member, dropping DNF cards.
+func finishedScores(scores []RoundScore) map[sharedtypes.DiscordID]int {
+	out := make(map[sharedtypes.DiscordID]int, len(scores))
+	for _, sc := range scores {
+		if sc.MemberID == "" || sc.IsDNF {
+			continue
+		}
+		out[sc.MemberID] = sc.Score
+	}
+	return out
+}
+
+func scopeOf(layoutID *uuid.UUID) uuid.UUID {
+	if layoutID == nil {
+		return ratingdb.GuildScope
+	}
+	return *layoutID
+}
+
+func layoutPtr(scope uuid.UUID) *uuid.UUID {
+	if scope == ratingdb.GuildScope {
+		return nil
+	}
+	id := scope
+	return &id
+}
+
+func toRatingView(r *ratingdb.PlayerRating) RatingView {
+	return RatingView{
+		MemberID:    r.MemberID,
+		LayoutID:    layoutPtr(r.LayoutID),
+		Rating:      r.Rating,
+		RD:          r.RD,
+		Volatility:  r.Volatility,
+		RoundsRated: r.RoundsRated,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
@@ -0,0 +1,398 @@
+package ratingservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	ratingdomain "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/domain"
+	ratingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func newTestService(repo ratingdb.Repository, rounds roundLayoutLookup) *RatingService {
+	return NewRatingService(repo, rounds, loggerfrolfbot.NoOpLogger, noop.NewTracerProvider().Tracer("test"), nil)
+}
+
+func TestRatingService_ApplyRoundResults(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+	layoutID := uuid.New()
+
+	scores := []RoundScore{
+		{MemberID: "winner", Score: -4},
+		{MemberID: "middle", Score: 0},
+		{MemberID: "last", Score: 5},
+		{MemberID: "quit", Score: 2, IsDNF: true},
+	}
+
+	tests := []struct {
+		name      string
+		req       ApplyRoundRequest
+		rounds    roundLayoutLookup
+		setupFake func(*FakeRatingRepository)
+		wantErr   error
+		wantInfra bool
+		verify    func(t *testing.T, res RoundRatingResult, fake *FakeRatingRepository)
+	}{
+		{
+			name: "rates finishers guild-wide and skips DNF",
+			req:  ApplyRoundRequest{GuildID: guildID, RoundID: roundID, Scores: scores},
+			verify: func(t *testing.T, res RoundRatingResult, fake *FakeRatingRepository) {
+				if res.Success == nil {
+					t.Fatalf("expected success, got %v", res.Failure)
+				}
+				if got := len((*res.Success).Changes); got != 3 {
+					t.Fatalf("expected 3 changes, got %d", got)
+				}
+				winner := fake.Ratings[ratingKey(guildID, "winner", ratingdb.GuildScope)]
+				last := fake.Ratings[ratingKey(guildID, "last", ratingdb.GuildScope)]
+				if winner == nil || last == nil {
+					t.Fatal("expected stored ratings for winner and last")
+				}
+				if winner.Rating <= ratingdomain.DefaultRating || last.Rating >= ratingdomain.DefaultRating {
+					t.Errorf("winner %.1f should gain and last %.1f should lose", winner.Rating, last.Rating)
+				}
+				if winner.RoundsRated != 1 || winner.LastRoundID != uuid.UUID(roundID) {
+					t.Errorf("unexpected bookkeeping: %+v", winner)
+				}
+				if _, ok := fake.Ratings[ratingKey(guildID, "quit", ratingdb.GuildScope)]; ok {
+					t.Error("DNF player should not be rated")
+				}
+				if len(fake.History) != 3 {
+					t.Errorf("expected 3 history rows, got %d", len(fake.History))
+				}
+			},
+		},
+		{
+			name:   "also rates the round's course layout",
+			req:    ApplyRoundRequest{GuildID: guildID, RoundID: roundID, Scores: scores},
+			rounds: &FakeRoundLayoutLookup{LayoutID: &layoutID},
+			verify: func(t *testing.T, res RoundRatingResult, fake *FakeRatingRepository) {
+				if res.Success == nil {
+					t.Fatalf("expected success, got %v", res.Failure)
+				}
+				summary := *res.Success
+				if summary.LayoutID == nil || *summary.LayoutID != layoutID {
+					t.Errorf("expected layout %s on summary, got %v", layoutID, summary.LayoutID)
+				}
+				if len(summary.Changes) != 6 {
+					t.Errorf("expected guild and layout changes, got %d", len(summary.Changes))
+				}
+				if _, ok := fake.Ratings[ratingKey(guildID, "winner", layoutID)]; !ok {
+					t.Error("expected a layout-scoped rating")
+				}
+			},
+		},
+		{
+			name:   "layout lookup failure still rates guild-wide",
+			req:    ApplyRoundRequest{GuildID: guildID, RoundID: roundID, Scores: scores},
+			rounds: &FakeRoundLayoutLookup{Err: errors.New("round db down")},
+			verify: func(t *testing.T, res RoundRatingResult, fake *FakeRatingRepository) {
+				if res.Success == nil || len((*res.Success).Changes) != 3 {
+					t.Fatalf("expected guild-wide changes only, got %+v", res)
+				}
+			},
+		},
+		{
+			name: "existing ratings are carried forward",
+			req:  ApplyRoundRequest{GuildID: guildID, RoundID: roundID, Scores: scores[:2]},
+			setupFake: func(f *FakeRatingRepository) {
+				f.Ratings[ratingKey(guildID, "winner", ratingdb.GuildScope)] = &ratingdb.PlayerRating{
+					GuildID: guildID, MemberID: "winner", LayoutID: ratingdb.GuildScope,
+					Rating: 1800, RD: 60, Volatility: 0.06, RoundsRated: 12,
+				}
+			},
+			verify: func(t *testing.T, res RoundRatingResult, fake *FakeRatingRepository) {
+				winner := fake.Ratings[ratingKey(guildID, "winner", ratingdb.GuildScope)]
+				if winner.RoundsRated != 13 {
+					t.Errorf("RoundsRated = %d, want 13", winner.RoundsRated)
+				}
+				if winner.Rating < 1800 || winner.Rating > 1810 {
+					t.Errorf("expected a small gain for a heavy favourite, got %.2f", winner.Rating)
+				}
+			},
+		},
+		{
+			name:    "missing guild",
+			req:     ApplyRoundRequest{RoundID: roundID, Scores: scores},
+			wantErr: ErrInvalidGuildID,
+		},
+		{
+			name:    "single finisher",
+			req:     ApplyRoundRequest{GuildID: guildID, RoundID: roundID, Scores: scores[2:]},
+			wantErr: ErrInsufficientField,
+		},
+		{
+			name: "already rated round",
+			req:  ApplyRoundRequest{GuildID: guildID, RoundID: roundID, Scores: scores},
+			setupFake: func(f *FakeRatingRepository) {
+				f.ListRoundHistoryFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r uuid.UUID) ([]*ratingdb.RatingHistory, error) {
+					rows := []*ratingdb.RatingHistory{}
+					for _, sc := range scores[:3] {
+						score := sc.Score
+						rows = append(rows, &ratingdb.RatingHistory{GuildID: g, MemberID: sc.MemberID, LayoutID: ratingdb.GuildScope, RoundID: r, Score: &score})
+					}
+					return rows, nil
+				}
+			},
+			wantErr: ErrRoundAlreadyRated,
+			verify: func(t *testing.T, res RoundRatingResult, fake *FakeRatingRepository) {
+				for _, step := range fake.Trace() {
+					if step == "UpsertRatings" {
+						t.Error("already rated round should not write ratings")
+					}
+				}
+			},
+		},
+		{
+			name: "repository error",
+			req:  ApplyRoundRequest{GuildID: guildID, RoundID: roundID, Scores: scores},
+			setupFake: func(f *FakeRatingRepository) {
+				f.UpsertRatingsFunc = func(ctx context.Context, db bun.IDB, ratings []*ratingdb.PlayerRating) error {
+					return errors.New("db down")
+				}
+			},
+			wantInfra: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := NewFakeRatingRepository()
+			if tt.setupFake != nil {
+				tt.setupFake(fake)
+			}
+			svc := newTestService(fake, tt.rounds)
+
+			res, err := svc.ApplyRoundResults(ctx, tt.req)
+			if tt.wantInfra {
+				if err == nil {
+					t.Fatal("expected infrastructure error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil {
+				if res.Failure == nil || !errors.Is(*res.Failure, tt.wantErr) {
+					t.Fatalf("expected failure %v, got %+v", tt.wantErr, res)
+				}
+			}
+			if tt.verify != nil {
+				tt.verify(t, res, fake)
+			}
+		})
+	}
+}
+
+func TestRatingService_ApplyRoundResults_Idempotent(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeRatingRepository()
+	svc := newTestService(fake, nil)
+	req := ApplyRoundRequest{
+		GuildID: "guild-1",
+		RoundID: sharedtypes.RoundID(uuid.New()),
+		Scores:  []RoundScore{{MemberID: "a", Score: -1}, {MemberID: "b", Score: 1}},
+	}
+
+	if res, err := svc.ApplyRoundResults(ctx, req); err != nil || res.Success == nil {
+		t.Fatalf("first apply failed: %v %+v", err, res)
+	}
+	before := *fake.Ratings[ratingKey("guild-1", "a", ratingdb.GuildScope)]
+
+	res, err := svc.ApplyRoundResults(ctx, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Failure == nil || !errors.Is(*res.Failure, ErrRoundAlreadyRated) {
+		t.Fatalf("expected ErrRoundAlreadyRated on redelivery, got %+v", res)
+	}
+	if after := *fake.Ratings[ratingKey("guild-1", "a", ratingdb.GuildScope)]; after.Rating != before.Rating {
+		t.Errorf("redelivery changed rating from %.2f to %.2f", before.Rating, after.Rating)
+	}
+}
+
+func TestRatingService_GetMemberRating(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	layoutID := uuid.New()
+
+	fake := NewFakeRatingRepository()
+	svc := newTestService(fake, &FakeRoundLayoutLookup{LayoutID: &layoutID})
+	for i := 0; i < 2; i++ {
+		_, err := svc.ApplyRoundResults(ctx, ApplyRoundRequest{
+			GuildID: guildID,
+			RoundID: sharedtypes.RoundID(uuid.New()),
+			Scores:  []RoundScore{{MemberID: "a", Score: -2}, {MemberID: "b", Score: 0}},
+		})
+		if err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+
+	res, err := svc.GetMemberRating(ctx, guildID, "a")
+	if err != nil || res.Success == nil {
+		t.Fatalf("GetMemberRating failed: %v %+v", err, res)
+	}
+	profile := *res.Success
+	if profile.Overall == nil || profile.Overall.RoundsRated != 2 || profile.Overall.LayoutID != nil {
+		t.Errorf("unexpected overall rating: %+v", profile.Overall)
+	}
+	if len(profile.Layouts) != 1 || profile.Layouts[0].LayoutID == nil || *profile.Layouts[0].LayoutID != layoutID {
+		t.Errorf("unexpected layout ratings: %+v", profile.Layouts)
+	}
+	if len(profile.History) != 2 || profile.History[0].RatingBefore <= profile.History[1].RatingBefore {
+		t.Errorf("expected newest-first guild history, got %+v", profile.History)
+	}
+
+	unrated, err := svc.GetMemberRating(ctx, guildID, "nobody")
+	if err != nil || unrated.Success == nil || (*unrated.Success).Overall != nil {
+		t.Errorf("expected empty profile for unrated member, got %v %+v", err, unrated)
+	}
+
+	invalid, _ := svc.GetMemberRating(ctx, guildID, "")
+	if invalid.Failure == nil || !errors.Is(*invalid.Failure, ErrInvalidMemberID) {
+		t.Errorf("expected ErrInvalidMemberID, got %+v", invalid)
+	}
+}
+
+func TestRatingService_ListRatings_ClampsLimit(t *testing.T) {
+	var gotLimit int
+	fake := &limitRecordingRepo{FakeRatingRepository: NewFakeRatingRepository(), limit: &gotLimit}
+	svc := newTestService(fake, nil)
+
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{name: "default", limit: 0, want: defaultListLimit},
+		{name: "within range", limit: 10, want: 10},
+		{name: "capped", limit: 1000, want: maxListLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := svc.ListRatings(context.Background(), "guild-1", nil, tt.limit); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotLimit != tt.want {
+				t.Errorf("limit = %d, want %d", gotLimit, tt.want)
+			}
+		})
+	}
+}
+
+type limitRecordingRepo struct {
+	*FakeRatingRepository
+	limit *int
+}
+
+func (r *limitRecordingRepo) ListRatings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, limit int) ([]*ratingdb.PlayerRating, error) {
+	*r.limit = limit
+	return r.FakeRatingRepository.ListRatings(ctx, db, guildID, layoutID, limit)
+}
+
+func TestRatingService_ApplyRoundResults_Rerate(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+	original := ApplyRoundRequest{
+		GuildID: guildID,
+		RoundID: roundID,
+		Scores:  []RoundScore{{MemberID: "a", Score: -1}, {MemberID: "b", Score: 1}, {MemberID: "c", Score: 3}},
+	}
+	corrected := ApplyRoundRequest{
+		GuildID: guildID,
+		RoundID: roundID,
+		Scores:  []RoundScore{{MemberID: "a", Score: 4}, {MemberID: "b", Score: 1}, {MemberID: "c", Score: 3, IsDNF: true}},
+	}
+
+	t.Run("corrected scores replace the earlier rating", func(t *testing.T) {
+		priorRound := uuid.New()
+		fake := NewFakeRatingRepository()
+		fake.Ratings[ratingKey(guildID, "a", ratingdb.GuildScope)] = &ratingdb.PlayerRating{
+			GuildID: guildID, MemberID: "a", LayoutID: ratingdb.GuildScope,
+			Rating: 1600, RD: 80, Volatility: 0.06, RoundsRated: 4, LastRoundID: priorRound,
+		}
+		fake.History = append(fake.History, &ratingdb.RatingHistory{
+			GuildID: guildID, MemberID: "a", LayoutID: ratingdb.GuildScope, RoundID: priorRound,
+		})
+		svc := newTestService(fake, nil)
+
+		if res, err := svc.ApplyRoundResults(ctx, original); err != nil || res.Success == nil {
+			t.Fatalf("first apply failed: %v %+v", err, res)
+		}
+		res, err := svc.ApplyRoundResults(ctx, corrected)
+		if err != nil || res.Success == nil {
+			t.Fatalf("re-rate failed: %v %+v", err, res)
+		}
+
+		a := fake.Ratings[ratingKey(guildID, "a", ratingdb.GuildScope)]
+		if a.RoundsRated != 5 || a.LastRoundID != uuid.UUID(roundID) {
+			t.Errorf("expected one rated round on top of the prior four, got %+v", a)
+		}
+		if a.Rating >= 1600 {
+			t.Errorf("a lost to b after the correction and should drop below 1600, got %.2f", a.Rating)
+		}
+		if _, ok := fake.Ratings[ratingKey(guildID, "c", ratingdb.GuildScope)]; ok {
+			t.Error("c became a DNF and should no longer be rated")
+		}
+		rows := 0
+		for _, h := range fake.History {
+			if h.RoundID == uuid.UUID(roundID) {
+				rows++
+			}
+		}
+		if rows != 2 {
+			t.Errorf("expected history for the two corrected finishers, got %d rows", rows)
+		}
+
+		fresh := NewFakeRatingRepository()
+		fresh.Ratings[ratingKey(guildID, "a", ratingdb.GuildScope)] = &ratingdb.PlayerRating{
+			GuildID: guildID, MemberID: "a", LayoutID: ratingdb.GuildScope,
+			Rating: 1600, RD: 80, Volatility: 0.06, RoundsRated: 4, LastRoundID: priorRound,
+		}
+		if _, err := newTestService(fresh, nil).ApplyRoundResults(ctx, corrected); err != nil {
+			t.Fatalf("direct apply failed: %v", err)
+		}
+		if want := fresh.Ratings[ratingKey(guildID, "a", ratingdb.GuildScope)].Rating; a.Rating != want {
+			t.Errorf("re-rated %.4f, want %.4f as if the corrected round were rated once", a.Rating, want)
+		}
+	})
+
+	t.Run("a later round supersedes the correction", func(t *testing.T) {
+		fake := NewFakeRatingRepository()
+		svc := newTestService(fake, nil)
+
+		if res, err := svc.ApplyRoundResults(ctx, original); err != nil || res.Success == nil {
+			t.Fatalf("first apply failed: %v %+v", err, res)
+		}
+		later := ApplyRoundRequest{
+			GuildID: guildID,
+			RoundID: sharedtypes.RoundID(uuid.New()),
+			Scores:  []RoundScore{{MemberID: "b", Score: 0}, {MemberID: "d", Score: 2}},
+		}
+		if res, err := svc.ApplyRoundResults(ctx, later); err != nil || res.Success == nil {
+			t.Fatalf("later apply failed: %v %+v", err, res)
+		}
+		b := *fake.Ratings[ratingKey(guildID, "b", ratingdb.GuildScope)]
+
+		res, err := svc.ApplyRoundResults(ctx, corrected)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrRatingSuperseded) {
+			t.Fatalf("expected ErrRatingSuperseded, got %+v", res)
+		}
+		if after := *fake.Ratings[ratingKey(guildID, "b", ratingdb.GuildScope)]; after.Rating != b.Rating {
+			t.Errorf("superseded correction changed b from %.2f to %.2f", b.Rating, after.Rating)
+		}
+	})
+}
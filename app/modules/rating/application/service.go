@@ -0,0 +1,122 @@
+package ratingservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	ratingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/infrastructure/repositories"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RatingService implements the Service interface.
+type RatingService struct {
+	repo   ratingdb.Repository
+	rounds roundLayoutLookup
+	logger *slog.Logger
+	tracer trace.Tracer
+	db     *bun.DB
+}
+
+// NewRatingService creates a new RatingService. rounds may be nil, in which case
+// rounds are only rated on a layout when the request names one.
+func NewRatingService(
+	repo ratingdb.Repository,
+	rounds roundLayoutLookup,
+	logger *slog.Logger,
+	tracer trace.Tracer,
+	db *bun.DB,
+) *RatingService {
+	return &RatingService{
+		repo:   repo,
+		rounds: rounds,
+		logger: logger,
+		tracer: tracer,
+		db:     db,
+	}
+}
+
+// withTelemetry wraps a service operation with tracing, logging, and panic recovery.
+func withTelemetry[S any, F any](
+	s *RatingService,
+	ctx context.Context,
+	operationName string,
+	guildID sharedtypes.GuildID,
+	op func(ctx context.Context) (results.OperationResult[S, F], error),
+) (result results.OperationResult[S, F], err error) {
+	var span trace.Span
+	if s.tracer != nil {
+		ctx, span = s.tracer.Start(ctx, operationName, trace.WithAttributes(
+			attribute.String("operation", operationName),
+			attribute.String("guild_id", string(guildID)),
+		))
+	} else {
+		span = trace.SpanFromContext(ctx)
+	}
+	defer span.End()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic in %s: %v", operationName, r)
+			s.logger.ErrorContext(ctx, "Critical panic recovered",
+				attr.String("operation", operationName),
+				attr.String("guild_id", string(guildID)),
+				attr.Error(err),
+			)
+			span.RecordError(err)
+			result = results.OperationResult[S, F]{}
+		}
+	}()
+
+	result, err = op(ctx)
+	if err != nil {
+		wrappedErr := fmt.Errorf("%s: %w", operationName, err)
+		s.logger.ErrorContext(ctx, "Operation failed with error",
+			attr.String("operation", operationName),
+			attr.String("guild_id", string(guildID)),
+			attr.Error(wrappedErr),
+		)
+		span.RecordError(wrappedErr)
+		return result, wrappedErr
+	}
+
+	if result.IsFailure() {
+		s.logger.WarnContext(ctx, "Operation returned failure result",
+			attr.String("operation", operationName),
+			attr.String("guild_id", string(guildID)),
+			attr.Any("failure_payload", *result.Failure),
+		)
+	}
+
+	return result, nil
+}
+
+// runInTx runs fn inside a transaction when the service owns a database handle.
+// Tests construct the service without one, in which case fn receives a nil IDB
+// and repositories fall back to their own connection.
+func runInTx[S any, F any](
+	s *RatingService,
+	ctx context.Context,
+	fn func(ctx context.Context, db bun.IDB) (results.OperationResult[S, F], error),
+) (results.OperationResult[S, F], error) {
+	if s.db == nil {
+		return fn(ctx, nil)
+	}
+
+	var result results.OperationResult[S, F]
+	err := s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+		result, err = fn(ctx, tx)
+		return err
+	})
+	return result, err
+}
+
+// compile-time interface check
+var _ Service = (*RatingService)(nil)
@@ -0,0 +1,173 @@
+// Package ratingdomain holds the pure rating math used by the rating module.
+package ratingdomain
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultRating, DefaultRD and DefaultVolatility describe an unrated player.
+	DefaultRating     = 1500.0
+	DefaultRD         = 350.0
+	DefaultVolatility = 0.06
+
+	// MinRD stops ratings from becoming so certain that a single round barely moves them.
+	MinRD = 30.0
+
+	// glickoScale converts between the Glicko and Glicko-2 scales.
+	glickoScale = 173.7178
+
+	// tau constrains how quickly volatility can change. Glickman suggests 0.3–1.2;
+	// casual league fields are small and noisy, so we stay at the low end.
+	tau = 0.5
+
+	// convergenceTolerance is the stopping criterion for the volatility iteration.
+	convergenceTolerance = 0.000001
+)
+
+// Rating is a Glicko-2 player rating expressed on the familiar Glicko scale.
+type Rating struct {
+	Rating     float64
+	RD         float64
+	Volatility float64
+}
+
+// NewRating returns the rating assigned to a player with no history.
+func NewRating() Rating {
+	return Rating{Rating: DefaultRating, RD: DefaultRD, Volatility: DefaultVolatility}
+}
+
+// Outcome is one result against one opponent: 1 for a win, 0.5 for a tie, 0 for a loss.
+type Outcome struct {
+	Opponent Rating
+	Score    float64
+}
+
+// Update applies one rating period of outcomes to r using the Glicko-2 algorithm.
+// With no outcomes only the deviation grows, reflecting time without play.
+func Update(r Rating, outcomes []Outcome) Rating {
+	mu := (r.Rating - DefaultRating) / glickoScale
+	phi := r.RD / glickoScale
+	sigma := r.Volatility
+
+	if len(outcomes) == 0 {
+		phiStar := math.Sqrt(phi*phi + sigma*sigma)
+		return Rating{Rating: r.Rating, RD: clampRD(phiStar * glickoScale), Volatility: sigma}
+	}
+
+	var vInv, deltaSum float64
+	for _, o := range outcomes {
+		muJ := (o.Opponent.Rating - DefaultRating) / glickoScale
+		phiJ := o.Opponent.RD / glickoScale
+		gJ := g(phiJ)
+		eJ := expected(mu, muJ, phiJ)
+		vInv += gJ * gJ * eJ * (1 - eJ)
+		deltaSum += gJ * (o.Score - eJ)
+	}
+	v := 1 / vInv
+	delta := v * deltaSum
+
+	sigmaPrime := newVolatility(phi, sigma, v, delta)
+	phiStar := math.Sqrt(phi*phi + sigmaPrime*sigmaPrime)
+	phiPrime := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	muPrime := mu + phiPrime*phiPrime*deltaSum
+
+	return Rating{
+		Rating:     muPrime*glickoScale + DefaultRating,
+		RD:         clampRD(phiPrime * glickoScale),
+		Volatility: sigmaPrime,
+	}
+}
+
+// ExpectedScore returns the probability that a beats b.
+func ExpectedScore(a, b Rating) float64 {
+	mu := (a.Rating - DefaultRating) / glickoScale
+	muJ := (b.Rating - DefaultRating) / glickoScale
+	phiJ := math.Sqrt(a.RD*a.RD+b.RD*b.RD) / glickoScale
+	return expected(mu, muJ, phiJ)
+}
+
+// PairwiseOutcomes treats a stroke-play round as a set of head-to-head games: every
+// player beats everyone with a higher score and ties everyone with the same score.
+// scores maps a player key to strokes (lower is better); ratings holds each player's
+// rating before the round. The returned outcomes are keyed by player.
+func PairwiseOutcomes(scores map[string]int, ratings map[string]Rating) map[string][]Outcome {
+	keys := make([]string, 0, len(scores))
+	for k := range scores {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make(map[string][]Outcome, len(keys))
+	for _, a := range keys {
+		for _, b := range keys {
+			if a == b {
+				continue
+			}
+			result := 0.5
+			switch {
+			case scores[a] < scores[b]:
+				result = 1
+			case scores[a] > scores[b]:
+				result = 0
+			}
+			out[a] = append(out[a], Outcome{Opponent: ratings[b], Score: result})
+		}
+	}
+	return out
+}
+
+func g(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+func expected(mu, muJ, phiJ float64) float64 {
+	return 1 / (1 + math.Exp(-g(phiJ)*(mu-muJ)))
+}
+
+// newVolatility solves for σ' with the Illinois algorithm (Glickman, step 5).
+func newVolatility(phi, sigma, v, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		num := ex * (delta*delta - phi*phi - v - ex)
+		den := 2 * math.Pow(phi*phi+v+ex, 2)
+		return num/den - (x-a)/(tau*tau)
+	}
+
+	A := a
+	var B float64
+	if delta*delta > phi*phi+v {
+		B = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*tau) < 0 {
+			k++
+		}
+		B = a - k*tau
+	}
+
+	fA, fB := f(A), f(B)
+	for math.Abs(B-A) > convergenceTolerance {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB <= 0 {
+			A, fA = B, fB
+		} else {
+			fA /= 2
+		}
+		B, fB = C, fC
+	}
+	return math.Exp(A / 2)
+}
+
+func clampRD(rd float64) float64 {
+	if rd < MinRD {
+		return MinRD
+	}
+	if rd > DefaultRD {
+		return DefaultRD
+	}
+	return rd
+}
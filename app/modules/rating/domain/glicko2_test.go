@@ -0,0 +1,121 @@
+package ratingdomain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUpdate_GlickmanExample(t *testing.T) {
+	// Worked example from Glickman's "Example of the Glicko-2 system".
+	player := Rating{Rating: 1500, RD: 200, Volatility: 0.06}
+	outcomes := []Outcome{
+		{Opponent: Rating{Rating: 1400, RD: 30, Volatility: 0.06}, Score: 1},
+		{Opponent: Rating{Rating: 1550, RD: 100, Volatility: 0.06}, Score: 0},
+		{Opponent: Rating{Rating: 1700, RD: 300, Volatility: 0.06}, Score: 0},
+	}
+
+	got := Update(player, outcomes)
+
+	if math.Abs(got.Rating-1464.06) > 0.05 {
+		t.Errorf("Rating = %.2f, want 1464.06", got.Rating)
+	}
+	if math.Abs(got.RD-151.52) > 0.05 {
+		t.Errorf("RD = %.2f, want 151.52", got.RD)
+	}
+	if math.Abs(got.Volatility-0.05999) > 0.00001 {
+		t.Errorf("Volatility = %.5f, want 0.05999", got.Volatility)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		player   Rating
+		outcomes []Outcome
+		check    func(t *testing.T, before, after Rating)
+	}{
+		{
+			name:   "no games only widens deviation",
+			player: Rating{Rating: 1600, RD: 80, Volatility: 0.06},
+			check: func(t *testing.T, before, after Rating) {
+				if after.Rating != before.Rating {
+					t.Errorf("rating changed without games: %v", after.Rating)
+				}
+				if after.RD <= before.RD {
+					t.Errorf("RD should grow without games: %v", after.RD)
+				}
+			},
+		},
+		{
+			name:   "deviation never exceeds default",
+			player: NewRating(),
+			check: func(t *testing.T, _, after Rating) {
+				if after.RD != DefaultRD {
+					t.Errorf("RD = %v, want %v", after.RD, DefaultRD)
+				}
+			},
+		},
+		{
+			name:     "win against equal opponent raises rating",
+			player:   NewRating(),
+			outcomes: []Outcome{{Opponent: NewRating(), Score: 1}},
+			check: func(t *testing.T, before, after Rating) {
+				if after.Rating <= before.Rating {
+					t.Errorf("rating should rise after a win: %v", after.Rating)
+				}
+				if after.RD >= before.RD {
+					t.Errorf("RD should shrink after a game: %v", after.RD)
+				}
+			},
+		},
+		{
+			name:     "tie against equal opponent keeps rating",
+			player:   NewRating(),
+			outcomes: []Outcome{{Opponent: NewRating(), Score: 0.5}},
+			check: func(t *testing.T, before, after Rating) {
+				if math.Abs(after.Rating-before.Rating) > 1e-9 {
+					t.Errorf("rating should not move on a tie: %v", after.Rating)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, tt.player, Update(tt.player, tt.outcomes))
+		})
+	}
+}
+
+func TestPairwiseOutcomes(t *testing.T) {
+	ratings := map[string]Rating{"a": NewRating(), "b": NewRating(), "c": NewRating()}
+	scores := map[string]int{"a": -3, "b": 0, "c": 0}
+
+	got := PairwiseOutcomes(scores, ratings)
+
+	wantTotals := map[string]float64{"a": 2, "b": 0.5, "c": 0.5}
+	for player, want := range wantTotals {
+		if len(got[player]) != 2 {
+			t.Fatalf("%s: expected 2 outcomes, got %d", player, len(got[player]))
+		}
+		var total float64
+		for _, o := range got[player] {
+			total += o.Score
+		}
+		if total != want {
+			t.Errorf("%s: total score = %v, want %v", player, total, want)
+		}
+	}
+}
+
+func TestExpectedScore(t *testing.T) {
+	strong := Rating{Rating: 1700, RD: 50}
+	weak := Rating{Rating: 1400, RD: 50}
+
+	if p := ExpectedScore(strong, weak); p <= 0.5 || p >= 1 {
+		t.Errorf("ExpectedScore(strong, weak) = %v, want (0.5, 1)", p)
+	}
+	if p := ExpectedScore(NewRating(), NewRating()); math.Abs(p-0.5) > 1e-9 {
+		t.Errorf("ExpectedScore(equal) = %v, want 0.5", p)
+	}
+}
@@ -0,0 +1,47 @@
+package ratinghandlers
+
+import (
+	"context"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
+	"github.com/google/uuid"
+)
+
+// FakeService implements ratingservice.Service for handler testing.
+type FakeService struct {
+	ApplyRoundResultsFunc func(ctx context.Context, req ratingservice.ApplyRoundRequest) (ratingservice.RoundRatingResult, error)
+	GetMemberRatingFunc   func(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (ratingservice.MemberRatingResult, error)
+	ListRatingsFunc       func(ctx context.Context, guildID sharedtypes.GuildID, layoutID *uuid.UUID, limit int) (ratingservice.RatingListResult, error)
+	GetRatingsFunc        func(ctx context.Context, guildID sharedtypes.GuildID, layoutID *uuid.UUID, memberIDs []sharedtypes.DiscordID) (map[sharedtypes.DiscordID]ratingservice.RatingView, error)
+}
+
+func (f *FakeService) ApplyRoundResults(ctx context.Context, req ratingservice.ApplyRoundRequest) (ratingservice.RoundRatingResult, error) {
+	if f.ApplyRoundResultsFunc != nil {
+		return f.ApplyRoundResultsFunc(ctx, req)
+	}
+	return ratingservice.RoundRatingResult{}, nil
+}
+
+func (f *FakeService) GetMemberRating(ctx context.Context, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) (ratingservice.MemberRatingResult, error) {
+	if f.GetMemberRatingFunc != nil {
+		return f.GetMemberRatingFunc(ctx, guildID, memberID)
+	}
+	return ratingservice.MemberRatingResult{}, nil
+}
+
+func (f *FakeService) ListRatings(ctx context.Context, guildID sharedtypes.GuildID, layoutID *uuid.UUID, limit int) (ratingservice.RatingListResult, error) {
+	if f.ListRatingsFunc != nil {
+		return f.ListRatingsFunc(ctx, guildID, layoutID, limit)
+	}
+	return ratingservice.RatingListResult{}, nil
+}
+
+func (f *FakeService) GetRatings(ctx context.Context, guildID sharedtypes.GuildID, layoutID *uuid.UUID, memberIDs []sharedtypes.DiscordID) (map[sharedtypes.DiscordID]ratingservice.RatingView, error) {
+	if f.GetRatingsFunc != nil {
+		return f.GetRatingsFunc(ctx, guildID, layoutID, memberIDs)
+	}
+	return map[sharedtypes.DiscordID]ratingservice.RatingView{}, nil
+}
+
+var _ ratingservice.Service = (*FakeService)(nil)
@@ -0,0 +1,170 @@
+package ratinghandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+	"github.com/google/uuid"
+)
+
+// Rating topics.
+const (
+	RatingsUpdatedV1       = "rating.updated.v1"
+	RatingMemberRequestV1  = "rating.member.request.v1"
+	RatingMemberResponseV1 = "rating.member.response.v1"
+	RatingListRequestV1    = "rating.list.request.v1"
+	RatingListResponseV1   = "rating.list.response.v1"
+	RatingRequestFailedV1  = "rating.request.failed.v1"
+)
+
+// RatingsUpdatedPayloadV1 is published after a finalized round has been rated.
+type RatingsUpdatedPayloadV1 struct {
+	GuildID  sharedtypes.GuildID          `json:"guild_id"`
+	RoundID  sharedtypes.RoundID          `json:"round_id"`
+	LayoutID *uuid.UUID                   `json:"layout_id,omitempty"`
+	Changes  []ratingservice.RatingChange `json:"changes"`
+}
+
+// MemberRatingRequestPayloadV1 is the request/reply payload for one member's ratings.
+type MemberRatingRequestPayloadV1 struct {
+	GuildID  sharedtypes.GuildID   `json:"guild_id"`
+	MemberID sharedtypes.DiscordID `json:"member_id"`
+}
+
+// MemberRatingResponsePayloadV1 is the reply for RatingMemberRequestV1.
+type MemberRatingResponsePayloadV1 struct {
+	GuildID sharedtypes.GuildID                `json:"guild_id"`
+	Profile *ratingservice.MemberRatingProfile `json:"profile"`
+}
+
+// RatingListRequestPayloadV1 is the request/reply payload for the guild's rating table.
+// LayoutID selects ratings on one course layout; omit it for guild-wide ratings.
+type RatingListRequestPayloadV1 struct {
+	GuildID  sharedtypes.GuildID `json:"guild_id"`
+	LayoutID *uuid.UUID          `json:"layout_id,omitempty"`
+	Limit    int                 `json:"limit,omitempty"`
+}
+
+// RatingListResponsePayloadV1 is the reply for RatingListRequestV1.
+type RatingListResponsePayloadV1 struct {
+	GuildID  sharedtypes.GuildID        `json:"guild_id"`
+	LayoutID *uuid.UUID                 `json:"layout_id,omitempty"`
+	Ratings  []ratingservice.RatingView `json:"ratings"`
+}
+
+// RatingRequestFailedPayloadV1 reports a rating read that could not be served.
+type RatingRequestFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+	Reason  string              `json:"reason"`
+}
+
+// RatingHandlers implements the Handlers interface for rating events.
+type RatingHandlers struct {
+	service ratingservice.Service
+	logger  *slog.Logger
+}
+
+// NewRatingHandlers creates a new RatingHandlers instance.
+func NewRatingHandlers(service ratingservice.Service, logger *slog.Logger) Handlers {
+	return &RatingHandlers{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// HandleRoundFinalized rates the finalized round. Rounds that cannot be rated (too
+// few finishers, or a redelivered event) are acknowledged without output.
+func (h *RatingHandlers) HandleRoundFinalized(ctx context.Context, payload *roundevents.RoundFinalizedPayloadV1) ([]handlerwrapper.Result, error) {
+	scores := make([]ratingservice.RoundScore, 0, len(payload.RoundData.Participants))
+	for _, p := range payload.RoundData.Participants {
+		if p.Score == nil {
+			continue
+		}
+		scores = append(scores, ratingservice.RoundScore{
+			MemberID: p.UserID,
+			Score:    int(*p.Score),
+			IsDNF:    p.IsDNF,
+		})
+	}
+
+	result, err := h.service.ApplyRoundResults(ctx, ratingservice.ApplyRoundRequest{
+		GuildID: payload.GuildID,
+		RoundID: payload.RoundID,
+		Scores:  scores,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		failure := *result.Failure
+		if !errors.Is(failure, ratingservice.ErrRoundAlreadyRated) && !errors.Is(failure, ratingservice.ErrInsufficientField) {
+			h.logger.WarnContext(ctx, "Round not rated",
+				attr.String("guild_id", string(payload.GuildID)),
+				attr.String("round_id", payload.RoundID.String()),
+				attr.Error(failure),
+			)
+		}
+		return nil, nil
+	}
+
+	summary := *result.Success
+	return []handlerwrapper.Result{{
+		Topic: RatingsUpdatedV1,
+		Payload: &RatingsUpdatedPayloadV1{
+			GuildID:  summary.GuildID,
+			RoundID:  summary.RoundID,
+			LayoutID: summary.LayoutID,
+			Changes:  summary.Changes,
+		},
+	}}, nil
+}
+
+// HandleMemberRatingRequest replies with a member's ratings and recent history.
+func (h *RatingHandlers) HandleMemberRatingRequest(ctx context.Context, payload *MemberRatingRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	result, err := h.service.GetMemberRating(ctx, payload.GuildID, payload.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.requestFailure(ctx, payload.GuildID, *result.Failure), nil
+	}
+
+	return []handlerwrapper.Result{{
+		Topic:   handlerutil.ReplyTopic(ctx, RatingMemberResponseV1),
+		Payload: &MemberRatingResponsePayloadV1{GuildID: payload.GuildID, Profile: *result.Success},
+	}}, nil
+}
+
+// HandleRatingListRequest replies with the guild's ratings, best first.
+func (h *RatingHandlers) HandleRatingListRequest(ctx context.Context, payload *RatingListRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	result, err := h.service.ListRatings(ctx, payload.GuildID, payload.LayoutID, payload.Limit)
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.requestFailure(ctx, payload.GuildID, *result.Failure), nil
+	}
+
+	return []handlerwrapper.Result{{
+		Topic: handlerutil.ReplyTopic(ctx, RatingListResponseV1),
+		Payload: &RatingListResponsePayloadV1{
+			GuildID:  payload.GuildID,
+			LayoutID: payload.LayoutID,
+			Ratings:  *result.Success,
+		},
+	}}, nil
+}
+
+func (h *RatingHandlers) requestFailure(ctx context.Context, guildID sharedtypes.GuildID, err error) []handlerwrapper.Result {
+	return []handlerwrapper.Result{{
+		Topic:   handlerutil.ReplyTopic(ctx, RatingRequestFailedV1),
+		Payload: &RatingRequestFailedPayloadV1{GuildID: guildID, Reason: err.Error()},
+	}}
+}
@@ -0,0 +1,185 @@
+package ratinghandlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
+	"github.com/google/uuid"
+)
+
+func TestRatingHandlers_HandleRoundFinalized(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+	score := func(v int) *sharedtypes.Score {
+		s := sharedtypes.Score(v)
+		return &s
+	}
+	payload := &roundevents.RoundFinalizedPayloadV1{
+		GuildID: guildID,
+		RoundID: roundID,
+		RoundData: roundtypes.Round{
+			Participants: []roundtypes.Participant{
+				{UserID: "a", Score: score(-2)},
+				{UserID: "b", Score: score(1), IsDNF: true},
+				{UserID: "c"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		setupFake func(t *testing.T, f *FakeService)
+		wantLen   int
+		wantErr   bool
+	}{
+		{
+			name: "publishes rating changes",
+			setupFake: func(t *testing.T, f *FakeService) {
+				f.ApplyRoundResultsFunc = func(ctx context.Context, req ratingservice.ApplyRoundRequest) (ratingservice.RoundRatingResult, error) {
+					if len(req.Scores) != 2 {
+						t.Errorf("expected unscored participant to be dropped, got %+v", req.Scores)
+					}
+					if !req.Scores[1].IsDNF {
+						t.Errorf("expected DNF flag to be forwarded, got %+v", req.Scores[1])
+					}
+					return results.SuccessResult[*ratingservice.RoundRatingSummary, error](&ratingservice.RoundRatingSummary{
+						GuildID: req.GuildID,
+						RoundID: req.RoundID,
+						Changes: []ratingservice.RatingChange{{MemberID: "a", Before: 1500, After: 1560}},
+					}), nil
+				}
+			},
+			wantLen: 1,
+		},
+		{
+			name: "already rated round is acknowledged quietly",
+			setupFake: func(t *testing.T, f *FakeService) {
+				f.ApplyRoundResultsFunc = func(ctx context.Context, req ratingservice.ApplyRoundRequest) (ratingservice.RoundRatingResult, error) {
+					return results.FailureResult[*ratingservice.RoundRatingSummary, error](ratingservice.ErrRoundAlreadyRated), nil
+				}
+			},
+			wantLen: 0,
+		},
+		{
+			name: "infrastructure error is retried",
+			setupFake: func(t *testing.T, f *FakeService) {
+				f.ApplyRoundResultsFunc = func(ctx context.Context, req ratingservice.ApplyRoundRequest) (ratingservice.RoundRatingResult, error) {
+					return ratingservice.RoundRatingResult{}, errors.New("db down")
+				}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &FakeService{}
+			tt.setupFake(t, svc)
+			h := NewRatingHandlers(svc, slog.Default())
+
+			got, err := h.HandleRoundFinalized(context.Background(), payload)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantLen {
+				t.Fatalf("expected %d results, got %d", tt.wantLen, len(got))
+			}
+			if tt.wantLen > 0 && got[0].Topic != RatingsUpdatedV1 {
+				t.Errorf("topic = %s, want %s", got[0].Topic, RatingsUpdatedV1)
+			}
+		})
+	}
+}
+
+func TestRatingHandlers_HandleMemberRatingRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-1")
+
+	tests := []struct {
+		name      string
+		setupFake func(f *FakeService)
+		replyTo   string
+		wantTopic string
+	}{
+		{
+			name: "replies to inbox",
+			setupFake: func(f *FakeService) {
+				f.GetMemberRatingFunc = func(ctx context.Context, g sharedtypes.GuildID, m sharedtypes.DiscordID) (ratingservice.MemberRatingResult, error) {
+					return results.SuccessResult[*ratingservice.MemberRatingProfile, error](&ratingservice.MemberRatingProfile{MemberID: m}), nil
+				}
+			},
+			replyTo:   "_INBOX.rating",
+			wantTopic: "_INBOX.rating",
+		},
+		{
+			name: "falls back to response topic",
+			setupFake: func(f *FakeService) {
+				f.GetMemberRatingFunc = func(ctx context.Context, g sharedtypes.GuildID, m sharedtypes.DiscordID) (ratingservice.MemberRatingResult, error) {
+					return results.SuccessResult[*ratingservice.MemberRatingProfile, error](&ratingservice.MemberRatingProfile{MemberID: m}), nil
+				}
+			},
+			wantTopic: RatingMemberResponseV1,
+		},
+		{
+			name: "validation failure",
+			setupFake: func(f *FakeService) {
+				f.GetMemberRatingFunc = func(ctx context.Context, g sharedtypes.GuildID, m sharedtypes.DiscordID) (ratingservice.MemberRatingResult, error) {
+					return results.FailureResult[*ratingservice.MemberRatingProfile, error](ratingservice.ErrInvalidMemberID), nil
+				}
+			},
+			wantTopic: RatingRequestFailedV1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &FakeService{}
+			tt.setupFake(svc)
+			h := NewRatingHandlers(svc, slog.Default())
+
+			ctx := context.Background()
+			if tt.replyTo != "" {
+				ctx = context.WithValue(ctx, handlerwrapper.CtxKeyReplyTo, tt.replyTo)
+			}
+			got, err := h.HandleMemberRatingRequest(ctx, &MemberRatingRequestPayloadV1{GuildID: guildID, MemberID: "a"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 1 || got[0].Topic != tt.wantTopic {
+				t.Fatalf("expected single result on %s, got %+v", tt.wantTopic, got)
+			}
+		})
+	}
+}
+
+func TestRatingHandlers_HandleRatingListRequest(t *testing.T) {
+	layoutID := uuid.New()
+	svc := &FakeService{
+		ListRatingsFunc: func(ctx context.Context, g sharedtypes.GuildID, l *uuid.UUID, limit int) (ratingservice.RatingListResult, error) {
+			if l == nil || *l != layoutID || limit != 5 {
+				t.Errorf("unexpected arguments: layout=%v limit=%d", l, limit)
+			}
+			return results.SuccessResult[[]ratingservice.RatingView, error]([]ratingservice.RatingView{{MemberID: "a", Rating: 1620}}), nil
+		},
+	}
+	h := NewRatingHandlers(svc, slog.Default())
+
+	got, err := h.HandleRatingListRequest(context.Background(), &RatingListRequestPayloadV1{GuildID: "guild-1", LayoutID: &layoutID, Limit: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].Topic != RatingListResponseV1 {
+		t.Fatalf("unexpected results: %+v", got)
+	}
+	payload := got[0].Payload.(*RatingListResponsePayloadV1)
+	if len(payload.Ratings) != 1 || payload.Ratings[0].MemberID != "a" {
+		t.Errorf("unexpected ratings: %+v", payload.Ratings)
+	}
+}
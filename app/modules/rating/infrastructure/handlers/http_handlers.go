@@ -0,0 +1,191 @@
+package ratinghandlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
+	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const refreshTokenCookie = "refresh_token"
+
+// HTTPHandlers implements the PWA rating endpoints. Every endpoint is scoped to a
+// club (?club_uuid=) and requires the caller to be a member of it.
+type HTTPHandlers struct {
+	service  ratingservice.Service
+	userRepo userdb.Repository
+	logger   *slog.Logger
+}
+
+// NewHTTPHandlers creates new rating HTTP handlers.
+func NewHTTPHandlers(service ratingservice.Service, userRepo userdb.Repository, logger *slog.Logger) *HTTPHandlers {
+	return &HTTPHandlers{
+		service:  service,
+		userRepo: userRepo,
+		logger:   logger,
+	}
+}
+
+// HandleListRatings returns the club's rating table.
+// GET /api/ratings?club_uuid=...&layout_id=...&limit=...
+func (h *HTTPHandlers) HandleListRatings(w http.ResponseWriter, r *http.Request) {
+	guildID, ok := h.authorizeClub(w, r)
+	if !ok {
+		return
+	}
+
+	var layoutID *uuid.UUID
+	if raw := r.URL.Query().Get("layout_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			httpError(w, http.StatusBadRequest, "invalid_layout_id", "invalid layout_id")
+			return
+		}
+		layoutID = &id
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			httpError(w, http.StatusBadRequest, "invalid_limit", "invalid limit")
+			return
+		}
+		limit = n
+	}
+
+	result, err := h.service.ListRatings(r.Context(), guildID, layoutID, limit)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "ListRatings failed", slog.String("error", err.Error()))
+		httpError(w, http.StatusInternalServerError, "internal_error", "internal server error")
+		return
+	}
+	if result.Failure != nil {
+		httpError(w, http.StatusBadRequest, "invalid_request", (*result.Failure).Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"layout_id": layoutID,
+		"ratings":   *result.Success,
+	})
+}
+
+// HandleGetMemberRating returns one member's ratings and recent history.
+// GET /api/ratings/members/{memberID}?club_uuid=...
+func (h *HTTPHandlers) HandleGetMemberRating(w http.ResponseWriter, r *http.Request) {
+	guildID, ok := h.authorizeClub(w, r)
+	if !ok {
+		return
+	}
+
+	memberID := sharedtypes.DiscordID(chi.URLParam(r, "memberID"))
+	result, err := h.service.GetMemberRating(r.Context(), guildID, memberID)
+	if err != nil {
+		h.logger.ErrorContext(r.Context(), "GetMemberRating failed", slog.String("error", err.Error()))
+		httpError(w, http.StatusInternalServerError, "internal_error", "internal server error")
+		return
+	}
+	if result.Failure != nil {
+		httpError(w, http.StatusBadRequest, "invalid_request", (*result.Failure).Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, *result.Success)
+}
+
+// authorizeClub resolves the caller's session and club, writing the error response
+// and returning false when the caller may not read the club's ratings.
+func (h *HTTPHandlers) authorizeClub(w http.ResponseWriter, r *http.Request) (sharedtypes.GuildID, bool) {
+	userUUID, err := h.resolveUserUUID(r)
+	if err != nil {
+		httpError(w, http.StatusUnauthorized, "unauthorized", "unauthorized")
+		return "", false
+	}
+
+	clubUUID, err := uuid.Parse(r.URL.Query().Get("club_uuid"))
+	if err != nil {
+		httpError(w, http.StatusBadRequest, "invalid_club_uuid", "invalid club_uuid")
+		return "", false
+	}
+
+	if _, err := h.userRepo.GetClubMembership(r.Context(), nil, userUUID, clubUUID); err != nil {
+		httpError(w, http.StatusForbidden, "membership_required", "club membership required")
+		return "", false
+	}
+
+	guildID, err := h.userRepo.GetDiscordGuildIDByClubUUID(r.Context(), nil, clubUUID)
+	if err != nil || guildID == "" {
+		httpError(w, http.StatusNotFound, "club_not_found", "club not found")
+		return "", false
+	}
+	return guildID, true
+}
+
+// isValidTokenFormat checks that a refresh token has the expected format:
+// exactly 64 lowercase hex characters (hex-encoded 32 random bytes).
+func isValidTokenFormat(s string) bool {
+	if len(s) != 64 {
+		return false
+	}
+	for _, c := range s {
+		if !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'f')) {
+			return false
+		}
+	}
+	return true
+}
+
+func (h *HTTPHandlers) resolveUserUUID(r *http.Request) (uuid.UUID, error) {
+	raw := ""
+	if cookie, err := r.Cookie(refreshTokenCookie); err == nil && isValidTokenFormat(cookie.Value) {
+		raw = cookie.Value
+	}
+	if raw == "" {
+		if auth := r.Header.Get("Authorization"); len(auth) > 7 && auth[:7] == "Bearer " && isValidTokenFormat(auth[7:]) {
+			raw = auth[7:]
+		}
+	}
+	if raw == "" {
+		return uuid.Nil, fmt.Errorf("missing session")
+	}
+	token, err := h.userRepo.GetRefreshToken(r.Context(), nil, sha256hex(raw))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid session")
+	}
+	if token.Revoked {
+		return uuid.Nil, fmt.Errorf("session revoked")
+	}
+	if time.Now().After(token.ExpiresAt) {
+		return uuid.Nil, fmt.Errorf("session expired")
+	}
+	return token.UserUUID, nil
+}
+
+func sha256hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func httpError(w http.ResponseWriter, status int, code, msg string) {
+	writeJSON(w, status, map[string]string{
+		"code":  code,
+		"error": msg,
+	})
+}
@@ -0,0 +1,112 @@
+package ratinghandlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
+	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+const testSessionToken = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestHTTPHandlers_HandleListRatings(t *testing.T) {
+	userUUID := uuid.New()
+	clubUUID := uuid.New()
+
+	member := func(repo *userdb.FakeRepository) {
+		repo.GetClubMembershipFn = func(_ context.Context, _ bun.IDB, _, _ uuid.UUID) (*userdb.ClubMembership, error) {
+			return &userdb.ClubMembership{}, nil
+		}
+		repo.GetDiscordGuildIDByClubUUIDFn = func(_ context.Context, _ bun.IDB, _ uuid.UUID) (sharedtypes.GuildID, error) {
+			return "guild-1", nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		withCookie bool
+		setupRepo  func(*userdb.FakeRepository)
+		wantStatus int
+	}{
+		{
+			name:       "member lists ratings",
+			query:      "?club_uuid=" + clubUUID.String(),
+			withCookie: true,
+			setupRepo:  member,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing session",
+			query:      "?club_uuid=" + clubUUID.String(),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid club uuid",
+			query:      "?club_uuid=nope",
+			withCookie: true,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "non-member is forbidden",
+			query:      "?club_uuid=" + clubUUID.String(),
+			withCookie: true,
+			setupRepo: func(repo *userdb.FakeRepository) {
+				repo.GetClubMembershipFn = func(_ context.Context, _ bun.IDB, _, _ uuid.UUID) (*userdb.ClubMembership, error) {
+					return nil, errors.New("not found")
+				}
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "invalid layout id",
+			query:      "?club_uuid=" + clubUUID.String() + "&layout_id=bad",
+			withCookie: true,
+			setupRepo:  member,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &userdb.FakeRepository{}
+			repo.GetRefreshTokenFn = func(_ context.Context, _ bun.IDB, _ string) (*userdb.RefreshToken, error) {
+				return &userdb.RefreshToken{UserUUID: userUUID, ExpiresAt: time.Now().Add(time.Hour)}, nil
+			}
+			if tt.setupRepo != nil {
+				tt.setupRepo(repo)
+			}
+			svc := &FakeService{
+				ListRatingsFunc: func(ctx context.Context, g sharedtypes.GuildID, l *uuid.UUID, limit int) (ratingservice.RatingListResult, error) {
+					if g != "guild-1" {
+						t.Errorf("guild = %s, want guild-1", g)
+					}
+					return results.SuccessResult[[]ratingservice.RatingView, error]([]ratingservice.RatingView{}), nil
+				},
+			}
+			h := NewHTTPHandlers(svc, repo, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/ratings"+tt.query, nil)
+			if tt.withCookie {
+				req.AddCookie(&http.Cookie{Name: refreshTokenCookie, Value: testSessionToken})
+			}
+			rec := httptest.NewRecorder()
+			h.HandleListRatings(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
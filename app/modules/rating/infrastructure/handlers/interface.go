@@ -0,0 +1,18 @@
+package ratinghandlers
+
+import (
+	"context"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+)
+
+// Handlers defines the contract for rating message handlers.
+type Handlers interface {
+	// Events
+	HandleRoundFinalized(ctx context.Context, payload *roundevents.RoundFinalizedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Request/reply reads
+	HandleMemberRatingRequest(ctx context.Context, payload *MemberRatingRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleRatingListRequest(ctx context.Context, payload *RatingListRequestPayloadV1) ([]handlerwrapper.Result, error)
+}
@@ -0,0 +1,9 @@
+package ratingdb
+
+import "errors"
+
+// Sentinel errors for the rating repository layer.
+var (
+	// ErrNotFound indicates the member has no rating in the requested scope.
+	ErrNotFound = errors.New("rating not found")
+)
@@ -0,0 +1,47 @@
+package ratingdb
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// Repository defines the contract for rating persistence.
+//
+// Scopes: layoutID == GuildScope selects guild-wide ratings; any other value selects
+// ratings on that course layout.
+type Repository interface {
+	// GetRatingsForUpdate returns the given members' ratings in a scope, locking the
+	// rows for the rest of the transaction. Members without a rating are omitted.
+	GetRatingsForUpdate(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, memberIDs []sharedtypes.DiscordID) ([]*PlayerRating, error)
+
+	// GetRatings returns the given members' ratings in a scope without locking.
+	GetRatings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, memberIDs []sharedtypes.DiscordID) ([]*PlayerRating, error)
+
+	// ListRatings returns ratings in a scope ordered best first.
+	ListRatings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, limit int) ([]*PlayerRating, error)
+
+	// ListMemberRatings returns a member's ratings across all scopes.
+	ListMemberRatings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) ([]*PlayerRating, error)
+
+	// UpsertRatings inserts or replaces ratings keyed by (guild, member, layout).
+	UpsertRatings(ctx context.Context, db bun.IDB, ratings []*PlayerRating) error
+
+	// InsertHistory appends rating history rows.
+	InsertHistory(ctx context.Context, db bun.IDB, entries []*RatingHistory) error
+
+	// DeleteRatings removes ratings keyed by (guild, member, layout).
+	DeleteRatings(ctx context.Context, db bun.IDB, ratings []*PlayerRating) error
+
+	// ListRoundHistory returns every history row a round produced, across scopes.
+	ListRoundHistory(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID uuid.UUID) ([]*RatingHistory, error)
+
+	// DeleteRoundHistory removes the history rows a round produced.
+	DeleteRoundHistory(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID uuid.UUID) error
+
+	// ListMemberHistory returns a member's history in a scope, newest first.
+	ListMemberHistory(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, layoutID uuid.UUID, limit int) ([]*RatingHistory, error)
+}
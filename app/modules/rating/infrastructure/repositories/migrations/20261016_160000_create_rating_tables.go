@@ -0,0 +1,86 @@
+package ratingmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Creating player rating tables...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				CREATE TABLE IF NOT EXISTS player_ratings (
+					guild_id VARCHAR NOT NULL,
+					member_id VARCHAR NOT NULL,
+					layout_id UUID NOT NULL,
+					rating DOUBLE PRECISION NOT NULL,
+					rd DOUBLE PRECISION NOT NULL,
+					volatility DOUBLE PRECISION NOT NULL,
+					rounds_rated INTEGER NOT NULL DEFAULT 0,
+					last_round_id UUID NOT NULL,
+					updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					PRIMARY KEY (guild_id, member_id, layout_id)
+				);
+			`); err != nil {
+				return fmt.Errorf("failed to create player_ratings table: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_player_ratings_scope_rating
+					ON player_ratings (guild_id, layout_id, rating DESC);
+			`); err != nil {
+				return fmt.Errorf("failed to create player_ratings scope index: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE TABLE IF NOT EXISTS player_rating_history (
+					id BIGSERIAL PRIMARY KEY,
+					guild_id VARCHAR NOT NULL,
+					member_id VARCHAR NOT NULL,
+					layout_id UUID NOT NULL,
+					round_id UUID NOT NULL,
+					rating_before DOUBLE PRECISION NOT NULL,
+					rating_after DOUBLE PRECISION NOT NULL,
+					rd_after DOUBLE PRECISION NOT NULL,
+					volatility DOUBLE PRECISION NOT NULL,
+					created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					UNIQUE (round_id, member_id, layout_id)
+				);
+			`); err != nil {
+				return fmt.Errorf("failed to create player_rating_history table: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_player_rating_history_member
+					ON player_rating_history (guild_id, member_id, layout_id, created_at DESC);
+			`); err != nil {
+				return fmt.Errorf("failed to create player_rating_history member index: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_player_rating_history_round
+					ON player_rating_history (guild_id, round_id);
+			`); err != nil {
+				return fmt.Errorf("failed to create player_rating_history round index: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping player rating tables...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `DROP TABLE IF EXISTS player_rating_history;`); err != nil {
+				return fmt.Errorf("failed to drop player_rating_history table: %w", err)
+			}
+			if _, err := tx.ExecContext(ctx, `DROP TABLE IF EXISTS player_ratings;`); err != nil {
+				return fmt.Errorf("failed to drop player_ratings table: %w", err)
+			}
+			return nil
+		})
+	})
+}
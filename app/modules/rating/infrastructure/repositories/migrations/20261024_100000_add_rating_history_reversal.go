@@ -0,0 +1,40 @@
+package ratingmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding reversal columns to player_rating_history...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE player_rating_history
+					ADD COLUMN IF NOT EXISTS rd_before DOUBLE PRECISION,
+					ADD COLUMN IF NOT EXISTS volatility_before DOUBLE PRECISION,
+					ADD COLUMN IF NOT EXISTS score INTEGER;
+			`); err != nil {
+				return fmt.Errorf("failed to add reversal columns to player_rating_history: %w", err)
+			}
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping reversal columns from player_rating_history...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE player_rating_history
+					DROP COLUMN IF EXISTS score,
+					DROP COLUMN IF EXISTS volatility_before,
+					DROP COLUMN IF EXISTS rd_before;
+			`); err != nil {
+				return fmt.Errorf("failed to drop reversal columns from player_rating_history: %w", err)
+			}
+			return nil
+		})
+	})
+}
@@ -0,0 +1,5 @@
+package ratingmigrations
+
+import "github.com/uptrace/bun/migrate"
+
+var Migrations = migrate.NewMigrations()
@@ -0,0 +1,50 @@
+package ratingdb
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// GuildScope is the layout ID used for a member's guild-wide rating. Ratings scoped
+// to a course layout carry that layout's ID instead.
+var GuildScope = uuid.Nil
+
+// PlayerRating is a member's current Glicko-2 rating in one scope.
+type PlayerRating struct {
+	bun.BaseModel `bun:"table:player_ratings,alias:pr"`
+
+	GuildID     sharedtypes.GuildID   `bun:"guild_id,pk"`
+	MemberID    sharedtypes.DiscordID `bun:"member_id,pk"`
+	LayoutID    uuid.UUID             `bun:"layout_id,pk,type:uuid"`
+	Rating      float64               `bun:"rating,notnull"`
+	RD          float64               `bun:"rd,notnull"`
+	Volatility  float64               `bun:"volatility,notnull"`
+	RoundsRated int                   `bun:"rounds_rated,notnull,default:0"`
+	LastRoundID uuid.UUID             `bun:"last_round_id,type:uuid,notnull"`
+	UpdatedAt   time.Time             `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// RatingHistory records how one round moved a member's rating in one scope.
+// RDBefore, VolatilityBefore and Score let a re-finalized round be reversed and
+// re-rated; rows written before they existed leave them nil.
+type RatingHistory struct {
+	bun.BaseModel `bun:"table:player_rating_history,alias:prh"`
+
+	ID               int64                 `bun:"id,pk,autoincrement"`
+	GuildID          sharedtypes.GuildID   `bun:"guild_id,notnull"`
+	MemberID         sharedtypes.DiscordID `bun:"member_id,notnull"`
+	LayoutID         uuid.UUID             `bun:"layout_id,type:uuid,notnull"`
+	RoundID          uuid.UUID             `bun:"round_id,type:uuid,notnull"`
+	RatingBefore     float64               `bun:"rating_before,notnull"`
+	RatingAfter      float64               `bun:"rating_after,notnull"`
+	RDAfter          float64               `bun:"rd_after,notnull"`
+	Volatility       float64               `bun:"volatility,notnull"`
+	RDBefore         *float64              `bun:"rd_before"`
+	VolatilityBefore *float64              `bun:"volatility_before"`
+	Score            *int                  `bun:"score"`
+	CreatedAt        time.Time             `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
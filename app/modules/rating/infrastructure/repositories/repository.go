@@ -0,0 +1,201 @@
+package ratingdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// Impl implements the Repository interface using Bun ORM.
+type Impl struct {
+	db bun.IDB
+}
+
+// NewRepository creates a new rating repository.
+func NewRepository(db bun.IDB) Repository {
+	return &Impl{db: db}
+}
+
+// GetRatingsForUpdate returns members' ratings in a scope and locks the rows.
+func (r *Impl) GetRatingsForUpdate(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, memberIDs []sharedtypes.DiscordID) ([]*PlayerRating, error) {
+	if db == nil {
+		db = r.db
+	}
+	if len(memberIDs) == 0 {
+		return []*PlayerRating{}, nil
+	}
+	var ratings []*PlayerRating
+	err := db.NewSelect().
+		Model(&ratings).
+		Where("pr.guild_id = ? AND pr.layout_id = ?", guildID, layoutID).
+		Where("pr.member_id IN (?)", bun.In(memberIDs)).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock ratings: %w", err)
+	}
+	return ratings, nil
+}
+
+// GetRatings returns members' ratings in a scope.
+func (r *Impl) GetRatings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, memberIDs []sharedtypes.DiscordID) ([]*PlayerRating, error) {
+	if db == nil {
+		db = r.db
+	}
+	if len(memberIDs) == 0 {
+		return []*PlayerRating{}, nil
+	}
+	var ratings []*PlayerRating
+	err := db.NewSelect().
+		Model(&ratings).
+		Where("pr.guild_id = ? AND pr.layout_id = ?", guildID, layoutID).
+		Where("pr.member_id IN (?)", bun.In(memberIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ratings: %w", err)
+	}
+	return ratings, nil
+}
+
+// ListRatings returns ratings in a scope ordered best first.
+func (r *Impl) ListRatings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, limit int) ([]*PlayerRating, error) {
+	if db == nil {
+		db = r.db
+	}
+	var ratings []*PlayerRating
+	q := db.NewSelect().
+		Model(&ratings).
+		Where("pr.guild_id = ? AND pr.layout_id = ?", guildID, layoutID).
+		OrderExpr("pr.rating DESC, pr.member_id ASC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	if err := q.Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list ratings: %w", err)
+	}
+	return ratings, nil
+}
+
+// ListMemberRatings returns a member's ratings across all scopes.
+func (r *Impl) ListMemberRatings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID) ([]*PlayerRating, error) {
+	if db == nil {
+		db = r.db
+	}
+	var ratings []*PlayerRating
+	err := db.NewSelect().
+		Model(&ratings).
+		Where("pr.guild_id = ? AND pr.member_id = ?", guildID, memberID).
+		OrderExpr("pr.rounds_rated DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list member ratings: %w", err)
+	}
+	return ratings, nil
+}
+
+// UpsertRatings inserts or replaces ratings keyed by (guild, member, layout).
+func (r *Impl) UpsertRatings(ctx context.Context, db bun.IDB, ratings []*PlayerRating) error {
+	if db == nil {
+		db = r.db
+	}
+	if len(ratings) == 0 {
+		return nil
+	}
+	_, err := db.NewInsert().
+		Model(&ratings).
+		On("CONFLICT (guild_id, member_id, layout_id) DO UPDATE").
+		Set("rating = EXCLUDED.rating").
+		Set("rd = EXCLUDED.rd").
+		Set("volatility = EXCLUDED.volatility").
+		Set("rounds_rated = EXCLUDED.rounds_rated").
+		Set("last_round_id = EXCLUDED.last_round_id").
+		Set("updated_at = now()").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to upsert ratings: %w", err)
+	}
+	return nil
+}
+
+// InsertHistory appends rating history rows.
+func (r *Impl) InsertHistory(ctx context.Context, db bun.IDB, entries []*RatingHistory) error {
+	if db == nil {
+		db = r.db
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	if _, err := db.NewInsert().Model(&entries).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to insert rating history: %w", err)
+	}
+	return nil
+}
+
+// DeleteRatings removes ratings keyed by (guild, member, layout).
+func (r *Impl) DeleteRatings(ctx context.Context, db bun.IDB, ratings []*PlayerRating) error {
+	if db == nil {
+		db = r.db
+	}
+	if len(ratings) == 0 {
+		return nil
+	}
+	if _, err := db.NewDelete().Model(&ratings).WherePK().Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete ratings: %w", err)
+	}
+	return nil
+}
+
+// ListRoundHistory returns every history row a round produced, across scopes.
+func (r *Impl) ListRoundHistory(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID uuid.UUID) ([]*RatingHistory, error) {
+	if db == nil {
+		db = r.db
+	}
+	var entries []*RatingHistory
+	err := db.NewSelect().
+		Model(&entries).
+		Where("prh.guild_id = ? AND prh.round_id = ?", guildID, roundID).
+		OrderExpr("prh.id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list round rating history: %w", err)
+	}
+	return entries, nil
+}
+
+// DeleteRoundHistory removes the history rows a round produced.
+func (r *Impl) DeleteRoundHistory(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID uuid.UUID) error {
+	if db == nil {
+		db = r.db
+	}
+	_, err := db.NewDelete().
+		Model((*RatingHistory)(nil)).
+		Where("prh.guild_id = ? AND prh.round_id = ?", guildID, roundID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete round rating history: %w", err)
+	}
+	return nil
+}
+
+// ListMemberHistory returns a member's history in a scope, newest first.
+func (r *Impl) ListMemberHistory(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, memberID sharedtypes.DiscordID, layoutID uuid.UUID, limit int) ([]*RatingHistory, error) {
+	if db == nil {
+		db = r.db
+	}
+	var entries []*RatingHistory
+	q := db.NewSelect().
+		Model(&entries).
+		Where("prh.guild_id = ? AND prh.member_id = ? AND prh.layout_id = ?", guildID, memberID, layoutID).
+		OrderExpr("prh.created_at DESC, prh.id DESC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	if err := q.Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list rating history: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,96 @@
+package ratingrouter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	ratinghandlers "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/infrastructure/handlers"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Router struct {
+	logger     *slog.Logger
+	router     *message.Router
+	subscriber eventbus.EventBus
+	publisher  eventbus.EventBus
+	helper     utils.Helpers
+	tracer     trace.Tracer
+}
+
+func NewRouter(
+	logger *slog.Logger,
+	router *message.Router,
+	subscriber eventbus.EventBus,
+	publisher eventbus.EventBus,
+	helper utils.Helpers,
+	tracer trace.Tracer,
+) *Router {
+	return &Router{
+		logger:     logger,
+		router:     router,
+		subscriber: subscriber,
+		publisher:  publisher,
+		helper:     helper,
+		tracer:     tracer,
+	}
+}
+
+func (r *Router) Configure(_ context.Context, handlers ratinghandlers.Handlers) error {
+	deps := handlerDeps{
+		router:     r.router,
+		subscriber: r.subscriber,
+		publisher:  r.publisher,
+		logger:     r.logger,
+		tracer:     r.tracer,
+		helper:     r.helper,
+	}
+
+	registerHandler(deps, roundevents.RoundFinalizedV2, handlers.HandleRoundFinalized)
+	// NATS request/reply: rating.*.request.v1.> captures per-club subjects
+	registerHandler(deps, ratinghandlers.RatingMemberRequestV1+".>", handlers.HandleMemberRatingRequest)
+	registerHandler(deps, ratinghandlers.RatingListRequestV1+".>", handlers.HandleRatingListRequest)
+
+	return nil
+}
+
+type handlerDeps struct {
+	router     *message.Router
+	subscriber eventbus.EventBus
+	publisher  eventbus.EventBus
+	logger     *slog.Logger
+	tracer     trace.Tracer
+	helper     utils.Helpers
+}
+
+func registerHandler[T any](
+	deps handlerDeps,
+	topic string,
+	handler func(context.Context, *T) ([]handlerwrapper.Result, error),
+) {
+	handlerName := "rating." + topic
+
+	deps.router.AddHandler(
+		handlerName,
+		topic,
+		deps.subscriber,
+		"",
+		deps.publisher,
+		handlerwrapper.WrapTransformingTyped(
+			handlerName,
+			deps.logger,
+			deps.tracer,
+			deps.helper,
+			nil,
+			handler,
+		),
+	)
+}
+
+func (r *Router) Close() error {
+	return r.router.Close()
+}
@@ -0,0 +1,76 @@
+package rating
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
+	ratinghandlers "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/infrastructure/handlers"
+	ratingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/infrastructure/repositories"
+	ratingrouter "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/infrastructure/router"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-chi/chi/v5"
+	"github.com/uptrace/bun"
+)
+
+type Module struct {
+	RatingService ratingservice.Service
+	Router        *ratingrouter.Router
+	observability observability.Observability
+}
+
+type ModuleOptions struct {
+	Observability observability.Observability
+	EventBus      eventbus.EventBus
+	Router        *message.Router
+	Helpers       utils.Helpers
+	RouterCtx     context.Context
+	DB            *bun.DB
+	HTTPRouter    chi.Router
+	UserRepo      userdb.Repository
+	RoundRepo     rounddb.Repository
+}
+
+func NewModule(ctx context.Context, opts ModuleOptions) (*Module, error) {
+	logger := opts.Observability.Provider.Logger
+	tracer := opts.Observability.Registry.Tracer
+
+	repo := ratingdb.NewRepository(opts.DB)
+	service := ratingservice.NewRatingService(repo, opts.RoundRepo, logger, tracer, opts.DB)
+
+	var ratingRouter *ratingrouter.Router
+	if opts.Router != nil && opts.EventBus != nil {
+		handlers := ratinghandlers.NewRatingHandlers(service, logger)
+		ratingRouter = ratingrouter.NewRouter(logger, opts.Router, opts.EventBus, opts.EventBus, opts.Helpers, tracer)
+		if err := ratingRouter.Configure(opts.RouterCtx, handlers); err != nil {
+			return nil, fmt.Errorf("failed to configure rating router: %w", err)
+		}
+	}
+
+	if opts.HTTPRouter != nil && opts.UserRepo != nil {
+		httpHandlers := ratinghandlers.NewHTTPHandlers(service, opts.UserRepo, logger)
+		opts.HTTPRouter.Route("/api/ratings", func(r chi.Router) {
+			r.Get("/", httpHandlers.HandleListRatings)
+			r.Get("/members/{memberID}", httpHandlers.HandleGetMemberRating)
+		})
+	}
+
+	return &Module{
+		RatingService: service,
+		Router:        ratingRouter,
+		observability: opts.Observability,
+	}, nil
+}
+
+func (m *Module) Close() error {
+	if m.Router != nil {
+		return m.Router.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+// ResponseWaitlist marks a player waiting for a seat in a full round. Waitlisted
+// players are kept out of the round's participants until they are promoted.
+const ResponseWaitlist roundtypes.Response = "WAITLIST"
+
+// ParticipantChange is a round after a participant change, with the change's effect
+// on the round's capacity.
+type ParticipantChange struct {
+	Round *roundtypes.Round
+	// MaxParticipants is the round's cap; 0 means unlimited.
+	MaxParticipants int
+	// Waitlisted is set when the round was full and the player was waitlisted
+	// instead of joining.
+	Waitlisted bool
+	// Waitlist is the remaining waitlist in promotion order, with WAITLIST responses.
+	Waitlist []roundtypes.Participant
+	// Promoted lists the waitlisted players the change moved into the round.
+	Promoted []roundtypes.Participant
+}
+
+// WaitlistPosition returns the player's 1-based waitlist position, or 0 when they
+// are not waitlisted.
+func (c *ParticipantChange) WaitlistPosition(userID sharedtypes.DiscordID) int {
+	for i, p := range c.Waitlist {
+		if p.UserID == userID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// SetRoundCapacity caps how many players can accept or tentatively accept a round.
+// Raising or removing the cap promotes waitlisted players into the open seats;
+// lowering it below the current count keeps everyone already in.
+func (s *RoundService) SetRoundCapacity(ctx context.Context, req *SetRoundCapacityRequest) (ParticipantChangeResult, error) {
+	return withTelemetry(s, ctx, "SetRoundCapacity", req.RoundID, func(ctx context.Context) (ParticipantChangeResult, error) {
+		if req.MaxParticipants < 0 {
+			return results.FailureResult[*ParticipantChange, error](ErrInvalidCapacity), nil
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, tx bun.IDB) (ParticipantChangeResult, error) {
+			round, err := s.repo.GetRoundForUpdate(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				if errors.Is(err, rounddb.ErrNotFound) {
+					return results.FailureResult[*ParticipantChange, error](ErrRoundNotFound), nil
+				}
+				return ParticipantChangeResult{}, fmt.Errorf("failed to fetch round: %w", err)
+			}
+			if round.Finalized {
+				return results.FailureResult[*ParticipantChange, error](ErrRoundAlreadyFinalized), nil
+			}
+
+			if err := s.repo.SetRoundMaxParticipants(ctx, tx, req.GuildID, req.RoundID, req.MaxParticipants); err != nil {
+				return ParticipantChangeResult{}, fmt.Errorf("failed to update max participants: %w", err)
+			}
+			_, waitlist, err := s.repo.GetRoundCapacity(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				return ParticipantChangeResult{}, fmt.Errorf("failed to fetch round capacity: %w", err)
+			}
+			promoted, waitlist, err := s.fillOpenSeats(ctx, tx, req.GuildID, req.RoundID, req.MaxParticipants, waitlist, false)
+			if err != nil {
+				return ParticipantChangeResult{}, err
+			}
+
+			updated, err := s.repo.GetRound(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				return ParticipantChangeResult{}, fmt.Errorf("failed to fetch updated round: %w", err)
+			}
+
+			s.logger.InfoContext(ctx, "Round capacity updated",
+				attr.RoundID("round_id", req.RoundID),
+				attr.String("guild_id", string(req.GuildID)),
+				attr.Int("max_participants", req.MaxParticipants),
+				attr.Int("promoted", len(promoted)),
+			)
+
+			return results.SuccessResult[*ParticipantChange, error](&ParticipantChange{
+				Round:           updated,
+				MaxParticipants: req.MaxParticipants,
+				Waitlist:        waitlistParticipants(waitlist),
+				Promoted:        promoted,
+			}), nil
+		})
+	})
+}
+
+// fillOpenSeats promotes waitlisted players, oldest first, while the round has open
+// seats, and stores the waitlist when it changed. It returns the promoted players and
+// the remaining waitlist.
+func (s *RoundService) fillOpenSeats(
+	ctx context.Context,
+	tx bun.IDB,
+	guildID sharedtypes.GuildID,
+	roundID sharedtypes.RoundID,
+	maxParticipants int,
+	waitlist []rounddb.WaitlistEntry,
+	changed bool,
+) ([]roundtypes.Participant, []rounddb.WaitlistEntry, error) {
+	var promoted []roundtypes.Participant
+	if len(waitlist) > 0 {
+		participants, err := s.repo.GetParticipants(ctx, tx, guildID, roundID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch participants: %w", err)
+		}
+		seats := seatsTaken(participants, "")
+		for len(waitlist) > 0 && (maxParticipants == 0 || seats < maxParticipants) {
+			entry := waitlist[0]
+			waitlist = waitlist[1:]
+			p := roundtypes.Participant{
+				UserID:    entry.UserID,
+				Response:  entry.Response,
+				TagNumber: entry.TagNumber,
+			}
+			if _, err := s.repo.UpdateParticipant(ctx, tx, guildID, roundID, p); err != nil {
+				return nil, nil, fmt.Errorf("failed to promote waitlisted participant: %w", err)
+			}
+			promoted = append(promoted, p)
+			seats++
+		}
+	}
+
+	if changed || len(promoted) > 0 {
+		if err := s.repo.SetRoundWaitlist(ctx, tx, guildID, roundID, waitlist); err != nil {
+			return nil, nil, fmt.Errorf("failed to update waitlist: %w", err)
+		}
+	}
+	for _, p := range promoted {
+		s.logger.InfoContext(ctx, "Promoted participant from waitlist",
+			attr.RoundID("round_id", roundID),
+			attr.String("user_id", string(p.UserID)),
+			attr.String("response", string(p.Response)),
+		)
+	}
+	return promoted, waitlist, nil
+}
+
+// takesSeat reports whether a response counts against the round's cap.
+func takesSeat(response roundtypes.Response) bool {
+	return response == roundtypes.ResponseAccept || response == roundtypes.ResponseTentative
+}
+
+// seatsTaken counts the participants holding a seat, ignoring exclude.
+func seatsTaken(participants []roundtypes.Participant, exclude sharedtypes.DiscordID) int {
+	n := 0
+	for _, p := range participants {
+		if p.UserID != exclude && takesSeat(p.Response) {
+			n++
+		}
+	}
+	return n
+}
+
+// joinWaitlist adds the player to the end of the waitlist. A player already waiting
+// keeps their place and only has their requested response updated.
+func joinWaitlist(waitlist []rounddb.WaitlistEntry, entry rounddb.WaitlistEntry) []rounddb.WaitlistEntry {
+	for i := range waitlist {
+		if waitlist[i].UserID == entry.UserID {
+			waitlist[i].Response = entry.Response
+			waitlist[i].TagNumber = entry.TagNumber
+			return waitlist
+		}
+	}
+	return append(waitlist, entry)
+}
+
+// leaveWaitlist removes the player from the waitlist, reporting whether they were on it.
+func leaveWaitlist(waitlist []rounddb.WaitlistEntry, userID sharedtypes.DiscordID) ([]rounddb.WaitlistEntry, bool) {
+	out := make([]rounddb.WaitlistEntry, 0, len(waitlist))
+	for _, e := range waitlist {
+		if e.UserID != userID {
+			out = append(out, e)
+		}
+	}
+	return out, len(out) != len(waitlist)
+}
+
+func waitlistParticipants(waitlist []rounddb.WaitlistEntry) []roundtypes.Participant {
+	out := make([]roundtypes.Participant, len(waitlist))
+	for i, e := range waitlist {
+		out[i] = roundtypes.Participant{
+			UserID:    e.UserID,
+			Response:  ResponseWaitlist,
+			TagNumber: e.TagNumber,
+		}
+	}
+	return out
+}
+
+// waitlistEntryFor builds the waitlist entry for a join request.
+func waitlistEntryFor(req *roundtypes.JoinRoundRequest) rounddb.WaitlistEntry {
+	return rounddb.WaitlistEntry{
+		UserID:    req.UserID,
+		Response:  req.Response,
+		TagNumber: req.TagNumber,
+		JoinedAt:  time.Now().UTC(),
+	}
+}
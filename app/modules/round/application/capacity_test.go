@@ -0,0 +1,213 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	roundmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/round"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// capacityRound is an in-memory round for capacity tests.
+type capacityRound struct {
+	max          int
+	participants []roundtypes.Participant
+	waitlist     []rounddb.WaitlistEntry
+}
+
+func newCapacityTestService(state *capacityRound) *RoundService {
+	repo := NewFakeRepo()
+	repo.GetRoundCapacityFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (int, []rounddb.WaitlistEntry, error) {
+		return state.max, append([]rounddb.WaitlistEntry(nil), state.waitlist...), nil
+	}
+	repo.SetRoundMaxParticipantsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID, maxParticipants int) error {
+		state.max = maxParticipants
+		return nil
+	}
+	repo.SetRoundWaitlistFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID, waitlist []rounddb.WaitlistEntry) error {
+		state.waitlist = waitlist
+		return nil
+	}
+	repo.GetParticipantsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) ([]roundtypes.Participant, error) {
+		return state.participants, nil
+	}
+	repo.UpdateParticipantFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID, p roundtypes.Participant) ([]roundtypes.Participant, error) {
+		for i := range state.participants {
+			if state.participants[i].UserID == p.UserID {
+				state.participants[i] = p
+				return state.participants, nil
+			}
+		}
+		state.participants = append(state.participants, p)
+		return state.participants, nil
+	}
+	repo.RemoveParticipantFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID, u sharedtypes.DiscordID) ([]roundtypes.Participant, error) {
+		out := state.participants[:0]
+		for _, p := range state.participants {
+			if p.UserID != u {
+				out = append(out, p)
+			}
+		}
+		state.participants = out
+		return out, nil
+	}
+	repo.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+		return &roundtypes.Round{ID: r, GuildID: g, Participants: state.participants}, nil
+	}
+
+	return &RoundService{
+		repo:    repo,
+		logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+		metrics: &roundmetrics.NoOpMetrics{},
+		tracer:  noop.NewTracerProvider().Tracer("test"),
+	}
+}
+
+func acceptedParticipants(ids ...string) []roundtypes.Participant {
+	out := make([]roundtypes.Participant, len(ids))
+	for i, id := range ids {
+		out[i] = roundtypes.Participant{UserID: sharedtypes.DiscordID(id), Response: roundtypes.ResponseAccept}
+	}
+	return out
+}
+
+func waitlistOf(ids ...string) []rounddb.WaitlistEntry {
+	out := make([]rounddb.WaitlistEntry, len(ids))
+	for i, id := range ids {
+		out[i] = rounddb.WaitlistEntry{UserID: sharedtypes.DiscordID(id), Response: roundtypes.ResponseAccept}
+	}
+	return out
+}
+
+func TestRoundService_UpdateParticipantStatusWithWaitlist(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	t.Run("joins beyond the cap are waitlisted", func(t *testing.T) {
+		state := &capacityRound{max: 2, participants: acceptedParticipants("a", "b"), waitlist: waitlistOf("c")}
+		s := newCapacityTestService(state)
+
+		res, err := s.UpdateParticipantStatusWithWaitlist(ctx, &roundtypes.JoinRoundRequest{GuildID: guildID, RoundID: roundID, UserID: "d", Response: roundtypes.ResponseTentative})
+		if err != nil || res.Success == nil {
+			t.Fatalf("expected success, got %+v, %v", res, err)
+		}
+		change := *res.Success
+		if !change.Waitlisted || change.WaitlistPosition("d") != 2 {
+			t.Fatalf("expected d second on the waitlist, got %+v", change)
+		}
+		if len(state.participants) != 2 || state.waitlist[1].Response != roundtypes.ResponseTentative {
+			t.Fatalf("unexpected state %+v", state)
+		}
+		if change.Waitlist[1].Response != ResponseWaitlist {
+			t.Errorf("expected WAITLIST response, got %q", change.Waitlist[1].Response)
+		}
+	})
+
+	t.Run("waitlisted player keeps their place", func(t *testing.T) {
+		state := &capacityRound{max: 1, participants: acceptedParticipants("a"), waitlist: waitlistOf("b", "c")}
+		s := newCapacityTestService(state)
+
+		res, _ := s.UpdateParticipantStatusWithWaitlist(ctx, &roundtypes.JoinRoundRequest{GuildID: guildID, RoundID: roundID, UserID: "b", Response: roundtypes.ResponseAccept})
+		if res.Success == nil || (*res.Success).WaitlistPosition("b") != 1 || len(state.waitlist) != 2 {
+			t.Fatalf("expected b to stay first, got %+v", state.waitlist)
+		}
+	})
+
+	t.Run("a decline promotes the next waitlisted player", func(t *testing.T) {
+		state := &capacityRound{max: 2, participants: acceptedParticipants("a", "b"), waitlist: waitlistOf("c", "d")}
+		s := newCapacityTestService(state)
+
+		res, err := s.UpdateParticipantStatusWithWaitlist(ctx, &roundtypes.JoinRoundRequest{GuildID: guildID, RoundID: roundID, UserID: "a", Response: roundtypes.ResponseDecline})
+		if err != nil || res.Success == nil {
+			t.Fatalf("expected success, got %+v, %v", res, err)
+		}
+		change := *res.Success
+		if change.Waitlisted || len(change.Promoted) != 1 || change.Promoted[0].UserID != "c" {
+			t.Fatalf("expected c promoted, got %+v", change)
+		}
+		if len(state.waitlist) != 1 || state.waitlist[0].UserID != "d" || seatsTaken(state.participants, "") != 2 {
+			t.Fatalf("unexpected state %+v", state)
+		}
+	})
+
+	t.Run("a waitlisted decline leaves the waitlist", func(t *testing.T) {
+		state := &capacityRound{max: 1, participants: acceptedParticipants("a"), waitlist: waitlistOf("b", "c")}
+		s := newCapacityTestService(state)
+
+		res, _ := s.UpdateParticipantStatusWithWaitlist(ctx, &roundtypes.JoinRoundRequest{GuildID: guildID, RoundID: roundID, UserID: "b", Response: roundtypes.ResponseDecline})
+		if res.Success == nil || len((*res.Success).Promoted) != 0 || len(state.waitlist) != 1 || state.waitlist[0].UserID != "c" {
+			t.Fatalf("expected b off the waitlist without promotions, got %+v", state.waitlist)
+		}
+	})
+}
+
+func TestRoundService_ParticipantRemovalWithWaitlist(t *testing.T) {
+	state := &capacityRound{max: 1, participants: acceptedParticipants("a"), waitlist: waitlistOf("b")}
+	s := newCapacityTestService(state)
+
+	res, err := s.ParticipantRemovalWithWaitlist(context.Background(), &roundtypes.JoinRoundRequest{GuildID: "guild-1", RoundID: sharedtypes.RoundID(uuid.New()), UserID: "a"})
+	if err != nil || res.Success == nil {
+		t.Fatalf("expected success, got %+v, %v", res, err)
+	}
+	if len((*res.Success).Promoted) != 1 || len(state.waitlist) != 0 || len(state.participants) != 1 || state.participants[0].UserID != "b" {
+		t.Fatalf("expected b promoted, got %+v", state)
+	}
+}
+
+func TestRoundService_SetRoundCapacity(t *testing.T) {
+	ctx := context.Background()
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	t.Run("raising the cap fills open seats", func(t *testing.T) {
+		state := &capacityRound{max: 1, participants: acceptedParticipants("a"), waitlist: waitlistOf("b", "c", "d")}
+		s := newCapacityTestService(state)
+
+		res, err := s.SetRoundCapacity(ctx, &SetRoundCapacityRequest{GuildID: "guild-1", RoundID: roundID, MaxParticipants: 3})
+		if err != nil || res.Success == nil {
+			t.Fatalf("expected success, got %+v, %v", res, err)
+		}
+		if len((*res.Success).Promoted) != 2 || len(state.waitlist) != 1 || state.waitlist[0].UserID != "d" || state.max != 3 {
+			t.Fatalf("expected b and c promoted, got %+v", state)
+		}
+	})
+
+	t.Run("removing the cap promotes everyone", func(t *testing.T) {
+		state := &capacityRound{max: 1, participants: acceptedParticipants("a"), waitlist: waitlistOf("b", "c")}
+		s := newCapacityTestService(state)
+
+		res, _ := s.SetRoundCapacity(ctx, &SetRoundCapacityRequest{GuildID: "guild-1", RoundID: roundID})
+		if res.Success == nil || len((*res.Success).Promoted) != 2 || len(state.waitlist) != 0 {
+			t.Fatalf("expected the waitlist emptied, got %+v", state)
+		}
+	})
+
+	t.Run("rejects negative caps", func(t *testing.T) {
+		s := newCapacityTestService(&capacityRound{})
+
+		res, _ := s.SetRoundCapacity(ctx, &SetRoundCapacityRequest{GuildID: "guild-1", RoundID: roundID, MaxParticipants: -1})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrInvalidCapacity) {
+			t.Fatalf("expected ErrInvalidCapacity, got %+v", res)
+		}
+	})
+
+	t.Run("rejects finalized rounds", func(t *testing.T) {
+		s := newCapacityTestService(&capacityRound{})
+		s.repo.(*FakeRepo).GetRoundForUpdateFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+			return &roundtypes.Round{ID: r, GuildID: g, Finalized: true}, nil
+		}
+
+		res, _ := s.SetRoundCapacity(ctx, &SetRoundCapacityRequest{GuildID: "guild-1", RoundID: roundID, MaxParticipants: 4})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrRoundAlreadyFinalized) {
+			t.Fatalf("expected ErrRoundAlreadyFinalized, got %+v", res)
+		}
+	})
+}
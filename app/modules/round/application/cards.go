@@ -0,0 +1,315 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+// Card assignment strategies.
+const (
+	// CardStrategyRandom shuffles players into cards.
+	CardStrategyRandom = "random"
+	// CardStrategyByTag keeps the lowest tags together; untagged players go last.
+	CardStrategyByTag = "by_tag"
+	// CardStrategyBalanced spreads players by rating so each card has a similar mix.
+	CardStrategyBalanced = "balanced"
+)
+
+const (
+	// MaxCardSize is the largest card a round can be split into.
+	MaxCardSize = 8
+	// defaultCardHoles is used for shotgun starts when the round has no par scores.
+	defaultCardHoles = 18
+	// defaultCardRating places unrated players mid-field in balanced cards.
+	defaultCardRating = 1500.0
+)
+
+// Card is a group of players teeing off together. StartingHole is set for shotgun
+// starts.
+type Card struct {
+	Number       int                      `json:"number"`
+	StartingHole *int                     `json:"starting_hole,omitempty"`
+	Players      []roundtypes.Participant `json:"players"`
+}
+
+// CardSettings is how a round's players are split into cards when it starts.
+type CardSettings struct {
+	Size         int    `json:"size"`
+	Strategy     string `json:"strategy"`
+	ShotgunStart bool   `json:"shotgun_start"`
+}
+
+// ConfigureRoundCards sets how the round's accepted players are split into cards
+// when it starts. A size of 0 turns cards off.
+func (s *RoundService) ConfigureRoundCards(ctx context.Context, req *ConfigureRoundCardsRequest) (results.OperationResult[*CardSettings, error], error) {
+	return withTelemetry(s, ctx, "ConfigureRoundCards", req.RoundID, func(ctx context.Context) (results.OperationResult[*CardSettings, error], error) {
+		settings := &CardSettings{Size: req.Size, Strategy: req.Strategy, ShotgunStart: req.ShotgunStart}
+		if settings.Strategy == "" {
+			settings.Strategy = CardStrategyRandom
+		}
+		if settings.Size < 0 || settings.Size > MaxCardSize {
+			return results.FailureResult[*CardSettings, error](ErrInvalidCardSize), nil
+		}
+		if !validCardStrategy(settings.Strategy) {
+			return results.FailureResult[*CardSettings, error](ErrInvalidCardStrategy), nil
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, tx bun.IDB) (results.OperationResult[*CardSettings, error], error) {
+			round, err := s.repo.GetRoundForUpdate(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				if errors.Is(err, rounddb.ErrNotFound) {
+					return results.FailureResult[*CardSettings, error](ErrRoundNotFound), nil
+				}
+				return results.OperationResult[*CardSettings, error]{}, fmt.Errorf("failed to fetch round: %w", err)
+			}
+			if round.Finalized {
+				return results.FailureResult[*CardSettings, error](ErrRoundAlreadyFinalized), nil
+			}
+			if round.State == roundtypes.RoundStateInProgress {
+				return results.FailureResult[*CardSettings, error](ErrRoundAlreadyStarted), nil
+			}
+
+			if err := s.repo.SetRoundCardSettings(ctx, tx, req.GuildID, req.RoundID, rounddb.RoundCardSettings{
+				Size:         settings.Size,
+				Strategy:     settings.Strategy,
+				ShotgunStart: settings.ShotgunStart,
+			}); err != nil {
+				return results.OperationResult[*CardSettings, error]{}, fmt.Errorf("failed to update card settings: %w", err)
+			}
+
+			s.logger.InfoContext(ctx, "Round card settings updated",
+				attr.RoundID("round_id", req.RoundID),
+				attr.String("guild_id", string(req.GuildID)),
+				attr.Int("card_size", settings.Size),
+				attr.String("card_strategy", settings.Strategy),
+				attr.Bool("shotgun_start", settings.ShotgunStart),
+			)
+
+			return results.SuccessResult[*CardSettings, error](settings), nil
+		})
+	})
+}
+
+// GetRoundTeeSheet returns the cards assigned when the round started, in card
+// order. Rounds without cards return an empty sheet.
+func (s *RoundService) GetRoundTeeSheet(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[[]Card, error], error) {
+	return withTelemetry(s, ctx, "GetRoundTeeSheet", roundID, func(ctx context.Context) (results.OperationResult[[]Card, error], error) {
+		round, err := s.repo.GetRound(ctx, nil, guildID, roundID)
+		if err != nil {
+			if errors.Is(err, rounddb.ErrNotFound) {
+				return results.FailureResult[[]Card, error](ErrRoundNotFound), nil
+			}
+			return results.OperationResult[[]Card, error]{}, fmt.Errorf("failed to fetch round: %w", err)
+		}
+		cards, err := s.loadRoundCards(ctx, nil, round)
+		if err != nil {
+			return results.OperationResult[[]Card, error]{}, err
+		}
+		return results.SuccessResult[[]Card, error](cards), nil
+	})
+}
+
+// assignRoundCards splits the round's accepted players, less any no-shows, into
+// cards using the round's card settings and stores them. It returns nil when cards are off.
+func (s *RoundService) assignRoundCards(ctx context.Context, db bun.IDB, round *roundtypes.Round, noShows map[sharedtypes.DiscordID]bool) ([]Card, error) {
+	settings, err := s.repo.GetRoundCardSettings(ctx, db, round.GuildID, round.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch card settings: %w", err)
+	}
+	if settings.Size <= 0 {
+		return nil, nil
+	}
+
+	players := playingParticipants(round.Participants, noShows)
+	if len(players) == 0 {
+		return nil, nil
+	}
+
+	var cards []Card
+	switch settings.Strategy {
+	case CardStrategyByTag:
+		sortByTag(players)
+		cards = dealInOrder(players, settings.Size)
+	case CardStrategyBalanced:
+		ratings := s.cardRatings(ctx, round.GuildID, players)
+		sort.SliceStable(players, func(i, j int) bool {
+			return ratings[players[i].UserID] > ratings[players[j].UserID]
+		})
+		cards = dealSnake(players, settings.Size)
+	default:
+		rand.Shuffle(len(players), func(i, j int) { players[i], players[j] = players[j], players[i] })
+		cards = dealInOrder(players, settings.Size)
+	}
+	if settings.ShotgunStart {
+		assignStartingHoles(cards, len(round.ParScores))
+	}
+
+	stored := make([]rounddb.RoundCard, len(cards))
+	for i, card := range cards {
+		stored[i] = rounddb.RoundCard{Number: card.Number, StartingHole: card.StartingHole}
+		for _, p := range card.Players {
+			member := rounddb.RoundGroupParticipant{RawName: roundtypes.DisplayName(p.UserIDPointer(), p.RawNameString())}
+			if p.UserID != "" {
+				userID := p.UserID
+				member.UserID = &userID
+			}
+			stored[i].Members = append(stored[i].Members, member)
+		}
+	}
+	if err := s.repo.ReplaceRoundCards(ctx, db, round.ID, stored); err != nil {
+		return nil, fmt.Errorf("failed to store round cards: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "Assigned round cards",
+		attr.RoundID("round_id", round.ID),
+		attr.String("guild_id", string(round.GuildID)),
+		attr.Int("cards", len(cards)),
+		attr.Int("players", len(players)),
+		attr.String("card_strategy", settings.Strategy),
+	)
+	return cards, nil
+}
+
+// loadRoundCards reads the round's stored cards, matching members back to the
+// round's participants.
+func (s *RoundService) loadRoundCards(ctx context.Context, db bun.IDB, round *roundtypes.Round) ([]Card, error) {
+	stored, err := s.repo.GetRoundCards(ctx, db, round.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch round cards: %w", err)
+	}
+
+	byUser := make(map[sharedtypes.DiscordID]roundtypes.Participant, len(round.Participants))
+	for _, p := range round.Participants {
+		if p.UserID != "" {
+			byUser[p.UserID] = p
+		}
+	}
+
+	cards := make([]Card, len(stored))
+	for i, sc := range stored {
+		cards[i] = Card{Number: sc.Number, StartingHole: sc.StartingHole, Players: []roundtypes.Participant{}}
+		for _, m := range sc.Members {
+			if m.UserID != nil {
+				if p, ok := byUser[*m.UserID]; ok {
+					cards[i].Players = append(cards[i].Players, p)
+					continue
+				}
+				cards[i].Players = append(cards[i].Players, roundtypes.Participant{UserID: *m.UserID})
+				continue
+			}
+			cards[i].Players = append(cards[i].Players, roundtypes.Participant{RawName: m.RawName})
+		}
+	}
+	return cards, nil
+}
+
+// cardRatings returns each player's rating for balanced cards. Players without a
+// rating, or every player when ratings are unavailable, get the default rating.
+func (s *RoundService) cardRatings(ctx context.Context, guildID sharedtypes.GuildID, players []roundtypes.Participant) map[sharedtypes.DiscordID]float64 {
+	ratings := make(map[sharedtypes.DiscordID]float64, len(players))
+	for _, p := range players {
+		ratings[p.UserID] = defaultCardRating
+	}
+	if s.ratings == nil {
+		return ratings
+	}
+
+	memberIDs := make([]sharedtypes.DiscordID, 0, len(players))
+	for _, p := range players {
+		if p.UserID != "" {
+			memberIDs = append(memberIDs, p.UserID)
+		}
+	}
+	found, err := s.ratings.GetRatings(ctx, guildID, memberIDs)
+	if err != nil {
+		s.logger.WarnContext(ctx, "Failed to fetch ratings for card assignment; using default ratings",
+			attr.String("guild_id", string(guildID)),
+			attr.Error(err),
+		)
+		return ratings
+	}
+	for id, rating := range found {
+		ratings[id] = rating
+	}
+	return ratings
+}
+
+func validCardStrategy(strategy string) bool {
+	switch strategy {
+	case CardStrategyRandom, CardStrategyByTag, CardStrategyBalanced:
+		return true
+	}
+	return false
+}
+
+// cardCount is the fewest cards of at most size players that fit everyone.
+func cardCount(players, size int) int {
+	return (players + size - 1) / size
+}
+
+// sortByTag orders players by tag number, lowest first, with untagged players last.
+func sortByTag(players []roundtypes.Participant) {
+	sort.SliceStable(players, func(i, j int) bool {
+		a, b := players[i].TagNumber, players[j].TagNumber
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return *a < *b
+	})
+}
+
+// dealInOrder fills cards front to back, keeping card sizes within one of each other.
+func dealInOrder(players []roundtypes.Participant, size int) []Card {
+	n := cardCount(len(players), size)
+	cards := make([]Card, n)
+	next := 0
+	for i := range cards {
+		count := len(players) / n
+		if i < len(players)%n {
+			count++
+		}
+		cards[i] = Card{Number: i + 1, Players: append([]roundtypes.Participant(nil), players[next:next+count]...)}
+		next += count
+	}
+	return cards
+}
+
+// dealSnake deals players across the cards in a snake draft (1..n, n..1, ...), so a
+// ranked field ends up with each card holding a similar spread.
+func dealSnake(players []roundtypes.Participant, size int) []Card {
+	n := cardCount(len(players), size)
+	cards := make([]Card, n)
+	for i := range cards {
+		cards[i].Number = i + 1
+	}
+	for i, p := range players {
+		idx := i % n
+		if (i/n)%2 == 1 {
+			idx = n - 1 - idx
+		}
+		cards[idx].Players = append(cards[idx].Players, p)
+	}
+	return cards
+}
+
+// assignStartingHoles spreads cards evenly around the course for a shotgun start.
+// With more cards than holes, some holes start two cards.
+func assignStartingHoles(cards []Card, holes int) {
+	if holes <= 0 {
+		holes = defaultCardHoles
+	}
+	for i := range cards {
+		hole := i*holes/len(cards) + 1
+		cards[i].StartingHole = &hole
+	}
+}
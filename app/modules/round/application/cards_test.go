@@ -0,0 +1,238 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	roundmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/round"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type fakePlayerRatings struct {
+	ratings map[sharedtypes.DiscordID]float64
+	err     error
+}
+
+func (f fakePlayerRatings) GetRatings(ctx context.Context, guildID sharedtypes.GuildID, memberIDs []sharedtypes.DiscordID) (map[sharedtypes.DiscordID]float64, error) {
+	return f.ratings, f.err
+}
+
+func newCardsTestService(repo *FakeRepo) *RoundService {
+	return &RoundService{
+		repo:    repo,
+		logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+		metrics: &roundmetrics.NoOpMetrics{},
+		tracer:  noop.NewTracerProvider().Tracer("test"),
+	}
+}
+
+func tagged(id string, tag int) roundtypes.Participant {
+	t := sharedtypes.TagNumber(tag)
+	return roundtypes.Participant{UserID: sharedtypes.DiscordID(id), Response: roundtypes.ResponseAccept, TagNumber: &t}
+}
+
+func cardUserIDs(card Card) []sharedtypes.DiscordID {
+	ids := make([]sharedtypes.DiscordID, len(card.Players))
+	for i, p := range card.Players {
+		ids[i] = p.UserID
+	}
+	return ids
+}
+
+func TestRoundService_StartRound_AssignsCards(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	startWith := func(settings rounddb.RoundCardSettings, participants []roundtypes.Participant, ratings PlayerRatings) (StartRoundResult, []rounddb.RoundCard) {
+		repo := NewFakeRepo()
+		repo.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+			return &roundtypes.Round{ID: r, GuildID: g, State: roundtypes.RoundStateUpcoming, Participants: participants}, nil
+		}
+		repo.GetRoundCardSettingsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (rounddb.RoundCardSettings, error) {
+			return settings, nil
+		}
+		var stored []rounddb.RoundCard
+		repo.ReplaceRoundCardsFunc = func(ctx context.Context, db bun.IDB, r sharedtypes.RoundID, cards []rounddb.RoundCard) error {
+			stored = cards
+			return nil
+		}
+		s := newCardsTestService(repo)
+		if ratings != nil {
+			s.WithPlayerRatings(ratings)
+		}
+
+		res, err := s.StartRound(context.Background(), &roundtypes.StartRoundRequest{GuildID: guildID, RoundID: roundID})
+		if err != nil || res.Success == nil {
+			t.Fatalf("expected success, got %+v, %v", res, err)
+		}
+		return res, stored
+	}
+
+	t.Run("no card size leaves cards off", func(t *testing.T) {
+		res, stored := startWith(rounddb.RoundCardSettings{}, acceptedParticipants("a", "b"), nil)
+		if len(res.Cards) != 0 || stored != nil {
+			t.Fatalf("expected no cards, got %+v", res.Cards)
+		}
+	})
+
+	t.Run("by tag keeps top tags together and skips non-accepted", func(t *testing.T) {
+		participants := []roundtypes.Participant{
+			tagged("d", 4), tagged("a", 1), {UserID: "x", Response: roundtypes.ResponseDecline},
+			{UserID: "u", Response: roundtypes.ResponseAccept}, tagged("c", 3), tagged("b", 2),
+		}
+		res, stored := startWith(rounddb.RoundCardSettings{Size: 3, Strategy: CardStrategyByTag}, participants, nil)
+		if len(res.Cards) != 2 || len(stored) != 2 {
+			t.Fatalf("expected two cards, got %+v", res.Cards)
+		}
+		want := [][]sharedtypes.DiscordID{{"a", "b", "c"}, {"d", "u"}}
+		for i, card := range res.Cards {
+			got := cardUserIDs(card)
+			if card.Number != i+1 || len(got) != len(want[i]) {
+				t.Fatalf("card %d: expected %v, got %v", i+1, want[i], got)
+			}
+			for j := range got {
+				if got[j] != want[i][j] {
+					t.Fatalf("card %d: expected %v, got %v", i+1, want[i], got)
+				}
+			}
+			if card.StartingHole != nil {
+				t.Errorf("expected no starting hole without a shotgun start")
+			}
+		}
+		if len(stored[1].Members) != 2 || stored[1].Members[0].UserID == nil || *stored[1].Members[0].UserID != "d" {
+			t.Errorf("unexpected stored members %+v", stored[1].Members)
+		}
+	})
+
+	t.Run("balanced snake drafts by rating", func(t *testing.T) {
+		ratings := fakePlayerRatings{ratings: map[sharedtypes.DiscordID]float64{"a": 1000, "b": 990, "c": 980, "d": 970}}
+		res, _ := startWith(rounddb.RoundCardSettings{Size: 2, Strategy: CardStrategyBalanced}, acceptedParticipants("d", "c", "b", "a"), ratings)
+		if len(res.Cards) != 2 {
+			t.Fatalf("expected two cards, got %+v", res.Cards)
+		}
+		first, second := cardUserIDs(res.Cards[0]), cardUserIDs(res.Cards[1])
+		if first[0] != "a" || first[1] != "d" || second[0] != "b" || second[1] != "c" {
+			t.Fatalf("expected [a d] [b c], got %v %v", first, second)
+		}
+	})
+
+	t.Run("balanced falls back when ratings fail", func(t *testing.T) {
+		res, _ := startWith(rounddb.RoundCardSettings{Size: 4, Strategy: CardStrategyBalanced}, acceptedParticipants("a", "b", "c", "d", "e"), fakePlayerRatings{err: errors.New("down")})
+		if len(res.Cards) != 2 || len(res.Cards[0].Players)+len(res.Cards[1].Players) != 5 {
+			t.Fatalf("expected five players over two cards, got %+v", res.Cards)
+		}
+	})
+
+	t.Run("shotgun start spreads cards around the course", func(t *testing.T) {
+		res, stored := startWith(rounddb.RoundCardSettings{Size: 2, Strategy: CardStrategyRandom, ShotgunStart: true}, acceptedParticipants("a", "b", "c", "d", "e", "f"), nil)
+		want := []int{1, 7, 13}
+		if len(res.Cards) != 3 {
+			t.Fatalf("expected three cards, got %+v", res.Cards)
+		}
+		for i, card := range res.Cards {
+			if card.StartingHole == nil || *card.StartingHole != want[i] || len(card.Players) != 2 {
+				t.Fatalf("card %d: unexpected %+v", i+1, card)
+			}
+			if stored[i].StartingHole == nil || *stored[i].StartingHole != want[i] {
+				t.Fatalf("card %d: starting hole not stored", i+1)
+			}
+		}
+	})
+}
+
+func TestDealInOrder_EvensCardSizes(t *testing.T) {
+	cards := dealInOrder(acceptedParticipants("a", "b", "c", "d", "e", "f", "g"), 4)
+	if len(cards) != 2 || len(cards[0].Players) != 4 || len(cards[1].Players) != 3 {
+		t.Fatalf("expected cards of 4 and 3, got %+v", cards)
+	}
+	cards = dealInOrder(acceptedParticipants("a", "b", "c", "d", "e", "f", "g", "h", "i"), 4)
+	if len(cards) != 3 || len(cards[0].Players) != 3 || len(cards[2].Players) != 3 {
+		t.Fatalf("expected three cards of 3, got %+v", cards)
+	}
+}
+
+func TestRoundService_ConfigureRoundCards(t *testing.T) {
+	ctx := context.Background()
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	t.Run("stores settings with a default strategy", func(t *testing.T) {
+		repo := NewFakeRepo()
+		var saved rounddb.RoundCardSettings
+		repo.SetRoundCardSettingsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID, settings rounddb.RoundCardSettings) error {
+			saved = settings
+			return nil
+		}
+		s := newCardsTestService(repo)
+
+		res, err := s.ConfigureRoundCards(ctx, &ConfigureRoundCardsRequest{GuildID: "guild-1", RoundID: roundID, Size: 4, ShotgunStart: true})
+		if err != nil || res.Success == nil {
+			t.Fatalf("expected success, got %+v, %v", res, err)
+		}
+		if saved.Size != 4 || saved.Strategy != CardStrategyRandom || !saved.ShotgunStart {
+			t.Fatalf("unexpected saved settings %+v", saved)
+		}
+	})
+
+	t.Run("rejects invalid settings", func(t *testing.T) {
+		s := newCardsTestService(NewFakeRepo())
+
+		res, _ := s.ConfigureRoundCards(ctx, &ConfigureRoundCardsRequest{GuildID: "guild-1", RoundID: roundID, Size: MaxCardSize + 1})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrInvalidCardSize) {
+			t.Fatalf("expected ErrInvalidCardSize, got %+v", res)
+		}
+		res, _ = s.ConfigureRoundCards(ctx, &ConfigureRoundCardsRequest{GuildID: "guild-1", RoundID: roundID, Size: 4, Strategy: "alphabetical"})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrInvalidCardStrategy) {
+			t.Fatalf("expected ErrInvalidCardStrategy, got %+v", res)
+		}
+	})
+
+	t.Run("rejects started rounds", func(t *testing.T) {
+		repo := NewFakeRepo()
+		repo.GetRoundForUpdateFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+			return &roundtypes.Round{ID: r, GuildID: g, State: roundtypes.RoundStateInProgress}, nil
+		}
+		s := newCardsTestService(repo)
+
+		res, _ := s.ConfigureRoundCards(ctx, &ConfigureRoundCardsRequest{GuildID: "guild-1", RoundID: roundID, Size: 4})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrRoundAlreadyStarted) {
+			t.Fatalf("expected ErrRoundAlreadyStarted, got %+v", res)
+		}
+	})
+}
+
+func TestRoundService_GetRoundTeeSheet(t *testing.T) {
+	repo := NewFakeRepo()
+	hole := 5
+	userID := sharedtypes.DiscordID("a")
+	repo.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+		return &roundtypes.Round{ID: r, GuildID: g, Participants: []roundtypes.Participant{tagged("a", 1)}}, nil
+	}
+	repo.GetRoundCardsFunc = func(ctx context.Context, db bun.IDB, r sharedtypes.RoundID) ([]rounddb.RoundCard, error) {
+		return []rounddb.RoundCard{{
+			Number:       1,
+			StartingHole: &hole,
+			Members:      []rounddb.RoundGroupParticipant{{UserID: &userID, RawName: "a"}, {RawName: "Guest"}},
+		}}, nil
+	}
+	s := newCardsTestService(repo)
+
+	res, err := s.GetRoundTeeSheet(context.Background(), "guild-1", sharedtypes.RoundID(uuid.New()))
+	if err != nil || res.Success == nil {
+		t.Fatalf("expected success, got %+v, %v", res, err)
+	}
+	cards := *res.Success
+	if len(cards) != 1 || *cards[0].StartingHole != 5 || len(cards[0].Players) != 2 {
+		t.Fatalf("unexpected tee sheet %+v", cards)
+	}
+	if cards[0].Players[0].TagNumber == nil || cards[0].Players[1].RawName != "Guest" {
+		t.Errorf("expected players matched to participants, got %+v", cards[0].Players)
+	}
+}
@@ -0,0 +1,366 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+// MaxCheckInMinutes is the longest check-in window a round can have.
+const MaxCheckInMinutes = 240
+
+// RoundCheckInStatus is a round's check-in window and who has checked in so far.
+type RoundCheckInStatus struct {
+	GuildID        sharedtypes.GuildID     `json:"guild_id"`
+	RoundID        sharedtypes.RoundID     `json:"round_id"`
+	EventMessageID string                  `json:"event_message_id,omitempty"`
+	WindowMinutes  int                     `json:"window_minutes"`
+	OpensAt        time.Time               `json:"opens_at"`
+	StartTime      time.Time               `json:"start_time"`
+	CheckedIn      []sharedtypes.DiscordID `json:"checked_in"`
+	Pending        []sharedtypes.DiscordID `json:"pending"`
+}
+
+// MemberReliability is a member's check-in record across the guild's rounds. Rate
+// is the share of tracked rounds the member turned up for; 1 with no history.
+type MemberReliability struct {
+	GuildID   sharedtypes.GuildID   `json:"guild_id"`
+	UserID    sharedtypes.DiscordID `json:"user_id"`
+	CheckedIn int                   `json:"checked_in"`
+	NoShows   int                   `json:"no_shows"`
+	Rate      float64               `json:"rate"`
+}
+
+// ConfigureRoundCheckIn sets how many minutes before the start time players can
+// check in. Zero turns check-in off. The window opening is scheduled on the queue
+// so it can be announced.
+func (s *RoundService) ConfigureRoundCheckIn(ctx context.Context, req *ConfigureRoundCheckInRequest) (RoundCheckInResult, error) {
+	return withTelemetry(s, ctx, "ConfigureRoundCheckIn", req.RoundID, func(ctx context.Context) (RoundCheckInResult, error) {
+		if req.WindowMinutes < 0 || req.WindowMinutes > MaxCheckInMinutes {
+			return results.FailureResult[*RoundCheckInStatus, error](ErrInvalidCheckInWindow), nil
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, tx bun.IDB) (RoundCheckInResult, error) {
+			round, err := s.repo.GetRoundForUpdate(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				if errors.Is(err, rounddb.ErrNotFound) {
+					return results.FailureResult[*RoundCheckInStatus, error](ErrRoundNotFound), nil
+				}
+				return RoundCheckInResult{}, fmt.Errorf("failed to fetch round: %w", err)
+			}
+			if round.Finalized {
+				return results.FailureResult[*RoundCheckInStatus, error](ErrRoundAlreadyFinalized), nil
+			}
+			if round.State == roundtypes.RoundStateInProgress {
+				return results.FailureResult[*RoundCheckInStatus, error](ErrRoundAlreadyStarted), nil
+			}
+
+			if err := s.repo.SetRoundCheckInMinutes(ctx, tx, req.GuildID, req.RoundID, req.WindowMinutes); err != nil {
+				return RoundCheckInResult{}, fmt.Errorf("failed to update check-in window: %w", err)
+			}
+
+			if s.queueService != nil {
+				if err := s.queueService.CancelRoundCheckInJobs(ctx, req.RoundID); err != nil {
+					return RoundCheckInResult{}, fmt.Errorf("failed to cancel check-in jobs: %w", err)
+				}
+				if round.StartTime != nil {
+					if err := s.scheduleCheckInOpen(ctx, tx, req.GuildID, req.RoundID, time.Time(*round.StartTime)); err != nil {
+						return RoundCheckInResult{}, err
+					}
+				}
+			}
+
+			checkIns, err := s.repo.GetRoundCheckIns(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				return RoundCheckInResult{}, err
+			}
+
+			s.logger.InfoContext(ctx, "Round check-in window updated",
+				attr.RoundID("round_id", req.RoundID),
+				attr.String("guild_id", string(req.GuildID)),
+				attr.Int("window_minutes", req.WindowMinutes),
+			)
+
+			return results.SuccessResult[*RoundCheckInStatus, error](checkInStatus(round, req.WindowMinutes, checkIns)), nil
+		})
+	})
+}
+
+// OpenRoundCheckIn returns the check-in status of a round whose window has just
+// opened, for announcement. It fails when the round has started or check-in was
+// turned off after the window was scheduled.
+func (s *RoundService) OpenRoundCheckIn(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (RoundCheckInResult, error) {
+	return withTelemetry(s, ctx, "OpenRoundCheckIn", roundID, func(ctx context.Context) (RoundCheckInResult, error) {
+		round, err := s.repo.GetRound(ctx, s.db, guildID, roundID)
+		if err != nil {
+			if errors.Is(err, rounddb.ErrNotFound) {
+				return results.FailureResult[*RoundCheckInStatus, error](ErrRoundNotFound), nil
+			}
+			return RoundCheckInResult{}, fmt.Errorf("failed to fetch round: %w", err)
+		}
+		if round.Finalized || round.State != roundtypes.RoundStateUpcoming {
+			return results.FailureResult[*RoundCheckInStatus, error](ErrRoundAlreadyStarted), nil
+		}
+
+		minutes, err := s.repo.GetRoundCheckInMinutes(ctx, s.db, guildID, roundID)
+		if err != nil {
+			return RoundCheckInResult{}, err
+		}
+		if minutes == 0 {
+			return results.FailureResult[*RoundCheckInStatus, error](ErrCheckInNotEnabled), nil
+		}
+
+		checkIns, err := s.repo.GetRoundCheckIns(ctx, s.db, guildID, roundID)
+		if err != nil {
+			return RoundCheckInResult{}, err
+		}
+
+		return results.SuccessResult[*RoundCheckInStatus, error](checkInStatus(round, minutes, checkIns)), nil
+	})
+}
+
+// CheckInParticipant confirms a player's attendance while the round's check-in
+// window is open. Tentative players who check in are accepted.
+func (s *RoundService) CheckInParticipant(ctx context.Context, req *CheckInRequest) (RoundCheckInResult, error) {
+	return withTelemetry(s, ctx, "CheckInParticipant", req.RoundID, func(ctx context.Context) (RoundCheckInResult, error) {
+		return runInTx(s, ctx, func(ctx context.Context, tx bun.IDB) (RoundCheckInResult, error) {
+			round, err := s.repo.GetRoundForUpdate(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				if errors.Is(err, rounddb.ErrNotFound) {
+					return results.FailureResult[*RoundCheckInStatus, error](ErrRoundNotFound), nil
+				}
+				return RoundCheckInResult{}, fmt.Errorf("failed to fetch round: %w", err)
+			}
+			if round.Finalized {
+				return results.FailureResult[*RoundCheckInStatus, error](ErrRoundAlreadyFinalized), nil
+			}
+			if round.State == roundtypes.RoundStateInProgress {
+				return results.FailureResult[*RoundCheckInStatus, error](ErrRoundAlreadyStarted), nil
+			}
+
+			minutes, err := s.repo.GetRoundCheckInMinutes(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				return RoundCheckInResult{}, err
+			}
+			if minutes == 0 || round.StartTime == nil {
+				return results.FailureResult[*RoundCheckInStatus, error](ErrCheckInNotEnabled), nil
+			}
+			opensAt := time.Time(*round.StartTime).Add(-time.Duration(minutes) * time.Minute)
+			if time.Now().UTC().Before(opensAt) {
+				return results.FailureResult[*RoundCheckInStatus, error](ErrCheckInNotOpen), nil
+			}
+
+			participant, ok := findParticipant(round.Participants, req.UserID)
+			if !ok || (participant.Response != roundtypes.ResponseAccept && participant.Response != roundtypes.ResponseTentative) {
+				return results.FailureResult[*RoundCheckInStatus, error](ErrParticipantNotFound), nil
+			}
+
+			if participant.Response == roundtypes.ResponseTentative {
+				participants := make([]roundtypes.Participant, len(round.Participants))
+				for i, p := range round.Participants {
+					if p.UserID == req.UserID {
+						p.Response = roundtypes.ResponseAccept
+					}
+					participants[i] = p
+				}
+				if err := s.repo.UpdateRoundsAndParticipants(ctx, tx, req.GuildID, []roundtypes.RoundUpdate{{
+					RoundID:      req.RoundID,
+					Participants: participants,
+				}}); err != nil {
+					return RoundCheckInResult{}, fmt.Errorf("failed to accept checked-in participant: %w", err)
+				}
+				round.Participants = participants
+			}
+
+			if err := s.repo.UpsertCheckIns(ctx, tx, []*rounddb.RoundCheckIn{{
+				RoundID: req.RoundID,
+				GuildID: req.GuildID,
+				UserID:  req.UserID,
+				Status:  rounddb.CheckInStatusCheckedIn,
+			}}); err != nil {
+				return RoundCheckInResult{}, err
+			}
+
+			checkIns, err := s.repo.GetRoundCheckIns(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				return RoundCheckInResult{}, err
+			}
+
+			s.logger.InfoContext(ctx, "Participant checked in",
+				attr.RoundID("round_id", req.RoundID),
+				attr.String("guild_id", string(req.GuildID)),
+				attr.String("user_id", string(req.UserID)),
+			)
+
+			return results.SuccessResult[*RoundCheckInStatus, error](checkInStatus(round, minutes, checkIns)), nil
+		})
+	})
+}
+
+// GetMemberReliability returns how often a member has turned up for rounds with
+// check-in enabled that started. It backs the reliability in the leaderboard's
+// member stats profile.
+func (s *RoundService) GetMemberReliability(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[*MemberReliability, error], error) {
+	attendance, err := s.repo.GetMemberAttendance(ctx, s.db, guildID, userID)
+	if err != nil {
+		return results.OperationResult[*MemberReliability, error]{}, err
+	}
+
+	reliability := &MemberReliability{
+		GuildID:   guildID,
+		UserID:    userID,
+		CheckedIn: attendance.CheckedIn,
+		NoShows:   attendance.NoShows,
+		Rate:      1,
+	}
+	if tracked := attendance.CheckedIn + attendance.NoShows; tracked > 0 {
+		reliability.Rate = float64(attendance.CheckedIn) / float64(tracked)
+	}
+	return results.SuccessResult[*MemberReliability, error](reliability), nil
+}
+
+// markNoShows records accepted players who did not check in as no-shows when a
+// round with check-in enabled starts. Their response is left alone; the check-in
+// records are what keep them out of the draw, the cards and the scores.
+func (s *RoundService) markNoShows(ctx context.Context, db bun.IDB, round *roundtypes.Round) ([]roundtypes.Participant, error) {
+	minutes, err := s.repo.GetRoundCheckInMinutes(ctx, db, round.GuildID, round.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch check-in window: %w", err)
+	}
+	if minutes == 0 {
+		return nil, nil
+	}
+
+	checkIns, err := s.repo.GetRoundCheckIns(ctx, db, round.GuildID, round.ID)
+	if err != nil {
+		return nil, err
+	}
+	checkedIn := make(map[sharedtypes.DiscordID]bool, len(checkIns))
+	for _, c := range checkIns {
+		if c.Status == rounddb.CheckInStatusCheckedIn {
+			checkedIn[c.UserID] = true
+		}
+	}
+
+	var noShows []roundtypes.Participant
+	var records []*rounddb.RoundCheckIn
+	for _, p := range round.Participants {
+		if p.Response == roundtypes.ResponseAccept && p.UserID != "" && !checkedIn[p.UserID] {
+			noShows = append(noShows, p)
+			records = append(records, &rounddb.RoundCheckIn{
+				RoundID: round.ID,
+				GuildID: round.GuildID,
+				UserID:  p.UserID,
+				Status:  rounddb.CheckInStatusNoShow,
+			})
+		}
+	}
+	if len(noShows) == 0 {
+		return nil, nil
+	}
+
+	if err := s.repo.UpsertCheckIns(ctx, db, records); err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Marked no-shows at round start",
+		attr.RoundID("round_id", round.ID),
+		attr.String("guild_id", string(round.GuildID)),
+		attr.Int("no_shows", len(noShows)),
+	)
+	return noShows, nil
+}
+
+// roundNoShows returns the players recorded as no-shows when the round started.
+func (s *RoundService) roundNoShows(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (map[sharedtypes.DiscordID]bool, error) {
+	checkIns, err := s.repo.GetRoundCheckIns(ctx, db, guildID, roundID)
+	if err != nil {
+		return nil, err
+	}
+	noShows := make(map[sharedtypes.DiscordID]bool)
+	for _, c := range checkIns {
+		if c.Status == rounddb.CheckInStatusNoShow {
+			noShows[c.UserID] = true
+		}
+	}
+	return noShows, nil
+}
+
+// playingParticipants returns the accepted players who are not no-shows.
+func playingParticipants(participants []roundtypes.Participant, noShows map[sharedtypes.DiscordID]bool) []roundtypes.Participant {
+	var players []roundtypes.Participant
+	for _, p := range participants {
+		if p.Response == roundtypes.ResponseAccept && !noShows[p.UserID] {
+			players = append(players, p)
+		}
+	}
+	return players
+}
+
+// scheduleCheckInOpen queues the opening of the round's check-in window. Nothing
+// is queued when check-in is off or the window has already opened.
+func (s *RoundService) scheduleCheckInOpen(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, startTime time.Time) error {
+	minutes, err := s.repo.GetRoundCheckInMinutes(ctx, db, guildID, roundID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch check-in window: %w", err)
+	}
+	if minutes == 0 {
+		return nil
+	}
+
+	opensAt := startTime.UTC().Add(-time.Duration(minutes) * time.Minute)
+	if !opensAt.After(time.Now().UTC()) {
+		return nil
+	}
+	if err := s.queueService.ScheduleRoundCheckInOpen(ctx, guildID, roundID, opensAt); err != nil {
+		return fmt.Errorf("failed to schedule check-in window: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "Scheduled round check-in window",
+		attr.RoundID("round_id", roundID),
+		attr.String("guild_id", string(guildID)),
+		attr.Time("opens_at", opensAt),
+	)
+	return nil
+}
+
+func checkInStatus(round *roundtypes.Round, minutes int, checkIns []*rounddb.RoundCheckIn) *RoundCheckInStatus {
+	status := &RoundCheckInStatus{
+		GuildID:        round.GuildID,
+		RoundID:        round.ID,
+		EventMessageID: round.EventMessageID,
+		WindowMinutes:  minutes,
+		CheckedIn:      []sharedtypes.DiscordID{},
+		Pending:        []sharedtypes.DiscordID{},
+	}
+	if round.StartTime != nil {
+		status.StartTime = time.Time(*round.StartTime).UTC()
+		status.OpensAt = status.StartTime.Add(-time.Duration(minutes) * time.Minute)
+	}
+
+	checkedIn := make(map[sharedtypes.DiscordID]bool, len(checkIns))
+	for _, c := range checkIns {
+		if c.Status == rounddb.CheckInStatusCheckedIn {
+			checkedIn[c.UserID] = true
+		}
+	}
+	for _, p := range round.Participants {
+		if p.UserID == "" || p.Response != roundtypes.ResponseAccept {
+			continue
+		}
+		if checkedIn[p.UserID] {
+			status.CheckedIn = append(status.CheckedIn, p.UserID)
+		} else {
+			status.Pending = append(status.Pending, p.UserID)
+		}
+	}
+	return status
+}
@@ -0,0 +1,281 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+func checkInRound(guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, startIn time.Duration, participants []roundtypes.Participant) *roundtypes.Round {
+	start := sharedtypes.StartTime(time.Now().UTC().Add(startIn))
+	return &roundtypes.Round{ID: roundID, GuildID: guildID, State: roundtypes.RoundStateUpcoming, StartTime: &start, Participants: participants}
+}
+
+func TestRoundService_ConfigureRoundCheckIn(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	setup := func(round *roundtypes.Round) (*RoundService, *FakeRepo, *FakeQueueService) {
+		repo := NewFakeRepo()
+		repo.GetRoundForUpdateFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+			return round, nil
+		}
+		queue := NewFakeQueueService()
+		s := newCardsTestService(repo)
+		s.queueService = queue
+		return s, repo, queue
+	}
+
+	t.Run("stores the window and schedules the opening", func(t *testing.T) {
+		s, repo, queue := setup(checkInRound(guildID, roundID, 2*time.Hour, acceptedParticipants("a", "b")))
+		var stored int
+		repo.SetRoundCheckInMinutesFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID, minutes int) error {
+			stored = minutes
+			return nil
+		}
+		repo.GetRoundCheckInMinutesFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (int, error) {
+			return stored, nil
+		}
+		var opensAt time.Time
+		queue.ScheduleRoundCheckInOpenFunc = func(ctx context.Context, g sharedtypes.GuildID, r sharedtypes.RoundID, openTime time.Time) error {
+			opensAt = openTime
+			return nil
+		}
+
+		res, err := s.ConfigureRoundCheckIn(ctx, &ConfigureRoundCheckInRequest{GuildID: guildID, RoundID: roundID, WindowMinutes: 30})
+		if err != nil || res.Success == nil {
+			t.Fatalf("expected success, got %+v, %v", res, err)
+		}
+		if stored != 30 {
+			t.Errorf("expected a 30 minute window to be stored, got %d", stored)
+		}
+		status := *res.Success
+		if !opensAt.Equal(status.OpensAt) || status.StartTime.Sub(status.OpensAt) != 30*time.Minute {
+			t.Errorf("expected the opening at %v to be scheduled, got %v", status.OpensAt, opensAt)
+		}
+		if len(status.Pending) != 2 {
+			t.Errorf("expected both players pending, got %+v", status)
+		}
+		trace := queue.Trace()
+		if len(trace) != 2 || trace[0] != "CancelRoundCheckInJobs" {
+			t.Errorf("expected existing check-in jobs to be cancelled first, got %v", trace)
+		}
+	})
+
+	t.Run("rejects windows out of range", func(t *testing.T) {
+		s, _, _ := setup(checkInRound(guildID, roundID, 2*time.Hour, nil))
+		for _, minutes := range []int{-1, MaxCheckInMinutes + 1} {
+			res, _ := s.ConfigureRoundCheckIn(ctx, &ConfigureRoundCheckInRequest{GuildID: guildID, RoundID: roundID, WindowMinutes: minutes})
+			if res.Failure == nil || !errors.Is(*res.Failure, ErrInvalidCheckInWindow) {
+				t.Fatalf("expected ErrInvalidCheckInWindow for %d, got %+v", minutes, res)
+			}
+		}
+	})
+
+	t.Run("rejects started rounds", func(t *testing.T) {
+		round := checkInRound(guildID, roundID, -time.Minute, nil)
+		round.State = roundtypes.RoundStateInProgress
+		s, _, _ := setup(round)
+
+		res, _ := s.ConfigureRoundCheckIn(ctx, &ConfigureRoundCheckInRequest{GuildID: guildID, RoundID: roundID, WindowMinutes: 30})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrRoundAlreadyStarted) {
+			t.Fatalf("expected ErrRoundAlreadyStarted, got %+v", res)
+		}
+	})
+}
+
+func TestRoundService_CheckInParticipant(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	setup := func(startIn time.Duration, minutes int, participants []roundtypes.Participant) (*RoundService, *[]*rounddb.RoundCheckIn, *[]roundtypes.Participant) {
+		var recorded []*rounddb.RoundCheckIn
+		var stored []roundtypes.Participant
+		repo := NewFakeRepo()
+		repo.GetRoundForUpdateFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+			return checkInRound(g, r, startIn, participants), nil
+		}
+		repo.GetRoundCheckInMinutesFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (int, error) {
+			return minutes, nil
+		}
+		repo.UpsertCheckInsFunc = func(ctx context.Context, db bun.IDB, checkIns []*rounddb.RoundCheckIn) error {
+			recorded = append(recorded, checkIns...)
+			return nil
+		}
+		repo.GetRoundCheckInsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) ([]*rounddb.RoundCheckIn, error) {
+			return recorded, nil
+		}
+		repo.UpdateRoundsAndParticipantsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, updates []roundtypes.RoundUpdate) error {
+			stored = updates[0].Participants
+			return nil
+		}
+		return newCardsTestService(repo), &recorded, &stored
+	}
+
+	t.Run("records the check-in while the window is open", func(t *testing.T) {
+		s, recorded, _ := setup(10*time.Minute, 30, acceptedParticipants("a", "b"))
+
+		res, err := s.CheckInParticipant(ctx, &CheckInRequest{GuildID: guildID, RoundID: roundID, UserID: "a"})
+		if err != nil || res.Success == nil {
+			t.Fatalf("expected success, got %+v, %v", res, err)
+		}
+		if len(*recorded) != 1 || (*recorded)[0].Status != rounddb.CheckInStatusCheckedIn {
+			t.Fatalf("expected a checked-in record, got %+v", *recorded)
+		}
+		status := *res.Success
+		if len(status.CheckedIn) != 1 || status.CheckedIn[0] != "a" || len(status.Pending) != 1 || status.Pending[0] != "b" {
+			t.Errorf("unexpected status %+v", status)
+		}
+	})
+
+	t.Run("accepts tentative players who check in", func(t *testing.T) {
+		participants := []roundtypes.Participant{{UserID: "a", Response: roundtypes.ResponseTentative}}
+		s, _, stored := setup(10*time.Minute, 30, participants)
+
+		res, _ := s.CheckInParticipant(ctx, &CheckInRequest{GuildID: guildID, RoundID: roundID, UserID: "a"})
+		if res.Success == nil {
+			t.Fatalf("expected success, got %+v", res)
+		}
+		if len(*stored) != 1 || (*stored)[0].Response != roundtypes.ResponseAccept {
+			t.Errorf("expected the player to be accepted, got %+v", *stored)
+		}
+	})
+
+	t.Run("rejects check-ins before the window opens", func(t *testing.T) {
+		s, recorded, _ := setup(2*time.Hour, 30, acceptedParticipants("a"))
+
+		res, _ := s.CheckInParticipant(ctx, &CheckInRequest{GuildID: guildID, RoundID: roundID, UserID: "a"})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrCheckInNotOpen) {
+			t.Fatalf("expected ErrCheckInNotOpen, got %+v", res)
+		}
+		if len(*recorded) != 0 {
+			t.Error("no check-in should be recorded")
+		}
+	})
+
+	t.Run("rejects rounds without check-in", func(t *testing.T) {
+		s, _, _ := setup(10*time.Minute, 0, acceptedParticipants("a"))
+
+		res, _ := s.CheckInParticipant(ctx, &CheckInRequest{GuildID: guildID, RoundID: roundID, UserID: "a"})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrCheckInNotEnabled) {
+			t.Fatalf("expected ErrCheckInNotEnabled, got %+v", res)
+		}
+	})
+
+	t.Run("rejects players who declined", func(t *testing.T) {
+		participants := []roundtypes.Participant{{UserID: "a", Response: roundtypes.ResponseDecline}}
+		s, _, _ := setup(10*time.Minute, 30, participants)
+
+		res, _ := s.CheckInParticipant(ctx, &CheckInRequest{GuildID: guildID, RoundID: roundID, UserID: "a"})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrParticipantNotFound) {
+			t.Fatalf("expected ErrParticipantNotFound, got %+v", res)
+		}
+	})
+}
+
+func TestRoundService_StartRound_MarksNoShows(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+	participants := append(acceptedParticipants("a", "b", "c"), roundtypes.Participant{UserID: "x", Response: roundtypes.ResponseTentative})
+
+	repo := NewFakeRepo()
+	repo.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+		return checkInRound(g, r, 0, participants), nil
+	}
+	repo.GetRoundCheckInMinutesFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (int, error) {
+		return 30, nil
+	}
+	repo.GetRoundCheckInsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) ([]*rounddb.RoundCheckIn, error) {
+		return []*rounddb.RoundCheckIn{{RoundID: r, GuildID: g, UserID: "b", Status: rounddb.CheckInStatusCheckedIn}}, nil
+	}
+	var recorded []*rounddb.RoundCheckIn
+	repo.UpsertCheckInsFunc = func(ctx context.Context, db bun.IDB, checkIns []*rounddb.RoundCheckIn) error {
+		recorded = checkIns
+		return nil
+	}
+	s := newCardsTestService(repo)
+
+	res, err := s.StartRound(context.Background(), &roundtypes.StartRoundRequest{GuildID: guildID, RoundID: roundID})
+	if err != nil || res.Success == nil {
+		t.Fatalf("expected success, got %+v, %v", res, err)
+	}
+	if len(res.NoShows) != 2 || res.NoShows[0].UserID != "a" || res.NoShows[1].UserID != "c" {
+		t.Fatalf("expected a and c to be no-shows, got %+v", res.NoShows)
+	}
+	if len(recorded) != 2 || recorded[0].Status != rounddb.CheckInStatusNoShow {
+		t.Errorf("expected two no-show records, got %+v", recorded)
+	}
+
+	// No-shows live in the check-in records; responses are left alone.
+	for _, call := range repo.Trace() {
+		if call == "UpdateRoundsAndParticipants" {
+			t.Error("marking no-shows should not rewrite participants")
+		}
+	}
+	for _, p := range (*res.Success).Participants {
+		if p.UserID == "a" && p.Response != roundtypes.ResponseAccept {
+			t.Errorf("no-show response changed to %s", p.Response)
+		}
+	}
+}
+
+func TestRoundService_StartRound_WithoutCheckIn(t *testing.T) {
+	repo := NewFakeRepo()
+	repo.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+		return checkInRound(g, r, 0, acceptedParticipants("a")), nil
+	}
+	s := newCardsTestService(repo)
+
+	res, err := s.StartRound(context.Background(), &roundtypes.StartRoundRequest{GuildID: "guild-1", RoundID: sharedtypes.RoundID(uuid.New())})
+	if err != nil || res.Success == nil {
+		t.Fatalf("expected success, got %+v, %v", res, err)
+	}
+	if len(res.NoShows) != 0 {
+		t.Errorf("expected no no-shows, got %+v", res.NoShows)
+	}
+	for _, call := range repo.Trace() {
+		if call == "UpsertCheckIns" || call == "GetRoundCheckIns" {
+			t.Errorf("check-ins should not be touched without a window, got %s", call)
+		}
+	}
+}
+
+func TestRoundService_GetMemberReliability(t *testing.T) {
+	tests := []struct {
+		name       string
+		attendance rounddb.MemberAttendance
+		wantRate   float64
+	}{
+		{name: "no history is fully reliable", wantRate: 1},
+		{name: "share of rounds turned up for", attendance: rounddb.MemberAttendance{CheckedIn: 3, NoShows: 1}, wantRate: 0.75},
+		{name: "only no-shows", attendance: rounddb.MemberAttendance{NoShows: 2}, wantRate: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewFakeRepo()
+			repo.GetMemberAttendanceFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, u sharedtypes.DiscordID) (rounddb.MemberAttendance, error) {
+				return tt.attendance, nil
+			}
+			s := newCardsTestService(repo)
+
+			res, err := s.GetMemberReliability(context.Background(), "guild-1", "a")
+			if err != nil || res.Success == nil {
+				t.Fatalf("expected success, got %+v, %v", res, err)
+			}
+			got := *res.Success
+			if got.Rate != tt.wantRate || got.CheckedIn != tt.attendance.CheckedIn || got.NoShows != tt.attendance.NoShows {
+				t.Errorf("unexpected reliability %+v", got)
+			}
+		})
+	}
+}
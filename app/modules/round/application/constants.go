@@ -16,6 +16,18 @@ const (
 	maxRedirects    = 5
 	maxFileSize     = 10 << 20 // 10MB
 
+	// Round series materialization window (days ahead of an occurrence)
+	defaultSeriesMaterializeDaysAhead = 7
+	maxSeriesMaterializeDaysAhead     = 60
+	roundSeriesPreviewCount           = 5
+
+	// Live hole scoring
+	maxHoleStrokes = 20
+
+	// Round scoring modes
+	ScoringModeGross = "gross"
+	ScoringModeNet   = "net"
+
 	// Error codes
 	errCodeRoundNotFound     = "ROUND_NOT_FOUND"
 	errCodeImportConflict    = "IMPORT_CONFLICT"
@@ -0,0 +1,129 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// SetRoundCourseLayout links a round to a course layout and copies the layout's pars
+// onto the round so live hole scoring works before any scorecard is imported.
+func (s *RoundService) SetRoundCourseLayout(ctx context.Context, req *SetRoundCourseLayoutRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+	return withTelemetry(s, ctx, "SetRoundCourseLayout", req.RoundID, func(ctx context.Context) (results.OperationResult[*roundtypes.Round, error], error) {
+		var layout *CourseLayout
+		if req.LayoutID != nil {
+			if s.courseLayouts == nil {
+				return results.FailureResult[*roundtypes.Round, error](ErrCourseRegistryUnavailable), nil
+			}
+			found, err := s.courseLayouts.GetLayout(ctx, req.GuildID, *req.LayoutID)
+			if err != nil {
+				return results.OperationResult[*roundtypes.Round, error]{}, fmt.Errorf("failed to fetch course layout: %w", err)
+			}
+			if found == nil {
+				return results.FailureResult[*roundtypes.Round, error](ErrCourseLayoutNotFound), nil
+			}
+			layout = found
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, tx bun.IDB) (results.OperationResult[*roundtypes.Round, error], error) {
+			round, err := s.repo.GetRoundForUpdate(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				if errors.Is(err, rounddb.ErrNotFound) {
+					return results.FailureResult[*roundtypes.Round, error](ErrRoundNotFound), nil
+				}
+				return results.OperationResult[*roundtypes.Round, error]{}, fmt.Errorf("failed to fetch round: %w", err)
+			}
+			if round.Finalized {
+				return results.FailureResult[*roundtypes.Round, error](ErrRoundAlreadyFinalized), nil
+			}
+
+			if err := s.repo.SetRoundCourseLayout(ctx, tx, req.GuildID, req.RoundID, req.LayoutID); err != nil {
+				return results.OperationResult[*roundtypes.Round, error]{}, fmt.Errorf("failed to link course layout: %w", err)
+			}
+
+			if layout != nil && len(layout.ParScores) > 0 {
+				round.ParScores = layout.ParScores
+				updated, err := s.repo.UpdateRound(ctx, tx, req.GuildID, req.RoundID, round)
+				if err != nil {
+					return results.OperationResult[*roundtypes.Round, error]{}, fmt.Errorf("failed to update round par scores: %w", err)
+				}
+				if updated != nil {
+					round = updated
+				}
+			}
+
+			s.logger.InfoContext(ctx, "Round course layout updated",
+				attr.RoundID("round_id", req.RoundID),
+				attr.String("guild_id", string(req.GuildID)),
+				attr.Any("layout_id", req.LayoutID),
+			)
+
+			return results.SuccessResult[*roundtypes.Round, error](round), nil
+		})
+	})
+}
+
+// GetCourseLayoutRounds returns finalized rounds played on a layout after startTime.
+func (s *RoundService) GetCourseLayoutRounds(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error) {
+	ctx, span := s.tracer.Start(ctx, "GetCourseLayoutRounds")
+	defer span.End()
+
+	rounds, err := s.repo.GetFinalizedRoundsOnLayout(ctx, s.db, guildID, layoutID, startTime)
+	if err != nil {
+		s.metrics.RecordDBOperationError(ctx, "GetFinalizedRoundsOnLayout")
+		return nil, fmt.Errorf("failed to query finalized rounds on layout: %w", err)
+	}
+	s.metrics.RecordDBOperationSuccess(ctx, "GetFinalizedRoundsOnLayout")
+	return rounds, nil
+}
+
+// resolveImportLayout matches an imported scorecard's pars against the course
+// registry before the import transaction opens, so a rolled-back import never holds a
+// registry write and the registry serializes concurrent matches on its own. Failures
+// are logged and ignored: the round keeps its copied par scores either way.
+func (s *RoundService) resolveImportLayout(ctx context.Context, req roundtypes.ImportApplyScoresInput) *CourseLayout {
+	if s.courseLayouts == nil || len(req.ParScores) == 0 || len(req.Scores) == 0 {
+		return nil
+	}
+
+	round, err := s.repo.GetRound(ctx, s.db, req.GuildID, req.RoundID)
+	if err != nil {
+		return nil
+	}
+
+	layout, err := s.courseLayouts.ResolveLayout(ctx, req.GuildID, string(round.Location), req.ParScores)
+	if err != nil || layout == nil {
+		s.logger.DebugContext(ctx, "Could not match imported scorecard to a course layout",
+			attr.RoundID("round_id", req.RoundID),
+			attr.String("guild_id", string(req.GuildID)),
+			attr.Error(err),
+		)
+		return nil
+	}
+	return layout
+}
+
+// linkCourseLayout links the round to a layout resolved by resolveImportLayout.
+func (s *RoundService) linkCourseLayout(ctx context.Context, tx bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, layout *CourseLayout) {
+	if layout == nil {
+		return
+	}
+
+	if err := s.repo.SetRoundCourseLayout(ctx, tx, guildID, roundID, &layout.ID); err != nil {
+		s.logger.WarnContext(ctx, "Failed to link round to course layout",
+			attr.RoundID("round_id", roundID),
+			attr.String("guild_id", string(guildID)),
+			attr.String("layout_id", layout.ID.String()),
+			attr.Error(err),
+		)
+	}
+}
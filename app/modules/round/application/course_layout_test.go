@@ -0,0 +1,193 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	roundmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/round"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestRoundService_SetRoundCourseLayout(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+	layoutID := uuid.New()
+
+	upcoming := func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+		return &roundtypes.Round{ID: r, GuildID: g, State: roundtypes.RoundStateUpcoming}, nil
+	}
+	knownLayout := &FakeCourseLayoutResolver{
+		GetLayoutFunc: func(ctx context.Context, g sharedtypes.GuildID, id uuid.UUID) (*CourseLayout, error) {
+			if id != layoutID {
+				return nil, nil
+			}
+			return &CourseLayout{ID: id, CourseName: "Maple Hill", Name: "Gold", ParScores: []int{3, 4, 3}}, nil
+		},
+	}
+
+	tests := []struct {
+		name      string
+		req       *SetRoundCourseLayoutRequest
+		resolver  CourseLayoutResolver
+		setupRepo func(f *FakeRepo)
+		wantErr   error
+		wantTrace []string
+		wantPars  []int
+	}{
+		{
+			name:      "links layout and copies pars",
+			req:       &SetRoundCourseLayoutRequest{GuildID: guildID, RoundID: roundID, LayoutID: &layoutID},
+			resolver:  knownLayout,
+			setupRepo: func(f *FakeRepo) { f.GetRoundForUpdateFunc = upcoming },
+			wantTrace: []string{"GetRoundForUpdate", "SetRoundCourseLayout", "UpdateRound"},
+			wantPars:  []int{3, 4, 3},
+		},
+		{
+			name:      "clearing the link needs no registry",
+			req:       &SetRoundCourseLayoutRequest{GuildID: guildID, RoundID: roundID},
+			setupRepo: func(f *FakeRepo) { f.GetRoundForUpdateFunc = upcoming },
+			wantTrace: []string{"GetRoundForUpdate", "SetRoundCourseLayout"},
+		},
+		{
+			name:    "rejects layouts without a registry",
+			req:     &SetRoundCourseLayoutRequest{GuildID: guildID, RoundID: roundID, LayoutID: &layoutID},
+			wantErr: ErrCourseRegistryUnavailable,
+		},
+		{
+			name:     "rejects unknown layouts",
+			req:      &SetRoundCourseLayoutRequest{GuildID: guildID, RoundID: roundID, LayoutID: func() *uuid.UUID { id := uuid.New(); return &id }()},
+			resolver: knownLayout,
+			wantErr:  ErrCourseLayoutNotFound,
+		},
+		{
+			name:     "rejects finalized rounds",
+			req:      &SetRoundCourseLayoutRequest{GuildID: guildID, RoundID: roundID, LayoutID: &layoutID},
+			resolver: knownLayout,
+			setupRepo: func(f *FakeRepo) {
+				f.GetRoundForUpdateFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+					return &roundtypes.Round{ID: r, GuildID: g, Finalized: true}, nil
+				}
+			},
+			wantErr:   ErrRoundAlreadyFinalized,
+			wantTrace: []string{"GetRoundForUpdate"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewFakeRepo()
+			if tt.setupRepo != nil {
+				tt.setupRepo(repo)
+			}
+			s := &RoundService{
+				repo:          repo,
+				logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+				metrics:       &roundmetrics.NoOpMetrics{},
+				tracer:        noop.NewTracerProvider().Tracer("test"),
+				courseLayouts: tt.resolver,
+			}
+
+			res, err := s.SetRoundCourseLayout(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantErr != nil {
+				if res.Failure == nil {
+					t.Fatalf("expected failure %v, got success", tt.wantErr)
+				}
+				if !errors.Is(*res.Failure, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, *res.Failure)
+				}
+			} else {
+				if res.Success == nil {
+					t.Fatalf("expected success, got failure: %v", *res.Failure)
+				}
+				if !slices.Equal((*res.Success).ParScores, tt.wantPars) {
+					t.Errorf("expected pars %v, got %v", tt.wantPars, (*res.Success).ParScores)
+				}
+			}
+
+			if tt.wantTrace != nil && !slices.Equal(repo.Trace(), tt.wantTrace) {
+				t.Errorf("expected trace %v, got %v", tt.wantTrace, repo.Trace())
+			}
+		})
+	}
+}
+
+func TestRoundService_resolveAndLinkCourseLayout(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+	layoutID := uuid.New()
+	input := roundtypes.ImportApplyScoresInput{
+		GuildID:   guildID,
+		RoundID:   roundID,
+		ParScores: []int{3, 3, 3},
+		Scores:    []roundtypes.ImportScoreData{{UserID: "u1", Score: 0}},
+	}
+
+	tests := []struct {
+		name      string
+		resolver  CourseLayoutResolver
+		wantTrace []string
+	}{
+		{
+			name: "links matched layout",
+			resolver: &FakeCourseLayoutResolver{
+				ResolveLayoutFunc: func(ctx context.Context, g sharedtypes.GuildID, courseName string, pars []int) (*CourseLayout, error) {
+					if courseName != "Maple Hill" {
+						t.Errorf("expected round location as course name, got %q", courseName)
+					}
+					return &CourseLayout{ID: layoutID, ParScores: pars}, nil
+				},
+			},
+			wantTrace: []string{"GetRound", "SetRoundCourseLayout"},
+		},
+		{
+			name: "ignores resolver errors",
+			resolver: &FakeCourseLayoutResolver{
+				ResolveLayoutFunc: func(ctx context.Context, g sharedtypes.GuildID, courseName string, pars []int) (*CourseLayout, error) {
+					return nil, errors.New("ambiguous")
+				},
+			},
+			wantTrace: []string{"GetRound"},
+		},
+		{
+			name:      "skips without registry",
+			wantTrace: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewFakeRepo()
+			repo.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+				return &roundtypes.Round{ID: r, GuildID: g, Location: "Maple Hill"}, nil
+			}
+			s := &RoundService{
+				repo:          repo,
+				logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+				metrics:       &roundmetrics.NoOpMetrics{},
+				tracer:        noop.NewTracerProvider().Tracer("test"),
+				courseLayouts: tt.resolver,
+			}
+
+			layout := s.resolveImportLayout(ctx, input)
+			s.linkCourseLayout(ctx, nil, guildID, roundID, layout)
+
+			if !slices.Equal(repo.Trace(), tt.wantTrace) {
+				t.Errorf("expected trace %v, got %v", tt.wantTrace, repo.Trace())
+			}
+		})
+	}
+}
@@ -29,6 +29,60 @@ var (
 
 	// ErrUnauthorized indicates the user is not authorized for the operation.
 	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrRoundSeriesNotFound indicates a round series does not exist in the guild.
+	ErrRoundSeriesNotFound = errors.New("round series not found")
+
+	// ErrInvalidRoundSeries indicates the series definition (rule, timezone, window) is invalid.
+	ErrInvalidRoundSeries = errors.New("invalid round series")
+
+	// ErrOccurrenceNotInSeries indicates the requested time is not an occurrence of the series.
+	ErrOccurrenceNotInSeries = errors.New("time is not an occurrence of the round series")
+
+	// ErrOccurrenceSkipped indicates the occurrence was skipped and cannot be changed.
+	ErrOccurrenceSkipped = errors.New("round series occurrence has been skipped")
+
+	// ErrRoundNotInProgress indicates the operation requires an in-progress round.
+	ErrRoundNotInProgress = errors.New("round is not in progress")
+
+	// ErrParScoresUnavailable indicates the round has no layout pars to score holes against.
+	ErrParScoresUnavailable = errors.New("round has no par scores configured")
+
+	// ErrInvalidHoleScore indicates the hole number or stroke count is out of range.
+	ErrInvalidHoleScore = errors.New("invalid hole score")
+
+	// ErrCourseRegistryUnavailable indicates no course registry is wired into the round service.
+	ErrCourseRegistryUnavailable = errors.New("course registry is not available")
+
+	// ErrCourseLayoutNotFound indicates the referenced course layout does not exist in the guild.
+	ErrCourseLayoutNotFound = errors.New("course layout not found")
+
+	// ErrInvalidScoringMode indicates a scoring mode other than gross or net was requested.
+	ErrInvalidScoringMode = errors.New("scoring mode must be gross or net")
+
+	// ErrInvalidCapacity indicates a negative participant cap was requested.
+	ErrInvalidCapacity = errors.New("max participants cannot be negative")
+
+	// ErrInvalidCardSize indicates a card size outside 0 (off) to MaxCardSize.
+	ErrInvalidCardSize = fmt.Errorf("card size must be between 0 and %d", MaxCardSize)
+
+	// ErrInvalidCardStrategy indicates an unknown card assignment strategy.
+	ErrInvalidCardStrategy = errors.New("card strategy must be random, by_tag or balanced")
+
+	// ErrInvalidTeamDraw indicates a team draw other than off, random or seeded.
+	ErrInvalidTeamDraw = errors.New("team draw must be off, random or seeded")
+
+	// ErrNotOnTeam indicates a team score was submitted by a player who is not on the team.
+	ErrNotOnTeam = errors.New("submitter is not a member of the team")
+
+	// ErrInvalidCheckInWindow indicates a check-in window outside 0..MaxCheckInMinutes.
+	ErrInvalidCheckInWindow = fmt.Errorf("check-in window must be between 0 and %d minutes", MaxCheckInMinutes)
+
+	// ErrCheckInNotEnabled indicates a check-in for a round without a check-in window.
+	ErrCheckInNotEnabled = errors.New("check-in is not enabled for this round")
+
+	// ErrCheckInNotOpen indicates a check-in before the round's check-in window opens.
+	ErrCheckInNotOpen = errors.New("check-in has not opened yet")
 )
 
 // ImportError is a structured error used internally by import helpers.
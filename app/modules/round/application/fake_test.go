@@ -16,6 +16,7 @@ import (
 	roundtime "github.com/Black-And-White-Club/frolf-bot/app/modules/round/time_utils"
 	roundutil "github.com/Black-And-White-Club/frolf-bot/app/modules/round/utils"
 	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
 	nc "github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/uptrace/bun"
@@ -104,6 +105,54 @@ type FakeRepo struct {
 	GetRoundsByGuildIDFunc             func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, states ...roundtypes.RoundState) ([]*roundtypes.Round, error)
 	GetFinalizedRoundsAfterFunc        func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, startTime time.Time) ([]*roundtypes.Round, error)
 	GetAllUpcomingRoundsInWindowFunc   func(ctx context.Context, db bun.IDB, lookahead time.Duration) ([]*roundtypes.Round, error)
+	SetRoundModeFunc                   func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, mode sharedtypes.RoundMode) error
+
+	// Round series
+	CreateRoundSeriesFunc           func(ctx context.Context, db bun.IDB, series *rounddb.RoundSeries) error
+	GetRoundSeriesFunc              func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, seriesID uuid.UUID) (*rounddb.RoundSeries, error)
+	ListRoundSeriesFunc             func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) ([]*rounddb.RoundSeries, error)
+	ListActiveRoundSeriesFunc       func(ctx context.Context, db bun.IDB) ([]*rounddb.RoundSeries, error)
+	UpdateRoundSeriesFunc           func(ctx context.Context, db bun.IDB, series *rounddb.RoundSeries) error
+	GetRoundSeriesOccurrencesFunc   func(ctx context.Context, db bun.IDB, seriesID uuid.UUID) ([]*rounddb.RoundSeriesOccurrence, error)
+	ClaimRoundSeriesOccurrenceFunc  func(ctx context.Context, db bun.IDB, occurrence *rounddb.RoundSeriesOccurrence) (bool, error)
+	UpsertRoundSeriesOccurrenceFunc func(ctx context.Context, db bun.IDB, occurrence *rounddb.RoundSeriesOccurrence) error
+	DeleteRoundSeriesOccurrenceFunc func(ctx context.Context, db bun.IDB, seriesID uuid.UUID, occurrenceAt time.Time) error
+
+	// Live hole scores
+	UpsertHoleScoreFunc func(ctx context.Context, db bun.IDB, score *rounddb.RoundHoleScore) error
+	GetHoleScoresFunc   func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]*rounddb.RoundHoleScore, error)
+
+	// Course layout references
+	SetRoundCourseLayoutFunc       func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, layoutID *uuid.UUID) error
+	GetRoundCourseLayoutIDFunc     func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*uuid.UUID, error)
+	GetFinalizedRoundsOnLayoutFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error)
+
+	// Scoring mode
+	SetRoundScoringModeFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, mode string) error
+	GetRoundScoringModeFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (string, error)
+
+	// Capacity
+	GetRoundCapacityFunc        func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (int, []rounddb.WaitlistEntry, error)
+	SetRoundMaxParticipantsFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, maxParticipants int) error
+	SetRoundWaitlistFunc        func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, waitlist []rounddb.WaitlistEntry) error
+
+	// Cards
+	GetRoundCardSettingsFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (rounddb.RoundCardSettings, error)
+	SetRoundCardSettingsFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, settings rounddb.RoundCardSettings) error
+	ReplaceRoundCardsFunc    func(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID, cards []rounddb.RoundCard) error
+	GetRoundCardsFunc        func(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID) ([]rounddb.RoundCard, error)
+
+	// Team draws
+	GetRoundTeamDrawFunc        func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (sharedtypes.RoundMode, string, error)
+	SetRoundTeamDrawFunc        func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, draw string) error
+	ReplaceRoundEntryGroupsFunc func(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID, groups []rounddb.RoundEntryGroup) error
+
+	// Check-ins
+	GetRoundCheckInMinutesFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (int, error)
+	SetRoundCheckInMinutesFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, minutes int) error
+	UpsertCheckInsFunc         func(ctx context.Context, db bun.IDB, checkIns []*rounddb.RoundCheckIn) error
+	GetRoundCheckInsFunc       func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]*rounddb.RoundCheckIn, error)
+	GetMemberAttendanceFunc    func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (rounddb.MemberAttendance, error)
 }
 
 func NewFakeRepo() *FakeRepo {
@@ -299,6 +348,262 @@ func (f *FakeRepo) GetAllUpcomingRoundsInWindow(ctx context.Context, db bun.IDB,
 	return nil, nil
 }
 
+func (f *FakeRepo) SetRoundMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, mode sharedtypes.RoundMode) error {
+	f.record("SetRoundMode")
+	if f.SetRoundModeFunc != nil {
+		return f.SetRoundModeFunc(ctx, db, guildID, roundID, mode)
+	}
+	return nil
+}
+
+func (f *FakeRepo) CreateRoundSeries(ctx context.Context, db bun.IDB, series *rounddb.RoundSeries) error {
+	f.record("CreateRoundSeries")
+	if f.CreateRoundSeriesFunc != nil {
+		return f.CreateRoundSeriesFunc(ctx, db, series)
+	}
+	return nil
+}
+
+func (f *FakeRepo) GetRoundSeries(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, seriesID uuid.UUID) (*rounddb.RoundSeries, error) {
+	f.record("GetRoundSeries")
+	if f.GetRoundSeriesFunc != nil {
+		return f.GetRoundSeriesFunc(ctx, db, guildID, seriesID)
+	}
+	return nil, rounddb.ErrRoundSeriesNotFound
+}
+
+func (f *FakeRepo) ListRoundSeries(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) ([]*rounddb.RoundSeries, error) {
+	f.record("ListRoundSeries")
+	if f.ListRoundSeriesFunc != nil {
+		return f.ListRoundSeriesFunc(ctx, db, guildID)
+	}
+	return nil, nil
+}
+
+func (f *FakeRepo) ListActiveRoundSeries(ctx context.Context, db bun.IDB) ([]*rounddb.RoundSeries, error) {
+	f.record("ListActiveRoundSeries")
+	if f.ListActiveRoundSeriesFunc != nil {
+		return f.ListActiveRoundSeriesFunc(ctx, db)
+	}
+	return nil, nil
+}
+
+func (f *FakeRepo) UpdateRoundSeries(ctx context.Context, db bun.IDB, series *rounddb.RoundSeries) error {
+	f.record("UpdateRoundSeries")
+	if f.UpdateRoundSeriesFunc != nil {
+		return f.UpdateRoundSeriesFunc(ctx, db, series)
+	}
+	return nil
+}
+
+func (f *FakeRepo) GetRoundSeriesOccurrences(ctx context.Context, db bun.IDB, seriesID uuid.UUID) ([]*rounddb.RoundSeriesOccurrence, error) {
+	f.record("GetRoundSeriesOccurrences")
+	if f.GetRoundSeriesOccurrencesFunc != nil {
+		return f.GetRoundSeriesOccurrencesFunc(ctx, db, seriesID)
+	}
+	return nil, nil
+}
+
+func (f *FakeRepo) ClaimRoundSeriesOccurrence(ctx context.Context, db bun.IDB, occurrence *rounddb.RoundSeriesOccurrence) (bool, error) {
+	f.record("ClaimRoundSeriesOccurrence")
+	if f.ClaimRoundSeriesOccurrenceFunc != nil {
+		return f.ClaimRoundSeriesOccurrenceFunc(ctx, db, occurrence)
+	}
+	return true, nil
+}
+
+func (f *FakeRepo) UpsertRoundSeriesOccurrence(ctx context.Context, db bun.IDB, occurrence *rounddb.RoundSeriesOccurrence) error {
+	f.record("UpsertRoundSeriesOccurrence")
+	if f.UpsertRoundSeriesOccurrenceFunc != nil {
+		return f.UpsertRoundSeriesOccurrenceFunc(ctx, db, occurrence)
+	}
+	return nil
+}
+
+func (f *FakeRepo) DeleteRoundSeriesOccurrence(ctx context.Context, db bun.IDB, seriesID uuid.UUID, occurrenceAt time.Time) error {
+	f.record("DeleteRoundSeriesOccurrence")
+	if f.DeleteRoundSeriesOccurrenceFunc != nil {
+		return f.DeleteRoundSeriesOccurrenceFunc(ctx, db, seriesID, occurrenceAt)
+	}
+	return nil
+}
+
+func (f *FakeRepo) UpsertHoleScore(ctx context.Context, db bun.IDB, score *rounddb.RoundHoleScore) error {
+	f.record("UpsertHoleScore")
+	if f.UpsertHoleScoreFunc != nil {
+		return f.UpsertHoleScoreFunc(ctx, db, score)
+	}
+	return nil
+}
+
+func (f *FakeRepo) GetHoleScores(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]*rounddb.RoundHoleScore, error) {
+	f.record("GetHoleScores")
+	if f.GetHoleScoresFunc != nil {
+		return f.GetHoleScoresFunc(ctx, db, guildID, roundID)
+	}
+	return nil, nil
+}
+
+func (f *FakeRepo) SetRoundCourseLayout(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, layoutID *uuid.UUID) error {
+	f.record("SetRoundCourseLayout")
+	if f.SetRoundCourseLayoutFunc != nil {
+		return f.SetRoundCourseLayoutFunc(ctx, db, guildID, roundID, layoutID)
+	}
+	return nil
+}
+
+func (f *FakeRepo) GetRoundCourseLayoutID(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*uuid.UUID, error) {
+	f.record("GetRoundCourseLayoutID")
+	if f.GetRoundCourseLayoutIDFunc != nil {
+		return f.GetRoundCourseLayoutIDFunc(ctx, db, guildID, roundID)
+	}
+	return nil, nil
+}
+
+func (f *FakeRepo) GetFinalizedRoundsOnLayout(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error) {
+	f.record("GetFinalizedRoundsOnLayout")
+	if f.GetFinalizedRoundsOnLayoutFunc != nil {
+		return f.GetFinalizedRoundsOnLayoutFunc(ctx, db, guildID, layoutID, startTime)
+	}
+	return nil, nil
+}
+
+func (f *FakeRepo) SetRoundScoringMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, mode string) error {
+	f.record("SetRoundScoringMode")
+	if f.SetRoundScoringModeFunc != nil {
+		return f.SetRoundScoringModeFunc(ctx, db, guildID, roundID, mode)
+	}
+	return nil
+}
+
+func (f *FakeRepo) GetRoundScoringMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (string, error) {
+	f.record("GetRoundScoringMode")
+	if f.GetRoundScoringModeFunc != nil {
+		return f.GetRoundScoringModeFunc(ctx, db, guildID, roundID)
+	}
+	return "gross", nil
+}
+
+func (f *FakeRepo) GetRoundCapacity(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (int, []rounddb.WaitlistEntry, error) {
+	f.record("GetRoundCapacity")
+	if f.GetRoundCapacityFunc != nil {
+		return f.GetRoundCapacityFunc(ctx, db, guildID, roundID)
+	}
+	return 0, nil, nil
+}
+
+func (f *FakeRepo) SetRoundMaxParticipants(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, maxParticipants int) error {
+	f.record("SetRoundMaxParticipants")
+	if f.SetRoundMaxParticipantsFunc != nil {
+		return f.SetRoundMaxParticipantsFunc(ctx, db, guildID, roundID, maxParticipants)
+	}
+	return nil
+}
+
+func (f *FakeRepo) SetRoundWaitlist(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, waitlist []rounddb.WaitlistEntry) error {
+	f.record("SetRoundWaitlist")
+	if f.SetRoundWaitlistFunc != nil {
+		return f.SetRoundWaitlistFunc(ctx, db, guildID, roundID, waitlist)
+	}
+	return nil
+}
+
+func (f *FakeRepo) GetRoundCardSettings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (rounddb.RoundCardSettings, error) {
+	f.record("GetRoundCardSettings")
+	if f.GetRoundCardSettingsFunc != nil {
+		return f.GetRoundCardSettingsFunc(ctx, db, guildID, roundID)
+	}
+	return rounddb.RoundCardSettings{}, nil
+}
+
+func (f *FakeRepo) SetRoundCardSettings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, settings rounddb.RoundCardSettings) error {
+	f.record("SetRoundCardSettings")
+	if f.SetRoundCardSettingsFunc != nil {
+		return f.SetRoundCardSettingsFunc(ctx, db, guildID, roundID, settings)
+	}
+	return nil
+}
+
+func (f *FakeRepo) ReplaceRoundCards(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID, cards []rounddb.RoundCard) error {
+	f.record("ReplaceRoundCards")
+	if f.ReplaceRoundCardsFunc != nil {
+		return f.ReplaceRoundCardsFunc(ctx, db, roundID, cards)
+	}
+	return nil
+}
+
+func (f *FakeRepo) GetRoundCards(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID) ([]rounddb.RoundCard, error) {
+	f.record("GetRoundCards")
+	if f.GetRoundCardsFunc != nil {
+		return f.GetRoundCardsFunc(ctx, db, roundID)
+	}
+	return nil, nil
+}
+
+func (f *FakeRepo) GetRoundTeamDraw(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (sharedtypes.RoundMode, string, error) {
+	f.record("GetRoundTeamDraw")
+	if f.GetRoundTeamDrawFunc != nil {
+		return f.GetRoundTeamDrawFunc(ctx, db, guildID, roundID)
+	}
+	return sharedtypes.RoundModeSingles, "off", nil
+}
+
+func (f *FakeRepo) SetRoundTeamDraw(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, draw string) error {
+	f.record("SetRoundTeamDraw")
+	if f.SetRoundTeamDrawFunc != nil {
+		return f.SetRoundTeamDrawFunc(ctx, db, guildID, roundID, draw)
+	}
+	return nil
+}
+
+func (f *FakeRepo) ReplaceRoundEntryGroups(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID, groups []rounddb.RoundEntryGroup) error {
+	f.record("ReplaceRoundEntryGroups")
+	if f.ReplaceRoundEntryGroupsFunc != nil {
+		return f.ReplaceRoundEntryGroupsFunc(ctx, db, roundID, groups)
+	}
+	return nil
+}
+
+func (f *FakeRepo) GetRoundCheckInMinutes(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (int, error) {
+	f.record("GetRoundCheckInMinutes")
+	if f.GetRoundCheckInMinutesFunc != nil {
+		return f.GetRoundCheckInMinutesFunc(ctx, db, guildID, roundID)
+	}
+	return 0, nil
+}
+
+func (f *FakeRepo) SetRoundCheckInMinutes(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, minutes int) error {
+	f.record("SetRoundCheckInMinutes")
+	if f.SetRoundCheckInMinutesFunc != nil {
+		return f.SetRoundCheckInMinutesFunc(ctx, db, guildID, roundID, minutes)
+	}
+	return nil
+}
+
+func (f *FakeRepo) UpsertCheckIns(ctx context.Context, db bun.IDB, checkIns []*rounddb.RoundCheckIn) error {
+	f.record("UpsertCheckIns")
+	if f.UpsertCheckInsFunc != nil {
+		return f.UpsertCheckInsFunc(ctx, db, checkIns)
+	}
+	return nil
+}
+
+func (f *FakeRepo) GetRoundCheckIns(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]*rounddb.RoundCheckIn, error) {
+	f.record("GetRoundCheckIns")
+	if f.GetRoundCheckInsFunc != nil {
+		return f.GetRoundCheckInsFunc(ctx, db, guildID, roundID)
+	}
+	return nil, nil
+}
+
+func (f *FakeRepo) GetMemberAttendance(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (rounddb.MemberAttendance, error) {
+	f.record("GetMemberAttendance")
+	if f.GetMemberAttendanceFunc != nil {
+		return f.GetMemberAttendanceFunc(ctx, db, guildID, userID)
+	}
+	return rounddb.MemberAttendance{}, nil
+}
+
 func (f *FakeRepo) UpdateImportStatus(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, importID string, status string, errorMessage string, errorCode string) error {
 	f.record("UpdateImportStatus")
 	if f.UpdateImportStatusFunc != nil {
@@ -346,14 +651,16 @@ func (f *FakeRepo) GetFinalizedRoundsAfter(ctx context.Context, db bun.IDB, guil
 type FakeQueueService struct {
 	trace []string
 
-	ScheduleRoundStartFunc    func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, startTime time.Time, payload roundevents.RoundStartedPayloadV1) error
-	ScheduleRoundReminderFunc func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, reminderTime time.Time, payload roundevents.DiscordReminderPayloadV1) error
-	CancelRoundStartJobsFunc  func(ctx context.Context, roundID sharedtypes.RoundID) error
-	CancelRoundJobsFunc       func(ctx context.Context, roundID sharedtypes.RoundID) error
-	GetScheduledJobsFunc      func(ctx context.Context, roundID sharedtypes.RoundID) ([]roundqueue.JobInfo, error)
-	HealthCheckFunc           func(ctx context.Context) error
-	StartFunc                 func(ctx context.Context) error
-	StopFunc                  func(ctx context.Context) error
+	ScheduleRoundStartFunc       func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, startTime time.Time, payload roundevents.RoundStartedPayloadV1) error
+	ScheduleRoundReminderFunc    func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, reminderTime time.Time, payload roundevents.DiscordReminderPayloadV1) error
+	ScheduleRoundCheckInOpenFunc func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, openTime time.Time) error
+	CancelRoundStartJobsFunc     func(ctx context.Context, roundID sharedtypes.RoundID) error
+	CancelRoundCheckInJobsFunc   func(ctx context.Context, roundID sharedtypes.RoundID) error
+	CancelRoundJobsFunc          func(ctx context.Context, roundID sharedtypes.RoundID) error
+	GetScheduledJobsFunc         func(ctx context.Context, roundID sharedtypes.RoundID) ([]roundqueue.JobInfo, error)
+	HealthCheckFunc              func(ctx context.Context) error
+	StartFunc                    func(ctx context.Context) error
+	StopFunc                     func(ctx context.Context) error
 }
 
 func NewFakeQueueService() *FakeQueueService {
@@ -388,6 +695,22 @@ func (f *FakeQueueService) ScheduleRoundReminder(ctx context.Context, guildID sh
 	return nil
 }
 
+func (f *FakeQueueService) ScheduleRoundCheckInOpen(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, openTime time.Time) error {
+	f.record("ScheduleRoundCheckInOpen")
+	if f.ScheduleRoundCheckInOpenFunc != nil {
+		return f.ScheduleRoundCheckInOpenFunc(ctx, guildID, roundID, openTime)
+	}
+	return nil
+}
+
+func (f *FakeQueueService) CancelRoundCheckInJobs(ctx context.Context, roundID sharedtypes.RoundID) error {
+	f.record("CancelRoundCheckInJobs")
+	if f.CancelRoundCheckInJobsFunc != nil {
+		return f.CancelRoundCheckInJobsFunc(ctx, roundID)
+	}
+	return nil
+}
+
 func (f *FakeQueueService) CancelRoundJobs(ctx context.Context, roundID sharedtypes.RoundID) error {
 	f.record("CancelRoundJobs")
 	if f.CancelRoundJobsFunc != nil {
@@ -591,6 +914,29 @@ func (f *FakeGuildConfigProvider) GetConfig(ctx context.Context, guildID sharedt
 	return nil, nil
 }
 
+// ------------------------
+// Fake Course Layout Resolver
+// ------------------------
+
+type FakeCourseLayoutResolver struct {
+	ResolveLayoutFunc func(ctx context.Context, guildID sharedtypes.GuildID, courseName string, parScores []int) (*CourseLayout, error)
+	GetLayoutFunc     func(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID) (*CourseLayout, error)
+}
+
+func (f *FakeCourseLayoutResolver) ResolveLayout(ctx context.Context, guildID sharedtypes.GuildID, courseName string, parScores []int) (*CourseLayout, error) {
+	if f.ResolveLayoutFunc != nil {
+		return f.ResolveLayoutFunc(ctx, guildID, courseName, parScores)
+	}
+	return nil, nil
+}
+
+func (f *FakeCourseLayoutResolver) GetLayout(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID) (*CourseLayout, error) {
+	if f.GetLayoutFunc != nil {
+		return f.GetLayoutFunc(ctx, guildID, layoutID)
+	}
+	return nil, nil
+}
+
 // ------------------------
 // Interface assertions
 // ------------------------
@@ -601,3 +947,4 @@ var _ roundtime.TimeParserInterface = (*FakeTimeParser)(nil)
 var _ UserLookup = (*FakeUserLookup)(nil)
 var _ eventbus.EventBus = (*FakeEventBus)(nil)
 var _ GuildConfigProvider = (*FakeGuildConfigProvider)(nil)
+var _ CourseLayoutResolver = (*FakeCourseLayoutResolver)(nil)
@@ -49,6 +49,22 @@ func (s *RoundService) FinalizeRound(ctx context.Context, req *roundtypes.Finali
 				}), nil
 			}
 
+			// Total up live hole-by-hole cards for anyone who never got a final score.
+			changed, err := s.applyHoleScoreTotals(ctx, tx, roundForUpdate)
+			if err != nil {
+				s.metrics.RecordDBOperationError(ctx, "get_hole_scores")
+				return results.FailureResult[*roundtypes.FinalizeRoundResult, error](err), nil
+			}
+			if changed {
+				if err := s.repo.UpdateRoundsAndParticipants(ctx, tx, req.GuildID, []roundtypes.RoundUpdate{{
+					RoundID:      req.RoundID,
+					Participants: roundForUpdate.Participants,
+				}}); err != nil {
+					s.metrics.RecordDBOperationError(ctx, "update_rounds_and_participants")
+					return results.FailureResult[*roundtypes.FinalizeRoundResult, error](fmt.Errorf("failed to persist hole score totals: %w", err)), nil
+				}
+			}
+
 			// Update the round state to finalized in the database
 			rounddbState := roundtypes.RoundStateFinalized
 			s.logger.InfoContext(ctx, "Attempting to update round state to finalized",
@@ -120,9 +136,20 @@ func (s *RoundService) NotifyScoreModule(ctx context.Context, result *roundtypes
 	return withTelemetry(s, ctx, "NotifyScoreModule", result.Round.ID, func(ctx context.Context) (results.OperationResult[*roundtypes.Round, error], error) {
 		round := result.Round
 
-		roundMode := sharedtypes.RoundMode("SINGLES")
-		if len(round.Teams) > 0 {
-			roundMode = sharedtypes.RoundMode("DOUBLES")
+		// No-shows did not play; they neither score nor move tags.
+		noShows, err := s.roundNoShows(ctx, s.db, round.GuildID, round.ID)
+		if err != nil {
+			return results.OperationResult[*roundtypes.Round, error]{}, err
+		}
+		if len(noShows) > 0 {
+			played := *round
+			played.Participants = make([]roundtypes.Participant, 0, len(round.Participants))
+			for _, p := range round.Participants {
+				if !noShows[p.UserID] || p.Score != nil {
+					played.Participants = append(played.Participants, p)
+				}
+			}
+			round = &played
 		}
 
 		scores := make([]sharedtypes.ScoreInfo, 0, len(round.Participants))
@@ -131,9 +158,10 @@ func (s *RoundService) NotifyScoreModule(ctx context.Context, result *roundtypes
 				continue
 			}
 
-			// TagNumbers are meaningful only for singles
+			// Tags are kept for doubles too: team members move tags by their
+			// team's finish.
 			var tagPtr *sharedtypes.TagNumber
-			if roundMode == "SINGLES" && p.TagNumber != nil {
+			if p.TagNumber != nil {
 				tag := *p.TagNumber
 				tagPtr = &tag
 			}
@@ -142,6 +170,7 @@ func (s *RoundService) NotifyScoreModule(ctx context.Context, result *roundtypes
 				UserID:    p.UserID,
 				TagNumber: tagPtr,
 				Score:     *p.Score,
+				TeamID:    p.TeamID,
 				IsDNF:     p.IsDNF,
 			})
 		}
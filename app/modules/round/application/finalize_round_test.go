@@ -12,6 +12,7 @@ import (
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"go.opentelemetry.io/otel/trace/noop"
@@ -31,6 +32,7 @@ func TestRoundService_FinalizeRound(t *testing.T) {
 	ctx := context.Background()
 	roundID := sharedtypes.RoundID(uuid.New())
 	guildID := sharedtypes.GuildID("guild-1")
+	var persistedTotals []roundtypes.Participant
 
 	tests := []struct {
 		name       string
@@ -83,6 +85,57 @@ func TestRoundService_FinalizeRound(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "totals live hole scores for unscored participants",
+			setupRepo: func(f *FakeRepo) {
+				f.GetRoundForUpdateFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+					return &roundtypes.Round{
+						ID:        r,
+						GuildID:   g,
+						State:     roundtypes.RoundStateInProgress,
+						ParScores: []int{3, 3, 4},
+						Participants: []roundtypes.Participant{
+							{UserID: "done", Score: ptrScore(-1)},
+							{UserID: "partial"},
+							{UserID: "none"},
+						},
+					}, nil
+				}
+				f.GetHoleScoresFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) ([]*rounddb.RoundHoleScore, error) {
+					return []*rounddb.RoundHoleScore{
+						{UserID: "partial", Hole: 1, Strokes: 4},
+						{UserID: "partial", Hole: 2, Strokes: 3},
+					}, nil
+				}
+				f.UpdateRoundsAndParticipantsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, updates []roundtypes.RoundUpdate) error {
+					persistedTotals = updates[0].Participants
+					return nil
+				}
+				f.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+					return &roundtypes.Round{ID: r, GuildID: g, State: roundtypes.RoundStateFinalized}, nil
+				}
+			},
+			payload: &roundtypes.FinalizeRoundInput{
+				GuildID: guildID,
+				RoundID: roundID,
+			},
+			wantTrace: []string{"GetRoundForUpdate", "GetHoleScores", "UpdateRoundsAndParticipants", "UpdateRoundState", "GetRound", "GetParticipants"},
+			assertFunc: func(t *testing.T, res FinalizeRoundResult) {
+				if res.IsFailure() {
+					t.Fatalf("expected success, got failure: %v", *res.Failure)
+				}
+				if len(persistedTotals) != 3 {
+					t.Fatalf("expected 3 persisted participants, got %d", len(persistedTotals))
+				}
+				partial := persistedTotals[1]
+				if partial.Score == nil || *partial.Score != 1 || !partial.IsDNF {
+					t.Errorf("expected partial card to score +1 as DNF, got %+v", partial)
+				}
+				if persistedTotals[2].Score != nil {
+					t.Errorf("expected participant without holes to stay unscored")
+				}
+			},
+		},
 		{
 			name: "already finalized is idempotent success",
 			setupRepo: func(f *FakeRepo) {
@@ -245,6 +298,7 @@ func TestRoundService_NotifyScoreModule(t *testing.T) {
 	tests := []struct {
 		name       string
 		payload    *roundtypes.FinalizeRoundResult
+		noShows    []sharedtypes.DiscordID
 		assertFunc func(t *testing.T, res results.OperationResult[*roundtypes.Round, error])
 	}{
 		{
@@ -269,6 +323,29 @@ func TestRoundService_NotifyScoreModule(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "no-shows are left out",
+			payload: &roundtypes.FinalizeRoundResult{
+				Round: &roundtypes.Round{
+					ID:      roundID,
+					GuildID: guildID,
+				},
+				Participants: []roundtypes.Participant{
+					{UserID: "user1", Score: ptrScore(3), TagNumber: ptrTag(1)},
+					{UserID: "noshow", Response: roundtypes.ResponseAccept, TagNumber: ptrTag(2)},
+				},
+			},
+			noShows: []sharedtypes.DiscordID{"noshow"},
+			assertFunc: func(t *testing.T, res results.OperationResult[*roundtypes.Round, error]) {
+				if res.IsFailure() {
+					t.Fatalf("expected success, got failure: %+v", res.Failure)
+				}
+				participants := (*res.Success).Participants
+				if len(participants) != 1 || participants[0].UserID != "user1" {
+					t.Errorf("expected only user1 to be scored, got %+v", participants)
+				}
+			},
+		},
 		{
 			name: "failure no scores",
 			payload: &roundtypes.FinalizeRoundResult{
@@ -293,7 +370,16 @@ func TestRoundService_NotifyScoreModule(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			repo := &FakeRepo{}
+			repo.GetRoundCheckInsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) ([]*rounddb.RoundCheckIn, error) {
+				var checkIns []*rounddb.RoundCheckIn
+				for _, id := range tt.noShows {
+					checkIns = append(checkIns, &rounddb.RoundCheckIn{RoundID: r, GuildID: g, UserID: id, Status: rounddb.CheckInStatusNoShow})
+				}
+				return checkIns, nil
+			}
 			s := &RoundService{
+				repo:          repo,
 				logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
 				metrics:       &roundmetrics.NoOpMetrics{},
 				tracer:        noop.NewTracerProvider().Tracer("test"),
@@ -0,0 +1,227 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// SubmitHoleScore records a single hole for a participant of an in-progress round and
+// refreshes their running totals. Once every hole on the card has been played the
+// participant's Score is set to the total relative to par, which lets the regular
+// all-scores-submitted flow finalize the round.
+func (s *RoundService) SubmitHoleScore(ctx context.Context, req *SubmitHoleScoreRequest) (HoleScoreUpdateResult, error) {
+	return withTelemetry(s, ctx, "SubmitHoleScore", req.RoundID, func(ctx context.Context) (HoleScoreUpdateResult, error) {
+		if err := validateHoleScoreRequest(req); err != nil {
+			return results.FailureResult[*HoleScoreUpdate, error](err), nil
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, tx bun.IDB) (HoleScoreUpdateResult, error) {
+			round, err := s.repo.GetRoundForUpdate(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				s.logger.ErrorContext(ctx, "Failed to fetch round for hole score",
+					attr.RoundID("round_id", req.RoundID),
+					attr.String("guild_id", string(req.GuildID)),
+					attr.Error(err),
+				)
+				if errors.Is(err, rounddb.ErrNotFound) {
+					return results.FailureResult[*HoleScoreUpdate, error](ErrRoundNotFound), nil
+				}
+				return results.FailureResult[*HoleScoreUpdate, error](fmt.Errorf("failed to fetch round: %w", err)), nil
+			}
+
+			if round.State != roundtypes.RoundStateInProgress {
+				return results.FailureResult[*HoleScoreUpdate, error](ErrRoundNotInProgress), nil
+			}
+			if len(round.ParScores) == 0 {
+				return results.FailureResult[*HoleScoreUpdate, error](ErrParScoresUnavailable), nil
+			}
+			if req.Hole > len(round.ParScores) {
+				return results.FailureResult[*HoleScoreUpdate, error](
+					fmt.Errorf("%w: hole %d exceeds the %d holes on this layout", ErrInvalidHoleScore, req.Hole, len(round.ParScores)),
+				), nil
+			}
+
+			participants := make([]roundtypes.Participant, len(round.Participants))
+			copy(participants, round.Participants)
+
+			idx := -1
+			for i, p := range participants {
+				if p.UserID == req.UserID {
+					idx = i
+					break
+				}
+			}
+			if idx < 0 {
+				return results.FailureResult[*HoleScoreUpdate, error](ErrParticipantNotFound), nil
+			}
+
+			if err := s.repo.UpsertHoleScore(ctx, tx, &rounddb.RoundHoleScore{
+				RoundID: req.RoundID,
+				GuildID: req.GuildID,
+				UserID:  req.UserID,
+				Hole:    req.Hole,
+				Strokes: req.Strokes,
+			}); err != nil {
+				s.metrics.RecordDBOperationError(ctx, "upsert_hole_score")
+				return results.FailureResult[*HoleScoreUpdate, error](fmt.Errorf("failed to store hole score: %w", err)), nil
+			}
+
+			stored, err := s.repo.GetHoleScores(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				s.metrics.RecordDBOperationError(ctx, "get_hole_scores")
+				return results.FailureResult[*HoleScoreUpdate, error](fmt.Errorf("failed to load hole scores: %w", err)), nil
+			}
+
+			card := holeScoreCards(stored, len(round.ParScores))[req.UserID]
+			if card == nil {
+				card = make([]int, len(round.ParScores))
+			}
+			card[req.Hole-1] = req.Strokes
+
+			totals := summarizeHoleScores(round.ParScores, card)
+
+			participants[idx].HoleScores = card
+			if totals.Complete {
+				score := sharedtypes.Score(totals.RelativeToPar)
+				participants[idx].Score = &score
+			}
+
+			if err := s.repo.UpdateRoundsAndParticipants(ctx, tx, req.GuildID, []roundtypes.RoundUpdate{{
+				RoundID:      req.RoundID,
+				Participants: participants,
+			}}); err != nil {
+				s.metrics.RecordDBOperationError(ctx, "update_rounds_and_participants")
+				return results.FailureResult[*HoleScoreUpdate, error](fmt.Errorf("failed to persist hole scores: %w", err)), nil
+			}
+
+			s.logger.InfoContext(ctx, "Hole score recorded",
+				attr.RoundID("round_id", req.RoundID),
+				attr.String("guild_id", string(req.GuildID)),
+				attr.String("user_id", string(req.UserID)),
+				attr.Int("hole", req.Hole),
+				attr.Int("holes_played", totals.HolesPlayed),
+				attr.Int("relative_to_par", totals.RelativeToPar),
+			)
+
+			return results.SuccessResult[*HoleScoreUpdate, error](&HoleScoreUpdate{
+				GuildID:        req.GuildID,
+				RoundID:        req.RoundID,
+				UserID:         req.UserID,
+				EventMessageID: round.EventMessageID,
+				Hole:           req.Hole,
+				Strokes:        req.Strokes,
+				HoleScores:     card,
+				Totals:         totals,
+				Participants:   participants,
+			}), nil
+		})
+	})
+}
+
+// applyHoleScoreTotals fills in the Score of participants who entered hole scores but
+// never received a total. Complete cards score their total relative to par; partial
+// cards score the holes played and are marked DNF. It reports whether any participant
+// changed.
+func (s *RoundService) applyHoleScoreTotals(ctx context.Context, tx bun.IDB, round *roundtypes.Round) (bool, error) {
+	if len(round.ParScores) == 0 {
+		return false, nil
+	}
+
+	pending := false
+	for _, p := range round.Participants {
+		if p.Score == nil {
+			pending = true
+			break
+		}
+	}
+	if !pending {
+		return false, nil
+	}
+
+	stored, err := s.repo.GetHoleScores(ctx, tx, round.GuildID, round.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to load hole scores: %w", err)
+	}
+	cards := holeScoreCards(stored, len(round.ParScores))
+
+	changed := false
+	for i := range round.Participants {
+		p := &round.Participants[i]
+		card, ok := cards[p.UserID]
+		if p.Score != nil || !ok {
+			continue
+		}
+
+		totals := summarizeHoleScores(round.ParScores, card)
+		if totals.HolesPlayed == 0 {
+			continue
+		}
+
+		score := sharedtypes.Score(totals.RelativeToPar)
+		p.Score = &score
+		p.HoleScores = card
+		p.IsDNF = !totals.Complete
+		changed = true
+	}
+
+	return changed, nil
+}
+
+func validateHoleScoreRequest(req *SubmitHoleScoreRequest) error {
+	if req.RoundID == sharedtypes.RoundID(uuid.Nil) {
+		return ErrInvalidRoundID
+	}
+	if req.UserID == "" {
+		return fmt.Errorf("%w: participant Discord ID cannot be empty", ErrInvalidHoleScore)
+	}
+	if req.Hole < 1 {
+		return fmt.Errorf("%w: hole must be 1 or greater", ErrInvalidHoleScore)
+	}
+	if req.Strokes < 1 || req.Strokes > maxHoleStrokes {
+		return fmt.Errorf("%w: strokes must be between 1 and %d", ErrInvalidHoleScore, maxHoleStrokes)
+	}
+	return nil
+}
+
+// holeScoreCards groups stored hole scores into per-player cards sized to the layout.
+// Unplayed holes are 0; rows beyond the layout are ignored.
+func holeScoreCards(stored []*rounddb.RoundHoleScore, holes int) map[sharedtypes.DiscordID][]int {
+	cards := make(map[sharedtypes.DiscordID][]int)
+	for _, hs := range stored {
+		if hs == nil || hs.Hole < 1 || hs.Hole > holes {
+			continue
+		}
+		card, ok := cards[hs.UserID]
+		if !ok {
+			card = make([]int, holes)
+			cards[hs.UserID] = card
+		}
+		card[hs.Hole-1] = hs.Strokes
+	}
+	return cards
+}
+
+// summarizeHoleScores computes running totals for a card against the layout pars.
+func summarizeHoleScores(pars []int, card []int) HoleScoreTotals {
+	var totals HoleScoreTotals
+	for i, par := range pars {
+		if i >= len(card) || card[i] <= 0 {
+			continue
+		}
+		totals.HolesPlayed++
+		totals.TotalStrokes += card[i]
+		totals.ParPlayed += par
+	}
+	totals.RelativeToPar = totals.TotalStrokes - totals.ParPlayed
+	totals.Complete = len(pars) > 0 && totals.HolesPlayed == len(pars)
+	return totals
+}
@@ -0,0 +1,225 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	roundmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/round"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestRoundService_SubmitHoleScore(t *testing.T) {
+	ctx := context.Background()
+	roundID := sharedtypes.RoundID(uuid.New())
+	guildID := sharedtypes.GuildID("guild-1")
+	pars := []int{3, 3, 4}
+
+	liveRound := func(participants ...roundtypes.Participant) func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+		return func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+			return &roundtypes.Round{
+				ID:             r,
+				GuildID:        g,
+				State:          roundtypes.RoundStateInProgress,
+				ParScores:      pars,
+				EventMessageID: "msg-1",
+				Participants:   participants,
+			}, nil
+		}
+	}
+
+	tests := []struct {
+		name       string
+		req        *SubmitHoleScoreRequest
+		setupRepo  func(f *FakeRepo)
+		wantErr    error
+		wantTrace  []string
+		assertFunc func(t *testing.T, res *HoleScoreUpdate)
+	}{
+		{
+			name: "records hole and running totals",
+			req:  &SubmitHoleScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "u1", Hole: 2, Strokes: 2},
+			setupRepo: func(f *FakeRepo) {
+				f.GetRoundForUpdateFunc = liveRound(roundtypes.Participant{UserID: "u1", Response: roundtypes.ResponseAccept})
+				f.GetHoleScoresFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) ([]*rounddb.RoundHoleScore, error) {
+					return []*rounddb.RoundHoleScore{
+						{UserID: "u1", Hole: 1, Strokes: 4},
+						{UserID: "u1", Hole: 2, Strokes: 2},
+						{UserID: "u2", Hole: 1, Strokes: 3},
+					}, nil
+				}
+			},
+			wantTrace: []string{"GetRoundForUpdate", "UpsertHoleScore", "GetHoleScores", "UpdateRoundsAndParticipants"},
+			assertFunc: func(t *testing.T, res *HoleScoreUpdate) {
+				if !slices.Equal(res.HoleScores, []int{4, 2, 0}) {
+					t.Errorf("unexpected card %v", res.HoleScores)
+				}
+				want := HoleScoreTotals{HolesPlayed: 2, TotalStrokes: 6, ParPlayed: 6, RelativeToPar: 0}
+				if res.Totals != want {
+					t.Errorf("expected totals %+v, got %+v", want, res.Totals)
+				}
+				if res.Participants[0].Score != nil {
+					t.Errorf("expected no score for an incomplete card")
+				}
+				if res.EventMessageID != "msg-1" {
+					t.Errorf("expected event message id to be carried through")
+				}
+			},
+		},
+		{
+			name: "completing the card sets score relative to par",
+			req:  &SubmitHoleScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "u1", Hole: 3, Strokes: 5},
+			setupRepo: func(f *FakeRepo) {
+				f.GetRoundForUpdateFunc = liveRound(roundtypes.Participant{UserID: "u1", Response: roundtypes.ResponseAccept})
+				f.GetHoleScoresFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) ([]*rounddb.RoundHoleScore, error) {
+					return []*rounddb.RoundHoleScore{
+						{UserID: "u1", Hole: 1, Strokes: 3},
+						{UserID: "u1", Hole: 2, Strokes: 2},
+						{UserID: "u1", Hole: 3, Strokes: 5},
+					}, nil
+				}
+			},
+			assertFunc: func(t *testing.T, res *HoleScoreUpdate) {
+				if !res.Totals.Complete {
+					t.Fatalf("expected complete card")
+				}
+				score := res.Participants[0].Score
+				if score == nil || *score != 0 {
+					t.Errorf("expected score 0, got %v", score)
+				}
+			},
+		},
+		{
+			name:      "rejects hole beyond layout",
+			req:       &SubmitHoleScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "u1", Hole: 4, Strokes: 3},
+			setupRepo: func(f *FakeRepo) { f.GetRoundForUpdateFunc = liveRound(roundtypes.Participant{UserID: "u1"}) },
+			wantErr:   ErrInvalidHoleScore,
+			wantTrace: []string{"GetRoundForUpdate"},
+		},
+		{
+			name:      "rejects out of range strokes before touching the repo",
+			req:       &SubmitHoleScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "u1", Hole: 1, Strokes: 0},
+			wantErr:   ErrInvalidHoleScore,
+			wantTrace: []string{},
+		},
+		{
+			name: "rejects rounds that are not in progress",
+			req:  &SubmitHoleScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "u1", Hole: 1, Strokes: 3},
+			setupRepo: func(f *FakeRepo) {
+				f.GetRoundForUpdateFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+					return &roundtypes.Round{ID: r, State: roundtypes.RoundStateUpcoming, ParScores: pars}, nil
+				}
+			},
+			wantErr: ErrRoundNotInProgress,
+		},
+		{
+			name: "rejects rounds without par scores",
+			req:  &SubmitHoleScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "u1", Hole: 1, Strokes: 3},
+			setupRepo: func(f *FakeRepo) {
+				f.GetRoundForUpdateFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+					return &roundtypes.Round{ID: r, State: roundtypes.RoundStateInProgress}, nil
+				}
+			},
+			wantErr: ErrParScoresUnavailable,
+		},
+		{
+			name:      "rejects non participants",
+			req:       &SubmitHoleScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "stranger", Hole: 1, Strokes: 3},
+			setupRepo: func(f *FakeRepo) { f.GetRoundForUpdateFunc = liveRound(roundtypes.Participant{UserID: "u1"}) },
+			wantErr:   ErrParticipantNotFound,
+		},
+		{
+			name: "maps missing round",
+			req:  &SubmitHoleScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "u1", Hole: 1, Strokes: 3},
+			setupRepo: func(f *FakeRepo) {
+				f.GetRoundForUpdateFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+					return nil, rounddb.ErrNotFound
+				}
+			},
+			wantErr: ErrRoundNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewFakeRepo()
+			if tt.setupRepo != nil {
+				tt.setupRepo(repo)
+			}
+			s := &RoundService{
+				repo:          repo,
+				logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+				metrics:       &roundmetrics.NoOpMetrics{},
+				tracer:        noop.NewTracerProvider().Tracer("test"),
+				parserFactory: &StubFactory{},
+			}
+
+			res, err := s.SubmitHoleScore(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantErr != nil {
+				if res.Failure == nil {
+					t.Fatalf("expected failure %v, got success", tt.wantErr)
+				}
+				if !errors.Is(*res.Failure, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, *res.Failure)
+				}
+			} else {
+				if res.Success == nil {
+					t.Fatalf("expected success, got failure: %v", *res.Failure)
+				}
+				tt.assertFunc(t, *res.Success)
+			}
+
+			if tt.wantTrace != nil && !slices.Equal(repo.Trace(), tt.wantTrace) {
+				t.Errorf("expected trace %v, got %v", tt.wantTrace, repo.Trace())
+			}
+		})
+	}
+}
+
+func TestSummarizeHoleScores(t *testing.T) {
+	tests := []struct {
+		name string
+		pars []int
+		card []int
+		want HoleScoreTotals
+	}{
+		{
+			name: "empty card",
+			pars: []int{3, 3},
+			card: []int{0, 0},
+			want: HoleScoreTotals{},
+		},
+		{
+			name: "skips unplayed holes when comparing to par",
+			pars: []int{3, 4, 5},
+			card: []int{2, 0, 6},
+			want: HoleScoreTotals{HolesPlayed: 2, TotalStrokes: 8, ParPlayed: 8, RelativeToPar: 0},
+		},
+		{
+			name: "complete card",
+			pars: []int{3, 3},
+			card: []int{4, 4},
+			want: HoleScoreTotals{HolesPlayed: 2, TotalStrokes: 8, ParPlayed: 6, RelativeToPar: 2, Complete: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summarizeHoleScores(tt.pars, tt.card); got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
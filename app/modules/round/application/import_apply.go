@@ -29,6 +29,8 @@ func (s *RoundService) executeApplyImportedScores(
 	ctx context.Context,
 	req roundtypes.ImportApplyScoresInput,
 ) (ApplyImportedScoresResult, error) {
+	layout := s.resolveImportLayout(ctx, req)
+
 	return runInTx(s, ctx, func(ctx context.Context, tx bun.IDB) (ApplyImportedScoresResult, error) {
 		source := normalizeImportSource(req.Source)
 		importInputKind := "unknown"
@@ -69,6 +71,7 @@ func (s *RoundService) executeApplyImportedScores(
 			s.recordImportFailure(ctx, source, importInputKind, importFileExt, roundState, *result.Failure)
 			return result, nil
 		}
+		s.linkCourseLayout(ctx, tx, req.GuildID, req.RoundID, layout)
 		if req.ImportID == "" {
 			return result, nil
 		}
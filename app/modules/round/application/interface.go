@@ -9,6 +9,7 @@ import (
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
 	roundtime "github.com/Black-And-White-Club/frolf-bot/app/modules/round/time_utils"
 	roundutil "github.com/Black-And-White-Club/frolf-bot/app/modules/round/utils"
+	"github.com/google/uuid"
 )
 
 // Service defines the interface for the round service.
@@ -47,6 +48,8 @@ type Service interface {
 	UpdateParticipantScore(ctx context.Context, req *roundtypes.ScoreUpdateRequest) (ScoreUpdateResult, error)
 	UpdateParticipantScoresBulk(ctx context.Context, req *roundtypes.BulkScoreUpdateRequest) (BulkScoreUpdateResult, error)
 	CheckAllScoresSubmitted(ctx context.Context, req *roundtypes.CheckAllScoresSubmittedRequest) (AllScoresSubmittedResult, error)
+	SubmitHoleScore(ctx context.Context, req *SubmitHoleScoreRequest) (HoleScoreUpdateResult, error)
+	SubmitTeamScore(ctx context.Context, req *SubmitTeamScoreRequest) (ScoreUpdateResult, error)
 
 	// Finalize Round
 	FinalizeRound(ctx context.Context, req *roundtypes.FinalizeRoundInput) (FinalizeRoundResult, error)
@@ -74,6 +77,39 @@ type Service interface {
 	NormalizeParsedScorecard(ctx context.Context, data *roundtypes.ParsedScorecard, meta roundtypes.Metadata) (results.OperationResult[*roundtypes.NormalizedScorecard, error], error)
 	IngestNormalizedScorecard(ctx context.Context, req roundtypes.ImportIngestScorecardInput) (results.OperationResult[*roundtypes.IngestScorecardResult, error], error)
 	ApplyImportedScores(ctx context.Context, req roundtypes.ImportApplyScoresInput) (ApplyImportedScoresResult, error)
+
+	// Round Series
+	CreateRoundSeries(ctx context.Context, req *CreateRoundSeriesRequest) (RoundSeriesResult, error)
+	ListRoundSeries(ctx context.Context, guildID sharedtypes.GuildID) (ListRoundSeriesResult, error)
+	UpdateRoundSeries(ctx context.Context, req *UpdateRoundSeriesRequest) (UpdateRoundSeriesResult, error)
+	SkipRoundSeriesOccurrence(ctx context.Context, req *RoundSeriesOccurrenceRequest) (RoundSeriesOccurrenceResult, error)
+	OverrideRoundSeriesOccurrence(ctx context.Context, req *RoundSeriesOccurrenceRequest) (RoundSeriesOccurrenceResult, error)
+	MaterializeRoundSeries(ctx context.Context, now time.Time) (MaterializeRoundSeriesResult, error)
+
+	// Course Layouts
+	SetRoundCourseLayout(ctx context.Context, req *SetRoundCourseLayoutRequest) (results.OperationResult[*roundtypes.Round, error], error)
+	GetCourseLayoutRounds(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error)
+
+	// Scoring Mode
+	SetRoundScoringMode(ctx context.Context, req *SetRoundScoringModeRequest) (results.OperationResult[*roundtypes.Round, error], error)
+
+	// Capacity
+	SetRoundCapacity(ctx context.Context, req *SetRoundCapacityRequest) (ParticipantChangeResult, error)
+	UpdateParticipantStatusWithWaitlist(ctx context.Context, req *roundtypes.JoinRoundRequest) (ParticipantChangeResult, error)
+	ParticipantRemovalWithWaitlist(ctx context.Context, req *roundtypes.JoinRoundRequest) (ParticipantChangeResult, error)
+
+	// Cards
+	ConfigureRoundCards(ctx context.Context, req *ConfigureRoundCardsRequest) (results.OperationResult[*CardSettings, error], error)
+	GetRoundTeeSheet(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[[]Card, error], error)
+
+	// Team draws
+	ConfigureTeamDraw(ctx context.Context, req *ConfigureTeamDrawRequest) (results.OperationResult[*roundtypes.Round, error], error)
+
+	// Check-ins
+	ConfigureRoundCheckIn(ctx context.Context, req *ConfigureRoundCheckInRequest) (RoundCheckInResult, error)
+	OpenRoundCheckIn(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (RoundCheckInResult, error)
+	CheckInParticipant(ctx context.Context, req *CheckInRequest) (RoundCheckInResult, error)
+	GetMemberReliability(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[*MemberReliability, error], error)
 }
 
 // =============================================================================
@@ -94,10 +130,24 @@ type ScheduleRoundEventsResult = results.OperationResult[*roundtypes.ScheduleRou
 type ScoreUpdateResult = results.OperationResult[*roundtypes.ScoreUpdateResult, error]
 type BulkScoreUpdateResult = results.OperationResult[*roundtypes.BulkScoreUpdateResult, error]
 type AllScoresSubmittedResult = results.OperationResult[*roundtypes.AllScoresSubmittedResult, error]
+type RoundSeriesResult = results.OperationResult[*RoundSeriesInfo, error]
+type ListRoundSeriesResult = results.OperationResult[[]*RoundSeriesInfo, error]
+type UpdateRoundSeriesResult = results.OperationResult[*RoundSeriesUpdateResult, error]
+type RoundSeriesOccurrenceResult = results.OperationResult[*RoundSeriesOccurrenceInfo, error]
+type MaterializeRoundSeriesResult = results.OperationResult[*MaterializeRoundSeriesOutput, error]
+type HoleScoreUpdateResult = results.OperationResult[*HoleScoreUpdate, error]
+type ParticipantChangeResult = results.OperationResult[*ParticipantChange, error]
+type RoundCheckInResult = results.OperationResult[*RoundCheckInStatus, error]
 
 type StartRoundResult struct {
 	results.OperationResult[*roundtypes.Round, error]
 	AlreadyStarted bool
+	// Teams are the doubles teams drawn at start; empty without a team draw.
+	Teams []DrawnTeam
+	// Cards are the cards assigned at start; empty when the round has no card size.
+	Cards []Card
+	// NoShows are the accepted players who did not check in; empty without check-in.
+	NoShows []roundtypes.Participant
 }
 
 type ProcessRoundReminderRequest struct {
@@ -108,4 +158,197 @@ type ProcessRoundReminderRequest struct {
 	Location  *string
 }
 
+// CreateRoundSeriesRequest defines a recurring round. RecurrenceRule is an RFC 5545
+// RRULE (e.g. "FREQ=WEEKLY;BYDAY=TU") and FirstStart anchors the wall-clock time.
+type CreateRoundSeriesRequest struct {
+	GuildID              sharedtypes.GuildID
+	UserID               sharedtypes.DiscordID
+	ChannelID            string
+	Title                roundtypes.Title
+	Description          roundtypes.Description
+	Location             roundtypes.Location
+	EventType            *roundtypes.EventType
+	Mode                 sharedtypes.RoundMode
+	RecurrenceRule       string
+	Timezone             string
+	FirstStart           time.Time
+	MaterializeDaysAhead int
+}
+
+// UpdateRoundSeriesRequest is a series-wide edit; nil fields are left unchanged.
+type UpdateRoundSeriesRequest struct {
+	GuildID              sharedtypes.GuildID
+	SeriesID             uuid.UUID
+	UserID               sharedtypes.DiscordID
+	Title                *roundtypes.Title
+	Description          *roundtypes.Description
+	Location             *roundtypes.Location
+	EventType            *roundtypes.EventType
+	Mode                 *sharedtypes.RoundMode
+	RecurrenceRule       *string
+	Timezone             *string
+	StartTime            *time.Time
+	MaterializeDaysAhead *int
+	Active               *bool
+}
+
+// RoundSeriesOccurrenceRequest targets a single occurrence of a series. The override
+// fields are only used by OverrideRoundSeriesOccurrence.
+type RoundSeriesOccurrenceRequest struct {
+	GuildID      sharedtypes.GuildID
+	SeriesID     uuid.UUID
+	UserID       sharedtypes.DiscordID
+	OccurrenceAt time.Time
+	Title        *roundtypes.Title
+	Location     *roundtypes.Location
+	StartTime    *time.Time
+}
+
+// RoundSeriesInfo is the externally visible view of a round series.
+type RoundSeriesInfo struct {
+	ID                   uuid.UUID              `json:"id"`
+	GuildID              sharedtypes.GuildID    `json:"guild_id"`
+	Title                roundtypes.Title       `json:"title"`
+	Description          roundtypes.Description `json:"description"`
+	Location             roundtypes.Location    `json:"location"`
+	EventType            roundtypes.EventType   `json:"event_type"`
+	Mode                 sharedtypes.RoundMode  `json:"mode"`
+	RecurrenceRule       string                 `json:"recurrence_rule"`
+	Timezone             string                 `json:"timezone"`
+	FirstStart           time.Time              `json:"first_start"`
+	MaterializeDaysAhead int                    `json:"materialize_days_ahead"`
+	ChannelID            string                 `json:"channel_id,omitempty"`
+	CreatedBy            sharedtypes.DiscordID  `json:"created_by"`
+	Active               bool                   `json:"active"`
+	NextOccurrences      []time.Time            `json:"next_occurrences,omitempty"`
+}
+
+// RoundSeriesUpdateResult carries the series and the not-yet-started rounds the edit
+// was propagated to. RescheduledRounds is the subset whose start time moved.
+type RoundSeriesUpdateResult struct {
+	Series            *RoundSeriesInfo
+	UpdatedRounds     []*roundtypes.Round
+	RescheduledRounds []*roundtypes.Round
+}
+
+// RoundSeriesOccurrenceInfo describes the outcome of a skip or override.
+type RoundSeriesOccurrenceInfo struct {
+	SeriesID     uuid.UUID         `json:"series_id"`
+	OccurrenceAt time.Time         `json:"occurrence_at"`
+	Status       string            `json:"status"`
+	Round        *roundtypes.Round `json:"round,omitempty"`
+	DeletedRound *roundtypes.Round `json:"deleted_round,omitempty"`
+	Rescheduled  bool              `json:"rescheduled,omitempty"`
+}
+
+// MaterializedSeriesRound is a round created from a series occurrence.
+type MaterializedSeriesRound struct {
+	SeriesID  uuid.UUID
+	ChannelID string
+	Created   *roundtypes.CreateRoundResult
+}
+
+// MaterializeRoundSeriesOutput summarizes a materialization run.
+type MaterializeRoundSeriesOutput struct {
+	Rounds       []MaterializedSeriesRound
+	FailedSeries []uuid.UUID
+}
+
+// SetRoundCourseLayoutRequest links a round to a registered course layout. A nil LayoutID
+// clears the link and leaves the round's par scores untouched.
+type SetRoundCourseLayoutRequest struct {
+	GuildID  sharedtypes.GuildID
+	RoundID  sharedtypes.RoundID
+	LayoutID *uuid.UUID
+}
+
+// SetRoundScoringMode switches a round between gross and net (handicapped) ranking.
+type SetRoundScoringModeRequest struct {
+	GuildID sharedtypes.GuildID
+	RoundID sharedtypes.RoundID
+	Mode    string
+}
+
+// SetRoundCapacityRequest caps how many players can accept a round. A
+// MaxParticipants of 0 removes the cap.
+type SetRoundCapacityRequest struct {
+	GuildID         sharedtypes.GuildID
+	RoundID         sharedtypes.RoundID
+	MaxParticipants int
+}
+
+// ConfigureRoundCardsRequest sets how a round's accepted players are split into
+// cards when it starts. A Size of 0 turns cards off; an empty Strategy is random.
+type ConfigureRoundCardsRequest struct {
+	GuildID      sharedtypes.GuildID
+	RoundID      sharedtypes.RoundID
+	Size         int
+	Strategy     string
+	ShotgunStart bool
+}
+
+// ConfigureTeamDrawRequest sets a round's blind draw: TeamDrawOff, TeamDrawRandom
+// or TeamDrawSeeded.
+type ConfigureTeamDrawRequest struct {
+	GuildID sharedtypes.GuildID
+	RoundID sharedtypes.RoundID
+	Draw    string
+}
+
+// SubmitHoleScoreRequest records the strokes a participant took on one hole (1-based).
+type SubmitHoleScoreRequest struct {
+	GuildID sharedtypes.GuildID
+	RoundID sharedtypes.RoundID
+	UserID  sharedtypes.DiscordID
+	Hole    int
+	Strokes int
+}
+
+// SubmitTeamScoreRequest records one score for a doubles team on behalf of a member.
+type SubmitTeamScoreRequest struct {
+	GuildID sharedtypes.GuildID
+	RoundID sharedtypes.RoundID
+	UserID  sharedtypes.DiscordID
+	TeamID  uuid.UUID
+	Score   *sharedtypes.Score
+}
+
+// ConfigureRoundCheckInRequest sets how many minutes before the start time players
+// can check in; 0 turns check-in off.
+type ConfigureRoundCheckInRequest struct {
+	GuildID       sharedtypes.GuildID
+	RoundID       sharedtypes.RoundID
+	WindowMinutes int
+}
+
+// CheckInRequest confirms a participant's attendance at a round.
+type CheckInRequest struct {
+	GuildID sharedtypes.GuildID
+	RoundID sharedtypes.RoundID
+	UserID  sharedtypes.DiscordID
+}
+
+// HoleScoreTotals are the running totals of a live scorecard.
+type HoleScoreTotals struct {
+	HolesPlayed   int  `json:"holes_played"`
+	TotalStrokes  int  `json:"total_strokes"`
+	ParPlayed     int  `json:"par_played"`
+	RelativeToPar int  `json:"relative_to_par"`
+	Complete      bool `json:"complete"`
+}
+
+// HoleScoreUpdate is the outcome of a hole submission. HoleScores holds one entry per
+// hole on the layout, with 0 for holes not yet played.
+type HoleScoreUpdate struct {
+	GuildID        sharedtypes.GuildID
+	RoundID        sharedtypes.RoundID
+	UserID         sharedtypes.DiscordID
+	EventMessageID string
+	Hole           int
+	Strokes        int
+	HoleScores     []int
+	Totals         HoleScoreTotals
+	Participants   []roundtypes.Participant
+}
+
 // Import DTOs placeholders
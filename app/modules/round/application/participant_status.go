@@ -8,6 +8,7 @@ import (
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 )
@@ -94,8 +95,31 @@ func (s *RoundService) ValidateJoinRequest(ctx context.Context, req *roundtypes.
 
 // UpdateParticipantStatus handles the actual joining/updating of the round participant.
 func (s *RoundService) UpdateParticipantStatus(ctx context.Context, req *roundtypes.JoinRoundRequest) (results.OperationResult[*roundtypes.Round, error], error) {
-	result, err := withTelemetry[*roundtypes.Round, error](s, ctx, "UpdateParticipantStatus", req.RoundID, func(ctx context.Context) (results.OperationResult[*roundtypes.Round, error], error) {
-		return runInTx[*roundtypes.Round, error](s, ctx, func(ctx context.Context, tx bun.IDB) (results.OperationResult[*roundtypes.Round, error], error) {
+	return changedRound(s.UpdateParticipantStatusWithWaitlist(ctx, req))
+}
+
+// UpdateParticipantStatusWithWaitlist joins or updates the round participant and
+// reports the capacity outcome. When the round has a cap and no open seat, an accept
+// or tentative response puts the player on the waitlist instead. Any other change
+// frees the player's seat, so open seats are then filled from the waitlist.
+func (s *RoundService) UpdateParticipantStatusWithWaitlist(ctx context.Context, req *roundtypes.JoinRoundRequest) (ParticipantChangeResult, error) {
+	result, err := withTelemetry[*ParticipantChange, error](s, ctx, "UpdateParticipantStatusWithWaitlist", req.RoundID, func(ctx context.Context) (ParticipantChangeResult, error) {
+		return runInTx[*ParticipantChange, error](s, ctx, func(ctx context.Context, tx bun.IDB) (ParticipantChangeResult, error) {
+			maxParticipants, waitlist, err := s.repo.GetRoundCapacity(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				return results.FailureResult[*ParticipantChange, error](fmt.Errorf("failed to update participant: %w", err)), nil
+			}
+
+			if maxParticipants > 0 && takesSeat(req.Response) {
+				participants, err := s.repo.GetParticipants(ctx, tx, req.GuildID, req.RoundID)
+				if err != nil {
+					return results.FailureResult[*ParticipantChange, error](fmt.Errorf("failed to fetch participants: %w", err)), nil
+				}
+				if seatsTaken(participants, req.UserID) >= maxParticipants {
+					return s.waitlistParticipant(ctx, tx, req, participants, maxParticipants, waitlist)
+				}
+			}
+
 			participant := roundtypes.Participant{
 				UserID:    req.UserID,
 				Response:  req.Response,
@@ -104,24 +128,80 @@ func (s *RoundService) UpdateParticipantStatus(ctx context.Context, req *roundty
 			}
 
 			// Update participant in DB
-			_, err := s.repo.UpdateParticipant(ctx, tx, req.GuildID, req.RoundID, participant)
+			_, err = s.repo.UpdateParticipant(ctx, tx, req.GuildID, req.RoundID, participant)
 			if err != nil {
-				return results.FailureResult[*roundtypes.Round, error](fmt.Errorf("failed to update participant in DB: %w", err)), nil
+				return results.FailureResult[*ParticipantChange, error](fmt.Errorf("failed to update participant in DB: %w", err)), nil
+			}
+
+			waitlist, left := leaveWaitlist(waitlist, req.UserID)
+			promoted, waitlist, err := s.fillOpenSeats(ctx, tx, req.GuildID, req.RoundID, maxParticipants, waitlist, left)
+			if err != nil {
+				return ParticipantChangeResult{}, err
 			}
 
 			// Fetch updated round to return
 			round, err := s.repo.GetRound(ctx, tx, req.GuildID, req.RoundID)
 			if err != nil {
-				return results.FailureResult[*roundtypes.Round, error](fmt.Errorf("failed to fetch updated round: %w", err)), nil
+				return results.FailureResult[*ParticipantChange, error](fmt.Errorf("failed to fetch updated round: %w", err)), nil
 			}
 
-			return results.SuccessResult[*roundtypes.Round, error](round), nil
+			return results.SuccessResult[*ParticipantChange, error](&ParticipantChange{
+				Round:           round,
+				MaxParticipants: maxParticipants,
+				Waitlist:        waitlistParticipants(waitlist),
+				Promoted:        promoted,
+			}), nil
 		})
 	})
 
 	return result, err
 }
 
+// waitlistParticipant puts a player who asked for a seat in a full round on the
+// waitlist. A player who had declined is taken off the participant list while waiting.
+func (s *RoundService) waitlistParticipant(
+	ctx context.Context,
+	tx bun.IDB,
+	req *roundtypes.JoinRoundRequest,
+	participants []roundtypes.Participant,
+	maxParticipants int,
+	waitlist []rounddb.WaitlistEntry,
+) (ParticipantChangeResult, error) {
+	for _, p := range participants {
+		if p.UserID == req.UserID {
+			if _, err := s.repo.RemoveParticipant(ctx, tx, req.GuildID, req.RoundID, req.UserID); err != nil {
+				return results.FailureResult[*ParticipantChange, error](fmt.Errorf("failed to remove participant: %w", err)), nil
+			}
+			break
+		}
+	}
+
+	waitlist = joinWaitlist(waitlist, waitlistEntryFor(req))
+	if err := s.repo.SetRoundWaitlist(ctx, tx, req.GuildID, req.RoundID, waitlist); err != nil {
+		return results.FailureResult[*ParticipantChange, error](fmt.Errorf("failed to update waitlist: %w", err)), nil
+	}
+
+	round, err := s.repo.GetRound(ctx, tx, req.GuildID, req.RoundID)
+	if err != nil {
+		return results.FailureResult[*ParticipantChange, error](fmt.Errorf("failed to fetch updated round: %w", err)), nil
+	}
+
+	change := &ParticipantChange{
+		Round:           round,
+		MaxParticipants: maxParticipants,
+		Waitlisted:      true,
+		Waitlist:        waitlistParticipants(waitlist),
+	}
+	s.logger.InfoContext(ctx, "Round is full, participant waitlisted",
+		attr.RoundID("round_id", req.RoundID),
+		attr.String("user_id", string(req.UserID)),
+		attr.Int("max_participants", maxParticipants),
+		attr.Int("waitlist_position", change.WaitlistPosition(req.UserID)),
+	)
+
+	return results.SuccessResult[*ParticipantChange, error](change), nil
+}
+
 // JoinRound is a wrapper for UpdateParticipantStatus to satisfy interface if needed.
 func (s *RoundService) JoinRound(ctx context.Context, req *roundtypes.JoinRoundRequest) (results.OperationResult[*roundtypes.Round, error], error) {
 	return s.UpdateParticipantStatus(ctx, req)
@@ -129,25 +209,55 @@ func (s *RoundService) JoinRound(ctx context.Context, req *roundtypes.JoinRoundR
 
 // ParticipantRemoval handles removing a participant from a round.
 func (s *RoundService) ParticipantRemoval(ctx context.Context, req *roundtypes.JoinRoundRequest) (results.OperationResult[*roundtypes.Round, error], error) {
-	result, err := withTelemetry[*roundtypes.Round, error](s, ctx, "ParticipantRemoval", req.RoundID, func(ctx context.Context) (results.OperationResult[*roundtypes.Round, error], error) {
-		return runInTx[*roundtypes.Round, error](s, ctx, func(ctx context.Context, tx bun.IDB) (results.OperationResult[*roundtypes.Round, error], error) {
-			_, err := s.repo.RemoveParticipant(ctx, tx, req.GuildID, req.RoundID, req.UserID)
+	return changedRound(s.ParticipantRemovalWithWaitlist(ctx, req))
+}
+
+// ParticipantRemovalWithWaitlist removes a participant from a round and reports the
+// capacity outcome. The freed seat is filled from the waitlist.
+func (s *RoundService) ParticipantRemovalWithWaitlist(ctx context.Context, req *roundtypes.JoinRoundRequest) (ParticipantChangeResult, error) {
+	result, err := withTelemetry[*ParticipantChange, error](s, ctx, "ParticipantRemovalWithWaitlist", req.RoundID, func(ctx context.Context) (ParticipantChangeResult, error) {
+		return runInTx[*ParticipantChange, error](s, ctx, func(ctx context.Context, tx bun.IDB) (ParticipantChangeResult, error) {
+			maxParticipants, waitlist, err := s.repo.GetRoundCapacity(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				return results.FailureResult[*ParticipantChange, error](fmt.Errorf("failed to remove participant: %w", err)), nil
+			}
+
+			_, err = s.repo.RemoveParticipant(ctx, tx, req.GuildID, req.RoundID, req.UserID)
 			if err != nil {
-				return results.FailureResult[*roundtypes.Round, error](fmt.Errorf("failed to remove participant: %w", err)), nil
+				return results.FailureResult[*ParticipantChange, error](fmt.Errorf("failed to remove participant: %w", err)), nil
+			}
+
+			waitlist, left := leaveWaitlist(waitlist, req.UserID)
+			promoted, waitlist, err := s.fillOpenSeats(ctx, tx, req.GuildID, req.RoundID, maxParticipants, waitlist, left)
+			if err != nil {
+				return ParticipantChangeResult{}, err
 			}
 
 			round, err := s.repo.GetRound(ctx, tx, req.GuildID, req.RoundID)
 			if err != nil {
-				return results.FailureResult[*roundtypes.Round, error](fmt.Errorf("failed to fetch updated round: %w", err)), nil
+				return results.FailureResult[*ParticipantChange, error](fmt.Errorf("failed to fetch updated round: %w", err)), nil
 			}
 
-			return results.SuccessResult[*roundtypes.Round, error](round), nil
+			return results.SuccessResult[*ParticipantChange, error](&ParticipantChange{
+				Round:           round,
+				MaxParticipants: maxParticipants,
+				Waitlist:        waitlistParticipants(waitlist),
+				Promoted:        promoted,
+			}), nil
 		})
 	})
 
 	return result, err
 }
 
+// changedRound narrows a participant change to the updated round.
+func changedRound(result ParticipantChangeResult, err error) (results.OperationResult[*roundtypes.Round, error], error) {
+	if err != nil || result.Success == nil {
+		return results.OperationResult[*roundtypes.Round, error]{Failure: result.Failure}, err
+	}
+	return results.SuccessResult[*roundtypes.Round, error]((*result.Success).Round), nil
+}
+
 // ValidateParticipantJoinRequest is a pass-through to ValidateJoinRequest
 func (s *RoundService) ValidateParticipantJoinRequest(ctx context.Context, req *roundtypes.JoinRoundRequest) (results.OperationResult[*roundtypes.JoinRoundRequest, error], error) {
 	return s.ValidateJoinRequest(ctx, req)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 )
 
@@ -20,3 +21,25 @@ type UserLookup interface {
 	FindByNormalizedUDiscDisplayName(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, normalizedDisplayName string) (*UserIdentity, error)
 	FindByPartialUDiscName(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, partialName string) ([]*UserIdentity, error)
 }
+
+// CourseLayout is the minimal view of a registered course layout needed by rounds.
+type CourseLayout struct {
+	ID         uuid.UUID
+	CourseName string
+	Name       string
+	ParScores  []int
+}
+
+// CourseLayoutResolver links rounds to the course registry. Implementations live
+// outside the round module so rounds keep working when no registry is wired in.
+type CourseLayoutResolver interface {
+	// ResolveLayout matches (or registers) the layout an imported scorecard was played on.
+	ResolveLayout(ctx context.Context, guildID sharedtypes.GuildID, courseName string, parScores []int) (*CourseLayout, error)
+	GetLayout(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID) (*CourseLayout, error)
+}
+
+// PlayerRatings supplies current player ratings for rating-balanced cards. Players
+// without a rating are omitted from the result.
+type PlayerRatings interface {
+	GetRatings(ctx context.Context, guildID sharedtypes.GuildID, memberIDs []sharedtypes.DiscordID) (map[sharedtypes.DiscordID]float64, error)
+}
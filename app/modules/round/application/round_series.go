@@ -0,0 +1,665 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	roundutil "github.com/Black-And-White-Club/frolf-bot/app/modules/round/utils"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// CreateRoundSeries validates and persists a recurring round series. Rounds are not
+// created here; the periodic materialization job creates them ahead of each occurrence.
+func (s *RoundService) CreateRoundSeries(ctx context.Context, req *CreateRoundSeriesRequest) (RoundSeriesResult, error) {
+	return withTelemetry(s, ctx, "CreateRoundSeries", sharedtypes.RoundID(uuid.Nil), func(ctx context.Context) (RoundSeriesResult, error) {
+		if req.GuildID == "" || strings.TrimSpace(string(req.Title)) == "" || strings.TrimSpace(string(req.Location)) == "" {
+			return results.FailureResult[*RoundSeriesInfo](fmt.Errorf("%w: guild, title and location are required", ErrInvalidRoundSeries)), nil
+		}
+
+		rule, loc, err := parseSeriesSchedule(req.RecurrenceRule, req.Timezone)
+		if err != nil {
+			return results.FailureResult[*RoundSeriesInfo](err), nil
+		}
+		if req.FirstStart.IsZero() {
+			return results.FailureResult[*RoundSeriesInfo](fmt.Errorf("%w: first start time is required", ErrInvalidRoundSeries)), nil
+		}
+
+		daysAhead, err := normalizeMaterializeDaysAhead(req.MaterializeDaysAhead)
+		if err != nil {
+			return results.FailureResult[*RoundSeriesInfo](err), nil
+		}
+
+		eventType := roundtypes.DefaultEventType
+		if req.EventType != nil && *req.EventType != "" {
+			eventType = *req.EventType
+		}
+		mode := req.Mode
+		if mode == "" {
+			mode = sharedtypes.RoundModeSingles
+		}
+
+		series := &rounddb.RoundSeries{
+			ID:                   uuid.New(),
+			GuildID:              req.GuildID,
+			Title:                req.Title,
+			Description:          req.Description,
+			Location:             req.Location,
+			EventType:            eventType,
+			Mode:                 mode,
+			RecurrenceRule:       rule.String(),
+			Timezone:             loc.String(),
+			DTStart:              req.FirstStart.In(loc),
+			MaterializeDaysAhead: daysAhead,
+			ChannelID:            req.ChannelID,
+			CreatedBy:            req.UserID,
+			Active:               true,
+		}
+
+		if err := s.repo.CreateRoundSeries(ctx, s.db, series); err != nil {
+			return results.OperationResult[*RoundSeriesInfo, error]{}, fmt.Errorf("failed to create round series: %w", err)
+		}
+
+		s.logger.InfoContext(ctx, "Round series created",
+			attr.ExtractCorrelationID(ctx),
+			attr.String("guild_id", string(series.GuildID)),
+			attr.StringUUID("series_id", series.ID.String()),
+			attr.String("recurrence_rule", series.RecurrenceRule),
+			attr.String("timezone", series.Timezone),
+		)
+
+		return results.SuccessResult[*RoundSeriesInfo, error](toRoundSeriesInfo(series, rule, time.Now())), nil
+	})
+}
+
+// ListRoundSeries returns the guild's series with their next few occurrences.
+func (s *RoundService) ListRoundSeries(ctx context.Context, guildID sharedtypes.GuildID) (ListRoundSeriesResult, error) {
+	return withTelemetry(s, ctx, "ListRoundSeries", sharedtypes.RoundID(uuid.Nil), func(ctx context.Context) (ListRoundSeriesResult, error) {
+		seriesList, err := s.repo.ListRoundSeries(ctx, s.db, guildID)
+		if err != nil {
+			return results.OperationResult[[]*RoundSeriesInfo, error]{}, fmt.Errorf("failed to list round series: %w", err)
+		}
+
+		now := time.Now()
+		infos := make([]*RoundSeriesInfo, 0, len(seriesList))
+		for _, series := range seriesList {
+			rule, err := roundutil.ParseRecurrenceRule(series.RecurrenceRule)
+			if err != nil {
+				// Stored rules are validated on write; surface the series without occurrences.
+				rule = nil
+			}
+			infos = append(infos, toRoundSeriesInfo(series, rule, now))
+		}
+
+		return results.SuccessResult[[]*RoundSeriesInfo, error](infos), nil
+	})
+}
+
+// UpdateRoundSeries applies a series-wide edit and propagates it to rounds that were
+// already materialized but have not started. Occurrences carrying an override keep
+// their overridden values.
+func (s *RoundService) UpdateRoundSeries(ctx context.Context, req *UpdateRoundSeriesRequest) (UpdateRoundSeriesResult, error) {
+	return withTelemetry(s, ctx, "UpdateRoundSeries", sharedtypes.RoundID(uuid.Nil), func(ctx context.Context) (UpdateRoundSeriesResult, error) {
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (UpdateRoundSeriesResult, error) {
+			series, err := s.repo.GetRoundSeries(ctx, db, req.GuildID, req.SeriesID)
+			if err != nil {
+				if errors.Is(err, rounddb.ErrRoundSeriesNotFound) {
+					return results.FailureResult[*RoundSeriesUpdateResult](ErrRoundSeriesNotFound), nil
+				}
+				return results.OperationResult[*RoundSeriesUpdateResult, error]{}, fmt.Errorf("failed to load round series: %w", err)
+			}
+
+			previousLoc, err := time.LoadLocation(series.Timezone)
+			if err != nil {
+				previousLoc = time.UTC
+			}
+			previousStart := series.DTStart.In(previousLoc)
+
+			if req.Title != nil {
+				series.Title = *req.Title
+			}
+			if req.Description != nil {
+				series.Description = *req.Description
+			}
+			if req.Location != nil {
+				series.Location = *req.Location
+			}
+			if req.EventType != nil {
+				series.EventType = *req.EventType
+			}
+			if req.Mode != nil {
+				series.Mode = *req.Mode
+			}
+			if req.Active != nil {
+				series.Active = *req.Active
+			}
+			if req.RecurrenceRule != nil {
+				series.RecurrenceRule = *req.RecurrenceRule
+			}
+			if req.Timezone != nil {
+				series.Timezone = *req.Timezone
+			}
+			if req.MaterializeDaysAhead != nil {
+				daysAhead, err := normalizeMaterializeDaysAhead(*req.MaterializeDaysAhead)
+				if err != nil {
+					return results.FailureResult[*RoundSeriesUpdateResult](err), nil
+				}
+				series.MaterializeDaysAhead = daysAhead
+			}
+
+			rule, loc, err := parseSeriesSchedule(series.RecurrenceRule, series.Timezone)
+			if err != nil {
+				return results.FailureResult[*RoundSeriesUpdateResult](err), nil
+			}
+			series.RecurrenceRule = rule.String()
+			series.Timezone = loc.String()
+			if req.StartTime != nil {
+				series.DTStart = req.StartTime.In(loc)
+			} else {
+				series.DTStart = time.Date(previousStart.Year(), previousStart.Month(), previousStart.Day(),
+					previousStart.Hour(), previousStart.Minute(), previousStart.Second(), 0, loc)
+			}
+
+			if err := s.repo.UpdateRoundSeries(ctx, db, series); err != nil {
+				return results.OperationResult[*RoundSeriesUpdateResult, error]{}, fmt.Errorf("failed to update round series: %w", err)
+			}
+
+			newStart := series.DTStart
+			clockChanged := newStart.Hour() != previousStart.Hour() ||
+				newStart.Minute() != previousStart.Minute() ||
+				loc.String() != previousLoc.String()
+
+			occurrences, err := s.repo.GetRoundSeriesOccurrences(ctx, db, series.ID)
+			if err != nil {
+				return results.OperationResult[*RoundSeriesUpdateResult, error]{}, fmt.Errorf("failed to load round series occurrences: %w", err)
+			}
+
+			result := &RoundSeriesUpdateResult{}
+			now := time.Now()
+			for _, occ := range occurrences {
+				if occ.Status != rounddb.RoundSeriesOccurrenceMaterialized || occ.RoundID == nil || occ.HasOverride() {
+					continue
+				}
+
+				current, err := s.repo.GetRound(ctx, db, series.GuildID, *occ.RoundID)
+				if err != nil {
+					if errors.Is(err, rounddb.ErrNotFound) {
+						continue
+					}
+					return results.OperationResult[*RoundSeriesUpdateResult, error]{}, fmt.Errorf("failed to load series round: %w", err)
+				}
+				if current.State != roundtypes.RoundStateUpcoming || current.StartTime == nil || !current.StartTime.AsTime().After(now) {
+					continue
+				}
+
+				update := &roundtypes.Round{
+					Title:       series.Title,
+					Description: series.Description,
+					Location:    series.Location,
+					EventType:   &series.EventType,
+				}
+
+				rescheduled := false
+				if clockChanged {
+					localDay := current.StartTime.AsTime().In(previousLoc)
+					moved := time.Date(localDay.Year(), localDay.Month(), localDay.Day(),
+						newStart.Hour(), newStart.Minute(), newStart.Second(), 0, loc)
+					if moved.After(now) {
+						movedStart := sharedtypes.StartTime(moved)
+						update.StartTime = &movedStart
+						rescheduled = true
+
+						// Re-key the occurrence so later materialization runs see it at its new time.
+						if err := s.repo.DeleteRoundSeriesOccurrence(ctx, db, occ.SeriesID, occ.OccurrenceAt); err != nil {
+							return results.OperationResult[*RoundSeriesUpdateResult, error]{}, err
+						}
+						occ.OccurrenceAt = moved
+						if err := s.repo.UpsertRoundSeriesOccurrence(ctx, db, occ); err != nil {
+							return results.OperationResult[*RoundSeriesUpdateResult, error]{}, err
+						}
+					}
+				}
+
+				updated, err := s.repo.UpdateRound(ctx, db, series.GuildID, *occ.RoundID, update)
+				if err != nil {
+					return results.OperationResult[*RoundSeriesUpdateResult, error]{}, fmt.Errorf("failed to propagate series update to round: %w", err)
+				}
+				result.UpdatedRounds = append(result.UpdatedRounds, updated)
+				if rescheduled {
+					result.RescheduledRounds = append(result.RescheduledRounds, updated)
+				}
+			}
+
+			if req.Mode != nil {
+				for _, round := range result.UpdatedRounds {
+					if err := s.repo.SetRoundMode(ctx, db, series.GuildID, round.ID, series.Mode); err != nil {
+						return results.OperationResult[*RoundSeriesUpdateResult, error]{}, err
+					}
+				}
+			}
+
+			result.Series = toRoundSeriesInfo(series, rule, now)
+
+			s.logger.InfoContext(ctx, "Round series updated",
+				attr.ExtractCorrelationID(ctx),
+				attr.String("guild_id", string(series.GuildID)),
+				attr.StringUUID("series_id", series.ID.String()),
+				attr.Int("rounds_updated", len(result.UpdatedRounds)),
+				attr.Int("rounds_rescheduled", len(result.RescheduledRounds)),
+			)
+
+			return results.SuccessResult[*RoundSeriesUpdateResult, error](result), nil
+		})
+	})
+}
+
+// SkipRoundSeriesOccurrence marks a single occurrence as skipped. If the occurrence
+// was already materialized and has not started, its round is deleted.
+func (s *RoundService) SkipRoundSeriesOccurrence(ctx context.Context, req *RoundSeriesOccurrenceRequest) (RoundSeriesOccurrenceResult, error) {
+	return withTelemetry(s, ctx, "SkipRoundSeriesOccurrence", sharedtypes.RoundID(uuid.Nil), func(ctx context.Context) (RoundSeriesOccurrenceResult, error) {
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (RoundSeriesOccurrenceResult, error) {
+			series, occ, failure, err := s.loadSeriesOccurrence(ctx, db, req)
+			if failure != nil || err != nil {
+				return results.OperationResult[*RoundSeriesOccurrenceInfo, error]{Failure: failure}, err
+			}
+
+			info := &RoundSeriesOccurrenceInfo{
+				SeriesID:     series.ID,
+				OccurrenceAt: occ.OccurrenceAt,
+				Status:       string(rounddb.RoundSeriesOccurrenceSkipped),
+			}
+
+			if occ.Status == rounddb.RoundSeriesOccurrenceMaterialized && occ.RoundID != nil {
+				round, err := s.repo.GetRound(ctx, db, series.GuildID, *occ.RoundID)
+				if err != nil && !errors.Is(err, rounddb.ErrNotFound) {
+					return results.OperationResult[*RoundSeriesOccurrenceInfo, error]{}, fmt.Errorf("failed to load series round: %w", err)
+				}
+				if round != nil {
+					if round.State != roundtypes.RoundStateUpcoming {
+						return results.FailureResult[*RoundSeriesOccurrenceInfo](ErrRoundAlreadyStarted), nil
+					}
+					if err := s.repo.DeleteRound(ctx, db, series.GuildID, round.ID); err != nil {
+						return results.OperationResult[*RoundSeriesOccurrenceInfo, error]{}, fmt.Errorf("failed to delete skipped round: %w", err)
+					}
+					if err := s.queueService.CancelRoundJobs(ctx, round.ID); err != nil {
+						s.logger.WarnContext(ctx, "Failed to cancel jobs for skipped series round",
+							attr.RoundID("round_id", round.ID),
+							attr.Error(err),
+						)
+					}
+					info.DeletedRound = round
+				}
+			}
+
+			occ.Status = rounddb.RoundSeriesOccurrenceSkipped
+			if err := s.repo.UpsertRoundSeriesOccurrence(ctx, db, occ); err != nil {
+				return results.OperationResult[*RoundSeriesOccurrenceInfo, error]{}, err
+			}
+
+			return results.SuccessResult[*RoundSeriesOccurrenceInfo, error](info), nil
+		})
+	})
+}
+
+// OverrideRoundSeriesOccurrence changes the title, location or start time of a single
+// occurrence. Already-materialized rounds that have not started are updated in place.
+func (s *RoundService) OverrideRoundSeriesOccurrence(ctx context.Context, req *RoundSeriesOccurrenceRequest) (RoundSeriesOccurrenceResult, error) {
+	return withTelemetry(s, ctx, "OverrideRoundSeriesOccurrence", sharedtypes.RoundID(uuid.Nil), func(ctx context.Context) (RoundSeriesOccurrenceResult, error) {
+		if req.Title == nil && req.Location == nil && req.StartTime == nil {
+			return results.FailureResult[*RoundSeriesOccurrenceInfo](fmt.Errorf("%w: at least one override must be provided", ErrInvalidRoundSeries)), nil
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (RoundSeriesOccurrenceResult, error) {
+			series, occ, failure, err := s.loadSeriesOccurrence(ctx, db, req)
+			if failure != nil || err != nil {
+				return results.OperationResult[*RoundSeriesOccurrenceInfo, error]{Failure: failure}, err
+			}
+			if occ.Status == rounddb.RoundSeriesOccurrenceSkipped {
+				return results.FailureResult[*RoundSeriesOccurrenceInfo](ErrOccurrenceSkipped), nil
+			}
+			if req.StartTime != nil && !req.StartTime.After(time.Now()) {
+				return results.FailureResult[*RoundSeriesOccurrenceInfo](fmt.Errorf("%w: override start time must be in the future", ErrInvalidRoundSeries)), nil
+			}
+
+			if req.Title != nil {
+				occ.OverrideTitle = req.Title
+			}
+			if req.Location != nil {
+				occ.OverrideLocation = req.Location
+			}
+			if req.StartTime != nil {
+				start := req.StartTime.UTC()
+				occ.OverrideStartTime = &start
+			}
+			if occ.Status == "" {
+				occ.Status = rounddb.RoundSeriesOccurrenceScheduled
+			}
+
+			info := &RoundSeriesOccurrenceInfo{
+				SeriesID:     series.ID,
+				OccurrenceAt: occ.OccurrenceAt,
+				Status:       string(occ.Status),
+			}
+
+			if occ.Status == rounddb.RoundSeriesOccurrenceMaterialized && occ.RoundID != nil {
+				current, err := s.repo.GetRound(ctx, db, series.GuildID, *occ.RoundID)
+				if err != nil {
+					return results.OperationResult[*RoundSeriesOccurrenceInfo, error]{}, fmt.Errorf("failed to load series round: %w", err)
+				}
+				if current.State != roundtypes.RoundStateUpcoming {
+					return results.FailureResult[*RoundSeriesOccurrenceInfo](ErrRoundAlreadyStarted), nil
+				}
+
+				update := &roundtypes.Round{}
+				if req.Title != nil {
+					update.Title = *req.Title
+				}
+				if req.Location != nil {
+					update.Location = *req.Location
+				}
+				if req.StartTime != nil {
+					start := sharedtypes.StartTime(req.StartTime.UTC())
+					update.StartTime = &start
+					info.Rescheduled = true
+				}
+
+				updated, err := s.repo.UpdateRound(ctx, db, series.GuildID, current.ID, update)
+				if err != nil {
+					return results.OperationResult[*RoundSeriesOccurrenceInfo, error]{}, fmt.Errorf("failed to apply occurrence override: %w", err)
+				}
+				info.Round = updated
+			}
+
+			if err := s.repo.UpsertRoundSeriesOccurrence(ctx, db, occ); err != nil {
+				return results.OperationResult[*RoundSeriesOccurrenceInfo, error]{}, err
+			}
+
+			return results.SuccessResult[*RoundSeriesOccurrenceInfo, error](info), nil
+		})
+	})
+}
+
+// MaterializeRoundSeries creates concrete rounds for every active series occurrence
+// that falls within its materialization window. It is idempotent: each occurrence is
+// claimed before its round is stored, so concurrent or repeated runs never duplicate.
+func (s *RoundService) MaterializeRoundSeries(ctx context.Context, now time.Time) (MaterializeRoundSeriesResult, error) {
+	return withTelemetry(s, ctx, "MaterializeRoundSeries", sharedtypes.RoundID(uuid.Nil), func(ctx context.Context) (MaterializeRoundSeriesResult, error) {
+		seriesList, err := s.repo.ListActiveRoundSeries(ctx, s.db)
+		if err != nil {
+			return results.OperationResult[*MaterializeRoundSeriesOutput, error]{}, fmt.Errorf("failed to list active round series: %w", err)
+		}
+
+		output := &MaterializeRoundSeriesOutput{}
+		for _, series := range seriesList {
+			created, err := s.materializeSeries(ctx, series, now)
+			if err != nil {
+				// One broken series must not block the rest of the guilds.
+				s.logger.ErrorContext(ctx, "Failed to materialize round series",
+					attr.String("guild_id", string(series.GuildID)),
+					attr.StringUUID("series_id", series.ID.String()),
+					attr.Error(err),
+				)
+				output.FailedSeries = append(output.FailedSeries, series.ID)
+				continue
+			}
+			output.Rounds = append(output.Rounds, created...)
+		}
+
+		return results.SuccessResult[*MaterializeRoundSeriesOutput, error](output), nil
+	})
+}
+
+func (s *RoundService) materializeSeries(ctx context.Context, series *rounddb.RoundSeries, now time.Time) ([]MaterializedSeriesRound, error) {
+	rule, loc, err := parseSeriesSchedule(series.RecurrenceRule, series.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetRoundSeriesOccurrences(ctx, s.db, series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	byTime := make(map[int64]*rounddb.RoundSeriesOccurrence, len(existing))
+	var highWater time.Time
+	for _, occ := range existing {
+		byTime[occ.OccurrenceAt.Unix()] = occ
+		if occ.Status == rounddb.RoundSeriesOccurrenceMaterialized && occ.OccurrenceAt.After(highWater) {
+			highWater = occ.OccurrenceAt
+		}
+	}
+
+	windowEnd := now.AddDate(0, 0, series.MaterializeDaysAhead)
+	var created []MaterializedSeriesRound
+	for _, at := range rule.Occurrences(series.DTStart.In(loc), now, windowEnd) {
+		occ, recorded := byTime[at.Unix()]
+		if recorded && occ.Status != rounddb.RoundSeriesOccurrenceScheduled {
+			continue
+		}
+		// Once a series' schedule changes, occurrences at or before the last
+		// materialized one are considered covered by the rounds already created.
+		if !recorded && !at.After(highWater) {
+			continue
+		}
+
+		round, err := s.materializeOccurrence(ctx, series, at, occ)
+		if err != nil {
+			s.logger.WarnContext(ctx, "Failed to materialize series occurrence",
+				attr.StringUUID("series_id", series.ID.String()),
+				attr.Time("occurrence_at", at),
+				attr.Error(err),
+			)
+			continue
+		}
+		if round == nil {
+			continue
+		}
+		created = append(created, MaterializedSeriesRound{
+			SeriesID:  series.ID,
+			ChannelID: series.ChannelID,
+			Created:   round,
+		})
+	}
+
+	return created, nil
+}
+
+// materializeOccurrence claims an occurrence and stores its round. A nil result with
+// a nil error means another run already claimed the occurrence.
+func (s *RoundService) materializeOccurrence(ctx context.Context, series *rounddb.RoundSeries, at time.Time, override *rounddb.RoundSeriesOccurrence) (*roundtypes.CreateRoundResult, error) {
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	occ := &rounddb.RoundSeriesOccurrence{
+		SeriesID:     series.ID,
+		OccurrenceAt: at,
+		Status:       rounddb.RoundSeriesOccurrenceMaterialized,
+		RoundID:      &roundID,
+	}
+	if override != nil {
+		occ.OverrideTitle = override.OverrideTitle
+		occ.OverrideLocation = override.OverrideLocation
+		occ.OverrideStartTime = override.OverrideStartTime
+		// Overridden occurrences already own a row; flip it instead of claiming.
+		if err := s.repo.UpsertRoundSeriesOccurrence(ctx, s.db, occ); err != nil {
+			return nil, err
+		}
+	} else {
+		claimed, err := s.repo.ClaimRoundSeriesOccurrence(ctx, s.db, occ)
+		if err != nil {
+			return nil, err
+		}
+		if !claimed {
+			return nil, nil
+		}
+	}
+
+	release := func() {
+		var err error
+		if override != nil {
+			err = s.repo.UpsertRoundSeriesOccurrence(ctx, s.db, override)
+		} else {
+			err = s.repo.DeleteRoundSeriesOccurrence(ctx, s.db, series.ID, at)
+		}
+		if err != nil {
+			s.logger.ErrorContext(ctx, "Failed to release series occurrence claim",
+				attr.StringUUID("series_id", series.ID.String()),
+				attr.Time("occurrence_at", at),
+				attr.Error(err),
+			)
+		}
+	}
+
+	round := buildSeriesRound(series, roundID, at, occ)
+	result, err := s.StoreRound(ctx, round, series.GuildID)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	if result.Failure != nil {
+		release()
+		return nil, *result.Failure
+	}
+
+	if series.Mode != "" && series.Mode != sharedtypes.RoundModeSingles {
+		if err := s.repo.SetRoundMode(ctx, s.db, series.GuildID, roundID, series.Mode); err != nil {
+			s.logger.WarnContext(ctx, "Failed to set mode on series round",
+				attr.RoundID("round_id", roundID),
+				attr.Error(err),
+			)
+		}
+	}
+
+	return *result.Success, nil
+}
+
+func (s *RoundService) loadSeriesOccurrence(ctx context.Context, db bun.IDB, req *RoundSeriesOccurrenceRequest) (*rounddb.RoundSeries, *rounddb.RoundSeriesOccurrence, *error, error) {
+	fail := func(err error) (*rounddb.RoundSeries, *rounddb.RoundSeriesOccurrence, *error, error) {
+		return nil, nil, &err, nil
+	}
+
+	series, err := s.repo.GetRoundSeries(ctx, db, req.GuildID, req.SeriesID)
+	if err != nil {
+		if errors.Is(err, rounddb.ErrRoundSeriesNotFound) {
+			return fail(ErrRoundSeriesNotFound)
+		}
+		return nil, nil, nil, fmt.Errorf("failed to load round series: %w", err)
+	}
+
+	rule, loc, err := parseSeriesSchedule(series.RecurrenceRule, series.Timezone)
+	if err != nil {
+		return fail(err)
+	}
+	at := req.OccurrenceAt.In(loc)
+	if len(rule.Occurrences(series.DTStart.In(loc), at, at)) == 0 {
+		return fail(ErrOccurrenceNotInSeries)
+	}
+
+	occurrences, err := s.repo.GetRoundSeriesOccurrences(ctx, db, series.ID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to load round series occurrences: %w", err)
+	}
+	for _, occ := range occurrences {
+		if occ.OccurrenceAt.Equal(at) {
+			return series, occ, nil, nil
+		}
+	}
+
+	return series, &rounddb.RoundSeriesOccurrence{SeriesID: series.ID, OccurrenceAt: at}, nil, nil
+}
+
+func buildSeriesRound(series *rounddb.RoundSeries, roundID sharedtypes.RoundID, at time.Time, occ *rounddb.RoundSeriesOccurrence) *roundtypes.Round {
+	title := series.Title
+	location := series.Location
+	start := at
+	if occ != nil {
+		if occ.OverrideTitle != nil {
+			title = *occ.OverrideTitle
+		}
+		if occ.OverrideLocation != nil {
+			location = *occ.OverrideLocation
+		}
+		if occ.OverrideStartTime != nil {
+			start = *occ.OverrideStartTime
+		}
+	}
+
+	startTime := sharedtypes.StartTime(start.UTC())
+	eventType := series.EventType
+
+	return &roundtypes.Round{
+		ID:           roundID,
+		Title:        title,
+		Description:  series.Description,
+		Location:     location,
+		EventType:    &eventType,
+		StartTime:    &startTime,
+		CreatedBy:    series.CreatedBy,
+		State:        roundtypes.RoundStateUpcoming,
+		GuildID:      series.GuildID,
+		Participants: []roundtypes.Participant{},
+	}
+}
+
+func parseSeriesSchedule(rawRule, timezone string) (*roundutil.RecurrenceRule, *time.Location, error) {
+	rule, err := roundutil.ParseRecurrenceRule(rawRule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidRoundSeries, err)
+	}
+	if strings.TrimSpace(timezone) == "" {
+		return nil, nil, fmt.Errorf("%w: timezone is required", ErrInvalidRoundSeries)
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidRoundSeries, timezone)
+	}
+	return rule, loc, nil
+}
+
+func normalizeMaterializeDaysAhead(days int) (int, error) {
+	if days == 0 {
+		return defaultSeriesMaterializeDaysAhead, nil
+	}
+	if days < 1 || days > maxSeriesMaterializeDaysAhead {
+		return 0, fmt.Errorf("%w: materialize days ahead must be between 1 and %d", ErrInvalidRoundSeries, maxSeriesMaterializeDaysAhead)
+	}
+	return days, nil
+}
+
+func toRoundSeriesInfo(series *rounddb.RoundSeries, rule *roundutil.RecurrenceRule, now time.Time) *RoundSeriesInfo {
+	info := &RoundSeriesInfo{
+		ID:                   series.ID,
+		GuildID:              series.GuildID,
+		Title:                series.Title,
+		Description:          series.Description,
+		Location:             series.Location,
+		EventType:            series.EventType,
+		Mode:                 series.Mode,
+		RecurrenceRule:       series.RecurrenceRule,
+		Timezone:             series.Timezone,
+		FirstStart:           series.DTStart,
+		MaterializeDaysAhead: series.MaterializeDaysAhead,
+		ChannelID:            series.ChannelID,
+		CreatedBy:            series.CreatedBy,
+		Active:               series.Active,
+	}
+	if rule != nil && series.Active {
+		loc, err := time.LoadLocation(series.Timezone)
+		if err != nil {
+			loc = time.UTC
+		}
+		upcoming := rule.Occurrences(series.DTStart.In(loc), now, now.AddDate(0, 0, maxSeriesMaterializeDaysAhead))
+		if len(upcoming) > roundSeriesPreviewCount {
+			upcoming = upcoming[:roundSeriesPreviewCount]
+		}
+		info.NextOccurrences = upcoming
+	}
+	return info
+}
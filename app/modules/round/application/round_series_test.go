@@ -0,0 +1,508 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	roundmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/round"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func newRoundSeriesTestService(repo *FakeRepo, queue *FakeQueueService) *RoundService {
+	return &RoundService{
+		repo:          repo,
+		queueService:  queue,
+		logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+		metrics:       &roundmetrics.NoOpMetrics{},
+		tracer:        noop.NewTracerProvider().Tracer("test"),
+		parserFactory: &StubFactory{},
+	}
+}
+
+func hasCall(trace []string, call string) bool {
+	for _, c := range trace {
+		if c == call {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRoundService_CreateRoundSeries(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	firstStart := time.Date(2026, 11, 3, 18, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		req         *CreateRoundSeriesRequest
+		expectFail  error
+		verifySaved func(t *testing.T, series *rounddb.RoundSeries)
+	}{
+		{
+			name: "success - applies defaults and canonical rule",
+			req: &CreateRoundSeriesRequest{
+				GuildID:        guildID,
+				UserID:         "admin",
+				Title:          "Tuesday Doubles",
+				Location:       "Maple Hill",
+				RecurrenceRule: "rrule:freq=weekly;byday=tu",
+				Timezone:       "America/Chicago",
+				FirstStart:     firstStart,
+			},
+			verifySaved: func(t *testing.T, series *rounddb.RoundSeries) {
+				if series.RecurrenceRule != "FREQ=WEEKLY;BYDAY=TU" {
+					t.Errorf("expected canonical rule, got %q", series.RecurrenceRule)
+				}
+				if series.MaterializeDaysAhead != defaultSeriesMaterializeDaysAhead {
+					t.Errorf("expected default days ahead %d, got %d", defaultSeriesMaterializeDaysAhead, series.MaterializeDaysAhead)
+				}
+				if series.Mode != sharedtypes.RoundModeSingles {
+					t.Errorf("expected SINGLES mode, got %q", series.Mode)
+				}
+				if series.EventType != roundtypes.DefaultEventType {
+					t.Errorf("expected default event type, got %q", series.EventType)
+				}
+				if !series.Active {
+					t.Error("expected new series to be active")
+				}
+			},
+		},
+		{
+			name: "failure - invalid recurrence rule",
+			req: &CreateRoundSeriesRequest{
+				GuildID:        guildID,
+				Title:          "Weekly",
+				Location:       "Park",
+				RecurrenceRule: "FREQ=HOURLY",
+				Timezone:       "UTC",
+				FirstStart:     firstStart,
+			},
+			expectFail: ErrInvalidRoundSeries,
+		},
+		{
+			name: "failure - unknown timezone",
+			req: &CreateRoundSeriesRequest{
+				GuildID:        guildID,
+				Title:          "Weekly",
+				Location:       "Park",
+				RecurrenceRule: "FREQ=WEEKLY",
+				Timezone:       "Mars/Olympus",
+				FirstStart:     firstStart,
+			},
+			expectFail: ErrInvalidRoundSeries,
+		},
+		{
+			name: "failure - materialization window too large",
+			req: &CreateRoundSeriesRequest{
+				GuildID:              guildID,
+				Title:                "Weekly",
+				Location:             "Park",
+				RecurrenceRule:       "FREQ=WEEKLY",
+				Timezone:             "UTC",
+				FirstStart:           firstStart,
+				MaterializeDaysAhead: maxSeriesMaterializeDaysAhead + 1,
+			},
+			expectFail: ErrInvalidRoundSeries,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewFakeRepo()
+			var saved *rounddb.RoundSeries
+			repo.CreateRoundSeriesFunc = func(ctx context.Context, db bun.IDB, series *rounddb.RoundSeries) error {
+				saved = series
+				return nil
+			}
+
+			s := newRoundSeriesTestService(repo, NewFakeQueueService())
+			res, err := s.CreateRoundSeries(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("unexpected infra error: %v", err)
+			}
+
+			if tt.expectFail != nil {
+				if res.Failure == nil || !errors.Is(*res.Failure, tt.expectFail) {
+					t.Fatalf("expected failure %v, got %+v", tt.expectFail, res)
+				}
+				if saved != nil {
+					t.Fatal("expected series not to be persisted")
+				}
+				return
+			}
+
+			if res.Success == nil {
+				t.Fatalf("expected success, got failure %v", res.Failure)
+			}
+			if saved == nil {
+				t.Fatal("expected series to be persisted")
+			}
+			tt.verifySaved(t, saved)
+		})
+	}
+}
+
+func TestRoundService_MaterializeRoundSeries(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	seriesID := uuid.New()
+
+	// Series runs every Tuesday at 18:30 UTC; "now" is Monday the day before an occurrence.
+	dtstart := time.Date(2026, 10, 6, 18, 30, 0, 0, time.UTC)
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	nextOccurrence := time.Date(2026, 10, 20, 18, 30, 0, 0, time.UTC)
+
+	baseSeries := func() *rounddb.RoundSeries {
+		return &rounddb.RoundSeries{
+			ID:                   seriesID,
+			GuildID:              guildID,
+			Title:                "Tuesday League",
+			Location:             "Maple Hill",
+			EventType:            roundtypes.DefaultEventType,
+			Mode:                 sharedtypes.RoundModeSingles,
+			RecurrenceRule:       "FREQ=WEEKLY;BYDAY=TU",
+			Timezone:             "UTC",
+			DTStart:              dtstart,
+			MaterializeDaysAhead: 3,
+			ChannelID:            "channel-1",
+			CreatedBy:            "admin",
+			Active:               true,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		series       func() *rounddb.RoundSeries
+		setup        func(*FakeRepo)
+		expectRounds int
+		verify       func(t *testing.T, out *MaterializeRoundSeriesOutput, repo *FakeRepo, stored []*roundtypes.Round)
+	}{
+		{
+			name:         "creates round for occurrence inside window",
+			series:       baseSeries,
+			expectRounds: 1,
+			verify: func(t *testing.T, out *MaterializeRoundSeriesOutput, repo *FakeRepo, stored []*roundtypes.Round) {
+				r := stored[0]
+				if !r.StartTime.AsTime().Equal(nextOccurrence) {
+					t.Errorf("expected start %v, got %v", nextOccurrence, r.StartTime.AsTime())
+				}
+				if r.Title != "Tuesday League" || r.State != roundtypes.RoundStateUpcoming {
+					t.Errorf("unexpected round %+v", r)
+				}
+				if out.Rounds[0].ChannelID != "channel-1" || out.Rounds[0].SeriesID != seriesID {
+					t.Errorf("unexpected materialized metadata %+v", out.Rounds[0])
+				}
+				if hasCall(repo.Trace(), "SetRoundMode") {
+					t.Error("singles series should not update round mode")
+				}
+			},
+		},
+		{
+			name:   "already claimed occurrence is not duplicated",
+			series: baseSeries,
+			setup: func(r *FakeRepo) {
+				r.ClaimRoundSeriesOccurrenceFunc = func(ctx context.Context, db bun.IDB, occ *rounddb.RoundSeriesOccurrence) (bool, error) {
+					return false, nil
+				}
+			},
+			expectRounds: 0,
+		},
+		{
+			name:   "skipped occurrence is not materialized",
+			series: baseSeries,
+			setup: func(r *FakeRepo) {
+				r.GetRoundSeriesOccurrencesFunc = func(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*rounddb.RoundSeriesOccurrence, error) {
+					return []*rounddb.RoundSeriesOccurrence{{SeriesID: id, OccurrenceAt: nextOccurrence, Status: rounddb.RoundSeriesOccurrenceSkipped}}, nil
+				}
+			},
+			expectRounds: 0,
+		},
+		{
+			name:   "override is applied to materialized round",
+			series: baseSeries,
+			setup: func(r *FakeRepo) {
+				title := roundtypes.Title("Moved to Oak Park")
+				location := roundtypes.Location("Oak Park")
+				r.GetRoundSeriesOccurrencesFunc = func(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*rounddb.RoundSeriesOccurrence, error) {
+					return []*rounddb.RoundSeriesOccurrence{{
+						SeriesID:         id,
+						OccurrenceAt:     nextOccurrence,
+						Status:           rounddb.RoundSeriesOccurrenceScheduled,
+						OverrideTitle:    &title,
+						OverrideLocation: &location,
+					}}, nil
+				}
+			},
+			expectRounds: 1,
+			verify: func(t *testing.T, out *MaterializeRoundSeriesOutput, repo *FakeRepo, stored []*roundtypes.Round) {
+				if stored[0].Title != "Moved to Oak Park" || stored[0].Location != "Oak Park" {
+					t.Errorf("expected override to be applied, got %+v", stored[0])
+				}
+				if hasCall(repo.Trace(), "ClaimRoundSeriesOccurrence") {
+					t.Error("overridden occurrence should be updated in place, not claimed")
+				}
+			},
+		},
+		{
+			name: "doubles series sets round mode",
+			series: func() *rounddb.RoundSeries {
+				s := baseSeries()
+				s.Mode = sharedtypes.RoundModeDoubles
+				return s
+			},
+			expectRounds: 1,
+			verify: func(t *testing.T, out *MaterializeRoundSeriesOutput, repo *FakeRepo, stored []*roundtypes.Round) {
+				if !hasCall(repo.Trace(), "SetRoundMode") {
+					t.Error("expected doubles series to set round mode")
+				}
+			},
+		},
+		{
+			name:   "claim is released when storing the round fails",
+			series: baseSeries,
+			setup: func(r *FakeRepo) {
+				r.CreateRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, round *roundtypes.Round) error {
+					return errors.New("insert failed")
+				}
+			},
+			expectRounds: 0,
+			verify: func(t *testing.T, out *MaterializeRoundSeriesOutput, repo *FakeRepo, stored []*roundtypes.Round) {
+				if !hasCall(repo.Trace(), "DeleteRoundSeriesOccurrence") {
+					t.Error("expected failed occurrence claim to be released")
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewFakeRepo()
+			series := tt.series()
+			repo.ListActiveRoundSeriesFunc = func(ctx context.Context, db bun.IDB) ([]*rounddb.RoundSeries, error) {
+				return []*rounddb.RoundSeries{series}, nil
+			}
+			var stored []*roundtypes.Round
+			repo.CreateRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, round *roundtypes.Round) error {
+				stored = append(stored, round)
+				return nil
+			}
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+
+			s := newRoundSeriesTestService(repo, NewFakeQueueService())
+			res, err := s.MaterializeRoundSeries(ctx, now)
+			if err != nil {
+				t.Fatalf("unexpected infra error: %v", err)
+			}
+			if res.Success == nil {
+				t.Fatalf("expected success, got failure %v", res.Failure)
+			}
+
+			out := *res.Success
+			if len(out.Rounds) != tt.expectRounds {
+				t.Fatalf("expected %d rounds, got %d", tt.expectRounds, len(out.Rounds))
+			}
+			if tt.verify != nil {
+				tt.verify(t, out, repo, stored)
+			}
+		})
+	}
+}
+
+func TestRoundService_SkipRoundSeriesOccurrence(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	seriesID := uuid.New()
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	occurrence := time.Now().UTC().Truncate(time.Minute).Add(72 * time.Hour)
+	series := &rounddb.RoundSeries{
+		ID:             seriesID,
+		GuildID:        guildID,
+		RecurrenceRule: "FREQ=DAILY",
+		Timezone:       "UTC",
+		DTStart:        occurrence.AddDate(0, 0, -7),
+		Active:         true,
+	}
+
+	tests := []struct {
+		name         string
+		occurrenceAt time.Time
+		setup        func(*FakeRepo)
+		expectFail   error
+		verify       func(t *testing.T, info *RoundSeriesOccurrenceInfo, repo *FakeRepo, queue *FakeQueueService)
+	}{
+		{
+			name:         "skips occurrence that has not been materialized",
+			occurrenceAt: occurrence,
+			verify: func(t *testing.T, info *RoundSeriesOccurrenceInfo, repo *FakeRepo, queue *FakeQueueService) {
+				if info.DeletedRound != nil {
+					t.Error("expected no round to be deleted")
+				}
+				if !hasCall(repo.Trace(), "UpsertRoundSeriesOccurrence") {
+					t.Error("expected skip to be recorded")
+				}
+			},
+		},
+		{
+			name:         "deletes materialized upcoming round",
+			occurrenceAt: occurrence,
+			setup: func(r *FakeRepo) {
+				r.GetRoundSeriesOccurrencesFunc = func(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*rounddb.RoundSeriesOccurrence, error) {
+					rid := roundID
+					return []*rounddb.RoundSeriesOccurrence{{SeriesID: id, OccurrenceAt: occurrence, Status: rounddb.RoundSeriesOccurrenceMaterialized, RoundID: &rid}}, nil
+				}
+				r.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, id sharedtypes.RoundID) (*roundtypes.Round, error) {
+					return &roundtypes.Round{ID: id, GuildID: g, State: roundtypes.RoundStateUpcoming, EventMessageID: "msg-1"}, nil
+				}
+			},
+			verify: func(t *testing.T, info *RoundSeriesOccurrenceInfo, repo *FakeRepo, queue *FakeQueueService) {
+				if info.DeletedRound == nil || info.DeletedRound.ID != roundID {
+					t.Fatalf("expected deleted round %s, got %+v", roundID, info.DeletedRound)
+				}
+				if !hasCall(repo.Trace(), "DeleteRound") {
+					t.Error("expected round to be deleted")
+				}
+				if !hasCall(queue.Trace(), "CancelRoundJobs") {
+					t.Error("expected round jobs to be cancelled")
+				}
+			},
+		},
+		{
+			name:         "rejects started round",
+			occurrenceAt: occurrence,
+			setup: func(r *FakeRepo) {
+				r.GetRoundSeriesOccurrencesFunc = func(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*rounddb.RoundSeriesOccurrence, error) {
+					rid := roundID
+					return []*rounddb.RoundSeriesOccurrence{{SeriesID: id, OccurrenceAt: occurrence, Status: rounddb.RoundSeriesOccurrenceMaterialized, RoundID: &rid}}, nil
+				}
+				r.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, id sharedtypes.RoundID) (*roundtypes.Round, error) {
+					return &roundtypes.Round{ID: id, GuildID: g, State: roundtypes.RoundStateInProgress}, nil
+				}
+			},
+			expectFail: ErrRoundAlreadyStarted,
+		},
+		{
+			name:         "rejects time that is not an occurrence",
+			occurrenceAt: occurrence.Add(time.Hour),
+			expectFail:   ErrOccurrenceNotInSeries,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewFakeRepo()
+			queue := NewFakeQueueService()
+			repo.GetRoundSeriesFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, id uuid.UUID) (*rounddb.RoundSeries, error) {
+				return series, nil
+			}
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+
+			s := newRoundSeriesTestService(repo, queue)
+			res, err := s.SkipRoundSeriesOccurrence(ctx, &RoundSeriesOccurrenceRequest{
+				GuildID:      guildID,
+				SeriesID:     seriesID,
+				OccurrenceAt: tt.occurrenceAt,
+			})
+			if err != nil {
+				t.Fatalf("unexpected infra error: %v", err)
+			}
+
+			if tt.expectFail != nil {
+				if res.Failure == nil || !errors.Is(*res.Failure, tt.expectFail) {
+					t.Fatalf("expected failure %v, got %+v", tt.expectFail, res)
+				}
+				return
+			}
+			if res.Success == nil {
+				t.Fatalf("expected success, got failure %v", res.Failure)
+			}
+			tt.verify(t, *res.Success, repo, queue)
+		})
+	}
+}
+
+func TestRoundService_UpdateRoundSeries(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	seriesID := uuid.New()
+	plainRoundID := sharedtypes.RoundID(uuid.New())
+	overriddenRoundID := sharedtypes.RoundID(uuid.New())
+
+	upcoming := time.Now().UTC().Truncate(time.Hour).Add(48 * time.Hour)
+	newTitle := roundtypes.Title("Renamed League")
+	overrideTitle := roundtypes.Title("Special Edition")
+
+	repo := NewFakeRepo()
+	repo.GetRoundSeriesFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, id uuid.UUID) (*rounddb.RoundSeries, error) {
+		return &rounddb.RoundSeries{
+			ID:             seriesID,
+			GuildID:        guildID,
+			Title:          "League",
+			Location:       "Maple Hill",
+			RecurrenceRule: "FREQ=DAILY",
+			Timezone:       "UTC",
+			DTStart:        upcoming.AddDate(0, 0, -7),
+			Active:         true,
+		}, nil
+	}
+	repo.GetRoundSeriesOccurrencesFunc = func(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*rounddb.RoundSeriesOccurrence, error) {
+		plain, overridden := plainRoundID, overriddenRoundID
+		return []*rounddb.RoundSeriesOccurrence{
+			{SeriesID: id, OccurrenceAt: upcoming, Status: rounddb.RoundSeriesOccurrenceMaterialized, RoundID: &plain},
+			{SeriesID: id, OccurrenceAt: upcoming.AddDate(0, 0, 1), Status: rounddb.RoundSeriesOccurrenceMaterialized, RoundID: &overridden, OverrideTitle: &overrideTitle},
+		}, nil
+	}
+	repo.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, id sharedtypes.RoundID) (*roundtypes.Round, error) {
+		start := sharedtypes.StartTime(upcoming)
+		return &roundtypes.Round{ID: id, GuildID: g, State: roundtypes.RoundStateUpcoming, StartTime: &start}, nil
+	}
+	var updatedIDs []sharedtypes.RoundID
+	repo.UpdateRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, id sharedtypes.RoundID, r *roundtypes.Round) (*roundtypes.Round, error) {
+		updatedIDs = append(updatedIDs, id)
+		if r.Title != newTitle {
+			t.Errorf("expected propagated title %q, got %q", newTitle, r.Title)
+		}
+		if r.StartTime != nil {
+			t.Error("title-only edit should not move the start time")
+		}
+		return &roundtypes.Round{ID: id, GuildID: g, Title: r.Title}, nil
+	}
+
+	s := newRoundSeriesTestService(repo, NewFakeQueueService())
+	res, err := s.UpdateRoundSeries(ctx, &UpdateRoundSeriesRequest{
+		GuildID:  guildID,
+		SeriesID: seriesID,
+		Title:    &newTitle,
+	})
+	if err != nil {
+		t.Fatalf("unexpected infra error: %v", err)
+	}
+	if res.Success == nil {
+		t.Fatalf("expected success, got failure %v", res.Failure)
+	}
+
+	out := *res.Success
+	if out.Series.Title != newTitle {
+		t.Errorf("expected series title %q, got %q", newTitle, out.Series.Title)
+	}
+	if len(updatedIDs) != 1 || updatedIDs[0] != plainRoundID {
+		t.Errorf("expected only the non-overridden round to be updated, got %v", updatedIDs)
+	}
+	if len(out.RescheduledRounds) != 0 {
+		t.Errorf("expected no rescheduled rounds, got %d", len(out.RescheduledRounds))
+	}
+}
@@ -167,6 +167,17 @@ func (s *RoundService) ScheduleRoundEvents(ctx context.Context, req *roundtypes.
 			)
 		}
 
+		// Queue the check-in window opening for rounds with check-in enabled.
+		if s.repo != nil {
+			if err := s.scheduleCheckInOpen(ctx, s.db, req.GuildID, req.RoundID, startTimeUTC); err != nil {
+				s.logger.ErrorContext(ctx, "Failed to schedule check-in window",
+					attr.RoundID("round_id", req.RoundID),
+					attr.Error(err),
+				)
+				return results.OperationResult[*roundtypes.ScheduleRoundEventsResult, error]{}, err
+			}
+		}
+
 		// Return success with the original payload
 		return results.SuccessResult[*roundtypes.ScheduleRoundEventsResult, error](&roundtypes.ScheduleRoundEventsResult{
 			RoundID:        req.RoundID,
@@ -50,7 +50,8 @@ func (s *RoundService) ValidateScoreUpdateRequest(ctx context.Context, req *roun
 	return result, err
 }
 
-// UpdateParticipantScore updates the participant's score in the database.
+// UpdateParticipantScore updates the participant's score in the database. In a
+// doubles round the score is applied to the participant's whole team.
 func (s *RoundService) UpdateParticipantScore(ctx context.Context, req *roundtypes.ScoreUpdateRequest) (ScoreUpdateResult, error) {
 	result, err := withTelemetry[*roundtypes.ScoreUpdateResult, error](s, ctx, "UpdateParticipantScore", req.RoundID, func(ctx context.Context) (ScoreUpdateResult, error) {
 		return runInTx[*roundtypes.ScoreUpdateResult, error](s, ctx, func(ctx context.Context, tx bun.IDB) (ScoreUpdateResult, error) {
@@ -72,12 +73,26 @@ func (s *RoundService) UpdateParticipantScore(ctx context.Context, req *roundtyp
 			}
 
 			// Check if participant is already in the round
-			isParticipant := false
-			for _, p := range round.Participants {
-				if p.UserID == req.UserID {
-					isParticipant = true
-					break
+			existing, isParticipant := findParticipant(round.Participants, req.UserID)
+
+			// Doubles: a score from any team member is the team's score.
+			if isParticipant && existing.TeamID != uuid.Nil && req.Score != nil {
+				updatedParticipants, err := s.applyTeamScore(ctx, tx, round, existing.TeamID, req.Score)
+				if err != nil {
+					s.logger.ErrorContext(ctx, "Failed to update team score in DB",
+						attr.RoundID("round_id", req.RoundID),
+						attr.String("guild_id", string(req.GuildID)),
+						attr.String("user_id", string(req.UserID)),
+						attr.Error(err),
+					)
+					return results.FailureResult[*roundtypes.ScoreUpdateResult, error](err), nil
 				}
+				return results.SuccessResult[*roundtypes.ScoreUpdateResult, error](&roundtypes.ScoreUpdateResult{
+					GuildID:             req.GuildID,
+					RoundID:             req.RoundID,
+					EventMessageID:      round.EventMessageID,
+					UpdatedParticipants: updatedParticipants,
+				}), nil
 			}
 
 			// Prepare participant object for update/upsert
@@ -195,10 +210,16 @@ func (s *RoundService) CheckAllScoresSubmitted(ctx context.Context, req *roundty
 			return results.FailureResult[*roundtypes.AllScoresSubmittedResult, error](err), nil
 		}
 
+		// No-shows recorded at start are not required to score.
+		noShows, err := s.roundNoShows(ctx, nil, req.GuildID, req.RoundID)
+		if err != nil {
+			return results.FailureResult[*roundtypes.AllScoresSubmittedResult, error](err), nil
+		}
+
 		allSubmitted := true
 		for _, p := range participants {
 			// Only check participants who have accepted the invite
-			if p.Response == roundtypes.ResponseAccept && p.Score == nil {
+			if p.Response == roundtypes.ResponseAccept && !noShows[p.UserID] && p.Score == nil {
 				allSubmitted = false
 				break
 			}
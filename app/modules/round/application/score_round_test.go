@@ -11,6 +11,7 @@ import (
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"go.opentelemetry.io/otel/trace/noop"
@@ -410,6 +411,34 @@ func TestRoundService_CheckAllScoresSubmitted(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "no-shows are not waited on",
+			setup: func(f *FakeRepo) {
+				f.GetParticipantsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) ([]roundtypes.Participant, error) {
+					return []roundtypes.Participant{
+						{UserID: sharedtypes.DiscordID("user1"), Response: roundtypes.ResponseAccept, Score: &testScore},
+						{UserID: sharedtypes.DiscordID("user2"), Response: roundtypes.ResponseAccept, Score: nil},
+					}, nil
+				}
+				f.GetRoundCheckInsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) ([]*rounddb.RoundCheckIn, error) {
+					return []*rounddb.RoundCheckIn{
+						{RoundID: r, GuildID: g, UserID: "user1", Status: rounddb.CheckInStatusCheckedIn},
+						{RoundID: r, GuildID: g, UserID: "user2", Status: rounddb.CheckInStatusNoShow},
+					}, nil
+				}
+				f.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+					return &roundtypes.Round{ID: testScoreRoundID, GuildID: g}, nil
+				}
+			},
+			payload: &roundtypes.CheckAllScoresSubmittedRequest{
+				GuildID: sharedtypes.GuildID("guild-123"),
+				RoundID: testScoreRoundID,
+			},
+			expectedResult: results.OperationResult[*roundtypes.AllScoresSubmittedResult, error]{
+				Success: ptr(&roundtypes.AllScoresSubmittedResult{IsComplete: true}),
+			},
+			expectedError: nil,
+		},
 		{
 			name: "error checking if all scores submitted",
 			setup: func(f *FakeRepo) {
@@ -462,6 +491,10 @@ func TestRoundService_CheckAllScoresSubmitted(t *testing.T) {
 			if tt.expectedResult.Failure != nil && result.Failure == nil {
 				t.Errorf("expected failure result, got success")
 			}
+			if tt.expectedResult.Success != nil && result.Success != nil &&
+				(*result.Success).IsComplete != (*tt.expectedResult.Success).IsComplete {
+				t.Errorf("expected IsComplete %v, got %v", (*tt.expectedResult.Success).IsComplete, (*result.Success).IsComplete)
+			}
 		})
 	}
 }
@@ -0,0 +1,49 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+// SetRoundScoringMode switches a round between gross and net ranking. The score
+// module reads the mode when the round's scores are processed, so it can only be
+// changed before the round is finalized.
+func (s *RoundService) SetRoundScoringMode(ctx context.Context, req *SetRoundScoringModeRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+	return withTelemetry(s, ctx, "SetRoundScoringMode", req.RoundID, func(ctx context.Context) (results.OperationResult[*roundtypes.Round, error], error) {
+		if req.Mode != ScoringModeGross && req.Mode != ScoringModeNet {
+			return results.FailureResult[*roundtypes.Round, error](ErrInvalidScoringMode), nil
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, tx bun.IDB) (results.OperationResult[*roundtypes.Round, error], error) {
+			round, err := s.repo.GetRoundForUpdate(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				if errors.Is(err, rounddb.ErrNotFound) {
+					return results.FailureResult[*roundtypes.Round, error](ErrRoundNotFound), nil
+				}
+				return results.OperationResult[*roundtypes.Round, error]{}, fmt.Errorf("failed to fetch round: %w", err)
+			}
+			if round.Finalized {
+				return results.FailureResult[*roundtypes.Round, error](ErrRoundAlreadyFinalized), nil
+			}
+
+			if err := s.repo.SetRoundScoringMode(ctx, tx, req.GuildID, req.RoundID, req.Mode); err != nil {
+				return results.OperationResult[*roundtypes.Round, error]{}, fmt.Errorf("failed to update scoring mode: %w", err)
+			}
+
+			s.logger.InfoContext(ctx, "Round scoring mode updated",
+				attr.RoundID("round_id", req.RoundID),
+				attr.String("guild_id", string(req.GuildID)),
+				attr.String("scoring_mode", req.Mode),
+			)
+
+			return results.SuccessResult[*roundtypes.Round, error](round), nil
+		})
+	})
+}
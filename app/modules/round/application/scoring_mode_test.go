@@ -0,0 +1,94 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	roundmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/round"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestRoundService_SetRoundScoringMode(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	upcoming := func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+		return &roundtypes.Round{ID: r, GuildID: g, State: roundtypes.RoundStateUpcoming}, nil
+	}
+
+	tests := []struct {
+		name      string
+		mode      string
+		setupRepo func(f *FakeRepo)
+		wantErr   error
+		wantTrace []string
+	}{
+		{
+			name:      "switches to net",
+			mode:      ScoringModeNet,
+			setupRepo: func(f *FakeRepo) { f.GetRoundForUpdateFunc = upcoming },
+			wantTrace: []string{"GetRoundForUpdate", "SetRoundScoringMode"},
+		},
+		{
+			name:      "rejects unknown modes",
+			mode:      "stableford",
+			wantErr:   ErrInvalidScoringMode,
+			wantTrace: []string{},
+		},
+		{
+			name: "rejects finalized rounds",
+			mode: ScoringModeGross,
+			setupRepo: func(f *FakeRepo) {
+				f.GetRoundForUpdateFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+					return &roundtypes.Round{ID: r, GuildID: g, Finalized: true}, nil
+				}
+			},
+			wantErr:   ErrRoundAlreadyFinalized,
+			wantTrace: []string{"GetRoundForUpdate"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewFakeRepo()
+			if tt.setupRepo != nil {
+				tt.setupRepo(repo)
+			}
+			s := &RoundService{
+				repo:    repo,
+				logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+				metrics: &roundmetrics.NoOpMetrics{},
+				tracer:  noop.NewTracerProvider().Tracer("test"),
+			}
+
+			res, err := s.SetRoundScoringMode(ctx, &SetRoundScoringModeRequest{GuildID: guildID, RoundID: roundID, Mode: tt.mode})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantErr != nil {
+				if res.Failure == nil {
+					t.Fatalf("expected failure %v, got success", tt.wantErr)
+				}
+				if !errors.Is(*res.Failure, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, *res.Failure)
+				}
+			} else if res.Success == nil {
+				t.Fatalf("expected success, got failure: %v", *res.Failure)
+			}
+
+			if !slices.Equal(repo.Trace(), tt.wantTrace) {
+				t.Errorf("expected trace %v, got %v", tt.wantTrace, repo.Trace())
+			}
+		})
+	}
+}
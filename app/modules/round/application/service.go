@@ -36,6 +36,8 @@ type RoundService struct {
 	tracer              trace.Tracer
 	roundValidator      roundutil.RoundValidator
 	guildConfigProvider GuildConfigProvider
+	courseLayouts       CourseLayoutResolver
+	ratings             PlayerRatings
 	parserFactory       parsers.ParserFactory
 	db                  *bun.DB
 	downloadClient      *http.Client
@@ -167,6 +169,18 @@ func (s *RoundService) WithGuildConfigProvider(p GuildConfigProvider) *RoundServ
 	return s
 }
 
+// WithCourseLayoutResolver injects the course registry used to link rounds to layouts (fluent style)
+func (s *RoundService) WithCourseLayoutResolver(r CourseLayoutResolver) *RoundService {
+	s.courseLayouts = r
+	return s
+}
+
+// WithPlayerRatings injects the ratings used to balance cards (fluent style)
+func (s *RoundService) WithPlayerRatings(r PlayerRatings) *RoundService {
+	s.ratings = r
+	return s
+}
+
 // getGuildConfigForEnrichment attempts to retrieve a guild config for adding config fragments
 // to outbound round events.
 func (s *RoundService) getGuildConfigForEnrichment(ctx context.Context, guildID sharedtypes.GuildID) *guildtypes.GuildConfig {
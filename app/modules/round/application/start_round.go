@@ -6,6 +6,7 @@ import (
 
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
 	"github.com/uptrace/bun"
 )
@@ -19,6 +20,9 @@ func (s *RoundService) StartRound(
 	guildID := req.GuildID
 	roundID := req.RoundID
 	alreadyStarted := false
+	var teams []DrawnTeam
+	var cards []Card
+	var noShows []roundtypes.Participant
 
 	startOp := func(ctx context.Context, db bun.IDB) (results.OperationResult[*roundtypes.Round, error], error) {
 		s.logger.InfoContext(ctx, "Processing round start",
@@ -68,6 +72,45 @@ func (s *RoundService) StartRound(
 		// Update local object state to reflect DB change
 		round.State = roundtypes.RoundStateInProgress
 
+		// Accepted players who did not check in are no-shows and are left out of
+		// the draw, the cards and the scores.
+		noShows, err = s.markNoShows(ctx, db, round)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "Failed to mark no-shows",
+				attr.RoundID("round_id", roundID),
+				attr.String("guild_id", string(guildID)),
+				attr.Error(err),
+			)
+			return results.OperationResult[*roundtypes.Round, error]{}, err
+		}
+
+		absent := make(map[sharedtypes.DiscordID]bool, len(noShows))
+		for _, p := range noShows {
+			absent[p.UserID] = true
+		}
+
+		// Draw doubles teams for blind draw rounds.
+		teams, err = s.drawRoundTeams(ctx, db, round, absent)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "Failed to draw doubles teams",
+				attr.RoundID("round_id", roundID),
+				attr.String("guild_id", string(guildID)),
+				attr.Error(err),
+			)
+			return results.OperationResult[*roundtypes.Round, error]{}, err
+		}
+
+		// Split accepted players into cards when the round has a card size.
+		cards, err = s.assignRoundCards(ctx, db, round, absent)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "Failed to assign round cards",
+				attr.RoundID("round_id", roundID),
+				attr.String("guild_id", string(guildID)),
+				attr.Error(err),
+			)
+			return results.OperationResult[*roundtypes.Round, error]{}, err
+		}
+
 		return results.SuccessResult[*roundtypes.Round, error](round), nil
 	}
 
@@ -77,5 +120,8 @@ func (s *RoundService) StartRound(
 	return StartRoundResult{
 		OperationResult: result,
 		AlreadyStarted:  alreadyStarted,
+		Teams:           teams,
+		Cards:           cards,
+		NoShows:         noShows,
 	}, err
 }
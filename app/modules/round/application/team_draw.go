@@ -0,0 +1,205 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Team draw settings for doubles rounds.
+const (
+	// TeamDrawOff keeps teams as imported, if any.
+	TeamDrawOff = "off"
+	// TeamDrawRandom pairs accepted players at random when the round starts.
+	TeamDrawRandom = "random"
+	// TeamDrawSeeded pairs a random top-half tag with a random bottom-half tag.
+	TeamDrawSeeded = "seeded"
+)
+
+// DrawnTeam is a doubles team drawn when the round started. A Cali team is a lone
+// player left over from an odd field, who plays both shots.
+type DrawnTeam struct {
+	Number  int                      `json:"number"`
+	TeamID  uuid.UUID                `json:"team_id"`
+	Players []roundtypes.Participant `json:"players"`
+	Cali    bool                     `json:"cali,omitempty"`
+}
+
+// ConfigureTeamDraw turns the blind draw on or off for a round. Turning it on makes
+// the round a doubles round; the teams are drawn when it starts.
+func (s *RoundService) ConfigureTeamDraw(ctx context.Context, req *ConfigureTeamDrawRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+	return withTelemetry(s, ctx, "ConfigureTeamDraw", req.RoundID, func(ctx context.Context) (results.OperationResult[*roundtypes.Round, error], error) {
+		if req.Draw != TeamDrawOff && req.Draw != TeamDrawRandom && req.Draw != TeamDrawSeeded {
+			return results.FailureResult[*roundtypes.Round, error](ErrInvalidTeamDraw), nil
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, tx bun.IDB) (results.OperationResult[*roundtypes.Round, error], error) {
+			round, err := s.repo.GetRoundForUpdate(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				if errors.Is(err, rounddb.ErrNotFound) {
+					return results.FailureResult[*roundtypes.Round, error](ErrRoundNotFound), nil
+				}
+				return results.OperationResult[*roundtypes.Round, error]{}, fmt.Errorf("failed to fetch round: %w", err)
+			}
+			if round.Finalized {
+				return results.FailureResult[*roundtypes.Round, error](ErrRoundAlreadyFinalized), nil
+			}
+			if round.State == roundtypes.RoundStateInProgress {
+				return results.FailureResult[*roundtypes.Round, error](ErrRoundAlreadyStarted), nil
+			}
+
+			if err := s.repo.SetRoundTeamDraw(ctx, tx, req.GuildID, req.RoundID, req.Draw); err != nil {
+				return results.OperationResult[*roundtypes.Round, error]{}, fmt.Errorf("failed to update team draw: %w", err)
+			}
+			if req.Draw != TeamDrawOff {
+				if err := s.repo.SetRoundMode(ctx, tx, req.GuildID, req.RoundID, sharedtypes.RoundModeDoubles); err != nil {
+					return results.OperationResult[*roundtypes.Round, error]{}, err
+				}
+			}
+
+			s.logger.InfoContext(ctx, "Round team draw updated",
+				attr.RoundID("round_id", req.RoundID),
+				attr.String("guild_id", string(req.GuildID)),
+				attr.String("team_draw", req.Draw),
+			)
+
+			return results.SuccessResult[*roundtypes.Round, error](round), nil
+		})
+	})
+}
+
+// drawRoundTeams pairs the round's accepted players, less any no-shows, into
+// doubles teams when the round is a doubles round with a team draw. Team IDs are stored on the
+// participants, from which the round's teams are derived, and the teams replace
+// the round's entry groups. It returns nil when there is nothing to draw.
+func (s *RoundService) drawRoundTeams(ctx context.Context, db bun.IDB, round *roundtypes.Round, noShows map[sharedtypes.DiscordID]bool) ([]DrawnTeam, error) {
+	mode, draw, err := s.repo.GetRoundTeamDraw(ctx, db, round.GuildID, round.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch team draw: %w", err)
+	}
+	if mode != sharedtypes.RoundModeDoubles || draw == "" || draw == TeamDrawOff {
+		return nil, nil
+	}
+
+	players := playingParticipants(round.Participants, noShows)
+	if len(players) == 0 {
+		return nil, nil
+	}
+
+	teams := pairTeams(players, draw == TeamDrawSeeded)
+
+	teamOf := make(map[sharedtypes.DiscordID]uuid.UUID, len(players))
+	for _, team := range teams {
+		for _, p := range team.Players {
+			teamOf[p.UserID] = team.TeamID
+		}
+	}
+	participants := make([]roundtypes.Participant, len(round.Participants))
+	for i, p := range round.Participants {
+		p.TeamID = uuid.Nil
+		if p.Response == roundtypes.ResponseAccept {
+			p.TeamID = teamOf[p.UserID]
+		}
+		participants[i] = p
+	}
+	if err := s.repo.UpdateRoundsAndParticipants(ctx, db, round.GuildID, []roundtypes.RoundUpdate{{
+		RoundID:      round.ID,
+		Participants: participants,
+	}}); err != nil {
+		return nil, fmt.Errorf("failed to store drawn teams: %w", err)
+	}
+
+	groups := make([]rounddb.RoundEntryGroup, len(teams))
+	roundTeams := make([]roundtypes.NormalizedTeam, len(teams))
+	for i, team := range teams {
+		groups[i].Name = fmt.Sprintf("Team %d", team.Number)
+		if team.Cali {
+			groups[i].Name += " (cali)"
+		}
+		roundTeams[i] = roundtypes.NormalizedTeam{TeamID: team.TeamID, Members: []roundtypes.TeamMember{}}
+		for j := range team.Players {
+			team.Players[j].TeamID = team.TeamID
+			p := team.Players[j]
+			name := roundtypes.DisplayName(p.UserIDPointer(), p.RawNameString())
+			member := rounddb.RoundGroupParticipant{RawName: name}
+			var userID *sharedtypes.DiscordID
+			if p.UserID != "" {
+				id := p.UserID
+				member.UserID = &id
+				userID = &id
+			}
+			groups[i].Members = append(groups[i].Members, member)
+			roundTeams[i].Members = append(roundTeams[i].Members, roundtypes.TeamMember{UserID: userID, RawName: name})
+		}
+	}
+	if err := s.repo.ReplaceRoundEntryGroups(ctx, db, round.ID, groups); err != nil {
+		return nil, fmt.Errorf("failed to store team groups: %w", err)
+	}
+
+	round.Participants = participants
+	round.Teams = roundTeams
+
+	s.logger.InfoContext(ctx, "Drew doubles teams",
+		attr.RoundID("round_id", round.ID),
+		attr.String("guild_id", string(round.GuildID)),
+		attr.String("team_draw", draw),
+		attr.Int("teams", len(teams)),
+		attr.Bool("cali", len(players)%2 == 1),
+	)
+	return teams, nil
+}
+
+// pairTeams draws doubles teams. With an odd field one random player is left out
+// as a cali and plays alone. Seeded draws sort the rest by tag (untagged last) and
+// pair a random top-half player with a random bottom-half player; otherwise pairs
+// are fully random. The cali team, if any, is numbered last.
+func pairTeams(players []roundtypes.Participant, seeded bool) []DrawnTeam {
+	pool := append([]roundtypes.Participant(nil), players...)
+
+	var cali *roundtypes.Participant
+	if len(pool)%2 == 1 {
+		i := rand.IntN(len(pool))
+		p := pool[i]
+		cali = &p
+		pool = append(pool[:i], pool[i+1:]...)
+	}
+
+	half := len(pool) / 2
+	var top, bottom []roundtypes.Participant
+	if seeded {
+		sortByTag(pool)
+		top, bottom = pool[:half], pool[half:]
+		rand.Shuffle(len(top), func(i, j int) { top[i], top[j] = top[j], top[i] })
+		rand.Shuffle(len(bottom), func(i, j int) { bottom[i], bottom[j] = bottom[j], bottom[i] })
+	} else {
+		rand.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+		top, bottom = pool[:half], pool[half:]
+	}
+
+	teams := make([]DrawnTeam, 0, half+1)
+	for i := 0; i < half; i++ {
+		teams = append(teams, DrawnTeam{
+			Number:  len(teams) + 1,
+			TeamID:  uuid.New(),
+			Players: []roundtypes.Participant{top[i], bottom[i]},
+		})
+	}
+	if cali != nil {
+		teams = append(teams, DrawnTeam{
+			Number:  len(teams) + 1,
+			TeamID:  uuid.New(),
+			Players: []roundtypes.Participant{*cali},
+			Cali:    true,
+		})
+	}
+	return teams
+}
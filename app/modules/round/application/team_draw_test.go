@@ -0,0 +1,149 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+func TestPairTeams(t *testing.T) {
+	t.Run("even field pairs everyone", func(t *testing.T) {
+		teams := pairTeams(acceptedParticipants("a", "b", "c", "d", "e", "f"), false)
+		if len(teams) != 3 {
+			t.Fatalf("expected three teams, got %+v", teams)
+		}
+		seen := map[sharedtypes.DiscordID]bool{}
+		for i, team := range teams {
+			if team.Number != i+1 || len(team.Players) != 2 || team.Cali || team.TeamID == uuid.Nil {
+				t.Fatalf("unexpected team %+v", team)
+			}
+			for _, p := range team.Players {
+				if seen[p.UserID] {
+					t.Fatalf("%s drawn twice", p.UserID)
+				}
+				seen[p.UserID] = true
+			}
+		}
+	})
+
+	t.Run("odd field leaves a cali last", func(t *testing.T) {
+		teams := pairTeams(acceptedParticipants("a", "b", "c", "d", "e"), false)
+		if len(teams) != 3 || !teams[2].Cali || len(teams[2].Players) != 1 {
+			t.Fatalf("expected two pairs and a cali, got %+v", teams)
+		}
+		if teams[0].Cali || teams[1].Cali {
+			t.Fatalf("only the last team is a cali, got %+v", teams)
+		}
+	})
+
+	t.Run("seeded pairs top half with bottom half", func(t *testing.T) {
+		players := []roundtypes.Participant{tagged("a", 1), tagged("b", 2), tagged("c", 3), tagged("d", 4), tagged("e", 5), tagged("f", 6)}
+		top := map[sharedtypes.DiscordID]bool{"a": true, "b": true, "c": true}
+		for run := 0; run < 20; run++ {
+			for _, team := range pairTeams(players, true) {
+				if top[team.Players[0].UserID] == top[team.Players[1].UserID] {
+					t.Fatalf("expected one top-half and one bottom-half player, got %+v", team.Players)
+				}
+			}
+		}
+	})
+}
+
+func TestRoundService_StartRound_DrawsTeams(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+	participants := append(acceptedParticipants("a", "b", "c"), roundtypes.Participant{UserID: "x", Response: roundtypes.ResponseDecline})
+
+	repo := NewFakeRepo()
+	repo.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+		return &roundtypes.Round{ID: r, GuildID: g, State: roundtypes.RoundStateUpcoming, Participants: participants}, nil
+	}
+	repo.GetRoundTeamDrawFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (sharedtypes.RoundMode, string, error) {
+		return sharedtypes.RoundModeDoubles, TeamDrawRandom, nil
+	}
+	var stored []roundtypes.Participant
+	repo.UpdateRoundsAndParticipantsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, updates []roundtypes.RoundUpdate) error {
+		stored = updates[0].Participants
+		return nil
+	}
+	var groups []rounddb.RoundEntryGroup
+	repo.ReplaceRoundEntryGroupsFunc = func(ctx context.Context, db bun.IDB, r sharedtypes.RoundID, g []rounddb.RoundEntryGroup) error {
+		groups = g
+		return nil
+	}
+	s := newCardsTestService(repo)
+
+	res, err := s.StartRound(context.Background(), &roundtypes.StartRoundRequest{GuildID: guildID, RoundID: roundID})
+	if err != nil || res.Success == nil {
+		t.Fatalf("expected success, got %+v, %v", res, err)
+	}
+	if len(res.Teams) != 2 || !res.Teams[1].Cali {
+		t.Fatalf("expected a pair and a cali, got %+v", res.Teams)
+	}
+	if len(groups) != 2 || groups[1].Name != "Team 2 (cali)" || len(groups[0].Members) != 2 {
+		t.Fatalf("unexpected entry groups %+v", groups)
+	}
+
+	teamIDs := map[uuid.UUID]int{}
+	for _, p := range stored {
+		if p.UserID == "x" {
+			if p.TeamID != uuid.Nil {
+				t.Errorf("declined player should not be on a team")
+			}
+			continue
+		}
+		if p.TeamID == uuid.Nil {
+			t.Fatalf("%s was not given a team", p.UserID)
+		}
+		teamIDs[p.TeamID]++
+	}
+	if len(teamIDs) != 2 {
+		t.Fatalf("expected two team IDs, got %v", teamIDs)
+	}
+	if round := *res.Success; len(round.Teams) != 2 {
+		t.Errorf("expected the started round to carry its teams, got %+v", round.Teams)
+	}
+}
+
+func TestRoundService_ConfigureTeamDraw(t *testing.T) {
+	ctx := context.Background()
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	t.Run("enabling the draw makes the round doubles", func(t *testing.T) {
+		repo := NewFakeRepo()
+		var mode sharedtypes.RoundMode
+		var draw string
+		repo.SetRoundModeFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID, m sharedtypes.RoundMode) error {
+			mode = m
+			return nil
+		}
+		repo.SetRoundTeamDrawFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID, d string) error {
+			draw = d
+			return nil
+		}
+		s := newCardsTestService(repo)
+
+		res, err := s.ConfigureTeamDraw(ctx, &ConfigureTeamDrawRequest{GuildID: "guild-1", RoundID: roundID, Draw: TeamDrawSeeded})
+		if err != nil || res.Success == nil {
+			t.Fatalf("expected success, got %+v, %v", res, err)
+		}
+		if draw != TeamDrawSeeded || mode != sharedtypes.RoundModeDoubles {
+			t.Fatalf("expected a seeded doubles round, got %q %q", draw, mode)
+		}
+	})
+
+	t.Run("rejects unknown draws", func(t *testing.T) {
+		s := newCardsTestService(NewFakeRepo())
+
+		res, _ := s.ConfigureTeamDraw(ctx, &ConfigureTeamDrawRequest{GuildID: "guild-1", RoundID: roundID, Draw: "snake"})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrInvalidTeamDraw) {
+			t.Fatalf("expected ErrInvalidTeamDraw, got %+v", res)
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// SubmitTeamScore records one score for a doubles team. The submitter must be a
+// member of the team; the score is copied to every member's participant record so
+// the round's team totals and the all-scores-submitted check see the whole team.
+func (s *RoundService) SubmitTeamScore(ctx context.Context, req *SubmitTeamScoreRequest) (ScoreUpdateResult, error) {
+	return withTelemetry(s, ctx, "SubmitTeamScore", req.RoundID, func(ctx context.Context) (ScoreUpdateResult, error) {
+		if err := validateTeamScoreRequest(req); err != nil {
+			return results.FailureResult[*roundtypes.ScoreUpdateResult, error](err), nil
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, tx bun.IDB) (ScoreUpdateResult, error) {
+			round, err := s.repo.GetRoundForUpdate(ctx, tx, req.GuildID, req.RoundID)
+			if err != nil {
+				if errors.Is(err, rounddb.ErrNotFound) {
+					return results.FailureResult[*roundtypes.ScoreUpdateResult, error](ErrRoundNotFound), nil
+				}
+				return ScoreUpdateResult{}, fmt.Errorf("failed to fetch round: %w", err)
+			}
+			if round.Finalized {
+				return results.FailureResult[*roundtypes.ScoreUpdateResult, error](ErrRoundAlreadyFinalized), nil
+			}
+
+			submitter, ok := findParticipant(round.Participants, req.UserID)
+			if !ok {
+				return results.FailureResult[*roundtypes.ScoreUpdateResult, error](ErrParticipantNotFound), nil
+			}
+			if submitter.TeamID != req.TeamID {
+				return results.FailureResult[*roundtypes.ScoreUpdateResult, error](ErrNotOnTeam), nil
+			}
+
+			participants, err := s.applyTeamScore(ctx, tx, round, req.TeamID, req.Score)
+			if err != nil {
+				return ScoreUpdateResult{}, err
+			}
+
+			s.logger.InfoContext(ctx, "Team score recorded",
+				attr.RoundID("round_id", req.RoundID),
+				attr.String("guild_id", string(req.GuildID)),
+				attr.String("submitted_by", string(req.UserID)),
+				attr.String("team_id", req.TeamID.String()),
+				attr.Int("score", int(*req.Score)),
+			)
+
+			return results.SuccessResult[*roundtypes.ScoreUpdateResult, error](&roundtypes.ScoreUpdateResult{
+				GuildID:             req.GuildID,
+				RoundID:             req.RoundID,
+				EventMessageID:      round.EventMessageID,
+				UpdatedParticipants: participants,
+			}), nil
+		})
+	})
+}
+
+// applyTeamScore sets score on every participant of the team and stores the round's
+// participants, which also refreshes the round's teams. It returns the updated
+// participants.
+func (s *RoundService) applyTeamScore(ctx context.Context, tx bun.IDB, round *roundtypes.Round, teamID uuid.UUID, score *sharedtypes.Score) ([]roundtypes.Participant, error) {
+	participants := make([]roundtypes.Participant, len(round.Participants))
+	for i, p := range round.Participants {
+		if p.TeamID == teamID {
+			value := *score
+			p.Score = &value
+		}
+		participants[i] = p
+	}
+
+	if err := s.repo.UpdateRoundsAndParticipants(ctx, tx, round.GuildID, []roundtypes.RoundUpdate{{
+		RoundID:      round.ID,
+		Participants: participants,
+	}}); err != nil {
+		return nil, fmt.Errorf("failed to store team score: %w", err)
+	}
+	return participants, nil
+}
+
+func validateTeamScoreRequest(req *SubmitTeamScoreRequest) error {
+	if req.RoundID == sharedtypes.RoundID(uuid.Nil) {
+		return ErrInvalidRoundID
+	}
+	if req.UserID == "" {
+		return fmt.Errorf("%w: submitter Discord ID cannot be empty", ErrInvalidScore)
+	}
+	if req.TeamID == uuid.Nil {
+		return fmt.Errorf("%w: team ID cannot be empty", ErrInvalidScore)
+	}
+	if req.Score == nil {
+		return fmt.Errorf("%w: score cannot be empty", ErrInvalidScore)
+	}
+	return nil
+}
+
+func findParticipant(participants []roundtypes.Participant, userID sharedtypes.DiscordID) (roundtypes.Participant, bool) {
+	for _, p := range participants {
+		if p.UserID == userID {
+			return p, true
+		}
+	}
+	return roundtypes.Participant{}, false
+}
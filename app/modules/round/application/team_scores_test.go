@@ -0,0 +1,138 @@
+package roundservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+func doublesRound(guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, teamA, teamB uuid.UUID) *roundtypes.Round {
+	participants := acceptedParticipants("a1", "a2", "b1", "b2")
+	participants[0].TeamID, participants[1].TeamID = teamA, teamA
+	participants[2].TeamID, participants[3].TeamID = teamB, teamB
+	return &roundtypes.Round{ID: roundID, GuildID: guildID, EventMessageID: "msg-1", Participants: participants}
+}
+
+func TestRoundService_SubmitTeamScore(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+	teamA, teamB := uuid.New(), uuid.New()
+	score := sharedtypes.Score(-7)
+
+	setup := func(round *roundtypes.Round) (*RoundService, *[]roundtypes.Participant) {
+		var stored []roundtypes.Participant
+		repo := NewFakeRepo()
+		repo.GetRoundForUpdateFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+			return round, nil
+		}
+		repo.UpdateRoundsAndParticipantsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, updates []roundtypes.RoundUpdate) error {
+			stored = updates[0].Participants
+			return nil
+		}
+		return newCardsTestService(repo), &stored
+	}
+
+	t.Run("copies the score to every team member", func(t *testing.T) {
+		s, stored := setup(doublesRound(guildID, roundID, teamA, teamB))
+
+		res, err := s.SubmitTeamScore(ctx, &SubmitTeamScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "a2", TeamID: teamA, Score: &score})
+		if err != nil || res.Success == nil {
+			t.Fatalf("expected success, got %+v, %v", res, err)
+		}
+		for _, p := range *stored {
+			scored := p.Score != nil && *p.Score == score
+			if scored != (p.TeamID == teamA) {
+				t.Errorf("participant %s: unexpected score %v", p.UserID, p.Score)
+			}
+		}
+		if (*res.Success).EventMessageID != "msg-1" || len((*res.Success).UpdatedParticipants) != 4 {
+			t.Errorf("unexpected result %+v", *res.Success)
+		}
+	})
+
+	t.Run("rejects a submitter from another team", func(t *testing.T) {
+		s, stored := setup(doublesRound(guildID, roundID, teamA, teamB))
+
+		res, _ := s.SubmitTeamScore(ctx, &SubmitTeamScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "b1", TeamID: teamA, Score: &score})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrNotOnTeam) {
+			t.Fatalf("expected ErrNotOnTeam, got %+v", res)
+		}
+		if *stored != nil {
+			t.Error("no scores should be stored")
+		}
+	})
+
+	t.Run("rejects a submitter outside the round", func(t *testing.T) {
+		s, _ := setup(doublesRound(guildID, roundID, teamA, teamB))
+
+		res, _ := s.SubmitTeamScore(ctx, &SubmitTeamScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "x", TeamID: teamA, Score: &score})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrParticipantNotFound) {
+			t.Fatalf("expected ErrParticipantNotFound, got %+v", res)
+		}
+	})
+
+	t.Run("requires a team and a score", func(t *testing.T) {
+		s, _ := setup(doublesRound(guildID, roundID, teamA, teamB))
+
+		for _, req := range []*SubmitTeamScoreRequest{
+			{GuildID: guildID, RoundID: roundID, UserID: "a1", Score: &score},
+			{GuildID: guildID, RoundID: roundID, UserID: "a1", TeamID: teamA},
+		} {
+			res, _ := s.SubmitTeamScore(ctx, req)
+			if res.Failure == nil || !errors.Is(*res.Failure, ErrInvalidScore) {
+				t.Fatalf("expected ErrInvalidScore, got %+v", res)
+			}
+		}
+	})
+
+	t.Run("rejects finalized rounds", func(t *testing.T) {
+		round := doublesRound(guildID, roundID, teamA, teamB)
+		round.Finalized = true
+		s, _ := setup(round)
+
+		res, _ := s.SubmitTeamScore(ctx, &SubmitTeamScoreRequest{GuildID: guildID, RoundID: roundID, UserID: "a1", TeamID: teamA, Score: &score})
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrRoundAlreadyFinalized) {
+			t.Fatalf("expected ErrRoundAlreadyFinalized, got %+v", res)
+		}
+	})
+}
+
+func TestRoundService_UpdateParticipantScore_Doubles(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+	teamA, teamB := uuid.New(), uuid.New()
+	score := sharedtypes.Score(-3)
+
+	var stored []roundtypes.Participant
+	repo := NewFakeRepo()
+	repo.GetRoundFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*roundtypes.Round, error) {
+		return doublesRound(g, r, teamA, teamB), nil
+	}
+	repo.UpdateRoundsAndParticipantsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, updates []roundtypes.RoundUpdate) error {
+		stored = updates[0].Participants
+		return nil
+	}
+	s := newCardsTestService(repo)
+
+	res, err := s.UpdateParticipantScore(context.Background(), &roundtypes.ScoreUpdateRequest{GuildID: guildID, RoundID: roundID, UserID: "b2", Score: &score})
+	if err != nil || res.Success == nil {
+		t.Fatalf("expected success, got %+v, %v", res, err)
+	}
+	for _, p := range stored {
+		scored := p.Score != nil && *p.Score == score
+		if scored != (p.TeamID == teamB) {
+			t.Errorf("participant %s: unexpected score %v", p.UserID, p.Score)
+		}
+	}
+	for _, call := range repo.Trace() {
+		if call == "UpdateParticipant" {
+			t.Error("team scores must be stored for the whole team")
+		}
+	}
+}
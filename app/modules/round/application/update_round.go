@@ -437,6 +437,14 @@ func (s *RoundService) UpdateScheduledRoundEvents(ctx context.Context, req *roun
 			)
 		}
 
+		if err := s.scheduleCheckInOpen(ctx, s.db, req.GuildID, req.RoundID, startTimeUTC); err != nil {
+			s.logger.ErrorContext(ctx, "Failed to reschedule check-in window",
+				attr.RoundID("round_id", req.RoundID),
+				attr.Error(err),
+			)
+			return results.OperationResult[bool, error]{}, err
+		}
+
 		s.logger.InfoContext(ctx, "Round events rescheduled successfully",
 			attr.RoundID("round_id", req.RoundID),
 			attr.Time("reminder_time", reminderTimeUTC),
@@ -0,0 +1,62 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	courseservice "github.com/Black-And-White-Club/frolf-bot/app/modules/course/application"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	"github.com/google/uuid"
+)
+
+// CourseLayoutAdapter adapts the course service to the round service CourseLayoutResolver port.
+type CourseLayoutAdapter struct {
+	courses courseservice.Service
+}
+
+// NewCourseLayoutAdapter constructs a new adapter.
+func NewCourseLayoutAdapter(courses courseservice.Service) *CourseLayoutAdapter {
+	return &CourseLayoutAdapter{courses: courses}
+}
+
+func (a *CourseLayoutAdapter) ResolveLayout(ctx context.Context, guildID sharedtypes.GuildID, courseName string, parScores []int) (*roundservice.CourseLayout, error) {
+	result, err := a.courses.MatchOrCreateLayout(ctx, courseservice.MatchLayoutRequest{
+		GuildID:    guildID,
+		CourseName: courseName,
+		ParScores:  parScores,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return nil, *result.Failure
+	}
+	return toCourseLayout(*result.Success), nil
+}
+
+func (a *CourseLayoutAdapter) GetLayout(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID) (*roundservice.CourseLayout, error) {
+	result, err := a.courses.GetLayout(ctx, guildID, layoutID)
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		if errors.Is(*result.Failure, courseservice.ErrLayoutNotFound) {
+			return nil, nil
+		}
+		return nil, *result.Failure
+	}
+	return toCourseLayout(*result.Success), nil
+}
+
+func toCourseLayout(layout *courseservice.LayoutInfo) *roundservice.CourseLayout {
+	if layout == nil {
+		return nil
+	}
+	return &roundservice.CourseLayout{
+		ID:         layout.ID,
+		CourseName: layout.CourseName,
+		Name:       layout.Name,
+		ParScores:  layout.ParScores(),
+	}
+}
@@ -0,0 +1,31 @@
+package adapters
+
+import (
+	"context"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
+)
+
+// PlayerRatingsAdapter adapts the rating service to the round service PlayerRatings port.
+type PlayerRatingsAdapter struct {
+	ratings ratingservice.Service
+}
+
+// NewPlayerRatingsAdapter constructs a new adapter.
+func NewPlayerRatingsAdapter(ratings ratingservice.Service) *PlayerRatingsAdapter {
+	return &PlayerRatingsAdapter{ratings: ratings}
+}
+
+// GetRatings returns the players' overall (not layout-specific) ratings.
+func (a *PlayerRatingsAdapter) GetRatings(ctx context.Context, guildID sharedtypes.GuildID, memberIDs []sharedtypes.DiscordID) (map[sharedtypes.DiscordID]float64, error) {
+	views, err := a.ratings.GetRatings(ctx, guildID, nil, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+	ratings := make(map[sharedtypes.DiscordID]float64, len(views))
+	for id, view := range views {
+		ratings[id] = view.Rating
+	}
+	return ratings, nil
+}
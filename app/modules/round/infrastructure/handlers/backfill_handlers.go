@@ -8,6 +8,7 @@ import (
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
 	"github.com/google/uuid"
 )
 
@@ -29,10 +30,7 @@ func (h *RoundHandlers) HandleAdminBackfillCheckRequested(ctx context.Context, p
 		RoundTitles:          titles,
 	}
 
-	topic := "round.admin.backfill.check.response.v1"
-	if replyTo, ok := ctx.Value(handlerwrapper.CtxKeyReplyTo).(string); ok && replyTo != "" {
-		topic = replyTo
-	}
+	topic := handlerutil.ReplyTopic(ctx, "round.admin.backfill.check.response.v1")
 
 	return []handlerwrapper.Result{{Topic: topic, Payload: response}}, nil
 }
@@ -0,0 +1,158 @@
+package roundhandlers
+
+import (
+	"context"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Capacity and waitlist topics. Waitlisted and promoted events are also published
+// on guild- and club-scoped subjects like the other participant events.
+const (
+	RoundCapacitySetRequestedV1  = "round.capacity.set.requested.v1"
+	RoundCapacityUpdatedV1       = "round.capacity.updated.v1"
+	RoundCapacitySetFailedV1     = "round.capacity.set.failed.v1"
+	RoundParticipantWaitlistedV1 = "round.participant.waitlisted.v1"
+	RoundParticipantPromotedV1   = "round.participant.promoted.v1"
+)
+
+// RoundCapacitySetRequestPayloadV1 caps how many players can join a round. A
+// MaxParticipants of 0 removes the cap.
+type RoundCapacitySetRequestPayloadV1 struct {
+	GuildID         sharedtypes.GuildID   `json:"guild_id"`
+	RoundID         sharedtypes.RoundID   `json:"round_id"`
+	UserID          sharedtypes.DiscordID `json:"user_id"`
+	MaxParticipants int                   `json:"max_participants"`
+}
+
+// RoundCapacityUpdatedPayloadV1 confirms a round's new cap and the waitlist left after
+// any promotions.
+type RoundCapacityUpdatedPayloadV1 struct {
+	GuildID         sharedtypes.GuildID      `json:"guild_id"`
+	RoundID         sharedtypes.RoundID      `json:"round_id"`
+	MaxParticipants int                      `json:"max_participants"`
+	Waitlist        []roundtypes.Participant `json:"waitlist"`
+}
+
+// RoundCapacitySetFailedPayloadV1 reports a rejected capacity change.
+type RoundCapacitySetFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	Reason  string                `json:"reason"`
+}
+
+// RoundParticipantWaitlistedPayloadV1 reports a player who tried to join a full round
+// and is waiting for a seat. Position is 1-based.
+type RoundParticipantWaitlistedPayloadV1 struct {
+	GuildID         sharedtypes.GuildID      `json:"guild_id"`
+	RoundID         sharedtypes.RoundID      `json:"round_id"`
+	UserID          sharedtypes.DiscordID    `json:"user_id"`
+	EventMessageID  string                   `json:"event_message_id"`
+	Position        int                      `json:"position"`
+	MaxParticipants int                      `json:"max_participants"`
+	Waitlist        []roundtypes.Participant `json:"waitlist"`
+}
+
+// RoundParticipantPromotedPayloadV1 reports waitlisted players moved into the round,
+// with the round's participant lists so the event embed can be redrawn.
+type RoundParticipantPromotedPayloadV1 struct {
+	GuildID               sharedtypes.GuildID      `json:"guild_id"`
+	RoundID               sharedtypes.RoundID      `json:"round_id"`
+	EventMessageID        string                   `json:"event_message_id"`
+	Promoted              []roundtypes.Participant `json:"promoted"`
+	AcceptedParticipants  []roundtypes.Participant `json:"accepted_participants"`
+	DeclinedParticipants  []roundtypes.Participant `json:"declined_participants"`
+	TentativeParticipants []roundtypes.Participant `json:"tentative_participants"`
+	Waitlist              []roundtypes.Participant `json:"waitlist"`
+}
+
+// HandleRoundCapacitySetRequest sets or clears a round's participant cap (admin only).
+func (h *RoundHandlers) HandleRoundCapacitySetRequest(ctx context.Context, payload *RoundCapacitySetRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.ensureAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.capacityFailure(ctx, payload, err), nil
+	}
+
+	result, err := h.service.SetRoundCapacity(ctx, &roundservice.SetRoundCapacityRequest{
+		GuildID:         payload.GuildID,
+		RoundID:         payload.RoundID,
+		MaxParticipants: payload.MaxParticipants,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.capacityFailure(ctx, payload, *result.Failure), nil
+	}
+
+	change := *result.Success
+	results := handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundCapacityUpdatedV1,
+		Payload: &RoundCapacityUpdatedPayloadV1{
+			GuildID:         payload.GuildID,
+			RoundID:         payload.RoundID,
+			MaxParticipants: change.MaxParticipants,
+			Waitlist:        change.Waitlist,
+		},
+	}})
+	return append(results, h.promotedResults(ctx, change, payload.GuildID)...), nil
+}
+
+func (h *RoundHandlers) capacityFailure(ctx context.Context, payload *RoundCapacitySetRequestPayloadV1, err error) []handlerwrapper.Result {
+	h.logger.WarnContext(ctx, "Round capacity change rejected",
+		attr.String("guild_id", string(payload.GuildID)),
+		attr.RoundID("round_id", payload.RoundID),
+		attr.String("user_id", string(payload.UserID)),
+		attr.Error(err),
+	)
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundCapacitySetFailedV1,
+		Payload: &RoundCapacitySetFailedPayloadV1{
+			GuildID: payload.GuildID,
+			RoundID: payload.RoundID,
+			UserID:  payload.UserID,
+			Reason:  err.Error(),
+		},
+	}})
+}
+
+// waitlistedResults announces a player put on a full round's waitlist.
+func (h *RoundHandlers) waitlistedResults(ctx context.Context, change *roundservice.ParticipantChange, userID sharedtypes.DiscordID, guildID sharedtypes.GuildID) []handlerwrapper.Result {
+	results := []handlerwrapper.Result{{
+		Topic: RoundParticipantWaitlistedV1,
+		Payload: &RoundParticipantWaitlistedPayloadV1{
+			GuildID:         change.Round.GuildID,
+			RoundID:         change.Round.ID,
+			UserID:          userID,
+			EventMessageID:  change.Round.EventMessageID,
+			Position:        change.WaitlistPosition(userID),
+			MaxParticipants: change.MaxParticipants,
+			Waitlist:        change.Waitlist,
+		},
+	}}
+	return h.addParallelIdentityResults(ctx, results, RoundParticipantWaitlistedV1, guildID)
+}
+
+// promotedResults announces waitlisted players a change moved into the round, if any.
+func (h *RoundHandlers) promotedResults(ctx context.Context, change *roundservice.ParticipantChange, guildID sharedtypes.GuildID) []handlerwrapper.Result {
+	if len(change.Promoted) == 0 {
+		return nil
+	}
+	promoted := &RoundParticipantPromotedPayloadV1{
+		GuildID:        change.Round.GuildID,
+		RoundID:        change.Round.ID,
+		EventMessageID: change.Round.EventMessageID,
+		Promoted:       change.Promoted,
+		Waitlist:       change.Waitlist,
+	}
+	promoted.AcceptedParticipants, promoted.DeclinedParticipants, promoted.TentativeParticipants = h.splitParticipants(change.Round.Participants)
+
+	results := []handlerwrapper.Result{{Topic: RoundParticipantPromotedV1, Payload: promoted}}
+	return h.addParallelIdentityResults(ctx, results, RoundParticipantPromotedV1, guildID)
+}
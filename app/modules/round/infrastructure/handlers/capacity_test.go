@@ -0,0 +1,220 @@
+package roundhandlers
+
+import (
+	"context"
+	"testing"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
+	"github.com/google/uuid"
+)
+
+func resultTopics(results []handlerwrapper.Result) []string {
+	topics := make([]string, len(results))
+	for i, r := range results {
+		topics[i] = r.Topic
+	}
+	return topics
+}
+
+func TestRoundHandlers_HandleParticipantStatusUpdateRequest_Capacity(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	roundID := sharedtypes.RoundID(uuid.New())
+	round := &roundtypes.Round{
+		ID:             roundID,
+		GuildID:        guildID,
+		EventMessageID: "msg-1",
+		Participants: []roundtypes.Participant{
+			{UserID: "a", Response: roundtypes.ResponseDecline},
+			{UserID: "b", Response: roundtypes.ResponseAccept},
+		},
+	}
+
+	t.Run("full round waitlists the player", func(t *testing.T) {
+		fakeService := NewFakeService()
+		fakeService.UpdateParticipantStatusWithWaitlistFunc = func(ctx context.Context, req *roundtypes.JoinRoundRequest) (roundservice.ParticipantChangeResult, error) {
+			return results.SuccessResult[*roundservice.ParticipantChange, error](&roundservice.ParticipantChange{
+				Round:           round,
+				MaxParticipants: 1,
+				Waitlisted:      true,
+				Waitlist:        []roundtypes.Participant{{UserID: "x", Response: roundservice.ResponseWaitlist}, {UserID: "c", Response: roundservice.ResponseWaitlist}},
+			}), nil
+		}
+		h := &RoundHandlers{service: fakeService, userService: NewFakeUserService(), logger: loggerfrolfbot.NoOpLogger}
+
+		got, err := h.HandleParticipantStatusUpdateRequest(context.Background(), &roundevents.ParticipantJoinRequestPayloadV1{
+			GuildID: guildID, RoundID: roundID, UserID: "c", Response: roundtypes.ResponseAccept,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) == 0 || got[0].Topic != RoundParticipantWaitlistedV1 {
+			t.Fatalf("expected waitlisted event, got %v", resultTopics(got))
+		}
+		payload := got[0].Payload.(*RoundParticipantWaitlistedPayloadV1)
+		if payload.Position != 2 || payload.EventMessageID != "msg-1" {
+			t.Errorf("unexpected payload %+v", payload)
+		}
+		for _, r := range got {
+			if r.Topic == roundevents.RoundParticipantJoinedV2 {
+				t.Error("a waitlisted player must not be announced as joined")
+			}
+		}
+	})
+
+	t.Run("decline announces promotions", func(t *testing.T) {
+		fakeService := NewFakeService()
+		fakeService.UpdateParticipantStatusWithWaitlistFunc = func(ctx context.Context, req *roundtypes.JoinRoundRequest) (roundservice.ParticipantChangeResult, error) {
+			return results.SuccessResult[*roundservice.ParticipantChange, error](&roundservice.ParticipantChange{
+				Round:           round,
+				MaxParticipants: 1,
+				Promoted:        []roundtypes.Participant{{UserID: "b", Response: roundtypes.ResponseAccept}},
+			}), nil
+		}
+		h := &RoundHandlers{service: fakeService, userService: NewFakeUserService(), logger: loggerfrolfbot.NoOpLogger}
+
+		got, err := h.HandleParticipantStatusUpdateRequest(context.Background(), &roundevents.ParticipantJoinRequestPayloadV1{
+			GuildID: guildID, RoundID: roundID, UserID: "a", Response: roundtypes.ResponseDecline,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var promoted *RoundParticipantPromotedPayloadV1
+		for _, r := range got {
+			if r.Topic == RoundParticipantPromotedV1 {
+				promoted = r.Payload.(*RoundParticipantPromotedPayloadV1)
+			}
+		}
+		if got[0].Topic != roundevents.RoundParticipantJoinedV2 || promoted == nil {
+			t.Fatalf("expected joined and promoted events, got %v", resultTopics(got))
+		}
+		if len(promoted.Promoted) != 1 || len(promoted.AcceptedParticipants) != 1 || len(promoted.DeclinedParticipants) != 1 {
+			t.Errorf("unexpected promoted payload %+v", promoted)
+		}
+	})
+}
+
+func TestRoundHandlers_HandleParticipantRemovalRequest_PromotesWaitlist(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	fakeService := NewFakeService()
+	fakeService.ParticipantRemovalWithWaitlistFunc = func(ctx context.Context, req *roundtypes.JoinRoundRequest) (roundservice.ParticipantChangeResult, error) {
+		return results.SuccessResult[*roundservice.ParticipantChange, error](&roundservice.ParticipantChange{
+			Round:    &roundtypes.Round{ID: roundID, GuildID: guildID, Participants: []roundtypes.Participant{{UserID: "b", Response: roundtypes.ResponseAccept}}},
+			Promoted: []roundtypes.Participant{{UserID: "b", Response: roundtypes.ResponseAccept}},
+		}), nil
+	}
+	h := &RoundHandlers{service: fakeService, userService: NewFakeUserService(), logger: loggerfrolfbot.NoOpLogger}
+
+	got, err := h.HandleParticipantRemovalRequest(context.Background(), &roundevents.ParticipantRemovalRequestPayloadV1{GuildID: guildID, RoundID: roundID, UserID: "a"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	topics := resultTopics(got)
+	if topics[0] != roundevents.RoundParticipantRemovedV2 {
+		t.Fatalf("expected removed event first, got %v", topics)
+	}
+	found := false
+	for _, topic := range topics {
+		found = found || topic == RoundParticipantPromotedV1
+	}
+	if !found {
+		t.Errorf("expected a promoted event, got %v", topics)
+	}
+}
+
+func TestRoundHandlers_HandleRoundCapacitySetRequest(t *testing.T) {
+	payload := &RoundCapacitySetRequestPayloadV1{
+		GuildID:         "test-guild",
+		RoundID:         sharedtypes.RoundID(uuid.New()),
+		UserID:          "admin-1",
+		MaxParticipants: 12,
+	}
+
+	adminRole := func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+		return results.SuccessResult[sharedtypes.UserRoleEnum, error](sharedtypes.UserRoleAdmin), nil
+	}
+	playerRole := func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+		return results.SuccessResult[sharedtypes.UserRoleEnum, error](sharedtypes.UserRoleUser), nil
+	}
+
+	tests := []struct {
+		name       string
+		role       func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error)
+		fakeSetup  func(*FakeService)
+		wantTopics []string
+	}{
+		{
+			name: "admin raises the cap",
+			role: adminRole,
+			fakeSetup: func(f *FakeService) {
+				f.SetRoundCapacityFunc = func(ctx context.Context, req *roundservice.SetRoundCapacityRequest) (roundservice.ParticipantChangeResult, error) {
+					if req.MaxParticipants != 12 {
+						t.Errorf("expected cap 12, got %d", req.MaxParticipants)
+					}
+					return results.SuccessResult[*roundservice.ParticipantChange, error](&roundservice.ParticipantChange{
+						Round:           &roundtypes.Round{ID: req.RoundID, GuildID: req.GuildID},
+						MaxParticipants: req.MaxParticipants,
+						Promoted:        []roundtypes.Participant{{UserID: "w", Response: roundtypes.ResponseAccept}},
+					}), nil
+				}
+			},
+			wantTopics: []string{RoundCapacityUpdatedV1, RoundParticipantPromotedV1, RoundParticipantPromotedV1 + ".test-guild"},
+		},
+		{
+			name:       "non-admin is rejected",
+			role:       playerRole,
+			fakeSetup:  func(f *FakeService) {},
+			wantTopics: []string{RoundCapacitySetFailedV1},
+		},
+		{
+			name: "business failure publishes failed event",
+			role: adminRole,
+			fakeSetup: func(f *FakeService) {
+				f.SetRoundCapacityFunc = func(ctx context.Context, req *roundservice.SetRoundCapacityRequest) (roundservice.ParticipantChangeResult, error) {
+					return results.FailureResult[*roundservice.ParticipantChange, error](roundservice.ErrInvalidCapacity), nil
+				}
+			},
+			wantTopics: []string{RoundCapacitySetFailedV1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			tt.fakeSetup(fakeService)
+			fakeUsers := NewFakeUserService()
+			fakeUsers.GetUserRoleFunc = tt.role
+			fakeUsers.GetClubUUIDByDiscordGuildIDFunc = func(ctx context.Context, guildID sharedtypes.GuildID) (uuid.UUID, error) {
+				return uuid.Nil, nil
+			}
+
+			h := &RoundHandlers{
+				service:     fakeService,
+				userService: fakeUsers,
+				logger:      loggerfrolfbot.NoOpLogger,
+			}
+
+			got, err := h.HandleRoundCapacitySetRequest(context.Background(), payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			topics := resultTopics(got)
+			if len(topics) != len(tt.wantTopics) {
+				t.Fatalf("expected topics %v, got %v", tt.wantTopics, topics)
+			}
+			for i := range topics {
+				if topics[i] != tt.wantTopics[i] {
+					t.Fatalf("expected topics %v, got %v", tt.wantTopics, topics)
+				}
+			}
+		})
+	}
+}
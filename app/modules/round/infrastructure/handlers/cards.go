@@ -0,0 +1,156 @@
+package roundhandlers
+
+import (
+	"context"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Card topics. Cards assigned at start are published alongside the started event.
+const (
+	RoundCardsConfigureRequestedV1 = "round.cards.configure.requested.v1"
+	RoundCardsConfiguredV1         = "round.cards.configured.v1"
+	RoundCardsConfigureFailedV1    = "round.cards.configure.failed.v1"
+	RoundCardsAssignedV1           = "round.cards.assigned.v1"
+	RoundTeeSheetRequestV1         = "round.tee_sheet.request.v1"
+	RoundTeeSheetResponseV1        = "round.tee_sheet.response.v1"
+)
+
+// RoundCardsConfigureRequestPayloadV1 sets how a round's accepted players are split
+// into cards when it starts. A Size of 0 turns cards off.
+type RoundCardsConfigureRequestPayloadV1 struct {
+	GuildID      sharedtypes.GuildID   `json:"guild_id"`
+	RoundID      sharedtypes.RoundID   `json:"round_id"`
+	UserID       sharedtypes.DiscordID `json:"user_id"`
+	Size         int                   `json:"size"`
+	Strategy     string                `json:"strategy,omitempty"`
+	ShotgunStart bool                  `json:"shotgun_start"`
+}
+
+// RoundCardsConfiguredPayloadV1 confirms a round's card settings.
+type RoundCardsConfiguredPayloadV1 struct {
+	GuildID  sharedtypes.GuildID       `json:"guild_id"`
+	RoundID  sharedtypes.RoundID       `json:"round_id"`
+	Settings roundservice.CardSettings `json:"settings"`
+}
+
+// RoundCardsConfigureFailedPayloadV1 reports a rejected card configuration.
+type RoundCardsConfigureFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	Reason  string                `json:"reason"`
+}
+
+// RoundCardsAssignedPayloadV1 is the tee sheet drawn when a round started.
+type RoundCardsAssignedPayloadV1 struct {
+	GuildID        sharedtypes.GuildID `json:"guild_id"`
+	RoundID        sharedtypes.RoundID `json:"round_id"`
+	EventMessageID string              `json:"event_message_id,omitempty"`
+	Cards          []roundservice.Card `json:"cards"`
+}
+
+// RoundTeeSheetRequestPayloadV1 is the request/reply payload for a round's cards.
+type RoundTeeSheetRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+	RoundID sharedtypes.RoundID `json:"round_id"`
+}
+
+// RoundTeeSheetResponsePayloadV1 is the reply for RoundTeeSheetRequestV1. Cards is
+// empty until the round starts or when it has no card size.
+type RoundTeeSheetResponsePayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+	RoundID sharedtypes.RoundID `json:"round_id"`
+	Cards   []roundservice.Card `json:"cards"`
+	Error   string              `json:"error,omitempty"`
+}
+
+// HandleRoundCardsConfigureRequest sets a round's card size, strategy and shotgun
+// start (admin only).
+func (h *RoundHandlers) HandleRoundCardsConfigureRequest(ctx context.Context, payload *RoundCardsConfigureRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.ensureAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.cardsConfigureFailure(ctx, payload, err), nil
+	}
+
+	result, err := h.service.ConfigureRoundCards(ctx, &roundservice.ConfigureRoundCardsRequest{
+		GuildID:      payload.GuildID,
+		RoundID:      payload.RoundID,
+		Size:         payload.Size,
+		Strategy:     payload.Strategy,
+		ShotgunStart: payload.ShotgunStart,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.cardsConfigureFailure(ctx, payload, *result.Failure), nil
+	}
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundCardsConfiguredV1,
+		Payload: &RoundCardsConfiguredPayloadV1{
+			GuildID:  payload.GuildID,
+			RoundID:  payload.RoundID,
+			Settings: **result.Success,
+		},
+	}}), nil
+}
+
+func (h *RoundHandlers) cardsConfigureFailure(ctx context.Context, payload *RoundCardsConfigureRequestPayloadV1, err error) []handlerwrapper.Result {
+	h.logger.WarnContext(ctx, "Round card configuration rejected",
+		attr.String("guild_id", string(payload.GuildID)),
+		attr.RoundID("round_id", payload.RoundID),
+		attr.String("user_id", string(payload.UserID)),
+		attr.Error(err),
+	)
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundCardsConfigureFailedV1,
+		Payload: &RoundCardsConfigureFailedPayloadV1{
+			GuildID: payload.GuildID,
+			RoundID: payload.RoundID,
+			UserID:  payload.UserID,
+			Reason:  err.Error(),
+		},
+	}})
+}
+
+// HandleRoundTeeSheetRequest replies with the cards drawn when the round started.
+func (h *RoundHandlers) HandleRoundTeeSheetRequest(ctx context.Context, payload *RoundTeeSheetRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	result, err := h.service.GetRoundTeeSheet(ctx, payload.GuildID, payload.RoundID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RoundTeeSheetResponsePayloadV1{GuildID: payload.GuildID, RoundID: payload.RoundID, Cards: []roundservice.Card{}}
+	if result.Failure != nil {
+		response.Error = (*result.Failure).Error()
+	} else if result.Success != nil && len(*result.Success) > 0 {
+		response.Cards = *result.Success
+	}
+
+	topic := handlerutil.ReplyTopic(ctx, RoundTeeSheetResponseV1)
+
+	return []handlerwrapper.Result{{Topic: topic, Payload: response}}, nil
+}
+
+// cardsAssignedResults announces the cards drawn when a round started, if any.
+func (h *RoundHandlers) cardsAssignedResults(ctx context.Context, cards []roundservice.Card, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, eventMessageID string) []handlerwrapper.Result {
+	if len(cards) == 0 {
+		return nil
+	}
+	results := []handlerwrapper.Result{{
+		Topic: RoundCardsAssignedV1,
+		Payload: &RoundCardsAssignedPayloadV1{
+			GuildID:        guildID,
+			RoundID:        roundID,
+			EventMessageID: eventMessageID,
+			Cards:          cards,
+		},
+	}}
+	return h.addParallelIdentityResults(ctx, results, RoundCardsAssignedV1, guildID)
+}
@@ -0,0 +1,151 @@
+package roundhandlers
+
+import (
+	"context"
+	"testing"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
+	"github.com/google/uuid"
+)
+
+func TestRoundHandlers_HandleRoundStartRequested_PublishesCards(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-123")
+	roundID := sharedtypes.RoundID(uuid.New())
+	hole := 10
+
+	fakeService := NewFakeService()
+	fakeService.StartRoundFunc = func(ctx context.Context, req *roundtypes.StartRoundRequest) (roundservice.StartRoundResult, error) {
+		return roundservice.StartRoundResult{
+			OperationResult: results.SuccessResult[*roundtypes.Round, error](&roundtypes.Round{ID: roundID, GuildID: guildID}),
+			Cards: []roundservice.Card{
+				{Number: 1, Players: []roundtypes.Participant{{UserID: "a"}, {UserID: "b"}}},
+				{Number: 2, StartingHole: &hole, Players: []roundtypes.Participant{{UserID: "c"}}},
+			},
+		}, nil
+	}
+	fakeUsers := NewFakeUserService()
+	fakeUsers.GetClubUUIDByDiscordGuildIDFunc = func(ctx context.Context, guildID sharedtypes.GuildID) (uuid.UUID, error) {
+		return uuid.Nil, nil
+	}
+	h := &RoundHandlers{service: fakeService, userService: fakeUsers, logger: loggerfrolfbot.NoOpLogger}
+
+	got, err := h.HandleRoundStartRequested(context.Background(), &roundevents.RoundStartRequestedPayloadV1{GuildID: guildID, RoundID: roundID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		roundevents.RoundStartedV2, roundevents.RoundStartedV2 + ".guild-123",
+		RoundCardsAssignedV1, RoundCardsAssignedV1 + ".guild-123",
+	}
+	topics := resultTopics(got)
+	if len(topics) != len(want) {
+		t.Fatalf("expected topics %v, got %v", want, topics)
+	}
+	for i := range want {
+		if topics[i] != want[i] {
+			t.Fatalf("expected topics %v, got %v", want, topics)
+		}
+	}
+	payload := got[2].Payload.(*RoundCardsAssignedPayloadV1)
+	if len(payload.Cards) != 2 || payload.Cards[1].StartingHole == nil || *payload.Cards[1].StartingHole != 10 {
+		t.Errorf("unexpected cards payload %+v", payload)
+	}
+}
+
+func TestRoundHandlers_HandleRoundCardsConfigureRequest(t *testing.T) {
+	payload := &RoundCardsConfigureRequestPayloadV1{
+		GuildID:  "test-guild",
+		RoundID:  sharedtypes.RoundID(uuid.New()),
+		UserID:   "admin-1",
+		Size:     4,
+		Strategy: roundservice.CardStrategyByTag,
+	}
+
+	adminRole := func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+		return results.SuccessResult[sharedtypes.UserRoleEnum, error](sharedtypes.UserRoleAdmin), nil
+	}
+	playerRole := func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+		return results.SuccessResult[sharedtypes.UserRoleEnum, error](sharedtypes.UserRoleUser), nil
+	}
+
+	tests := []struct {
+		name      string
+		role      func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error)
+		fakeSetup func(*FakeService)
+		wantTopic string
+	}{
+		{
+			name: "admin configures cards",
+			role: adminRole,
+			fakeSetup: func(f *FakeService) {
+				f.ConfigureRoundCardsFunc = func(ctx context.Context, req *roundservice.ConfigureRoundCardsRequest) (results.OperationResult[*roundservice.CardSettings, error], error) {
+					if req.Size != 4 || req.Strategy != roundservice.CardStrategyByTag {
+						t.Errorf("unexpected request %+v", req)
+					}
+					return results.SuccessResult[*roundservice.CardSettings, error](&roundservice.CardSettings{Size: req.Size, Strategy: req.Strategy}), nil
+				}
+			},
+			wantTopic: RoundCardsConfiguredV1,
+		},
+		{
+			name:      "non-admin is rejected",
+			role:      playerRole,
+			fakeSetup: func(f *FakeService) {},
+			wantTopic: RoundCardsConfigureFailedV1,
+		},
+		{
+			name: "business failure publishes failed event",
+			role: adminRole,
+			fakeSetup: func(f *FakeService) {
+				f.ConfigureRoundCardsFunc = func(ctx context.Context, req *roundservice.ConfigureRoundCardsRequest) (results.OperationResult[*roundservice.CardSettings, error], error) {
+					return results.FailureResult[*roundservice.CardSettings, error](roundservice.ErrRoundAlreadyStarted), nil
+				}
+			},
+			wantTopic: RoundCardsConfigureFailedV1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			tt.fakeSetup(fakeService)
+			fakeUsers := NewFakeUserService()
+			fakeUsers.GetUserRoleFunc = tt.role
+
+			h := &RoundHandlers{service: fakeService, userService: fakeUsers, logger: loggerfrolfbot.NoOpLogger}
+
+			got, err := h.HandleRoundCardsConfigureRequest(context.Background(), payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 1 || got[0].Topic != tt.wantTopic {
+				t.Fatalf("expected %s, got %v", tt.wantTopic, resultTopics(got))
+			}
+		})
+	}
+}
+
+func TestRoundHandlers_HandleRoundTeeSheetRequest(t *testing.T) {
+	fakeService := NewFakeService()
+	fakeService.GetRoundTeeSheetFunc = func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[[]roundservice.Card, error], error) {
+		return results.SuccessResult[[]roundservice.Card, error]([]roundservice.Card{{Number: 1}}), nil
+	}
+	h := &RoundHandlers{service: fakeService, logger: loggerfrolfbot.NoOpLogger}
+
+	got, err := h.HandleRoundTeeSheetRequest(context.Background(), &RoundTeeSheetRequestPayloadV1{GuildID: "test-guild", RoundID: sharedtypes.RoundID(uuid.New())})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].Topic != RoundTeeSheetResponseV1 {
+		t.Fatalf("expected tee sheet response, got %v", resultTopics(got))
+	}
+	if response := got[0].Payload.(*RoundTeeSheetResponsePayloadV1); len(response.Cards) != 1 || response.Error != "" {
+		t.Errorf("unexpected response %+v", response)
+	}
+}
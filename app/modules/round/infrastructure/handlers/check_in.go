@@ -0,0 +1,196 @@
+package roundhandlers
+
+import (
+	"context"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	roundqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/queue"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Check-in topics. No-shows marked at start are published alongside the started
+// event, like cards.
+const (
+	RoundCheckInConfigureRequestedV1 = "round.check_in.configure.requested.v1"
+	RoundCheckInConfiguredV1         = "round.check_in.configured.v1"
+	RoundCheckInConfigureFailedV1    = "round.check_in.configure.failed.v1"
+	RoundCheckInRequestedV1          = "round.check_in.requested.v1"
+	RoundCheckedInV1                 = "round.check_in.checked_in.v1"
+	RoundCheckInFailedV1             = "round.check_in.failed.v1"
+	RoundCheckInOpenedV1             = "round.check_in.opened.v1"
+	RoundParticipantNoShowV1         = "round.participant.no_show.v1"
+)
+
+// RoundCheckInConfigureRequestPayloadV1 sets how many minutes before the start time
+// players can check in. WindowMinutes of 0 turns check-in off.
+type RoundCheckInConfigureRequestPayloadV1 struct {
+	GuildID       sharedtypes.GuildID   `json:"guild_id"`
+	RoundID       sharedtypes.RoundID   `json:"round_id"`
+	UserID        sharedtypes.DiscordID `json:"user_id"`
+	WindowMinutes int                   `json:"window_minutes"`
+}
+
+// RoundCheckInConfigureFailedPayloadV1 reports a rejected check-in window change.
+type RoundCheckInConfigureFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	Reason  string                `json:"reason"`
+}
+
+// RoundCheckInRequestPayloadV1 checks a player in for a round.
+type RoundCheckInRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+}
+
+// RoundCheckInStatusPayloadV1 carries a round's check-in window and who has checked
+// in. It is the payload of the configured, checked-in and opened events; UserID is
+// the player or admin who triggered the event, if any.
+type RoundCheckInStatusPayloadV1 struct {
+	UserID sharedtypes.DiscordID `json:"user_id,omitempty"`
+	roundservice.RoundCheckInStatus
+}
+
+// RoundCheckInFailedPayloadV1 reports a rejected check-in.
+type RoundCheckInFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	Reason  string                `json:"reason"`
+}
+
+// RoundParticipantNoShowPayloadV1 lists the accepted players who did not check in
+// before a round started.
+type RoundParticipantNoShowPayloadV1 struct {
+	GuildID        sharedtypes.GuildID      `json:"guild_id"`
+	RoundID        sharedtypes.RoundID      `json:"round_id"`
+	EventMessageID string                   `json:"event_message_id,omitempty"`
+	NoShows        []roundtypes.Participant `json:"no_shows"`
+}
+
+// HandleRoundCheckInConfigureRequest sets a round's check-in window (admin only).
+func (h *RoundHandlers) HandleRoundCheckInConfigureRequest(ctx context.Context, payload *RoundCheckInConfigureRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.ensureAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.checkInConfigureFailure(ctx, payload, err), nil
+	}
+
+	result, err := h.service.ConfigureRoundCheckIn(ctx, &roundservice.ConfigureRoundCheckInRequest{
+		GuildID:       payload.GuildID,
+		RoundID:       payload.RoundID,
+		WindowMinutes: payload.WindowMinutes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.checkInConfigureFailure(ctx, payload, *result.Failure), nil
+	}
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic:   RoundCheckInConfiguredV1,
+		Payload: &RoundCheckInStatusPayloadV1{UserID: payload.UserID, RoundCheckInStatus: **result.Success},
+	}}), nil
+}
+
+func (h *RoundHandlers) checkInConfigureFailure(ctx context.Context, payload *RoundCheckInConfigureRequestPayloadV1, err error) []handlerwrapper.Result {
+	h.logger.WarnContext(ctx, "Round check-in window change rejected",
+		attr.String("guild_id", string(payload.GuildID)),
+		attr.RoundID("round_id", payload.RoundID),
+		attr.String("user_id", string(payload.UserID)),
+		attr.Error(err),
+	)
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundCheckInConfigureFailedV1,
+		Payload: &RoundCheckInConfigureFailedPayloadV1{
+			GuildID: payload.GuildID,
+			RoundID: payload.RoundID,
+			UserID:  payload.UserID,
+			Reason:  err.Error(),
+		},
+	}})
+}
+
+// HandleRoundCheckInRequest checks a player in while the round's check-in window is
+// open and announces the updated check-in list.
+func (h *RoundHandlers) HandleRoundCheckInRequest(ctx context.Context, payload *RoundCheckInRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	result, err := h.service.CheckInParticipant(ctx, &roundservice.CheckInRequest{
+		GuildID: payload.GuildID,
+		RoundID: payload.RoundID,
+		UserID:  payload.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Failure != nil {
+		h.logger.WarnContext(ctx, "Round check-in rejected",
+			attr.String("guild_id", string(payload.GuildID)),
+			attr.RoundID("round_id", payload.RoundID),
+			attr.String("user_id", string(payload.UserID)),
+			attr.Error(*result.Failure),
+		)
+		return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+			Topic: RoundCheckInFailedV1,
+			Payload: &RoundCheckInFailedPayloadV1{
+				GuildID: payload.GuildID,
+				RoundID: payload.RoundID,
+				UserID:  payload.UserID,
+				Reason:  (*result.Failure).Error(),
+			},
+		}}), nil
+	}
+
+	results := handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic:   RoundCheckedInV1,
+		Payload: &RoundCheckInStatusPayloadV1{UserID: payload.UserID, RoundCheckInStatus: **result.Success},
+	}})
+	return h.addParallelIdentityResults(ctx, results, RoundCheckedInV1, payload.GuildID), nil
+}
+
+// HandleRoundCheckInOpenRequested announces a round's check-in window when the
+// queued check-in job fires. Nothing is announced if the round has started or
+// check-in was turned off since the job was queued.
+func (h *RoundHandlers) HandleRoundCheckInOpenRequested(ctx context.Context, payload *roundqueue.RoundCheckInOpenRequestedPayloadV1) ([]handlerwrapper.Result, error) {
+	result, err := h.service.OpenRoundCheckIn(ctx, payload.GuildID, payload.RoundID)
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		h.logger.InfoContext(ctx, "Skipping round check-in announcement",
+			attr.String("guild_id", string(payload.GuildID)),
+			attr.RoundID("round_id", payload.RoundID),
+			attr.Error(*result.Failure),
+		)
+		return nil, nil
+	}
+
+	results := []handlerwrapper.Result{{
+		Topic:   RoundCheckInOpenedV1,
+		Payload: &RoundCheckInStatusPayloadV1{RoundCheckInStatus: **result.Success},
+	}}
+	return h.addParallelIdentityResults(ctx, results, RoundCheckInOpenedV1, payload.GuildID), nil
+}
+
+// noShowResults announces the players marked as no-shows when a round started, if any.
+func (h *RoundHandlers) noShowResults(ctx context.Context, noShows []roundtypes.Participant, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, eventMessageID string) []handlerwrapper.Result {
+	if len(noShows) == 0 {
+		return nil
+	}
+	results := []handlerwrapper.Result{{
+		Topic: RoundParticipantNoShowV1,
+		Payload: &RoundParticipantNoShowPayloadV1{
+			GuildID:        guildID,
+			RoundID:        roundID,
+			EventMessageID: eventMessageID,
+			NoShows:        noShows,
+		},
+	}}
+	return h.addParallelIdentityResults(ctx, results, RoundParticipantNoShowV1, guildID)
+}
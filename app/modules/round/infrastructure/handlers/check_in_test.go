@@ -0,0 +1,125 @@
+package roundhandlers
+
+import (
+	"context"
+	"testing"
+
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	roundqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/queue"
+	"github.com/google/uuid"
+)
+
+func newCheckInTestHandlers(fakeService *FakeService) *RoundHandlers {
+	fakeUsers := NewFakeUserService()
+	fakeUsers.GetClubUUIDByDiscordGuildIDFunc = func(ctx context.Context, guildID sharedtypes.GuildID) (uuid.UUID, error) {
+		return uuid.Nil, nil
+	}
+	return &RoundHandlers{service: fakeService, userService: fakeUsers, logger: loggerfrolfbot.NoOpLogger}
+}
+
+func assertTopics(t *testing.T, got []handlerwrapper.Result, want []string) {
+	t.Helper()
+	topics := resultTopics(got)
+	if len(topics) != len(want) {
+		t.Fatalf("expected topics %v, got %v", want, topics)
+	}
+	for i := range topics {
+		if topics[i] != want[i] {
+			t.Fatalf("expected topics %v, got %v", want, topics)
+		}
+	}
+}
+
+func TestRoundHandlers_HandleRoundCheckInRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	roundID := sharedtypes.RoundID(uuid.New())
+	payload := &RoundCheckInRequestPayloadV1{GuildID: guildID, RoundID: roundID, UserID: "a"}
+
+	tests := []struct {
+		name       string
+		fakeSetup  func(*FakeService)
+		wantTopics []string
+	}{
+		{
+			name: "check-in announces the updated list",
+			fakeSetup: func(f *FakeService) {
+				f.CheckInParticipantFunc = func(ctx context.Context, req *roundservice.CheckInRequest) (roundservice.RoundCheckInResult, error) {
+					if req.UserID != "a" {
+						t.Errorf("unexpected request %+v", req)
+					}
+					return results.SuccessResult[*roundservice.RoundCheckInStatus, error](&roundservice.RoundCheckInStatus{
+						GuildID:   guildID,
+						RoundID:   roundID,
+						CheckedIn: []sharedtypes.DiscordID{"a"},
+					}), nil
+				}
+			},
+			wantTopics: []string{RoundCheckedInV1, RoundCheckedInV1 + ".test-guild"},
+		},
+		{
+			name: "check-in before the window opens is rejected",
+			fakeSetup: func(f *FakeService) {
+				f.CheckInParticipantFunc = func(ctx context.Context, req *roundservice.CheckInRequest) (roundservice.RoundCheckInResult, error) {
+					return results.FailureResult[*roundservice.RoundCheckInStatus, error](roundservice.ErrCheckInNotOpen), nil
+				}
+			},
+			wantTopics: []string{RoundCheckInFailedV1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			tt.fakeSetup(fakeService)
+			h := newCheckInTestHandlers(fakeService)
+
+			got, err := h.HandleRoundCheckInRequest(context.Background(), payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertTopics(t, got, tt.wantTopics)
+		})
+	}
+}
+
+func TestRoundHandlers_HandleRoundCheckInOpenRequested(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	roundID := sharedtypes.RoundID(uuid.New())
+	payload := &roundqueue.RoundCheckInOpenRequestedPayloadV1{GuildID: guildID, RoundID: roundID}
+
+	t.Run("announces the open window", func(t *testing.T) {
+		fakeService := NewFakeService()
+		fakeService.OpenRoundCheckInFunc = func(ctx context.Context, g sharedtypes.GuildID, r sharedtypes.RoundID) (roundservice.RoundCheckInResult, error) {
+			return results.SuccessResult[*roundservice.RoundCheckInStatus, error](&roundservice.RoundCheckInStatus{
+				GuildID: g,
+				RoundID: r,
+				Pending: []sharedtypes.DiscordID{"a", "b"},
+			}), nil
+		}
+		h := newCheckInTestHandlers(fakeService)
+
+		got, err := h.HandleRoundCheckInOpenRequested(context.Background(), payload)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertTopics(t, got, []string{RoundCheckInOpenedV1, RoundCheckInOpenedV1 + ".test-guild"})
+	})
+
+	t.Run("stays quiet once the round has started", func(t *testing.T) {
+		fakeService := NewFakeService()
+		fakeService.OpenRoundCheckInFunc = func(ctx context.Context, g sharedtypes.GuildID, r sharedtypes.RoundID) (roundservice.RoundCheckInResult, error) {
+			return results.FailureResult[*roundservice.RoundCheckInStatus, error](roundservice.ErrRoundAlreadyStarted), nil
+		}
+		h := newCheckInTestHandlers(fakeService)
+
+		got, err := h.HandleRoundCheckInOpenRequested(context.Background(), payload)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		assertTopics(t, got, nil)
+	})
+}
@@ -0,0 +1,76 @@
+package roundhandlers
+
+import (
+	"context"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+	"github.com/google/uuid"
+)
+
+// Course layout topics.
+const (
+	RoundCourseLayoutSetRequestedV1 = "round.course_layout.set.requested.v1"
+	RoundCourseLayoutSetFailedV1    = "round.course_layout.set.failed.v1"
+)
+
+// RoundCourseLayoutSetRequestPayloadV1 links a round to a registered course layout.
+// A null layout_id clears the link.
+type RoundCourseLayoutSetRequestPayloadV1 struct {
+	GuildID  sharedtypes.GuildID   `json:"guild_id"`
+	RoundID  sharedtypes.RoundID   `json:"round_id"`
+	UserID   sharedtypes.DiscordID `json:"user_id"`
+	LayoutID *uuid.UUID            `json:"layout_id"`
+}
+
+// RoundCourseLayoutSetFailedPayloadV1 reports a rejected layout change.
+type RoundCourseLayoutSetFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	Reason  string                `json:"reason"`
+}
+
+// HandleRoundCourseLayoutSetRequest links a round to a course layout (admin only) and
+// republishes the round so embeds pick up the layout's pars.
+func (h *RoundHandlers) HandleRoundCourseLayoutSetRequest(ctx context.Context, payload *RoundCourseLayoutSetRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.ensureAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.courseLayoutFailure(ctx, payload, err), nil
+	}
+
+	result, err := h.service.SetRoundCourseLayout(ctx, &roundservice.SetRoundCourseLayoutRequest{
+		GuildID:  payload.GuildID,
+		RoundID:  payload.RoundID,
+		LayoutID: payload.LayoutID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.courseLayoutFailure(ctx, payload, *result.Failure), nil
+	}
+
+	return handlerutil.WithReplyTo(ctx, h.roundUpdatedResults(ctx, payload.GuildID, *result.Success, false)), nil
+}
+
+func (h *RoundHandlers) courseLayoutFailure(ctx context.Context, payload *RoundCourseLayoutSetRequestPayloadV1, err error) []handlerwrapper.Result {
+	h.logger.WarnContext(ctx, "Round course layout change rejected",
+		attr.String("guild_id", string(payload.GuildID)),
+		attr.RoundID("round_id", payload.RoundID),
+		attr.String("user_id", string(payload.UserID)),
+		attr.Error(err),
+	)
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundCourseLayoutSetFailedV1,
+		Payload: &RoundCourseLayoutSetFailedPayloadV1{
+			GuildID: payload.GuildID,
+			RoundID: payload.RoundID,
+			UserID:  payload.UserID,
+			Reason:  err.Error(),
+		},
+	}})
+}
@@ -0,0 +1,121 @@
+package roundhandlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
+	"github.com/google/uuid"
+)
+
+func TestRoundHandlers_HandleRoundCourseLayoutSetRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	roundID := sharedtypes.RoundID(uuid.New())
+	layoutID := uuid.New()
+	payload := &RoundCourseLayoutSetRequestPayloadV1{
+		GuildID:  guildID,
+		RoundID:  roundID,
+		UserID:   "admin-1",
+		LayoutID: &layoutID,
+	}
+
+	adminRole := func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+		return results.SuccessResult[sharedtypes.UserRoleEnum, error](sharedtypes.UserRoleAdmin), nil
+	}
+	playerRole := func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+		return results.SuccessResult[sharedtypes.UserRoleEnum, error](sharedtypes.UserRoleUser), nil
+	}
+
+	tests := []struct {
+		name        string
+		role        func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error)
+		fakeSetup   func(*FakeService)
+		wantErr     bool
+		wantTopic   string
+		wantService bool
+	}{
+		{
+			name: "admin links layout and round update is published",
+			role: adminRole,
+			fakeSetup: func(f *FakeService) {
+				f.SetRoundCourseLayoutFunc = func(ctx context.Context, req *roundservice.SetRoundCourseLayoutRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+					return results.SuccessResult[*roundtypes.Round, error](&roundtypes.Round{ID: req.RoundID, ParScores: []int{3, 3, 4}}), nil
+				}
+			},
+			wantTopic:   roundevents.RoundUpdatedV2,
+			wantService: true,
+		},
+		{
+			name:      "non-admin is rejected",
+			role:      playerRole,
+			fakeSetup: func(f *FakeService) {},
+			wantTopic: RoundCourseLayoutSetFailedV1,
+		},
+		{
+			name: "business failure publishes failed event",
+			role: adminRole,
+			fakeSetup: func(f *FakeService) {
+				f.SetRoundCourseLayoutFunc = func(ctx context.Context, req *roundservice.SetRoundCourseLayoutRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+					return results.FailureResult[*roundtypes.Round, error](roundservice.ErrCourseLayoutNotFound), nil
+				}
+			},
+			wantTopic:   RoundCourseLayoutSetFailedV1,
+			wantService: true,
+		},
+		{
+			name: "service error is returned",
+			role: adminRole,
+			fakeSetup: func(f *FakeService) {
+				f.SetRoundCourseLayoutFunc = func(ctx context.Context, req *roundservice.SetRoundCourseLayoutRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+					return results.OperationResult[*roundtypes.Round, error]{}, errors.New("db down")
+				}
+			},
+			wantErr:     true,
+			wantService: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			tt.fakeSetup(fakeService)
+			fakeUsers := NewFakeUserService()
+			fakeUsers.GetUserRoleFunc = tt.role
+
+			h := &RoundHandlers{
+				service:     fakeService,
+				userService: fakeUsers,
+				logger:      loggerfrolfbot.NoOpLogger,
+			}
+
+			got, err := h.HandleRoundCourseLayoutSetRequest(context.Background(), payload)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HandleRoundCourseLayoutSetRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			called := false
+			for _, step := range fakeService.Trace() {
+				if step == "SetRoundCourseLayout" {
+					called = true
+				}
+			}
+			if called != tt.wantService {
+				t.Errorf("SetRoundCourseLayout called = %v, want %v", called, tt.wantService)
+			}
+
+			if tt.wantErr {
+				return
+			}
+			if len(got) == 0 || got[0].Topic != tt.wantTopic {
+				t.Fatalf("expected first topic %s, got %v", tt.wantTopic, got)
+			}
+		})
+	}
+}
@@ -87,6 +87,45 @@ type FakeService struct {
 	NormalizeParsedScorecardFunc  func(ctx context.Context, data *roundtypes.ParsedScorecard, meta roundtypes.Metadata) (results.OperationResult[*roundtypes.NormalizedScorecard, error], error)
 	IngestNormalizedScorecardFunc func(ctx context.Context, req roundtypes.ImportIngestScorecardInput) (results.OperationResult[*roundtypes.IngestScorecardResult, error], error)
 	ApplyImportedScoresFunc       func(ctx context.Context, req roundtypes.ImportApplyScoresInput) (roundservice.ApplyImportedScoresResult, error)
+
+	// Round Series
+	CreateRoundSeriesFunc             func(ctx context.Context, req *roundservice.CreateRoundSeriesRequest) (roundservice.RoundSeriesResult, error)
+	ListRoundSeriesFunc               func(ctx context.Context, guildID sharedtypes.GuildID) (roundservice.ListRoundSeriesResult, error)
+	UpdateRoundSeriesFunc             func(ctx context.Context, req *roundservice.UpdateRoundSeriesRequest) (roundservice.UpdateRoundSeriesResult, error)
+	SkipRoundSeriesOccurrenceFunc     func(ctx context.Context, req *roundservice.RoundSeriesOccurrenceRequest) (roundservice.RoundSeriesOccurrenceResult, error)
+	OverrideRoundSeriesOccurrenceFunc func(ctx context.Context, req *roundservice.RoundSeriesOccurrenceRequest) (roundservice.RoundSeriesOccurrenceResult, error)
+	MaterializeRoundSeriesFunc        func(ctx context.Context, now time.Time) (roundservice.MaterializeRoundSeriesResult, error)
+
+	// Live hole scoring
+	SubmitHoleScoreFunc func(ctx context.Context, req *roundservice.SubmitHoleScoreRequest) (roundservice.HoleScoreUpdateResult, error)
+
+	// Team scores
+	SubmitTeamScoreFunc func(ctx context.Context, req *roundservice.SubmitTeamScoreRequest) (roundservice.ScoreUpdateResult, error)
+
+	// Course layouts
+	SetRoundCourseLayoutFunc  func(ctx context.Context, req *roundservice.SetRoundCourseLayoutRequest) (results.OperationResult[*roundtypes.Round, error], error)
+	GetCourseLayoutRoundsFunc func(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error)
+
+	// Scoring mode
+	SetRoundScoringModeFunc func(ctx context.Context, req *roundservice.SetRoundScoringModeRequest) (results.OperationResult[*roundtypes.Round, error], error)
+
+	// Capacity
+	SetRoundCapacityFunc                    func(ctx context.Context, req *roundservice.SetRoundCapacityRequest) (roundservice.ParticipantChangeResult, error)
+	UpdateParticipantStatusWithWaitlistFunc func(ctx context.Context, req *roundtypes.JoinRoundRequest) (roundservice.ParticipantChangeResult, error)
+	ParticipantRemovalWithWaitlistFunc      func(ctx context.Context, req *roundtypes.JoinRoundRequest) (roundservice.ParticipantChangeResult, error)
+
+	// Cards
+	ConfigureRoundCardsFunc func(ctx context.Context, req *roundservice.ConfigureRoundCardsRequest) (results.OperationResult[*roundservice.CardSettings, error], error)
+	GetRoundTeeSheetFunc    func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[[]roundservice.Card, error], error)
+
+	// Team draws
+	ConfigureTeamDrawFunc func(ctx context.Context, req *roundservice.ConfigureTeamDrawRequest) (results.OperationResult[*roundtypes.Round, error], error)
+
+	// Check-ins
+	ConfigureRoundCheckInFunc func(ctx context.Context, req *roundservice.ConfigureRoundCheckInRequest) (roundservice.RoundCheckInResult, error)
+	OpenRoundCheckInFunc      func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (roundservice.RoundCheckInResult, error)
+	CheckInParticipantFunc    func(ctx context.Context, req *roundservice.CheckInRequest) (roundservice.RoundCheckInResult, error)
+	GetMemberReliabilityFunc  func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[*roundservice.MemberReliability, error], error)
 }
 
 func NewFakeService() *FakeService {
@@ -433,6 +472,185 @@ func (f *FakeService) ApplyImportedScores(ctx context.Context, req roundtypes.Im
 	return roundservice.ApplyImportedScoresResult{}, nil
 }
 
+func (f *FakeService) CreateRoundSeries(ctx context.Context, req *roundservice.CreateRoundSeriesRequest) (roundservice.RoundSeriesResult, error) {
+	f.record("CreateRoundSeries")
+	if f.CreateRoundSeriesFunc != nil {
+		return f.CreateRoundSeriesFunc(ctx, req)
+	}
+	return roundservice.RoundSeriesResult{}, nil
+}
+
+func (f *FakeService) ListRoundSeries(ctx context.Context, guildID sharedtypes.GuildID) (roundservice.ListRoundSeriesResult, error) {
+	f.record("ListRoundSeries")
+	if f.ListRoundSeriesFunc != nil {
+		return f.ListRoundSeriesFunc(ctx, guildID)
+	}
+	return roundservice.ListRoundSeriesResult{}, nil
+}
+
+func (f *FakeService) UpdateRoundSeries(ctx context.Context, req *roundservice.UpdateRoundSeriesRequest) (roundservice.UpdateRoundSeriesResult, error) {
+	f.record("UpdateRoundSeries")
+	if f.UpdateRoundSeriesFunc != nil {
+		return f.UpdateRoundSeriesFunc(ctx, req)
+	}
+	return roundservice.UpdateRoundSeriesResult{}, nil
+}
+
+func (f *FakeService) SkipRoundSeriesOccurrence(ctx context.Context, req *roundservice.RoundSeriesOccurrenceRequest) (roundservice.RoundSeriesOccurrenceResult, error) {
+	f.record("SkipRoundSeriesOccurrence")
+	if f.SkipRoundSeriesOccurrenceFunc != nil {
+		return f.SkipRoundSeriesOccurrenceFunc(ctx, req)
+	}
+	return roundservice.RoundSeriesOccurrenceResult{}, nil
+}
+
+func (f *FakeService) OverrideRoundSeriesOccurrence(ctx context.Context, req *roundservice.RoundSeriesOccurrenceRequest) (roundservice.RoundSeriesOccurrenceResult, error) {
+	f.record("OverrideRoundSeriesOccurrence")
+	if f.OverrideRoundSeriesOccurrenceFunc != nil {
+		return f.OverrideRoundSeriesOccurrenceFunc(ctx, req)
+	}
+	return roundservice.RoundSeriesOccurrenceResult{}, nil
+}
+
+func (f *FakeService) MaterializeRoundSeries(ctx context.Context, now time.Time) (roundservice.MaterializeRoundSeriesResult, error) {
+	f.record("MaterializeRoundSeries")
+	if f.MaterializeRoundSeriesFunc != nil {
+		return f.MaterializeRoundSeriesFunc(ctx, now)
+	}
+	return roundservice.MaterializeRoundSeriesResult{}, nil
+}
+
+func (f *FakeService) SubmitHoleScore(ctx context.Context, req *roundservice.SubmitHoleScoreRequest) (roundservice.HoleScoreUpdateResult, error) {
+	f.record("SubmitHoleScore")
+	if f.SubmitHoleScoreFunc != nil {
+		return f.SubmitHoleScoreFunc(ctx, req)
+	}
+	return roundservice.HoleScoreUpdateResult{}, nil
+}
+
+func (f *FakeService) SubmitTeamScore(ctx context.Context, req *roundservice.SubmitTeamScoreRequest) (roundservice.ScoreUpdateResult, error) {
+	f.record("SubmitTeamScore")
+	if f.SubmitTeamScoreFunc != nil {
+		return f.SubmitTeamScoreFunc(ctx, req)
+	}
+	return roundservice.ScoreUpdateResult{}, nil
+}
+
+func (f *FakeService) SetRoundCourseLayout(ctx context.Context, req *roundservice.SetRoundCourseLayoutRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+	f.record("SetRoundCourseLayout")
+	if f.SetRoundCourseLayoutFunc != nil {
+		return f.SetRoundCourseLayoutFunc(ctx, req)
+	}
+	return results.OperationResult[*roundtypes.Round, error]{}, nil
+}
+
+func (f *FakeService) GetCourseLayoutRounds(ctx context.Context, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error) {
+	f.record("GetCourseLayoutRounds")
+	if f.GetCourseLayoutRoundsFunc != nil {
+		return f.GetCourseLayoutRoundsFunc(ctx, guildID, layoutID, startTime)
+	}
+	return nil, nil
+}
+
+func (f *FakeService) SetRoundScoringMode(ctx context.Context, req *roundservice.SetRoundScoringModeRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+	f.record("SetRoundScoringMode")
+	if f.SetRoundScoringModeFunc != nil {
+		return f.SetRoundScoringModeFunc(ctx, req)
+	}
+	return results.OperationResult[*roundtypes.Round, error]{}, nil
+}
+
+func (f *FakeService) SetRoundCapacity(ctx context.Context, req *roundservice.SetRoundCapacityRequest) (roundservice.ParticipantChangeResult, error) {
+	f.record("SetRoundCapacity")
+	if f.SetRoundCapacityFunc != nil {
+		return f.SetRoundCapacityFunc(ctx, req)
+	}
+	return roundservice.ParticipantChangeResult{}, nil
+}
+
+func (f *FakeService) ConfigureRoundCards(ctx context.Context, req *roundservice.ConfigureRoundCardsRequest) (results.OperationResult[*roundservice.CardSettings, error], error) {
+	f.record("ConfigureRoundCards")
+	if f.ConfigureRoundCardsFunc != nil {
+		return f.ConfigureRoundCardsFunc(ctx, req)
+	}
+	return results.OperationResult[*roundservice.CardSettings, error]{}, nil
+}
+
+func (f *FakeService) GetRoundTeeSheet(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[[]roundservice.Card, error], error) {
+	f.record("GetRoundTeeSheet")
+	if f.GetRoundTeeSheetFunc != nil {
+		return f.GetRoundTeeSheetFunc(ctx, guildID, roundID)
+	}
+	return results.OperationResult[[]roundservice.Card, error]{}, nil
+}
+
+func (f *FakeService) ConfigureTeamDraw(ctx context.Context, req *roundservice.ConfigureTeamDrawRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+	f.record("ConfigureTeamDraw")
+	if f.ConfigureTeamDrawFunc != nil {
+		return f.ConfigureTeamDrawFunc(ctx, req)
+	}
+	return results.OperationResult[*roundtypes.Round, error]{}, nil
+}
+
+func (f *FakeService) ConfigureRoundCheckIn(ctx context.Context, req *roundservice.ConfigureRoundCheckInRequest) (roundservice.RoundCheckInResult, error) {
+	f.record("ConfigureRoundCheckIn")
+	if f.ConfigureRoundCheckInFunc != nil {
+		return f.ConfigureRoundCheckInFunc(ctx, req)
+	}
+	return roundservice.RoundCheckInResult{}, nil
+}
+
+func (f *FakeService) OpenRoundCheckIn(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (roundservice.RoundCheckInResult, error) {
+	f.record("OpenRoundCheckIn")
+	if f.OpenRoundCheckInFunc != nil {
+		return f.OpenRoundCheckInFunc(ctx, guildID, roundID)
+	}
+	return roundservice.RoundCheckInResult{}, nil
+}
+
+func (f *FakeService) CheckInParticipant(ctx context.Context, req *roundservice.CheckInRequest) (roundservice.RoundCheckInResult, error) {
+	f.record("CheckInParticipant")
+	if f.CheckInParticipantFunc != nil {
+		return f.CheckInParticipantFunc(ctx, req)
+	}
+	return roundservice.RoundCheckInResult{}, nil
+}
+
+func (f *FakeService) GetMemberReliability(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (results.OperationResult[*roundservice.MemberReliability, error], error) {
+	f.record("GetMemberReliability")
+	if f.GetMemberReliabilityFunc != nil {
+		return f.GetMemberReliabilityFunc(ctx, guildID, userID)
+	}
+	return results.OperationResult[*roundservice.MemberReliability, error]{}, nil
+}
+
+// UpdateParticipantStatusWithWaitlist falls back to UpdateParticipantStatus, so tests
+// that stub the plain status update see it reported as an uncapped change.
+func (f *FakeService) UpdateParticipantStatusWithWaitlist(ctx context.Context, req *roundtypes.JoinRoundRequest) (roundservice.ParticipantChangeResult, error) {
+	if f.UpdateParticipantStatusWithWaitlistFunc != nil {
+		f.record("UpdateParticipantStatusWithWaitlist")
+		return f.UpdateParticipantStatusWithWaitlistFunc(ctx, req)
+	}
+	return uncappedChange(f.UpdateParticipantStatus(ctx, req))
+}
+
+// ParticipantRemovalWithWaitlist falls back to ParticipantRemoval, so tests that stub
+// the plain removal see it reported as an uncapped change.
+func (f *FakeService) ParticipantRemovalWithWaitlist(ctx context.Context, req *roundtypes.JoinRoundRequest) (roundservice.ParticipantChangeResult, error) {
+	if f.ParticipantRemovalWithWaitlistFunc != nil {
+		f.record("ParticipantRemovalWithWaitlist")
+		return f.ParticipantRemovalWithWaitlistFunc(ctx, req)
+	}
+	return uncappedChange(f.ParticipantRemoval(ctx, req))
+}
+
+func uncappedChange(result results.OperationResult[*roundtypes.Round, error], err error) (roundservice.ParticipantChangeResult, error) {
+	if err != nil || result.Success == nil {
+		return roundservice.ParticipantChangeResult{Failure: result.Failure}, err
+	}
+	return results.SuccessResult[*roundservice.ParticipantChange, error](&roundservice.ParticipantChange{Round: *result.Success}), nil
+}
+
 var _ roundservice.Service = (*FakeService)(nil)
 var _ userservice.Service = (*FakeUserService)(nil)
 var _ utils.Helpers = (*FakeHelpers)(nil)
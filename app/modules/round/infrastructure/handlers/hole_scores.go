@@ -0,0 +1,156 @@
+package roundhandlers
+
+import (
+	"context"
+	"fmt"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Live hole-by-hole scoring topics.
+const (
+	RoundHoleScoreSubmitRequestedV1 = "round.hole.score.submit.requested.v1"
+	RoundHoleScoreUpdatedV1         = "round.hole.score.updated.v1"
+	RoundHoleScoreFailedV1          = "round.hole.score.failed.v1"
+)
+
+// RoundHoleScoreSubmitRequestPayloadV1 submits the strokes for one hole of a live round.
+// SubmittedBy is the member sending the score; only admins and editors may submit for
+// someone other than themselves.
+type RoundHoleScoreSubmitRequestPayloadV1 struct {
+	GuildID     sharedtypes.GuildID   `json:"guild_id"`
+	RoundID     sharedtypes.RoundID   `json:"round_id"`
+	UserID      sharedtypes.DiscordID `json:"user_id"`
+	SubmittedBy sharedtypes.DiscordID `json:"submitted_by"`
+	Hole        int                   `json:"hole"`
+	Strokes     int                   `json:"strokes"`
+}
+
+// RoundHoleScoreUpdatedPayloadV1 carries a participant's live card and running totals.
+type RoundHoleScoreUpdatedPayloadV1 struct {
+	GuildID        sharedtypes.GuildID          `json:"guild_id"`
+	RoundID        sharedtypes.RoundID          `json:"round_id"`
+	UserID         sharedtypes.DiscordID        `json:"user_id"`
+	EventMessageID string                       `json:"event_message_id,omitempty"`
+	Hole           int                          `json:"hole"`
+	Strokes        int                          `json:"strokes"`
+	HoleScores     []int                        `json:"hole_scores"`
+	Totals         roundservice.HoleScoreTotals `json:"totals"`
+	Participants   []roundtypes.Participant     `json:"participants"`
+}
+
+// RoundHoleScoreFailedPayloadV1 reports a rejected hole submission.
+type RoundHoleScoreFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	Hole    int                   `json:"hole"`
+	Reason  string                `json:"reason"`
+}
+
+// HandleHoleScoreSubmitRequest records a hole score for a live round. When the
+// submission completes the participant's card, a participant score update is also
+// published so the existing all-scores-submitted check can finalize the round.
+func (h *RoundHandlers) HandleHoleScoreSubmitRequest(ctx context.Context, payload *RoundHoleScoreSubmitRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.authorizeHoleScoreSubmitter(ctx, payload); err != nil {
+		return h.holeScoreFailure(ctx, payload, err), nil
+	}
+
+	result, err := h.service.SubmitHoleScore(ctx, &roundservice.SubmitHoleScoreRequest{
+		GuildID: payload.GuildID,
+		RoundID: payload.RoundID,
+		UserID:  payload.UserID,
+		Hole:    payload.Hole,
+		Strokes: payload.Strokes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Failure != nil {
+		return h.holeScoreFailure(ctx, payload, *result.Failure), nil
+	}
+
+	update := *result.Success
+	results := handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundHoleScoreUpdatedV1,
+		Payload: &RoundHoleScoreUpdatedPayloadV1{
+			GuildID:        update.GuildID,
+			RoundID:        update.RoundID,
+			UserID:         update.UserID,
+			EventMessageID: update.EventMessageID,
+			Hole:           update.Hole,
+			Strokes:        update.Strokes,
+			HoleScores:     update.HoleScores,
+			Totals:         update.Totals,
+			Participants:   update.Participants,
+		},
+	}})
+	results = h.addParallelIdentityResults(ctx, results, RoundHoleScoreUpdatedV1, update.GuildID)
+
+	if update.Totals.Complete {
+		scoreResults := []handlerwrapper.Result{{
+			Topic: roundevents.RoundParticipantScoreUpdatedV2,
+			Payload: &roundevents.ParticipantScoreUpdatedPayloadV1{
+				GuildID:        update.GuildID,
+				RoundID:        update.RoundID,
+				UserID:         update.UserID,
+				Score:          sharedtypes.Score(update.Totals.RelativeToPar),
+				EventMessageID: update.EventMessageID,
+				Participants:   update.Participants,
+			},
+		}}
+		scoreResults = h.addParallelIdentityResults(ctx, scoreResults, roundevents.RoundParticipantScoreUpdatedV2, update.GuildID)
+		results = append(results, scoreResults...)
+	}
+
+	return results, nil
+}
+
+// authorizeHoleScoreSubmitter lets players record their own card and admins or editors
+// keep score for anyone in the round.
+func (h *RoundHandlers) authorizeHoleScoreSubmitter(ctx context.Context, payload *RoundHoleScoreSubmitRequestPayloadV1) error {
+	if payload.SubmittedBy == "" {
+		return fmt.Errorf("%w: submitted_by is required", roundservice.ErrUnauthorized)
+	}
+	if payload.SubmittedBy == payload.UserID {
+		return nil
+	}
+
+	roleResult, err := h.userService.GetUserRole(ctx, payload.GuildID, payload.SubmittedBy)
+	if err != nil {
+		return fmt.Errorf("failed to verify scorekeeper role: %w", err)
+	}
+	if roleResult.Success == nil || (*roleResult.Success != sharedtypes.UserRoleAdmin && *roleResult.Success != sharedtypes.UserRoleEditor) {
+		return fmt.Errorf("%w: only admins and editors can submit another player's score", roundservice.ErrUnauthorized)
+	}
+
+	return nil
+}
+
+func (h *RoundHandlers) holeScoreFailure(ctx context.Context, payload *RoundHoleScoreSubmitRequestPayloadV1, err error) []handlerwrapper.Result {
+	h.logger.WarnContext(ctx, "Hole score submission rejected",
+		attr.RoundID("round_id", payload.RoundID),
+		attr.String("user_id", string(payload.UserID)),
+		attr.String("submitted_by", string(payload.SubmittedBy)),
+		attr.Int("hole", payload.Hole),
+		attr.Error(err),
+	)
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundHoleScoreFailedV1,
+		Payload: &RoundHoleScoreFailedPayloadV1{
+			GuildID: payload.GuildID,
+			RoundID: payload.RoundID,
+			UserID:  payload.UserID,
+			Hole:    payload.Hole,
+			Reason:  err.Error(),
+		},
+	}})
+}
@@ -0,0 +1,163 @@
+package roundhandlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
+	"github.com/google/uuid"
+)
+
+func TestRoundHandlers_HandleHoleScoreSubmitRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	roundID := sharedtypes.RoundID(uuid.New())
+	payload := func(submittedBy sharedtypes.DiscordID) *RoundHoleScoreSubmitRequestPayloadV1 {
+		return &RoundHoleScoreSubmitRequestPayloadV1{
+			GuildID:     guildID,
+			RoundID:     roundID,
+			UserID:      "user-1",
+			SubmittedBy: submittedBy,
+			Hole:        3,
+			Strokes:     4,
+		}
+	}
+
+	update := func(complete bool) *roundservice.HoleScoreUpdate {
+		return &roundservice.HoleScoreUpdate{
+			GuildID:    guildID,
+			RoundID:    roundID,
+			UserID:     "user-1",
+			Hole:       3,
+			Strokes:    4,
+			HoleScores: []int{3, 3, 4},
+			Totals:     roundservice.HoleScoreTotals{HolesPlayed: 3, TotalStrokes: 10, ParPlayed: 9, RelativeToPar: 1, Complete: complete},
+		}
+	}
+
+	rejectSubmit := func(f *FakeService) {
+		f.SubmitHoleScoreFunc = func(ctx context.Context, req *roundservice.SubmitHoleScoreRequest) (roundservice.HoleScoreUpdateResult, error) {
+			t.Error("SubmitHoleScore must not be called for an unauthorized submitter")
+			return roundservice.HoleScoreUpdateResult{}, nil
+		}
+	}
+
+	tests := []struct {
+		name        string
+		submittedBy sharedtypes.DiscordID
+		role        sharedtypes.UserRoleEnum
+		fakeSetup   func(*FakeService)
+		wantErr     bool
+		wantTopics  []string
+	}{
+		{
+			name:        "partial card publishes running totals",
+			submittedBy: "user-1",
+			fakeSetup: func(f *FakeService) {
+				f.SubmitHoleScoreFunc = func(ctx context.Context, req *roundservice.SubmitHoleScoreRequest) (roundservice.HoleScoreUpdateResult, error) {
+					return results.SuccessResult[*roundservice.HoleScoreUpdate, error](update(false)), nil
+				}
+			},
+			wantTopics: []string{RoundHoleScoreUpdatedV1},
+		},
+		{
+			name:        "complete card also publishes participant score update",
+			submittedBy: "user-1",
+			fakeSetup: func(f *FakeService) {
+				f.SubmitHoleScoreFunc = func(ctx context.Context, req *roundservice.SubmitHoleScoreRequest) (roundservice.HoleScoreUpdateResult, error) {
+					return results.SuccessResult[*roundservice.HoleScoreUpdate, error](update(true)), nil
+				}
+			},
+			wantTopics: []string{RoundHoleScoreUpdatedV1, roundevents.RoundParticipantScoreUpdatedV2},
+		},
+		{
+			name:        "business failure publishes failed event",
+			submittedBy: "user-1",
+			fakeSetup: func(f *FakeService) {
+				f.SubmitHoleScoreFunc = func(ctx context.Context, req *roundservice.SubmitHoleScoreRequest) (roundservice.HoleScoreUpdateResult, error) {
+					return results.FailureResult[*roundservice.HoleScoreUpdate, error](roundservice.ErrRoundNotInProgress), nil
+				}
+			},
+			wantTopics: []string{RoundHoleScoreFailedV1},
+		},
+		{
+			name:        "service error is returned",
+			submittedBy: "user-1",
+			fakeSetup: func(f *FakeService) {
+				f.SubmitHoleScoreFunc = func(ctx context.Context, req *roundservice.SubmitHoleScoreRequest) (roundservice.HoleScoreUpdateResult, error) {
+					return roundservice.HoleScoreUpdateResult{}, errors.New("db down")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name:        "editor keeps score for another player",
+			submittedBy: "editor-1",
+			role:        sharedtypes.UserRoleEditor,
+			fakeSetup: func(f *FakeService) {
+				f.SubmitHoleScoreFunc = func(ctx context.Context, req *roundservice.SubmitHoleScoreRequest) (roundservice.HoleScoreUpdateResult, error) {
+					return results.SuccessResult[*roundservice.HoleScoreUpdate, error](update(false)), nil
+				}
+			},
+			wantTopics: []string{RoundHoleScoreUpdatedV1},
+		},
+		{
+			name:        "player cannot submit another player's score",
+			submittedBy: "user-2",
+			role:        sharedtypes.UserRoleUser,
+			fakeSetup:   rejectSubmit,
+			wantTopics:  []string{RoundHoleScoreFailedV1},
+		},
+		{
+			name:       "missing submitter is rejected",
+			fakeSetup:  rejectSubmit,
+			wantTopics: []string{RoundHoleScoreFailedV1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			tt.fakeSetup(fakeService)
+
+			fakeUsers := NewFakeUserService()
+			fakeUsers.GetUserRoleFunc = func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+				if userID != tt.submittedBy {
+					t.Errorf("expected role lookup for %s, got %s", tt.submittedBy, userID)
+				}
+				return results.SuccessResult[sharedtypes.UserRoleEnum, error](tt.role), nil
+			}
+
+			h := &RoundHandlers{
+				service:     fakeService,
+				userService: fakeUsers,
+				logger:      loggerfrolfbot.NoOpLogger,
+			}
+
+			got, err := h.HandleHoleScoreSubmitRequest(context.Background(), payload(tt.submittedBy))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HandleHoleScoreSubmitRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			topics := make(map[string]bool, len(got))
+			for _, r := range got {
+				topics[r.Topic] = true
+			}
+			for _, want := range tt.wantTopics {
+				if !topics[want] {
+					t.Errorf("expected topic %s in results, got %v", want, got)
+				}
+			}
+			wantScoreUpdate := slices.Contains(tt.wantTopics, roundevents.RoundParticipantScoreUpdatedV2)
+			if topics[roundevents.RoundParticipantScoreUpdatedV2] != wantScoreUpdate {
+				t.Errorf("participant score update published = %v, want %v", !wantScoreUpdate, wantScoreUpdate)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	sharedevents "github.com/Black-And-White-Club/frolf-bot-shared/events/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	roundqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/queue"
 )
 
 // RoundListRequest is the minimal request payload for PWA round list requests
@@ -95,4 +96,37 @@ type Handlers interface {
 
 	// PWA request/reply handlers
 	HandleRoundListRequest(ctx context.Context, payload *RoundListRequest) ([]handlerwrapper.Result, error)
+
+	// Round series handlers
+	HandleRoundSeriesCreateRequest(ctx context.Context, payload *RoundSeriesCreateRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleRoundSeriesUpdateRequest(ctx context.Context, payload *RoundSeriesUpdateRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleRoundSeriesOccurrenceSkipRequest(ctx context.Context, payload *RoundSeriesOccurrenceRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleRoundSeriesOccurrenceOverrideRequest(ctx context.Context, payload *RoundSeriesOccurrenceRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleRoundSeriesListRequest(ctx context.Context, payload *RoundSeriesListRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleRoundSeriesMaterializeRequested(ctx context.Context, payload *roundqueue.RoundSeriesMaterializeRequestedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Live hole and team scoring handlers
+	HandleHoleScoreSubmitRequest(ctx context.Context, payload *RoundHoleScoreSubmitRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleTeamScoreSubmitRequest(ctx context.Context, payload *RoundTeamScoreSubmitRequestPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Course layout handlers
+	HandleRoundCourseLayoutSetRequest(ctx context.Context, payload *RoundCourseLayoutSetRequestPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Scoring mode handlers
+	HandleRoundScoringModeSetRequest(ctx context.Context, payload *RoundScoringModeSetRequestPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Capacity handlers
+	HandleRoundCapacitySetRequest(ctx context.Context, payload *RoundCapacitySetRequestPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Card handlers
+	HandleRoundCardsConfigureRequest(ctx context.Context, payload *RoundCardsConfigureRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleRoundTeeSheetRequest(ctx context.Context, payload *RoundTeeSheetRequestPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Team draw handlers
+	HandleRoundTeamDrawSetRequest(ctx context.Context, payload *RoundTeamDrawSetRequestPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Check-in handlers
+	HandleRoundCheckInConfigureRequest(ctx context.Context, payload *RoundCheckInConfigureRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleRoundCheckInRequest(ctx context.Context, payload *RoundCheckInRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleRoundCheckInOpenRequested(ctx context.Context, payload *roundqueue.RoundCheckInOpenRequestedPayloadV1) ([]handlerwrapper.Result, error)
 }
@@ -157,7 +157,7 @@ func (h *RoundHandlers) HandleParticipantStatusUpdateRequest(
 		JoinedLate: payload.JoinedLate,
 	}
 
-	result, err := h.service.UpdateParticipantStatus(ctx, req)
+	result, err := h.service.UpdateParticipantStatusWithWaitlist(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -176,8 +176,12 @@ func (h *RoundHandlers) HandleParticipantStatusUpdateRequest(
 		return results, nil
 	}
 
-	round := result.Success
-	joinedPayload := h.createJoinedPayload(*round, payload.JoinedLate)
+	change := *result.Success
+	if change.Waitlisted {
+		// The round is full; the player waits for a seat instead of joining.
+		return h.waitlistedResults(ctx, change, payload.UserID, payload.GuildID), nil
+	}
+	joinedPayload := h.createJoinedPayload(change.Round, payload.JoinedLate)
 
 	results := []handlerwrapper.Result{
 		{Topic: roundevents.RoundParticipantJoinedV2, Payload: joinedPayload},
@@ -186,6 +190,9 @@ func (h *RoundHandlers) HandleParticipantStatusUpdateRequest(
 	// Add both legacy GuildID and internal ClubUUID scoped versions for PWA/NATS transition
 	results = h.addParallelIdentityResults(ctx, results, roundevents.RoundParticipantJoinedV2, payload.GuildID)
 
+	// A decline can free a seat for the next waitlisted player.
+	results = append(results, h.promotedResults(ctx, change, payload.GuildID)...)
+
 	return results, nil
 }
 
@@ -200,7 +207,7 @@ func (h *RoundHandlers) HandleParticipantRemovalRequest(
 		UserID:  payload.UserID,
 	}
 
-	result, err := h.service.ParticipantRemoval(ctx, req)
+	result, err := h.service.ParticipantRemovalWithWaitlist(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -220,14 +227,15 @@ func (h *RoundHandlers) HandleParticipantRemovalRequest(
 		return results, nil
 	}
 
-	round := result.Success
+	change := *result.Success
+	round := change.Round
 	removedPayload := &roundevents.ParticipantRemovedPayloadV1{
-		GuildID:        (*round).GuildID,
-		RoundID:        (*round).ID,
+		GuildID:        round.GuildID,
+		RoundID:        round.ID,
 		UserID:         payload.UserID,
-		EventMessageID: (*round).EventMessageID,
+		EventMessageID: round.EventMessageID,
 	}
-	removedPayload.AcceptedParticipants, removedPayload.DeclinedParticipants, removedPayload.TentativeParticipants = h.splitParticipants((*round).Participants)
+	removedPayload.AcceptedParticipants, removedPayload.DeclinedParticipants, removedPayload.TentativeParticipants = h.splitParticipants(round.Participants)
 
 	results := []handlerwrapper.Result{
 		{Topic: roundevents.RoundParticipantRemovedV2, Payload: removedPayload},
@@ -236,6 +244,9 @@ func (h *RoundHandlers) HandleParticipantRemovalRequest(
 	// Add both legacy GuildID and internal ClubUUID scoped versions for PWA/NATS transition
 	results = h.addParallelIdentityResults(ctx, results, roundevents.RoundParticipantRemovedV2, payload.GuildID)
 
+	// The freed seat goes to the next waitlisted player.
+	results = append(results, h.promotedResults(ctx, change, payload.GuildID)...)
+
 	return results, nil
 }
 
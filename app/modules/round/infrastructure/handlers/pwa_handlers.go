@@ -8,6 +8,7 @@ import (
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	usertypes "github.com/Black-And-White-Club/frolf-bot-shared/types/user"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
 )
 
 // HandleRoundListRequest handles PWA requests for round list
@@ -47,10 +48,7 @@ func (h *RoundHandlers) HandleRoundListRequest(
 	}
 
 	// Check for reply_to subject for Request-Reply pattern
-	topic := "round.list.response.v1"
-	if replyTo, ok := ctx.Value(handlerwrapper.CtxKeyReplyTo).(string); ok && replyTo != "" {
-		topic = replyTo
-	}
+	topic := handlerutil.ReplyTopic(ctx, "round.list.response.v1")
 
 	results := []handlerwrapper.Result{
 		{
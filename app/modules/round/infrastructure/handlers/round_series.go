@@ -0,0 +1,359 @@
+package roundhandlers
+
+import (
+	"context"
+	"time"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	sharedevents "github.com/Black-And-White-Club/frolf-bot-shared/events/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	roundqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/queue"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+	"github.com/google/uuid"
+)
+
+// Round series topics.
+const (
+	RoundSeriesCreateRequestedV1             = "round.series.create.requested.v1"
+	RoundSeriesCreatedV1                     = "round.series.created.v1"
+	RoundSeriesUpdateRequestedV1             = "round.series.update.requested.v1"
+	RoundSeriesUpdatedV1                     = "round.series.updated.v1"
+	RoundSeriesOccurrenceSkipRequestedV1     = "round.series.occurrence.skip.requested.v1"
+	RoundSeriesOccurrenceOverrideRequestedV1 = "round.series.occurrence.override.requested.v1"
+	RoundSeriesOccurrenceUpdatedV1           = "round.series.occurrence.updated.v1"
+	RoundSeriesFailedV1                      = "round.series.failed.v1"
+	RoundSeriesListRequestV1                 = "round.series.list.request.v1"
+	RoundSeriesListResponseV1                = "round.series.list.response.v1"
+)
+
+// RoundSeriesCreateRequestPayloadV1 is the request to create a recurring round series.
+type RoundSeriesCreateRequestPayloadV1 struct {
+	GuildID              sharedtypes.GuildID    `json:"guild_id"`
+	UserID               sharedtypes.DiscordID  `json:"user_id"`
+	ChannelID            string                 `json:"channel_id,omitempty"`
+	Title                roundtypes.Title       `json:"title"`
+	Description          roundtypes.Description `json:"description,omitempty"`
+	Location             roundtypes.Location    `json:"location"`
+	EventType            *roundtypes.EventType  `json:"event_type,omitempty"`
+	Mode                 sharedtypes.RoundMode  `json:"mode,omitempty"`
+	RecurrenceRule       string                 `json:"recurrence_rule"`
+	Timezone             string                 `json:"timezone"`
+	FirstStart           time.Time              `json:"first_start"`
+	MaterializeDaysAhead int                    `json:"materialize_days_ahead,omitempty"`
+}
+
+// RoundSeriesUpdateRequestPayloadV1 is a series-wide edit; omitted fields are unchanged.
+type RoundSeriesUpdateRequestPayloadV1 struct {
+	GuildID              sharedtypes.GuildID     `json:"guild_id"`
+	SeriesID             uuid.UUID               `json:"series_id"`
+	UserID               sharedtypes.DiscordID   `json:"user_id"`
+	Title                *roundtypes.Title       `json:"title,omitempty"`
+	Description          *roundtypes.Description `json:"description,omitempty"`
+	Location             *roundtypes.Location    `json:"location,omitempty"`
+	EventType            *roundtypes.EventType   `json:"event_type,omitempty"`
+	Mode                 *sharedtypes.RoundMode  `json:"mode,omitempty"`
+	RecurrenceRule       *string                 `json:"recurrence_rule,omitempty"`
+	Timezone             *string                 `json:"timezone,omitempty"`
+	StartTime            *time.Time              `json:"start_time,omitempty"`
+	MaterializeDaysAhead *int                    `json:"materialize_days_ahead,omitempty"`
+	Active               *bool                   `json:"active,omitempty"`
+}
+
+// RoundSeriesOccurrenceRequestPayloadV1 targets a single occurrence for skip or override.
+type RoundSeriesOccurrenceRequestPayloadV1 struct {
+	GuildID      sharedtypes.GuildID   `json:"guild_id"`
+	SeriesID     uuid.UUID             `json:"series_id"`
+	UserID       sharedtypes.DiscordID `json:"user_id"`
+	OccurrenceAt time.Time             `json:"occurrence_at"`
+	Title        *roundtypes.Title     `json:"title,omitempty"`
+	Location     *roundtypes.Location  `json:"location,omitempty"`
+	StartTime    *time.Time            `json:"start_time,omitempty"`
+}
+
+// RoundSeriesListRequestPayloadV1 is the request/reply payload for listing a guild's series.
+type RoundSeriesListRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+}
+
+// RoundSeriesListResponsePayloadV1 is the reply for RoundSeriesListRequestV1.
+type RoundSeriesListResponsePayloadV1 struct {
+	GuildID sharedtypes.GuildID             `json:"guild_id"`
+	Series  []*roundservice.RoundSeriesInfo `json:"series"`
+}
+
+// RoundSeriesResultPayloadV1 is published after a series is created or updated.
+type RoundSeriesResultPayloadV1 struct {
+	GuildID sharedtypes.GuildID           `json:"guild_id"`
+	Series  *roundservice.RoundSeriesInfo `json:"series"`
+}
+
+// RoundSeriesFailedPayloadV1 reports a rejected series operation.
+type RoundSeriesFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	Reason  string                `json:"reason"`
+}
+
+// HandleRoundSeriesCreateRequest creates a recurring round series (admin only).
+func (h *RoundHandlers) HandleRoundSeriesCreateRequest(ctx context.Context, payload *RoundSeriesCreateRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.ensureAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.roundSeriesFailure(ctx, payload.GuildID, payload.UserID, err), nil
+	}
+
+	result, err := h.service.CreateRoundSeries(ctx, &roundservice.CreateRoundSeriesRequest{
+		GuildID:              payload.GuildID,
+		UserID:               payload.UserID,
+		ChannelID:            payload.ChannelID,
+		Title:                payload.Title,
+		Description:          payload.Description,
+		Location:             payload.Location,
+		EventType:            payload.EventType,
+		Mode:                 payload.Mode,
+		RecurrenceRule:       payload.RecurrenceRule,
+		Timezone:             payload.Timezone,
+		FirstStart:           payload.FirstStart,
+		MaterializeDaysAhead: payload.MaterializeDaysAhead,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.roundSeriesFailure(ctx, payload.GuildID, payload.UserID, *result.Failure), nil
+	}
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic:   RoundSeriesCreatedV1,
+		Payload: &RoundSeriesResultPayloadV1{GuildID: payload.GuildID, Series: *result.Success},
+	}}), nil
+}
+
+// HandleRoundSeriesUpdateRequest applies a series-wide edit and republishes the
+// not-yet-started rounds it touched so Discord embeds and schedules stay in sync.
+func (h *RoundHandlers) HandleRoundSeriesUpdateRequest(ctx context.Context, payload *RoundSeriesUpdateRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.ensureAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.roundSeriesFailure(ctx, payload.GuildID, payload.UserID, err), nil
+	}
+
+	result, err := h.service.UpdateRoundSeries(ctx, &roundservice.UpdateRoundSeriesRequest{
+		GuildID:              payload.GuildID,
+		SeriesID:             payload.SeriesID,
+		UserID:               payload.UserID,
+		Title:                payload.Title,
+		Description:          payload.Description,
+		Location:             payload.Location,
+		EventType:            payload.EventType,
+		Mode:                 payload.Mode,
+		RecurrenceRule:       payload.RecurrenceRule,
+		Timezone:             payload.Timezone,
+		StartTime:            payload.StartTime,
+		MaterializeDaysAhead: payload.MaterializeDaysAhead,
+		Active:               payload.Active,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.roundSeriesFailure(ctx, payload.GuildID, payload.UserID, *result.Failure), nil
+	}
+
+	update := *result.Success
+	results := handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic:   RoundSeriesUpdatedV1,
+		Payload: &RoundSeriesResultPayloadV1{GuildID: payload.GuildID, Series: update.Series},
+	}})
+
+	rescheduled := make(map[sharedtypes.RoundID]bool, len(update.RescheduledRounds))
+	for _, round := range update.RescheduledRounds {
+		rescheduled[round.ID] = true
+	}
+	for _, round := range update.UpdatedRounds {
+		results = append(results, h.roundUpdatedResults(ctx, payload.GuildID, round, rescheduled[round.ID])...)
+	}
+
+	return results, nil
+}
+
+// HandleRoundSeriesOccurrenceSkipRequest skips one occurrence, deleting its round if
+// it was already materialized.
+func (h *RoundHandlers) HandleRoundSeriesOccurrenceSkipRequest(ctx context.Context, payload *RoundSeriesOccurrenceRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.ensureAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.roundSeriesFailure(ctx, payload.GuildID, payload.UserID, err), nil
+	}
+
+	result, err := h.service.SkipRoundSeriesOccurrence(ctx, toOccurrenceRequest(payload))
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.roundSeriesFailure(ctx, payload.GuildID, payload.UserID, *result.Failure), nil
+	}
+
+	info := *result.Success
+	results := handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{Topic: RoundSeriesOccurrenceUpdatedV1, Payload: info}})
+
+	if deleted := info.DeletedRound; deleted != nil {
+		deletedResults := []handlerwrapper.Result{{
+			Topic: roundevents.RoundDeletedV2,
+			Payload: &roundevents.RoundDeletedPayloadV1{
+				GuildID:        payload.GuildID,
+				RoundID:        deleted.ID,
+				EventMessageID: deleted.EventMessageID,
+				DiscordEventID: deleted.DiscordEventID,
+			},
+		}}
+		results = append(results, h.addParallelIdentityResults(ctx, deletedResults, roundevents.RoundDeletedV2, payload.GuildID)...)
+	}
+
+	return results, nil
+}
+
+// HandleRoundSeriesOccurrenceOverrideRequest changes a single occurrence's title,
+// location or start time.
+func (h *RoundHandlers) HandleRoundSeriesOccurrenceOverrideRequest(ctx context.Context, payload *RoundSeriesOccurrenceRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.ensureAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.roundSeriesFailure(ctx, payload.GuildID, payload.UserID, err), nil
+	}
+
+	result, err := h.service.OverrideRoundSeriesOccurrence(ctx, toOccurrenceRequest(payload))
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.roundSeriesFailure(ctx, payload.GuildID, payload.UserID, *result.Failure), nil
+	}
+
+	info := *result.Success
+	results := handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{Topic: RoundSeriesOccurrenceUpdatedV1, Payload: info}})
+	if info.Round != nil {
+		results = append(results, h.roundUpdatedResults(ctx, payload.GuildID, info.Round, info.Rescheduled)...)
+	}
+
+	return results, nil
+}
+
+// HandleRoundSeriesListRequest replies with the guild's round series.
+func (h *RoundHandlers) HandleRoundSeriesListRequest(ctx context.Context, payload *RoundSeriesListRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	result, err := h.service.ListRoundSeries(ctx, payload.GuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RoundSeriesListResponsePayloadV1{GuildID: payload.GuildID, Series: []*roundservice.RoundSeriesInfo{}}
+	if result.Success != nil {
+		response.Series = *result.Success
+	}
+
+	topic := handlerutil.ReplyTopic(ctx, RoundSeriesListResponseV1)
+
+	return []handlerwrapper.Result{{Topic: topic, Payload: response}}, nil
+}
+
+// HandleRoundSeriesMaterializeRequested is triggered by the periodic queue job and
+// publishes RoundCreatedV2 for every round materialized from a series, so the rest of
+// the pipeline (Discord embed, message ID update, scheduling) runs unchanged.
+func (h *RoundHandlers) HandleRoundSeriesMaterializeRequested(ctx context.Context, payload *roundqueue.RoundSeriesMaterializeRequestedPayloadV1) ([]handlerwrapper.Result, error) {
+	now := payload.RequestedAt
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+
+	result, err := h.service.MaterializeRoundSeries(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	if result.Success == nil {
+		return nil, nil
+	}
+
+	output := *result.Success
+	var results []handlerwrapper.Result
+	for _, materialized := range output.Rounds {
+		created := materialized.Created
+		if created == nil || created.Round == nil {
+			continue
+		}
+		r := created.Round
+
+		channelID := materialized.ChannelID
+		if channelID == "" && created.GuildConfig != nil {
+			channelID = created.GuildConfig.EventChannelID
+		}
+
+		createdResults := []handlerwrapper.Result{{
+			Topic: roundevents.RoundCreatedV2,
+			Payload: &roundevents.RoundCreatedPayloadV1{
+				GuildID: r.GuildID,
+				BaseRoundPayload: roundtypes.BaseRoundPayload{
+					RoundID:     r.ID,
+					Title:       r.Title,
+					Description: r.Description,
+					Location:    r.Location,
+					StartTime:   r.StartTime,
+					UserID:      r.CreatedBy,
+				},
+				ChannelID: channelID,
+				Config:    sharedevents.NewGuildConfigFragment(created.GuildConfig),
+			},
+		}}
+		results = append(results, h.addParallelIdentityResults(ctx, createdResults, roundevents.RoundCreatedV2, r.GuildID)...)
+	}
+
+	if len(output.Rounds) > 0 || len(output.FailedSeries) > 0 {
+		h.logger.InfoContext(ctx, "Round series materialization completed",
+			attr.Int("rounds_created", len(output.Rounds)),
+			attr.Int("series_failed", len(output.FailedSeries)),
+		)
+	}
+
+	return results, nil
+}
+
+func (h *RoundHandlers) roundUpdatedResults(ctx context.Context, guildID sharedtypes.GuildID, round *roundtypes.Round, rescheduled bool) []handlerwrapper.Result {
+	updatedPayload := &roundevents.RoundEntityUpdatedPayloadV1{
+		GuildID: guildID,
+		Round:   *round,
+	}
+
+	results := []handlerwrapper.Result{{Topic: roundevents.RoundUpdatedV2, Payload: updatedPayload}}
+	results = h.addParallelIdentityResults(ctx, results, roundevents.RoundUpdatedV2, guildID)
+	if rescheduled {
+		results = append(results, handlerwrapper.Result{
+			Topic:   roundevents.RoundScheduleUpdatedV1,
+			Payload: updatedPayload,
+		})
+	}
+	return results
+}
+
+func (h *RoundHandlers) roundSeriesFailure(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID, err error) []handlerwrapper.Result {
+	h.logger.WarnContext(ctx, "Round series request rejected",
+		attr.String("guild_id", string(guildID)),
+		attr.String("user_id", string(userID)),
+		attr.Error(err),
+	)
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundSeriesFailedV1,
+		Payload: &RoundSeriesFailedPayloadV1{
+			GuildID: guildID,
+			UserID:  userID,
+			Reason:  err.Error(),
+		},
+	}})
+}
+
+func toOccurrenceRequest(payload *RoundSeriesOccurrenceRequestPayloadV1) *roundservice.RoundSeriesOccurrenceRequest {
+	return &roundservice.RoundSeriesOccurrenceRequest{
+		GuildID:      payload.GuildID,
+		SeriesID:     payload.SeriesID,
+		UserID:       payload.UserID,
+		OccurrenceAt: payload.OccurrenceAt,
+		Title:        payload.Title,
+		Location:     payload.Location,
+		StartTime:    payload.StartTime,
+	}
+}
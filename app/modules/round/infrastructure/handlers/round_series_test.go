@@ -0,0 +1,376 @@
+package roundhandlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
+	"github.com/google/uuid"
+)
+
+const testSeriesReplyInbox = "_INBOX.series"
+
+func roundSeriesTestHandlers(fakeService *FakeService, role sharedtypes.UserRoleEnum) *RoundHandlers {
+	fakeUsers := NewFakeUserService()
+	fakeUsers.GetUserRoleFunc = func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+		return results.SuccessResult[sharedtypes.UserRoleEnum, error](role), nil
+	}
+	fakeUsers.GetClubUUIDByDiscordGuildIDFunc = func(ctx context.Context, guildID sharedtypes.GuildID) (uuid.UUID, error) {
+		return uuid.Nil, nil
+	}
+	return &RoundHandlers{service: fakeService, userService: fakeUsers, logger: loggerfrolfbot.NoOpLogger}
+}
+
+func roundSeriesTestContext(replyTo string) context.Context {
+	if replyTo == "" {
+		return context.Background()
+	}
+	return context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, replyTo)
+}
+
+func TestRoundHandlers_HandleRoundSeriesCreateRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	series := &roundservice.RoundSeriesInfo{ID: uuid.New(), GuildID: guildID, Title: "Weekly Doubles"}
+	payload := &RoundSeriesCreateRequestPayloadV1{
+		GuildID:        guildID,
+		UserID:         "admin-1",
+		Title:          "Weekly Doubles",
+		Location:       "Central Park",
+		RecurrenceRule: "FREQ=WEEKLY;BYDAY=TU",
+		Timezone:       "America/Chicago",
+		FirstStart:     time.Date(2026, 11, 3, 18, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name       string
+		role       sharedtypes.UserRoleEnum
+		replyTo    string
+		fakeSetup  func(*FakeService)
+		wantTopics []string
+		wantErr    bool
+	}{
+		{
+			name: "admin creates a series",
+			role: sharedtypes.UserRoleAdmin,
+			fakeSetup: func(f *FakeService) {
+				f.CreateRoundSeriesFunc = func(ctx context.Context, req *roundservice.CreateRoundSeriesRequest) (roundservice.RoundSeriesResult, error) {
+					if req.RecurrenceRule != payload.RecurrenceRule || req.Timezone != payload.Timezone {
+						t.Errorf("unexpected request %+v", req)
+					}
+					return results.SuccessResult[*roundservice.RoundSeriesInfo, error](series), nil
+				}
+			},
+			wantTopics: []string{RoundSeriesCreatedV1},
+		},
+		{
+			name:    "reply inbox receives the created series",
+			role:    sharedtypes.UserRoleAdmin,
+			replyTo: testSeriesReplyInbox,
+			fakeSetup: func(f *FakeService) {
+				f.CreateRoundSeriesFunc = func(ctx context.Context, req *roundservice.CreateRoundSeriesRequest) (roundservice.RoundSeriesResult, error) {
+					return results.SuccessResult[*roundservice.RoundSeriesInfo, error](series), nil
+				}
+			},
+			wantTopics: []string{RoundSeriesCreatedV1, testSeriesReplyInbox},
+		},
+		{
+			name:       "non-admin is rejected",
+			role:       sharedtypes.UserRoleUser,
+			replyTo:    testSeriesReplyInbox,
+			fakeSetup:  func(f *FakeService) {},
+			wantTopics: []string{RoundSeriesFailedV1, testSeriesReplyInbox},
+		},
+		{
+			name: "invalid series publishes failed event",
+			role: sharedtypes.UserRoleAdmin,
+			fakeSetup: func(f *FakeService) {
+				f.CreateRoundSeriesFunc = func(ctx context.Context, req *roundservice.CreateRoundSeriesRequest) (roundservice.RoundSeriesResult, error) {
+					return results.FailureResult[*roundservice.RoundSeriesInfo, error](roundservice.ErrInvalidRoundSeries), nil
+				}
+			},
+			wantTopics: []string{RoundSeriesFailedV1},
+		},
+		{
+			name: "service error is returned",
+			role: sharedtypes.UserRoleAdmin,
+			fakeSetup: func(f *FakeService) {
+				f.CreateRoundSeriesFunc = func(ctx context.Context, req *roundservice.CreateRoundSeriesRequest) (roundservice.RoundSeriesResult, error) {
+					return roundservice.RoundSeriesResult{}, errors.New("db down")
+				}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			tt.fakeSetup(fakeService)
+			h := roundSeriesTestHandlers(fakeService, tt.role)
+
+			got, err := h.HandleRoundSeriesCreateRequest(roundSeriesTestContext(tt.replyTo), payload)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			assertTopics(t, got, tt.wantTopics)
+			if tt.replyTo != "" && got[len(got)-1].Payload != got[0].Payload {
+				t.Error("expected the reply to mirror the first result")
+			}
+		})
+	}
+}
+
+func TestRoundHandlers_HandleRoundSeriesUpdateRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	seriesID := uuid.New()
+	moved := &roundtypes.Round{ID: sharedtypes.RoundID(uuid.New()), GuildID: guildID}
+	renamed := &roundtypes.Round{ID: sharedtypes.RoundID(uuid.New()), GuildID: guildID}
+	title := roundtypes.Title("Tuesday Doubles")
+	payload := &RoundSeriesUpdateRequestPayloadV1{GuildID: guildID, SeriesID: seriesID, UserID: "admin-1", Title: &title}
+
+	tests := []struct {
+		name       string
+		role       sharedtypes.UserRoleEnum
+		replyTo    string
+		fakeSetup  func(*FakeService)
+		wantTopics []string
+	}{
+		{
+			name:    "update republishes touched rounds",
+			role:    sharedtypes.UserRoleAdmin,
+			replyTo: testSeriesReplyInbox,
+			fakeSetup: func(f *FakeService) {
+				f.UpdateRoundSeriesFunc = func(ctx context.Context, req *roundservice.UpdateRoundSeriesRequest) (roundservice.UpdateRoundSeriesResult, error) {
+					if req.SeriesID != seriesID || req.Title == nil || *req.Title != title {
+						t.Errorf("unexpected request %+v", req)
+					}
+					return results.SuccessResult[*roundservice.RoundSeriesUpdateResult, error](&roundservice.RoundSeriesUpdateResult{
+						Series:            &roundservice.RoundSeriesInfo{ID: seriesID, GuildID: guildID, Title: title},
+						UpdatedRounds:     []*roundtypes.Round{moved, renamed},
+						RescheduledRounds: []*roundtypes.Round{moved},
+					}), nil
+				}
+			},
+			wantTopics: []string{
+				RoundSeriesUpdatedV1,
+				testSeriesReplyInbox,
+				roundevents.RoundUpdatedV2,
+				roundevents.RoundUpdatedV2 + ".test-guild",
+				roundevents.RoundScheduleUpdatedV1,
+				roundevents.RoundUpdatedV2,
+				roundevents.RoundUpdatedV2 + ".test-guild",
+			},
+		},
+		{
+			name:       "non-admin is rejected",
+			role:       sharedtypes.UserRoleUser,
+			fakeSetup:  func(f *FakeService) {},
+			wantTopics: []string{RoundSeriesFailedV1},
+		},
+		{
+			name:    "missing series publishes failed event",
+			role:    sharedtypes.UserRoleAdmin,
+			replyTo: testSeriesReplyInbox,
+			fakeSetup: func(f *FakeService) {
+				f.UpdateRoundSeriesFunc = func(ctx context.Context, req *roundservice.UpdateRoundSeriesRequest) (roundservice.UpdateRoundSeriesResult, error) {
+					return results.FailureResult[*roundservice.RoundSeriesUpdateResult, error](roundservice.ErrRoundSeriesNotFound), nil
+				}
+			},
+			wantTopics: []string{RoundSeriesFailedV1, testSeriesReplyInbox},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			tt.fakeSetup(fakeService)
+			h := roundSeriesTestHandlers(fakeService, tt.role)
+
+			got, err := h.HandleRoundSeriesUpdateRequest(roundSeriesTestContext(tt.replyTo), payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertTopics(t, got, tt.wantTopics)
+		})
+	}
+}
+
+func TestRoundHandlers_HandleRoundSeriesOccurrenceRequests(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	seriesID := uuid.New()
+	occurrenceAt := time.Date(2026, 11, 10, 18, 0, 0, 0, time.UTC)
+	round := &roundtypes.Round{ID: sharedtypes.RoundID(uuid.New()), GuildID: guildID, EventMessageID: "msg-1"}
+	payload := &RoundSeriesOccurrenceRequestPayloadV1{GuildID: guildID, SeriesID: seriesID, UserID: "admin-1", OccurrenceAt: occurrenceAt}
+
+	tests := []struct {
+		name       string
+		override   bool
+		role       sharedtypes.UserRoleEnum
+		replyTo    string
+		fakeSetup  func(*FakeService)
+		wantTopics []string
+	}{
+		{
+			name:    "skip deletes the materialized round",
+			role:    sharedtypes.UserRoleAdmin,
+			replyTo: testSeriesReplyInbox,
+			fakeSetup: func(f *FakeService) {
+				f.SkipRoundSeriesOccurrenceFunc = func(ctx context.Context, req *roundservice.RoundSeriesOccurrenceRequest) (roundservice.RoundSeriesOccurrenceResult, error) {
+					if !req.OccurrenceAt.Equal(occurrenceAt) {
+						t.Errorf("unexpected occurrence %v", req.OccurrenceAt)
+					}
+					return results.SuccessResult[*roundservice.RoundSeriesOccurrenceInfo, error](&roundservice.RoundSeriesOccurrenceInfo{
+						SeriesID: seriesID, OccurrenceAt: occurrenceAt, Status: "skipped", DeletedRound: round,
+					}), nil
+				}
+			},
+			wantTopics: []string{
+				RoundSeriesOccurrenceUpdatedV1,
+				testSeriesReplyInbox,
+				roundevents.RoundDeletedV2,
+				roundevents.RoundDeletedV2 + ".test-guild",
+			},
+		},
+		{
+			name: "skip of an unmaterialized occurrence",
+			role: sharedtypes.UserRoleAdmin,
+			fakeSetup: func(f *FakeService) {
+				f.SkipRoundSeriesOccurrenceFunc = func(ctx context.Context, req *roundservice.RoundSeriesOccurrenceRequest) (roundservice.RoundSeriesOccurrenceResult, error) {
+					return results.SuccessResult[*roundservice.RoundSeriesOccurrenceInfo, error](&roundservice.RoundSeriesOccurrenceInfo{
+						SeriesID: seriesID, OccurrenceAt: occurrenceAt, Status: "skipped",
+					}), nil
+				}
+			},
+			wantTopics: []string{RoundSeriesOccurrenceUpdatedV1},
+		},
+		{
+			name:     "override reschedules the round",
+			override: true,
+			role:     sharedtypes.UserRoleAdmin,
+			fakeSetup: func(f *FakeService) {
+				f.OverrideRoundSeriesOccurrenceFunc = func(ctx context.Context, req *roundservice.RoundSeriesOccurrenceRequest) (roundservice.RoundSeriesOccurrenceResult, error) {
+					return results.SuccessResult[*roundservice.RoundSeriesOccurrenceInfo, error](&roundservice.RoundSeriesOccurrenceInfo{
+						SeriesID: seriesID, OccurrenceAt: occurrenceAt, Status: "overridden", Round: round, Rescheduled: true,
+					}), nil
+				}
+			},
+			wantTopics: []string{
+				RoundSeriesOccurrenceUpdatedV1,
+				roundevents.RoundUpdatedV2,
+				roundevents.RoundUpdatedV2 + ".test-guild",
+				roundevents.RoundScheduleUpdatedV1,
+			},
+		},
+		{
+			name:     "override of a skipped occurrence fails",
+			override: true,
+			role:     sharedtypes.UserRoleAdmin,
+			replyTo:  testSeriesReplyInbox,
+			fakeSetup: func(f *FakeService) {
+				f.OverrideRoundSeriesOccurrenceFunc = func(ctx context.Context, req *roundservice.RoundSeriesOccurrenceRequest) (roundservice.RoundSeriesOccurrenceResult, error) {
+					return results.FailureResult[*roundservice.RoundSeriesOccurrenceInfo, error](roundservice.ErrOccurrenceSkipped), nil
+				}
+			},
+			wantTopics: []string{RoundSeriesFailedV1, testSeriesReplyInbox},
+		},
+		{
+			name:       "non-admin cannot skip",
+			role:       sharedtypes.UserRoleUser,
+			fakeSetup:  func(f *FakeService) {},
+			wantTopics: []string{RoundSeriesFailedV1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			tt.fakeSetup(fakeService)
+			h := roundSeriesTestHandlers(fakeService, tt.role)
+
+			handle := h.HandleRoundSeriesOccurrenceSkipRequest
+			if tt.override {
+				handle = h.HandleRoundSeriesOccurrenceOverrideRequest
+			}
+			got, err := handle(roundSeriesTestContext(tt.replyTo), payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertTopics(t, got, tt.wantTopics)
+		})
+	}
+}
+
+func TestRoundHandlers_HandleRoundSeriesListRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	series := []*roundservice.RoundSeriesInfo{{ID: uuid.New(), GuildID: guildID}}
+
+	tests := []struct {
+		name      string
+		replyTo   string
+		result    roundservice.ListRoundSeriesResult
+		err       error
+		wantTopic string
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name:      "replies to the request inbox",
+			replyTo:   testSeriesReplyInbox,
+			result:    results.SuccessResult[[]*roundservice.RoundSeriesInfo, error](series),
+			wantTopic: testSeriesReplyInbox,
+			wantCount: 1,
+		},
+		{
+			name:      "publishes the response topic without an inbox",
+			result:    results.SuccessResult[[]*roundservice.RoundSeriesInfo, error](series),
+			wantTopic: RoundSeriesListResponseV1,
+			wantCount: 1,
+		},
+		{
+			name:      "failure replies with an empty list",
+			replyTo:   testSeriesReplyInbox,
+			result:    results.FailureResult[[]*roundservice.RoundSeriesInfo, error](errors.New("unavailable")),
+			wantTopic: testSeriesReplyInbox,
+		},
+		{
+			name:    "service error is returned",
+			err:     errors.New("db down"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			fakeService.ListRoundSeriesFunc = func(ctx context.Context, gotGuild sharedtypes.GuildID) (roundservice.ListRoundSeriesResult, error) {
+				return tt.result, tt.err
+			}
+			h := roundSeriesTestHandlers(fakeService, sharedtypes.UserRoleUser)
+
+			got, err := h.HandleRoundSeriesListRequest(roundSeriesTestContext(tt.replyTo), &RoundSeriesListRequestPayloadV1{GuildID: guildID})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			assertTopics(t, got, []string{tt.wantTopic})
+			response := got[0].Payload.(*RoundSeriesListResponsePayloadV1)
+			if response.Series == nil || len(response.Series) != tt.wantCount {
+				t.Errorf("expected %d series, got %+v", tt.wantCount, response.Series)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package roundhandlers
+
+import (
+	"context"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Scoring mode topics.
+const (
+	RoundScoringModeSetRequestedV1 = "round.scoring_mode.set.requested.v1"
+	RoundScoringModeUpdatedV1      = "round.scoring_mode.updated.v1"
+	RoundScoringModeSetFailedV1    = "round.scoring_mode.set.failed.v1"
+)
+
+// RoundScoringModeSetRequestPayloadV1 switches a round between "gross" and "net" ranking.
+type RoundScoringModeSetRequestPayloadV1 struct {
+	GuildID     sharedtypes.GuildID   `json:"guild_id"`
+	RoundID     sharedtypes.RoundID   `json:"round_id"`
+	UserID      sharedtypes.DiscordID `json:"user_id"`
+	ScoringMode string                `json:"scoring_mode"`
+}
+
+// RoundScoringModeUpdatedPayloadV1 confirms a round's new scoring mode.
+type RoundScoringModeUpdatedPayloadV1 struct {
+	GuildID     sharedtypes.GuildID `json:"guild_id"`
+	RoundID     sharedtypes.RoundID `json:"round_id"`
+	ScoringMode string              `json:"scoring_mode"`
+}
+
+// RoundScoringModeSetFailedPayloadV1 reports a rejected scoring mode change.
+type RoundScoringModeSetFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	Reason  string                `json:"reason"`
+}
+
+// HandleRoundScoringModeSetRequest switches a round to gross or net scoring (admin only).
+func (h *RoundHandlers) HandleRoundScoringModeSetRequest(ctx context.Context, payload *RoundScoringModeSetRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.ensureAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.scoringModeFailure(ctx, payload, err), nil
+	}
+
+	result, err := h.service.SetRoundScoringMode(ctx, &roundservice.SetRoundScoringModeRequest{
+		GuildID: payload.GuildID,
+		RoundID: payload.RoundID,
+		Mode:    payload.ScoringMode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.scoringModeFailure(ctx, payload, *result.Failure), nil
+	}
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundScoringModeUpdatedV1,
+		Payload: &RoundScoringModeUpdatedPayloadV1{
+			GuildID:     payload.GuildID,
+			RoundID:     payload.RoundID,
+			ScoringMode: payload.ScoringMode,
+		},
+	}}), nil
+}
+
+func (h *RoundHandlers) scoringModeFailure(ctx context.Context, payload *RoundScoringModeSetRequestPayloadV1, err error) []handlerwrapper.Result {
+	h.logger.WarnContext(ctx, "Round scoring mode change rejected",
+		attr.String("guild_id", string(payload.GuildID)),
+		attr.RoundID("round_id", payload.RoundID),
+		attr.String("user_id", string(payload.UserID)),
+		attr.Error(err),
+	)
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundScoringModeSetFailedV1,
+		Payload: &RoundScoringModeSetFailedPayloadV1{
+			GuildID: payload.GuildID,
+			RoundID: payload.RoundID,
+			UserID:  payload.UserID,
+			Reason:  err.Error(),
+		},
+	}})
+}
@@ -0,0 +1,90 @@
+package roundhandlers
+
+import (
+	"context"
+	"testing"
+
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
+	"github.com/google/uuid"
+)
+
+func TestRoundHandlers_HandleRoundScoringModeSetRequest(t *testing.T) {
+	payload := &RoundScoringModeSetRequestPayloadV1{
+		GuildID:     "test-guild",
+		RoundID:     sharedtypes.RoundID(uuid.New()),
+		UserID:      "admin-1",
+		ScoringMode: roundservice.ScoringModeNet,
+	}
+
+	adminRole := func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+		return results.SuccessResult[sharedtypes.UserRoleEnum, error](sharedtypes.UserRoleAdmin), nil
+	}
+	playerRole := func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+		return results.SuccessResult[sharedtypes.UserRoleEnum, error](sharedtypes.UserRoleUser), nil
+	}
+
+	tests := []struct {
+		name      string
+		role      func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error)
+		fakeSetup func(*FakeService)
+		wantTopic string
+	}{
+		{
+			name: "admin switches mode",
+			role: adminRole,
+			fakeSetup: func(f *FakeService) {
+				f.SetRoundScoringModeFunc = func(ctx context.Context, req *roundservice.SetRoundScoringModeRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+					if req.Mode != roundservice.ScoringModeNet {
+						t.Errorf("expected net mode, got %q", req.Mode)
+					}
+					return results.SuccessResult[*roundtypes.Round, error](&roundtypes.Round{ID: req.RoundID}), nil
+				}
+			},
+			wantTopic: RoundScoringModeUpdatedV1,
+		},
+		{
+			name:      "non-admin is rejected",
+			role:      playerRole,
+			fakeSetup: func(f *FakeService) {},
+			wantTopic: RoundScoringModeSetFailedV1,
+		},
+		{
+			name: "business failure publishes failed event",
+			role: adminRole,
+			fakeSetup: func(f *FakeService) {
+				f.SetRoundScoringModeFunc = func(ctx context.Context, req *roundservice.SetRoundScoringModeRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+					return results.FailureResult[*roundtypes.Round, error](roundservice.ErrRoundAlreadyFinalized), nil
+				}
+			},
+			wantTopic: RoundScoringModeSetFailedV1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			tt.fakeSetup(fakeService)
+			fakeUsers := NewFakeUserService()
+			fakeUsers.GetUserRoleFunc = tt.role
+
+			h := &RoundHandlers{
+				service:     fakeService,
+				userService: fakeUsers,
+				logger:      loggerfrolfbot.NoOpLogger,
+			}
+
+			got, err := h.HandleRoundScoringModeSetRequest(context.Background(), payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) == 0 || got[0].Topic != tt.wantTopic {
+				t.Fatalf("expected first topic %s, got %v", tt.wantTopic, got)
+			}
+		})
+	}
+}
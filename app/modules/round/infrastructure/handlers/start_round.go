@@ -65,6 +65,12 @@ func (h *RoundHandlers) HandleRoundStartRequested(
 	// Publish both guild and club scoped start events so PWA-only consumers can react.
 	results = h.addParallelIdentityResults(ctx, results, roundevents.RoundStartedV2, req.GuildID)
 
+	// The shared started payload has no teams, cards or no-shows, so drawn teams, the
+	// tee sheet and the no-shows follow as their own events.
+	results = append(results, h.teamsDrawnResults(ctx, result.Teams, round.GuildID, round.ID, round.EventMessageID)...)
+	results = append(results, h.cardsAssignedResults(ctx, result.Cards, round.GuildID, round.ID, round.EventMessageID)...)
+	results = append(results, h.noShowResults(ctx, result.NoShows, round.GuildID, round.ID, round.EventMessageID)...)
+
 	// Discord-specific start updates require a target message id.
 	if round.EventMessageID != "" {
 		eventParticipants := make([]roundevents.RoundParticipantV1, len(round.Participants))
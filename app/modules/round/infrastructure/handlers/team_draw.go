@@ -0,0 +1,116 @@
+package roundhandlers
+
+import (
+	"context"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Team draw topics. Teams drawn at start are published alongside the started
+// event, like cards.
+const (
+	RoundTeamDrawSetRequestedV1 = "round.team_draw.set.requested.v1"
+	RoundTeamDrawUpdatedV1      = "round.team_draw.updated.v1"
+	RoundTeamDrawSetFailedV1    = "round.team_draw.set.failed.v1"
+	RoundTeamsDrawnV1           = "round.teams.drawn.v1"
+)
+
+// RoundTeamDrawSetRequestPayloadV1 sets a round's blind draw to "off", "random" or
+// "seeded".
+type RoundTeamDrawSetRequestPayloadV1 struct {
+	GuildID  sharedtypes.GuildID   `json:"guild_id"`
+	RoundID  sharedtypes.RoundID   `json:"round_id"`
+	UserID   sharedtypes.DiscordID `json:"user_id"`
+	TeamDraw string                `json:"team_draw"`
+}
+
+// RoundTeamDrawUpdatedPayloadV1 confirms a round's new blind draw setting.
+type RoundTeamDrawUpdatedPayloadV1 struct {
+	GuildID  sharedtypes.GuildID `json:"guild_id"`
+	RoundID  sharedtypes.RoundID `json:"round_id"`
+	TeamDraw string              `json:"team_draw"`
+}
+
+// RoundTeamDrawSetFailedPayloadV1 reports a rejected blind draw change.
+type RoundTeamDrawSetFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	Reason  string                `json:"reason"`
+}
+
+// RoundTeamsDrawnPayloadV1 is the doubles teams drawn when a round started.
+type RoundTeamsDrawnPayloadV1 struct {
+	GuildID        sharedtypes.GuildID      `json:"guild_id"`
+	RoundID        sharedtypes.RoundID      `json:"round_id"`
+	EventMessageID string                   `json:"event_message_id,omitempty"`
+	Teams          []roundservice.DrawnTeam `json:"teams"`
+}
+
+// HandleRoundTeamDrawSetRequest turns a round's blind draw on or off (admin only).
+func (h *RoundHandlers) HandleRoundTeamDrawSetRequest(ctx context.Context, payload *RoundTeamDrawSetRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if err := h.ensureAdminRole(ctx, payload.GuildID, payload.UserID); err != nil {
+		return h.teamDrawFailure(ctx, payload, err), nil
+	}
+
+	result, err := h.service.ConfigureTeamDraw(ctx, &roundservice.ConfigureTeamDrawRequest{
+		GuildID: payload.GuildID,
+		RoundID: payload.RoundID,
+		Draw:    payload.TeamDraw,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return h.teamDrawFailure(ctx, payload, *result.Failure), nil
+	}
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundTeamDrawUpdatedV1,
+		Payload: &RoundTeamDrawUpdatedPayloadV1{
+			GuildID:  payload.GuildID,
+			RoundID:  payload.RoundID,
+			TeamDraw: payload.TeamDraw,
+		},
+	}}), nil
+}
+
+func (h *RoundHandlers) teamDrawFailure(ctx context.Context, payload *RoundTeamDrawSetRequestPayloadV1, err error) []handlerwrapper.Result {
+	h.logger.WarnContext(ctx, "Round team draw change rejected",
+		attr.String("guild_id", string(payload.GuildID)),
+		attr.RoundID("round_id", payload.RoundID),
+		attr.String("user_id", string(payload.UserID)),
+		attr.Error(err),
+	)
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundTeamDrawSetFailedV1,
+		Payload: &RoundTeamDrawSetFailedPayloadV1{
+			GuildID: payload.GuildID,
+			RoundID: payload.RoundID,
+			UserID:  payload.UserID,
+			Reason:  err.Error(),
+		},
+	}})
+}
+
+// teamsDrawnResults announces the doubles teams drawn when a round started, if any.
+func (h *RoundHandlers) teamsDrawnResults(ctx context.Context, teams []roundservice.DrawnTeam, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, eventMessageID string) []handlerwrapper.Result {
+	if len(teams) == 0 {
+		return nil
+	}
+	results := []handlerwrapper.Result{{
+		Topic: RoundTeamsDrawnV1,
+		Payload: &RoundTeamsDrawnPayloadV1{
+			GuildID:        guildID,
+			RoundID:        roundID,
+			EventMessageID: eventMessageID,
+			Teams:          teams,
+		},
+	}}
+	return h.addParallelIdentityResults(ctx, results, RoundTeamsDrawnV1, guildID)
+}
@@ -0,0 +1,120 @@
+package roundhandlers
+
+import (
+	"context"
+	"testing"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	userservice "github.com/Black-And-White-Club/frolf-bot/app/modules/user/application"
+	"github.com/google/uuid"
+)
+
+func TestRoundHandlers_HandleRoundStartRequested_PublishesDrawnTeams(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-123")
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	fakeService := NewFakeService()
+	fakeService.StartRoundFunc = func(ctx context.Context, req *roundtypes.StartRoundRequest) (roundservice.StartRoundResult, error) {
+		return roundservice.StartRoundResult{
+			OperationResult: results.SuccessResult[*roundtypes.Round, error](&roundtypes.Round{ID: roundID, GuildID: guildID}),
+			Teams: []roundservice.DrawnTeam{
+				{Number: 1, TeamID: uuid.New(), Players: []roundtypes.Participant{{UserID: "a"}, {UserID: "b"}}},
+				{Number: 2, TeamID: uuid.New(), Players: []roundtypes.Participant{{UserID: "c"}}, Cali: true},
+			},
+		}, nil
+	}
+	fakeUsers := NewFakeUserService()
+	fakeUsers.GetClubUUIDByDiscordGuildIDFunc = func(ctx context.Context, guildID sharedtypes.GuildID) (uuid.UUID, error) {
+		return uuid.Nil, nil
+	}
+	h := &RoundHandlers{service: fakeService, userService: fakeUsers, logger: loggerfrolfbot.NoOpLogger}
+
+	got, err := h.HandleRoundStartRequested(context.Background(), &roundevents.RoundStartRequestedPayloadV1{GuildID: guildID, RoundID: roundID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	topics := resultTopics(got)
+	if len(topics) != 4 || topics[2] != RoundTeamsDrawnV1 || topics[3] != RoundTeamsDrawnV1+".guild-123" {
+		t.Fatalf("expected started and teams drawn events, got %v", topics)
+	}
+	if payload := got[2].Payload.(*RoundTeamsDrawnPayloadV1); len(payload.Teams) != 2 || !payload.Teams[1].Cali {
+		t.Errorf("unexpected teams payload %+v", payload)
+	}
+}
+
+func TestRoundHandlers_HandleRoundTeamDrawSetRequest(t *testing.T) {
+	payload := &RoundTeamDrawSetRequestPayloadV1{
+		GuildID:  "test-guild",
+		RoundID:  sharedtypes.RoundID(uuid.New()),
+		UserID:   "admin-1",
+		TeamDraw: roundservice.TeamDrawSeeded,
+	}
+
+	adminRole := func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+		return results.SuccessResult[sharedtypes.UserRoleEnum, error](sharedtypes.UserRoleAdmin), nil
+	}
+	playerRole := func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error) {
+		return results.SuccessResult[sharedtypes.UserRoleEnum, error](sharedtypes.UserRoleUser), nil
+	}
+
+	tests := []struct {
+		name      string
+		role      func(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (userservice.UserRoleResult, error)
+		fakeSetup func(*FakeService)
+		wantTopic string
+	}{
+		{
+			name: "admin enables the draw",
+			role: adminRole,
+			fakeSetup: func(f *FakeService) {
+				f.ConfigureTeamDrawFunc = func(ctx context.Context, req *roundservice.ConfigureTeamDrawRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+					if req.Draw != roundservice.TeamDrawSeeded {
+						t.Errorf("expected seeded draw, got %q", req.Draw)
+					}
+					return results.SuccessResult[*roundtypes.Round, error](&roundtypes.Round{ID: req.RoundID}), nil
+				}
+			},
+			wantTopic: RoundTeamDrawUpdatedV1,
+		},
+		{
+			name:      "non-admin is rejected",
+			role:      playerRole,
+			fakeSetup: func(f *FakeService) {},
+			wantTopic: RoundTeamDrawSetFailedV1,
+		},
+		{
+			name: "business failure publishes failed event",
+			role: adminRole,
+			fakeSetup: func(f *FakeService) {
+				f.ConfigureTeamDrawFunc = func(ctx context.Context, req *roundservice.ConfigureTeamDrawRequest) (results.OperationResult[*roundtypes.Round, error], error) {
+					return results.FailureResult[*roundtypes.Round, error](roundservice.ErrInvalidTeamDraw), nil
+				}
+			},
+			wantTopic: RoundTeamDrawSetFailedV1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			tt.fakeSetup(fakeService)
+			fakeUsers := NewFakeUserService()
+			fakeUsers.GetUserRoleFunc = tt.role
+
+			h := &RoundHandlers{service: fakeService, userService: fakeUsers, logger: loggerfrolfbot.NoOpLogger}
+
+			got, err := h.HandleRoundTeamDrawSetRequest(context.Background(), payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 1 || got[0].Topic != tt.wantTopic {
+				t.Fatalf("expected %s, got %v", tt.wantTopic, resultTopics(got))
+			}
+		})
+	}
+}
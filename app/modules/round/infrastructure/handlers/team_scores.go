@@ -0,0 +1,126 @@
+package roundhandlers
+
+import (
+	"context"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+	"github.com/google/uuid"
+)
+
+// Doubles team scoring topics.
+const (
+	RoundTeamScoreSubmitRequestedV1 = "round.team.score.submit.requested.v1"
+	RoundTeamScoreUpdatedV1         = "round.team.score.updated.v1"
+	RoundTeamScoreFailedV1          = "round.team.score.failed.v1"
+)
+
+// RoundTeamScoreSubmitRequestPayloadV1 submits one score for a doubles team. UserID
+// is the submitting player, who must be on the team.
+type RoundTeamScoreSubmitRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	TeamID  uuid.UUID             `json:"team_id"`
+	Score   *sharedtypes.Score    `json:"score"`
+}
+
+// RoundTeamScoreUpdatedPayloadV1 carries a team's score and the round's participants
+// after it was copied to every member.
+type RoundTeamScoreUpdatedPayloadV1 struct {
+	GuildID        sharedtypes.GuildID      `json:"guild_id"`
+	RoundID        sharedtypes.RoundID      `json:"round_id"`
+	UserID         sharedtypes.DiscordID    `json:"user_id"`
+	TeamID         uuid.UUID                `json:"team_id"`
+	Score          sharedtypes.Score        `json:"score"`
+	EventMessageID string                   `json:"event_message_id,omitempty"`
+	Members        []roundtypes.Participant `json:"members"`
+	Participants   []roundtypes.Participant `json:"participants"`
+}
+
+// RoundTeamScoreFailedPayloadV1 reports a rejected team score.
+type RoundTeamScoreFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	TeamID  uuid.UUID             `json:"team_id"`
+	Reason  string                `json:"reason"`
+}
+
+// HandleTeamScoreSubmitRequest records a doubles team's score. A participant score
+// update is also published so the existing all-scores-submitted check can finalize
+// the round.
+func (h *RoundHandlers) HandleTeamScoreSubmitRequest(ctx context.Context, payload *RoundTeamScoreSubmitRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	result, err := h.service.SubmitTeamScore(ctx, &roundservice.SubmitTeamScoreRequest{
+		GuildID: payload.GuildID,
+		RoundID: payload.RoundID,
+		UserID:  payload.UserID,
+		TeamID:  payload.TeamID,
+		Score:   payload.Score,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Failure != nil {
+		h.logger.WarnContext(ctx, "Team score submission rejected",
+			attr.RoundID("round_id", payload.RoundID),
+			attr.String("user_id", string(payload.UserID)),
+			attr.String("team_id", payload.TeamID.String()),
+			attr.Error(*result.Failure),
+		)
+		return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+			Topic: RoundTeamScoreFailedV1,
+			Payload: &RoundTeamScoreFailedPayloadV1{
+				GuildID: payload.GuildID,
+				RoundID: payload.RoundID,
+				UserID:  payload.UserID,
+				TeamID:  payload.TeamID,
+				Reason:  (*result.Failure).Error(),
+			},
+		}}), nil
+	}
+
+	update := *result.Success
+	var members []roundtypes.Participant
+	for _, p := range update.UpdatedParticipants {
+		if p.TeamID == payload.TeamID {
+			members = append(members, p)
+		}
+	}
+
+	results := handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic: RoundTeamScoreUpdatedV1,
+		Payload: &RoundTeamScoreUpdatedPayloadV1{
+			GuildID:        update.GuildID,
+			RoundID:        update.RoundID,
+			UserID:         payload.UserID,
+			TeamID:         payload.TeamID,
+			Score:          *payload.Score,
+			EventMessageID: update.EventMessageID,
+			Members:        members,
+			Participants:   update.UpdatedParticipants,
+		},
+	}})
+	results = h.addParallelIdentityResults(ctx, results, RoundTeamScoreUpdatedV1, update.GuildID)
+
+	scoreResults := []handlerwrapper.Result{{
+		Topic: roundevents.RoundParticipantScoreUpdatedV2,
+		Payload: &roundevents.ParticipantScoreUpdatedPayloadV1{
+			GuildID:        update.GuildID,
+			RoundID:        update.RoundID,
+			UserID:         payload.UserID,
+			Score:          *payload.Score,
+			EventMessageID: update.EventMessageID,
+			Participants:   update.UpdatedParticipants,
+		},
+	}}
+	scoreResults = h.addParallelIdentityResults(ctx, scoreResults, roundevents.RoundParticipantScoreUpdatedV2, update.GuildID)
+
+	return append(results, scoreResults...), nil
+}
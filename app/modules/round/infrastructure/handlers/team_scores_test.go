@@ -0,0 +1,92 @@
+package roundhandlers
+
+import (
+	"context"
+	"testing"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
+	"github.com/google/uuid"
+)
+
+func TestRoundHandlers_HandleTeamScoreSubmitRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("test-guild")
+	roundID := sharedtypes.RoundID(uuid.New())
+	teamID := uuid.New()
+	score := sharedtypes.Score(-5)
+	payload := &RoundTeamScoreSubmitRequestPayloadV1{GuildID: guildID, RoundID: roundID, UserID: "a1", TeamID: teamID, Score: &score}
+
+	tests := []struct {
+		name       string
+		fakeSetup  func(*FakeService)
+		wantTopics []string
+	}{
+		{
+			name: "team score announces the update and the participant score",
+			fakeSetup: func(f *FakeService) {
+				f.SubmitTeamScoreFunc = func(ctx context.Context, req *roundservice.SubmitTeamScoreRequest) (roundservice.ScoreUpdateResult, error) {
+					if req.TeamID != teamID || req.UserID != "a1" {
+						t.Errorf("unexpected request %+v", req)
+					}
+					return results.SuccessResult[*roundtypes.ScoreUpdateResult, error](&roundtypes.ScoreUpdateResult{
+						GuildID: guildID,
+						RoundID: roundID,
+						UpdatedParticipants: []roundtypes.Participant{
+							{UserID: "a1", TeamID: teamID, Score: &score},
+							{UserID: "a2", TeamID: teamID, Score: &score},
+							{UserID: "b1", TeamID: uuid.New()},
+						},
+					}), nil
+				}
+			},
+			wantTopics: []string{
+				RoundTeamScoreUpdatedV1,
+				RoundTeamScoreUpdatedV1 + ".test-guild",
+				roundevents.RoundParticipantScoreUpdatedV2,
+				roundevents.RoundParticipantScoreUpdatedV2 + ".test-guild",
+			},
+		},
+		{
+			name: "submitter off the team is rejected",
+			fakeSetup: func(f *FakeService) {
+				f.SubmitTeamScoreFunc = func(ctx context.Context, req *roundservice.SubmitTeamScoreRequest) (roundservice.ScoreUpdateResult, error) {
+					return results.FailureResult[*roundtypes.ScoreUpdateResult, error](roundservice.ErrNotOnTeam), nil
+				}
+			},
+			wantTopics: []string{RoundTeamScoreFailedV1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeService := NewFakeService()
+			tt.fakeSetup(fakeService)
+			fakeUsers := NewFakeUserService()
+			fakeUsers.GetClubUUIDByDiscordGuildIDFunc = func(ctx context.Context, guildID sharedtypes.GuildID) (uuid.UUID, error) {
+				return uuid.Nil, nil
+			}
+			h := &RoundHandlers{service: fakeService, userService: fakeUsers, logger: loggerfrolfbot.NoOpLogger}
+
+			got, err := h.HandleTeamScoreSubmitRequest(context.Background(), payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			topics := resultTopics(got)
+			if len(topics) != len(tt.wantTopics) {
+				t.Fatalf("expected topics %v, got %v", tt.wantTopics, topics)
+			}
+			for i := range topics {
+				if topics[i] != tt.wantTopics[i] {
+					t.Fatalf("expected topics %v, got %v", tt.wantTopics, topics)
+				}
+			}
+			if updated, ok := got[0].Payload.(*RoundTeamScoreUpdatedPayloadV1); ok && len(updated.Members) != 2 {
+				t.Errorf("expected two team members, got %+v", updated.Members)
+			}
+		})
+	}
+}
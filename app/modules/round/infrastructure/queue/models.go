@@ -1,6 +1,8 @@
 package roundqueue
 
 import (
+	"time"
+
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 )
@@ -26,6 +28,15 @@ type RoundReminderJob struct {
 // Kind returns the job type identifier for River
 func (j RoundReminderJob) Kind() string { return "round_reminder" }
 
+// RoundCheckInOpenJob opens a round's check-in window at the scheduled time.
+type RoundCheckInOpenJob struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+	RoundID sharedtypes.RoundID `json:"round_id"`
+}
+
+// Kind returns the job type identifier for River
+func (RoundCheckInOpenJob) Kind() string { return "round_check_in_open" }
+
 // JobInfo represents information about a scheduled job (for debugging/monitoring)
 type JobInfo struct {
 	ID          int64  `json:"id"`
@@ -37,3 +48,28 @@ type JobInfo struct {
 	Attempt     int    `json:"attempt"`
 	MaxAttempts int    `json:"max_attempts"`
 }
+
+// RoundSeriesMaterializeRequestedV1 is published by the periodic series job so the
+// round module can materialize upcoming occurrences of recurring round series.
+const RoundSeriesMaterializeRequestedV1 = "round.series.materialize.requested.v1"
+
+// RoundSeriesMaterializeRequestedPayloadV1 is the payload for RoundSeriesMaterializeRequestedV1.
+type RoundSeriesMaterializeRequestedPayloadV1 struct {
+	RequestedAt time.Time `json:"requested_at"`
+}
+
+// RoundSeriesMaterializeJob is a periodic job that triggers series materialization.
+type RoundSeriesMaterializeJob struct{}
+
+// Kind returns the job type identifier for River
+func (RoundSeriesMaterializeJob) Kind() string { return "round_series_materialize" }
+
+// RoundCheckInOpenRequestedV1 is published by the check-in job when a round's
+// check-in window opens.
+const RoundCheckInOpenRequestedV1 = "round.check_in.open.requested.v1"
+
+// RoundCheckInOpenRequestedPayloadV1 is the payload for RoundCheckInOpenRequestedV1.
+type RoundCheckInOpenRequestedPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+	RoundID sharedtypes.RoundID `json:"round_id"`
+}
@@ -30,7 +30,9 @@ type Metrics interface {
 type QueueService interface {
 	ScheduleRoundStart(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, startTime time.Time, payload roundevents.RoundStartedPayloadV1) error
 	ScheduleRoundReminder(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, reminderTime time.Time, payload roundevents.DiscordReminderPayloadV1) error
+	ScheduleRoundCheckInOpen(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, openTime time.Time) error
 	CancelRoundStartJobs(ctx context.Context, roundID sharedtypes.RoundID) error
+	CancelRoundCheckInJobs(ctx context.Context, roundID sharedtypes.RoundID) error
 	CancelRoundJobs(ctx context.Context, roundID sharedtypes.RoundID) error
 	GetScheduledJobs(ctx context.Context, roundID sharedtypes.RoundID) ([]JobInfo, error)
 	HealthCheck(ctx context.Context) error
@@ -38,6 +40,10 @@ type QueueService interface {
 	Stop(ctx context.Context) error
 }
 
+// roundSeriesMaterializeInterval is how often recurring round series are checked
+// for occurrences that have entered their materialization window.
+const roundSeriesMaterializeInterval = time.Hour
+
 // Ensure Service implements QueueService
 var _ QueueService = (*Service)(nil)
 
@@ -106,6 +112,8 @@ func NewServiceWithOptions(ctx context.Context, bunDB *bun.DB, logger *slog.Logg
 	workers := river.NewWorkers()
 	river.AddWorker(workers, NewRoundStartWorker(ctxLogger, eventBus, helpers))
 	river.AddWorker(workers, NewRoundReminderWorker(ctxLogger, eventBus, helpers))
+	river.AddWorker(workers, NewRoundSeriesMaterializeWorker(ctxLogger, eventBus, helpers))
+	river.AddWorker(workers, NewRoundCheckInOpenWorker(ctxLogger, eventBus, helpers))
 
 	defaultWorkers := 50
 	roundWorkers := 25
@@ -122,6 +130,15 @@ func NewServiceWithOptions(ctx context.Context, bunDB *bun.DB, logger *slog.Logg
 			"round":            {MaxWorkers: roundWorkers},
 		},
 		Workers: workers,
+		PeriodicJobs: []*river.PeriodicJob{
+			river.NewPeriodicJob(
+				river.PeriodicInterval(roundSeriesMaterializeInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return RoundSeriesMaterializeJob{}, &river.InsertOpts{Queue: "round"}
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+		},
 	}
 
 	if opts != nil && opts.FetchPollInterval != nil {
@@ -210,6 +227,22 @@ func (s *Service) ScheduleRoundReminder(ctx context.Context, guildID sharedtypes
 	return err
 }
 
+func (s *Service) ScheduleRoundCheckInOpen(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, openTime time.Time) error {
+	job := RoundCheckInOpenJob{
+		GuildID: guildID,
+		RoundID: roundID,
+	}
+
+	_, err := s.client.Insert(ctx, job, &river.InsertOpts{
+		Queue:       "round",
+		ScheduledAt: openTime,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+		},
+	})
+	return err
+}
+
 func (s *Service) CancelRoundJobs(ctx context.Context, roundID sharedtypes.RoundID) error {
 	return s.cancelRoundJobsByKind(ctx, roundID, "")
 }
@@ -218,6 +251,10 @@ func (s *Service) CancelRoundStartJobs(ctx context.Context, roundID sharedtypes.
 	return s.cancelRoundJobsByKind(ctx, roundID, "round_start")
 }
 
+func (s *Service) CancelRoundCheckInJobs(ctx context.Context, roundID sharedtypes.RoundID) error {
+	return s.cancelRoundJobsByKind(ctx, roundID, "round_check_in_open")
+}
+
 func (s *Service) cancelRoundJobsByKind(ctx context.Context, roundID sharedtypes.RoundID, kind string) error {
 	type RiverJobRow struct {
 		ID int64 `bun:"id"`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
@@ -120,3 +121,97 @@ func (w *RoundReminderWorker) Work(ctx context.Context, job *river.Job[RoundRemi
 	ctxLogger.Info("Round reminder job processed successfully - event published")
 	return nil
 }
+
+// RoundSeriesMaterializeWorker processes the periodic series materialization job by
+// publishing a materialize request; the round handlers do the actual work so that
+// created rounds flow through the normal RoundCreated pipeline.
+type RoundSeriesMaterializeWorker struct {
+	river.WorkerDefaults[RoundSeriesMaterializeJob]
+	logger   *slog.Logger
+	eventBus eventbus.EventBus
+	helpers  utils.Helpers
+}
+
+func NewRoundSeriesMaterializeWorker(logger *slog.Logger, eventBus eventbus.EventBus, helpers utils.Helpers) *RoundSeriesMaterializeWorker {
+	return &RoundSeriesMaterializeWorker{
+		logger:   logger,
+		eventBus: eventBus,
+		helpers:  helpers,
+	}
+}
+
+func (w *RoundSeriesMaterializeWorker) Work(ctx context.Context, job *river.Job[RoundSeriesMaterializeJob]) error {
+	ctxLogger := w.logger.With(
+		attr.Int64("job_id", job.ID),
+		attr.String("operation", "process_round_series_materialize_job"),
+	)
+
+	payload := RoundSeriesMaterializeRequestedPayloadV1{
+		RequestedAt: time.Now().UTC(),
+	}
+
+	msg, err := w.helpers.CreateNewMessage(payload, RoundSeriesMaterializeRequestedV1)
+	if err != nil {
+		ctxLogger.Error("Failed to create round series materialize message", attr.Error(err))
+		return fmt.Errorf("failed to create round series materialize message: %w", err)
+	}
+
+	if err := w.eventBus.Publish(RoundSeriesMaterializeRequestedV1, msg); err != nil {
+		ctxLogger.Error("Failed to publish round series materialize event", attr.Error(err))
+		return fmt.Errorf("failed to publish round series materialize event: %w", err)
+	}
+
+	ctxLogger.Debug("Round series materialize job processed - requested event published")
+	return nil
+}
+
+// RoundCheckInOpenWorker processes check-in jobs by publishing a check-in open
+// request; the round handlers open the window and announce it.
+type RoundCheckInOpenWorker struct {
+	river.WorkerDefaults[RoundCheckInOpenJob]
+	logger   *slog.Logger
+	eventBus eventbus.EventBus
+	helpers  utils.Helpers
+}
+
+func NewRoundCheckInOpenWorker(logger *slog.Logger, eventBus eventbus.EventBus, helpers utils.Helpers) *RoundCheckInOpenWorker {
+	return &RoundCheckInOpenWorker{
+		logger:   logger,
+		eventBus: eventBus,
+		helpers:  helpers,
+	}
+}
+
+func (w *RoundCheckInOpenWorker) Work(ctx context.Context, job *river.Job[RoundCheckInOpenJob]) error {
+	ctxLogger := w.logger.With(
+		attr.Int64("job_id", job.ID),
+		attr.String("guild_id", string(job.Args.GuildID)),
+		attr.String("round_id", job.Args.RoundID.String()),
+		attr.String("operation", "process_round_check_in_open_job"),
+	)
+
+	ctxLogger.Info("Processing round check-in job")
+
+	payload := RoundCheckInOpenRequestedPayloadV1{
+		GuildID: job.Args.GuildID,
+		RoundID: job.Args.RoundID,
+	}
+
+	msg, err := w.helpers.CreateNewMessage(payload, RoundCheckInOpenRequestedV1)
+	if err != nil {
+		ctxLogger.Error("Failed to create round check-in open message", attr.Error(err))
+		return fmt.Errorf("failed to create round check-in open message: %w", err)
+	}
+
+	if msg.Metadata.Get("guild_id") == "" && job.Args.GuildID != "" {
+		msg.Metadata.Set("guild_id", string(job.Args.GuildID))
+	}
+
+	if err := w.eventBus.Publish(RoundCheckInOpenRequestedV1, msg); err != nil {
+		ctxLogger.Error("Failed to publish round check-in open event", attr.Error(err))
+		return fmt.Errorf("failed to publish round check-in open event: %w", err)
+	}
+
+	ctxLogger.Info("Round check-in job processed successfully - requested event published")
+	return nil
+}
@@ -0,0 +1,77 @@
+package rounddb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/uptrace/bun"
+)
+
+// GetRoundCapacity locks the round and returns its participant cap (0 = unlimited)
+// and waitlist, oldest first. Call it inside the transaction that changes the
+// round's participants so seats are counted against a stable list.
+func (r *Impl) GetRoundCapacity(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (int, []WaitlistEntry, error) {
+	if db == nil {
+		db = r.db
+	}
+	var round Round
+	err := db.NewSelect().
+		Model(&round).
+		Column("max_participants", "waitlist").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil, ErrNotFound
+		}
+		return 0, nil, fmt.Errorf("failed to fetch round capacity: %w", err)
+	}
+	return round.MaxParticipants, round.Waitlist, nil
+}
+
+// SetRoundMaxParticipants stores the round's participant cap; 0 removes it.
+func (r *Impl) SetRoundMaxParticipants(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, maxParticipants int) error {
+	if db == nil {
+		db = r.db
+	}
+	res, err := db.NewUpdate().
+		Model((*Round)(nil)).
+		Set("max_participants = ?", maxParticipants).
+		Set("updated_at = now()").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update round max participants: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return ErrNoRowsAffected
+	}
+	return nil
+}
+
+// SetRoundWaitlist replaces the round's waitlist.
+func (r *Impl) SetRoundWaitlist(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, waitlist []WaitlistEntry) error {
+	if db == nil {
+		db = r.db
+	}
+	if waitlist == nil {
+		waitlist = []WaitlistEntry{}
+	}
+	res, err := db.NewUpdate().
+		Model((*Round)(nil)).
+		Set("waitlist = ?", waitlist).
+		Set("updated_at = now()").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update round waitlist: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return ErrNoRowsAffected
+	}
+	return nil
+}
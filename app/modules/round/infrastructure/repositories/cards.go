@@ -0,0 +1,165 @@
+package rounddb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// RoundCardSettings controls how a round's players are split into cards. A Size of
+// 0 turns card assignment off.
+type RoundCardSettings struct {
+	Size         int
+	Strategy     string
+	ShotgunStart bool
+}
+
+// RoundCard is a card of players stored as a card round group. StartingHole is set
+// for shotgun starts.
+type RoundCard struct {
+	Number       int
+	StartingHole *int
+	Members      []RoundGroupParticipant
+}
+
+// GetRoundCardSettings returns the round's card settings.
+func (r *Impl) GetRoundCardSettings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (RoundCardSettings, error) {
+	if db == nil {
+		db = r.db
+	}
+	var round Round
+	err := db.NewSelect().
+		Model(&round).
+		Column("card_size", "card_strategy", "shotgun_start").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RoundCardSettings{}, ErrNotFound
+		}
+		return RoundCardSettings{}, fmt.Errorf("failed to fetch round card settings: %w", err)
+	}
+	return RoundCardSettings{
+		Size:         round.CardSize,
+		Strategy:     round.CardStrategy,
+		ShotgunStart: round.ShotgunStart,
+	}, nil
+}
+
+// SetRoundCardSettings stores the round's card settings.
+func (r *Impl) SetRoundCardSettings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, settings RoundCardSettings) error {
+	if db == nil {
+		db = r.db
+	}
+	res, err := db.NewUpdate().
+		Model((*Round)(nil)).
+		Set("card_size = ?", settings.Size).
+		Set("card_strategy = ?", settings.Strategy).
+		Set("shotgun_start = ?", settings.ShotgunStart).
+		Set("updated_at = now()").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update round card settings: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return ErrNoRowsAffected
+	}
+	return nil
+}
+
+// ReplaceRoundCards swaps the round's card groups for cards. Entry groups are left
+// untouched; members of the old cards go with them through the cascade.
+func (r *Impl) ReplaceRoundCards(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID, cards []RoundCard) error {
+	if db == nil {
+		db = r.db
+	}
+
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Model((*RoundGroup)(nil)).
+			Where("round_id = ?", roundID).
+			Where("kind = ?", RoundGroupKindCard).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete round cards: %w", err)
+		}
+
+		for _, card := range cards {
+			number := card.Number
+			group := &RoundGroup{
+				ID:           uuid.New(),
+				RoundID:      roundID,
+				Name:         fmt.Sprintf("Card %d", card.Number),
+				Kind:         RoundGroupKindCard,
+				CardNumber:   &number,
+				StartingHole: card.StartingHole,
+			}
+			if err := r.createRoundGroup(ctx, tx, group); err != nil {
+				return fmt.Errorf("failed to create card %d: %w", card.Number, err)
+			}
+
+			members := make([]*RoundGroupParticipant, len(card.Members))
+			for i := range card.Members {
+				m := card.Members[i]
+				m.GroupID = group.ID
+				members[i] = &m
+			}
+			if err := r.createRoundGroupParticipants(ctx, tx, members); err != nil {
+				return fmt.Errorf("failed to add players to card %d: %w", card.Number, err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetRoundCards returns the round's cards in card order, or nil when none have been
+// assigned.
+func (r *Impl) GetRoundCards(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID) ([]RoundCard, error) {
+	if db == nil {
+		db = r.db
+	}
+
+	var groups []RoundGroup
+	if err := db.NewSelect().
+		Model(&groups).
+		Where("round_id = ?", roundID).
+		Where("kind = ?", RoundGroupKindCard).
+		Order("card_number ASC").
+		Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to fetch round cards: %w", err)
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	groupIDs := make([]uuid.UUID, len(groups))
+	for i, g := range groups {
+		groupIDs[i] = g.ID
+	}
+	var members []RoundGroupParticipant
+	if err := db.NewSelect().
+		Model(&members).
+		Where("group_id IN (?)", bun.In(groupIDs)).
+		Order("raw_name ASC").
+		Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to fetch round card members: %w", err)
+	}
+	byGroup := make(map[uuid.UUID][]RoundGroupParticipant, len(groups))
+	for _, m := range members {
+		byGroup[m.GroupID] = append(byGroup[m.GroupID], m)
+	}
+
+	cards := make([]RoundCard, len(groups))
+	for i, g := range groups {
+		cards[i] = RoundCard{StartingHole: g.StartingHole, Members: byGroup[g.ID]}
+		if g.CardNumber != nil {
+			cards[i].Number = *g.CardNumber
+		}
+	}
+	return cards, nil
+}
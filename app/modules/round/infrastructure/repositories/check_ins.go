@@ -0,0 +1,154 @@
+package rounddb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// Check-in statuses stored in round_check_ins.
+const (
+	CheckInStatusCheckedIn = "checked_in"
+	CheckInStatusNoShow    = "no_show"
+)
+
+// RoundCheckIn records whether an accepted player turned up for a round with
+// check-in enabled.
+type RoundCheckIn struct {
+	bun.BaseModel `bun:"table:round_check_ins,alias:rci"`
+
+	RoundID    sharedtypes.RoundID   `bun:"round_id,pk,type:uuid"`
+	GuildID    sharedtypes.GuildID   `bun:"guild_id,notnull"`
+	UserID     sharedtypes.DiscordID `bun:"user_id,pk"`
+	Status     string                `bun:"status,notnull"`
+	RecordedAt time.Time             `bun:"recorded_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// MemberAttendance counts a member's check-ins and no-shows across a guild's rounds
+// that started.
+type MemberAttendance struct {
+	CheckedIn int
+	NoShows   int
+}
+
+// GetRoundCheckInMinutes returns the round's check-in window in minutes; 0 means
+// check-in is disabled.
+func (r *Impl) GetRoundCheckInMinutes(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (int, error) {
+	if db == nil {
+		db = r.db
+	}
+	var round Round
+	err := db.NewSelect().
+		Model(&round).
+		Column("check_in_minutes").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrNotFound
+		}
+		return 0, fmt.Errorf("failed to fetch round check-in window: %w", err)
+	}
+	return round.CheckInMinutes, nil
+}
+
+// SetRoundCheckInMinutes stores the round's check-in window in minutes.
+func (r *Impl) SetRoundCheckInMinutes(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, minutes int) error {
+	if db == nil {
+		db = r.db
+	}
+	res, err := db.NewUpdate().
+		Model((*Round)(nil)).
+		Set("check_in_minutes = ?", minutes).
+		Set("updated_at = now()").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update round check-in window: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return ErrNoRowsAffected
+	}
+	return nil
+}
+
+// UpsertCheckIns records check-in statuses, replacing any earlier status for the
+// same player and round.
+func (r *Impl) UpsertCheckIns(ctx context.Context, db bun.IDB, checkIns []*RoundCheckIn) error {
+	if len(checkIns) == 0 {
+		return nil
+	}
+	if db == nil {
+		db = r.db
+	}
+	_, err := db.NewInsert().
+		Model(&checkIns).
+		On("CONFLICT (round_id, user_id) DO UPDATE").
+		Set("status = EXCLUDED.status").
+		Set("recorded_at = now()").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to upsert round check-ins: %w", err)
+	}
+	return nil
+}
+
+// GetRoundCheckIns returns the recorded check-in statuses for a round.
+func (r *Impl) GetRoundCheckIns(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]*RoundCheckIn, error) {
+	if db == nil {
+		db = r.db
+	}
+	var checkIns []*RoundCheckIn
+	err := db.NewSelect().
+		Model(&checkIns).
+		Where("round_id = ? AND guild_id = ?", roundID, guildID).
+		Order("recorded_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get round check-ins: %w", err)
+	}
+	return checkIns, nil
+}
+
+// GetMemberAttendance counts the member's check-ins and no-shows in the guild. Only
+// rounds that started count; a check-in for a round that was deleted or never
+// started says nothing about whether the member turned up.
+func (r *Impl) GetMemberAttendance(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (MemberAttendance, error) {
+	if db == nil {
+		db = r.db
+	}
+	var rows []struct {
+		Status string `bun:"status"`
+		Count  int    `bun:"count"`
+	}
+	err := db.NewSelect().
+		Model((*RoundCheckIn)(nil)).
+		Column("rci.status").
+		ColumnExpr("COUNT(*) AS count").
+		Join("JOIN rounds AS r ON r.id = rci.round_id").
+		Where("rci.guild_id = ? AND rci.user_id = ?", guildID, userID).
+		Where("r.state IN (?)", bun.In([]roundtypes.RoundState{roundtypes.RoundStateInProgress, roundtypes.RoundStateFinalized})).
+		Group("rci.status").
+		Scan(ctx, &rows)
+	if err != nil {
+		return MemberAttendance{}, fmt.Errorf("failed to count member attendance: %w", err)
+	}
+
+	var attendance MemberAttendance
+	for _, row := range rows {
+		switch row.Status {
+		case CheckInStatusCheckedIn:
+			attendance.CheckedIn = row.Count
+		case CheckInStatusNoShow:
+			attendance.NoShows = row.Count
+		}
+	}
+	return attendance, nil
+}
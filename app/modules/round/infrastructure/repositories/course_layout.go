@@ -0,0 +1,78 @@
+package rounddb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// SetRoundCourseLayout links a round to a course layout; a nil layoutID clears the link.
+func (r *Impl) SetRoundCourseLayout(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, layoutID *uuid.UUID) error {
+	if db == nil {
+		db = r.db
+	}
+	res, err := db.NewUpdate().
+		Model((*Round)(nil)).
+		Set("course_layout_id = ?", layoutID).
+		Set("updated_at = now()").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update round course layout: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return ErrNoRowsAffected
+	}
+	return nil
+}
+
+// GetRoundCourseLayoutID returns the layout a round was played on, or nil when the
+// round only has a free-text location.
+func (r *Impl) GetRoundCourseLayoutID(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*uuid.UUID, error) {
+	if db == nil {
+		db = r.db
+	}
+	var layoutID *uuid.UUID
+	err := db.NewSelect().
+		Model((*Round)(nil)).
+		Column("course_layout_id").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Scan(ctx, &layoutID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch round course layout: %w", err)
+	}
+	return layoutID, nil
+}
+
+// GetFinalizedRoundsOnLayout returns finalized rounds played on one layout after
+// startTime, oldest first.
+func (r *Impl) GetFinalizedRoundsOnLayout(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error) {
+	if db == nil {
+		db = r.db
+	}
+	var localRounds []*Round
+	err := db.NewSelect().
+		Model(&localRounds).
+		ExcludeColumn("file_data").
+		Where("guild_id = ? AND course_layout_id = ? AND finalized = true AND start_time > ?", guildID, layoutID, startTime).
+		OrderExpr("start_time ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query finalized rounds on layout: %w", err)
+	}
+	rounds := make([]*roundtypes.Round, len(localRounds))
+	for i, lr := range localRounds {
+		rounds[i] = toSharedRound(lr)
+	}
+	return rounds, nil
+}
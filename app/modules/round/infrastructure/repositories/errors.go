@@ -13,4 +13,7 @@ var (
 
 	// ErrParticipantNotFound indicates the participant does not exist in the round.
 	ErrParticipantNotFound = errors.New("participant not found")
+
+	// ErrRoundSeriesNotFound indicates the requested round series or occurrence does not exist.
+	ErrRoundSeriesNotFound = errors.New("round series not found")
 )
@@ -0,0 +1,58 @@
+package rounddb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// RoundHoleScore is a single hole result submitted during a live round.
+type RoundHoleScore struct {
+	bun.BaseModel `bun:"table:round_hole_scores,alias:rhs"`
+
+	RoundID   sharedtypes.RoundID   `bun:"round_id,pk,type:uuid"`
+	GuildID   sharedtypes.GuildID   `bun:"guild_id,notnull"`
+	UserID    sharedtypes.DiscordID `bun:"user_id,pk"`
+	Hole      int                   `bun:"hole,pk"`
+	Strokes   int                   `bun:"strokes,notnull"`
+	CreatedAt time.Time             `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time             `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// UpsertHoleScore records the strokes for a hole, replacing any earlier entry.
+func (r *Impl) UpsertHoleScore(ctx context.Context, db bun.IDB, score *RoundHoleScore) error {
+	if db == nil {
+		db = r.db
+	}
+	_, err := db.NewInsert().
+		Model(score).
+		On("CONFLICT (round_id, user_id, hole) DO UPDATE").
+		Set("strokes = EXCLUDED.strokes").
+		Set("updated_at = now()").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to upsert hole score: %w", err)
+	}
+	return nil
+}
+
+// GetHoleScores returns all hole scores for a round, ordered by player and hole.
+func (r *Impl) GetHoleScores(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]*RoundHoleScore, error) {
+	if db == nil {
+		db = r.db
+	}
+	var scores []*RoundHoleScore
+	err := db.NewSelect().
+		Model(&scores).
+		Where("round_id = ? AND guild_id = ?", roundID, guildID).
+		Order("user_id ASC", "hole ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hole scores: %w", err)
+	}
+	return scores, nil
+}
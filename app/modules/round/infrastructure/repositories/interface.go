@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
@@ -48,4 +49,52 @@ type Repository interface {
 	// start_time falls within [now, now+lookahead]. Used by the betting market worker
 	// to enumerate clubs that need market generation without knowing guild IDs upfront.
 	GetAllUpcomingRoundsInWindow(ctx context.Context, db bun.IDB, lookahead time.Duration) ([]*roundtypes.Round, error)
+	SetRoundMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, mode sharedtypes.RoundMode) error
+
+	// Round series (recurring rounds)
+	CreateRoundSeries(ctx context.Context, db bun.IDB, series *RoundSeries) error
+	GetRoundSeries(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, seriesID uuid.UUID) (*RoundSeries, error)
+	ListRoundSeries(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) ([]*RoundSeries, error)
+	ListActiveRoundSeries(ctx context.Context, db bun.IDB) ([]*RoundSeries, error)
+	UpdateRoundSeries(ctx context.Context, db bun.IDB, series *RoundSeries) error
+	GetRoundSeriesOccurrences(ctx context.Context, db bun.IDB, seriesID uuid.UUID) ([]*RoundSeriesOccurrence, error)
+	ClaimRoundSeriesOccurrence(ctx context.Context, db bun.IDB, occurrence *RoundSeriesOccurrence) (bool, error)
+	UpsertRoundSeriesOccurrence(ctx context.Context, db bun.IDB, occurrence *RoundSeriesOccurrence) error
+	DeleteRoundSeriesOccurrence(ctx context.Context, db bun.IDB, seriesID uuid.UUID, occurrenceAt time.Time) error
+
+	// Live hole-by-hole scoring
+	UpsertHoleScore(ctx context.Context, db bun.IDB, score *RoundHoleScore) error
+	GetHoleScores(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]*RoundHoleScore, error)
+
+	// Course layout references
+	SetRoundCourseLayout(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, layoutID *uuid.UUID) error
+	GetRoundCourseLayoutID(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*uuid.UUID, error)
+	GetFinalizedRoundsOnLayout(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, layoutID uuid.UUID, startTime time.Time) ([]*roundtypes.Round, error)
+
+	// Scoring mode
+	SetRoundScoringMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, mode string) error
+	GetRoundScoringMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (string, error)
+
+	// Capacity
+	GetRoundCapacity(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (int, []WaitlistEntry, error)
+	SetRoundMaxParticipants(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, maxParticipants int) error
+	SetRoundWaitlist(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, waitlist []WaitlistEntry) error
+
+	// Cards
+	GetRoundCardSettings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (RoundCardSettings, error)
+	SetRoundCardSettings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, settings RoundCardSettings) error
+	ReplaceRoundCards(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID, cards []RoundCard) error
+	GetRoundCards(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID) ([]RoundCard, error)
+
+	// Team draws
+	GetRoundTeamDraw(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (sharedtypes.RoundMode, string, error)
+	SetRoundTeamDraw(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, draw string) error
+	ReplaceRoundEntryGroups(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID, groups []RoundEntryGroup) error
+
+	// Check-ins
+	GetRoundCheckInMinutes(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (int, error)
+	SetRoundCheckInMinutes(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, minutes int) error
+	UpsertCheckIns(ctx context.Context, db bun.IDB, checkIns []*RoundCheckIn) error
+	GetRoundCheckIns(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]*RoundCheckIn, error)
+	GetMemberAttendance(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) (MemberAttendance, error)
 }
@@ -0,0 +1,91 @@
+package roundmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Creating round series tables...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				CREATE TABLE IF NOT EXISTS round_series (
+					id UUID PRIMARY KEY,
+					guild_id VARCHAR NOT NULL,
+					title VARCHAR NOT NULL,
+					description VARCHAR NOT NULL DEFAULT '',
+					location VARCHAR NOT NULL,
+					event_type VARCHAR NOT NULL DEFAULT 'casual',
+					mode VARCHAR NOT NULL DEFAULT 'SINGLES',
+					recurrence_rule VARCHAR NOT NULL,
+					timezone VARCHAR NOT NULL,
+					dtstart TIMESTAMPTZ NOT NULL,
+					materialize_days_ahead INTEGER NOT NULL DEFAULT 7,
+					channel_id VARCHAR,
+					created_by VARCHAR NOT NULL,
+					active BOOLEAN NOT NULL DEFAULT TRUE,
+					created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+				);
+			`); err != nil {
+				return fmt.Errorf("failed to create round_series table: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_round_series_guild_active
+					ON round_series (guild_id, active);
+			`); err != nil {
+				return fmt.Errorf("failed to create round_series guild index: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE TABLE IF NOT EXISTS round_series_occurrences (
+					series_id UUID NOT NULL REFERENCES round_series(id) ON DELETE CASCADE,
+					occurrence_at TIMESTAMPTZ NOT NULL,
+					status VARCHAR NOT NULL,
+					round_id UUID,
+					override_title VARCHAR,
+					override_location VARCHAR,
+					override_start_time TIMESTAMPTZ,
+					created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					PRIMARY KEY (series_id, occurrence_at)
+				);
+			`); err != nil {
+				return fmt.Errorf("failed to create round_series_occurrences table: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_round_series_occurrences_round_id
+					ON round_series_occurrences (round_id)
+					WHERE round_id IS NOT NULL;
+			`); err != nil {
+				return fmt.Errorf("failed to create round_series_occurrences round_id index: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping round series tables...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				DROP TABLE IF EXISTS round_series_occurrences;
+			`); err != nil {
+				return fmt.Errorf("failed to drop round_series_occurrences table: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				DROP TABLE IF EXISTS round_series;
+			`); err != nil {
+				return fmt.Errorf("failed to drop round_series table: %w", err)
+			}
+
+			return nil
+		})
+	})
+}
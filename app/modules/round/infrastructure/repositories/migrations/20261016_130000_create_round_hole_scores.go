@@ -0,0 +1,47 @@
+package roundmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Creating round_hole_scores table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				CREATE TABLE IF NOT EXISTS round_hole_scores (
+					round_id UUID NOT NULL REFERENCES rounds(id) ON DELETE CASCADE,
+					guild_id VARCHAR NOT NULL,
+					user_id VARCHAR NOT NULL,
+					hole INTEGER NOT NULL CHECK (hole >= 1),
+					strokes INTEGER NOT NULL CHECK (strokes >= 1),
+					created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					PRIMARY KEY (round_id, user_id, hole)
+				);
+			`); err != nil {
+				return fmt.Errorf("failed to create round_hole_scores table: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_round_hole_scores_guild_round
+					ON round_hole_scores (guild_id, round_id);
+			`); err != nil {
+				return fmt.Errorf("failed to create round_hole_scores guild index: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping round_hole_scores table...")
+
+		if _, err := db.ExecContext(ctx, `DROP TABLE IF EXISTS round_hole_scores;`); err != nil {
+			return fmt.Errorf("failed to drop round_hole_scores table: %w", err)
+		}
+		return nil
+	})
+}
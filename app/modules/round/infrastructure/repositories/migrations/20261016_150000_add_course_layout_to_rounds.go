@@ -0,0 +1,47 @@
+package roundmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding course_layout_id column to rounds table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds ADD COLUMN IF NOT EXISTS course_layout_id UUID;
+			`); err != nil {
+				return fmt.Errorf("failed to add course_layout_id column to rounds: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_rounds_guild_course_layout
+				ON rounds (guild_id, course_layout_id, start_time)
+				WHERE course_layout_id IS NOT NULL;
+			`); err != nil {
+				return fmt.Errorf("failed to create rounds course layout index: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping course_layout_id column from rounds table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `DROP INDEX IF EXISTS idx_rounds_guild_course_layout;`); err != nil {
+				return fmt.Errorf("failed to drop rounds course layout index: %w", err)
+			}
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds DROP COLUMN IF EXISTS course_layout_id;
+			`); err != nil {
+				return fmt.Errorf("failed to drop course_layout_id column from rounds: %w", err)
+			}
+
+			return nil
+		})
+	})
+}
@@ -0,0 +1,44 @@
+package roundmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding scoring_mode column to rounds table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds ADD COLUMN IF NOT EXISTS scoring_mode VARCHAR(10) NOT NULL DEFAULT 'gross';
+			`); err != nil {
+				return fmt.Errorf("failed to add scoring_mode column to rounds: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds DROP CONSTRAINT IF EXISTS chk_rounds_scoring_mode;
+				ALTER TABLE rounds ADD CONSTRAINT chk_rounds_scoring_mode CHECK (scoring_mode IN ('gross', 'net'));
+			`); err != nil {
+				return fmt.Errorf("failed to add rounds scoring_mode constraint: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping scoring_mode column from rounds table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds DROP CONSTRAINT IF EXISTS chk_rounds_scoring_mode;
+				ALTER TABLE rounds DROP COLUMN IF EXISTS scoring_mode;
+			`); err != nil {
+				return fmt.Errorf("failed to drop scoring_mode column from rounds: %w", err)
+			}
+
+			return nil
+		})
+	})
+}
@@ -0,0 +1,46 @@
+package roundmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding max_participants and waitlist columns to rounds table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds ADD COLUMN IF NOT EXISTS max_participants INT NOT NULL DEFAULT 0;
+				ALTER TABLE rounds ADD COLUMN IF NOT EXISTS waitlist JSONB NOT NULL DEFAULT '[]'::jsonb;
+			`); err != nil {
+				return fmt.Errorf("failed to add capacity columns to rounds: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds DROP CONSTRAINT IF EXISTS chk_rounds_max_participants;
+				ALTER TABLE rounds ADD CONSTRAINT chk_rounds_max_participants CHECK (max_participants >= 0);
+			`); err != nil {
+				return fmt.Errorf("failed to add rounds max_participants constraint: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping max_participants and waitlist columns from rounds table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds DROP CONSTRAINT IF EXISTS chk_rounds_max_participants;
+				ALTER TABLE rounds DROP COLUMN IF EXISTS waitlist;
+				ALTER TABLE rounds DROP COLUMN IF EXISTS max_participants;
+			`); err != nil {
+				return fmt.Errorf("failed to drop capacity columns from rounds: %w", err)
+			}
+
+			return nil
+		})
+	})
+}
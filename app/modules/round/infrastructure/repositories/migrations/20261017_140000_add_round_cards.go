@@ -0,0 +1,69 @@
+package roundmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding card columns to round_groups and rounds tables...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Existing groups are per-participant entries (singles players or
+			// imported doubles teams); cards are a second kind of group.
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE round_groups ADD COLUMN IF NOT EXISTS kind VARCHAR(10) NOT NULL DEFAULT 'entry';
+				ALTER TABLE round_groups ADD COLUMN IF NOT EXISTS card_number INT;
+				ALTER TABLE round_groups ADD COLUMN IF NOT EXISTS starting_hole INT;
+				CREATE INDEX IF NOT EXISTS idx_round_groups_round_id_kind ON round_groups(round_id, kind);
+			`); err != nil {
+				return fmt.Errorf("failed to add card columns to round_groups: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds ADD COLUMN IF NOT EXISTS card_size INT NOT NULL DEFAULT 0;
+				ALTER TABLE rounds ADD COLUMN IF NOT EXISTS card_strategy VARCHAR(20) NOT NULL DEFAULT 'random';
+				ALTER TABLE rounds ADD COLUMN IF NOT EXISTS shotgun_start BOOLEAN NOT NULL DEFAULT false;
+			`); err != nil {
+				return fmt.Errorf("failed to add card settings to rounds: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds DROP CONSTRAINT IF EXISTS chk_rounds_card_size;
+				ALTER TABLE rounds ADD CONSTRAINT chk_rounds_card_size CHECK (card_size >= 0);
+			`); err != nil {
+				return fmt.Errorf("failed to add rounds card_size constraint: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping card columns from round_groups and rounds tables...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				DELETE FROM round_groups WHERE kind = 'card';
+				DROP INDEX IF EXISTS idx_round_groups_round_id_kind;
+				ALTER TABLE round_groups DROP COLUMN IF EXISTS starting_hole;
+				ALTER TABLE round_groups DROP COLUMN IF EXISTS card_number;
+				ALTER TABLE round_groups DROP COLUMN IF EXISTS kind;
+			`); err != nil {
+				return fmt.Errorf("failed to drop card columns from round_groups: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds DROP CONSTRAINT IF EXISTS chk_rounds_card_size;
+				ALTER TABLE rounds DROP COLUMN IF EXISTS shotgun_start;
+				ALTER TABLE rounds DROP COLUMN IF EXISTS card_strategy;
+				ALTER TABLE rounds DROP COLUMN IF EXISTS card_size;
+			`); err != nil {
+				return fmt.Errorf("failed to drop card settings from rounds: %w", err)
+			}
+
+			return nil
+		})
+	})
+}
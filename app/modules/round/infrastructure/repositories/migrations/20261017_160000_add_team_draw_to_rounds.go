@@ -0,0 +1,44 @@
+package roundmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding team_draw column to rounds table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds ADD COLUMN IF NOT EXISTS team_draw VARCHAR(10) NOT NULL DEFAULT 'off';
+			`); err != nil {
+				return fmt.Errorf("failed to add team_draw column to rounds: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds DROP CONSTRAINT IF EXISTS chk_rounds_team_draw;
+				ALTER TABLE rounds ADD CONSTRAINT chk_rounds_team_draw CHECK (team_draw IN ('off', 'random', 'seeded'));
+			`); err != nil {
+				return fmt.Errorf("failed to add rounds team_draw constraint: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping team_draw column from rounds table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds DROP CONSTRAINT IF EXISTS chk_rounds_team_draw;
+				ALTER TABLE rounds DROP COLUMN IF EXISTS team_draw;
+			`); err != nil {
+				return fmt.Errorf("failed to drop team_draw column from rounds: %w", err)
+			}
+
+			return nil
+		})
+	})
+}
@@ -0,0 +1,68 @@
+package roundmigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding check-in window to rounds and creating round_check_ins table...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds ADD COLUMN IF NOT EXISTS check_in_minutes INTEGER NOT NULL DEFAULT 0;
+			`); err != nil {
+				return fmt.Errorf("failed to add check_in_minutes column to rounds: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds DROP CONSTRAINT IF EXISTS chk_rounds_check_in_minutes;
+				ALTER TABLE rounds ADD CONSTRAINT chk_rounds_check_in_minutes CHECK (check_in_minutes BETWEEN 0 AND 240);
+			`); err != nil {
+				return fmt.Errorf("failed to add rounds check_in_minutes constraint: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE TABLE IF NOT EXISTS round_check_ins (
+					round_id UUID NOT NULL REFERENCES rounds(id) ON DELETE CASCADE,
+					guild_id VARCHAR NOT NULL,
+					user_id VARCHAR NOT NULL,
+					status VARCHAR(10) NOT NULL CHECK (status IN ('checked_in', 'no_show')),
+					recorded_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					PRIMARY KEY (round_id, user_id)
+				);
+			`); err != nil {
+				return fmt.Errorf("failed to create round_check_ins table: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_round_check_ins_guild_user
+					ON round_check_ins (guild_id, user_id);
+			`); err != nil {
+				return fmt.Errorf("failed to create round_check_ins member index: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Dropping round_check_ins table and check-in window from rounds...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `DROP TABLE IF EXISTS round_check_ins;`); err != nil {
+				return fmt.Errorf("failed to drop round_check_ins table: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE rounds DROP CONSTRAINT IF EXISTS chk_rounds_check_in_minutes;
+				ALTER TABLE rounds DROP COLUMN IF EXISTS check_in_minutes;
+			`); err != nil {
+				return fmt.Errorf("failed to drop check_in_minutes column from rounds: %w", err)
+			}
+
+			return nil
+		})
+	})
+}
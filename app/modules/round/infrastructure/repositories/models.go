@@ -52,8 +52,51 @@ type Round struct {
 	ImportUserID    sharedtypes.DiscordID `bun:"import_user_id,nullzero"`
 	ImportChannelID string                `bun:"import_channel_id,nullzero"`
 	ParScores       []int                 `bun:"par_scores,type:jsonb,nullzero" json:"par_scores,omitempty"`
+
+	// Course registry reference (course module layout); nil for free-text locations.
+	CourseLayoutID *uuid.UUID `bun:"course_layout_id,type:uuid,nullzero"`
+
+	// ScoringMode is "gross" (raw score) or "net" (score minus handicap) ranking.
+	ScoringMode string `bun:"scoring_mode,notnull,default:'gross'"`
+
+	// Capacity: MaxParticipants of 0 means unlimited. Joins beyond the cap wait in
+	// Waitlist, oldest first.
+	MaxParticipants int             `bun:"max_participants,notnull,default:0"`
+	Waitlist        []WaitlistEntry `bun:"waitlist,type:jsonb,nullzero,notnull,default:'[]'"`
+
+	// Cards: accepted players are split into cards of CardSize (0 = no cards) when
+	// the round starts, grouped by CardStrategy, with staggered starting holes for
+	// a ShotgunStart.
+	CardSize     int    `bun:"card_size,notnull,default:0"`
+	CardStrategy string `bun:"card_strategy,notnull,default:'random'"`
+	ShotgunStart bool   `bun:"shotgun_start,notnull,default:false"`
+
+	// TeamDraw pairs accepted players into random doubles teams when the round
+	// starts: "off", "random", or "seeded" (top-half tags with bottom-half tags).
+	TeamDraw string `bun:"team_draw,notnull,default:'off'"`
+
+	// CheckInMinutes is how long before the start time players can check in; 0
+	// disables check-in and no-show tracking for the round.
+	CheckInMinutes int `bun:"check_in_minutes,notnull,default:0"`
 }
 
+// WaitlistEntry is a player waiting for a seat in a full round. Response is the
+// response they asked for, which they get when promoted.
+type WaitlistEntry struct {
+	UserID    sharedtypes.DiscordID  `json:"user_id"`
+	Response  roundtypes.Response    `json:"response"`
+	TagNumber *sharedtypes.TagNumber `json:"tag_number,omitempty"`
+	JoinedAt  time.Time              `json:"joined_at"`
+}
+
+// Round group kinds. Entry groups are the round's scoring entries (a singles
+// player or an imported doubles team); card groups are the cards players tee off
+// on, assigned when the round starts.
+const (
+	RoundGroupKindEntry = "entry"
+	RoundGroupKindCard  = "card"
+)
+
 type RoundGroup struct {
 	bun.BaseModel `bun:"table:round_groups"`
 
@@ -61,6 +104,11 @@ type RoundGroup struct {
 	RoundID sharedtypes.RoundID `bun:"round_id,type:uuid,notnull"`
 
 	Name string `bun:"group_name,notnull"`
+	Kind string `bun:"kind,nullzero,notnull,default:'entry'"`
+
+	// Card groups only.
+	CardNumber   *int `bun:"card_number,nullzero"`
+	StartingHole *int `bun:"starting_hole,nullzero"`
 
 	CreatedAt time.Time `bun:",nullzero,notnull,default:now()"`
 	UpdatedAt time.Time `bun:",nullzero,notnull,default:now()"`
@@ -909,9 +909,9 @@ func (r *Impl) UpdateRoundsAndParticipants(ctx context.Context, db bun.IDB, guil
 					t = &roundtypes.NormalizedTeam{TeamID: p.TeamID, Members: []roundtypes.TeamMember{}}
 					teamsByID[p.TeamID] = t
 				}
-				// accumulate total if score present
+				// Members of a team share one score, so the team's total is that score.
 				if p.Score != nil {
-					t.Total += int(*p.Score)
+					t.Total = int(*p.Score)
 				}
 				// build member entry (handle guests)
 				var userPtr *sharedtypes.DiscordID
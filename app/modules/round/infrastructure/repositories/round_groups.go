@@ -21,6 +21,7 @@ func (r *Impl) RoundHasGroups(
 	count, err := db.NewSelect().
 		Model((*RoundGroup)(nil)).
 		Where("round_id = ?", roundID).
+		Where("kind = ?", RoundGroupKindEntry).
 		Count(ctx)
 	return count > 0, err
 }
@@ -47,6 +48,7 @@ func (r *Impl) CreateRoundGroups(
 				ID:      groupID,
 				RoundID: roundID,
 				Name:    displayName,
+				Kind:    RoundGroupKindEntry,
 			}
 			if err := r.createRoundGroup(ctx, tx, group); err != nil {
 				return err
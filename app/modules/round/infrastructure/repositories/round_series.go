@@ -0,0 +1,259 @@
+package rounddb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// RoundSeries is a recurring round template. Concrete rounds are materialized from
+// it a configurable number of days ahead of each occurrence.
+type RoundSeries struct {
+	bun.BaseModel `bun:"table:round_series,alias:rs"`
+
+	ID                   uuid.UUID              `bun:"id,pk,type:uuid"`
+	GuildID              sharedtypes.GuildID    `bun:"guild_id,notnull"`
+	Title                roundtypes.Title       `bun:"title,notnull"`
+	Description          roundtypes.Description `bun:"description,notnull"`
+	Location             roundtypes.Location    `bun:"location,notnull"`
+	EventType            roundtypes.EventType   `bun:"event_type,notnull"`
+	Mode                 sharedtypes.RoundMode  `bun:"mode,notnull"`
+	RecurrenceRule       string                 `bun:"recurrence_rule,notnull"`
+	Timezone             string                 `bun:"timezone,notnull"`
+	DTStart              time.Time              `bun:"dtstart,notnull,type:timestamptz"`
+	MaterializeDaysAhead int                    `bun:"materialize_days_ahead,notnull"`
+	ChannelID            string                 `bun:"channel_id,nullzero"`
+	CreatedBy            sharedtypes.DiscordID  `bun:"created_by,notnull"`
+	Active               bool                   `bun:"active,notnull"`
+	CreatedAt            time.Time              `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt            time.Time              `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// RoundSeriesOccurrenceStatus tracks what happened to a single series occurrence.
+type RoundSeriesOccurrenceStatus string
+
+const (
+	// RoundSeriesOccurrenceScheduled marks an occurrence that carries an override
+	// but has not been materialized yet.
+	RoundSeriesOccurrenceScheduled RoundSeriesOccurrenceStatus = "SCHEDULED"
+	// RoundSeriesOccurrenceMaterialized marks an occurrence that has a concrete round.
+	RoundSeriesOccurrenceMaterialized RoundSeriesOccurrenceStatus = "MATERIALIZED"
+	// RoundSeriesOccurrenceSkipped marks an occurrence that must never be materialized.
+	RoundSeriesOccurrenceSkipped RoundSeriesOccurrenceStatus = "SKIPPED"
+)
+
+// RoundSeriesOccurrence records per-occurrence state. Occurrences without a row
+// are implicitly scheduled with the series defaults.
+type RoundSeriesOccurrence struct {
+	bun.BaseModel `bun:"table:round_series_occurrences,alias:rso"`
+
+	SeriesID          uuid.UUID                   `bun:"series_id,pk,type:uuid"`
+	OccurrenceAt      time.Time                   `bun:"occurrence_at,pk,type:timestamptz"`
+	Status            RoundSeriesOccurrenceStatus `bun:"status,notnull"`
+	RoundID           *sharedtypes.RoundID        `bun:"round_id,type:uuid,nullzero"`
+	OverrideTitle     *roundtypes.Title           `bun:"override_title,nullzero"`
+	OverrideLocation  *roundtypes.Location        `bun:"override_location,nullzero"`
+	OverrideStartTime *time.Time                  `bun:"override_start_time,type:timestamptz,nullzero"`
+	CreatedAt         time.Time                   `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt         time.Time                   `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// HasOverride reports whether the occurrence diverges from the series defaults.
+func (o *RoundSeriesOccurrence) HasOverride() bool {
+	return o != nil && (o.OverrideTitle != nil || o.OverrideLocation != nil || o.OverrideStartTime != nil)
+}
+
+// CreateRoundSeries inserts a new round series.
+func (r *Impl) CreateRoundSeries(ctx context.Context, db bun.IDB, series *RoundSeries) error {
+	if db == nil {
+		db = r.db
+	}
+	if series.ID == uuid.Nil {
+		series.ID = uuid.New()
+	}
+	if _, err := db.NewInsert().Model(series).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create round series: %w", err)
+	}
+	return nil
+}
+
+// GetRoundSeries retrieves a round series scoped to a guild.
+func (r *Impl) GetRoundSeries(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, seriesID uuid.UUID) (*RoundSeries, error) {
+	if db == nil {
+		db = r.db
+	}
+	series := new(RoundSeries)
+	err := db.NewSelect().
+		Model(series).
+		Where("id = ? AND guild_id = ?", seriesID, guildID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRoundSeriesNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch round series: %w", err)
+	}
+	return series, nil
+}
+
+// ListRoundSeries returns every series for a guild, newest first.
+func (r *Impl) ListRoundSeries(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) ([]*RoundSeries, error) {
+	if db == nil {
+		db = r.db
+	}
+	var series []*RoundSeries
+	err := db.NewSelect().
+		Model(&series).
+		Where("guild_id = ?", guildID).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list round series: %w", err)
+	}
+	return series, nil
+}
+
+// ListActiveRoundSeries returns active series across all guilds. Used by the
+// periodic materialization job, which runs without a guild context.
+func (r *Impl) ListActiveRoundSeries(ctx context.Context, db bun.IDB) ([]*RoundSeries, error) {
+	if db == nil {
+		db = r.db
+	}
+	var series []*RoundSeries
+	err := db.NewSelect().
+		Model(&series).
+		Where("active = TRUE").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active round series: %w", err)
+	}
+	return series, nil
+}
+
+// UpdateRoundSeries persists the editable fields of a series.
+func (r *Impl) UpdateRoundSeries(ctx context.Context, db bun.IDB, series *RoundSeries) error {
+	if db == nil {
+		db = r.db
+	}
+	res, err := db.NewUpdate().
+		Model(series).
+		Column("title", "description", "location", "event_type", "mode", "recurrence_rule",
+			"timezone", "dtstart", "materialize_days_ahead", "channel_id", "active").
+		Set("updated_at = now()").
+		Where("id = ? AND guild_id = ?", series.ID, series.GuildID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update round series: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrRoundSeriesNotFound
+	}
+	return nil
+}
+
+// GetRoundSeriesOccurrences returns the recorded occurrence rows for a series.
+func (r *Impl) GetRoundSeriesOccurrences(ctx context.Context, db bun.IDB, seriesID uuid.UUID) ([]*RoundSeriesOccurrence, error) {
+	if db == nil {
+		db = r.db
+	}
+	var occurrences []*RoundSeriesOccurrence
+	err := db.NewSelect().
+		Model(&occurrences).
+		Where("series_id = ?", seriesID).
+		Order("occurrence_at ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get round series occurrences: %w", err)
+	}
+	return occurrences, nil
+}
+
+// ClaimRoundSeriesOccurrence inserts an occurrence row only if none exists yet.
+// It returns false when another worker (or an earlier run) already recorded it,
+// which is what keeps materialization idempotent.
+func (r *Impl) ClaimRoundSeriesOccurrence(ctx context.Context, db bun.IDB, occurrence *RoundSeriesOccurrence) (bool, error) {
+	if db == nil {
+		db = r.db
+	}
+	occurrence.OccurrenceAt = occurrence.OccurrenceAt.UTC()
+	res, err := db.NewInsert().
+		Model(occurrence).
+		On("CONFLICT (series_id, occurrence_at) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim round series occurrence: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// UpsertRoundSeriesOccurrence creates or replaces the state of an occurrence.
+func (r *Impl) UpsertRoundSeriesOccurrence(ctx context.Context, db bun.IDB, occurrence *RoundSeriesOccurrence) error {
+	if db == nil {
+		db = r.db
+	}
+	occurrence.OccurrenceAt = occurrence.OccurrenceAt.UTC()
+	_, err := db.NewInsert().
+		Model(occurrence).
+		On("CONFLICT (series_id, occurrence_at) DO UPDATE").
+		Set("status = EXCLUDED.status").
+		Set("round_id = EXCLUDED.round_id").
+		Set("override_title = EXCLUDED.override_title").
+		Set("override_location = EXCLUDED.override_location").
+		Set("override_start_time = EXCLUDED.override_start_time").
+		Set("updated_at = now()").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to upsert round series occurrence: %w", err)
+	}
+	return nil
+}
+
+// DeleteRoundSeriesOccurrence removes an occurrence row, returning the occurrence
+// to the series defaults. Used to release a claim when materialization fails.
+func (r *Impl) DeleteRoundSeriesOccurrence(ctx context.Context, db bun.IDB, seriesID uuid.UUID, occurrenceAt time.Time) error {
+	if db == nil {
+		db = r.db
+	}
+	_, err := db.NewDelete().
+		Model((*RoundSeriesOccurrence)(nil)).
+		Where("series_id = ? AND occurrence_at = ?", seriesID, occurrenceAt.UTC()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete round series occurrence: %w", err)
+	}
+	return nil
+}
+
+// SetRoundMode sets the play mode of a round. The shared round type does not
+// carry a mode, so rounds created from a doubles series are updated after insert.
+func (r *Impl) SetRoundMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, mode sharedtypes.RoundMode) error {
+	if db == nil {
+		db = r.db
+	}
+	_, err := db.NewUpdate().
+		Model((*Round)(nil)).
+		Set("mode = ?", mode).
+		Set("updated_at = now()").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update round mode: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package rounddb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/uptrace/bun"
+)
+
+// SetRoundScoringMode stores whether a round is ranked on gross or net scores.
+func (r *Impl) SetRoundScoringMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, mode string) error {
+	if db == nil {
+		db = r.db
+	}
+	res, err := db.NewUpdate().
+		Model((*Round)(nil)).
+		Set("scoring_mode = ?", mode).
+		Set("updated_at = now()").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update round scoring mode: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return ErrNoRowsAffected
+	}
+	return nil
+}
+
+// GetRoundScoringMode returns the round's scoring mode ("gross" or "net").
+func (r *Impl) GetRoundScoringMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (string, error) {
+	if db == nil {
+		db = r.db
+	}
+	var mode string
+	err := db.NewSelect().
+		Model((*Round)(nil)).
+		Column("scoring_mode").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Scan(ctx, &mode)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("failed to fetch round scoring mode: %w", err)
+	}
+	return mode, nil
+}
@@ -0,0 +1,101 @@
+package rounddb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// RoundEntryGroup is a scoring entry stored as an entry round group: a singles
+// player or the members of a doubles team.
+type RoundEntryGroup struct {
+	Name    string
+	Members []RoundGroupParticipant
+}
+
+// GetRoundTeamDraw returns the round's play mode and team draw setting.
+func (r *Impl) GetRoundTeamDraw(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (sharedtypes.RoundMode, string, error) {
+	if db == nil {
+		db = r.db
+	}
+	var round Round
+	err := db.NewSelect().
+		Model(&round).
+		Column("mode", "team_draw").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", "", ErrNotFound
+		}
+		return "", "", fmt.Errorf("failed to fetch round team draw: %w", err)
+	}
+	return round.Mode, round.TeamDraw, nil
+}
+
+// SetRoundTeamDraw stores the round's team draw setting.
+func (r *Impl) SetRoundTeamDraw(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, draw string) error {
+	if db == nil {
+		db = r.db
+	}
+	res, err := db.NewUpdate().
+		Model((*Round)(nil)).
+		Set("team_draw = ?", draw).
+		Set("updated_at = now()").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update round team draw: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return ErrNoRowsAffected
+	}
+	return nil
+}
+
+// ReplaceRoundEntryGroups swaps the round's entry groups for groups, e.g. when
+// drawn doubles teams replace the per-player entries created with the round. Card
+// groups are left untouched.
+func (r *Impl) ReplaceRoundEntryGroups(ctx context.Context, db bun.IDB, roundID sharedtypes.RoundID, groups []RoundEntryGroup) error {
+	if db == nil {
+		db = r.db
+	}
+
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewDelete().
+			Model((*RoundGroup)(nil)).
+			Where("round_id = ?", roundID).
+			Where("kind = ?", RoundGroupKindEntry).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete round entry groups: %w", err)
+		}
+
+		for _, g := range groups {
+			group := &RoundGroup{
+				ID:      uuid.New(),
+				RoundID: roundID,
+				Name:    g.Name,
+				Kind:    RoundGroupKindEntry,
+			}
+			if err := r.createRoundGroup(ctx, tx, group); err != nil {
+				return fmt.Errorf("failed to create round group %q: %w", g.Name, err)
+			}
+
+			members := make([]*RoundGroupParticipant, len(g.Members))
+			for i := range g.Members {
+				m := g.Members[i]
+				m.GroupID = group.ID
+				members[i] = &m
+			}
+			if err := r.createRoundGroupParticipants(ctx, tx, members); err != nil {
+				return fmt.Errorf("failed to add members to round group %q: %w", g.Name, err)
+			}
+		}
+		return nil
+	})
+}
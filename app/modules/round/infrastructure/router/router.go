@@ -12,6 +12,7 @@ import (
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
 
 	roundhandlers "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/handlers"
+	roundqueue "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/queue"
 
 	"github.com/ThreeDotsLabs/watermill/components/metrics"
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -161,6 +162,8 @@ func (r *RoundRouter) registerHandlers(h roundhandlers.Handlers) error {
 	registerHandler(deps, roundevents.RoundScoreBulkUpdateRequestedV1, h.HandleScoreBulkUpdateRequest)
 	registerHandler(deps, roundevents.RoundScoreUpdateValidatedV1, h.HandleScoreUpdateValidated)
 	registerHandler(deps, roundevents.RoundParticipantScoreUpdatedV2, h.HandleParticipantScoreUpdated)
+	registerHandler(deps, roundhandlers.RoundHoleScoreSubmitRequestedV1, h.HandleHoleScoreSubmitRequest)
+	registerHandler(deps, roundhandlers.RoundTeamScoreSubmitRequestedV1, h.HandleTeamScoreSubmitRequest)
 
 	registerHandler(deps, roundevents.RoundAllScoresSubmittedV1, h.HandleAllScoresSubmitted)
 	registerHandler(deps, roundevents.RoundFinalizeRequestedV1, h.HandleRoundFinalizeRequested)
@@ -194,6 +197,35 @@ func (r *RoundRouter) registerHandlers(h roundhandlers.Handlers) error {
 	// PWA request/reply handlers (with wildcard for guild_id)
 	registerHandler(deps, "round.list.request.v2.>", h.HandleRoundListRequest)
 
+	// Recurring round series
+	registerHandler(deps, roundhandlers.RoundSeriesCreateRequestedV1, h.HandleRoundSeriesCreateRequest)
+	registerHandler(deps, roundhandlers.RoundSeriesUpdateRequestedV1, h.HandleRoundSeriesUpdateRequest)
+	registerHandler(deps, roundhandlers.RoundSeriesOccurrenceSkipRequestedV1, h.HandleRoundSeriesOccurrenceSkipRequest)
+	registerHandler(deps, roundhandlers.RoundSeriesOccurrenceOverrideRequestedV1, h.HandleRoundSeriesOccurrenceOverrideRequest)
+	registerHandler(deps, roundhandlers.RoundSeriesListRequestV1+".>", h.HandleRoundSeriesListRequest)
+	registerHandler(deps, roundqueue.RoundSeriesMaterializeRequestedV1, h.HandleRoundSeriesMaterializeRequested)
+
+	// Course registry links
+	registerHandler(deps, roundhandlers.RoundCourseLayoutSetRequestedV1, h.HandleRoundCourseLayoutSetRequest)
+
+	// Net/gross scoring
+	registerHandler(deps, roundhandlers.RoundScoringModeSetRequestedV1, h.HandleRoundScoringModeSetRequest)
+
+	// Participant caps and waitlists
+	registerHandler(deps, roundhandlers.RoundCapacitySetRequestedV1, h.HandleRoundCapacitySetRequest)
+
+	// Cards and tee sheets
+	registerHandler(deps, roundhandlers.RoundCardsConfigureRequestedV1, h.HandleRoundCardsConfigureRequest)
+	registerHandler(deps, roundhandlers.RoundTeeSheetRequestV1+".>", h.HandleRoundTeeSheetRequest)
+
+	// Doubles blind draws
+	registerHandler(deps, roundhandlers.RoundTeamDrawSetRequestedV1, h.HandleRoundTeamDrawSetRequest)
+
+	// Check-ins and no-shows
+	registerHandler(deps, roundhandlers.RoundCheckInConfigureRequestedV1, h.HandleRoundCheckInConfigureRequest)
+	registerHandler(deps, roundhandlers.RoundCheckInRequestedV1, h.HandleRoundCheckInRequest)
+	registerHandler(deps, roundqueue.RoundCheckInOpenRequestedV1, h.HandleRoundCheckInOpenRequested)
+
 	return nil
 }
 
@@ -11,7 +11,9 @@ import (
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/clubresolver"
 	clubdb "github.com/Black-And-White-Club/frolf-bot/app/modules/club/infrastructure/repositories"
+	courseservice "github.com/Black-And-White-Club/frolf-bot/app/modules/course/application"
 	guilddb "github.com/Black-And-White-Club/frolf-bot/app/modules/guild/infrastructure/repositories"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
 	roundservice "github.com/Black-And-White-Club/frolf-bot/app/modules/round/application"
 	roundadapters "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/adapters"
 	roundhandlers "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/handlers"
@@ -153,6 +155,28 @@ func NewRoundModule(
 	return module, nil
 }
 
+// SetCourseService wires the course registry into the round service so rounds can
+// reference layouts and imported scorecards are matched against known courses.
+func (m *Module) SetCourseService(courses courseservice.Service) {
+	if courses == nil {
+		return
+	}
+	if service, ok := m.RoundService.(*roundservice.RoundService); ok {
+		service.WithCourseLayoutResolver(roundadapters.NewCourseLayoutAdapter(courses))
+	}
+}
+
+// SetRatingService wires player ratings into the round service so rating-balanced
+// cards can be drawn when a round starts.
+func (m *Module) SetRatingService(ratings ratingservice.Service) {
+	if ratings == nil {
+		return
+	}
+	if service, ok := m.RoundService.(*roundservice.RoundService); ok {
+		service.WithPlayerRatings(roundadapters.NewPlayerRatingsAdapter(ratings))
+	}
+}
+
 func (m *Module) Run(ctx context.Context, wg *sync.WaitGroup) {
 	logger := m.observability.Provider.Logger
 	logger.InfoContext(ctx, "Starting round module")
@@ -0,0 +1,309 @@
+package roundutil
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecurrenceFrequency is the FREQ component of a recurrence rule.
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily   RecurrenceFrequency = "DAILY"
+	RecurrenceWeekly  RecurrenceFrequency = "WEEKLY"
+	RecurrenceMonthly RecurrenceFrequency = "MONTHLY"
+)
+
+// maxRecurrenceScanDays bounds how far a rule is expanded so a malformed or
+// very old series can never turn occurrence expansion into an unbounded loop.
+const maxRecurrenceScanDays = 366 * 20
+
+// RecurrenceWeekday is a BYDAY entry. Ordinal is only meaningful for MONTHLY
+// rules (e.g. 2TU = second Tuesday, -1FR = last Friday); zero means "every".
+type RecurrenceWeekday struct {
+	Weekday time.Weekday
+	Ordinal int
+}
+
+// RecurrenceRule is the subset of RFC 5545 RRULE supported for round series:
+// FREQ (DAILY/WEEKLY/MONTHLY), INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL.
+type RecurrenceRule struct {
+	Freq       RecurrenceFrequency
+	Interval   int
+	ByDay      []RecurrenceWeekday
+	ByMonthDay []int
+	Count      int
+	Until      *time.Time
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// ParseRecurrenceRule parses an RRULE string such as
+// "FREQ=WEEKLY;INTERVAL=1;BYDAY=TU". A leading "RRULE:" prefix is accepted.
+func ParseRecurrenceRule(input string) (*RecurrenceRule, error) {
+	raw := strings.TrimSpace(input)
+	raw = strings.TrimPrefix(strings.ToUpper(raw), "RRULE:")
+	if raw == "" {
+		return nil, errors.New("recurrence rule is empty")
+	}
+
+	rule := &RecurrenceRule{Interval: 1}
+	for _, part := range strings.Split(raw, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid recurrence rule component %q", part)
+		}
+
+		switch key {
+		case "FREQ":
+			switch RecurrenceFrequency(value) {
+			case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly:
+				rule.Freq = RecurrenceFrequency(value)
+			default:
+				return nil, fmt.Errorf("unsupported recurrence frequency %q", value)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid recurrence interval %q", value)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid recurrence count %q", value)
+			}
+			rule.Count = n
+		case "UNTIL":
+			until, err := parseRecurrenceUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				day, err := parseRecurrenceWeekday(code)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, day)
+			}
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(v)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("invalid recurrence month day %q", v)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, n)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported recurrence rule component %q", key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, errors.New("recurrence rule is missing FREQ")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, errors.New("recurrence rule cannot set both COUNT and UNTIL")
+	}
+	if rule.Freq != RecurrenceMonthly {
+		for _, d := range rule.ByDay {
+			if d.Ordinal != 0 {
+				return nil, errors.New("ordinal BYDAY values are only supported for MONTHLY rules")
+			}
+		}
+		if len(rule.ByMonthDay) > 0 {
+			return nil, errors.New("BYMONTHDAY is only supported for MONTHLY rules")
+		}
+	}
+
+	return rule, nil
+}
+
+func parseRecurrenceWeekday(code string) (RecurrenceWeekday, error) {
+	code = strings.TrimSpace(code)
+	if len(code) < 2 {
+		return RecurrenceWeekday{}, fmt.Errorf("invalid recurrence weekday %q", code)
+	}
+	day, ok := weekdayCodes[code[len(code)-2:]]
+	if !ok {
+		return RecurrenceWeekday{}, fmt.Errorf("invalid recurrence weekday %q", code)
+	}
+	ordinal := 0
+	if prefix := code[:len(code)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return RecurrenceWeekday{}, fmt.Errorf("invalid recurrence weekday ordinal %q", code)
+		}
+		ordinal = n
+	}
+	return RecurrenceWeekday{Weekday: day, Ordinal: ordinal}, nil
+}
+
+func parseRecurrenceUntil(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			if layout == "20060102" {
+				// A date-only UNTIL is inclusive of the whole day.
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid recurrence until %q", value)
+}
+
+// String renders the rule back into canonical RRULE form.
+func (r *RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		codes := make([]string, 0, len(r.ByDay))
+		for _, d := range r.ByDay {
+			code := strings.ToUpper(d.Weekday.String()[:2])
+			if d.Ordinal != 0 {
+				code = strconv.Itoa(d.Ordinal) + code
+			}
+			codes = append(codes, code)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, 0, len(r.ByMonthDay))
+		for _, d := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(d))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrences expands the rule anchored at dtstart and returns every occurrence
+// falling within [from, to]. Occurrences keep dtstart's wall-clock time in
+// dtstart's location, so a 6:30pm series stays at 6:30pm across DST changes.
+func (r *RecurrenceRule) Occurrences(dtstart, from, to time.Time) []time.Time {
+	if to.Before(from) || to.Before(dtstart) {
+		return nil
+	}
+
+	loc := dtstart.Location()
+	startDay := time.Date(dtstart.Year(), dtstart.Month(), dtstart.Day(), 0, 0, 0, 0, loc)
+
+	var out []time.Time
+	matched := 0
+	for i := 0; i <= maxRecurrenceScanDays; i++ {
+		day := startDay.AddDate(0, 0, i)
+		occurrence := time.Date(day.Year(), day.Month(), day.Day(), dtstart.Hour(), dtstart.Minute(), dtstart.Second(), 0, loc)
+		if occurrence.After(to) {
+			break
+		}
+		if occurrence.Before(dtstart) || !r.matches(dtstart, day) {
+			continue
+		}
+		if r.Until != nil && occurrence.After(*r.Until) {
+			break
+		}
+		matched++
+		if r.Count > 0 && matched > r.Count {
+			break
+		}
+		if !occurrence.Before(from) {
+			out = append(out, occurrence)
+		}
+	}
+
+	return out
+}
+
+func (r *RecurrenceRule) matches(dtstart, day time.Time) bool {
+	startDay := time.Date(dtstart.Year(), dtstart.Month(), dtstart.Day(), 0, 0, 0, 0, day.Location())
+
+	switch r.Freq {
+	case RecurrenceDaily:
+		days := daysBetween(startDay, day)
+		return days%r.Interval == 0
+
+	case RecurrenceWeekly:
+		weeks := daysBetween(weekStart(startDay), weekStart(day)) / 7
+		if weeks%r.Interval != 0 {
+			return false
+		}
+		if len(r.ByDay) == 0 {
+			return day.Weekday() == dtstart.Weekday()
+		}
+		for _, d := range r.ByDay {
+			if d.Weekday == day.Weekday() {
+				return true
+			}
+		}
+		return false
+
+	case RecurrenceMonthly:
+		months := (day.Year()-startDay.Year())*12 + int(day.Month()) - int(startDay.Month())
+		if months%r.Interval != 0 {
+			return false
+		}
+		if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+			return day.Day() == dtstart.Day()
+		}
+		lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, day.Location()).Day()
+		for _, md := range r.ByMonthDay {
+			if md == day.Day() || (md < 0 && lastDay+md+1 == day.Day()) {
+				return true
+			}
+		}
+		for _, d := range r.ByDay {
+			if d.Weekday != day.Weekday() {
+				continue
+			}
+			switch {
+			case d.Ordinal == 0:
+				return true
+			case d.Ordinal > 0 && (day.Day()-1)/7+1 == d.Ordinal:
+				return true
+			case d.Ordinal < 0 && (lastDay-day.Day())/7+1 == -d.Ordinal:
+				return true
+			}
+		}
+		return false
+	}
+
+	return false
+}
+
+// daysBetween counts calendar days between two local midnights; it is DST-safe
+// because it compares dates rather than elapsed hours.
+func daysBetween(a, b time.Time) int {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ub.Sub(ua).Hours() / 24)
+}
+
+// weekStart returns the Monday of the week containing day (RFC 5545 WKST=MO).
+func weekStart(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,145 @@
+package roundutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      string
+		expectErr bool
+	}{
+		{name: "weekly tuesday", input: "FREQ=WEEKLY;BYDAY=TU", want: "FREQ=WEEKLY;BYDAY=TU"},
+		{name: "rrule prefix and lowercase", input: "rrule:freq=weekly;interval=2;byday=mo,th", want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH"},
+		{name: "monthly ordinal", input: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=6", want: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=6"},
+		{name: "date only until", input: "FREQ=DAILY;UNTIL=20261231", want: "FREQ=DAILY;UNTIL=20261231T235959Z"},
+		{name: "empty", input: "  ", expectErr: true},
+		{name: "missing freq", input: "BYDAY=TU", expectErr: true},
+		{name: "unsupported freq", input: "FREQ=YEARLY", expectErr: true},
+		{name: "bad interval", input: "FREQ=DAILY;INTERVAL=0", expectErr: true},
+		{name: "count and until", input: "FREQ=DAILY;COUNT=3;UNTIL=20261231", expectErr: true},
+		{name: "ordinal on weekly", input: "FREQ=WEEKLY;BYDAY=2TU", expectErr: true},
+		{name: "unknown component", input: "FREQ=WEEKLY;BYHOUR=18", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.input)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got rule %q", rule.String())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := rule.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_Occurrences(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	// Tuesday 2026-03-03 18:30 local, the week before the US DST change.
+	dtstart := time.Date(2026, 3, 3, 18, 30, 0, 0, chicago)
+
+	tests := []struct {
+		name  string
+		rule  string
+		from  time.Time
+		to    time.Time
+		wants []time.Time
+	}{
+		{
+			name: "weekly keeps wall clock across dst",
+			rule: "FREQ=WEEKLY;BYDAY=TU",
+			from: dtstart,
+			to:   dtstart.AddDate(0, 0, 14),
+			wants: []time.Time{
+				time.Date(2026, 3, 3, 18, 30, 0, 0, chicago),
+				time.Date(2026, 3, 10, 18, 30, 0, 0, chicago),
+				time.Date(2026, 3, 17, 18, 30, 0, 0, chicago),
+			},
+		},
+		{
+			name: "window excludes earlier occurrences",
+			rule: "FREQ=WEEKLY",
+			from: dtstart.AddDate(0, 0, 1),
+			to:   dtstart.AddDate(0, 0, 8),
+			wants: []time.Time{
+				time.Date(2026, 3, 10, 18, 30, 0, 0, chicago),
+			},
+		},
+		{
+			name: "biweekly multiple days",
+			rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH",
+			from: dtstart,
+			to:   dtstart.AddDate(0, 0, 20),
+			wants: []time.Time{
+				time.Date(2026, 3, 3, 18, 30, 0, 0, chicago),
+				time.Date(2026, 3, 5, 18, 30, 0, 0, chicago),
+				time.Date(2026, 3, 17, 18, 30, 0, 0, chicago),
+				time.Date(2026, 3, 19, 18, 30, 0, 0, chicago),
+			},
+		},
+		{
+			name: "count is counted from dtstart not the window",
+			rule: "FREQ=DAILY;COUNT=3",
+			from: dtstart.AddDate(0, 0, 1),
+			to:   dtstart.AddDate(0, 0, 10),
+			wants: []time.Time{
+				time.Date(2026, 3, 4, 18, 30, 0, 0, chicago),
+				time.Date(2026, 3, 5, 18, 30, 0, 0, chicago),
+			},
+		},
+		{
+			name: "monthly last friday",
+			rule: "FREQ=MONTHLY;BYDAY=-1FR",
+			from: dtstart,
+			to:   time.Date(2026, 5, 31, 0, 0, 0, 0, chicago),
+			wants: []time.Time{
+				time.Date(2026, 3, 27, 18, 30, 0, 0, chicago),
+				time.Date(2026, 4, 24, 18, 30, 0, 0, chicago),
+				time.Date(2026, 5, 29, 18, 30, 0, 0, chicago),
+			},
+		},
+		{
+			name: "until stops expansion",
+			rule: "FREQ=WEEKLY;UNTIL=20260311",
+			from: dtstart,
+			to:   dtstart.AddDate(0, 1, 0),
+			wants: []time.Time{
+				time.Date(2026, 3, 3, 18, 30, 0, 0, chicago),
+				time.Date(2026, 3, 10, 18, 30, 0, 0, chicago),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRecurrenceRule() error = %v", err)
+			}
+			got := rule.Occurrences(dtstart, tt.from, tt.to)
+			if len(got) != len(tt.wants) {
+				t.Fatalf("Occurrences() returned %d items %v, want %d %v", len(got), got, len(tt.wants), tt.wants)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.wants[i]) {
+					t.Errorf("occurrence[%d] = %v, want %v", i, got[i], tt.wants[i])
+				}
+			}
+		})
+	}
+}
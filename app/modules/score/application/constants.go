@@ -0,0 +1,24 @@
+package scoreservice
+
+const (
+	// Round scoring modes; also used as the tag reallocation order.
+	ScoringModeGross = "gross"
+	ScoringModeNet   = "net"
+
+	// Handicap formulas
+	HandicapMethodAverage = "average" // mean of the recent rounds
+	HandicapMethodBestOf  = "best_of" // mean of the best BestOf recent rounds
+
+	// Handicap defaults for guilds that have not configured a formula
+	defaultHandicapRounds    = 10
+	defaultHandicapBestOf    = 5
+	defaultHandicapAllowance = 100
+	defaultMaxHandicap       = 18
+	defaultHandicapMinRounds = 3
+
+	// Handicap bounds
+	maxHandicapRounds = 40
+
+	// handicapHistoryLimit is how many recent score records are scanned when deriving handicaps.
+	handicapHistoryLimit = 200
+)
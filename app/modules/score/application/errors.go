@@ -19,4 +19,10 @@ var (
 	// leaving no active scores to process. This is a valid business outcome and
 	// should not be treated as a data error.
 	ErrAllScoresDNF = errors.New("all scores are DNF")
+
+	// ErrInvalidHandicapSettings indicates a handicap formula with out-of-range values.
+	ErrInvalidHandicapSettings = errors.New("invalid handicap settings")
+
+	// ErrRoundScoresNotFound indicates no scores have been processed for the round.
+	ErrRoundScoresNotFound = errors.New("no scores recorded for round")
 )
@@ -21,6 +21,15 @@ type FakeScoreRepository struct {
 	UpdateOrAddScoreFunc  func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, scoreInfo sharedtypes.ScoreInfo) error
 	GetScoresForRoundFunc func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]sharedtypes.ScoreInfo, error)
 	LastLoggedScores      []sharedtypes.ScoreInfo
+
+	// Net scoring
+	GetScoreRecordFunc         func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*scoredb.Score, error)
+	SaveNetResultsFunc         func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, scoringMode, tagOrder string, netData []scoredb.NetScore) error
+	ListRecentScoresFunc       func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, excludeRoundID sharedtypes.RoundID, limit int) ([]scoredb.Score, error)
+	GetHandicapSettingsFunc    func(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*scoredb.HandicapSettings, error)
+	UpsertHandicapSettingsFunc func(ctx context.Context, db bun.IDB, settings *scoredb.HandicapSettings) error
+	LastNetResults             []scoredb.NetScore
+	LastTagOrder               string
 }
 
 // Trace returns the sequence of method calls made to the fake.
@@ -77,6 +86,63 @@ func (f *FakeScoreRepository) GetScoresForRound(ctx context.Context, db bun.IDB,
 	return []sharedtypes.ScoreInfo{}, nil
 }
 
+func (f *FakeScoreRepository) GetScoreRecord(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*scoredb.Score, error) {
+	f.record("GetScoreRecord")
+	if f.GetScoreRecordFunc != nil {
+		return f.GetScoreRecordFunc(ctx, db, guildID, roundID)
+	}
+	return nil, scoredb.ErrNotFound
+}
+
+func (f *FakeScoreRepository) SaveNetResults(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, scoringMode, tagOrder string, netData []scoredb.NetScore) error {
+	f.record("SaveNetResults")
+	f.LastNetResults = netData
+	f.LastTagOrder = tagOrder
+	if f.SaveNetResultsFunc != nil {
+		return f.SaveNetResultsFunc(ctx, db, guildID, roundID, scoringMode, tagOrder, netData)
+	}
+	return nil
+}
+
+func (f *FakeScoreRepository) ListRecentScores(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, excludeRoundID sharedtypes.RoundID, limit int) ([]scoredb.Score, error) {
+	f.record("ListRecentScores")
+	if f.ListRecentScoresFunc != nil {
+		return f.ListRecentScoresFunc(ctx, db, guildID, excludeRoundID, limit)
+	}
+	return nil, nil
+}
+
+func (f *FakeScoreRepository) GetHandicapSettings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*scoredb.HandicapSettings, error) {
+	f.record("GetHandicapSettings")
+	if f.GetHandicapSettingsFunc != nil {
+		return f.GetHandicapSettingsFunc(ctx, db, guildID)
+	}
+	return nil, scoredb.ErrNotFound
+}
+
+func (f *FakeScoreRepository) UpsertHandicapSettings(ctx context.Context, db bun.IDB, settings *scoredb.HandicapSettings) error {
+	f.record("UpsertHandicapSettings")
+	if f.UpsertHandicapSettingsFunc != nil {
+		return f.UpsertHandicapSettingsFunc(ctx, db, settings)
+	}
+	return nil
+}
+
+// ------------------------
+// Fake Round Scoring Mode Lookup
+// ------------------------
+
+// FakeRoundScoringModes returns a fixed scoring mode for every round.
+type FakeRoundScoringModes struct {
+	Mode string
+	Err  error
+}
+
+func (f *FakeRoundScoringModes) GetRoundScoringMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (string, error) {
+	return f.Mode, f.Err
+}
+
 // Ensure the fake actually satisfies the interface
 // Note: Replace 'scoredb' with your actual repository package name if different
 var _ scoredb.Repository = (*FakeScoreRepository)(nil)
+var _ roundScoringModeLookup = (*FakeRoundScoringModes)(nil)
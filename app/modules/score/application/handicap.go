@@ -0,0 +1,151 @@
+package scoreservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	scoredb "github.com/Black-And-White-Club/frolf-bot/app/modules/score/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+// DefaultHandicapSettings is the formula used until a guild configures its own:
+// full allowance of the average of the last 10 rounds, capped at 18 strokes.
+func DefaultHandicapSettings() HandicapSettings {
+	return HandicapSettings{
+		Method:           HandicapMethodAverage,
+		RoundsConsidered: defaultHandicapRounds,
+		BestOf:           defaultHandicapBestOf,
+		AllowancePercent: defaultHandicapAllowance,
+		MaxHandicap:      defaultMaxHandicap,
+		MinRounds:        defaultHandicapMinRounds,
+		TagOrder:         ScoringModeGross,
+	}
+}
+
+// GetHandicapSettings returns the guild's handicap formula, or the defaults.
+func (s *ScoreService) GetHandicapSettings(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[HandicapSettings, error], error) {
+	return withTelemetry(s, ctx, "GetHandicapSettings", sharedtypes.RoundID{}, func(ctx context.Context) (results.OperationResult[HandicapSettings, error], error) {
+		settings, err := s.loadHandicapSettings(ctx, nil, guildID)
+		if err != nil {
+			return results.OperationResult[HandicapSettings, error]{}, err
+		}
+		return results.SuccessResult[HandicapSettings, error](settings), nil
+	})
+}
+
+// UpdateHandicapSettings validates and stores the guild's handicap formula.
+func (s *ScoreService) UpdateHandicapSettings(ctx context.Context, guildID sharedtypes.GuildID, settings HandicapSettings) (results.OperationResult[HandicapSettings, error], error) {
+	return withTelemetry(s, ctx, "UpdateHandicapSettings", sharedtypes.RoundID{}, func(ctx context.Context) (results.OperationResult[HandicapSettings, error], error) {
+		if err := validateHandicapSettings(settings); err != nil {
+			return results.FailureResult[HandicapSettings, error](err), nil
+		}
+
+		return runInTx(s, ctx, func(ctx context.Context, db bun.IDB) (results.OperationResult[HandicapSettings, error], error) {
+			if err := s.repo.UpsertHandicapSettings(ctx, db, &scoredb.HandicapSettings{
+				GuildID:          guildID,
+				Method:           settings.Method,
+				RoundsConsidered: settings.RoundsConsidered,
+				BestOf:           settings.BestOf,
+				AllowancePercent: settings.AllowancePercent,
+				MaxHandicap:      settings.MaxHandicap,
+				MinRounds:        settings.MinRounds,
+				TagOrder:         settings.TagOrder,
+			}); err != nil {
+				return results.OperationResult[HandicapSettings, error]{}, fmt.Errorf("failed to save handicap settings: %w", err)
+			}
+			return results.SuccessResult[HandicapSettings, error](settings), nil
+		})
+	})
+}
+
+func (s *ScoreService) loadHandicapSettings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (HandicapSettings, error) {
+	stored, err := s.repo.GetHandicapSettings(ctx, db, guildID)
+	if err != nil {
+		if errors.Is(err, scoredb.ErrNotFound) {
+			return DefaultHandicapSettings(), nil
+		}
+		return HandicapSettings{}, fmt.Errorf("failed to load handicap settings: %w", err)
+	}
+	return HandicapSettings{
+		Method:           stored.Method,
+		RoundsConsidered: stored.RoundsConsidered,
+		BestOf:           stored.BestOf,
+		AllowancePercent: stored.AllowancePercent,
+		MaxHandicap:      stored.MaxHandicap,
+		MinRounds:        stored.MinRounds,
+		TagOrder:         stored.TagOrder,
+	}, nil
+}
+
+func validateHandicapSettings(settings HandicapSettings) error {
+	switch {
+	case settings.Method != HandicapMethodAverage && settings.Method != HandicapMethodBestOf:
+		return fmt.Errorf("%w: method must be %q or %q", ErrInvalidHandicapSettings, HandicapMethodAverage, HandicapMethodBestOf)
+	case settings.RoundsConsidered < 1 || settings.RoundsConsidered > maxHandicapRounds:
+		return fmt.Errorf("%w: rounds_considered must be between 1 and %d", ErrInvalidHandicapSettings, maxHandicapRounds)
+	case settings.Method == HandicapMethodBestOf && (settings.BestOf < 1 || settings.BestOf > settings.RoundsConsidered):
+		return fmt.Errorf("%w: best_of must be between 1 and rounds_considered", ErrInvalidHandicapSettings)
+	case settings.AllowancePercent < 1 || settings.AllowancePercent > 100:
+		return fmt.Errorf("%w: allowance_percent must be between 1 and 100", ErrInvalidHandicapSettings)
+	case settings.MaxHandicap < 0:
+		return fmt.Errorf("%w: max_handicap cannot be negative", ErrInvalidHandicapSettings)
+	case settings.MinRounds < 0 || settings.MinRounds > settings.RoundsConsidered:
+		return fmt.Errorf("%w: min_rounds must be between 0 and rounds_considered", ErrInvalidHandicapSettings)
+	case settings.TagOrder != ScoringModeGross && settings.TagOrder != ScoringModeNet:
+		return fmt.Errorf("%w: tag_order must be %q or %q", ErrInvalidHandicapSettings, ScoringModeGross, ScoringModeNet)
+	}
+	return nil
+}
+
+// computeHandicap applies the guild formula to a player's recent scores (newest
+// first). Players with too few rounds play off scratch. The result is rounded to
+// one decimal place.
+func computeHandicap(recent []sharedtypes.Score, settings HandicapSettings) float64 {
+	if len(recent) == 0 || len(recent) < settings.MinRounds {
+		return 0
+	}
+
+	window := make([]int, 0, settings.RoundsConsidered)
+	for _, score := range recent {
+		if len(window) == settings.RoundsConsidered {
+			break
+		}
+		window = append(window, int(score))
+	}
+
+	if settings.Method == HandicapMethodBestOf && settings.BestOf < len(window) {
+		slices.Sort(window)
+		window = window[:settings.BestOf]
+	}
+
+	total := 0
+	for _, score := range window {
+		total += score
+	}
+	handicap := float64(total) / float64(len(window)) * float64(settings.AllowancePercent) / 100
+
+	if settings.MaxHandicap > 0 {
+		handicap = math.Max(-settings.MaxHandicap, math.Min(settings.MaxHandicap, handicap))
+	}
+	return math.Round(handicap*10) / 10
+}
+
+// recentScoresByUser collects each player's non-DNF scores from score records
+// ordered newest first, keeping at most limit per player.
+func recentScoresByUser(records []scoredb.Score, limit int) map[sharedtypes.DiscordID][]sharedtypes.Score {
+	recent := make(map[sharedtypes.DiscordID][]sharedtypes.Score)
+	for _, record := range records {
+		for _, info := range record.RoundData {
+			if info.IsDNF || len(recent[info.UserID]) >= limit {
+				continue
+			}
+			recent[info.UserID] = append(recent[info.UserID], info.Score)
+		}
+	}
+	return recent
+}
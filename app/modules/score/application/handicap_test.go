@@ -0,0 +1,144 @@
+package scoreservice
+
+import (
+	"errors"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+func TestComputeHandicap(t *testing.T) {
+	scores := func(vals ...int) []sharedtypes.Score {
+		out := make([]sharedtypes.Score, len(vals))
+		for i, v := range vals {
+			out[i] = sharedtypes.Score(v)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		recent   []sharedtypes.Score
+		settings func(*HandicapSettings)
+		want     float64
+	}{
+		{
+			name:   "average of recent rounds",
+			recent: scores(6, 4, 8),
+			want:   6,
+		},
+		{
+			name:   "too few rounds plays off scratch",
+			recent: scores(10, 12),
+			want:   0,
+		},
+		{
+			name:     "window keeps newest rounds",
+			recent:   scores(2, 2, 2, 20),
+			settings: func(s *HandicapSettings) { s.RoundsConsidered = 3 },
+			want:     2,
+		},
+		{
+			name:   "best of takes lowest scores",
+			recent: scores(10, 2, 8, 4),
+			settings: func(s *HandicapSettings) {
+				s.Method = HandicapMethodBestOf
+				s.BestOf = 2
+			},
+			want: 3,
+		},
+		{
+			name:     "allowance scales the handicap",
+			recent:   scores(5, 5, 5),
+			settings: func(s *HandicapSettings) { s.AllowancePercent = 80 },
+			want:     4,
+		},
+		{
+			name:   "capped in both directions",
+			recent: scores(30, 30, 30),
+			want:   defaultMaxHandicap,
+		},
+		{
+			name:   "players under par get a negative handicap",
+			recent: scores(-3, -4, -2),
+			want:   -3,
+		},
+		{
+			name:   "rounded to one decimal",
+			recent: scores(1, 2, 2),
+			want:   1.7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := DefaultHandicapSettings()
+			if tt.settings != nil {
+				tt.settings(&settings)
+			}
+			if got := computeHandicap(tt.recent, settings); got != tt.want {
+				t.Errorf("computeHandicap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateHandicapSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*HandicapSettings)
+		wantErr bool
+	}{
+		{name: "defaults are valid", mutate: func(s *HandicapSettings) {}},
+		{name: "unknown method", mutate: func(s *HandicapSettings) { s.Method = "slope" }, wantErr: true},
+		{name: "best of larger than window", mutate: func(s *HandicapSettings) { s.Method = HandicapMethodBestOf; s.BestOf = 11 }, wantErr: true},
+		{name: "zero allowance", mutate: func(s *HandicapSettings) { s.AllowancePercent = 0 }, wantErr: true},
+		{name: "negative cap", mutate: func(s *HandicapSettings) { s.MaxHandicap = -1 }, wantErr: true},
+		{name: "unknown tag order", mutate: func(s *HandicapSettings) { s.TagOrder = "random" }, wantErr: true},
+		{name: "net tag order", mutate: func(s *HandicapSettings) { s.TagOrder = ScoringModeNet }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := DefaultHandicapSettings()
+			tt.mutate(&settings)
+			err := validateHandicapSettings(settings)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateHandicapSettings() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidHandicapSettings) {
+				t.Errorf("expected ErrInvalidHandicapSettings, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRankNet(t *testing.T) {
+	scores := []sharedtypes.ScoreInfo{
+		{UserID: "scratch", Score: -2},
+		{UserID: "newer", Score: 4},
+		{UserID: "mid", Score: 1},
+	}
+	grossRanks := map[sharedtypes.DiscordID]int{"scratch": 1, "mid": 2, "newer": 3}
+	handicaps := map[sharedtypes.DiscordID]float64{"scratch": 0, "mid": 3, "newer": 7}
+
+	got := rankNet(scores, grossRanks, handicaps)
+
+	want := []struct {
+		id      sharedtypes.DiscordID
+		net     float64
+		netRank int
+	}{
+		{"newer", -3, 1},
+		{"scratch", -2, 2},
+		{"mid", -2, 2},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d results, got %d", len(want), len(got))
+	}
+	for i, w := range want {
+		if got[i].UserID != w.id || got[i].NetScore != w.net || got[i].NetRank != w.netRank {
+			t.Errorf("result %d = %+v, want %s net=%v rank=%d", i, got[i], w.id, w.net, w.netRank)
+		}
+	}
+}
@@ -19,4 +19,42 @@ type Service interface {
 
 	// Retrieves the stored scores (including original tag numbers) for a round.
 	GetScoresForRound(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]sharedtypes.ScoreInfo, error)
+
+	// Handicaps and net scoring
+	GetHandicapSettings(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[HandicapSettings, error], error)
+	UpdateHandicapSettings(ctx context.Context, guildID sharedtypes.GuildID, settings HandicapSettings) (results.OperationResult[HandicapSettings, error], error)
+	// GetRoundResults returns gross and net results for a processed round.
+	GetRoundResults(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[RoundResults, error], error)
+}
+
+// HandicapSettings is a guild's handicap formula for net rounds. A player's handicap
+// is AllowancePercent of their average score over the last RoundsConsidered rounds
+// (or the best BestOf of them), capped at MaxHandicap in either direction.
+type HandicapSettings struct {
+	Method           string  `json:"method"`
+	RoundsConsidered int     `json:"rounds_considered"`
+	BestOf           int     `json:"best_of"`
+	AllowancePercent int     `json:"allowance_percent"`
+	MaxHandicap      float64 `json:"max_handicap"` // 0 = uncapped
+	MinRounds        int     `json:"min_rounds"`   // fewer rounds than this plays off scratch
+	TagOrder         string  `json:"tag_order"`    // order used for tag reallocation in net rounds
+}
+
+// RoundResults holds the gross and net standings of a processed round.
+type RoundResults struct {
+	RoundID     sharedtypes.RoundID `json:"round_id"`
+	ScoringMode string              `json:"scoring_mode"`
+	TagOrder    string              `json:"tag_order"`
+	Results     []PlayerResult      `json:"results"`
+}
+
+// PlayerResult is one player's gross and net finish. Gross rounds report a zero
+// handicap with net equal to gross.
+type PlayerResult struct {
+	UserID     sharedtypes.DiscordID `json:"user_id"`
+	GrossScore sharedtypes.Score     `json:"gross_score"`
+	Handicap   float64               `json:"handicap"`
+	NetScore   float64               `json:"net_score"`
+	GrossRank  int                   `json:"gross_rank"`
+	NetRank    int                   `json:"net_rank"`
 }
@@ -0,0 +1,172 @@
+package scoreservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	scoredb "github.com/Black-And-White-Club/frolf-bot/app/modules/score/infrastructure/repositories"
+	"github.com/uptrace/bun"
+)
+
+// GetRoundResults returns the gross and net standings stored for a round.
+func (s *ScoreService) GetRoundResults(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[RoundResults, error], error) {
+	return withTelemetry(s, ctx, "GetRoundResults", roundID, func(ctx context.Context) (results.OperationResult[RoundResults, error], error) {
+		record, err := s.repo.GetScoreRecord(ctx, nil, guildID, roundID)
+		if err != nil {
+			if errors.Is(err, scoredb.ErrNotFound) {
+				return results.FailureResult[RoundResults, error](ErrRoundScoresNotFound), nil
+			}
+			return results.OperationResult[RoundResults, error]{}, fmt.Errorf("failed to load round scores: %w", err)
+		}
+		return results.SuccessResult[RoundResults, error](roundResultsFromRecord(record)), nil
+	})
+}
+
+// roundScoringMode returns the round's scoring mode, treating an unwired lookup or
+// a failed read as gross so score processing never blocks on the round module.
+func (s *ScoreService) roundScoringMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) string {
+	if s.rounds == nil {
+		return ScoringModeGross
+	}
+	mode, err := s.rounds.GetRoundScoringMode(ctx, db, guildID, roundID)
+	if err != nil {
+		s.logger.WarnContext(ctx, "Could not read round scoring mode; scoring gross",
+			attr.RoundID("round_id", roundID),
+			attr.Error(err),
+		)
+		return ScoringModeGross
+	}
+	return mode
+}
+
+// applyNetScoring computes each player's handicap from their recent rounds, ranks the
+// round on net score and stores both gross and net results. It returns the net finish
+// ranks keyed by Discord ID.
+func (s *ScoreService) applyNetScoring(
+	ctx context.Context,
+	db bun.IDB,
+	guildID sharedtypes.GuildID,
+	roundID sharedtypes.RoundID,
+	scores []sharedtypes.ScoreInfo,
+	grossRanks map[sharedtypes.DiscordID]int,
+) (map[sharedtypes.DiscordID]int, error) {
+	settings, err := s.loadHandicapSettings(ctx, db, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.repo.ListRecentScores(ctx, db, guildID, roundID, handicapHistoryLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load score history: %w", err)
+	}
+	recent := recentScoresByUser(history, settings.RoundsConsidered)
+
+	handicaps := make(map[sharedtypes.DiscordID]float64, len(scores))
+	for _, sc := range scores {
+		handicaps[sc.UserID] = computeHandicap(recent[sc.UserID], settings)
+	}
+
+	playerResults := rankNet(scores, grossRanks, handicaps)
+
+	netData := make([]scoredb.NetScore, len(playerResults))
+	netRanks := make(map[sharedtypes.DiscordID]int, len(playerResults))
+	for i, r := range playerResults {
+		netData[i] = scoredb.NetScore(r)
+		netRanks[r.UserID] = r.NetRank
+	}
+
+	if err := s.repo.SaveNetResults(ctx, db, guildID, roundID, ScoringModeNet, settings.TagOrder, netData); err != nil {
+		return nil, fmt.Errorf("failed to save net results: %w", err)
+	}
+
+	s.logger.InfoContext(ctx, "Net scoring applied",
+		attr.RoundID("round_id", roundID),
+		attr.String("guild_id", string(guildID)),
+		attr.String("tag_order", settings.TagOrder),
+		attr.Int("num_scores", len(playerResults)),
+	)
+
+	return netRanks, nil
+}
+
+// rankNet orders players by score minus handicap. Ties on net score share a
+// competition-style rank; display order within a tie follows gross rank.
+func rankNet(scores []sharedtypes.ScoreInfo, grossRanks map[sharedtypes.DiscordID]int, handicaps map[sharedtypes.DiscordID]float64) []PlayerResult {
+	out := make([]PlayerResult, len(scores))
+	for i, sc := range scores {
+		handicap := handicaps[sc.UserID]
+		out[i] = PlayerResult{
+			UserID:     sc.UserID,
+			GrossScore: sc.Score,
+			Handicap:   handicap,
+			NetScore:   math.Round((float64(sc.Score)-handicap)*10) / 10,
+			GrossRank:  grossRanks[sc.UserID],
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].NetScore != out[j].NetScore {
+			return out[i].NetScore < out[j].NetScore
+		}
+		return out[i].GrossRank < out[j].GrossRank
+	})
+
+	for i := range out {
+		if i > 0 && out[i].NetScore == out[i-1].NetScore {
+			out[i].NetRank = out[i-1].NetRank
+			continue
+		}
+		out[i].NetRank = i + 1
+	}
+	return out
+}
+
+// roundResultsFromRecord builds round standings from a stored score record. Gross
+// rounds are re-ranked from round_data with a zero handicap.
+func roundResultsFromRecord(record *scoredb.Score) RoundResults {
+	mode := record.ScoringMode
+	if mode == "" {
+		mode = ScoringModeGross
+	}
+	tagOrder := record.TagOrder
+	if tagOrder == "" {
+		tagOrder = ScoringModeGross
+	}
+	out := RoundResults{RoundID: record.RoundID, ScoringMode: mode, TagOrder: tagOrder}
+
+	if mode == ScoringModeNet && len(record.NetData) > 0 {
+		out.Results = make([]PlayerResult, len(record.NetData))
+		for i, n := range record.NetData {
+			out.Results[i] = PlayerResult(n)
+		}
+		sort.SliceStable(out.Results, func(i, j int) bool { return out.Results[i].NetRank < out.Results[j].NetRank })
+		return out
+	}
+
+	active := make([]sharedtypes.ScoreInfo, 0, len(record.RoundData))
+	for _, info := range record.RoundData {
+		if !info.IsDNF {
+			active = append(active, info)
+		}
+	}
+	sortByGross(active)
+	ranks := computeFinishRanks(active)
+
+	out.Results = make([]PlayerResult, len(active))
+	for i, info := range active {
+		out.Results[i] = PlayerResult{
+			UserID:     info.UserID,
+			GrossScore: info.Score,
+			NetScore:   float64(info.Score),
+			GrossRank:  ranks[info.UserID],
+			NetRank:    ranks[info.UserID],
+		}
+	}
+	return out
+}
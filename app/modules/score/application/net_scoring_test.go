@@ -0,0 +1,168 @@
+package scoreservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	scoremetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/score"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	scoredb "github.com/Black-And-White-Club/frolf-bot/app/modules/score/infrastructure/repositories"
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func newNetTestService(repo *FakeScoreRepository, rounds roundScoringModeLookup) *ScoreService {
+	s := &ScoreService{
+		repo:    repo,
+		logger:  loggerfrolfbot.NoOpLogger,
+		metrics: &scoremetrics.NoOpMetrics{},
+		tracer:  noop.NewTracerProvider().Tracer("test"),
+	}
+	return s.WithRoundScoringModes(rounds)
+}
+
+func TestProcessRoundScores_NetMode(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	// "hack" has averaged +8 over the last three rounds, "pro" has averaged -2.
+	history := []scoredb.Score{
+		{RoundData: []sharedtypes.ScoreInfo{{UserID: "hack", Score: 8}, {UserID: "pro", Score: -2}}},
+		{RoundData: []sharedtypes.ScoreInfo{{UserID: "hack", Score: 9}, {UserID: "pro", Score: -1}}},
+		{RoundData: []sharedtypes.ScoreInfo{{UserID: "hack", Score: 7}, {UserID: "pro", Score: -3}}},
+	}
+	scores := []sharedtypes.ScoreInfo{
+		{UserID: "pro", Score: -1, TagNumber: ptr(sharedtypes.TagNumber(1))},
+		{UserID: "hack", Score: 3, TagNumber: ptr(sharedtypes.TagNumber(2))},
+	}
+
+	tests := []struct {
+		name         string
+		rounds       roundScoringModeLookup
+		settings     *scoredb.HandicapSettings
+		wantRanks    map[sharedtypes.DiscordID]int
+		wantNetSaved bool
+		wantTagOrder string
+	}{
+		{
+			name:      "gross round ranks raw scores",
+			rounds:    &FakeRoundScoringModes{Mode: ScoringModeGross},
+			wantRanks: map[sharedtypes.DiscordID]int{"pro": 1, "hack": 2},
+		},
+		{
+			name:         "net round ranks score minus handicap",
+			rounds:       &FakeRoundScoringModes{Mode: ScoringModeNet},
+			wantRanks:    map[sharedtypes.DiscordID]int{"hack": 1, "pro": 2},
+			wantNetSaved: true,
+			wantTagOrder: ScoringModeGross,
+		},
+		{
+			name:   "net round records configured tag order",
+			rounds: &FakeRoundScoringModes{Mode: ScoringModeNet},
+			settings: &scoredb.HandicapSettings{
+				Method: HandicapMethodAverage, RoundsConsidered: 10, AllowancePercent: 100,
+				MaxHandicap: 18, MinRounds: 3, TagOrder: ScoringModeNet,
+			},
+			wantRanks:    map[sharedtypes.DiscordID]int{"hack": 1, "pro": 2},
+			wantNetSaved: true,
+			wantTagOrder: ScoringModeNet,
+		},
+		{
+			name:      "lookup failure falls back to gross",
+			rounds:    &FakeRoundScoringModes{Err: errors.New("db down")},
+			wantRanks: map[sharedtypes.DiscordID]int{"pro": 1, "hack": 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewFakeScoreRepository()
+			repo.ListRecentScoresFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, exclude sharedtypes.RoundID, limit int) ([]scoredb.Score, error) {
+				return history, nil
+			}
+			if tt.settings != nil {
+				repo.GetHandicapSettingsFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID) (*scoredb.HandicapSettings, error) {
+					return tt.settings, nil
+				}
+			}
+			s := newNetTestService(repo, tt.rounds)
+
+			in := make([]sharedtypes.ScoreInfo, len(scores))
+			copy(in, scores)
+			res, err := s.ProcessRoundScores(ctx, guildID, roundID, in, true)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.Success == nil {
+				t.Fatalf("expected success, got failure: %v", *res.Failure)
+			}
+
+			for id, want := range tt.wantRanks {
+				if got := res.Success.FinishRanksByDiscordID[id]; got != want {
+					t.Errorf("player %s: want rank %d, got %d", id, want, got)
+				}
+			}
+
+			saved := repo.LastNetResults != nil
+			if saved != tt.wantNetSaved {
+				t.Fatalf("net results saved = %v, want %v", saved, tt.wantNetSaved)
+			}
+			if !saved {
+				return
+			}
+			if repo.LastTagOrder != tt.wantTagOrder {
+				t.Errorf("expected tag order %q, got %q", tt.wantTagOrder, repo.LastTagOrder)
+			}
+			for _, n := range repo.LastNetResults {
+				if n.UserID == "hack" && (n.Handicap != 8 || n.NetScore != -5 || n.GrossRank != 2 || n.NetRank != 1) {
+					t.Errorf("unexpected net result for hack: %+v", n)
+				}
+			}
+		})
+	}
+}
+
+func TestScoreService_GetRoundResults(t *testing.T) {
+	ctx := context.Background()
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	t.Run("gross round is ranked from round data", func(t *testing.T) {
+		repo := NewFakeScoreRepository()
+		repo.GetScoreRecordFunc = func(ctx context.Context, db bun.IDB, g sharedtypes.GuildID, r sharedtypes.RoundID) (*scoredb.Score, error) {
+			return &scoredb.Score{
+				RoundID: r,
+				RoundData: []sharedtypes.ScoreInfo{
+					{UserID: "b", Score: 2},
+					{UserID: "a", Score: -1},
+					{UserID: "dnf", Score: 0, IsDNF: true},
+				},
+			}, nil
+		}
+		res, err := newNetTestService(repo, nil).GetRoundResults(ctx, guildID, roundID)
+		if err != nil || res.Success == nil {
+			t.Fatalf("expected success, got err=%v failure=%v", err, res.Failure)
+		}
+		got := *res.Success
+		if got.ScoringMode != ScoringModeGross || len(got.Results) != 2 {
+			t.Fatalf("unexpected results: %+v", got)
+		}
+		if got.Results[0].UserID != "a" || got.Results[0].NetRank != 1 || got.Results[1].GrossRank != 2 {
+			t.Errorf("unexpected standings: %+v", got.Results)
+		}
+	})
+
+	t.Run("missing round is a failure", func(t *testing.T) {
+		res, err := newNetTestService(NewFakeScoreRepository(), nil).GetRoundResults(ctx, guildID, roundID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Failure == nil || !errors.Is(*res.Failure, ErrRoundScoresNotFound) {
+			t.Fatalf("expected ErrRoundScoresNotFound, got %+v", res)
+		}
+	})
+}
@@ -0,0 +1,14 @@
+package scoreservice
+
+import (
+	"context"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/uptrace/bun"
+)
+
+// roundScoringModeLookup reads a round's scoring mode ("gross" or "net").
+// rounddb.Repository satisfies it.
+type roundScoringModeLookup interface {
+	GetRoundScoringMode(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (string, error)
+}
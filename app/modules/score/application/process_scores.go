@@ -88,6 +88,18 @@ func (s *ScoreService) executeProcessRoundScores(
 		return results.OperationResult[ProcessRoundScoresResult, error]{}, fmt.Errorf("failed to log scores: %w", err)
 	}
 
+	// 4. Team rounds rank teams on their shared score; net scoring is per player and
+	// does not apply. Otherwise, net rounds rank on score minus handicap.
+	if hasTeams(processedScores) {
+		finishRanks = computeTeamFinishRanks(processedScores)
+	} else if s.roundScoringMode(ctx, db, guildID, roundID) == ScoringModeNet {
+		netRanks, err := s.applyNetScoring(ctx, db, guildID, roundID, processedScores, finishRanks)
+		if err != nil {
+			return results.OperationResult[ProcessRoundScoresResult, error]{}, err
+		}
+		finishRanks = netRanks
+	}
+
 	// 5. Mapping
 	tagMappings := make([]sharedtypes.TagMapping, 0, len(processedScores))
 	for _, scoreInfo := range processedScores {
@@ -234,6 +234,7 @@ func TestProcessRoundScores_FinishRanks(t *testing.T) {
 	ctx := context.Background()
 	testGuildID := sharedtypes.GuildID("guild-1234")
 	testRoundID := sharedtypes.RoundID(uuid.New())
+	teamA, teamB, teamC, teamCali := uuid.New(), uuid.New(), uuid.New(), uuid.New()
 
 	tests := []struct {
 		name      string
@@ -295,6 +296,29 @@ func TestProcessRoundScores_FinishRanks(t *testing.T) {
 				"z": 1,
 			},
 		},
+		{
+			name: "doubles members share their team's rank",
+			// Team A scored -6, teams B and C tied on -4; the cali plays alone on -1.
+			// Ranks count teams, not players: A=1, B=C=2, cali=4.
+			scores: []sharedtypes.ScoreInfo{
+				{UserID: "a1", Score: -6, TeamID: teamA, TagNumber: ptr(sharedtypes.TagNumber(4))},
+				{UserID: "a2", Score: -6, TeamID: teamA, TagNumber: ptr(sharedtypes.TagNumber(9))},
+				{UserID: "b1", Score: -4, TeamID: teamB},
+				{UserID: "b2", Score: -4, TeamID: teamB},
+				{UserID: "c1", Score: -4, TeamID: teamC},
+				{UserID: "c2", Score: -4, TeamID: teamC},
+				{UserID: "cali", Score: -1, TeamID: teamCali},
+			},
+			wantRanks: map[sharedtypes.DiscordID]int{
+				"a1":   1,
+				"a2":   1,
+				"b1":   2,
+				"b2":   2,
+				"c1":   2,
+				"c2":   2,
+				"cali": 4,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -26,6 +26,7 @@ type ScoreService struct {
 	metrics  scoremetrics.ScoreMetrics
 	tracer   trace.Tracer
 	db       *bun.DB
+	rounds   roundScoringModeLookup
 }
 
 // NewScoreService creates a new ScoreService.
@@ -47,6 +48,13 @@ func NewScoreService(
 	}
 }
 
+// WithRoundScoringModes lets the service look up whether a round is played net, so
+// finish ranks can be computed on handicapped scores. Without it every round is gross.
+func (s *ScoreService) WithRoundScoringModes(rounds roundScoringModeLookup) *ScoreService {
+	s.rounds = rounds
+	return s
+}
+
 // operationFunc is the generic signature for service operation functions.
 type operationFunc[S any, F any] func(ctx context.Context) (results.OperationResult[S, F], error)
 
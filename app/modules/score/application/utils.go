@@ -9,6 +9,7 @@ import (
 
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/google/uuid"
 )
 
 // noTagSortWeight is the sentinel used as a sort key for players without a tag.
@@ -41,6 +42,65 @@ func computeFinishRanks(scores []sharedtypes.ScoreInfo) map[sharedtypes.DiscordI
 	return ranks
 }
 
+// computeTeamFinishRanks returns competition-style finish ranks for a team round.
+// Teammates share their team's score, so each member gets the team's rank: one more
+// than the number of teams that scored better. Players without a team rank as a team
+// of one. Tag allocation breaks the tie between teammates by their current tags.
+// Example: teams A -6, B -4, C -4 → A's members rank 1, B's and C's rank 2.
+func computeTeamFinishRanks(scores []sharedtypes.ScoreInfo) map[sharedtypes.DiscordID]int {
+	teamScores := make(map[string]sharedtypes.Score, len(scores))
+	for _, sc := range scores {
+		teamScores[teamKey(sc)] = sc.Score
+	}
+
+	ranks := make(map[sharedtypes.DiscordID]int, len(scores))
+	for _, sc := range scores {
+		rank := 1
+		for _, other := range teamScores {
+			if other < sc.Score {
+				rank++
+			}
+		}
+		ranks[sc.UserID] = rank
+	}
+	return ranks
+}
+
+// hasTeams reports whether any score belongs to a team.
+func hasTeams(scores []sharedtypes.ScoreInfo) bool {
+	for _, sc := range scores {
+		if sc.TeamID != uuid.Nil {
+			return true
+		}
+	}
+	return false
+}
+
+func teamKey(sc sharedtypes.ScoreInfo) string {
+	if sc.TeamID != uuid.Nil {
+		return sc.TeamID.String()
+	}
+	return "player:" + string(sc.UserID)
+}
+
+// sortByGross orders scores ascending, breaking ties by the lower pre-round tag
+// (disc golf convention). Untagged players (nil TagNumber) have lowest priority.
+func sortByGross(scores []sharedtypes.ScoreInfo) {
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score == scores[j].Score {
+			iTag, jTag := noTagSortWeight, noTagSortWeight
+			if scores[i].TagNumber != nil {
+				iTag = int(*scores[i].TagNumber)
+			}
+			if scores[j].TagNumber != nil {
+				jTag = int(*scores[j].TagNumber)
+			}
+			return iTag < jTag
+		}
+		return scores[i].Score < scores[j].Score
+	})
+}
+
 // ProcessScoresForStorage validates, enriches, and sorts scores.
 // It returns the sorted scores and the competition-style finish ranks so callers
 // do not need to recompute ranks from the same data.
@@ -80,21 +140,7 @@ func (s *ScoreService) ProcessScoresForStorage(
 
 	// 2. Sorting Logic
 	sortStartTime := time.Now()
-	sort.Slice(scores, func(i, j int) bool {
-		if scores[i].Score == scores[j].Score {
-			// Tiebreak: lower pre-round tag wins (disc golf convention).
-			// Untagged players (nil TagNumber) have lowest priority.
-			iTag, jTag := noTagSortWeight, noTagSortWeight
-			if scores[i].TagNumber != nil {
-				iTag = int(*scores[i].TagNumber)
-			}
-			if scores[j].TagNumber != nil {
-				jTag = int(*scores[j].TagNumber)
-			}
-			return iTag < jTag
-		}
-		return scores[i].Score < scores[j].Score
-	})
+	sortByGross(scores)
 
 	// 3. Batch Metrics & Logging
 	s.metrics.RecordScoreSortingDuration(ctx, roundID, time.Since(sortStartTime))
@@ -21,6 +21,9 @@ type FakeScoreService struct {
 	CorrectScoreFunc            func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, userID sharedtypes.DiscordID, score sharedtypes.Score, tagNumber *sharedtypes.TagNumber) (scoreservice.ScoreOperationResult, error)
 	ProcessScoresForStorageFunc func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, scores []sharedtypes.ScoreInfo) ([]sharedtypes.ScoreInfo, map[sharedtypes.DiscordID]int, error)
 	GetScoresForRoundFunc       func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]sharedtypes.ScoreInfo, error)
+	GetHandicapSettingsFunc     func(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[scoreservice.HandicapSettings, error], error)
+	UpdateHandicapSettingsFunc  func(ctx context.Context, guildID sharedtypes.GuildID, settings scoreservice.HandicapSettings) (results.OperationResult[scoreservice.HandicapSettings, error], error)
+	GetRoundResultsFunc         func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[scoreservice.RoundResults, error], error)
 }
 
 // NewFakeScoreService initializes a new FakeScoreService.
@@ -75,5 +78,29 @@ func (f *FakeScoreService) GetScoresForRound(ctx context.Context, guildID shared
 	return nil, nil
 }
 
+func (f *FakeScoreService) GetHandicapSettings(ctx context.Context, guildID sharedtypes.GuildID) (results.OperationResult[scoreservice.HandicapSettings, error], error) {
+	f.record("GetHandicapSettings")
+	if f.GetHandicapSettingsFunc != nil {
+		return f.GetHandicapSettingsFunc(ctx, guildID)
+	}
+	return results.SuccessResult[scoreservice.HandicapSettings, error](scoreservice.DefaultHandicapSettings()), nil
+}
+
+func (f *FakeScoreService) UpdateHandicapSettings(ctx context.Context, guildID sharedtypes.GuildID, settings scoreservice.HandicapSettings) (results.OperationResult[scoreservice.HandicapSettings, error], error) {
+	f.record("UpdateHandicapSettings")
+	if f.UpdateHandicapSettingsFunc != nil {
+		return f.UpdateHandicapSettingsFunc(ctx, guildID, settings)
+	}
+	return results.SuccessResult[scoreservice.HandicapSettings, error](settings), nil
+}
+
+func (f *FakeScoreService) GetRoundResults(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (results.OperationResult[scoreservice.RoundResults, error], error) {
+	f.record("GetRoundResults")
+	if f.GetRoundResultsFunc != nil {
+		return f.GetRoundResultsFunc(ctx, guildID, roundID)
+	}
+	return results.OperationResult[scoreservice.RoundResults, error]{}, nil
+}
+
 // Ensure the fake satisfies the Service interface
 var _ scoreservice.Service = (*FakeScoreService)(nil)
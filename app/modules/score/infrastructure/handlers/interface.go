@@ -14,4 +14,9 @@ type Handlers interface {
 	HandleBulkCorrectScoreRequest(ctx context.Context, payload *sharedevents.ScoreBulkUpdateRequestedPayloadV1) ([]handlerwrapper.Result, error)
 	HandleReprocessAfterBulkScoreUpdate(ctx context.Context, payload *sharedevents.ScoreBulkUpdatedPayloadV1) ([]handlerwrapper.Result, error)
 	HandleReprocessAfterSingleScoreUpdate(ctx context.Context, payload *sharedevents.ScoreUpdatedPayloadV1) ([]handlerwrapper.Result, error)
+
+	// Handicaps and net scoring
+	HandleHandicapSettingsRequest(ctx context.Context, payload *HandicapSettingsRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleHandicapSettingsUpdateRequest(ctx context.Context, payload *HandicapSettingsUpdateRequestPayloadV1) ([]handlerwrapper.Result, error)
+	HandleRoundResultsRequest(ctx context.Context, payload *RoundResultsRequestPayloadV1) ([]handlerwrapper.Result, error)
 }
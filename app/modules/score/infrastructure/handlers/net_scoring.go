@@ -0,0 +1,129 @@
+package scorehandlers
+
+import (
+	"context"
+	"errors"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	scoreservice "github.com/Black-And-White-Club/frolf-bot/app/modules/score/application"
+	"github.com/Black-And-White-Club/frolf-bot/app/shared/handlerutil"
+)
+
+// Handicap and net scoring topics.
+const (
+	ScoreHandicapSettingsRequestV1         = "score.handicap.settings.request.v1"
+	ScoreHandicapSettingsResponseV1        = "score.handicap.settings.response.v1"
+	ScoreHandicapSettingsUpdateRequestedV1 = "score.handicap.settings.update.requested.v1"
+	ScoreHandicapSettingsUpdatedV1         = "score.handicap.settings.updated.v1"
+	ScoreHandicapSettingsUpdateFailedV1    = "score.handicap.settings.update.failed.v1"
+	ScoreRoundResultsRequestV1             = "score.round.results.request.v1"
+	ScoreRoundResultsResponseV1            = "score.round.results.response.v1"
+)
+
+// HandicapSettingsRequestPayloadV1 asks for a guild's handicap formula.
+type HandicapSettingsRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+}
+
+// HandicapSettingsUpdateRequestPayloadV1 replaces a guild's handicap formula.
+type HandicapSettingsUpdateRequestPayloadV1 struct {
+	GuildID  sharedtypes.GuildID           `json:"guild_id"`
+	UserID   sharedtypes.DiscordID         `json:"user_id"`
+	Settings scoreservice.HandicapSettings `json:"settings"`
+}
+
+// HandicapSettingsPayloadV1 carries a guild's current handicap formula.
+type HandicapSettingsPayloadV1 struct {
+	GuildID  sharedtypes.GuildID           `json:"guild_id"`
+	Settings scoreservice.HandicapSettings `json:"settings"`
+}
+
+// HandicapSettingsUpdateFailedPayloadV1 reports a rejected handicap formula.
+type HandicapSettingsUpdateFailedPayloadV1 struct {
+	GuildID sharedtypes.GuildID   `json:"guild_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	Reason  string                `json:"reason"`
+}
+
+// RoundResultsRequestPayloadV1 asks for a round's gross and net standings.
+type RoundResultsRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+	RoundID sharedtypes.RoundID `json:"round_id"`
+}
+
+// RoundResultsResponsePayloadV1 is the reply for ScoreRoundResultsRequestV1.
+type RoundResultsResponsePayloadV1 struct {
+	GuildID sharedtypes.GuildID        `json:"guild_id"`
+	Results *scoreservice.RoundResults `json:"results,omitempty"`
+	Error   string                     `json:"error,omitempty"`
+}
+
+// HandleHandicapSettingsRequest replies with the guild's handicap formula.
+func (h *ScoreHandlers) HandleHandicapSettingsRequest(ctx context.Context, payload *HandicapSettingsRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if payload == nil {
+		return nil, errors.New("payload is nil")
+	}
+
+	result, err := h.service.GetHandicapSettings(ctx, payload.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	if result.Success == nil {
+		return nil, errors.New("unexpected result from service: no handicap settings")
+	}
+
+	return []handlerwrapper.Result{{
+		Topic:   handlerutil.ReplyTopic(ctx, ScoreHandicapSettingsResponseV1),
+		Payload: &HandicapSettingsPayloadV1{GuildID: payload.GuildID, Settings: *result.Success},
+	}}, nil
+}
+
+// HandleHandicapSettingsUpdateRequest validates and stores a guild's handicap formula.
+func (h *ScoreHandlers) HandleHandicapSettingsUpdateRequest(ctx context.Context, payload *HandicapSettingsUpdateRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if payload == nil {
+		return nil, errors.New("payload is nil")
+	}
+
+	result, err := h.service.UpdateHandicapSettings(ctx, payload.GuildID, payload.Settings)
+	if err != nil {
+		return nil, err
+	}
+	if result.Failure != nil {
+		return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+			Topic: ScoreHandicapSettingsUpdateFailedV1,
+			Payload: &HandicapSettingsUpdateFailedPayloadV1{
+				GuildID: payload.GuildID,
+				UserID:  payload.UserID,
+				Reason:  (*result.Failure).Error(),
+			},
+		}}), nil
+	}
+
+	return handlerutil.WithReplyTo(ctx, []handlerwrapper.Result{{
+		Topic:   ScoreHandicapSettingsUpdatedV1,
+		Payload: &HandicapSettingsPayloadV1{GuildID: payload.GuildID, Settings: *result.Success},
+	}}), nil
+}
+
+// HandleRoundResultsRequest replies with a round's gross and net standings.
+func (h *ScoreHandlers) HandleRoundResultsRequest(ctx context.Context, payload *RoundResultsRequestPayloadV1) ([]handlerwrapper.Result, error) {
+	if payload == nil {
+		return nil, errors.New("payload is nil")
+	}
+
+	result, err := h.service.GetRoundResults(ctx, payload.GuildID, payload.RoundID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RoundResultsResponsePayloadV1{GuildID: payload.GuildID}
+	switch {
+	case result.Failure != nil:
+		response.Error = (*result.Failure).Error()
+	case result.Success != nil:
+		response.Results = result.Success
+	}
+
+	return []handlerwrapper.Result{{Topic: handlerutil.ReplyTopic(ctx, ScoreRoundResultsResponseV1), Payload: response}}, nil
+}
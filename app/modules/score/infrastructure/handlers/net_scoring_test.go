@@ -0,0 +1,143 @@
+package scorehandlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/results"
+	scoreservice "github.com/Black-And-White-Club/frolf-bot/app/modules/score/application"
+	"github.com/google/uuid"
+)
+
+func TestScoreHandlers_HandleHandicapSettingsUpdateRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-1")
+	settings := scoreservice.DefaultHandicapSettings()
+	settings.Method = scoreservice.HandicapMethodBestOf
+
+	tests := []struct {
+		name      string
+		setupFake func(*FakeScoreService)
+		payload   *HandicapSettingsUpdateRequestPayloadV1
+		ctx       context.Context
+		wantErr   bool
+		wantTopic string
+		wantLen   int
+	}{
+		{
+			name:    "nil payload",
+			payload: nil,
+			ctx:     context.Background(),
+			wantErr: true,
+		},
+		{
+			name:      "settings updated",
+			payload:   &HandicapSettingsUpdateRequestPayloadV1{GuildID: guildID, Settings: settings},
+			ctx:       context.Background(),
+			wantTopic: ScoreHandicapSettingsUpdatedV1,
+			wantLen:   1,
+		},
+		{
+			name: "invalid settings rejected",
+			setupFake: func(f *FakeScoreService) {
+				f.UpdateHandicapSettingsFunc = func(ctx context.Context, gID sharedtypes.GuildID, s scoreservice.HandicapSettings) (results.OperationResult[scoreservice.HandicapSettings, error], error) {
+					return results.FailureResult[scoreservice.HandicapSettings, error](scoreservice.ErrInvalidHandicapSettings), nil
+				}
+			},
+			payload:   &HandicapSettingsUpdateRequestPayloadV1{GuildID: guildID, Settings: settings},
+			ctx:       context.Background(),
+			wantTopic: ScoreHandicapSettingsUpdateFailedV1,
+			wantLen:   1,
+		},
+		{
+			name:      "request reply is mirrored to inbox",
+			payload:   &HandicapSettingsUpdateRequestPayloadV1{GuildID: guildID, Settings: settings},
+			ctx:       context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.abc"),
+			wantTopic: ScoreHandicapSettingsUpdatedV1,
+			wantLen:   2,
+		},
+		{
+			name: "infrastructure error",
+			setupFake: func(f *FakeScoreService) {
+				f.UpdateHandicapSettingsFunc = func(ctx context.Context, gID sharedtypes.GuildID, s scoreservice.HandicapSettings) (results.OperationResult[scoreservice.HandicapSettings, error], error) {
+					return results.OperationResult[scoreservice.HandicapSettings, error]{}, errors.New("db down")
+				}
+			},
+			payload: &HandicapSettingsUpdateRequestPayloadV1{GuildID: guildID, Settings: settings},
+			ctx:     context.Background(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSvc := NewFakeScoreService()
+			if tt.setupFake != nil {
+				tt.setupFake(fakeSvc)
+			}
+			h := &ScoreHandlers{service: fakeSvc}
+
+			got, err := h.HandleHandicapSettingsUpdateRequest(tt.ctx, tt.payload)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("HandleHandicapSettingsUpdateRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != tt.wantLen {
+				t.Fatalf("expected %d results, got %d", tt.wantLen, len(got))
+			}
+			if got[0].Topic != tt.wantTopic {
+				t.Errorf("expected topic %s, got %s", tt.wantTopic, got[0].Topic)
+			}
+		})
+	}
+}
+
+func TestScoreHandlers_HandleRoundResultsRequest(t *testing.T) {
+	guildID := sharedtypes.GuildID("guild-1")
+	roundID := sharedtypes.RoundID(uuid.New())
+
+	t.Run("returns results", func(t *testing.T) {
+		fakeSvc := NewFakeScoreService()
+		fakeSvc.GetRoundResultsFunc = func(ctx context.Context, gID sharedtypes.GuildID, rID sharedtypes.RoundID) (results.OperationResult[scoreservice.RoundResults, error], error) {
+			return results.SuccessResult[scoreservice.RoundResults, error](scoreservice.RoundResults{
+				RoundID:     rID,
+				ScoringMode: scoreservice.ScoringModeNet,
+				Results:     []scoreservice.PlayerResult{{UserID: "u1", GrossRank: 2, NetRank: 1}},
+			}), nil
+		}
+		h := &ScoreHandlers{service: fakeSvc}
+
+		got, err := h.HandleRoundResultsRequest(context.Background(), &RoundResultsRequestPayloadV1{GuildID: guildID, RoundID: roundID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got) != 1 || got[0].Topic != ScoreRoundResultsResponseV1 {
+			t.Fatalf("unexpected results: %+v", got)
+		}
+		payload := got[0].Payload.(*RoundResultsResponsePayloadV1)
+		if payload.Results == nil || payload.Results.Results[0].NetRank != 1 {
+			t.Errorf("expected net results in payload, got %+v", payload)
+		}
+	})
+
+	t.Run("missing round reports error", func(t *testing.T) {
+		fakeSvc := NewFakeScoreService()
+		fakeSvc.GetRoundResultsFunc = func(ctx context.Context, gID sharedtypes.GuildID, rID sharedtypes.RoundID) (results.OperationResult[scoreservice.RoundResults, error], error) {
+			return results.FailureResult[scoreservice.RoundResults, error](scoreservice.ErrRoundScoresNotFound), nil
+		}
+		h := &ScoreHandlers{service: fakeSvc}
+
+		got, err := h.HandleRoundResultsRequest(context.Background(), &RoundResultsRequestPayloadV1{GuildID: guildID, RoundID: roundID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		payload := got[0].Payload.(*RoundResultsResponsePayloadV1)
+		if payload.Error == "" || payload.Results != nil {
+			t.Errorf("expected error payload, got %+v", payload)
+		}
+	})
+}
@@ -13,7 +13,11 @@ import (
 
 // HandleProcessRoundScoresRequest handles incoming messages for processing round scores.
 // - Singles rounds propagate tag assignments to leaderboard.
-// - Team/group rounds only update DB, flow terminates here.
+// - Doubles rounds propagate tag assignments at each member's team finish rank.
+// - Other group rounds only update DB, flow terminates here.
+//
+// In doubles the tags held by the field are re-dealt in team order; teammates share
+// a finish rank, so the member with the better current tag takes the better tag.
 func (h *ScoreHandlers) HandleProcessRoundScoresRequest(
 	ctx context.Context,
 	payload *sharedevents.ProcessRoundScoresRequestedPayloadV1,
@@ -59,11 +63,11 @@ func (h *ScoreHandlers) HandleProcessRoundScoresRequest(
 		}, nil
 	}
 
-	// Non-singles rounds terminate here (DB updated only).
-	// FinishRanksByDiscordID is computed but not forwarded because non-singles
-	// rounds do not drive tag assignments or points. If a future mode needs
-	// FinishRank, add a branch here rather than silently using zero values.
-	if payload.RoundMode != sharedtypes.RoundModeSingles {
+	// Rounds other than singles and doubles terminate here (DB updated only).
+	// FinishRanksByDiscordID is computed but not forwarded because those rounds
+	// do not drive tag assignments or points. If a future mode needs FinishRank,
+	// add a branch here rather than silently using zero values.
+	if payload.RoundMode != sharedtypes.RoundModeSingles && payload.RoundMode != sharedtypes.RoundModeDoubles {
 		return nil, nil
 	}
 
@@ -127,10 +127,50 @@ func TestScoreHandlers_HandleProcessRoundScoresRequest(t *testing.T) {
 			},
 		},
 		{
-			name: "Successfully handle ProcessRoundScoresRequest - Teams (Terminates Early)",
+			name: "Doubles forwards each member at their team's finish rank",
+			payload: &sharedevents.ProcessRoundScoresRequestedPayloadV1{
+				GuildID:   testGuildID,
+				RoundID:   testRoundID,
+				Overwrite: true,
+				RoundMode: sharedtypes.RoundModeDoubles,
+				Scores: []sharedtypes.ScoreInfo{
+					{UserID: "a1", Score: -6, TagNumber: ptrTagNumber(9)},
+					{UserID: "a2", Score: -6, TagNumber: ptrTagNumber(4)},
+					{UserID: "b1", Score: -2, TagNumber: ptrTagNumber(1)},
+				},
+			},
+			setupFake: func(f *FakeScoreService) {
+				f.ProcessRoundScoresFunc = func(ctx context.Context, gID sharedtypes.GuildID, rID sharedtypes.RoundID, scores []sharedtypes.ScoreInfo, overwrite bool) (results.OperationResult[scoreservice.ProcessRoundScoresResult, error], error) {
+					return results.OperationResult[scoreservice.ProcessRoundScoresResult, error]{
+						Success: &scoreservice.ProcessRoundScoresResult{
+							FinishRanksByDiscordID: map[sharedtypes.DiscordID]int{"a1": 1, "a2": 1, "b1": 2},
+						},
+					}, nil
+				}
+			},
+			wantErr: false,
+			checkResults: func(t *testing.T, res []handlerwrapper.Result) {
+				if len(res) != 1 {
+					t.Fatalf("expected 1 result, got %d", len(res))
+				}
+				batchPayload, ok := res[0].Payload.(*sharedevents.BatchTagAssignmentRequestedPayloadV1)
+				if !ok {
+					t.Fatalf("expected *BatchTagAssignmentRequestedPayloadV1, got %T", res[0].Payload)
+				}
+				rankByUser := make(map[sharedtypes.DiscordID]int, len(batchPayload.Assignments))
+				for _, a := range batchPayload.Assignments {
+					rankByUser[a.UserID] = a.FinishRank
+				}
+				if len(rankByUser) != 3 || rankByUser["a1"] != 1 || rankByUser["a2"] != 1 || rankByUser["b1"] != 2 {
+					t.Errorf("unexpected finish ranks %v", rankByUser)
+				}
+			},
+		},
+		{
+			name: "Other group modes terminate early",
 			payload: func() *sharedevents.ProcessRoundScoresRequestedPayloadV1 {
 				p := *basePayload
-				p.RoundMode = sharedtypes.RoundModeDoubles
+				p.RoundMode = sharedtypes.RoundMode("TEAMS")
 				return &p
 			}(),
 			setupFake: func(f *FakeScoreService) {
@@ -143,7 +183,7 @@ func TestScoreHandlers_HandleProcessRoundScoresRequest(t *testing.T) {
 			wantErr: false,
 			checkResults: func(t *testing.T, res []handlerwrapper.Result) {
 				if len(res) != 0 {
-					t.Errorf("expected 0 results for non-singles mode, got %d", len(res))
+					t.Errorf("expected 0 results for other group modes, got %d", len(res))
 				}
 			},
 		},
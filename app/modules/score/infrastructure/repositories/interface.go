@@ -32,4 +32,23 @@ type Repository interface {
 	// GetScoresForRound retrieves all scores for a round.
 	// Returns nil if no scores exist (not an error condition).
 	GetScoresForRound(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]sharedtypes.ScoreInfo, error)
+
+	// GetScoreRecord retrieves the full score record for a round, including net results.
+	// Returns ErrNotFound if the round has no scores.
+	GetScoreRecord(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*Score, error)
+
+	// SaveNetResults stores the scoring mode, tag order and net results for a round.
+	// Returns ErrNoRowsAffected if the round has no score record.
+	SaveNetResults(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, scoringMode, tagOrder string, netData []NetScore) error
+
+	// ListRecentScores returns the guild's most recent score records, newest first,
+	// excluding the given round. Used to derive handicaps.
+	ListRecentScores(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, excludeRoundID sharedtypes.RoundID, limit int) ([]Score, error)
+
+	// GetHandicapSettings returns the guild's handicap formula.
+	// Returns ErrNotFound if the guild has not configured one.
+	GetHandicapSettings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*HandicapSettings, error)
+
+	// UpsertHandicapSettings creates or replaces the guild's handicap formula.
+	UpsertHandicapSettings(ctx context.Context, db bun.IDB, settings *HandicapSettings) error
 }
@@ -0,0 +1,72 @@
+package scoremigrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Adding net scoring columns and handicap settings for scores module...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE scores
+					ADD COLUMN IF NOT EXISTS scoring_mode VARCHAR(10) NOT NULL DEFAULT 'gross',
+					ADD COLUMN IF NOT EXISTS tag_order VARCHAR(10) NOT NULL DEFAULT 'gross',
+					ADD COLUMN IF NOT EXISTS net_data JSONB,
+					ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+			`); err != nil {
+				return fmt.Errorf("failed to add net scoring columns to scores: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE INDEX IF NOT EXISTS idx_scores_guild_created_at ON scores(guild_id, created_at DESC);
+			`); err != nil {
+				return fmt.Errorf("failed to add scores created_at index: %w", err)
+			}
+
+			if _, err := tx.ExecContext(ctx, `
+				CREATE TABLE IF NOT EXISTS handicap_settings (
+					guild_id VARCHAR(20) PRIMARY KEY,
+					method VARCHAR(20) NOT NULL,
+					rounds_considered INTEGER NOT NULL,
+					best_of INTEGER NOT NULL,
+					allowance_percent INTEGER NOT NULL,
+					max_handicap DOUBLE PRECISION NOT NULL,
+					min_rounds INTEGER NOT NULL,
+					tag_order VARCHAR(10) NOT NULL,
+					updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+					CONSTRAINT chk_handicap_settings_tag_order CHECK (tag_order IN ('gross', 'net'))
+				);
+			`); err != nil {
+				return fmt.Errorf("failed to create handicap_settings table: %w", err)
+			}
+
+			return nil
+		})
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Println("Rolling back net scoring columns and handicap settings for scores module...")
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := tx.ExecContext(ctx, `DROP TABLE IF EXISTS handicap_settings;`); err != nil {
+				return fmt.Errorf("failed to drop handicap_settings table: %w", err)
+			}
+			if _, err := tx.ExecContext(ctx, `DROP INDEX IF EXISTS idx_scores_guild_created_at;`); err != nil {
+				return fmt.Errorf("failed to drop scores created_at index: %w", err)
+			}
+			if _, err := tx.ExecContext(ctx, `
+				ALTER TABLE scores
+					DROP COLUMN IF EXISTS created_at,
+					DROP COLUMN IF EXISTS net_data,
+					DROP COLUMN IF EXISTS tag_order,
+					DROP COLUMN IF EXISTS scoring_mode;
+			`); err != nil {
+				return fmt.Errorf("failed to drop net scoring columns from scores: %w", err)
+			}
+			return nil
+		})
+	})
+}
@@ -1,6 +1,8 @@
 package scoredb
 
 import (
+	"time"
+
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/uptrace/bun"
 )
@@ -13,4 +15,37 @@ type Score struct {
 	RoundData []sharedtypes.ScoreInfo `bun:"round_data,type:jsonb,notnull"`
 	Source    string                  `bun:"source,notnull"`
 	GuildID   sharedtypes.GuildID     `bun:"guild_id,notnull"`
+
+	// ScoringMode records whether finish ranks were computed on gross or net scores.
+	ScoringMode string `bun:"scoring_mode,notnull,default:'gross'"`
+	// TagOrder records whether tag reallocation followed gross or net order.
+	TagOrder string `bun:"tag_order,notnull,default:'gross'"`
+	// NetData holds per-player handicap results; nil for gross rounds.
+	NetData   []NetScore `bun:"net_data,type:jsonb,nullzero"`
+	CreatedAt time.Time  `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// NetScore is one player's handicapped result within a net round.
+type NetScore struct {
+	UserID     sharedtypes.DiscordID `json:"user_id"`
+	GrossScore sharedtypes.Score     `json:"gross_score"`
+	Handicap   float64               `json:"handicap"`
+	NetScore   float64               `json:"net_score"`
+	GrossRank  int                   `json:"gross_rank"`
+	NetRank    int                   `json:"net_rank"`
+}
+
+// HandicapSettings is a guild's handicap formula for net rounds.
+type HandicapSettings struct {
+	bun.BaseModel `bun:"table:handicap_settings,alias:hs"`
+
+	GuildID          sharedtypes.GuildID `bun:"guild_id,pk"`
+	Method           string              `bun:"method,notnull"`
+	RoundsConsidered int                 `bun:"rounds_considered,notnull"`
+	BestOf           int                 `bun:"best_of,notnull"`
+	AllowancePercent int                 `bun:"allowance_percent,notnull"`
+	MaxHandicap      float64             `bun:"max_handicap,notnull"`
+	MinRounds        int                 `bun:"min_rounds,notnull"`
+	TagOrder         string              `bun:"tag_order,notnull"`
+	UpdatedAt        time.Time           `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
 }
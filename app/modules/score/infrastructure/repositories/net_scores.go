@@ -0,0 +1,101 @@
+package scoredb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/uptrace/bun"
+)
+
+func (r *Impl) GetScoreRecord(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (*Score, error) {
+	db = r.resolveDB(db)
+
+	var score Score
+	err := db.NewSelect().
+		Model(&score).
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("scoredb.GetScoreRecord: %w", err)
+	}
+	return &score, nil
+}
+
+func (r *Impl) SaveNetResults(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, scoringMode, tagOrder string, netData []NetScore) error {
+	db = r.resolveDB(db)
+
+	score := Score{ScoringMode: scoringMode, TagOrder: tagOrder, NetData: netData}
+	res, err := db.NewUpdate().
+		Model(&score).
+		Column("scoring_mode", "tag_order", "net_data").
+		Where("id = ? AND guild_id = ?", roundID, guildID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("scoredb.SaveNetResults: %w", err)
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		return ErrNoRowsAffected
+	}
+	return nil
+}
+
+func (r *Impl) ListRecentScores(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID, excludeRoundID sharedtypes.RoundID, limit int) ([]Score, error) {
+	db = r.resolveDB(db)
+
+	var scores []Score
+	err := db.NewSelect().
+		Model(&scores).
+		Column("id", "round_data", "guild_id", "created_at").
+		Where("guild_id = ? AND id != ?", guildID, excludeRoundID).
+		OrderExpr("created_at DESC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("scoredb.ListRecentScores: %w", err)
+	}
+	return scores, nil
+}
+
+func (r *Impl) GetHandicapSettings(ctx context.Context, db bun.IDB, guildID sharedtypes.GuildID) (*HandicapSettings, error) {
+	db = r.resolveDB(db)
+
+	var settings HandicapSettings
+	err := db.NewSelect().
+		Model(&settings).
+		Where("guild_id = ?", guildID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("scoredb.GetHandicapSettings: %w", err)
+	}
+	return &settings, nil
+}
+
+func (r *Impl) UpsertHandicapSettings(ctx context.Context, db bun.IDB, settings *HandicapSettings) error {
+	db = r.resolveDB(db)
+
+	_, err := db.NewInsert().
+		Model(settings).
+		On("CONFLICT (guild_id) DO UPDATE").
+		Set("method = EXCLUDED.method").
+		Set("rounds_considered = EXCLUDED.rounds_considered").
+		Set("best_of = EXCLUDED.best_of").
+		Set("allowance_percent = EXCLUDED.allowance_percent").
+		Set("max_handicap = EXCLUDED.max_handicap").
+		Set("min_rounds = EXCLUDED.min_rounds").
+		Set("tag_order = EXCLUDED.tag_order").
+		Set("updated_at = now()").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("scoredb.UpsertHandicapSettings: %w", err)
+	}
+	return nil
+}
@@ -43,6 +43,7 @@ func (r *Impl) LogScores(ctx context.Context, db bun.IDB, guildID sharedtypes.Gu
 		Model(&scoreToLog).
 		On("CONFLICT (id) DO UPDATE").
 		Set("round_data = EXCLUDED.round_data, source = EXCLUDED.source, guild_id = EXCLUDED.guild_id").
+		Set("scoring_mode = EXCLUDED.scoring_mode, tag_order = EXCLUDED.tag_order, net_data = EXCLUDED.net_data").
 		Exec(ctx)
 
 	if err != nil {
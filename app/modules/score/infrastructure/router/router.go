@@ -185,8 +185,13 @@ func (r *ScoreRouter) RegisterHandlers(ctx context.Context, handlers scorehandle
 		handlers.HandleReprocessAfterBulkScoreUpdate,
 	)
 
+	// Handicaps and net scoring; request/reply subjects capture per-club suffixes.
+	registerHandler(deps, scorehandlers.ScoreHandicapSettingsRequestV1+".>", handlers.HandleHandicapSettingsRequest)
+	registerHandler(deps, scorehandlers.ScoreHandicapSettingsUpdateRequestedV1, handlers.HandleHandicapSettingsUpdateRequest)
+	registerHandler(deps, scorehandlers.ScoreRoundResultsRequestV1+".>", handlers.HandleRoundResultsRequest)
+
 	r.logger.Info("Registered score handlers",
-		attr.Int("handlers", 5),
+		attr.Int("handlers", 8),
 	)
 
 	return nil
@@ -12,6 +12,7 @@ import (
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/clubresolver"
 	guilddb "github.com/Black-And-White-Club/frolf-bot/app/modules/guild/infrastructure/repositories"
+	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
 	scoreservice "github.com/Black-And-White-Club/frolf-bot/app/modules/score/application"
 	scoredb "github.com/Black-And-White-Club/frolf-bot/app/modules/score/infrastructure/repositories"
 	scorerouter "github.com/Black-And-White-Club/frolf-bot/app/modules/score/infrastructure/router"
@@ -77,6 +78,17 @@ func NewScoreModule(
 	return module, nil
 }
 
+// SetRoundRepository lets score processing read each round's scoring mode so net
+// rounds are ranked by handicap-adjusted score.
+func (m *Module) SetRoundRepository(rounds rounddb.Repository) {
+	if rounds == nil {
+		return
+	}
+	if service, ok := m.ScoreService.(*scoreservice.ScoreService); ok {
+		service.WithRoundScoringModes(rounds)
+	}
+}
+
 func (m *Module) Run(ctx context.Context, wg *sync.WaitGroup) {
 	ctx, cancel := context.WithCancel(ctx)
 	m.cancelFunc = cancel
@@ -0,0 +1,26 @@
+// Package handlerutil holds helpers shared by the Watermill handlers of every module.
+package handlerutil
+
+import (
+	"context"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+)
+
+// ReplyTopic returns the NATS reply inbox when the message was a request, or
+// fallback otherwise.
+func ReplyTopic(ctx context.Context, fallback string) string {
+	if replyTo, ok := ctx.Value(handlerwrapper.CtxKeyReplyTo).(string); ok && replyTo != "" {
+		return replyTo
+	}
+	return fallback
+}
+
+// WithReplyTo mirrors the first result to the request inbox when the message was a
+// NATS request, so PWA callers get a direct answer.
+func WithReplyTo(ctx context.Context, results []handlerwrapper.Result) []handlerwrapper.Result {
+	if replyTo, ok := ctx.Value(handlerwrapper.CtxKeyReplyTo).(string); ok && replyTo != "" && len(results) > 0 {
+		results = append(results, handlerwrapper.Result{Topic: replyTo, Payload: results[0].Payload})
+	}
+	return results
+}
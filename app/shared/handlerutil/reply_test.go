@@ -0,0 +1,38 @@
+package handlerutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
+)
+
+func TestReplyTopic(t *testing.T) {
+	if got := ReplyTopic(context.Background(), "fallback.v1"); got != "fallback.v1" {
+		t.Errorf("expected fallback topic, got %q", got)
+	}
+
+	ctx := context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.reply")
+	if got := ReplyTopic(ctx, "fallback.v1"); got != "_INBOX.reply" {
+		t.Errorf("expected reply inbox, got %q", got)
+	}
+}
+
+func TestWithReplyTo(t *testing.T) {
+	results := []handlerwrapper.Result{{Topic: "event.v1", Payload: "payload"}}
+
+	got := WithReplyTo(context.Background(), results)
+	if len(got) != 1 {
+		t.Fatalf("expected no reply result without an inbox, got %d results", len(got))
+	}
+
+	ctx := context.WithValue(context.Background(), handlerwrapper.CtxKeyReplyTo, "_INBOX.reply")
+	got = WithReplyTo(ctx, results)
+	if len(got) != 2 || got[1].Topic != "_INBOX.reply" || got[1].Payload != "payload" {
+		t.Errorf("expected the first result mirrored to the inbox, got %+v", got)
+	}
+
+	if got := WithReplyTo(ctx, nil); len(got) != 0 {
+		t.Errorf("expected nothing to mirror, got %+v", got)
+	}
+}
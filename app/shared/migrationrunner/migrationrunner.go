@@ -9,8 +9,10 @@ import (
 
 	bettingmigrations "github.com/Black-And-White-Club/frolf-bot/app/modules/betting/infrastructure/repositories/migrations"
 	clubmigrations "github.com/Black-And-White-Club/frolf-bot/app/modules/club/infrastructure/repositories/migrations"
+	coursemigrations "github.com/Black-And-White-Club/frolf-bot/app/modules/course/infrastructure/repositories/migrations"
 	guildmigrations "github.com/Black-And-White-Club/frolf-bot/app/modules/guild/infrastructure/repositories/migrations"
 	leaderboardmigrations "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories/migrations"
+	ratingmigrations "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/infrastructure/repositories/migrations"
 	roundmigrations "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories/migrations"
 	scoremigrations "github.com/Black-And-White-Club/frolf-bot/app/modules/score/infrastructure/repositories/migrations"
 	usermigrations "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories/migrations"
@@ -33,7 +35,9 @@ var dependencyOrderedModules = []ModuleConfig{
 	{Name: "guild", TableName: "bun_migrations_guild", Migrations: guildmigrations.Migrations},
 	{Name: "user", TableName: "bun_migrations_user", Migrations: usermigrations.Migrations},
 	{Name: "club", TableName: "bun_migrations_club", Migrations: clubmigrations.Migrations},
+	{Name: "course", TableName: "bun_migrations_course", Migrations: coursemigrations.Migrations},
 	{Name: "round", TableName: "bun_migrations_round", Migrations: roundmigrations.Migrations},
+	{Name: "rating", TableName: "bun_migrations_rating", Migrations: ratingmigrations.Migrations},
 	{Name: "score", TableName: "bun_migrations_score", Migrations: scoremigrations.Migrations},
 	{Name: "leaderboard", TableName: "bun_migrations_leaderboard", Migrations: leaderboardmigrations.Migrations},
 	{Name: "betting", TableName: "bun_migrations_betting", Migrations: bettingmigrations.Migrations},
@@ -66,7 +66,7 @@ func TestOrderedModuleNames(t *testing.T) {
 		{
 			name:    "reverse order",
 			reverse: true,
-			want:    []string{"betting", "leaderboard", "score", "round", "club", "user", "guild"},
+			want:    []string{"betting", "leaderboard", "score", "rating", "round", "course", "club", "user", "guild"},
 		},
 	}
 
@@ -162,7 +162,9 @@ func TestInitMigrateRollbackModules(t *testing.T) {
 				"init:guild",
 				"init:user",
 				"init:club",
+				"init:course",
 				"init:round",
+				"init:rating",
 				"init:score",
 				"init:leaderboard",
 				"init:betting",
@@ -198,7 +200,9 @@ func TestInitMigrateRollbackModules(t *testing.T) {
 				"migrate:guild",
 				"migrate:user",
 				"migrate:club",
+				"migrate:course",
 				"migrate:round",
+				"migrate:rating",
 				"migrate:score",
 				"migrate:leaderboard",
 				"migrate:betting",
@@ -221,6 +225,7 @@ func TestInitMigrateRollbackModules(t *testing.T) {
 				"migrate:guild",
 				"migrate:user",
 				"migrate:club",
+				"migrate:course",
 				"migrate:round",
 			},
 			wantErrContains:    "migrate round module",
@@ -236,7 +241,9 @@ func TestInitMigrateRollbackModules(t *testing.T) {
 				"rollback:betting",
 				"rollback:leaderboard",
 				"rollback:score",
+				"rollback:rating",
 				"rollback:round",
+				"rollback:course",
 				"rollback:club",
 				"rollback:user",
 				"rollback:guild",
@@ -377,7 +384,7 @@ func TestOrderedModuleNamesFromConfig(t *testing.T) {
 	}{
 		{
 			name: "matches dependency module configs",
-			want: []string{"guild", "user", "club", "round", "score", "leaderboard", "betting"},
+			want: []string{"guild", "user", "club", "course", "round", "rating", "score", "leaderboard", "betting"},
 		},
 	}
 
@@ -403,7 +410,7 @@ func TestOrderedModuleNames_ErrorFormatting(t *testing.T) {
 		{
 			name:      "missing and unknown sorted in error",
 			migrators: map[string]int{"guild": 1, "user": 1, "club": 1, "round": 1, "bogus": 1},
-			want:      []string{"missing=[betting leaderboard score]", "unknown=[bogus]"},
+			want:      []string{"missing=[betting course leaderboard rating score]", "unknown=[bogus]"},
 		},
 	}
 
@@ -27,7 +27,9 @@ func TestOrderedModuleNames(t *testing.T) {
 				"guild":       nil,
 				"user":        nil,
 				"club":        nil,
+				"course":      nil,
 				"round":       nil,
+				"rating":      nil,
 				"score":       nil,
 				"leaderboard": nil,
 				"betting":     nil,
@@ -50,7 +52,7 @@ func TestOrderedModuleNames(t *testing.T) {
 			t.Run("reverse order", func(t *testing.T) {
 				t.Parallel()
 
-				want := []string{"betting", "leaderboard", "score", "round", "club", "user", "guild"}
+				want := []string{"betting", "leaderboard", "score", "rating", "round", "course", "club", "user", "guild"}
 
 				got, err := orderedModuleNames(migrators, true)
 				if err != nil {
@@ -144,7 +146,9 @@ func TestOrderedModuleNames_ValidationErrors(t *testing.T) {
 					"guild":       nil,
 					"user":        nil,
 					"club":        nil,
+					"course":      nil,
 					"round":       nil,
+					"rating":      nil,
 					"score":       nil,
 					"leaderboard": nil,
 					"betting":     nil,
@@ -164,7 +168,9 @@ func TestOrderedModuleNames_ValidationErrors(t *testing.T) {
 					"guild":       nil,
 					"user":        nil,
 					"club":        nil,
+					"course":      nil,
 					"round":       nil,
+					"rating":      nil,
 					"score":       nil,
 					"leaderboard": nil,
 					"betting":     nil,
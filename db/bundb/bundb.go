@@ -12,8 +12,10 @@ import (
 
 	bettingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/betting/infrastructure/repositories"
 	clubdb "github.com/Black-And-White-Club/frolf-bot/app/modules/club/infrastructure/repositories"
+	coursedb "github.com/Black-And-White-Club/frolf-bot/app/modules/course/infrastructure/repositories"
 	guilddb "github.com/Black-And-White-Club/frolf-bot/app/modules/guild/infrastructure/repositories"
 	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	ratingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/infrastructure/repositories"
 	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
 	scoredb "github.com/Black-And-White-Club/frolf-bot/app/modules/score/infrastructure/repositories"
 	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
@@ -71,6 +73,7 @@ func newDBServiceWithDB(db *bun.DB) (*DBService, error) {
 	db.RegisterModel(&userdb.User{})
 	db.RegisterModel(&rounddb.Round{})
 	db.RegisterModel(&scoredb.Score{})
+	db.RegisterModel(&scoredb.HandicapSettings{})
 	db.RegisterModel(&leaderboarddb.Season{})
 	db.RegisterModel(&leaderboarddb.SeasonStanding{})
 	db.RegisterModel(&leaderboarddb.PointHistory{})
@@ -86,6 +89,11 @@ func newDBServiceWithDB(db *bun.DB) (*DBService, error) {
 	db.RegisterModel(&bettingdb.Market{})
 	db.RegisterModel(&bettingdb.MarketOption{})
 	db.RegisterModel(&bettingdb.Bet{})
+	db.RegisterModel(&coursedb.Course{})
+	db.RegisterModel(&coursedb.Layout{})
+	db.RegisterModel(&coursedb.Hole{})
+	db.RegisterModel(&ratingdb.PlayerRating{})
+	db.RegisterModel(&ratingdb.RatingHistory{})
 	log.Println("newDBServiceWithDB - Models registered successfully")
 
 	dbService := &DBService{
@@ -22,6 +22,8 @@ import (
 	clubdb "github.com/Black-And-White-Club/frolf-bot/app/modules/club/infrastructure/repositories"
 	guilddb "github.com/Black-And-White-Club/frolf-bot/app/modules/guild/infrastructure/repositories"
 	leaderboarddb "github.com/Black-And-White-Club/frolf-bot/app/modules/leaderboard/infrastructure/repositories"
+	ratingservice "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/application"
+	ratingdb "github.com/Black-And-White-Club/frolf-bot/app/modules/rating/infrastructure/repositories"
 	rounddb "github.com/Black-And-White-Club/frolf-bot/app/modules/round/infrastructure/repositories"
 	userdb "github.com/Black-And-White-Club/frolf-bot/app/modules/user/infrastructure/repositories"
 
@@ -102,6 +104,7 @@ func SetupTestBettingService(t *testing.T) BettingTestDeps {
 	guildRepo := guilddb.NewRepository(env.DB)
 	leaderboardRepo := leaderboarddb.NewRepository(env.DB)
 	roundRepo := rounddb.NewRepository(env.DB)
+	ratingService := ratingservice.NewRatingService(ratingdb.NewRepository(env.DB), roundRepo, testLogger, noOpTracer, env.DB)
 
 	service := bettingservice.NewService(
 		repo,
@@ -109,6 +112,7 @@ func SetupTestBettingService(t *testing.T) BettingTestDeps {
 		guildRepo,
 		leaderboardRepo,
 		roundRepo,
+		ratingService,
 		bettingmetrics.NewNoop(),
 		testLogger,
 		noOpTracer,